@@ -7,6 +7,10 @@ import { NotFound } from './pages/NotFound'
 import { ConsoleLogin } from './pages/auth/ConsoleLogin'
 import { ConsoleRegister } from './pages/auth/ConsoleRegister'
 import { PlatformLogin } from './pages/auth/PlatformLogin'
+import { SSOCallback } from './pages/auth/SSOCallback'
+import { AcceptInvite } from './pages/auth/AcceptInvite'
+import { ForgotPassword } from './pages/auth/ForgotPassword'
+import { ResetPassword } from './pages/auth/ResetPassword'
 import { RequirePermission } from './components/RequirePermission'
 import { PermissionProvider } from './auth/PermissionContext'
 import { RequireAuth } from './components/RequireAuth'
@@ -21,6 +25,10 @@ function App() {
       <Route path="/chat/:chatId/:sessionId" element={<PublicChat />} />
       <Route path="/console/login" element={<ConsoleLogin />} />
       <Route path="/console/register" element={<ConsoleRegister />} />
+      <Route path="/console/sso/callback" element={<SSOCallback />} />
+      <Route path="/console/invite" element={<AcceptInvite />} />
+      <Route path="/console/forgot-password" element={<ForgotPassword />} />
+      <Route path="/console/reset-password" element={<ResetPassword />} />
       <Route path="/platform/login" element={<PlatformLogin />} />
 
       <Route
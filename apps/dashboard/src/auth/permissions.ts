@@ -4,17 +4,22 @@ export const permissions = {
     userRead: 'tenant.user.read',
     roleRead: 'tenant.role.read',
     permissionRead: 'tenant.permission.read',
+    resourceGroupRead: 'tenant.resource_group.read',
+    ssoRead: 'tenant.sso.read',
     botRead: 'tenant.bot.read',
     knowledgeRead: 'tenant.knowledge_base.read',
     documentRead: 'tenant.document.read',
     apiKeyRead: 'tenant.api_key.read',
     apiUsageRead: 'tenant.api_usage.read',
     chatSessionRead: 'tenant.chat_session.read',
+    auditLogRead: 'tenant.audit_log.read',
+    llmCredentialRead: 'tenant.llm_credential.read',
   },
   platform: {
     tenantRead: 'platform.tenant.read',
     adminRead: 'platform.admin.read',
     roleRead: 'platform.role.read',
     permissionRead: 'platform.permission.read',
+    auditLogRead: 'platform.audit_log.read',
   },
 }
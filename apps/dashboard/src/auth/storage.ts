@@ -1,7 +1,9 @@
 const TOKEN_KEY = 'ragodesk_token'
+const REFRESH_TOKEN_KEY = 'ragodesk_refresh_token'
 const TENANT_KEY = 'ragodesk_tenant_id'
 const PROFILE_KEY = 'ragodesk_profile'
 const SCOPE_KEY = 'ragodesk_scope'
+const STASH_KEY = 'ragodesk_platform_stash'
 
 export type StoredProfile = {
   subject_id?: string
@@ -10,6 +12,9 @@ export type StoredProfile = {
   account?: string
   roles?: string[]
   scope?: 'console' | 'platform'
+  // Set while a platform admin impersonates the tenant.
+  impersonation_id?: string
+  read_only?: boolean
 }
 
 export function getToken() {
@@ -22,6 +27,19 @@ export function setToken(token: string) {
 
 export function clearToken() {
   localStorage.removeItem(TOKEN_KEY)
+  localStorage.removeItem(REFRESH_TOKEN_KEY)
+}
+
+export function getRefreshToken() {
+  return localStorage.getItem(REFRESH_TOKEN_KEY)
+}
+
+export function setRefreshToken(token?: string) {
+  if (token) {
+    localStorage.setItem(REFRESH_TOKEN_KEY, token)
+  } else {
+    localStorage.removeItem(REFRESH_TOKEN_KEY)
+  }
 }
 
 export function getTenantId() {
@@ -78,3 +96,29 @@ export function getCurrentTenantId() {
   if (profile?.tenant_id) return profile.tenant_id
   return getTenantId()
 }
+
+// stashPlatformSession keeps the platform session aside while its admin
+// impersonates a tenant, so signing out of the console returns to it.
+export function stashPlatformSession() {
+  localStorage.setItem(
+    STASH_KEY,
+    JSON.stringify({ token: getToken(), refresh_token: getRefreshToken(), profile: getProfile() }),
+  )
+}
+
+export function restorePlatformSession() {
+  const raw = localStorage.getItem(STASH_KEY)
+  localStorage.removeItem(STASH_KEY)
+  if (!raw) return false
+  try {
+    const stash = JSON.parse(raw) as { token?: string; refresh_token?: string; profile?: StoredProfile }
+    if (!stash.token) return false
+    setToken(stash.token)
+    setRefreshToken(stash.refresh_token)
+    if (stash.profile) setProfile(stash.profile)
+    setScope('platform')
+    return true
+  } catch {
+    return false
+  }
+}
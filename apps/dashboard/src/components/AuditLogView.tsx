@@ -0,0 +1,210 @@
+import { Button, DatePicker, Descriptions, Input, Select, Space, Tag, Typography } from 'antd'
+import type { Dayjs } from 'dayjs'
+import { useState } from 'react'
+import { PageHeader } from './PageHeader'
+import { FilterBar } from './FilterBar'
+import { TableCard } from './TableCard'
+import { DataSourceTag } from './DataSourceTag'
+import { RequestBanner } from './RequestBanner'
+import { useRequest } from '../hooks/useRequest'
+import { auditApi } from '../services/audit'
+import type { AuditLogItem, AuditLogQuery, AuditScope } from '../services/audit'
+import { formatDateTime } from '../utils/datetime'
+import { uiMessage } from '../services/uiMessage'
+
+type AuditLogViewProps = {
+  scope: AuditScope
+  description: string
+}
+
+export function AuditLogView({ scope, description }: AuditLogViewProps) {
+  const [tenantId, setTenantId] = useState('')
+  const [actorId, setActorId] = useState('')
+  const [operation, setOperation] = useState('')
+  const [resourceType, setResourceType] = useState('')
+  const [resourceId, setResourceId] = useState('')
+  const [result, setResult] = useState('all')
+  const [impersonationId, setImpersonationId] = useState('')
+  const [range, setRange] = useState<[Dayjs | null, Dayjs | null] | null>(null)
+  const [query, setQuery] = useState<AuditLogQuery>({})
+
+  const { data, loading, source, error } = useRequest(
+    () => auditApi.listAuditLogs(scope, { ...query, limit: 200 }),
+    { items: [] },
+    { deps: [query] },
+  )
+
+  const applyFilters = () => {
+    const next: AuditLogQuery = {}
+    if (scope === 'platform' && tenantId.trim()) next.tenant_id = tenantId.trim()
+    if (actorId.trim()) next.actor_id = actorId.trim()
+    if (operation.trim()) next.operation = operation.trim()
+    if (resourceType.trim()) next.resource_type = resourceType.trim()
+    if (resourceId.trim()) next.resource_id = resourceId.trim()
+    if (result !== 'all') next.result = result
+    if (impersonationId.trim()) next.impersonation_id = impersonationId.trim()
+    if (range && range[0] && range[1]) {
+      next.start_time = range[0].toISOString()
+      next.end_time = range[1].toISOString()
+    }
+    setQuery(next)
+  }
+
+  const resetFilters = () => {
+    setTenantId('')
+    setActorId('')
+    setOperation('')
+    setResourceType('')
+    setResourceId('')
+    setResult('all')
+    setImpersonationId('')
+    setRange(null)
+    setQuery({})
+  }
+
+  const handleExport = async () => {
+    try {
+      const res = await auditApi.exportAuditLogs(scope, { ...query, format: 'csv' })
+      if (res.content) {
+        const blob = new Blob([res.content], { type: res.content_type || 'text/csv' })
+        const url = URL.createObjectURL(blob)
+        const link = document.createElement('a')
+        link.href = url
+        link.download = res.filename || 'audit-log.csv'
+        link.click()
+        URL.revokeObjectURL(url)
+        return
+      }
+      uiMessage.info('暂无可下载内容')
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  return (
+    <div className="page">
+      <PageHeader title="审计日志" description={description} extra={<DataSourceTag source={source} />} />
+      <RequestBanner error={error} />
+      <FilterBar
+        left={
+          <Space wrap>
+            {scope === 'platform' ? (
+              <Input
+                placeholder="租户 ID"
+                value={tenantId}
+                onChange={(e) => setTenantId(e.target.value)}
+                style={{ width: 200 }}
+              />
+            ) : null}
+            <Input placeholder="操作人 ID" value={actorId} onChange={(e) => setActorId(e.target.value)} style={{ width: 200 }} />
+            <Input
+              placeholder="操作，如 ConsoleIAM/DeleteUser"
+              value={operation}
+              onChange={(e) => setOperation(e.target.value)}
+              style={{ width: 240 }}
+            />
+            <Input
+              placeholder="资源类型"
+              value={resourceType}
+              onChange={(e) => setResourceType(e.target.value)}
+              style={{ width: 140 }}
+            />
+            <Input
+              placeholder="资源 ID"
+              value={resourceId}
+              onChange={(e) => setResourceId(e.target.value)}
+              style={{ width: 200 }}
+            />
+            <Input
+              placeholder="代管会话 ID"
+              value={impersonationId}
+              onChange={(e) => setImpersonationId(e.target.value)}
+              style={{ width: 200 }}
+            />
+            <Select
+              value={result}
+              style={{ width: 120 }}
+              onChange={setResult}
+              options={[
+                { value: 'all', label: '全部结果' },
+                { value: 'success', label: '成功' },
+                { value: 'failure', label: '失败' },
+              ]}
+            />
+            <DatePicker.RangePicker showTime value={range} onChange={(value) => setRange(value)} />
+          </Space>
+        }
+        right={
+          <Space>
+            <Button onClick={applyFilters}>应用筛选</Button>
+            <Button onClick={resetFilters}>重置</Button>
+            <Button type="primary" onClick={handleExport}>
+              导出 CSV
+            </Button>
+          </Space>
+        }
+      />
+      <TableCard<AuditLogItem>
+        table={{
+          rowKey: 'id',
+          dataSource: data.items,
+          loading,
+          pagination: { pageSize: 20 },
+          expandable: {
+            expandedRowRender: (record) => (
+              <Descriptions column={2} bordered size="small">
+                <Descriptions.Item label="日志 ID">{record.id}</Descriptions.Item>
+                <Descriptions.Item label="租户 ID">{record.tenant_id || '-'}</Descriptions.Item>
+                <Descriptions.Item label="状态码">{record.status_code}</Descriptions.Item>
+                <Descriptions.Item label="错误原因">{record.error_reason || '-'}</Descriptions.Item>
+                <Descriptions.Item label="客户端 IP">{record.client_ip || '-'}</Descriptions.Item>
+                <Descriptions.Item label="User Agent">{record.user_agent || '-'}</Descriptions.Item>
+                {record.impersonation_id ? (
+                  <Descriptions.Item label="代管会话" span={2}>
+                    {record.impersonation_id}
+                  </Descriptions.Item>
+                ) : null}
+                <Descriptions.Item label="请求摘要" span={2}>
+                  <Typography.Text code style={{ whiteSpace: 'pre-wrap', wordBreak: 'break-all' }}>
+                    {record.request_summary || '-'}
+                  </Typography.Text>
+                </Descriptions.Item>
+              </Descriptions>
+            ),
+          },
+          columns: [
+            { title: '时间', dataIndex: 'created_at', render: (value: string) => formatDateTime(value) },
+            {
+              title: '操作人',
+              dataIndex: 'actor_id',
+              render: (value: string, record) => (
+                <Space size={4}>
+                  <Tag>{record.actor_type === 'platform' ? '平台' : '租户'}</Tag>
+                  {record.impersonation_id ? <Tag color="orange">代管</Tag> : null}
+                  <Typography.Text copyable={{ text: value }}>{value.slice(0, 8)}</Typography.Text>
+                </Space>
+              ),
+            },
+            { title: '操作', dataIndex: 'operation' },
+            {
+              title: '资源',
+              dataIndex: 'resource_id',
+              render: (value: string, record) => `${record.resource_type || '-'}${value ? ` / ${value}` : ''}`,
+            },
+            {
+              title: '结果',
+              dataIndex: 'result',
+              render: (value: string, record) =>
+                value === 'success' ? (
+                  <Tag color="green">成功</Tag>
+                ) : (
+                  <Tag color="red">失败{record.error_reason ? ` (${record.error_reason})` : ''}</Tag>
+                ),
+            },
+            { title: '客户端 IP', dataIndex: 'client_ip', render: (value?: string) => value || '-' },
+          ],
+        }}
+      />
+    </div>
+  )
+}
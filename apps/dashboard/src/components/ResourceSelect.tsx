@@ -0,0 +1,67 @@
+import { Select } from 'antd'
+import { useMemo } from 'react'
+import { useRequest } from '../hooks/useRequest'
+import { consoleApi, type ResourceRef } from '../services/console'
+
+export const resourceTypeLabels: Record<ResourceRef['type'], string> = {
+  bot: '机器人',
+  knowledge_base: '知识库',
+  resource_group: '资源组',
+}
+
+const encodeRef = (ref: ResourceRef) => `${ref.type}:${ref.id}`
+
+const decodeRef = (value: string): ResourceRef => {
+  const index = value.indexOf(':')
+  return { type: value.slice(0, index) as ResourceRef['type'], id: value.slice(index + 1) }
+}
+
+// useResourceCatalog loads the bots, knowledge bases and (optionally) resource
+// groups that can be picked as role scopes or group members.
+export function useResourceCatalog(includeGroups: boolean) {
+  const { data: bots } = useRequest(() => consoleApi.listBots(), { items: [] })
+  const { data: kbs } = useRequest(() => consoleApi.listKnowledgeBases(), { items: [] })
+  const { data: groups } = useRequest(() => consoleApi.listResourceGroups(), { items: [] }, { enabled: includeGroups })
+
+  return useMemo(() => {
+    const names = new Map<string, string>()
+    bots.items.forEach((item) => names.set(encodeRef({ type: 'bot', id: item.id }), item.name))
+    kbs.items.forEach((item) => names.set(encodeRef({ type: 'knowledge_base', id: item.id }), item.name))
+    if (includeGroups) {
+      groups.items.forEach((item) => names.set(encodeRef({ type: 'resource_group', id: item.id }), item.name))
+    }
+    const options = (['bot', 'knowledge_base', 'resource_group'] as const)
+      .filter((type) => includeGroups || type !== 'resource_group')
+      .map((type) => ({
+        label: resourceTypeLabels[type],
+        options: [...names.entries()]
+          .filter(([key]) => key.startsWith(`${type}:`))
+          .map(([key, name]) => ({ value: key, label: name })),
+      }))
+    const describe = (ref: ResourceRef) =>
+      `${resourceTypeLabels[ref.type] ?? ref.type} · ${names.get(encodeRef(ref)) ?? ref.id}`
+    return { options, describe }
+  }, [bots.items, kbs.items, groups.items, includeGroups])
+}
+
+type ResourceSelectProps = {
+  value?: ResourceRef[]
+  onChange?: (value: ResourceRef[]) => void
+  includeGroups?: boolean
+  placeholder?: string
+}
+
+export function ResourceSelect({ value, onChange, includeGroups = false, placeholder }: ResourceSelectProps) {
+  const { options } = useResourceCatalog(includeGroups)
+  return (
+    <Select
+      mode="multiple"
+      allowClear
+      placeholder={placeholder ?? '选择资源'}
+      value={(value ?? []).map(encodeRef)}
+      onChange={(values: string[]) => onChange?.(values.map(decodeRef))}
+      options={options}
+      optionFilterProp="label"
+    />
+  )
+}
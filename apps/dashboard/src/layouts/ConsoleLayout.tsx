@@ -5,15 +5,8 @@ import { Link, Outlet, useLocation, useNavigate } from 'react-router-dom'
 import { consoleNavItems, consoleMenuKeys } from '../routes/console'
 import { buildMenuItems, resolveSelectedKey } from '../routes/utils'
 import { usePermissions } from '../auth/PermissionContext'
-import {
-  clearProfile,
-  clearScope,
-  clearTenantId,
-  clearToken,
-  getCurrentTenantId,
-  getProfile,
-  getToken,
-} from '../auth/storage'
+import { getCurrentTenantId, getProfile, getToken } from '../auth/storage'
+import { signOut } from '../services/auth'
 import { ThemeModeToggle, ThemeStatusDot } from '../components/ThemeModeToggle'
 import { useThemeMode } from '../theme/mode'
 import { RequestBanner } from '../components/RequestBanner'
@@ -69,6 +62,9 @@ export function ConsoleLayout() {
         <Header className="app-header">
           <Space align="center">
             <Tag color="blue">Console</Tag>
+            {profile?.impersonation_id ? (
+              <Tag color="orange">{profile.read_only ? '平台代管 · 只读' : '平台代管'}</Tag>
+            ) : null}
             <ThemeStatusDot />
             <Typography.Text className="muted">Tenant: {tenantId || '-'}</Typography.Text>
           </Space>
@@ -78,7 +74,11 @@ export function ConsoleLayout() {
               menu={{
                 items: [
                   { key: 'profile', label: '个人中心' },
-                  { key: 'logout', label: '退出登录', icon: <LogoutOutlined /> },
+                  {
+                    key: 'logout',
+                    label: profile?.impersonation_id ? '结束代管' : '退出登录',
+                    icon: <LogoutOutlined />,
+                  },
                 ],
                 onClick: ({ key }) => {
                   if (key === 'profile') {
@@ -86,11 +86,9 @@ export function ConsoleLayout() {
                     return
                   }
                   if (key === 'logout') {
-                    clearToken()
-                    clearTenantId()
-                    clearProfile()
-                    clearScope()
-                    navigate('/')
+                    void signOut().then((restored) =>
+                      navigate(restored && tenantId ? `/platform/tenants/${tenantId}` : '/'),
+                    )
                   }
                 },
               }}
@@ -5,7 +5,8 @@ import { platformNavItems, platformMenuKeys } from '../routes/platform'
 import { buildMenuItems, resolveSelectedKey } from '../routes/utils'
 import { usePermissions } from '../auth/PermissionContext'
 import { useMemo } from 'react'
-import { clearProfile, clearScope, clearToken, getProfile, getToken } from '../auth/storage'
+import { getProfile, getToken } from '../auth/storage'
+import { signOut } from '../services/auth'
 import { ThemeModeToggle, ThemeStatusDot } from '../components/ThemeModeToggle'
 import { useThemeMode } from '../theme/mode'
 import { RequestBanner } from '../components/RequestBanner'
@@ -61,10 +62,7 @@ export function PlatformLayout() {
                 ],
                 onClick: ({ key }) => {
                   if (key === 'logout') {
-                    clearToken()
-                    clearProfile()
-                    clearScope()
-                    navigate('/')
+                    void signOut().then(() => navigate('/'))
                   }
                 },
               }}
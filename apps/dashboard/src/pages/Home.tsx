@@ -10,15 +10,8 @@ import {
   SafetyCertificateOutlined,
   ThunderboltOutlined,
 } from '@ant-design/icons'
-import {
-  clearProfile,
-  clearScope,
-  clearTenantId,
-  clearToken,
-  getProfile,
-  getScope,
-  getToken,
-} from '../auth/storage'
+import { getProfile, getScope, getToken } from '../auth/storage'
+import { signOut } from '../services/auth'
 
 export function Home() {
   const navigate = useNavigate()
@@ -69,11 +62,7 @@ export function Home() {
   ]
 
   const handleLogout = () => {
-    clearToken()
-    clearTenantId()
-    clearProfile()
-    clearScope()
-    navigate('/')
+    void signOut().then(() => navigate('/'))
   }
 
   return (
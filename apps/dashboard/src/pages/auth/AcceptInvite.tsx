@@ -0,0 +1,109 @@
+import { Button, Form, Input, Result, Space, Typography } from 'antd'
+import { useState } from 'react'
+import { useNavigate, useSearchParams } from 'react-router-dom'
+import { AuthLayout } from '../../layouts/AuthLayout'
+import { authApi, type AuthResponse } from '../../services/auth'
+import { MFAStep } from './MFAStep'
+import { saveConsoleSession } from './utils'
+
+import { uiMessage } from '../../services/uiMessage'
+export function AcceptInvite() {
+  const navigate = useNavigate()
+  const [search] = useSearchParams()
+  const token = search.get('token')?.trim() || ''
+  const [submitting, setSubmitting] = useState(false)
+  const [mfaChallenge, setMFAChallenge] = useState<AuthResponse | null>(null)
+
+  const finishLogin = (res: AuthResponse) => {
+    saveConsoleSession(res)
+    uiMessage.success('已加入租户')
+    navigate('/console/analytics/overview', { replace: true })
+  }
+
+  const onFinish = async (values: { name?: string; password: string }) => {
+    try {
+      setSubmitting(true)
+      const res = await authApi.acceptInvite({
+        token,
+        name: values.name?.trim() || undefined,
+        password: values.password,
+      })
+      if (res.mfa_required) {
+        setMFAChallenge(res)
+        return
+      }
+      finishLogin(res)
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    } finally {
+      setSubmitting(false)
+    }
+  }
+
+  if (!token) {
+    return (
+      <AuthLayout title="接受邀请" subtitle="设置密码后加入租户">
+        <Result
+          status="warning"
+          title="邀请链接无效"
+          subTitle="链接缺少邀请凭证，请向管理员重新获取邀请"
+          extra={
+            <Button type="primary" onClick={() => navigate('/console/login', { replace: true })}>
+              返回登录
+            </Button>
+          }
+        />
+      </AuthLayout>
+    )
+  }
+
+  if (mfaChallenge) {
+    return (
+      <AuthLayout title="接受邀请" subtitle="请完成多因素认证">
+        <MFAStep scope="console" challenge={mfaChallenge} onSuccess={finishLogin} onCancel={() => navigate('/console/login')} />
+      </AuthLayout>
+    )
+  }
+
+  return (
+    <AuthLayout title="接受邀请" subtitle="设置密码后加入租户">
+      <Form layout="vertical" requiredMark style={{ marginTop: 24 }} onFinish={onFinish}>
+        <Form.Item label="姓名（可选）" name="name" extra="留空则保留管理员填写的姓名">
+          <Input placeholder="你的姓名" allowClear />
+        </Form.Item>
+        <Form.Item
+          label="密码"
+          name="password"
+          rules={[
+            { required: true, message: '请输入密码' },
+            { min: 8, message: '密码至少 8 位' },
+          ]}
+        >
+          <Input.Password placeholder="至少 8 位" autoComplete="new-password" />
+        </Form.Item>
+        <Form.Item
+          label="确认密码"
+          name="confirm"
+          dependencies={['password']}
+          rules={[
+            { required: true, message: '请再次输入密码' },
+            ({ getFieldValue }) => ({
+              validator: (_, value: string) =>
+                !value || value === getFieldValue('password')
+                  ? Promise.resolve()
+                  : Promise.reject(new Error('两次输入的密码不一致')),
+            }),
+          ]}
+        >
+          <Input.Password placeholder="再次输入密码" autoComplete="new-password" />
+        </Form.Item>
+        <Space direction="vertical" style={{ width: '100%' }}>
+          <Button type="primary" htmlType="submit" block loading={submitting}>
+            设置密码并进入控制台
+          </Button>
+          <Typography.Text className="muted">邀请链接仅可使用一次，过期后请联系管理员重新发送。</Typography.Text>
+        </Space>
+      </Form>
+    </AuthLayout>
+  )
+}
@@ -2,9 +2,10 @@ import { Button, Form, Input, Space, Typography } from 'antd'
 import { useState } from 'react'
 import { Link, useNavigate, useSearchParams } from 'react-router-dom'
 import { AuthLayout } from '../../layouts/AuthLayout'
-import { getTenantId, setProfile, setScope, setTenantId, setToken } from '../../auth/storage'
-import { authApi } from '../../services/auth'
-import { normalizeAccount, validateAccount } from './utils'
+import { getTenantId, setTenantId } from '../../auth/storage'
+import { authApi, type AuthResponse } from '../../services/auth'
+import { MFAStep } from './MFAStep'
+import { normalizeAccount, saveConsoleSession, validateAccount } from './utils'
 
 import { uiMessage } from '../../services/uiMessage'
 export function ConsoleLogin() {
@@ -13,10 +14,17 @@ export function ConsoleLogin() {
   const [search] = useSearchParams()
   const cachedTenantID = getTenantId() || ''
   const presetAccount = search.get('account')?.trim() || ''
-  const presetPassword = search.get('temp_password')?.trim() || ''
   const presetTenantID = search.get('tenant_id')?.trim() || cachedTenantID
   const [showTenantField, setShowTenantField] = useState(cachedTenantID !== '')
   const [submitting, setSubmitting] = useState(false)
+  const [ssoStarting, setSSOStarting] = useState(false)
+  const [mfaChallenge, setMFAChallenge] = useState<AuthResponse | null>(null)
+
+  const finishLogin = (res: AuthResponse) => {
+    saveConsoleSession(res)
+    uiMessage.success('登录成功')
+    navigate('/console/analytics/overview', { replace: true })
+  }
 
   const onFinish = async (values: {
     account: string
@@ -35,21 +43,11 @@ export function ConsoleLogin() {
         password: values.password,
         tenant_id: values.tenant_id?.trim() || undefined,
       })
-      setToken(res.token)
-      setScope('console')
-      setProfile({
-        subject_id: res.profile?.subject_id,
-        tenant_id: res.profile?.tenant_id,
-        name: res.profile?.name,
-        account: res.profile?.account,
-        roles: res.profile?.roles,
-        scope: 'console',
-      })
-      if (res.profile?.tenant_id) {
-        setTenantId(res.profile.tenant_id)
+      if (res.mfa_required) {
+        setMFAChallenge(res)
+        return
       }
-      uiMessage.success('登录成功')
-      navigate('/console/analytics/overview', { replace: true })
+      finishLogin(res)
     } catch (err) {
       if (err instanceof Error) {
         if (err.message.includes('multiple tenants')) {
@@ -62,6 +60,32 @@ export function ConsoleLogin() {
     }
   }
 
+  const startSSO = async () => {
+    const tenantID = String(form.getFieldValue('tenant_id') || '').trim()
+    if (!tenantID) {
+      setShowTenantField(true)
+      uiMessage.error('请先填写租户 ID 再使用 SSO 登录')
+      return
+    }
+    try {
+      setSSOStarting(true)
+      setTenantId(tenantID)
+      const res = await authApi.startSSO(tenantID)
+      window.location.assign(res.redirect_url)
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+      setSSOStarting(false)
+    }
+  }
+
+  if (mfaChallenge) {
+    return (
+      <AuthLayout title="Console 登录" subtitle="请完成多因素认证">
+        <MFAStep scope="console" challenge={mfaChallenge} onSuccess={finishLogin} onCancel={() => setMFAChallenge(null)} />
+      </AuthLayout>
+    )
+  }
+
   return (
     <AuthLayout title="Console 登录" subtitle="租户管理员登录控制台">
       <Form
@@ -72,7 +96,6 @@ export function ConsoleLogin() {
         onFinish={onFinish}
         initialValues={{
           account: presetAccount || undefined,
-          password: presetPassword || undefined,
           tenant_id: presetTenantID || undefined,
         }}
       >
@@ -108,12 +131,17 @@ export function ConsoleLogin() {
           <Button type="primary" htmlType="submit" block loading={submitting}>
             登录并进入控制台
           </Button>
+          <Button block loading={ssoStarting} onClick={() => void startSSO()}>
+            使用企业 SSO 登录
+          </Button>
           <Button type="link" onClick={() => setShowTenantField((v) => !v)} style={{ paddingInline: 0 }}>
             {showTenantField ? '收起租户 ID（高级）' : '切换租户登录（高级）'}
           </Button>
-          <Typography.Text className="muted">使用账号密码登录控制台。</Typography.Text>
+          <Typography.Text className="muted">使用账号密码登录控制台；已启用单点登录的租户请填写租户 ID 后选择 SSO 登录。</Typography.Text>
           <Typography.Text className="muted">
             还没有账号？<Link to="/console/register">创建租户</Link>
+            <span style={{ marginInline: 8 }}>·</span>
+            <Link to="/console/forgot-password">忘记密码</Link>
           </Typography.Text>
         </Space>
       </Form>
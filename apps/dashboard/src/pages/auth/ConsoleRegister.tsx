@@ -2,7 +2,7 @@ import { Button, Form, Input, Select, Space, Typography } from 'antd'
 import { useState } from 'react'
 import { Link, useNavigate } from 'react-router-dom'
 import { AuthLayout } from '../../layouts/AuthLayout'
-import { setProfile, setScope, setTenantId, setToken } from '../../auth/storage'
+import { setProfile, setRefreshToken, setScope, setTenantId, setToken } from '../../auth/storage'
 import { authApi } from '../../services/auth'
 import {
   looksLikeEmail,
@@ -41,6 +41,7 @@ export function ConsoleRegister() {
       }
       const res = await authApi.consoleRegister(payload)
       setToken(res.token)
+      setRefreshToken(res.refresh_token)
       setScope('console')
       setProfile({
         subject_id: res.profile?.subject_id,
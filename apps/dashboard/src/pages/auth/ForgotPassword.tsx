@@ -0,0 +1,86 @@
+import { Button, Form, Input, Result, Space, Typography } from 'antd'
+import { useState } from 'react'
+import { Link, useNavigate } from 'react-router-dom'
+import { AuthLayout } from '../../layouts/AuthLayout'
+import { getTenantId } from '../../auth/storage'
+import { authApi } from '../../services/auth'
+import { normalizeAccount, validateAccount } from './utils'
+
+import { uiMessage } from '../../services/uiMessage'
+export function ForgotPassword() {
+  const navigate = useNavigate()
+  const [submitting, setSubmitting] = useState(false)
+  const [sent, setSent] = useState(false)
+  const cachedTenantID = getTenantId() || ''
+
+  const onFinish = async (values: { account: string; tenant_id?: string }) => {
+    try {
+      setSubmitting(true)
+      await authApi.requestPasswordReset({
+        account: normalizeAccount(values.account),
+        tenant_id: values.tenant_id?.trim() || undefined,
+      })
+      setSent(true)
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    } finally {
+      setSubmitting(false)
+    }
+  }
+
+  if (sent) {
+    return (
+      <AuthLayout title="找回密码" subtitle="通过邮件重置控制台密码">
+        <Result
+          status="success"
+          title="请查收邮件"
+          subTitle="如果该账号存在且绑定了邮箱，我们已发送密码重置链接。链接一小时内有效且仅可使用一次。"
+          extra={
+            <Button type="primary" onClick={() => navigate('/console/login', { replace: true })}>
+              返回登录
+            </Button>
+          }
+        />
+      </AuthLayout>
+    )
+  }
+
+  return (
+    <AuthLayout title="找回密码" subtitle="通过邮件重置控制台密码">
+      <Form
+        layout="vertical"
+        requiredMark
+        style={{ marginTop: 24 }}
+        onFinish={onFinish}
+        initialValues={{ tenant_id: cachedTenantID || undefined }}
+      >
+        <Form.Item
+          label="账号（邮箱/手机号）"
+          name="account"
+          rules={[
+            { required: true, message: '请输入邮箱或手机号' },
+            {
+              validator: (_, value: string) => {
+                if (!value || validateAccount(value)) return Promise.resolve()
+                return Promise.reject(new Error('请输入合法邮箱或手机号'))
+              },
+            },
+          ]}
+        >
+          <Input placeholder="请输入邮箱或手机号" allowClear autoComplete="username" />
+        </Form.Item>
+        <Form.Item label="租户 ID（可选）" name="tenant_id" extra="仅当同一账号加入多个租户时才需要填写">
+          <Input placeholder="例如：xxxx-xxxx-xxxx" allowClear />
+        </Form.Item>
+        <Space direction="vertical" style={{ width: '100%' }}>
+          <Button type="primary" htmlType="submit" block loading={submitting}>
+            发送重置邮件
+          </Button>
+          <Typography.Text className="muted">
+            已启用企业 SSO 的租户请通过身份提供方修改密码。<Link to="/console/login">返回登录</Link>
+          </Typography.Text>
+        </Space>
+      </Form>
+    </AuthLayout>
+  )
+}
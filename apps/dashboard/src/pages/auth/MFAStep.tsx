@@ -0,0 +1,107 @@
+import { Alert, Button, Form, Input, QRCode, Space, Typography } from 'antd'
+import { useEffect, useState } from 'react'
+import { authApi, type AuthResponse, type MFAEnrollment } from '../../services/auth'
+
+import { uiMessage } from '../../services/uiMessage'
+
+type Props = {
+  scope: 'console' | 'platform'
+  challenge: AuthResponse
+  onSuccess: (res: AuthResponse) => void
+  onCancel: () => void
+}
+
+// MFAStep is the second login step: it enrolls an authenticator first when
+// policy requires one, then exchanges the challenge and a code for a session.
+export function MFAStep({ scope, challenge, onSuccess, onCancel }: Props) {
+  const [form] = Form.useForm()
+  const [submitting, setSubmitting] = useState(false)
+  const [useRecovery, setUseRecovery] = useState(false)
+  const [enrollment, setEnrollment] = useState<MFAEnrollment | null>(null)
+  const [recoveryCodes, setRecoveryCodes] = useState<string[]>([])
+  const [session, setSession] = useState<AuthResponse | null>(null)
+  const enrolling = Boolean(challenge.mfa_enrollment_required)
+
+  useEffect(() => {
+    if (!enrolling || !challenge.mfa_token) return
+    authApi
+      .enrollMFAChallenge(scope, challenge.mfa_token)
+      .then(setEnrollment)
+      .catch((err) => {
+        if (err instanceof Error) uiMessage.error(err.message)
+      })
+  }, [enrolling, scope, challenge.mfa_token])
+
+  const onFinish = async (values: { code?: string; recovery_code?: string }) => {
+    try {
+      setSubmitting(true)
+      const res = await authApi.verifyMFA(scope, {
+        mfa_token: challenge.mfa_token || '',
+        code: useRecovery ? undefined : values.code?.trim(),
+        recovery_code: useRecovery ? values.recovery_code?.trim() : undefined,
+      })
+      if (res.recovery_codes?.length) {
+        setRecoveryCodes(res.recovery_codes)
+        setSession(res)
+        return
+      }
+      onSuccess(res)
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    } finally {
+      setSubmitting(false)
+    }
+  }
+
+  if (session) {
+    return (
+      <Space direction="vertical" style={{ width: '100%', marginTop: 24 }}>
+        <Alert type="warning" showIcon title="请妥善保存以下恢复码，每个只能使用一次，关闭后不再显示。" />
+        <Typography.Paragraph copyable={{ text: recoveryCodes.join('\n') }}>
+          <pre>{recoveryCodes.join('\n')}</pre>
+        </Typography.Paragraph>
+        <Button type="primary" block onClick={() => onSuccess(session)}>
+          我已保存，继续
+        </Button>
+      </Space>
+    )
+  }
+
+  return (
+    <Form form={form} layout="vertical" requiredMark style={{ marginTop: 24 }} onFinish={onFinish}>
+      {enrolling ? (
+        <Space direction="vertical" style={{ width: '100%', marginBottom: 16 }}>
+          <Alert type="info" showIcon title="安全策略要求启用多因素认证，请使用身份验证器应用扫描二维码。" />
+          {enrollment ? (
+            <>
+              <QRCode value={enrollment.provisioning_uri} />
+              <Typography.Text copyable={{ text: enrollment.secret }}>密钥：{enrollment.secret}</Typography.Text>
+            </>
+          ) : null}
+        </Space>
+      ) : null}
+      {useRecovery ? (
+        <Form.Item label="恢复码" name="recovery_code" rules={[{ required: true, message: '请输入恢复码' }]}>
+          <Input placeholder="xxxxx-xxxxx" autoComplete="off" />
+        </Form.Item>
+      ) : (
+        <Form.Item label="验证码" name="code" rules={[{ required: true, message: '请输入 6 位验证码' }]}>
+          <Input placeholder="6 位验证码" maxLength={6} inputMode="numeric" autoComplete="one-time-code" />
+        </Form.Item>
+      )}
+      <Space direction="vertical" style={{ width: '100%' }}>
+        <Button type="primary" htmlType="submit" block loading={submitting}>
+          验证并登录
+        </Button>
+        {!enrolling ? (
+          <Button type="link" onClick={() => setUseRecovery((v) => !v)} style={{ paddingInline: 0 }}>
+            {useRecovery ? '使用验证码' : '无法使用验证器？使用恢复码'}
+          </Button>
+        ) : null}
+        <Button type="link" onClick={onCancel} style={{ paddingInline: 0 }}>
+          返回重新登录
+        </Button>
+      </Space>
+    </Form>
+  )
+}
@@ -2,8 +2,9 @@ import { Button, Form, Input, Space, Typography } from 'antd'
 import { useState } from 'react'
 import { useNavigate } from 'react-router-dom'
 import { AuthLayout } from '../../layouts/AuthLayout'
-import { setProfile, setScope, setToken } from '../../auth/storage'
-import { authApi } from '../../services/auth'
+import { setProfile, setRefreshToken, setScope, setToken } from '../../auth/storage'
+import { authApi, type AuthResponse } from '../../services/auth'
+import { MFAStep } from './MFAStep'
 import { normalizeAccount, validateAccount } from './utils'
 
 import { uiMessage } from '../../services/uiMessage'
@@ -11,6 +12,22 @@ export function PlatformLogin() {
   const [form] = Form.useForm()
   const navigate = useNavigate()
   const [submitting, setSubmitting] = useState(false)
+  const [mfaChallenge, setMFAChallenge] = useState<AuthResponse | null>(null)
+
+  const finishLogin = (res: AuthResponse) => {
+    setToken(res.token)
+    setRefreshToken(res.refresh_token)
+    setScope('platform')
+    setProfile({
+      subject_id: res.profile?.subject_id,
+      name: res.profile?.name,
+      account: res.profile?.account,
+      roles: res.profile?.roles,
+      scope: 'platform',
+    })
+    uiMessage.success('登录成功')
+    navigate('/platform/tenants', { replace: true })
+  }
 
   const onFinish = async (values: { account: string; password: string }) => {
     try {
@@ -24,17 +41,11 @@ export function PlatformLogin() {
         account,
         password: values.password,
       })
-      setToken(res.token)
-      setScope('platform')
-      setProfile({
-        subject_id: res.profile?.subject_id,
-        name: res.profile?.name,
-        account: res.profile?.account,
-        roles: res.profile?.roles,
-        scope: 'platform',
-      })
-      uiMessage.success('登录成功')
-      navigate('/platform/tenants', { replace: true })
+      if (res.mfa_required) {
+        setMFAChallenge(res)
+        return
+      }
+      finishLogin(res)
     } catch (err) {
       if (err instanceof Error) {
         uiMessage.error(err.message)
@@ -44,6 +55,14 @@ export function PlatformLogin() {
     }
   }
 
+  if (mfaChallenge) {
+    return (
+      <AuthLayout title="Platform 登录" subtitle="请完成多因素认证">
+        <MFAStep scope="platform" challenge={mfaChallenge} onSuccess={finishLogin} onCancel={() => setMFAChallenge(null)} />
+      </AuthLayout>
+    )
+  }
+
   return (
     <AuthLayout title="Platform 登录" subtitle="平台管理员登录平台管理区">
       <Form
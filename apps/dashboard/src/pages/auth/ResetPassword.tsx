@@ -0,0 +1,84 @@
+import { Button, Form, Input, Result, Space, Typography } from 'antd'
+import { useState } from 'react'
+import { Link, useNavigate, useSearchParams } from 'react-router-dom'
+import { AuthLayout } from '../../layouts/AuthLayout'
+import { authApi } from '../../services/auth'
+
+import { uiMessage } from '../../services/uiMessage'
+export function ResetPassword() {
+  const navigate = useNavigate()
+  const [search] = useSearchParams()
+  const token = search.get('token')?.trim() || ''
+  const [submitting, setSubmitting] = useState(false)
+  const [done, setDone] = useState(false)
+
+  const onFinish = async (values: { password: string }) => {
+    try {
+      setSubmitting(true)
+      await authApi.resetPassword({ token, password: values.password })
+      setDone(true)
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    } finally {
+      setSubmitting(false)
+    }
+  }
+
+  if (!token || done) {
+    return (
+      <AuthLayout title="重置密码" subtitle="设置新的控制台密码">
+        <Result
+          status={done ? 'success' : 'warning'}
+          title={done ? '密码已重置' : '重置链接无效'}
+          subTitle={done ? '所有已登录的会话均已退出，请使用新密码登录' : '链接缺少重置凭证，请重新申请找回密码'}
+          extra={
+            <Button type="primary" onClick={() => navigate(done ? '/console/login' : '/console/forgot-password', { replace: true })}>
+              {done ? '前往登录' : '重新申请'}
+            </Button>
+          }
+        />
+      </AuthLayout>
+    )
+  }
+
+  return (
+    <AuthLayout title="重置密码" subtitle="设置新的控制台密码">
+      <Form layout="vertical" requiredMark style={{ marginTop: 24 }} onFinish={onFinish}>
+        <Form.Item
+          label="新密码"
+          name="password"
+          rules={[
+            { required: true, message: '请输入新密码' },
+            { min: 8, message: '密码至少 8 位' },
+          ]}
+        >
+          <Input.Password placeholder="至少 8 位" autoComplete="new-password" />
+        </Form.Item>
+        <Form.Item
+          label="确认新密码"
+          name="confirm"
+          dependencies={['password']}
+          rules={[
+            { required: true, message: '请再次输入新密码' },
+            ({ getFieldValue }) => ({
+              validator: (_, value: string) =>
+                !value || value === getFieldValue('password')
+                  ? Promise.resolve()
+                  : Promise.reject(new Error('两次输入的密码不一致')),
+            }),
+          ]}
+        >
+          <Input.Password placeholder="再次输入新密码" autoComplete="new-password" />
+        </Form.Item>
+        <Space direction="vertical" style={{ width: '100%' }}>
+          <Button type="primary" htmlType="submit" block loading={submitting}>
+            重置密码
+          </Button>
+          <Typography.Text className="muted">
+            重置后所有设备将退出登录。<Link to="/console/login">返回登录</Link>
+          </Typography.Text>
+        </Space>
+      </Form>
+    </AuthLayout>
+  )
+}
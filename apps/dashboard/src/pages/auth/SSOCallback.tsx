@@ -0,0 +1,87 @@
+import { Button, Result, Spin } from 'antd'
+import { useCallback, useEffect, useRef, useState } from 'react'
+import { useNavigate, useSearchParams } from 'react-router-dom'
+import { AuthLayout } from '../../layouts/AuthLayout'
+import { authApi, type AuthResponse } from '../../services/auth'
+import { MFAStep } from './MFAStep'
+import { saveConsoleSession } from './utils'
+
+const ssoErrorText: Record<string, string> = {
+  SSO_STATE_INVALID: '登录请求已过期，请重新发起 SSO 登录',
+  SSO_USER_NOT_PROVISIONED: '该账号尚未加入租户，请联系管理员开通',
+  ACCOUNT_DISABLED: '账号已被停用',
+  SSO_EMAIL_UNVERIFIED: '身份提供方未验证该邮箱',
+  SSO_IDP_ERROR: '身份提供方拒绝了登录请求',
+}
+
+export function SSOCallback() {
+  const navigate = useNavigate()
+  const [search] = useSearchParams()
+  const ticket = search.get('ticket')?.trim() || ''
+  const reason = search.get('error')?.trim() || ''
+  const [error, setError] = useState(reason ? ssoErrorText[reason] || `SSO 登录失败（${reason}）` : '')
+  const [mfaChallenge, setMFAChallenge] = useState<AuthResponse | null>(null)
+  const exchanged = useRef(false)
+
+  const finishLogin = useCallback(
+    (res: AuthResponse) => {
+      saveConsoleSession(res)
+      navigate('/console/analytics/overview', { replace: true })
+    },
+    [navigate],
+  )
+
+  useEffect(() => {
+    // The ticket is single use; StrictMode must not redeem it twice.
+    if (reason || exchanged.current) return
+    exchanged.current = true
+    if (!ticket) {
+      setError('缺少登录票据，请重新发起 SSO 登录')
+      return
+    }
+    authApi
+      .exchangeSSOTicket(ticket)
+      .then((res) => {
+        if (res.mfa_required) {
+          setMFAChallenge(res)
+          return
+        }
+        finishLogin(res)
+      })
+      .catch((err: Error) => setError(err.message))
+  }, [finishLogin, reason, ticket])
+
+  if (mfaChallenge && !error) {
+    return (
+      <AuthLayout title="SSO 登录" subtitle="请完成多因素认证">
+        <MFAStep
+          scope="console"
+          challenge={mfaChallenge}
+          onSuccess={finishLogin}
+          onCancel={() => navigate('/console/login', { replace: true })}
+        />
+      </AuthLayout>
+    )
+  }
+
+  return (
+    <AuthLayout title="SSO 登录" subtitle="正在完成单点登录">
+      {error ? (
+        <Result
+          status="error"
+          title="SSO 登录失败"
+          subTitle={error}
+          extra={
+            <Button type="primary" onClick={() => navigate('/console/login', { replace: true })}>
+              返回登录
+            </Button>
+          }
+        />
+      ) : (
+        <div style={{ padding: 48, textAlign: 'center' }}>
+          <Spin size="large" />
+        </div>
+      )}
+    </AuthLayout>
+  )
+}
@@ -1,3 +1,6 @@
+import { setProfile, setRefreshToken, setScope, setTenantId, setToken } from '../../auth/storage'
+import type { AuthResponse } from '../../services/auth'
+
 export function normalizeAccount(raw: string) {
   return raw.trim()
 }
@@ -18,3 +21,20 @@ export function validateAccount(value: string) {
   const normalized = normalizeAccount(value)
   return looksLikeEmail(normalized) || looksLikePhone(normalized)
 }
+
+export function saveConsoleSession(res: AuthResponse) {
+  setToken(res.token)
+  setRefreshToken(res.refresh_token)
+  setScope('console')
+  setProfile({
+    subject_id: res.profile?.subject_id,
+    tenant_id: res.profile?.tenant_id,
+    name: res.profile?.name,
+    account: res.profile?.account,
+    roles: res.profile?.roles,
+    scope: 'console',
+  })
+  if (res.profile?.tenant_id) {
+    setTenantId(res.profile.tenant_id)
+  }
+}
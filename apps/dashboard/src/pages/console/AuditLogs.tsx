@@ -0,0 +1,5 @@
+import { AuditLogView } from '../../components/AuditLogView'
+
+export function AuditLogs() {
+  return <AuditLogView scope="console" description="成员对租户资源的变更记录，敏感字段已脱敏" />
+}
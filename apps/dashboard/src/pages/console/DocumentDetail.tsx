@@ -143,6 +143,8 @@ export function DocumentDetail() {
           columns={[
             { title: 'Version', dataIndex: 'version' },
             { title: 'Status', dataIndex: 'status' },
+            { title: 'Reused', dataIndex: 'reused_chunks' },
+            { title: 'Embedded', dataIndex: 'embedded_chunks' },
             { title: 'Created At', dataIndex: 'created_at' },
           ]}
         />
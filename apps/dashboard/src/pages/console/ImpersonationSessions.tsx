@@ -0,0 +1,53 @@
+import { Tag } from 'antd'
+import { PageHeader } from '../../components/PageHeader'
+import { TableCard } from '../../components/TableCard'
+import { DataSourceTag } from '../../components/DataSourceTag'
+import { RequestBanner } from '../../components/RequestBanner'
+import { useRequest } from '../../hooks/useRequest'
+import { auditApi, type ImpersonationSessionItem } from '../../services/audit'
+import { formatDateTime } from '../../utils/datetime'
+
+function statusTag(session: ImpersonationSessionItem) {
+  if (session.revoked_at) return <Tag>已结束</Tag>
+  if (new Date(session.expires_at).getTime() <= Date.now()) return <Tag>已过期</Tag>
+  return <Tag color="orange">进行中</Tag>
+}
+
+export function ImpersonationSessions() {
+  const { data, loading, source, error } = useRequest(
+    () => auditApi.listImpersonationSessions('console', { limit: 200 }),
+    { items: [] },
+  )
+
+  return (
+    <div className="page">
+      <PageHeader
+        title="平台代管记录"
+        description="平台支持人员进入本租户控制台的记录；代管期间的请求可在审计日志中按代管会话查看"
+        extra={<DataSourceTag source={source} />}
+      />
+      <RequestBanner error={error} />
+      <TableCard<ImpersonationSessionItem>
+        table={{
+          rowKey: 'id',
+          dataSource: data.items,
+          loading,
+          pagination: { pageSize: 20 },
+          columns: [
+            { title: '开始时间', dataIndex: 'created_at', render: (value: string) => formatDateTime(value) },
+            { title: '平台管理员', dataIndex: 'admin_name', render: (value: string, record) => value || record.admin_id },
+            { title: '原因', dataIndex: 'reason' },
+            {
+              title: '权限',
+              dataIndex: 'read_only',
+              render: (value?: boolean) => (value ? <Tag>只读</Tag> : <Tag color="red">可写</Tag>),
+            },
+            { title: '状态', key: 'status', render: (_: unknown, record) => statusTag(record) },
+            { title: '到期时间', dataIndex: 'expires_at', render: (value: string) => formatDateTime(value) },
+            { title: '结束时间', dataIndex: 'revoked_at', render: (value?: string) => formatDateTime(value) },
+          ],
+        }}
+      />
+    </div>
+  )
+}
@@ -0,0 +1,159 @@
+import { Alert, Button, Form, Input, Modal, Popconfirm, Select, Space, Tag, Tooltip, Typography } from 'antd'
+import { useState } from 'react'
+import { PageHeader } from '../../components/PageHeader'
+import { TableCard } from '../../components/TableCard'
+import { DataSourceTag } from '../../components/DataSourceTag'
+import { RequestBanner } from '../../components/RequestBanner'
+import { useRequest } from '../../hooks/useRequest'
+import { consoleApi, type LLMCredential } from '../../services/console'
+import { formatDateTime } from '../../utils/datetime'
+
+import { uiMessage } from '../../services/uiMessage'
+const providerOptions = [
+  { label: 'OpenAI', value: 'openai' },
+  { label: 'DeepSeek', value: 'deepseek' },
+  { label: 'OpenAI 兼容（http）', value: 'http' },
+]
+
+function renderTestStatus(record: LLMCredential) {
+  if (record.last_test_status === 'ok') return <Tag color="green">可用</Tag>
+  if (record.last_test_status === 'failed') {
+    return (
+      <Tooltip title={record.last_test_error}>
+        <Tag color="red">失败</Tag>
+      </Tooltip>
+    )
+  }
+  return <Tag>未测试</Tag>
+}
+
+export function LLMCredentials() {
+  const [open, setOpen] = useState(false)
+  const [testingId, setTestingId] = useState('')
+  const [form] = Form.useForm()
+  const { data, loading, source, error, reload } = useRequest(() => consoleApi.listLLMCredentials(), {
+    items: [],
+    enabled: true,
+  })
+
+  const handleCreate = async () => {
+    try {
+      const values = await form.validateFields()
+      await consoleApi.createLLMCredential({
+        provider: values.provider,
+        name: values.name?.trim() || undefined,
+        api_key: values.api_key.trim(),
+      })
+      uiMessage.success('已添加密钥')
+      setOpen(false)
+      form.resetFields()
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const handleTest = async (record: LLMCredential) => {
+    setTestingId(record.id)
+    try {
+      const result = await consoleApi.testLLMCredential(record.id)
+      if (result.ok) {
+        uiMessage.success(`测试通过（${result.latency_ms ?? 0} ms）`)
+      } else {
+        uiMessage.error(`测试失败：${result.error_message || '未知错误'}`)
+      }
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    } finally {
+      setTestingId('')
+    }
+  }
+
+  const handleDelete = async (record: LLMCredential) => {
+    try {
+      await consoleApi.deleteLLMCredential(record.id)
+      uiMessage.success('已删除密钥')
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  return (
+    <div className="page">
+      <PageHeader
+        title="模型密钥"
+        description="使用本租户自己的 OpenAI / DeepSeek 账号调用大模型与向量模型，未配置时使用平台默认密钥"
+        extra={<DataSourceTag source={source} />}
+      />
+      <RequestBanner error={error} />
+      {data.enabled === false ? (
+        <Alert type="warning" showIcon title="平台未配置主密钥，暂不支持保存租户模型密钥。" />
+      ) : null}
+      <TableCard
+        extra={
+          <Button type="primary" disabled={data.enabled === false} onClick={() => setOpen(true)}>
+            添加密钥
+          </Button>
+        }
+        table={{
+          rowKey: 'id',
+          dataSource: data.items,
+          loading,
+          pagination: false,
+          columns: [
+            {
+              title: '名称',
+              dataIndex: 'name',
+              render: (_: string, record) => (
+                <>
+                  {record.name || record.provider} <Typography.Text className="muted">{record.provider}</Typography.Text>
+                </>
+              ),
+            },
+            { title: '密钥', dataIndex: 'key_hint', render: (value?: string) => <Typography.Text code>{value}</Typography.Text> },
+            { title: '测试状态', key: 'last_test_status', render: (_: unknown, record) => renderTestStatus(record) },
+            { title: '最近测试', dataIndex: 'last_tested_at', render: (value?: string) => formatDateTime(value) },
+            { title: '添加时间', dataIndex: 'created_at', render: (value?: string) => formatDateTime(value) },
+            {
+              title: '操作',
+              key: 'actions',
+              render: (_: unknown, record) => (
+                <Space>
+                  <Button type="link" loading={testingId === record.id} onClick={() => handleTest(record)}>
+                    测试
+                  </Button>
+                  <Popconfirm title="删除后将改用平台默认密钥，确认删除？" onConfirm={() => handleDelete(record)}>
+                    <Button type="link" danger>
+                      删除
+                    </Button>
+                  </Popconfirm>
+                </Space>
+              ),
+            },
+          ],
+        }}
+      />
+
+      <Modal title="添加模型密钥" open={open} onCancel={() => setOpen(false)} onOk={handleCreate} okText="保存">
+        <Form form={form} layout="vertical" initialValues={{ provider: 'openai' }}>
+          <Form.Item label="服务商" name="provider" rules={[{ required: true }]}>
+            <Select options={providerOptions} />
+          </Form.Item>
+          <Form.Item label="名称" name="name">
+            <Input placeholder="可选" />
+          </Form.Item>
+          <Form.Item
+            label="API Key"
+            name="api_key"
+            rules={[{ required: true, whitespace: true, message: '请输入 API Key' }]}
+            extra="密钥加密保存，保存后仅显示末四位"
+          >
+            <Input.Password autoComplete="new-password" />
+          </Form.Item>
+        </Form>
+      </Modal>
+    </div>
+  )
+}
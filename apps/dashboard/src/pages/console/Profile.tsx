@@ -2,15 +2,8 @@ import { Button, Card, Descriptions, Space, Tag, Typography } from 'antd'
 import { useMemo } from 'react'
 import { useNavigate } from 'react-router-dom'
 import { PageHeader } from '../../components/PageHeader'
-import {
-  clearProfile,
-  clearScope,
-  clearTenantId,
-  clearToken,
-  getCurrentTenantId,
-  getProfile,
-  getToken,
-} from '../../auth/storage'
+import { getCurrentTenantId, getProfile, getToken } from '../../auth/storage'
+import { authApi, signOut } from '../../services/auth'
 
 import { uiMessage } from '../../services/uiMessage'
 export function Profile() {
@@ -44,16 +37,29 @@ export function Profile() {
         <Space>
           <Button
             onClick={() => {
-              clearToken()
-              clearTenantId()
-              clearProfile()
-              clearScope()
-              uiMessage.success('已退出登录')
-              navigate('/')
+              void signOut().then(() => {
+                uiMessage.success('已退出登录')
+                navigate('/')
+              })
             }}
           >
             退出登录
           </Button>
+          <Button
+            danger
+            onClick={() => {
+              void authApi
+                .logoutAll('console')
+                .catch(() => undefined)
+                .then(() => signOut())
+                .then(() => {
+                  uiMessage.success('已退出所有设备')
+                  navigate('/')
+                })
+            }}
+          >
+            退出所有设备
+          </Button>
         </Space>
       </Card>
     </div>
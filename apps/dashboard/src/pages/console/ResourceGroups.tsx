@@ -0,0 +1,232 @@
+import { Button, Descriptions, Form, Input, Modal, Popconfirm, Space, Switch, Tag, Typography } from 'antd'
+import { useMemo, useState } from 'react'
+import { PageHeader } from '../../components/PageHeader'
+import { FilterBar } from '../../components/FilterBar'
+import { TableCard } from '../../components/TableCard'
+import { DataSourceTag } from '../../components/DataSourceTag'
+import { RequestBanner } from '../../components/RequestBanner'
+import { ResourceSelect, useResourceCatalog } from '../../components/ResourceSelect'
+import { useRequest } from '../../hooks/useRequest'
+import { consoleApi, type ResourceGroup, type ResourceRef } from '../../services/console'
+import { formatDateTime } from '../../utils/datetime'
+
+import { uiMessage } from '../../services/uiMessage'
+export function ResourceGroups() {
+  const [keyword, setKeyword] = useState('')
+  const [createOpen, setCreateOpen] = useState(false)
+  const [editOpen, setEditOpen] = useState(false)
+  const [membersOpen, setMembersOpen] = useState(false)
+  const [activeGroup, setActiveGroup] = useState<ResourceGroup | null>(null)
+  const [members, setMembers] = useState<ResourceRef[]>([])
+  const [showAdvanced, setShowAdvanced] = useState(false)
+  const [form] = Form.useForm()
+  const [editForm] = Form.useForm()
+
+  const { data, loading, source, error, reload } = useRequest(() => consoleApi.listResourceGroups(), { items: [] })
+  const { describe } = useResourceCatalog(false)
+
+  const filtered = useMemo(() => {
+    if (!keyword) return data.items
+    return data.items.filter((item) => item.name.toLowerCase().includes(keyword.toLowerCase()))
+  }, [data.items, keyword])
+
+  const handleCreate = async () => {
+    try {
+      const values = await form.validateFields()
+      await consoleApi.createResourceGroup({
+        name: values.name.trim(),
+        description: values.description?.trim(),
+        members: values.members,
+      })
+      uiMessage.success('已创建资源组')
+      form.resetFields()
+      setCreateOpen(false)
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const openEdit = (group: ResourceGroup) => {
+    setActiveGroup(group)
+    editForm.setFieldsValue({ name: group.name, description: group.description })
+    setEditOpen(true)
+  }
+
+  const handleEdit = async () => {
+    if (!activeGroup) return
+    try {
+      const values = await editForm.validateFields()
+      await consoleApi.updateResourceGroup(activeGroup.id, {
+        name: values.name.trim(),
+        description: values.description?.trim(),
+      })
+      uiMessage.success('已更新资源组')
+      setEditOpen(false)
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const openMembers = (group: ResourceGroup) => {
+    setActiveGroup(group)
+    setMembers(group.members ?? [])
+    setMembersOpen(true)
+  }
+
+  const handleSetMembers = async () => {
+    if (!activeGroup) return
+    try {
+      await consoleApi.setResourceGroupMembers(activeGroup.id, members)
+      uiMessage.success('已更新资源组成员')
+      setMembersOpen(false)
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const handleDelete = async (groupId: string) => {
+    try {
+      await consoleApi.deleteResourceGroup(groupId)
+      uiMessage.success('已删除资源组')
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  return (
+    <div className="page">
+      <PageHeader
+        title="资源组"
+        description="将机器人与知识库分组，按组为成员授予限定范围的角色"
+        extra={<DataSourceTag source={source} />}
+      />
+      <RequestBanner error={error} />
+      <FilterBar
+        left={<Input.Search placeholder="按资源组名称搜索" onSearch={setKeyword} allowClear style={{ width: 220 }} />}
+        right={
+          <>
+            <Button type="primary" onClick={() => setCreateOpen(true)}>
+              新建资源组
+            </Button>
+            <Space size={6}>
+              <Typography.Text className="muted">高级列</Typography.Text>
+              <Switch checked={showAdvanced} onChange={setShowAdvanced} />
+            </Space>
+          </>
+        }
+      />
+      <TableCard
+        table={{
+          rowKey: 'id',
+          dataSource: filtered,
+          loading,
+          pagination: { pageSize: 8 },
+          expandable: showAdvanced
+            ? {
+                expandedRowRender: (record) => (
+                  <Descriptions column={2} bordered size="small">
+                    <Descriptions.Item label="资源组 ID">{record.id}</Descriptions.Item>
+                    <Descriptions.Item label="更新时间">{formatDateTime(record.updated_at)}</Descriptions.Item>
+                  </Descriptions>
+                ),
+              }
+            : undefined,
+          columns: [
+            { title: '名称', dataIndex: 'name' },
+            { title: '描述', dataIndex: 'description' },
+            {
+              title: '成员',
+              dataIndex: 'members',
+              render: (value?: ResourceRef[]) =>
+                value?.length ? (
+                  <Space size={[4, 8]} wrap>
+                    {value.map((ref) => (
+                      <Tag key={`${ref.type}:${ref.id}`}>{describe(ref)}</Tag>
+                    ))}
+                  </Space>
+                ) : (
+                  <Typography.Text className="muted">暂无成员</Typography.Text>
+                ),
+            },
+            { title: '创建时间', dataIndex: 'created_at', render: (value: string) => formatDateTime(value) },
+            {
+              title: '操作',
+              key: 'actions',
+              render: (_: unknown, record) => (
+                <Space>
+                  <Button size="small" onClick={() => openMembers(record)}>
+                    管理成员
+                  </Button>
+                  <Button size="small" onClick={() => openEdit(record)}>
+                    编辑
+                  </Button>
+                  <Popconfirm
+                    title="确认删除该资源组？"
+                    description="仍被角色授权引用的资源组无法删除"
+                    onConfirm={() => handleDelete(record.id)}
+                  >
+                    <Button size="small" danger>
+                      删除
+                    </Button>
+                  </Popconfirm>
+                </Space>
+              ),
+            },
+          ],
+        }}
+      />
+
+      <Modal
+        title="新建资源组"
+        open={createOpen}
+        onCancel={() => setCreateOpen(false)}
+        onOk={handleCreate}
+        okText="创建"
+      >
+        <Form form={form} layout="vertical">
+          <Form.Item label="名称" name="name" rules={[{ required: true, whitespace: true, message: '请输入资源组名称' }]}>
+            <Input placeholder="例如：华东区客服" />
+          </Form.Item>
+          <Form.Item label="描述" name="description">
+            <Input.TextArea rows={2} />
+          </Form.Item>
+          <Form.Item label="成员" name="members">
+            <ResourceSelect placeholder="选择机器人或知识库" />
+          </Form.Item>
+        </Form>
+      </Modal>
+
+      <Modal
+        title="编辑资源组"
+        open={editOpen}
+        onCancel={() => setEditOpen(false)}
+        onOk={handleEdit}
+        okText="保存"
+      >
+        <Form form={editForm} layout="vertical">
+          <Form.Item label="名称" name="name" rules={[{ required: true, whitespace: true, message: '请输入资源组名称' }]}>
+            <Input />
+          </Form.Item>
+          <Form.Item label="描述" name="description">
+            <Input.TextArea rows={2} />
+          </Form.Item>
+        </Form>
+      </Modal>
+
+      <Modal
+        title="管理资源组成员"
+        open={membersOpen}
+        onCancel={() => setMembersOpen(false)}
+        onOk={handleSetMembers}
+        okText="保存"
+      >
+        <Typography.Paragraph className="muted">成员变更会立即影响按该资源组授权的角色。</Typography.Paragraph>
+        <ResourceSelect value={members} onChange={setMembers} placeholder="选择机器人或知识库" />
+      </Modal>
+    </div>
+  )
+}
@@ -1,4 +1,4 @@
-import { Button, Descriptions, Form, Input, Modal, Select, Space, Switch, Typography } from 'antd'
+import { Button, Descriptions, Form, Input, Modal, Popconfirm, Select, Space, Switch, Typography } from 'antd'
 import { useMemo, useState } from 'react'
 import { PageHeader } from '../../components/PageHeader'
 import { FilterBar } from '../../components/FilterBar'
@@ -18,7 +18,9 @@ export function Roles() {
   const [permissionCodes, setPermissionCodes] = useState<string[]>([])
   const [permLoading, setPermLoading] = useState(false)
   const [showAdvanced, setShowAdvanced] = useState(false)
+  const [renameOpen, setRenameOpen] = useState(false)
   const [form] = Form.useForm()
+  const [renameForm] = Form.useForm()
 
   const { data, loading, source, error, reload } = useRequest(() => consoleApi.listRoles(), { items: [] })
   const { data: permissionData } = useRequest(() => consoleApi.listPermissions(), { items: [] })
@@ -41,6 +43,34 @@ export function Roles() {
     }
   }
 
+  const openRename = (roleId: string, name: string) => {
+    setActiveRoleId(roleId)
+    renameForm.setFieldsValue({ name })
+    setRenameOpen(true)
+  }
+
+  const handleRename = async () => {
+    try {
+      const values = await renameForm.validateFields()
+      await consoleApi.updateRole(activeRoleId, values.name.trim())
+      uiMessage.success('已重命名角色')
+      setRenameOpen(false)
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const handleDelete = async (roleId: string) => {
+    try {
+      await consoleApi.deleteRole(roleId)
+      uiMessage.success('已删除角色')
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
   const openPermissions = async (roleId: string) => {
     setActiveRoleId(roleId)
     setPermOpen(true)
@@ -110,6 +140,22 @@ export function Roles() {
                   <Button size="small" onClick={() => openPermissions(record.id)}>
                     配置权限
                   </Button>
+                  {record.name === 'tenant_admin' ? null : (
+                    <>
+                      <Button size="small" onClick={() => openRename(record.id, record.name)}>
+                        重命名
+                      </Button>
+                      <Popconfirm
+                        title="确认删除该角色？"
+                        description="仍分配给成员的角色无法删除"
+                        onConfirm={() => handleDelete(record.id)}
+                      >
+                        <Button size="small" danger>
+                          删除
+                        </Button>
+                      </Popconfirm>
+                    </>
+                  )}
                 </Space>
               ),
             },
@@ -131,6 +177,20 @@ export function Roles() {
         </Form>
       </Modal>
 
+      <Modal
+        title="重命名角色"
+        open={renameOpen}
+        onCancel={() => setRenameOpen(false)}
+        onOk={handleRename}
+        okText="保存"
+      >
+        <Form form={renameForm} layout="vertical">
+          <Form.Item label="角色名称" name="name" rules={[{ required: true, whitespace: true, message: '请输入角色名称' }]}>
+            <Input />
+          </Form.Item>
+        </Form>
+      </Modal>
+
       <Modal
         title="配置角色权限"
         open={permOpen}
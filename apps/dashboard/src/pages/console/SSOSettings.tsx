@@ -0,0 +1,185 @@
+import { Button, Card, Descriptions, Form, Input, Select, Space, Switch, Typography } from 'antd'
+import { useEffect, useState } from 'react'
+import { PageHeader } from '../../components/PageHeader'
+import { DataSourceTag } from '../../components/DataSourceTag'
+import { RequestBanner } from '../../components/RequestBanner'
+import { useRequest } from '../../hooks/useRequest'
+import { consoleApi } from '../../services/console'
+import type { SSOConfig } from '../../services/console'
+import { formatDateTime } from '../../utils/datetime'
+
+import { uiMessage } from '../../services/uiMessage'
+export function SSOSettings() {
+  const [form] = Form.useForm()
+  const [saving, setSaving] = useState(false)
+  const { data, source, error, reload } = useRequest(() => consoleApi.getSSOConfig(), {
+    config: { protocol: 'oidc' } as SSOConfig,
+  })
+  const { data: roleData } = useRequest(() => consoleApi.listRoles(), { items: [] })
+  const config = data.config
+  const protocol = Form.useWatch('protocol', form) ?? config.protocol
+
+  useEffect(() => {
+    form.setFieldsValue({
+      ...config,
+      protocol: config.protocol || 'oidc',
+      oidc_client_secret: undefined,
+      oidc_scopes: config.oidc_scopes ?? [],
+      role_mappings: config.role_mappings ?? [],
+    })
+  }, [config, form])
+
+  const roleOptions = roleData.items.map((item) => ({ label: item.name, value: item.id }))
+
+  const handleSave = async () => {
+    try {
+      await form.validateFields()
+      // Keep the settings of the protocol that is not shown.
+      const values = form.getFieldsValue(true) as SSOConfig
+      setSaving(true)
+      await consoleApi.updateSSOConfig({
+        ...values,
+        oidc_client_secret: values.oidc_client_secret?.trim() || undefined,
+      })
+      uiMessage.success('已保存 SSO 配置')
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    } finally {
+      setSaving(false)
+    }
+  }
+
+  return (
+    <div className="page">
+      <PageHeader
+        title="单点登录"
+        description="通过 OIDC 或 SAML 身份提供方登录控制台"
+        extra={<DataSourceTag source={source} />}
+      />
+      <RequestBanner error={error} />
+      <Card title="在身份提供方登记">
+        <Descriptions column={1} bordered size="middle">
+          {protocol === 'saml' ? (
+            <>
+              <Descriptions.Item label="SP Entity ID / Metadata">
+                <Typography.Text copyable>{config.saml_sp_entity_id || '-'}</Typography.Text>
+              </Descriptions.Item>
+              <Descriptions.Item label="ACS URL">
+                <Typography.Text copyable>{config.saml_acs_url || '-'}</Typography.Text>
+              </Descriptions.Item>
+            </>
+          ) : (
+            <Descriptions.Item label="Redirect URI">
+              <Typography.Text copyable>{config.oidc_redirect_uri || '-'}</Typography.Text>
+            </Descriptions.Item>
+          )}
+          <Descriptions.Item label="更新时间">{formatDateTime(config.updated_at)}</Descriptions.Item>
+        </Descriptions>
+      </Card>
+      <Card title="配置">
+        <Form form={form} layout="vertical" style={{ maxWidth: 720 }}>
+          <Form.Item label="协议" name="protocol" rules={[{ required: true }]}>
+            <Select
+              options={[
+                { label: 'OpenID Connect', value: 'oidc' },
+                { label: 'SAML 2.0', value: 'saml' },
+              ]}
+            />
+          </Form.Item>
+          <Space size={32} wrap>
+            <Form.Item label="启用" name="enabled" valuePropName="checked">
+              <Switch />
+            </Form.Item>
+            <Form.Item label="强制 SSO（禁用密码登录）" name="enforce_sso" valuePropName="checked">
+              <Switch />
+            </Form.Item>
+            <Form.Item label="首次登录自动创建成员" name="jit_provisioning" valuePropName="checked">
+              <Switch />
+            </Form.Item>
+          </Space>
+          <Form.Item label="自动创建成员的默认角色" name="default_role_id">
+            <Select allowClear options={roleOptions} placeholder="不分配" />
+          </Form.Item>
+          {protocol === 'saml' ? (
+            <>
+              <Form.Item label="IdP Entity ID" name="saml_idp_entity_id">
+                <Input placeholder="https://idp.example.com/metadata" />
+              </Form.Item>
+              <Form.Item label="IdP SSO URL" name="saml_idp_sso_url" rules={[{ required: true, message: '请输入 SSO URL' }]}>
+                <Input placeholder="https://idp.example.com/sso/saml" />
+              </Form.Item>
+              <Form.Item
+                label="IdP 签名证书"
+                name="saml_idp_certificate"
+                rules={[{ required: true, message: '请输入签名证书' }]}
+                extra="PEM 或 metadata 中的 base64 证书"
+              >
+                <Input.TextArea rows={5} placeholder="-----BEGIN CERTIFICATE-----" />
+              </Form.Item>
+            </>
+          ) : (
+            <>
+              <Form.Item label="Issuer" name="oidc_issuer" rules={[{ required: true, message: '请输入 Issuer' }]}>
+                <Input placeholder="https://login.example.com" />
+              </Form.Item>
+              <Form.Item label="Client ID" name="oidc_client_id" rules={[{ required: true, message: '请输入 Client ID' }]}>
+                <Input />
+              </Form.Item>
+              <Form.Item
+                label="Client Secret"
+                name="oidc_client_secret"
+                extra={config.oidc_client_secret_set ? '已保存，留空则保持不变' : '公共客户端可留空'}
+              >
+                <Input.Password autoComplete="new-password" />
+              </Form.Item>
+              <Form.Item label="Scopes" name="oidc_scopes" extra="默认 openid email profile">
+                <Select mode="tags" tokenSeparators={[' ', ',']} />
+              </Form.Item>
+            </>
+          )}
+          <Space size={16} wrap>
+            <Form.Item label="邮箱字段" name="email_attribute">
+              <Input placeholder="email" />
+            </Form.Item>
+            <Form.Item label="姓名字段" name="name_attribute">
+              <Input placeholder="name" />
+            </Form.Item>
+          </Space>
+          <Typography.Title level={5}>角色映射</Typography.Title>
+          <Typography.Paragraph type="secondary">
+            每次 SSO 登录时按声明/属性值同步角色；映射中未命中的角色会被移除。
+          </Typography.Paragraph>
+          <Form.List name="role_mappings">
+            {(fields, { add, remove }) => (
+              <>
+                {fields.map((field) => (
+                  <Space key={field.key} align="baseline" wrap>
+                    <Form.Item name={[field.name, 'claim']} rules={[{ required: true, message: '声明' }]}>
+                      <Input placeholder="groups" />
+                    </Form.Item>
+                    <Form.Item name={[field.name, 'value']} rules={[{ required: true, message: '值' }]}>
+                      <Input placeholder="rag-admins" />
+                    </Form.Item>
+                    <Form.Item name={[field.name, 'role_id']} rules={[{ required: true, message: '角色' }]}>
+                      <Select options={roleOptions} placeholder="角色" style={{ width: 180 }} />
+                    </Form.Item>
+                    <Button type="link" danger onClick={() => remove(field.name)}>
+                      删除
+                    </Button>
+                  </Space>
+                ))}
+                <Form.Item>
+                  <Button onClick={() => add({ claim: 'groups', value: '', role_id: '' })}>添加映射</Button>
+                </Form.Item>
+              </>
+            )}
+          </Form.List>
+          <Button type="primary" loading={saving} onClick={() => void handleSave()}>
+            保存
+          </Button>
+        </Form>
+      </Card>
+    </div>
+  )
+}
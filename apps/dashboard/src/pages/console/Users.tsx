@@ -1,4 +1,4 @@
-import { Button, Descriptions, Form, Input, Modal, Select, Space, Switch, Tag, Typography } from 'antd'
+import { Button, Descriptions, Form, Input, Modal, Popconfirm, Select, Space, Switch, Tag, Typography } from 'antd'
 import { useMemo, useState } from 'react'
 import { PageHeader } from '../../components/PageHeader'
 import { FilterBar } from '../../components/FilterBar'
@@ -6,7 +6,8 @@ import { TableCard } from '../../components/TableCard'
 import { DataSourceTag } from '../../components/DataSourceTag'
 import { RequestBanner } from '../../components/RequestBanner'
 import { useRequest } from '../../hooks/useRequest'
-import { consoleApi } from '../../services/console'
+import { consoleApi, type RoleBinding, type UserItem } from '../../services/console'
+import { ResourceSelect, useResourceCatalog } from '../../components/ResourceSelect'
 import { getCurrentTenantId } from '../../auth/storage'
 import { formatDateTime } from '../../utils/datetime'
 
@@ -35,11 +36,16 @@ export function Users() {
   const [inviteOpen, setInviteOpen] = useState(false)
   const [assignOpen, setAssignOpen] = useState(false)
   const [assigningUserId, setAssigningUserId] = useState('')
+  const [userRoles, setUserRoles] = useState<RoleBinding[]>([])
+  const [userRolesLoading, setUserRolesLoading] = useState(false)
+  const [editOpen, setEditOpen] = useState(false)
+  const [editingUser, setEditingUser] = useState<UserItem | null>(null)
   const [showAdvanced, setShowAdvanced] = useState(false)
   const [inviteLinkOpen, setInviteLinkOpen] = useState(false)
   const [inviteLink, setInviteLink] = useState('')
   const [inviteForm] = Form.useForm()
   const [assignForm] = Form.useForm()
+  const [editForm] = Form.useForm()
   const sendInvite = Form.useWatch('send_invite', inviteForm) ?? true
 
   const { data, loading, source, error, reload } = useRequest(
@@ -48,6 +54,7 @@ export function Users() {
     { enabled: Boolean(tenantId), deps: [tenantId] },
   )
   const { data: roleData } = useRequest(() => consoleApi.listRoles(), { items: [] })
+  const { describe: describeResource } = useResourceCatalog(true)
 
   const filtered = useMemo(() => {
     return data.items.filter((item) => {
@@ -74,7 +81,7 @@ export function Users() {
       const account = normalizeAccount(values.account)
       const res = await consoleApi.createUser(tenantId, {
         name: values.name,
-        status: values.status,
+        status: values.send_invite ? 'invited' : values.status,
         email: looksLikeEmail(account) ? account : undefined,
         phone: looksLikeEmail(account) ? undefined : normalizePhone(account),
         send_invite: values.send_invite,
@@ -94,13 +101,99 @@ export function Users() {
     }
   }
 
+  const loadUserRoles = async (userId: string) => {
+    setUserRolesLoading(true)
+    try {
+      const res = await consoleApi.listUserRoleBindings(userId)
+      setUserRoles(res.items)
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    } finally {
+      setUserRolesLoading(false)
+    }
+  }
+
+  const openRoles = (userId: string) => {
+    setAssigningUserId(userId)
+    setUserRoles([])
+    assignForm.resetFields()
+    setAssignOpen(true)
+    loadUserRoles(userId)
+  }
+
   const handleAssign = async () => {
     try {
       const values = await assignForm.validateFields()
-      await consoleApi.assignRole(assigningUserId, values.role_id)
+      await consoleApi.assignRole(assigningUserId, values.role_id, values.scopes)
       uiMessage.success('已分配角色')
-      setAssignOpen(false)
       assignForm.resetFields()
+      loadUserRoles(assigningUserId)
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const handleRemoveRole = async (roleId: string) => {
+    try {
+      await consoleApi.removeRole(assigningUserId, roleId)
+      uiMessage.success('已移除角色')
+      loadUserRoles(assigningUserId)
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const openEdit = (user: UserItem) => {
+    setEditingUser(user)
+    editForm.setFieldsValue({ name: user.name, email: user.email, phone: user.phone, status: user.status })
+    setEditOpen(true)
+  }
+
+  const handleEdit = async () => {
+    if (!editingUser) return
+    try {
+      const values = await editForm.validateFields()
+      await consoleApi.updateUser(editingUser.id, {
+        name: values.name?.trim(),
+        email: values.email?.trim(),
+        phone: values.phone ? normalizePhone(values.phone) : undefined,
+        status: editingUser.status === 'invited' ? undefined : values.status,
+      })
+      uiMessage.success('已更新成员')
+      setEditOpen(false)
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const handleResendInvite = async (userId: string) => {
+    try {
+      const res = await consoleApi.resendInvite(userId, window.location.origin)
+      uiMessage.success('已重新发送邀请')
+      if (res.invite_link) {
+        setInviteLink(res.invite_link)
+        setInviteLinkOpen(true)
+      }
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const handleResetPassword = async (userId: string) => {
+    try {
+      await consoleApi.resetUserPassword(userId)
+      uiMessage.success('已发送密码重置邮件')
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const handleDelete = async (userId: string) => {
+    try {
+      await consoleApi.deleteUser(userId)
+      uiMessage.success('已删除成员')
+      reload()
     } catch (err) {
       if (err instanceof Error) uiMessage.error(err.message)
     }
@@ -167,15 +260,31 @@ export function Users() {
               key: 'actions',
               render: (_: unknown, record) => (
                 <Space>
-                  <Button
-                    size="small"
-                    onClick={() => {
-                      setAssigningUserId(record.id)
-                      setAssignOpen(true)
-                    }}
-                  >
-                    分配角色
+                  <Button size="small" onClick={() => openEdit(record)}>
+                    编辑
                   </Button>
+                  <Button size="small" onClick={() => openRoles(record.id)}>
+                    角色
+                  </Button>
+                  {record.status === 'invited' ? (
+                    <Button size="small" onClick={() => handleResendInvite(record.id)}>
+                      重发邀请
+                    </Button>
+                  ) : null}
+                  {record.status === 'active' && record.email ? (
+                    <Popconfirm title="向该成员发送密码重置邮件？" onConfirm={() => handleResetPassword(record.id)}>
+                      <Button size="small">重置密码</Button>
+                    </Popconfirm>
+                  ) : null}
+                  <Popconfirm
+                    title="确认删除该成员？"
+                    description="成员的角色分配与登录会话将一并移除"
+                    onConfirm={() => handleDelete(record.id)}
+                  >
+                    <Button size="small" danger>
+                      删除
+                    </Button>
+                  </Popconfirm>
                 </Space>
               ),
             },
@@ -190,7 +299,7 @@ export function Users() {
         onOk={handleInvite}
         okText="发送邀请"
       >
-        <Form form={inviteForm} layout="vertical" initialValues={{ status: 'invited', send_invite: true }}>
+        <Form form={inviteForm} layout="vertical" initialValues={{ status: 'active', send_invite: true }}>
           <Form.Item label="姓名" name="name" rules={[{ required: true, message: '请输入姓名' }]}>
             <Input placeholder="成员姓名" />
           </Form.Item>
@@ -203,6 +312,9 @@ export function Users() {
                 validator: (_, value: string) => {
                   if (!value) return Promise.resolve()
                   const normalized = normalizeAccount(value)
+                  if (sendInvite && !looksLikeEmail(normalized)) {
+                    return Promise.reject(new Error('发送邀请需要填写邮箱'))
+                  }
                   if (looksLikeEmail(normalized) || looksLikePhone(normalized)) {
                     return Promise.resolve()
                   }
@@ -210,7 +322,7 @@ export function Users() {
                 },
               },
             ]}
-            extra="支持邮箱或手机号，手机号中的空格与 - 会自动忽略"
+            extra="发送邀请时需填写邮箱；手机号中的空格与 - 会自动忽略"
           >
             <Input placeholder="name@company.com 或 +86 13800000000" allowClear />
           </Form.Item>
@@ -218,30 +330,31 @@ export function Users() {
             <Switch />
           </Form.Item>
           {sendInvite ? (
-            <Form.Item label="邀请链接基地址" name="invite_base_url" extra="默认使用当前站点地址">
+            <Form.Item label="邀请链接基地址" name="invite_base_url" extra="默认使用当前站点地址；成员通过邮件中的链接设置密码">
               <Input placeholder="例如：http://localhost:5173" allowClear />
             </Form.Item>
           ) : (
-            <Form.Item
-              label="初始密码"
-              name="password"
-              rules={[
-                { required: true, message: '请输入初始密码' },
-                { min: 6, message: '密码至少 6 位' },
-              ]}
-            >
-              <Input.Password placeholder="至少 6 位" />
-            </Form.Item>
+            <>
+              <Form.Item
+                label="初始密码"
+                name="password"
+                rules={[
+                  { required: true, message: '请输入初始密码' },
+                  { min: 8, message: '密码至少 8 位' },
+                ]}
+              >
+                <Input.Password placeholder="至少 8 位" />
+              </Form.Item>
+              <Form.Item label="状态" name="status">
+                <Select
+                  options={[
+                    { value: 'active', label: '启用' },
+                    { value: 'disabled', label: '停用' },
+                  ]}
+                />
+              </Form.Item>
+            </>
           )}
-          <Form.Item label="状态" name="status">
-            <Select
-              options={[
-                { value: 'active', label: '启用' },
-                { value: 'invited', label: '待激活' },
-                { value: 'disabled', label: '停用' },
-              ]}
-            />
-          </Form.Item>
         </Form>
       </Modal>
 
@@ -251,7 +364,7 @@ export function Users() {
         onCancel={() => setInviteLinkOpen(false)}
         footer={<Button onClick={() => setInviteLinkOpen(false)}>关闭</Button>}
       >
-        <Typography.Text className="muted">请将该链接发送给成员完成首次登录。</Typography.Text>
+        <Typography.Text className="muted">邀请邮件已发送，也可以将该链接直接发给成员设置密码。链接仅可使用一次。</Typography.Text>
         <Input.Group compact style={{ marginTop: 12 }}>
           <Input readOnly value={inviteLink} style={{ width: 'calc(100% - 84px)' }} />
           <Button
@@ -270,22 +383,110 @@ export function Users() {
       </Modal>
 
       <Modal
-        title="分配角色"
+        title="编辑成员"
+        open={editOpen}
+        onCancel={() => setEditOpen(false)}
+        onOk={handleEdit}
+        okText="保存"
+      >
+        <Form form={editForm} layout="vertical">
+          <Form.Item label="姓名" name="name">
+            <Input placeholder="成员姓名" />
+          </Form.Item>
+          <Form.Item
+            label="邮箱"
+            name="email"
+            rules={[
+              {
+                validator: (_, value: string) =>
+                  !value || looksLikeEmail(value.trim())
+                    ? Promise.resolve()
+                    : Promise.reject(new Error('请输入合法邮箱')),
+              },
+            ]}
+          >
+            <Input placeholder="name@company.com" />
+          </Form.Item>
+          <Form.Item
+            label="手机号"
+            name="phone"
+            rules={[
+              {
+                validator: (_, value: string) =>
+                  !value || looksLikePhone(value) ? Promise.resolve() : Promise.reject(new Error('请输入合法手机号')),
+              },
+            ]}
+          >
+            <Input placeholder="+86 13800000000" />
+          </Form.Item>
+          <Form.Item
+            label="状态"
+            name="status"
+            extra={editingUser?.status === 'invited' ? '成员接受邀请后自动启用' : '停用会立即注销该成员的所有会话'}
+          >
+            <Select
+              disabled={editingUser?.status === 'invited'}
+              options={[
+                { value: 'active', label: '启用' },
+                { value: 'disabled', label: '停用' },
+                ...(editingUser?.status === 'invited' ? [{ value: 'invited', label: '待激活' }] : []),
+              ]}
+            />
+          </Form.Item>
+        </Form>
+      </Modal>
+
+      <Modal
+        title="成员角色"
         open={assignOpen}
         onCancel={() => setAssignOpen(false)}
-        onOk={handleAssign}
-        okText="保存"
+        footer={<Button onClick={() => setAssignOpen(false)}>关闭</Button>}
       >
+        <div style={{ marginBottom: 16 }}>
+          {userRolesLoading ? (
+            <Typography.Text className="muted">加载中...</Typography.Text>
+          ) : userRoles.length === 0 ? (
+            <Typography.Text className="muted">尚未分配角色</Typography.Text>
+          ) : (
+            <Space size={[4, 8]} wrap>
+              {userRoles.map(({ role, scopes }) => (
+                <Tag
+                  key={role.id}
+                  closable
+                  onClose={(event) => {
+                    event.preventDefault()
+                    handleRemoveRole(role.id)
+                  }}
+                >
+                  {role.name}
+                  {scopes?.length ? ` · ${scopes.map(describeResource).join('、')}` : ' · 全部资源'}
+                </Tag>
+              ))}
+            </Space>
+          )}
+        </div>
         <Form form={assignForm} layout="vertical">
           <Form.Item label="角色" name="role_id" rules={[{ required: true, message: '请选择角色' }]}>
             <Select
-              placeholder="选择角色"
+              placeholder="选择要分配的角色"
               options={roleData.items.map((role) => ({
                 value: role.id,
-                label: role.name,
+                label: userRoles.some((item) => item.role.id === role.id) ? `${role.name}（已分配，将替换范围）` : role.name,
               }))}
             />
           </Form.Item>
+          <Form.Item
+            label="资源范围"
+            name="scopes"
+            extra="留空表示租户内全部资源；选择后该角色的权限仅对所选机器人、知识库或资源组生效"
+          >
+            <ResourceSelect includeGroups placeholder="全部资源" />
+          </Form.Item>
+          <Form.Item>
+            <Button type="primary" onClick={handleAssign}>
+              分配
+            </Button>
+          </Form.Item>
           {roleData.items.length === 0 ? (
             <Typography.Text className="muted">暂无角色，请先前往「角色管理」创建角色。</Typography.Text>
           ) : null}
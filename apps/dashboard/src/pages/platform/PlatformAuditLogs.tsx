@@ -0,0 +1,5 @@
+import { AuditLogView } from '../../components/AuditLogView'
+
+export function PlatformAuditLogs() {
+  return <AuditLogView scope="platform" description="所有租户与平台管理员的变更记录" />
+}
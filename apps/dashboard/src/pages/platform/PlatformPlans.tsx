@@ -0,0 +1,122 @@
+import { Button, Form, Input, InputNumber, Modal, Typography } from 'antd'
+import { useState } from 'react'
+import { PageHeader } from '../../components/PageHeader'
+import { TableCard } from '../../components/TableCard'
+import { DataSourceTag } from '../../components/DataSourceTag'
+import { RequestBanner } from '../../components/RequestBanner'
+import { useRequest } from '../../hooks/useRequest'
+import { platformApi, type PlanItem, type PlanLimits } from '../../services/platform'
+import { formatDateTime } from '../../utils/datetime'
+
+import { uiMessage } from '../../services/uiMessage'
+const limitFields: { key: keyof PlanLimits; label: string }[] = [
+  { key: 'max_bots', label: '机器人数' },
+  { key: 'max_knowledge_bases', label: '知识库数' },
+  { key: 'max_documents', label: '文档数' },
+  { key: 'max_storage_bytes', label: '存储（MB）' },
+  { key: 'monthly_queries', label: '月调用次数' },
+  { key: 'monthly_tokens', label: '月 Token 数' },
+]
+
+const mb = 1024 * 1024
+
+function formatLimit(key: keyof PlanLimits, value?: number) {
+  const n = Number(value || 0)
+  if (n <= 0) return '不限'
+  if (key === 'max_storage_bytes') return `${Math.round(n / mb)} MB`
+  return n.toLocaleString()
+}
+
+export function PlatformPlans() {
+  const [editing, setEditing] = useState<PlanItem | null>(null)
+  const [form] = Form.useForm()
+  const { data, loading, source, error, reload } = useRequest(() => platformApi.listPlans(), { items: [] })
+
+  const openEdit = (plan: PlanItem) => {
+    const values: Record<string, unknown> = { name: plan.name }
+    for (const field of limitFields) {
+      const value = Number(plan.limits?.[field.key] || 0)
+      values[field.key] = field.key === 'max_storage_bytes' ? Math.round(value / mb) : value
+    }
+    form.setFieldsValue(values)
+    setEditing(plan)
+  }
+
+  const handleSave = async () => {
+    if (!editing) return
+    try {
+      const values = await form.validateFields()
+      const limits = {} as PlanLimits
+      for (const field of limitFields) {
+        const value = Number(values[field.key] || 0)
+        limits[field.key] = field.key === 'max_storage_bytes' ? value * mb : value
+      }
+      await platformApi.updatePlan(editing.code, { name: values.name, limits })
+      uiMessage.success('已更新套餐')
+      setEditing(null)
+      reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  return (
+    <div className="page">
+      <PageHeader title="套餐管理" description="套餐配额：0 表示不限" extra={<DataSourceTag source={source} />} />
+      <RequestBanner error={error} />
+      <TableCard
+        table={{
+          rowKey: 'code',
+          dataSource: data.items,
+          loading,
+          pagination: false,
+          columns: [
+            {
+              title: '套餐',
+              dataIndex: 'name',
+              render: (_: string, record) => (
+                <>
+                  {record.name || record.code} <Typography.Text className="muted">{record.code}</Typography.Text>
+                </>
+              ),
+            },
+            ...limitFields.map((field) => ({
+              title: field.label,
+              key: field.key,
+              render: (_: unknown, record: PlanItem) => formatLimit(field.key, record.limits?.[field.key]),
+            })),
+            { title: '更新时间', dataIndex: 'updated_at', render: (value?: string) => formatDateTime(value) },
+            {
+              title: '操作',
+              key: 'actions',
+              render: (_: unknown, record) => (
+                <Button type="link" onClick={() => openEdit(record)}>
+                  编辑
+                </Button>
+              ),
+            },
+          ],
+        }}
+      />
+
+      <Modal
+        title={`编辑套餐：${editing?.code ?? ''}`}
+        open={Boolean(editing)}
+        onCancel={() => setEditing(null)}
+        onOk={handleSave}
+        okText="保存"
+      >
+        <Form form={form} layout="vertical">
+          <Form.Item label="名称" name="name">
+            <Input />
+          </Form.Item>
+          {limitFields.map((field) => (
+            <Form.Item key={field.key} label={field.label} name={field.key}>
+              <InputNumber min={0} precision={0} style={{ width: '100%' }} />
+            </Form.Item>
+          ))}
+        </Form>
+      </Modal>
+    </div>
+  )
+}
@@ -1,16 +1,94 @@
-import { Alert, Card, Descriptions, Skeleton } from 'antd'
-import { useParams } from 'react-router-dom'
+import {
+  Alert,
+  Button,
+  Card,
+  Checkbox,
+  Descriptions,
+  Form,
+  Input,
+  Modal,
+  Popconfirm,
+  Select,
+  Skeleton,
+  Space,
+  Table,
+  Tag,
+  Typography,
+} from 'antd'
+import { useState } from 'react'
+import { useNavigate, useParams } from 'react-router-dom'
 import { PageHeader } from '../../components/PageHeader'
 import { TechnicalMeta } from '../../components/TechnicalMeta'
 import { RequestBanner } from '../../components/RequestBanner'
 import { useRequest } from '../../hooks/useRequest'
-import { platformApi } from '../../services/platform'
+import { platformApi, type TenantPurgeJobItem } from '../../services/platform'
+import { auditApi, type ImpersonationSessionItem } from '../../services/audit'
+import { getProfile, setProfile, setRefreshToken, setScope, setToken, stashPlatformSession } from '../../auth/storage'
 import { formatDateTime } from '../../utils/datetime'
 
+import { uiMessage } from '../../services/uiMessage'
+const statusColors: Record<string, string> = {
+  active: 'green',
+  suspended: 'red',
+  deleting: 'orange',
+}
+
+const statusLabels: Record<string, string> = {
+  active: '启用',
+  suspended: '停用',
+  deleting: '删除中',
+}
+
+const purgeStatusColors: Record<string, string> = {
+  pending: 'default',
+  running: 'processing',
+  succeeded: 'green',
+  failed: 'red',
+}
+
+const purgeStatusLabels: Record<string, string> = {
+  pending: '排队中',
+  running: '清理中',
+  succeeded: '已完成',
+  failed: '失败',
+}
+
+function sessionStatus(session: ImpersonationSessionItem) {
+  if (session.revoked_at) return <Tag>已结束</Tag>
+  if (new Date(session.expires_at).getTime() <= Date.now()) return <Tag>已过期</Tag>
+  return <Tag color="orange">进行中</Tag>
+}
+
+function sumCounts(counts?: Record<string, number>) {
+  return Object.values(counts ?? {}).reduce((total, value) => total + Number(value || 0), 0)
+}
+
+function PurgeReport({ job }: { job: TenantPurgeJobItem }) {
+  const rows = Object.entries(job.report?.rows ?? {}).sort(([a], [b]) => a.localeCompare(b))
+  const vectors = Object.entries(job.report?.vector_points ?? {})
+  return (
+    <Descriptions column={1} bordered size="small">
+      <Descriptions.Item label="MySQL 行">
+        {rows.length === 0 ? '-' : rows.map(([table, count]) => `${table}: ${count}`).join('，')}
+      </Descriptions.Item>
+      <Descriptions.Item label="对象存储文件">{job.report?.objects ?? 0}</Descriptions.Item>
+      <Descriptions.Item label="向量点">
+        {vectors.length === 0 ? '-' : vectors.map(([collection, count]) => `${collection}: ${count}`).join('，')}
+      </Descriptions.Item>
+      {job.error_message ? <Descriptions.Item label="错误">{job.error_message}</Descriptions.Item> : null}
+    </Descriptions>
+  )
+}
+
 export function TenantDetail() {
   const { id } = useParams()
+  const navigate = useNavigate()
   const tenantId = id ?? ''
-  const { data, loading, error } = useRequest(
+  const [editOpen, setEditOpen] = useState(false)
+  const [impersonateOpen, setImpersonateOpen] = useState(false)
+  const [form] = Form.useForm()
+  const [impersonateForm] = Form.useForm()
+  const tenantRequest = useRequest(
     () => platformApi.getTenant(tenantId),
     {
       tenant: {
@@ -24,34 +102,279 @@ export function TenantDetail() {
     },
     { enabled: Boolean(tenantId), deps: [tenantId] },
   )
+  const { data, loading } = tenantRequest
+  const { data: planData } = useRequest(() => platformApi.listPlans(), { items: [] })
+  const purgeRequest = useRequest(
+    () => platformApi.listTenantPurgeJobs(tenantId),
+    { items: [] },
+    { enabled: Boolean(tenantId), deps: [tenantId] },
+  )
+  const sessionRequest = useRequest(
+    () => auditApi.listImpersonationSessions('platform', { tenant_id: tenantId, limit: 50 }),
+    { items: [] },
+    { enabled: Boolean(tenantId), deps: [tenantId] },
+  )
+  const requestError = tenantRequest.error || purgeRequest.error || sessionRequest.error
+  const tenant = data.tenant
+  const deleting = tenant.status === 'deleting'
+
+  const run = async (action: () => Promise<unknown>, success: string) => {
+    try {
+      await action()
+      uiMessage.success(success)
+      tenantRequest.reload()
+      purgeRequest.reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const openEdit = () => {
+    form.setFieldsValue({ name: tenant.name, type: tenant.type, plan: tenant.plan })
+    setEditOpen(true)
+  }
+
+  const handleEdit = async () => {
+    try {
+      const values = await form.validateFields()
+      await platformApi.updateTenant(tenantId, values)
+      uiMessage.success('已更新租户')
+      setEditOpen(false)
+      tenantRequest.reload()
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const handleImpersonate = async () => {
+    try {
+      const values = await impersonateForm.validateFields()
+      const res = await platformApi.impersonateTenant(tenantId, {
+        reason: values.reason,
+        write: Boolean(values.write),
+        ttl_seconds: values.ttl_minutes * 60,
+      })
+      const admin = getProfile()
+      stashPlatformSession()
+      setToken(res.token)
+      setRefreshToken(undefined)
+      setScope('console')
+      setProfile({
+        subject_id: res.session.admin_id,
+        tenant_id: res.session.tenant_id,
+        name: `${admin?.name || admin?.account || res.session.admin_name || '平台管理员'}（代管）`,
+        account: admin?.account,
+        roles: ['tenant_admin'],
+        scope: 'console',
+        impersonation_id: res.session.id,
+        read_only: res.session.read_only,
+      })
+      setImpersonateOpen(false)
+      navigate('/console')
+    } catch (err) {
+      if (err instanceof Error) uiMessage.error(err.message)
+    }
+  }
+
+  const openImpersonate = () => {
+    impersonateForm.setFieldsValue({ reason: '', write: false, ttl_minutes: 15 })
+    setImpersonateOpen(true)
+  }
 
   return (
     <div className="page">
-      <PageHeader title="租户详情" description="查看租户概览与资源使用" />
-      <RequestBanner error={error} />
+      <PageHeader
+        title="租户详情"
+        description="查看租户概览、调整套餐与状态"
+        extra={
+          <Space>
+            <Button onClick={openImpersonate} disabled={tenant.status !== 'active'}>
+              进入控制台
+            </Button>
+            <Button onClick={openEdit} disabled={!tenant.id || deleting}>
+              编辑
+            </Button>
+            {tenant.status === 'suspended' ? (
+              <Button onClick={() => run(() => platformApi.resumeTenant(tenantId), '已恢复租户')}>恢复</Button>
+            ) : (
+              <Popconfirm
+                title="停用后该租户的成员无法登录，API Key 将被拒绝，确认停用？"
+                okText="停用"
+                cancelText="取消"
+                disabled={!tenant.id || deleting}
+                onConfirm={() => run(() => platformApi.suspendTenant(tenantId), '已停用租户')}
+              >
+                <Button disabled={!tenant.id || deleting}>停用</Button>
+              </Popconfirm>
+            )}
+            <Popconfirm
+              title="将异步删除该租户的全部数据（MySQL、对象存储、向量库），不可恢复，确认删除？"
+              okText="删除"
+              okButtonProps={{ danger: true }}
+              cancelText="取消"
+              disabled={!tenant.id}
+              onConfirm={() => run(() => platformApi.deleteTenant(tenantId), '已提交删除任务')}
+            >
+              <Button danger disabled={!tenant.id}>
+                {deleting ? '重试删除' : '删除租户'}
+              </Button>
+            </Popconfirm>
+          </Space>
+        }
+      />
+      <RequestBanner error={requestError} />
+      {deleting ? <Alert type="warning" showIcon title="该租户正在删除，数据清理完成后将从列表中移除。" /> : null}
       <Card>
         {loading ? (
           <Skeleton active paragraph={{ rows: 3 }} />
         ) : (
           <Descriptions column={1} bordered size="middle">
-            <Descriptions.Item label="名称">{data.tenant.name || '-'}</Descriptions.Item>
+            <Descriptions.Item label="名称">{tenant.name || '-'}</Descriptions.Item>
             <Descriptions.Item label="类型">
-              {data.tenant.type === 'enterprise' ? '企业' : data.tenant.type === 'personal' ? '个人' : data.tenant.type || '-'}
+              {tenant.type === 'enterprise' ? '企业' : tenant.type === 'personal' ? '个人' : tenant.type || '-'}
+            </Descriptions.Item>
+            <Descriptions.Item label="套餐">
+              {planData.items.find((item) => item.code === tenant.plan)?.name || tenant.plan || '-'}
             </Descriptions.Item>
-            <Descriptions.Item label="套餐">{data.tenant.plan || '-'}</Descriptions.Item>
             <Descriptions.Item label="状态">
-              {data.tenant.status === 'active' ? '启用' : data.tenant.status === 'suspended' ? '停用' : data.tenant.status || '-'}
+              {tenant.status ? (
+                <Tag color={statusColors[tenant.status] || 'default'}>{statusLabels[tenant.status] || tenant.status}</Tag>
+              ) : (
+                '-'
+              )}
             </Descriptions.Item>
-            <Descriptions.Item label="创建时间">{formatDateTime(data.tenant.created_at)}</Descriptions.Item>
+            <Descriptions.Item label="创建时间">{formatDateTime(tenant.created_at)}</Descriptions.Item>
           </Descriptions>
         )}
       </Card>
       <Card>
-        <TechnicalMeta items={[{ key: 'tenant-id', label: 'Tenant ID', value: data.tenant.id || tenantId }]} />
+        <TechnicalMeta items={[{ key: 'tenant-id', label: 'Tenant ID', value: tenant.id || tenantId }]} />
       </Card>
-      <Card>
-        <Alert type="info" title="租户资源统计能力建设中，当前先提供基础资料管理。" showIcon />
+      <Card title="代管记录">
+        <Table<ImpersonationSessionItem>
+          rowKey="id"
+          size="small"
+          loading={sessionRequest.loading}
+          dataSource={sessionRequest.data.items}
+          pagination={false}
+          locale={{ emptyText: '暂无代管记录' }}
+          columns={[
+            { title: '管理员', dataIndex: 'admin_name', render: (value: string, record) => value || record.admin_id },
+            { title: '原因', dataIndex: 'reason' },
+            {
+              title: '权限',
+              dataIndex: 'read_only',
+              render: (value?: boolean) => (value ? <Tag>只读</Tag> : <Tag color="red">可写</Tag>),
+            },
+            { title: '状态', key: 'status', render: (_: unknown, record) => sessionStatus(record) },
+            { title: '开始时间', dataIndex: 'created_at', render: (value: string) => formatDateTime(value) },
+            { title: '到期时间', dataIndex: 'expires_at', render: (value: string) => formatDateTime(value) },
+            {
+              title: '操作',
+              key: 'actions',
+              render: (_: unknown, record) =>
+                record.revoked_at || new Date(record.expires_at).getTime() <= Date.now() ? null : (
+                  <Popconfirm
+                    title="结束后该代管令牌立即失效，确认结束？"
+                    okText="结束"
+                    cancelText="取消"
+                    onConfirm={async () => {
+                      try {
+                        await platformApi.revokeImpersonationSession(record.id)
+                        uiMessage.success('已结束代管')
+                        sessionRequest.reload()
+                      } catch (err) {
+                        if (err instanceof Error) uiMessage.error(err.message)
+                      }
+                    }}
+                  >
+                    <Button type="link">结束</Button>
+                  </Popconfirm>
+                ),
+            },
+          ]}
+        />
       </Card>
+      <Card title="删除任务">
+        <Table<TenantPurgeJobItem>
+          rowKey="id"
+          size="small"
+          loading={purgeRequest.loading}
+          dataSource={purgeRequest.data.items}
+          pagination={false}
+          locale={{ emptyText: '暂无删除任务' }}
+          expandable={{ expandedRowRender: (record) => <PurgeReport job={record} /> }}
+          columns={[
+            {
+              title: '状态',
+              dataIndex: 'status',
+              render: (status: string) => (
+                <Tag color={purgeStatusColors[status] || 'default'}>{purgeStatusLabels[status] || status}</Tag>
+              ),
+            },
+            {
+              title: '已清理',
+              key: 'summary',
+              render: (_: unknown, record) => (
+                <Typography.Text className="muted">
+                  {sumCounts(record.report?.rows)} 行 / {record.report?.objects ?? 0} 文件 / {sumCounts(record.report?.vector_points)} 向量
+                </Typography.Text>
+              ),
+            },
+            { title: '发起人', dataIndex: 'requested_by', render: (value?: string) => value || '-' },
+            { title: '创建时间', dataIndex: 'created_at', render: (value: string) => formatDateTime(value) },
+            { title: '完成时间', dataIndex: 'finished_at', render: (value?: string) => formatDateTime(value) },
+          ]}
+        />
+      </Card>
+
+      <Modal
+        title="进入租户控制台"
+        open={impersonateOpen}
+        onCancel={() => setImpersonateOpen(false)}
+        onOk={handleImpersonate}
+        okText="进入"
+      >
+        <Typography.Paragraph className="muted">
+          将以租户管理员视角打开该租户的控制台。租户可以在控制台查看代管记录，期间的每个请求都会写入审计日志。
+        </Typography.Paragraph>
+        <Form form={impersonateForm} layout="vertical">
+          <Form.Item label="原因" name="reason" rules={[{ required: true, message: '请填写原因，如工单号' }]}>
+            <Input.TextArea rows={3} maxLength={512} placeholder="如：排查工单 #1234 中机器人回答异常" />
+          </Form.Item>
+          <Form.Item label="有效期" name="ttl_minutes">
+            <Select
+              options={[
+                { value: 15, label: '15 分钟' },
+                { value: 30, label: '30 分钟' },
+                { value: 60, label: '1 小时' },
+              ]}
+            />
+          </Form.Item>
+          <Form.Item name="write" valuePropName="checked" extra="默认只读；可写需要 platform.tenant.impersonate_write 权限">
+            <Checkbox>允许修改</Checkbox>
+          </Form.Item>
+        </Form>
+      </Modal>
+
+      <Modal title="编辑租户" open={editOpen} onCancel={() => setEditOpen(false)} onOk={handleEdit} okText="保存">
+        <Form form={form} layout="vertical">
+          <Form.Item label="名称" name="name" rules={[{ required: true, message: '请输入租户名称' }]}>
+            <Input />
+          </Form.Item>
+          <Form.Item label="类型" name="type" rules={[{ required: true, message: '请选择类型' }]}>
+            <Select
+              options={[
+                { value: 'enterprise', label: '企业' },
+                { value: 'personal', label: '个人' },
+              ]}
+            />
+          </Form.Item>
+          <Form.Item label="套餐" name="plan" rules={[{ required: true, message: '请选择套餐' }]}>
+            <Select options={planData.items.map((item) => ({ value: item.code, label: item.name || item.code }))} />
+          </Form.Item>
+        </Form>
+      </Modal>
     </div>
   )
 }
@@ -14,6 +14,13 @@ import { uiMessage } from '../../services/uiMessage'
 const statusColors: Record<string, string> = {
   active: 'green',
   suspended: 'red',
+  deleting: 'orange',
+}
+
+const statusLabels: Record<string, string> = {
+  active: '启用',
+  suspended: '停用',
+  deleting: '删除中',
 }
 
 export function Tenants() {
@@ -23,6 +30,7 @@ export function Tenants() {
   const [createOpen, setCreateOpen] = useState(false)
   const [form] = Form.useForm()
   const { data, loading, source, error, reload } = useRequest(() => platformApi.listTenants(), { items: [] })
+  const { data: planData } = useRequest(() => platformApi.listPlans(), { items: [] })
 
   const filtered = useMemo(() => {
     return data.items.filter((item) => {
@@ -112,7 +120,7 @@ export function Tenants() {
               title: '状态',
               dataIndex: 'status',
               render: (status: string) => (
-                <Tag color={statusColors[status] || 'default'}>{status === 'active' ? '启用' : '停用'}</Tag>
+                <Tag color={statusColors[status] || 'default'}>{statusLabels[status] || status}</Tag>
               ),
             },
             { title: '创建时间', dataIndex: 'created_at', render: (value: string) => formatDateTime(value) },
@@ -127,7 +135,7 @@ export function Tenants() {
         onOk={handleCreate}
         okText="创建"
       >
-        <Form form={form} layout="vertical" initialValues={{ status: 'active', type: 'enterprise' }}>
+        <Form form={form} layout="vertical" initialValues={{ status: 'active', type: 'enterprise', plan: 'free' }}>
           <Form.Item label="名称" name="name" rules={[{ required: true, message: '请输入租户名称' }]}>
             <Input placeholder="例如：示例公司" />
           </Form.Item>
@@ -139,8 +147,8 @@ export function Tenants() {
               ]}
             />
           </Form.Item>
-          <Form.Item label="套餐" name="plan" rules={[{ required: true, message: '请输入套餐' }]}>
-            <Input placeholder="例如：pro" />
+          <Form.Item label="套餐" name="plan" rules={[{ required: true, message: '请选择套餐' }]}>
+            <Select options={planData.items.map((item) => ({ value: item.code, label: item.name || item.code }))} />
           </Form.Item>
           <Form.Item label="状态" name="status" rules={[{ required: true, message: '请选择状态' }]}>
             <Select options={[{ value: 'active', label: '启用' }, { value: 'suspended', label: '停用' }]} />
@@ -10,6 +10,11 @@
   TeamOutlined,
   SafetyOutlined,
   LockOutlined,
+  LoginOutlined,
+  AuditOutlined,
+  ClusterOutlined,
+  EyeOutlined,
+  ApiOutlined,
 } from '@ant-design/icons'
 import { AnalyticsOverview } from '../pages/console/AnalyticsOverview'
 import { AnalyticsLatency } from '../pages/console/AnalyticsLatency'
@@ -18,6 +23,7 @@ import { AnalyticsKBGaps } from '../pages/console/AnalyticsKBGaps'
 import { Users } from '../pages/console/Users'
 import { Roles } from '../pages/console/Roles'
 import { Permissions } from '../pages/console/Permissions'
+import { ResourceGroups } from '../pages/console/ResourceGroups'
 import { Bots } from '../pages/console/Bots'
 import { BotDetail } from '../pages/console/BotDetail'
 import { KnowledgeBases } from '../pages/console/KnowledgeBases'
@@ -31,6 +37,10 @@ import { ApiUsageSummary } from '../pages/console/ApiUsageSummary'
 import { Sessions } from '../pages/console/Sessions'
 import { SessionDetail } from '../pages/console/SessionDetail'
 import { Profile } from '../pages/console/Profile'
+import { SSOSettings } from '../pages/console/SSOSettings'
+import { AuditLogs } from '../pages/console/AuditLogs'
+import { ImpersonationSessions } from '../pages/console/ImpersonationSessions'
+import { LLMCredentials } from '../pages/console/LLMCredentials'
 import type { AppRoute, NavItem } from './types'
 import { permissions } from '../auth/permissions'
 
@@ -52,12 +62,22 @@ export const consoleNavItems: NavItem[] = [
   { key: '/console/users', icon: <TeamOutlined />, label: '成员管理', permission: permissions.tenant.userRead },
   { key: '/console/roles', icon: <SafetyOutlined />, label: '角色管理', permission: permissions.tenant.roleRead },
   { key: '/console/permissions', icon: <LockOutlined />, label: '权限目录', permission: permissions.tenant.permissionRead },
+  { key: '/console/resource-groups', icon: <ClusterOutlined />, label: '资源组', permission: permissions.tenant.resourceGroupRead },
+  { key: '/console/sso', icon: <LoginOutlined />, label: '单点登录', permission: permissions.tenant.ssoRead },
   { key: '/console/bots', icon: <RobotOutlined />, label: '机器人', permission: permissions.tenant.botRead },
   { key: '/console/knowledge-bases', icon: <DatabaseOutlined />, label: '知识库', permission: permissions.tenant.knowledgeRead },
   { key: '/console/documents', icon: <FileTextOutlined />, label: '文档管理', permission: permissions.tenant.documentRead },
   { key: '/console/api-keys', icon: <KeyOutlined />, label: '接口密钥', permission: permissions.tenant.apiKeyRead },
   { key: '/console/api-usage', icon: <HistoryOutlined />, label: '调用日志', permission: permissions.tenant.apiUsageRead },
+  { key: '/console/llm-credentials', icon: <ApiOutlined />, label: '模型密钥', permission: permissions.tenant.llmCredentialRead },
   { key: '/console/sessions', icon: <MessageOutlined />, label: '会话管理', permission: permissions.tenant.chatSessionRead },
+  { key: '/console/audit-logs', icon: <AuditOutlined />, label: '审计日志', permission: permissions.tenant.auditLogRead },
+  {
+    key: '/console/impersonation-sessions',
+    icon: <EyeOutlined />,
+    label: '平台代管记录',
+    permission: permissions.tenant.auditLogRead,
+  },
   { key: '/console/profile', icon: <SettingOutlined />, label: '个人中心' },
 ]
 
@@ -69,12 +89,17 @@ export const consoleMenuKeys = [
   '/console/users',
   '/console/roles',
   '/console/permissions',
+  '/console/resource-groups',
+  '/console/sso',
   '/console/bots',
   '/console/knowledge-bases',
   '/console/documents',
   '/console/api-keys',
   '/console/api-usage',
+  '/console/llm-credentials',
   '/console/sessions',
+  '/console/audit-logs',
+  '/console/impersonation-sessions',
   '/console/profile',
 ]
 
@@ -86,6 +111,8 @@ export const consoleRoutes: AppRoute[] = [
   { path: 'users', element: <Users />, permission: permissions.tenant.userRead },
   { path: 'roles', element: <Roles />, permission: permissions.tenant.roleRead },
   { path: 'permissions', element: <Permissions />, permission: permissions.tenant.permissionRead },
+  { path: 'resource-groups', element: <ResourceGroups />, permission: permissions.tenant.resourceGroupRead },
+  { path: 'sso', element: <SSOSettings />, permission: permissions.tenant.ssoRead },
   { path: 'bots', element: <Bots />, permission: permissions.tenant.botRead },
   { path: 'bots/:id', element: <BotDetail />, permission: permissions.tenant.botRead },
   { path: 'knowledge-bases', element: <KnowledgeBases />, permission: permissions.tenant.knowledgeRead },
@@ -96,7 +123,10 @@ export const consoleRoutes: AppRoute[] = [
   { path: 'api-keys/:id', element: <ApiKeyDetail />, permission: permissions.tenant.apiKeyRead },
   { path: 'api-usage', element: <ApiUsage />, permission: permissions.tenant.apiUsageRead },
   { path: 'api-usage/summary', element: <ApiUsageSummary />, permission: permissions.tenant.apiUsageRead },
+  { path: 'llm-credentials', element: <LLMCredentials />, permission: permissions.tenant.llmCredentialRead },
   { path: 'sessions', element: <Sessions />, permission: permissions.tenant.chatSessionRead },
   { path: 'sessions/:id', element: <SessionDetail />, permission: permissions.tenant.chatSessionRead },
+  { path: 'audit-logs', element: <AuditLogs />, permission: permissions.tenant.auditLogRead },
+  { path: 'impersonation-sessions', element: <ImpersonationSessions />, permission: permissions.tenant.auditLogRead },
   { path: 'profile', element: <Profile /> },
 ]
@@ -1,11 +1,13 @@
-﻿import { ApartmentOutlined, SafetyOutlined, TeamOutlined, LockOutlined } from '@ant-design/icons'
+﻿import { ApartmentOutlined, AuditOutlined, SafetyOutlined, TeamOutlined, LockOutlined, ProfileOutlined } from '@ant-design/icons'
 import { Tenants } from '../pages/platform/Tenants'
 import { TenantDetail } from '../pages/platform/TenantDetail'
+import { PlatformPlans } from '../pages/platform/PlatformPlans'
 import { PlatformAdmins } from '../pages/platform/PlatformAdmins'
 import { PlatformAdminDetail } from '../pages/platform/PlatformAdminDetail'
 import { PlatformRoles } from '../pages/platform/PlatformRoles'
 import { PlatformRoleDetail } from '../pages/platform/PlatformRoleDetail'
 import { PlatformPermissions } from '../pages/platform/PlatformPermissions'
+import { PlatformAuditLogs } from '../pages/platform/PlatformAuditLogs'
 import type { AppRoute, NavItem } from './types'
 import { permissions } from '../auth/permissions'
 
@@ -18,19 +20,23 @@ export const platformNavItems: NavItem[] = [
     label: '租户管理',
     permission: permissions.platform.tenantRead,
   },
+  { key: '/platform/plans', icon: <ProfileOutlined />, label: '套餐管理', permission: permissions.platform.tenantRead },
   { key: '/platform/admins', icon: <TeamOutlined />, label: '平台管理员', permission: permissions.platform.adminRead },
   { key: '/platform/roles', icon: <SafetyOutlined />, label: '平台角色', permission: permissions.platform.roleRead },
   { key: '/platform/permissions', icon: <LockOutlined />, label: '权限目录', permission: permissions.platform.permissionRead },
+  { key: '/platform/audit-logs', icon: <AuditOutlined />, label: '审计日志', permission: permissions.platform.auditLogRead },
 ]
 
-export const platformMenuKeys = ['/platform/tenants', '/platform/admins', '/platform/roles', '/platform/permissions']
+export const platformMenuKeys = ['/platform/tenants', '/platform/plans', '/platform/admins', '/platform/roles', '/platform/permissions', '/platform/audit-logs']
 
 export const platformRoutes: AppRoute[] = [
   { path: 'tenants', element: <Tenants />, permission: permissions.platform.tenantRead },
   { path: 'tenants/:id', element: <TenantDetail />, permission: permissions.platform.tenantRead },
+  { path: 'plans', element: <PlatformPlans />, permission: permissions.platform.tenantRead },
   { path: 'admins', element: <PlatformAdmins />, permission: permissions.platform.adminRead },
   { path: 'admins/:id', element: <PlatformAdminDetail />, permission: permissions.platform.adminRead },
   { path: 'roles', element: <PlatformRoles />, permission: permissions.platform.roleRead },
   { path: 'roles/:id', element: <PlatformRoleDetail />, permission: permissions.platform.roleRead },
   { path: 'permissions', element: <PlatformPermissions />, permission: permissions.platform.permissionRead },
+  { path: 'audit-logs', element: <PlatformAuditLogs />, permission: permissions.platform.auditLogRead },
 ]
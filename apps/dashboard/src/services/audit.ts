@@ -0,0 +1,88 @@
+import { request } from './client'
+
+export type AuditScope = 'console' | 'platform'
+
+export type AuditLogItem = {
+  id: string
+  tenant_id?: string
+  actor_id: string
+  actor_type: string
+  operation: string
+  resource_type?: string
+  resource_id?: string
+  request_summary?: string
+  result: string
+  error_reason?: string
+  status_code: number
+  client_ip?: string
+  user_agent?: string
+  impersonation_id?: string
+  created_at: string
+}
+
+export type ImpersonationSessionItem = {
+  id: string
+  tenant_id: string
+  admin_id: string
+  admin_name?: string
+  reason: string
+  read_only?: boolean
+  expires_at: string
+  revoked_at?: string
+  created_at: string
+}
+
+export type AuditLogQuery = {
+  tenant_id?: string
+  actor_id?: string
+  operation?: string
+  resource_type?: string
+  resource_id?: string
+  result?: string
+  impersonation_id?: string
+  start_time?: string
+  end_time?: string
+  limit?: number
+  offset?: number
+}
+
+export type AuditExportResult = {
+  content?: string
+  content_type?: string
+  filename?: string
+}
+
+function buildQuery(params?: AuditLogQuery) {
+  const query = new URLSearchParams()
+  if (params?.tenant_id) query.set('tenant_id', params.tenant_id)
+  if (params?.actor_id) query.set('actor_id', params.actor_id)
+  if (params?.operation) query.set('operation', params.operation)
+  if (params?.resource_type) query.set('resource_type', params.resource_type)
+  if (params?.resource_id) query.set('resource_id', params.resource_id)
+  if (params?.result) query.set('result', params.result)
+  if (params?.impersonation_id) query.set('impersonation_id', params.impersonation_id)
+  if (params?.start_time) query.set('start_time', params.start_time)
+  if (params?.end_time) query.set('end_time', params.end_time)
+  if (params?.limit) query.set('limit', String(params.limit))
+  if (params?.offset) query.set('offset', String(params.offset))
+  return query.toString() ? `?${query.toString()}` : ''
+}
+
+export const auditApi = {
+  listAuditLogs(scope: AuditScope, params?: AuditLogQuery) {
+    return request<{ items: AuditLogItem[] }>(`/${scope}/v1/audit_logs${buildQuery(params)}`).then((res) => ({
+      items: res.items ?? [],
+    }))
+  },
+  exportAuditLogs(scope: AuditScope, payload: Omit<AuditLogQuery, 'offset'> & { format?: string }) {
+    return request<AuditExportResult>(`/${scope}/v1/audit_logs/export`, {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    })
+  },
+  listImpersonationSessions(scope: AuditScope, params?: { tenant_id?: string; limit?: number; offset?: number }) {
+    return request<{ items: ImpersonationSessionItem[] }>(`/${scope}/v1/impersonation_sessions${buildQuery(params)}`).then(
+      (res) => ({ items: res.items ?? [] }),
+    )
+  },
+}
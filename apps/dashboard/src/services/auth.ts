@@ -1,3 +1,13 @@
+import {
+  clearProfile,
+  clearScope,
+  clearTenantId,
+  clearToken,
+  getProfile,
+  getScope,
+  getToken,
+  restorePlatformSession,
+} from '../auth/storage'
 import { request } from './client'
 
 export type AuthProfile = {
@@ -11,7 +21,19 @@ export type AuthProfile = {
 export type AuthResponse = {
   token: string
   expires_at?: string
+  refresh_token?: string
+  refresh_expires_at?: string
   profile: AuthProfile
+  mfa_required?: boolean
+  mfa_token?: string
+  mfa_expires_at?: string
+  mfa_enrollment_required?: boolean
+  recovery_codes?: string[]
+}
+
+export type MFAEnrollment = {
+  secret: string
+  provisioning_uri: string
 }
 
 export const authApi = {
@@ -40,4 +62,70 @@ export const authApi = {
       body: JSON.stringify(payload),
     })
   },
+  startSSO(tenantId: string) {
+    const query = new URLSearchParams({ tenant_id: tenantId })
+    return request<{ redirect_url: string; protocol: string }>(`/console/v1/sso/start?${query.toString()}`)
+  },
+  exchangeSSOTicket(ticket: string) {
+    return request<AuthResponse>('/console/v1/sso/token', {
+      method: 'POST',
+      body: JSON.stringify({ ticket }),
+    })
+  },
+  verifyMFA(scope: 'console' | 'platform', payload: { mfa_token: string; code?: string; recovery_code?: string }) {
+    return request<AuthResponse>(`/${scope}/v1/login/mfa`, {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    })
+  },
+  enrollMFAChallenge(scope: 'console' | 'platform', mfaToken: string) {
+    return request<MFAEnrollment>(`/${scope}/v1/login/mfa/enroll`, {
+      method: 'POST',
+      body: JSON.stringify({ mfa_token: mfaToken }),
+    })
+  },
+  acceptInvite(payload: { token: string; name?: string; password: string }) {
+    return request<AuthResponse>('/console/v1/invite/accept', {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    })
+  },
+  requestPasswordReset(payload: { account: string; tenant_id?: string }) {
+    return request<void>('/console/v1/password/forgot', {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    })
+  },
+  resetPassword(payload: { token: string; password: string }) {
+    return request<void>('/console/v1/password/reset', {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    })
+  },
+  logout(scope: 'console' | 'platform') {
+    return request<void>(`/${scope}/v1/logout`, { method: 'POST', body: '{}' })
+  },
+  logoutAll(scope: 'console' | 'platform') {
+    return request<void>(`/${scope}/v1/logout_all`, { method: 'POST', body: '{}' })
+  },
+}
+
+// signOut revokes the session on the server, then clears the local session
+// even if the server call fails. Ending an impersonation returns to the
+// platform session it was started from; the result tells whether it did.
+export async function signOut() {
+  const scope = getScope()
+  const impersonating = Boolean(getProfile()?.impersonation_id)
+  if (scope && getToken()) {
+    try {
+      await authApi.logout(scope)
+    } catch (_) {
+      // the token may already be expired or revoked
+    }
+  }
+  clearToken()
+  clearTenantId()
+  clearProfile()
+  clearScope()
+  return impersonating && restorePlatformSession()
 }
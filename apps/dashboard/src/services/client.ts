@@ -12,11 +12,45 @@
   }
 }
 
-import { getToken } from '../auth/storage'
+import { getRefreshToken, getScope, getToken, setRefreshToken, setToken } from '../auth/storage'
 
 const API_BASE = (import.meta.env.VITE_API_BASE_URL as string) || ''
 
-export async function request<T>(path: string, init?: RequestInit): Promise<T> {
+let refreshing: Promise<boolean> | null = null
+
+// refreshSession exchanges the stored refresh token for a new token pair.
+// Concurrent 401s share one refresh since every refresh token works once.
+function refreshSession(): Promise<boolean> {
+  const scope = getScope()
+  const refreshToken = getRefreshToken()
+  if (!scope || !refreshToken) return Promise.resolve(false)
+  if (!refreshing) {
+    refreshing = fetch(`${API_BASE}/${scope}/v1/token/refresh`, {
+      method: 'POST',
+      credentials: 'include',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ refresh_token: refreshToken }),
+    })
+      .then(async (response) => {
+        if (!response.ok) return false
+        let payload: any = await response.json()
+        if (payload && typeof payload === 'object' && 'code' in payload && 'data' in payload) {
+          payload = payload.data
+        }
+        if (!payload?.token) return false
+        setToken(payload.token)
+        setRefreshToken(payload.refresh_token)
+        return true
+      })
+      .catch(() => false)
+      .finally(() => {
+        refreshing = null
+      })
+  }
+  return refreshing
+}
+
+export async function request<T>(path: string, init?: RequestInit, retried = false): Promise<T> {
   const token = getToken()
   const rawHeaders =
     init?.headers instanceof Headers ? Object.fromEntries(init.headers.entries()) : init?.headers || {}
@@ -42,6 +76,9 @@ export async function request<T>(path: string, init?: RequestInit): Promise<T> {
   }
 
   if (!response.ok) {
+    if (response.status === 401 && token && !retried && (await refreshSession())) {
+      return request<T>(path, init, true)
+    }
     const message = payload?.message || response.statusText || 'Request failed'
     throw new ApiError(message, { status: response.status, code: payload?.code, requestId: payload?.request_id })
   }
@@ -58,3 +95,24 @@ export async function request<T>(path: string, init?: RequestInit): Promise<T> {
 
   return payload as T
 }
+
+// requestBlob fetches a binary response such as an archive download.
+export async function requestBlob(path: string, init?: RequestInit): Promise<Blob> {
+  const token = getToken()
+  const response = await fetch(`${API_BASE}${path}`, {
+    ...init,
+    credentials: 'include',
+    headers: token ? { Authorization: `Bearer ${token}` } : {},
+  })
+  if (!response.ok) {
+    let payload: any = null
+    try {
+      payload = await response.json()
+    } catch (_) {
+      // ignore
+    }
+    const message = payload?.message || response.statusText || 'Request failed'
+    throw new ApiError(message, { status: response.status, code: payload?.code, requestId: payload?.request_id })
+  }
+  return response.blob()
+}
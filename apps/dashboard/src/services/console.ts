@@ -1,15 +1,75 @@
-import { request } from './client'
+import { request, requestBlob } from './client'
 import type { ListParams } from './types'
 
+export type ChunkingConfig = {
+  strategy?: 'token' | 'markdown' | 'sentence_window' | 'semantic'
+  max_tokens?: number
+  overlap_tokens?: number
+  window_sentences?: number
+  overlap_sentences?: number
+  similarity_threshold?: number
+}
+
+export type EmbeddingConfig = {
+  provider?: string
+  model?: string
+  dim?: number
+}
+
+export type DedupConfig = {
+  mode?: 'flag' | 'skip' | 'off'
+  max_distance?: number
+  similarity_threshold?: number
+}
+
 export type KnowledgeBase = {
   id: string
   name: string
   description: string
+  chunking?: ChunkingConfig
+  embedding?: EmbeddingConfig
+  dedup?: DedupConfig
+  index_generation?: number
   document_count?: number
   created_at: string
   updated_at?: string
 }
 
+export type KnowledgeBaseMigration = {
+  id: string
+  kb_id: string
+  status: 'pending' | 'running' | 'switching' | 'collecting' | 'cancelling' | 'completed' | 'failed' | 'cancelled'
+  generation: number
+  chunking?: ChunkingConfig
+  embedding?: EmbeddingConfig
+  total_documents?: number
+  processed_documents?: number
+  failed_documents?: number
+  error_message?: string
+  created_at: string
+  updated_at?: string
+  finished_at?: string
+}
+
+export type IngestionJob = {
+  id: string
+  kb_id: string
+  document_id: string
+  document_version_id: string
+  status: 'queued' | 'running' | 'retrying' | 'succeeded' | 'failed' | 'dead' | 'cancelled'
+  current_step?: 'load' | 'chunk' | 'embed' | 'index' | ''
+  attempts?: number
+  chunk_count?: number
+  reused_chunks?: number
+  embedded_chunks?: number
+  step_durations?: Record<string, number>
+  error_message?: string
+  created_at: string
+  updated_at?: string
+  started_at?: string
+  finished_at?: string
+}
+
 export type DocumentItem = {
   id: string
   kb_id?: string
@@ -17,17 +77,163 @@ export type DocumentItem = {
   source_type: string
   status: string
   current_version: number
+  tags?: string[]
+  section_prefix?: string
+  access_labels?: string[]
   updated_at: string
   created_at?: string
 }
 
+export type ImportBatchItem = {
+  path: string
+  source_type?: string
+  document_id?: string
+  status: 'created' | 'failed' | 'skipped'
+  document_status?: string
+  error_message?: string
+}
+
+export type ImportBatch = {
+  id: string
+  kb_id: string
+  folder_mode: 'none' | 'tags' | 'section'
+  source: 'zip' | 'files' | 'kb_archive'
+  status: 'processing' | 'completed' | 'partial' | 'failed'
+  total_files: number
+  skipped_files?: number
+  failed_files?: number
+  documents_processing?: number
+  documents_ready?: number
+  documents_failed?: number
+  items?: ImportBatchItem[]
+  created_at: string
+}
+
+export type KnowledgeBaseImportResult = {
+  knowledge_base: KnowledgeBase
+  batch: ImportBatch
+  restored_documents: number
+  reembedded_documents: number
+}
+
 export type DocumentVersion = {
   id: string
   version: number
   status: string
+  reused_chunks?: number
+  embedded_chunks?: number
+  skipped_chunks?: number
   created_at: string
 }
 
+export type DiffChunk = {
+  id: string
+  chunk_index: number
+  content: string
+  content_hash: string
+  section?: string
+  page_no?: number
+  token_count?: number
+  citations?: number
+}
+
+export type ChunkCitation = {
+  chunk_id: string
+  chunk_index: number
+  content_hash?: string
+  citations: number
+  last_cited_at?: string
+  snippet?: string
+}
+
+export type DocumentVersionDiffSide = {
+  version: DocumentVersion
+  chunks_rebuilt?: boolean
+  citations?: ChunkCitation[]
+}
+
+export type DocumentVersionDiff = {
+  document_id: string
+  from: DocumentVersionDiffSide
+  to: DocumentVersionDiffSide
+  added?: DiffChunk[]
+  removed?: DiffChunk[]
+  modified?: { from: DiffChunk; to: DiffChunk }[]
+  unchanged_chunks?: number
+  text_diff?: string
+  text_diff_truncated?: boolean
+}
+
+export type ChunkItem = {
+  id: string
+  document_id: string
+  document_version_id: string
+  document_title?: string
+  chunk_index: number
+  content: string
+  content_hash: string
+  section?: string
+  page_no?: number
+  token_count?: number
+  language?: string
+  excluded?: boolean
+  keywords?: string[]
+  origin_hash?: string
+  citations?: number
+}
+
+export type UpdateChunkInput = {
+  content?: string
+  excluded?: boolean
+  keywords?: string[]
+}
+
+export type ChunkState = {
+  content: string
+  content_hash: string
+  excluded?: boolean
+  keywords?: string[]
+}
+
+export type ChunkEditItem = {
+  id: string
+  document_id: string
+  document_version_id: string
+  chunk_id: string
+  origin_hash?: string
+  actor_id?: string
+  before: ChunkState
+  after: ChunkState
+  created_at: string
+}
+
+export type DuplicateChunk = {
+  chunk_id: string
+  document_id: string
+  document_title?: string
+  content_preview?: string
+  method?: 'exact' | 'simhash' | 'embedding' | ''
+  skipped?: boolean
+  original_missing?: boolean
+}
+
+export type DuplicateDocumentPair = {
+  document_id: string
+  document_title?: string
+  duplicate_document_id: string
+  duplicate_document_title?: string
+  duplicate_chunks: number
+  total_chunks: number
+}
+
+export type DuplicateReport = {
+  kb_id: string
+  links?: number
+  skipped_chunks?: number
+  chunk_clusters?: { chunks: DuplicateChunk[] }[]
+  document_clusters?: { pairs: DuplicateDocumentPair[] }[]
+}
+
 export type ApiKeyItem = {
   id: string
   bot_id: string
@@ -35,6 +241,7 @@ export type ApiKeyItem = {
   status: string
   public_chat_id?: string
   public_chat_enabled?: boolean
+  entitlement_secret_set?: boolean
   scopes: string[]
   api_versions: string[]
   quota_daily: number
@@ -134,6 +341,80 @@ export type RoleItem = {
   created_at: string
 }
 
+export type ResourceRef = {
+  type: 'bot' | 'knowledge_base' | 'resource_group'
+  id: string
+}
+
+export type RoleBinding = {
+  role: RoleItem
+  scopes?: ResourceRef[]
+}
+
+export type ResourceGroup = {
+  id: string
+  name: string
+  description?: string
+  members?: ResourceRef[]
+  created_at: string
+  updated_at?: string
+}
+
+export type ResourceGroupInput = {
+  name: string
+  description?: string
+  members?: ResourceRef[]
+}
+
+export type SSORoleMapping = {
+  claim: string
+  value: string
+  role_id: string
+}
+
+export type SSOConfig = {
+  protocol: 'oidc' | 'saml'
+  enabled?: boolean
+  enforce_sso?: boolean
+  jit_provisioning?: boolean
+  default_role_id?: string
+  oidc_issuer?: string
+  oidc_client_id?: string
+  oidc_client_secret?: string
+  oidc_client_secret_set?: boolean
+  oidc_scopes?: string[]
+  saml_idp_entity_id?: string
+  saml_idp_sso_url?: string
+  saml_idp_certificate?: string
+  email_attribute?: string
+  name_attribute?: string
+  role_mappings?: SSORoleMapping[]
+  oidc_redirect_uri?: string
+  saml_sp_entity_id?: string
+  saml_acs_url?: string
+  updated_at?: string
+}
+
+export type LLMCredential = {
+  id: string
+  provider: string
+  name?: string
+  key_hint?: string
+  last_tested_at?: string
+  last_test_status?: string
+  last_test_error?: string
+  created_by?: string
+  created_at?: string
+  updated_at?: string
+}
+
+export type LLMCredentialTestResult = {
+  ok?: boolean
+  error_message?: string
+  latency_ms?: number
+  credential?: LLMCredential
+}
+
 export type PermissionItem = {
   code: string
   scope: string
@@ -143,6 +424,9 @@ export type PermissionItem = {
 export type CreateKnowledgeBaseInput = {
   name: string
   description: string
+  chunking?: ChunkingConfig
+  embedding?: EmbeddingConfig
+  dedup?: DedupConfig
 }
 
 export type UploadDocumentInput = {
@@ -182,6 +466,13 @@ export type CreateUserInput = {
   invite_base_url?: string
 }
 
+export type UpdateUserInput = {
+  name?: string
+  email?: string
+  phone?: string
+  status?: string
+}
+
 export type CreateRoleInput = {
   name: string
 }
@@ -190,6 +481,7 @@ type RawApiKeyItem = Partial<ApiKeyItem> & {
   botId?: string
   publicChatId?: string
   publicChatEnabled?: boolean
+  entitlementSecretSet?: boolean
   apiVersions?: string[]
   quotaDaily?: number
   qpsLimit?: number
@@ -202,6 +494,8 @@ type RawApiKeyEnvelope = {
   apiKey?: RawApiKeyItem
   raw_key?: string
   rawKey?: string
+  entitlement_secret?: string
+  entitlementSecret?: string
 }
 
 export const consoleApi = {
@@ -231,6 +525,7 @@ export const consoleApi = {
       status: input.status ?? '',
       public_chat_id: chatID,
       public_chat_enabled: enabledRaw ?? Boolean(chatID),
+      entitlement_secret_set: input.entitlement_secret_set ?? input.entitlementSecretSet ?? false,
       scopes: input.scopes ?? [],
       api_versions: input.api_versions ?? input.apiVersions ?? [],
       quota_daily: input.quota_daily ?? input.quotaDaily ?? 0,
@@ -258,6 +553,9 @@ export const consoleApi = {
       source_type: input.source_type ?? input.sourceType ?? '',
       status: input.status ?? '',
       current_version: input.current_version ?? input.currentVersion ?? 0,
+      tags: input.tags ?? [],
+      section_prefix: input.section_prefix ?? input.sectionPrefix ?? '',
+      access_labels: input.access_labels ?? input.accessLabels ?? [],
       updated_at: input.updated_at ?? input.updatedAt ?? '',
       created_at: input.created_at ?? input.createdAt ?? '',
     }
@@ -270,6 +568,9 @@ export const consoleApi = {
       id: input.id ?? '',
       version: input.version ?? 0,
       status: input.status ?? '',
+      reused_chunks: input.reused_chunks ?? input.reusedChunks ?? 0,
+      embedded_chunks: input.embedded_chunks ?? input.embeddedChunks ?? 0,
+      skipped_chunks: input.skipped_chunks ?? input.skippedChunks ?? 0,
       created_at: input.created_at ?? input.createdAt ?? '',
     }
   },
@@ -304,6 +605,51 @@ export const consoleApi = {
       method: 'DELETE',
     })
   },
+  startKnowledgeBaseMigration(kbId: string, payload: { chunking?: ChunkingConfig; embedding?: EmbeddingConfig } = {}) {
+    return request<{ migration: KnowledgeBaseMigration }>(`/console/v1/knowledge_bases/${kbId}/migrations`, {
+      method: 'POST',
+      body: JSON.stringify({ kb_id: kbId, ...payload }),
+    })
+  },
+  listKnowledgeBaseMigrations(kbId: string) {
+    return request<{ items: KnowledgeBaseMigration[] }>(`/console/v1/knowledge_bases/${kbId}/migrations`)
+  },
+  getKnowledgeBaseMigration(kbId: string, id: string) {
+    return request<{ migration: KnowledgeBaseMigration }>(`/console/v1/knowledge_bases/${kbId}/migrations/${id}`)
+  },
+  cancelKnowledgeBaseMigration(kbId: string, id: string) {
+    return request<{ migration: KnowledgeBaseMigration }>(`/console/v1/knowledge_bases/${kbId}/migrations/${id}/cancel`, {
+      method: 'POST',
+      body: JSON.stringify({ kb_id: kbId, id }),
+    })
+  },
+  getDuplicateReport(kbId: string, limit?: number) {
+    const suffix = limit ? `?limit=${limit}` : ''
+    return request<{ report: DuplicateReport }>(`/console/v1/knowledge_bases/${kbId}/duplicates${suffix}`)
+  },
+  listIngestionJobs(kbId: string, params?: ListParams & { status?: string }) {
+    const query = new URLSearchParams()
+    if (params?.status) query.set('status', params.status)
+    if (params?.limit) query.set('limit', String(params.limit))
+    if (params?.offset) query.set('offset', String(params.offset))
+    const suffix = query.toString() ? `?${query.toString()}` : ''
+    return request<{ items: IngestionJob[] }>(`/console/v1/knowledge_bases/${kbId}/ingestion_jobs${suffix}`)
+  },
+  getIngestionJob(id: string) {
+    return request<{ job: IngestionJob }>(`/console/v1/ingestion_jobs/${id}`)
+  },
+  retryIngestionJob(id: string) {
+    return request<{ job: IngestionJob }>(`/console/v1/ingestion_jobs/${id}/retry`, {
+      method: 'POST',
+      body: JSON.stringify({ id }),
+    })
+  },
+  cancelIngestionJob(id: string) {
+    return request<{ job: IngestionJob }>(`/console/v1/ingestion_jobs/${id}/cancel`, {
+      method: 'POST',
+      body: JSON.stringify({ id }),
+    })
+  },
   listDocuments(params?: ListParams & { kb_id?: string }) {
     const query = new URLSearchParams()
     if (params?.kb_id) query.set('kb_id', params.kb_id)
@@ -340,6 +686,26 @@ export const consoleApi = {
       })),
     }))
   },
+  // FormData fields: kb_id, folder_mode (none | tags | section), files (ZIP archives or documents)
+  importDocuments(payload: FormData) {
+    return request<{ batch: ImportBatch }>('/console/v1/documents/import', {
+      method: 'POST',
+      body: payload,
+    })
+  },
+  exportKnowledgeBase(id: string, includeVectors = true) {
+    return requestBlob(`/console/v1/knowledge_bases/${id}/export?include_vectors=${includeVectors}`)
+  },
+  // FormData fields: file (knowledge base archive), name (optional), reembed (true | false)
+  importKnowledgeBase(payload: FormData) {
+    return request<KnowledgeBaseImportResult>('/console/v1/knowledge_bases/import', {
+      method: 'POST',
+      body: payload,
+    })
+  },
+  getImportBatch(id: string) {
+    return request<{ batch: ImportBatch }>(`/console/v1/import_batches/${id}`)
+  },
   getDocument(id: string) {
     return request<{ document: DocumentItem; versions: DocumentVersion[] }>(
       `/console/v1/documents/${id}`,
@@ -356,6 +722,12 @@ export const consoleApi = {
       body: JSON.stringify(body),
     })
   },
+  setDocumentAccessLabels(id: string, accessLabels: string[]) {
+    return request<{ document: DocumentItem }>(`/console/v1/documents/${id}/access_labels`, {
+      method: 'PUT',
+      body: JSON.stringify({ id, access_labels: accessLabels }),
+    }).then((res) => ({ document: consoleApi.normalizeDocument(res.document) }))
+  },
   deleteDocument(id: string) {
     return request<void>(`/console/v1/documents/${id}`, {
       method: 'DELETE',
@@ -373,6 +745,43 @@ export const consoleApi = {
       body: JSON.stringify({ id, version }),
     })
   },
+  diffDocumentVersions(id: string, fromVersion: number, toVersion?: number) {
+    const query = new URLSearchParams({ from_version: String(fromVersion) })
+    if (toVersion) query.set('to_version', String(toVersion))
+    return request<{ diff: DocumentVersionDiff }>(`/console/v1/documents/${id}/diff?${query.toString()}`)
+  },
+  listDocumentChunks(id: string, params?: ListParams & { version?: number }) {
+    const query = new URLSearchParams()
+    if (params?.version) query.set('version', String(params.version))
+    if (params?.limit) query.set('limit', String(params.limit))
+    if (params?.offset) query.set('offset', String(params.offset))
+    const suffix = query.toString() ? `?${query.toString()}` : ''
+    return request<{ items: ChunkItem[]; total?: number }>(`/console/v1/documents/${id}/chunks${suffix}`)
+  },
+  searchChunks(kbId: string, q: string, params?: ListParams) {
+    const query = new URLSearchParams({ query: q })
+    if (params?.limit) query.set('limit', String(params.limit))
+    if (params?.offset) query.set('offset', String(params.offset))
+    return request<{ items: ChunkItem[] }>(`/console/v1/knowledge_bases/${kbId}/chunks/search?${query.toString()}`)
+  },
+  updateChunk(id: string, payload: UpdateChunkInput) {
+    const body: Record<string, unknown> = { id }
+    if (payload.content !== undefined) body.content = payload.content
+    if (payload.excluded !== undefined) body.excluded = payload.excluded
+    if (payload.keywords !== undefined) body.keywords = { values: payload.keywords }
+
+    return request<{ chunk: ChunkItem }>(`/console/v1/chunks/${id}`, {
+      method: 'PATCH',
+      body: JSON.stringify(body),
+    })
+  },
+  listChunkEdits(id: string, params?: ListParams) {
+    const query = new URLSearchParams()
+    if (params?.limit) query.set('limit', String(params.limit))
+    if (params?.offset) query.set('offset', String(params.offset))
+    const suffix = query.toString() ? `?${query.toString()}` : ''
+    return request<{ items: ChunkEditItem[] }>(`/console/v1/documents/${id}/chunk_edits${suffix}`)
+  },
   listBots() {
     return request<{ items: BotItem[] }>('/console/v1/bots')
   },
@@ -473,6 +882,15 @@ export const consoleApi = {
       api_key: consoleApi.normalizeApiKey(res.api_key ?? res.apiKey),
     }))
   },
+  regenerateApiKeyEntitlementSecret(id: string) {
+    return request<RawApiKeyEnvelope>(`/console/v1/api_keys/${id}/entitlement_secret/regenerate`, {
+      method: 'POST',
+      body: JSON.stringify({ id }),
+    }).then((res) => ({
+      api_key: consoleApi.normalizeApiKey(res.api_key ?? res.apiKey),
+      entitlement_secret: res.entitlement_secret ?? res.entitlementSecret ?? '',
+    }))
+  },
   getUsageSummary(params?: {
     bot_id?: string
     api_key_id?: string
@@ -558,6 +976,27 @@ export const consoleApi = {
     const suffix = query.toString() ? `?${query.toString()}` : ''
     return request<{ items: UserItem[] }>(`/console/v1/tenants/${tenantId}/users${suffix}`)
   },
+  updateUser(userId: string, payload: UpdateUserInput) {
+    return request<{ user: UserItem }>(`/console/v1/users/${userId}`, {
+      method: 'PATCH',
+      body: JSON.stringify({ user_id: userId, ...payload }),
+    })
+  },
+  deleteUser(userId: string) {
+    return request<void>(`/console/v1/users/${userId}`, { method: 'DELETE' })
+  },
+  resendInvite(userId: string, inviteBaseUrl?: string) {
+    return request<{ user: UserItem; invite_link?: string }>(`/console/v1/users/${userId}/invite`, {
+      method: 'POST',
+      body: JSON.stringify({ user_id: userId, invite_base_url: inviteBaseUrl }),
+    })
+  },
+  resetUserPassword(userId: string) {
+    return request<void>(`/console/v1/users/${userId}/password_reset`, {
+      method: 'POST',
+      body: JSON.stringify({ user_id: userId }),
+    })
+  },
   createRole(payload: CreateRoleInput) {
     return request<{ role: RoleItem }>('/console/v1/roles', {
       method: 'POST',
@@ -571,12 +1010,30 @@ export const consoleApi = {
     const suffix = query.toString() ? `?${query.toString()}` : ''
     return request<{ items: RoleItem[] }>(`/console/v1/roles${suffix}`)
   },
-  assignRole(userId: string, roleId: string) {
+  assignRole(userId: string, roleId: string, scopes?: ResourceRef[]) {
     return request<void>(`/console/v1/users/${userId}/roles`, {
       method: 'POST',
-      body: JSON.stringify({ user_id: userId, role_id: roleId }),
+      body: JSON.stringify({ user_id: userId, role_id: roleId, scopes }),
     })
   },
+  listUserRoles(userId: string) {
+    return request<{ items: RoleItem[] }>(`/console/v1/users/${userId}/roles`)
+  },
+  listUserRoleBindings(userId: string) {
+    return request<{ items: RoleBinding[] }>(`/console/v1/users/${userId}/role_bindings`)
+  },
+  removeRole(userId: string, roleId: string) {
+    return request<void>(`/console/v1/users/${userId}/roles/${roleId}`, { method: 'DELETE' })
+  },
+  updateRole(roleId: string, name: string) {
+    return request<{ role: RoleItem }>(`/console/v1/roles/${roleId}`, {
+      method: 'PATCH',
+      body: JSON.stringify({ role_id: roleId, name }),
+    })
+  },
+  deleteRole(roleId: string) {
+    return request<void>(`/console/v1/roles/${roleId}`, { method: 'DELETE' })
+  },
   listPermissions() {
     return request<{ items: PermissionItem[] }>('/console/v1/permissions')
   },
@@ -589,4 +1046,60 @@ export const consoleApi = {
   listRolePermissions(roleId: string) {
     return request<{ items: PermissionItem[] }>(`/console/v1/roles/${roleId}/permissions`)
   },
+  listResourceGroups() {
+    return request<{ items: ResourceGroup[] }>('/console/v1/resource_groups')
+  },
+  getResourceGroup(groupId: string) {
+    return request<{ group: ResourceGroup }>(`/console/v1/resource_groups/${groupId}`)
+  },
+  createResourceGroup(payload: ResourceGroupInput) {
+    return request<{ group: ResourceGroup }>('/console/v1/resource_groups', {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    })
+  },
+  updateResourceGroup(groupId: string, payload: { name?: string; description?: string }) {
+    return request<{ group: ResourceGroup }>(`/console/v1/resource_groups/${groupId}`, {
+      method: 'PATCH',
+      body: JSON.stringify({ group_id: groupId, ...payload }),
+    })
+  },
+  setResourceGroupMembers(groupId: string, members: ResourceRef[]) {
+    return request<{ group: ResourceGroup }>(`/console/v1/resource_groups/${groupId}/members`, {
+      method: 'PUT',
+      body: JSON.stringify({ group_id: groupId, members }),
+    })
+  },
+  deleteResourceGroup(groupId: string) {
+    return request<void>(`/console/v1/resource_groups/${groupId}`, { method: 'DELETE' })
+  },
+  getSSOConfig() {
+    return request<{ config: SSOConfig }>('/console/v1/sso/config')
+  },
+  updateSSOConfig(config: SSOConfig) {
+    return request<{ config: SSOConfig }>('/console/v1/sso/config', {
+      method: 'PUT',
+      body: JSON.stringify({ config }),
+    })
+  },
+  listLLMCredentials() {
+    return request<{ items: LLMCredential[]; enabled?: boolean }>('/console/v1/llm_credentials')
+  },
+  createLLMCredential(payload: { provider: string; name?: string; api_key: string }) {
+    return request<{ credential: LLMCredential }>('/console/v1/llm_credentials', {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    })
+  },
+  testLLMCredential(id: string) {
+    return request<LLMCredentialTestResult>(`/console/v1/llm_credentials/${id}/test`, {
+      method: 'POST',
+      body: JSON.stringify({}),
+    })
+  },
+  deleteLLMCredential(id: string) {
+    return request<void>(`/console/v1/llm_credentials/${id}`, {
+      method: 'DELETE',
+    })
+  },
 }
@@ -1,4 +1,6 @@
 import { request } from './client'
+import type { KnowledgeBaseImportResult } from './console'
+import type { ImpersonationSessionItem } from './audit'
 import type { ListParams } from './types'
 
 export type TenantItem = {
@@ -10,6 +12,42 @@ export type TenantItem = {
   created_at: string
 }
 
+export type PlanLimits = {
+  max_bots: number
+  max_knowledge_bases: number
+  max_documents: number
+  max_storage_bytes: number
+  monthly_queries: number
+  monthly_tokens: number
+}
+
+export type PlanItem = {
+  code: string
+  name: string
+  limits?: Partial<PlanLimits>
+  updated_at?: string
+}
+
+export type TenantPurgeReport = {
+  rows?: Record<string, number>
+  objects?: number
+  collections?: string[]
+  vector_points?: Record<string, number>
+}
+
+export type TenantPurgeJobItem = {
+  id: string
+  tenant_id: string
+  tenant_name: string
+  status: string
+  requested_by?: string
+  report?: TenantPurgeReport
+  error_message?: string
+  created_at: string
+  started_at?: string
+  finished_at?: string
+}
+
 export type PlatformAdminItem = {
   id: string
   name: string
@@ -39,6 +77,17 @@ export type CreateTenantInput = {
   status: string
 }
 
+export type UpdateTenantInput = {
+  name?: string
+  type?: string
+  plan?: string
+}
+
+export type UpdatePlanInput = {
+  name?: string
+  limits: PlanLimits
+}
+
 export type CreatePlatformAdminInput = {
   name: string
   email?: string
@@ -53,6 +102,14 @@ export type CreatePlatformRoleInput = {
   name: string
 }
 
+export type CloneKnowledgeBaseInput = {
+  source_tenant_id: string
+  source_kb_id: string
+  target_tenant_id: string
+  name?: string
+  reembed?: boolean
+}
+
 export type CreatePermissionInput = {
   code: string
   description: string
@@ -76,6 +133,60 @@ export const platformApi = {
   getTenant(id: string) {
     return request<{ tenant: TenantItem }>(`/platform/v1/tenants/${id}`)
   },
+  updateTenant(id: string, payload: UpdateTenantInput) {
+    return request<{ tenant: TenantItem }>(`/platform/v1/tenants/${id}`, {
+      method: 'PATCH',
+      body: JSON.stringify({ id, ...payload }),
+    })
+  },
+  suspendTenant(id: string) {
+    return request<{ tenant: TenantItem }>(`/platform/v1/tenants/${id}/suspend`, {
+      method: 'POST',
+      body: JSON.stringify({ id }),
+    })
+  },
+  resumeTenant(id: string) {
+    return request<{ tenant: TenantItem }>(`/platform/v1/tenants/${id}/resume`, {
+      method: 'POST',
+      body: JSON.stringify({ id }),
+    })
+  },
+  deleteTenant(id: string) {
+    return request<{ job: TenantPurgeJobItem }>(`/platform/v1/tenants/${id}`, {
+      method: 'DELETE',
+    })
+  },
+  listTenantPurgeJobs(tenantId?: string) {
+    const suffix = tenantId ? `?tenant_id=${encodeURIComponent(tenantId)}` : ''
+    return request<{ items: TenantPurgeJobItem[] }>(`/platform/v1/tenant_purge_jobs${suffix}`)
+  },
+  impersonateTenant(tenantId: string, payload: { reason: string; write?: boolean; ttl_seconds?: number }) {
+    return request<{ token: string; expires_at?: string; session: ImpersonationSessionItem }>(
+      `/platform/v1/tenants/${tenantId}/impersonate`,
+      {
+        method: 'POST',
+        body: JSON.stringify({ tenant_id: tenantId, ...payload }),
+      },
+    )
+  },
+  revokeImpersonationSession(id: string) {
+    return request<{ session: ImpersonationSessionItem }>(`/platform/v1/impersonation_sessions/${id}/revoke`, {
+      method: 'POST',
+      body: JSON.stringify({ id }),
+    })
+  },
+  getTenantPurgeJob(id: string) {
+    return request<{ job: TenantPurgeJobItem }>(`/platform/v1/tenant_purge_jobs/${id}`)
+  },
+  listPlans() {
+    return request<{ items: PlanItem[] }>('/platform/v1/plans')
+  },
+  updatePlan(code: string, payload: UpdatePlanInput) {
+    return request<{ plan: PlanItem }>(`/platform/v1/plans/${code}`, {
+      method: 'PUT',
+      body: JSON.stringify({ code, ...payload }),
+    })
+  },
   listAdmins(params?: ListParams) {
     const query = new URLSearchParams()
     if (params?.limit) query.set('limit', String(params.limit))
@@ -145,4 +256,10 @@ export const platformApi = {
       body: JSON.stringify(payload),
     })
   },
+  cloneKnowledgeBase(payload: CloneKnowledgeBaseInput) {
+    return request<KnowledgeBaseImportResult>('/platform/v1/knowledge_bases/clone', {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    })
+  },
 }
@@ -40,8 +40,10 @@ type APIKey struct {
 	LastUsedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 	PublicChatId      string                 `protobuf:"bytes,12,opt,name=public_chat_id,json=publicChatId,proto3" json:"public_chat_id,omitempty"`
 	PublicChatEnabled bool                   `protobuf:"varint,13,opt,name=public_chat_enabled,json=publicChatEnabled,proto3" json:"public_chat_enabled,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Whether sessions of this key accept end-user entitlement tokens.
+	EntitlementSecretSet bool `protobuf:"varint,14,opt,name=entitlement_secret_set,json=entitlementSecretSet,proto3" json:"entitlement_secret_set,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *APIKey) Reset() {
@@ -165,6 +167,13 @@ func (x *APIKey) GetPublicChatEnabled() bool {
 	return false
 }
 
+func (x *APIKey) GetEntitlementSecretSet() bool {
+	if x != nil {
+		return x.EntitlementSecretSet
+	}
+	return false
+}
+
 type UsageLog struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1105,6 +1114,103 @@ func (x *RegeneratePublicChatIDResponse) GetApiKey() *APIKey {
 	return nil
 }
 
+type RegenerateEntitlementSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateEntitlementSecretRequest) Reset() {
+	*x = RegenerateEntitlementSecretRequest{}
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateEntitlementSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateEntitlementSecretRequest) ProtoMessage() {}
+
+func (x *RegenerateEntitlementSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateEntitlementSecretRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateEntitlementSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RegenerateEntitlementSecretRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RegenerateEntitlementSecretResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// HS256 secret for signing entitlement tokens; shown only once.
+	EntitlementSecret string `protobuf:"bytes,2,opt,name=entitlement_secret,json=entitlementSecret,proto3" json:"entitlement_secret,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RegenerateEntitlementSecretResponse) Reset() {
+	*x = RegenerateEntitlementSecretResponse{}
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateEntitlementSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateEntitlementSecretResponse) ProtoMessage() {}
+
+func (x *RegenerateEntitlementSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateEntitlementSecretResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateEntitlementSecretResponse) Descriptor() ([]byte, []int) {
+	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RegenerateEntitlementSecretResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *RegenerateEntitlementSecretResponse) GetEntitlementSecret() string {
+	if x != nil {
+		return x.EntitlementSecret
+	}
+	return ""
+}
+
 type ListUsageLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
@@ -1121,7 +1227,7 @@ type ListUsageLogsRequest struct {
 
 func (x *ListUsageLogsRequest) Reset() {
 	*x = ListUsageLogsRequest{}
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[16]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1133,7 +1239,7 @@ func (x *ListUsageLogsRequest) String() string {
 func (*ListUsageLogsRequest) ProtoMessage() {}
 
 func (x *ListUsageLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[16]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1146,7 +1252,7 @@ func (x *ListUsageLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsageLogsRequest.ProtoReflect.Descriptor instead.
 func (*ListUsageLogsRequest) Descriptor() ([]byte, []int) {
-	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{16}
+	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListUsageLogsRequest) GetApiKeyId() string {
@@ -1214,7 +1320,7 @@ type ListUsageLogsResponse struct {
 
 func (x *ListUsageLogsResponse) Reset() {
 	*x = ListUsageLogsResponse{}
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[17]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1226,7 +1332,7 @@ func (x *ListUsageLogsResponse) String() string {
 func (*ListUsageLogsResponse) ProtoMessage() {}
 
 func (x *ListUsageLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[17]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,7 +1345,7 @@ func (x *ListUsageLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsageLogsResponse.ProtoReflect.Descriptor instead.
 func (*ListUsageLogsResponse) Descriptor() ([]byte, []int) {
-	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{17}
+	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListUsageLogsResponse) GetItems() []*UsageLog {
@@ -1263,7 +1369,7 @@ type GetUsageSummaryRequest struct {
 
 func (x *GetUsageSummaryRequest) Reset() {
 	*x = GetUsageSummaryRequest{}
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[18]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1275,7 +1381,7 @@ func (x *GetUsageSummaryRequest) String() string {
 func (*GetUsageSummaryRequest) ProtoMessage() {}
 
 func (x *GetUsageSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[18]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,7 +1394,7 @@ func (x *GetUsageSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsageSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetUsageSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{18}
+	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetUsageSummaryRequest) GetApiKeyId() string {
@@ -1342,7 +1448,7 @@ type GetUsageSummaryResponse struct {
 
 func (x *GetUsageSummaryResponse) Reset() {
 	*x = GetUsageSummaryResponse{}
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[19]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1354,7 +1460,7 @@ func (x *GetUsageSummaryResponse) String() string {
 func (*GetUsageSummaryResponse) ProtoMessage() {}
 
 func (x *GetUsageSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[19]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1367,7 +1473,7 @@ func (x *GetUsageSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsageSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetUsageSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{19}
+	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetUsageSummaryResponse) GetSummary() *UsageSummary {
@@ -1394,7 +1500,7 @@ type ExportUsageLogsRequest struct {
 
 func (x *ExportUsageLogsRequest) Reset() {
 	*x = ExportUsageLogsRequest{}
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[20]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1406,7 +1512,7 @@ func (x *ExportUsageLogsRequest) String() string {
 func (*ExportUsageLogsRequest) ProtoMessage() {}
 
 func (x *ExportUsageLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[20]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,7 +1525,7 @@ func (x *ExportUsageLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportUsageLogsRequest.ProtoReflect.Descriptor instead.
 func (*ExportUsageLogsRequest) Descriptor() ([]byte, []int) {
-	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{20}
+	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ExportUsageLogsRequest) GetApiKeyId() string {
@@ -1498,7 +1604,7 @@ type ExportUsageLogsResponse struct {
 
 func (x *ExportUsageLogsResponse) Reset() {
 	*x = ExportUsageLogsResponse{}
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[21]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1510,7 +1616,7 @@ func (x *ExportUsageLogsResponse) String() string {
 func (*ExportUsageLogsResponse) ProtoMessage() {}
 
 func (x *ExportUsageLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[21]
+	mi := &file_api_apimgmt_v1_console_apimgmt_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,7 +1629,7 @@ func (x *ExportUsageLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportUsageLogsResponse.ProtoReflect.Descriptor instead.
 func (*ExportUsageLogsResponse) Descriptor() ([]byte, []int) {
-	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{21}
+	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ExportUsageLogsResponse) GetContent() string {
@@ -1565,7 +1671,7 @@ var File_api_apimgmt_v1_console_apimgmt_proto protoreflect.FileDescriptor
 
 const file_api_apimgmt_v1_console_apimgmt_proto_rawDesc = "" +
 	"\n" +
-	"$api/apimgmt/v1/console_apimgmt.proto\x12\x0eapi.apimgmt.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xc0\x03\n" +
+	"$api/apimgmt/v1/console_apimgmt.proto\x12\x0eapi.apimgmt.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xf6\x03\n" +
 	"\x06APIKey\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
@@ -1583,7 +1689,8 @@ const file_api_apimgmt_v1_console_apimgmt_proto_rawDesc = "" +
 	"\flast_used_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"lastUsedAt\x12$\n" +
 	"\x0epublic_chat_id\x18\f \x01(\tR\fpublicChatId\x12.\n" +
-	"\x13public_chat_enabled\x18\r \x01(\bR\x11publicChatEnabled\"\xc6\x03\n" +
+	"\x13public_chat_enabled\x18\r \x01(\bR\x11publicChatEnabled\x124\n" +
+	"\x16entitlement_secret_set\x18\x0e \x01(\bR\x14entitlementSecretSet\"\xc6\x03\n" +
 	"\bUsageLog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\n" +
@@ -1658,7 +1765,12 @@ const file_api_apimgmt_v1_console_apimgmt_proto_rawDesc = "" +
 	"\x1dRegeneratePublicChatIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
 	"\x1eRegeneratePublicChatIDResponse\x12/\n" +
-	"\aapi_key\x18\x01 \x01(\v2\x16.api.apimgmt.v1.APIKeyR\x06apiKey\"\xa2\x02\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x16.api.apimgmt.v1.APIKeyR\x06apiKey\"4\n" +
+	"\"RegenerateEntitlementSecretRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x85\x01\n" +
+	"#RegenerateEntitlementSecretResponse\x12/\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x16.api.apimgmt.v1.APIKeyR\x06apiKey\x12-\n" +
+	"\x12entitlement_secret\x18\x02 \x01(\tR\x11entitlementSecret\"\xa2\x02\n" +
 	"\x14ListUsageLogsRequest\x12\x1c\n" +
 	"\n" +
 	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12\x15\n" +
@@ -1704,8 +1816,7 @@ const file_api_apimgmt_v1_console_apimgmt_proto_rawDesc = "" +
 	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
 	"\fdownload_url\x18\x04 \x01(\tR\vdownloadUrl\x12\x1d\n" +
 	"\n" +
-	"object_uri\x18\x05 \x01(\tR\tobjectUri2\xbf\n" +
-	"\n" +
+	"object_uri\x18\x05 \x01(\tR\tobjectUri2\x8c\f\n" +
 	"\x0eConsoleAPIMgmt\x12z\n" +
 	"\fCreateAPIKey\x12#.api.apimgmt.v1.CreateAPIKeyRequest\x1a$.api.apimgmt.v1.CreateAPIKeyResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/console/v1/api_keys\x12t\n" +
 	"\vListAPIKeys\x12\".api.apimgmt.v1.ListAPIKeysRequest\x1a#.api.apimgmt.v1.ListAPIKeysResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/console/v1/api_keys\x12s\n" +
@@ -1713,7 +1824,8 @@ const file_api_apimgmt_v1_console_apimgmt_proto_rawDesc = "" +
 	"\fUpdateAPIKey\x12#.api.apimgmt.v1.UpdateAPIKeyRequest\x1a$.api.apimgmt.v1.UpdateAPIKeyResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/console/v1/api_keys/{id}\x12n\n" +
 	"\fDeleteAPIKey\x12#.api.apimgmt.v1.DeleteAPIKeyRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/console/v1/api_keys/{id}\x12\x86\x01\n" +
 	"\fRotateAPIKey\x12#.api.apimgmt.v1.RotateAPIKeyRequest\x1a$.api.apimgmt.v1.RotateAPIKeyResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /console/v1/api_keys/{id}/rotate\x12\xb4\x01\n" +
-	"\x16RegeneratePublicChatID\x12-.api.apimgmt.v1.RegeneratePublicChatIDRequest\x1a..api.apimgmt.v1.RegeneratePublicChatIDResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/console/v1/api_keys/{id}/public_chat/regenerate\x12{\n" +
+	"\x16RegeneratePublicChatID\x12-.api.apimgmt.v1.RegeneratePublicChatIDRequest\x1a..api.apimgmt.v1.RegeneratePublicChatIDResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/console/v1/api_keys/{id}/public_chat/regenerate\x12\xca\x01\n" +
+	"\x1bRegenerateEntitlementSecret\x122.api.apimgmt.v1.RegenerateEntitlementSecretRequest\x1a3.api.apimgmt.v1.RegenerateEntitlementSecretResponse\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/console/v1/api_keys/{id}/entitlement_secret/regenerate\x12{\n" +
 	"\rListUsageLogs\x12$.api.apimgmt.v1.ListUsageLogsRequest\x1a%.api.apimgmt.v1.ListUsageLogsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/console/v1/api_usage\x12\x89\x01\n" +
 	"\x0fGetUsageSummary\x12&.api.apimgmt.v1.GetUsageSummaryRequest\x1a'.api.apimgmt.v1.GetUsageSummaryResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/console/v1/api_usage/summary\x12\x8b\x01\n" +
 	"\x0fExportUsageLogs\x12&.api.apimgmt.v1.ExportUsageLogsRequest\x1a'.api.apimgmt.v1.ExportUsageLogsResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/console/v1/api_usage/exportB8Z6github.com/ZTH7/RagoDesk/apps/server/api/apimgmt/v1;v1b\x06proto3"
@@ -1730,82 +1842,87 @@ func file_api_apimgmt_v1_console_apimgmt_proto_rawDescGZIP() []byte {
 	return file_api_apimgmt_v1_console_apimgmt_proto_rawDescData
 }
 
-var file_api_apimgmt_v1_console_apimgmt_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_api_apimgmt_v1_console_apimgmt_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_api_apimgmt_v1_console_apimgmt_proto_goTypes = []any{
-	(*APIKey)(nil),                         // 0: api.apimgmt.v1.APIKey
-	(*UsageLog)(nil),                       // 1: api.apimgmt.v1.UsageLog
-	(*UsageSummary)(nil),                   // 2: api.apimgmt.v1.UsageSummary
-	(*CreateAPIKeyRequest)(nil),            // 3: api.apimgmt.v1.CreateAPIKeyRequest
-	(*CreateAPIKeyResponse)(nil),           // 4: api.apimgmt.v1.CreateAPIKeyResponse
-	(*ListAPIKeysRequest)(nil),             // 5: api.apimgmt.v1.ListAPIKeysRequest
-	(*ListAPIKeysResponse)(nil),            // 6: api.apimgmt.v1.ListAPIKeysResponse
-	(*GetAPIKeyRequest)(nil),               // 7: api.apimgmt.v1.GetAPIKeyRequest
-	(*GetAPIKeyResponse)(nil),              // 8: api.apimgmt.v1.GetAPIKeyResponse
-	(*UpdateAPIKeyRequest)(nil),            // 9: api.apimgmt.v1.UpdateAPIKeyRequest
-	(*UpdateAPIKeyResponse)(nil),           // 10: api.apimgmt.v1.UpdateAPIKeyResponse
-	(*DeleteAPIKeyRequest)(nil),            // 11: api.apimgmt.v1.DeleteAPIKeyRequest
-	(*RotateAPIKeyRequest)(nil),            // 12: api.apimgmt.v1.RotateAPIKeyRequest
-	(*RotateAPIKeyResponse)(nil),           // 13: api.apimgmt.v1.RotateAPIKeyResponse
-	(*RegeneratePublicChatIDRequest)(nil),  // 14: api.apimgmt.v1.RegeneratePublicChatIDRequest
-	(*RegeneratePublicChatIDResponse)(nil), // 15: api.apimgmt.v1.RegeneratePublicChatIDResponse
-	(*ListUsageLogsRequest)(nil),           // 16: api.apimgmt.v1.ListUsageLogsRequest
-	(*ListUsageLogsResponse)(nil),          // 17: api.apimgmt.v1.ListUsageLogsResponse
-	(*GetUsageSummaryRequest)(nil),         // 18: api.apimgmt.v1.GetUsageSummaryRequest
-	(*GetUsageSummaryResponse)(nil),        // 19: api.apimgmt.v1.GetUsageSummaryResponse
-	(*ExportUsageLogsRequest)(nil),         // 20: api.apimgmt.v1.ExportUsageLogsRequest
-	(*ExportUsageLogsResponse)(nil),        // 21: api.apimgmt.v1.ExportUsageLogsResponse
-	(*timestamppb.Timestamp)(nil),          // 22: google.protobuf.Timestamp
-	(*wrapperspb.BoolValue)(nil),           // 23: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),          // 24: google.protobuf.Int32Value
-	(*emptypb.Empty)(nil),                  // 25: google.protobuf.Empty
+	(*APIKey)(nil),                              // 0: api.apimgmt.v1.APIKey
+	(*UsageLog)(nil),                            // 1: api.apimgmt.v1.UsageLog
+	(*UsageSummary)(nil),                        // 2: api.apimgmt.v1.UsageSummary
+	(*CreateAPIKeyRequest)(nil),                 // 3: api.apimgmt.v1.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),                // 4: api.apimgmt.v1.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                  // 5: api.apimgmt.v1.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),                 // 6: api.apimgmt.v1.ListAPIKeysResponse
+	(*GetAPIKeyRequest)(nil),                    // 7: api.apimgmt.v1.GetAPIKeyRequest
+	(*GetAPIKeyResponse)(nil),                   // 8: api.apimgmt.v1.GetAPIKeyResponse
+	(*UpdateAPIKeyRequest)(nil),                 // 9: api.apimgmt.v1.UpdateAPIKeyRequest
+	(*UpdateAPIKeyResponse)(nil),                // 10: api.apimgmt.v1.UpdateAPIKeyResponse
+	(*DeleteAPIKeyRequest)(nil),                 // 11: api.apimgmt.v1.DeleteAPIKeyRequest
+	(*RotateAPIKeyRequest)(nil),                 // 12: api.apimgmt.v1.RotateAPIKeyRequest
+	(*RotateAPIKeyResponse)(nil),                // 13: api.apimgmt.v1.RotateAPIKeyResponse
+	(*RegeneratePublicChatIDRequest)(nil),       // 14: api.apimgmt.v1.RegeneratePublicChatIDRequest
+	(*RegeneratePublicChatIDResponse)(nil),      // 15: api.apimgmt.v1.RegeneratePublicChatIDResponse
+	(*RegenerateEntitlementSecretRequest)(nil),  // 16: api.apimgmt.v1.RegenerateEntitlementSecretRequest
+	(*RegenerateEntitlementSecretResponse)(nil), // 17: api.apimgmt.v1.RegenerateEntitlementSecretResponse
+	(*ListUsageLogsRequest)(nil),                // 18: api.apimgmt.v1.ListUsageLogsRequest
+	(*ListUsageLogsResponse)(nil),               // 19: api.apimgmt.v1.ListUsageLogsResponse
+	(*GetUsageSummaryRequest)(nil),              // 20: api.apimgmt.v1.GetUsageSummaryRequest
+	(*GetUsageSummaryResponse)(nil),             // 21: api.apimgmt.v1.GetUsageSummaryResponse
+	(*ExportUsageLogsRequest)(nil),              // 22: api.apimgmt.v1.ExportUsageLogsRequest
+	(*ExportUsageLogsResponse)(nil),             // 23: api.apimgmt.v1.ExportUsageLogsResponse
+	(*timestamppb.Timestamp)(nil),               // 24: google.protobuf.Timestamp
+	(*wrapperspb.BoolValue)(nil),                // 25: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),               // 26: google.protobuf.Int32Value
+	(*emptypb.Empty)(nil),                       // 27: google.protobuf.Empty
 }
 var file_api_apimgmt_v1_console_apimgmt_proto_depIdxs = []int32{
-	22, // 0: api.apimgmt.v1.APIKey.created_at:type_name -> google.protobuf.Timestamp
-	22, // 1: api.apimgmt.v1.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
-	22, // 2: api.apimgmt.v1.UsageLog.created_at:type_name -> google.protobuf.Timestamp
-	23, // 3: api.apimgmt.v1.CreateAPIKeyRequest.public_chat_enabled:type_name -> google.protobuf.BoolValue
+	24, // 0: api.apimgmt.v1.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	24, // 1: api.apimgmt.v1.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
+	24, // 2: api.apimgmt.v1.UsageLog.created_at:type_name -> google.protobuf.Timestamp
+	25, // 3: api.apimgmt.v1.CreateAPIKeyRequest.public_chat_enabled:type_name -> google.protobuf.BoolValue
 	0,  // 4: api.apimgmt.v1.CreateAPIKeyResponse.api_key:type_name -> api.apimgmt.v1.APIKey
 	0,  // 5: api.apimgmt.v1.ListAPIKeysResponse.items:type_name -> api.apimgmt.v1.APIKey
 	0,  // 6: api.apimgmt.v1.GetAPIKeyResponse.api_key:type_name -> api.apimgmt.v1.APIKey
-	24, // 7: api.apimgmt.v1.UpdateAPIKeyRequest.quota_daily:type_name -> google.protobuf.Int32Value
-	24, // 8: api.apimgmt.v1.UpdateAPIKeyRequest.qps_limit:type_name -> google.protobuf.Int32Value
-	23, // 9: api.apimgmt.v1.UpdateAPIKeyRequest.public_chat_enabled:type_name -> google.protobuf.BoolValue
+	26, // 7: api.apimgmt.v1.UpdateAPIKeyRequest.quota_daily:type_name -> google.protobuf.Int32Value
+	26, // 8: api.apimgmt.v1.UpdateAPIKeyRequest.qps_limit:type_name -> google.protobuf.Int32Value
+	25, // 9: api.apimgmt.v1.UpdateAPIKeyRequest.public_chat_enabled:type_name -> google.protobuf.BoolValue
 	0,  // 10: api.apimgmt.v1.UpdateAPIKeyResponse.api_key:type_name -> api.apimgmt.v1.APIKey
 	0,  // 11: api.apimgmt.v1.RotateAPIKeyResponse.api_key:type_name -> api.apimgmt.v1.APIKey
 	0,  // 12: api.apimgmt.v1.RegeneratePublicChatIDResponse.api_key:type_name -> api.apimgmt.v1.APIKey
-	22, // 13: api.apimgmt.v1.ListUsageLogsRequest.start_time:type_name -> google.protobuf.Timestamp
-	22, // 14: api.apimgmt.v1.ListUsageLogsRequest.end_time:type_name -> google.protobuf.Timestamp
-	1,  // 15: api.apimgmt.v1.ListUsageLogsResponse.items:type_name -> api.apimgmt.v1.UsageLog
-	22, // 16: api.apimgmt.v1.GetUsageSummaryRequest.start_time:type_name -> google.protobuf.Timestamp
-	22, // 17: api.apimgmt.v1.GetUsageSummaryRequest.end_time:type_name -> google.protobuf.Timestamp
-	2,  // 18: api.apimgmt.v1.GetUsageSummaryResponse.summary:type_name -> api.apimgmt.v1.UsageSummary
-	22, // 19: api.apimgmt.v1.ExportUsageLogsRequest.start_time:type_name -> google.protobuf.Timestamp
-	22, // 20: api.apimgmt.v1.ExportUsageLogsRequest.end_time:type_name -> google.protobuf.Timestamp
-	3,  // 21: api.apimgmt.v1.ConsoleAPIMgmt.CreateAPIKey:input_type -> api.apimgmt.v1.CreateAPIKeyRequest
-	5,  // 22: api.apimgmt.v1.ConsoleAPIMgmt.ListAPIKeys:input_type -> api.apimgmt.v1.ListAPIKeysRequest
-	7,  // 23: api.apimgmt.v1.ConsoleAPIMgmt.GetAPIKey:input_type -> api.apimgmt.v1.GetAPIKeyRequest
-	9,  // 24: api.apimgmt.v1.ConsoleAPIMgmt.UpdateAPIKey:input_type -> api.apimgmt.v1.UpdateAPIKeyRequest
-	11, // 25: api.apimgmt.v1.ConsoleAPIMgmt.DeleteAPIKey:input_type -> api.apimgmt.v1.DeleteAPIKeyRequest
-	12, // 26: api.apimgmt.v1.ConsoleAPIMgmt.RotateAPIKey:input_type -> api.apimgmt.v1.RotateAPIKeyRequest
-	14, // 27: api.apimgmt.v1.ConsoleAPIMgmt.RegeneratePublicChatID:input_type -> api.apimgmt.v1.RegeneratePublicChatIDRequest
-	16, // 28: api.apimgmt.v1.ConsoleAPIMgmt.ListUsageLogs:input_type -> api.apimgmt.v1.ListUsageLogsRequest
-	18, // 29: api.apimgmt.v1.ConsoleAPIMgmt.GetUsageSummary:input_type -> api.apimgmt.v1.GetUsageSummaryRequest
-	20, // 30: api.apimgmt.v1.ConsoleAPIMgmt.ExportUsageLogs:input_type -> api.apimgmt.v1.ExportUsageLogsRequest
-	4,  // 31: api.apimgmt.v1.ConsoleAPIMgmt.CreateAPIKey:output_type -> api.apimgmt.v1.CreateAPIKeyResponse
-	6,  // 32: api.apimgmt.v1.ConsoleAPIMgmt.ListAPIKeys:output_type -> api.apimgmt.v1.ListAPIKeysResponse
-	8,  // 33: api.apimgmt.v1.ConsoleAPIMgmt.GetAPIKey:output_type -> api.apimgmt.v1.GetAPIKeyResponse
-	10, // 34: api.apimgmt.v1.ConsoleAPIMgmt.UpdateAPIKey:output_type -> api.apimgmt.v1.UpdateAPIKeyResponse
-	25, // 35: api.apimgmt.v1.ConsoleAPIMgmt.DeleteAPIKey:output_type -> google.protobuf.Empty
-	13, // 36: api.apimgmt.v1.ConsoleAPIMgmt.RotateAPIKey:output_type -> api.apimgmt.v1.RotateAPIKeyResponse
-	15, // 37: api.apimgmt.v1.ConsoleAPIMgmt.RegeneratePublicChatID:output_type -> api.apimgmt.v1.RegeneratePublicChatIDResponse
-	17, // 38: api.apimgmt.v1.ConsoleAPIMgmt.ListUsageLogs:output_type -> api.apimgmt.v1.ListUsageLogsResponse
-	19, // 39: api.apimgmt.v1.ConsoleAPIMgmt.GetUsageSummary:output_type -> api.apimgmt.v1.GetUsageSummaryResponse
-	21, // 40: api.apimgmt.v1.ConsoleAPIMgmt.ExportUsageLogs:output_type -> api.apimgmt.v1.ExportUsageLogsResponse
-	31, // [31:41] is the sub-list for method output_type
-	21, // [21:31] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	0,  // 13: api.apimgmt.v1.RegenerateEntitlementSecretResponse.api_key:type_name -> api.apimgmt.v1.APIKey
+	24, // 14: api.apimgmt.v1.ListUsageLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	24, // 15: api.apimgmt.v1.ListUsageLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	1,  // 16: api.apimgmt.v1.ListUsageLogsResponse.items:type_name -> api.apimgmt.v1.UsageLog
+	24, // 17: api.apimgmt.v1.GetUsageSummaryRequest.start_time:type_name -> google.protobuf.Timestamp
+	24, // 18: api.apimgmt.v1.GetUsageSummaryRequest.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 19: api.apimgmt.v1.GetUsageSummaryResponse.summary:type_name -> api.apimgmt.v1.UsageSummary
+	24, // 20: api.apimgmt.v1.ExportUsageLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	24, // 21: api.apimgmt.v1.ExportUsageLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	3,  // 22: api.apimgmt.v1.ConsoleAPIMgmt.CreateAPIKey:input_type -> api.apimgmt.v1.CreateAPIKeyRequest
+	5,  // 23: api.apimgmt.v1.ConsoleAPIMgmt.ListAPIKeys:input_type -> api.apimgmt.v1.ListAPIKeysRequest
+	7,  // 24: api.apimgmt.v1.ConsoleAPIMgmt.GetAPIKey:input_type -> api.apimgmt.v1.GetAPIKeyRequest
+	9,  // 25: api.apimgmt.v1.ConsoleAPIMgmt.UpdateAPIKey:input_type -> api.apimgmt.v1.UpdateAPIKeyRequest
+	11, // 26: api.apimgmt.v1.ConsoleAPIMgmt.DeleteAPIKey:input_type -> api.apimgmt.v1.DeleteAPIKeyRequest
+	12, // 27: api.apimgmt.v1.ConsoleAPIMgmt.RotateAPIKey:input_type -> api.apimgmt.v1.RotateAPIKeyRequest
+	14, // 28: api.apimgmt.v1.ConsoleAPIMgmt.RegeneratePublicChatID:input_type -> api.apimgmt.v1.RegeneratePublicChatIDRequest
+	16, // 29: api.apimgmt.v1.ConsoleAPIMgmt.RegenerateEntitlementSecret:input_type -> api.apimgmt.v1.RegenerateEntitlementSecretRequest
+	18, // 30: api.apimgmt.v1.ConsoleAPIMgmt.ListUsageLogs:input_type -> api.apimgmt.v1.ListUsageLogsRequest
+	20, // 31: api.apimgmt.v1.ConsoleAPIMgmt.GetUsageSummary:input_type -> api.apimgmt.v1.GetUsageSummaryRequest
+	22, // 32: api.apimgmt.v1.ConsoleAPIMgmt.ExportUsageLogs:input_type -> api.apimgmt.v1.ExportUsageLogsRequest
+	4,  // 33: api.apimgmt.v1.ConsoleAPIMgmt.CreateAPIKey:output_type -> api.apimgmt.v1.CreateAPIKeyResponse
+	6,  // 34: api.apimgmt.v1.ConsoleAPIMgmt.ListAPIKeys:output_type -> api.apimgmt.v1.ListAPIKeysResponse
+	8,  // 35: api.apimgmt.v1.ConsoleAPIMgmt.GetAPIKey:output_type -> api.apimgmt.v1.GetAPIKeyResponse
+	10, // 36: api.apimgmt.v1.ConsoleAPIMgmt.UpdateAPIKey:output_type -> api.apimgmt.v1.UpdateAPIKeyResponse
+	27, // 37: api.apimgmt.v1.ConsoleAPIMgmt.DeleteAPIKey:output_type -> google.protobuf.Empty
+	13, // 38: api.apimgmt.v1.ConsoleAPIMgmt.RotateAPIKey:output_type -> api.apimgmt.v1.RotateAPIKeyResponse
+	15, // 39: api.apimgmt.v1.ConsoleAPIMgmt.RegeneratePublicChatID:output_type -> api.apimgmt.v1.RegeneratePublicChatIDResponse
+	17, // 40: api.apimgmt.v1.ConsoleAPIMgmt.RegenerateEntitlementSecret:output_type -> api.apimgmt.v1.RegenerateEntitlementSecretResponse
+	19, // 41: api.apimgmt.v1.ConsoleAPIMgmt.ListUsageLogs:output_type -> api.apimgmt.v1.ListUsageLogsResponse
+	21, // 42: api.apimgmt.v1.ConsoleAPIMgmt.GetUsageSummary:output_type -> api.apimgmt.v1.GetUsageSummaryResponse
+	23, // 43: api.apimgmt.v1.ConsoleAPIMgmt.ExportUsageLogs:output_type -> api.apimgmt.v1.ExportUsageLogsResponse
+	33, // [33:44] is the sub-list for method output_type
+	22, // [22:33] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_api_apimgmt_v1_console_apimgmt_proto_init() }
@@ -1819,7 +1936,7 @@ func file_api_apimgmt_v1_console_apimgmt_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_apimgmt_v1_console_apimgmt_proto_rawDesc), len(file_api_apimgmt_v1_console_apimgmt_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
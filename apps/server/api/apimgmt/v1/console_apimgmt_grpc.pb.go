@@ -20,16 +20,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ConsoleAPIMgmt_CreateAPIKey_FullMethodName           = "/api.apimgmt.v1.ConsoleAPIMgmt/CreateAPIKey"
-	ConsoleAPIMgmt_ListAPIKeys_FullMethodName            = "/api.apimgmt.v1.ConsoleAPIMgmt/ListAPIKeys"
-	ConsoleAPIMgmt_GetAPIKey_FullMethodName              = "/api.apimgmt.v1.ConsoleAPIMgmt/GetAPIKey"
-	ConsoleAPIMgmt_UpdateAPIKey_FullMethodName           = "/api.apimgmt.v1.ConsoleAPIMgmt/UpdateAPIKey"
-	ConsoleAPIMgmt_DeleteAPIKey_FullMethodName           = "/api.apimgmt.v1.ConsoleAPIMgmt/DeleteAPIKey"
-	ConsoleAPIMgmt_RotateAPIKey_FullMethodName           = "/api.apimgmt.v1.ConsoleAPIMgmt/RotateAPIKey"
-	ConsoleAPIMgmt_RegeneratePublicChatID_FullMethodName = "/api.apimgmt.v1.ConsoleAPIMgmt/RegeneratePublicChatID"
-	ConsoleAPIMgmt_ListUsageLogs_FullMethodName          = "/api.apimgmt.v1.ConsoleAPIMgmt/ListUsageLogs"
-	ConsoleAPIMgmt_GetUsageSummary_FullMethodName        = "/api.apimgmt.v1.ConsoleAPIMgmt/GetUsageSummary"
-	ConsoleAPIMgmt_ExportUsageLogs_FullMethodName        = "/api.apimgmt.v1.ConsoleAPIMgmt/ExportUsageLogs"
+	ConsoleAPIMgmt_CreateAPIKey_FullMethodName                = "/api.apimgmt.v1.ConsoleAPIMgmt/CreateAPIKey"
+	ConsoleAPIMgmt_ListAPIKeys_FullMethodName                 = "/api.apimgmt.v1.ConsoleAPIMgmt/ListAPIKeys"
+	ConsoleAPIMgmt_GetAPIKey_FullMethodName                   = "/api.apimgmt.v1.ConsoleAPIMgmt/GetAPIKey"
+	ConsoleAPIMgmt_UpdateAPIKey_FullMethodName                = "/api.apimgmt.v1.ConsoleAPIMgmt/UpdateAPIKey"
+	ConsoleAPIMgmt_DeleteAPIKey_FullMethodName                = "/api.apimgmt.v1.ConsoleAPIMgmt/DeleteAPIKey"
+	ConsoleAPIMgmt_RotateAPIKey_FullMethodName                = "/api.apimgmt.v1.ConsoleAPIMgmt/RotateAPIKey"
+	ConsoleAPIMgmt_RegeneratePublicChatID_FullMethodName      = "/api.apimgmt.v1.ConsoleAPIMgmt/RegeneratePublicChatID"
+	ConsoleAPIMgmt_RegenerateEntitlementSecret_FullMethodName = "/api.apimgmt.v1.ConsoleAPIMgmt/RegenerateEntitlementSecret"
+	ConsoleAPIMgmt_ListUsageLogs_FullMethodName               = "/api.apimgmt.v1.ConsoleAPIMgmt/ListUsageLogs"
+	ConsoleAPIMgmt_GetUsageSummary_FullMethodName             = "/api.apimgmt.v1.ConsoleAPIMgmt/GetUsageSummary"
+	ConsoleAPIMgmt_ExportUsageLogs_FullMethodName             = "/api.apimgmt.v1.ConsoleAPIMgmt/ExportUsageLogs"
 )
 
 // ConsoleAPIMgmtClient is the client API for ConsoleAPIMgmt service.
@@ -43,6 +44,7 @@ type ConsoleAPIMgmtClient interface {
 	DeleteAPIKey(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*RotateAPIKeyResponse, error)
 	RegeneratePublicChatID(ctx context.Context, in *RegeneratePublicChatIDRequest, opts ...grpc.CallOption) (*RegeneratePublicChatIDResponse, error)
+	RegenerateEntitlementSecret(ctx context.Context, in *RegenerateEntitlementSecretRequest, opts ...grpc.CallOption) (*RegenerateEntitlementSecretResponse, error)
 	ListUsageLogs(ctx context.Context, in *ListUsageLogsRequest, opts ...grpc.CallOption) (*ListUsageLogsResponse, error)
 	GetUsageSummary(ctx context.Context, in *GetUsageSummaryRequest, opts ...grpc.CallOption) (*GetUsageSummaryResponse, error)
 	ExportUsageLogs(ctx context.Context, in *ExportUsageLogsRequest, opts ...grpc.CallOption) (*ExportUsageLogsResponse, error)
@@ -126,6 +128,16 @@ func (c *consoleAPIMgmtClient) RegeneratePublicChatID(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *consoleAPIMgmtClient) RegenerateEntitlementSecret(ctx context.Context, in *RegenerateEntitlementSecretRequest, opts ...grpc.CallOption) (*RegenerateEntitlementSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateEntitlementSecretResponse)
+	err := c.cc.Invoke(ctx, ConsoleAPIMgmt_RegenerateEntitlementSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleAPIMgmtClient) ListUsageLogs(ctx context.Context, in *ListUsageLogsRequest, opts ...grpc.CallOption) (*ListUsageLogsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUsageLogsResponse)
@@ -167,6 +179,7 @@ type ConsoleAPIMgmtServer interface {
 	DeleteAPIKey(context.Context, *DeleteAPIKeyRequest) (*emptypb.Empty, error)
 	RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error)
 	RegeneratePublicChatID(context.Context, *RegeneratePublicChatIDRequest) (*RegeneratePublicChatIDResponse, error)
+	RegenerateEntitlementSecret(context.Context, *RegenerateEntitlementSecretRequest) (*RegenerateEntitlementSecretResponse, error)
 	ListUsageLogs(context.Context, *ListUsageLogsRequest) (*ListUsageLogsResponse, error)
 	GetUsageSummary(context.Context, *GetUsageSummaryRequest) (*GetUsageSummaryResponse, error)
 	ExportUsageLogs(context.Context, *ExportUsageLogsRequest) (*ExportUsageLogsResponse, error)
@@ -201,6 +214,9 @@ func (UnimplementedConsoleAPIMgmtServer) RotateAPIKey(context.Context, *RotateAP
 func (UnimplementedConsoleAPIMgmtServer) RegeneratePublicChatID(context.Context, *RegeneratePublicChatIDRequest) (*RegeneratePublicChatIDResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RegeneratePublicChatID not implemented")
 }
+func (UnimplementedConsoleAPIMgmtServer) RegenerateEntitlementSecret(context.Context, *RegenerateEntitlementSecretRequest) (*RegenerateEntitlementSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegenerateEntitlementSecret not implemented")
+}
 func (UnimplementedConsoleAPIMgmtServer) ListUsageLogs(context.Context, *ListUsageLogsRequest) (*ListUsageLogsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListUsageLogs not implemented")
 }
@@ -357,6 +373,24 @@ func _ConsoleAPIMgmt_RegeneratePublicChatID_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConsoleAPIMgmt_RegenerateEntitlementSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateEntitlementSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAPIMgmtServer).RegenerateEntitlementSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAPIMgmt_RegenerateEntitlementSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAPIMgmtServer).RegenerateEntitlementSecret(ctx, req.(*RegenerateEntitlementSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConsoleAPIMgmt_ListUsageLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUsageLogsRequest)
 	if err := dec(in); err != nil {
@@ -446,6 +480,10 @@ var ConsoleAPIMgmt_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegeneratePublicChatID",
 			Handler:    _ConsoleAPIMgmt_RegeneratePublicChatID_Handler,
 		},
+		{
+			MethodName: "RegenerateEntitlementSecret",
+			Handler:    _ConsoleAPIMgmt_RegenerateEntitlementSecret_Handler,
+		},
 		{
 			MethodName: "ListUsageLogs",
 			Handler:    _ConsoleAPIMgmt_ListUsageLogs_Handler,
@@ -27,6 +27,7 @@ const OperationConsoleAPIMgmtGetAPIKey = "/api.apimgmt.v1.ConsoleAPIMgmt/GetAPIK
 const OperationConsoleAPIMgmtGetUsageSummary = "/api.apimgmt.v1.ConsoleAPIMgmt/GetUsageSummary"
 const OperationConsoleAPIMgmtListAPIKeys = "/api.apimgmt.v1.ConsoleAPIMgmt/ListAPIKeys"
 const OperationConsoleAPIMgmtListUsageLogs = "/api.apimgmt.v1.ConsoleAPIMgmt/ListUsageLogs"
+const OperationConsoleAPIMgmtRegenerateEntitlementSecret = "/api.apimgmt.v1.ConsoleAPIMgmt/RegenerateEntitlementSecret"
 const OperationConsoleAPIMgmtRegeneratePublicChatID = "/api.apimgmt.v1.ConsoleAPIMgmt/RegeneratePublicChatID"
 const OperationConsoleAPIMgmtRotateAPIKey = "/api.apimgmt.v1.ConsoleAPIMgmt/RotateAPIKey"
 const OperationConsoleAPIMgmtUpdateAPIKey = "/api.apimgmt.v1.ConsoleAPIMgmt/UpdateAPIKey"
@@ -39,6 +40,7 @@ type ConsoleAPIMgmtHTTPServer interface {
 	GetUsageSummary(context.Context, *GetUsageSummaryRequest) (*GetUsageSummaryResponse, error)
 	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
 	ListUsageLogs(context.Context, *ListUsageLogsRequest) (*ListUsageLogsResponse, error)
+	RegenerateEntitlementSecret(context.Context, *RegenerateEntitlementSecretRequest) (*RegenerateEntitlementSecretResponse, error)
 	RegeneratePublicChatID(context.Context, *RegeneratePublicChatIDRequest) (*RegeneratePublicChatIDResponse, error)
 	RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error)
 	UpdateAPIKey(context.Context, *UpdateAPIKeyRequest) (*UpdateAPIKeyResponse, error)
@@ -53,6 +55,7 @@ func RegisterConsoleAPIMgmtHTTPServer(s *http.Server, srv ConsoleAPIMgmtHTTPServ
 	r.DELETE("/console/v1/api_keys/{id}", _ConsoleAPIMgmt_DeleteAPIKey0_HTTP_Handler(srv))
 	r.POST("/console/v1/api_keys/{id}/rotate", _ConsoleAPIMgmt_RotateAPIKey0_HTTP_Handler(srv))
 	r.POST("/console/v1/api_keys/{id}/public_chat/regenerate", _ConsoleAPIMgmt_RegeneratePublicChatID0_HTTP_Handler(srv))
+	r.POST("/console/v1/api_keys/{id}/entitlement_secret/regenerate", _ConsoleAPIMgmt_RegenerateEntitlementSecret0_HTTP_Handler(srv))
 	r.GET("/console/v1/api_usage", _ConsoleAPIMgmt_ListUsageLogs0_HTTP_Handler(srv))
 	r.GET("/console/v1/api_usage/summary", _ConsoleAPIMgmt_GetUsageSummary0_HTTP_Handler(srv))
 	r.POST("/console/v1/api_usage/export", _ConsoleAPIMgmt_ExportUsageLogs0_HTTP_Handler(srv))
@@ -218,6 +221,31 @@ func _ConsoleAPIMgmt_RegeneratePublicChatID0_HTTP_Handler(srv ConsoleAPIMgmtHTTP
 	}
 }
 
+func _ConsoleAPIMgmt_RegenerateEntitlementSecret0_HTTP_Handler(srv ConsoleAPIMgmtHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RegenerateEntitlementSecretRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAPIMgmtRegenerateEntitlementSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RegenerateEntitlementSecret(ctx, req.(*RegenerateEntitlementSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RegenerateEntitlementSecretResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ConsoleAPIMgmt_ListUsageLogs0_HTTP_Handler(srv ConsoleAPIMgmtHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListUsageLogsRequest
@@ -286,6 +314,7 @@ type ConsoleAPIMgmtHTTPClient interface {
 	GetUsageSummary(ctx context.Context, req *GetUsageSummaryRequest, opts ...http.CallOption) (rsp *GetUsageSummaryResponse, err error)
 	ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest, opts ...http.CallOption) (rsp *ListAPIKeysResponse, err error)
 	ListUsageLogs(ctx context.Context, req *ListUsageLogsRequest, opts ...http.CallOption) (rsp *ListUsageLogsResponse, err error)
+	RegenerateEntitlementSecret(ctx context.Context, req *RegenerateEntitlementSecretRequest, opts ...http.CallOption) (rsp *RegenerateEntitlementSecretResponse, err error)
 	RegeneratePublicChatID(ctx context.Context, req *RegeneratePublicChatIDRequest, opts ...http.CallOption) (rsp *RegeneratePublicChatIDResponse, err error)
 	RotateAPIKey(ctx context.Context, req *RotateAPIKeyRequest, opts ...http.CallOption) (rsp *RotateAPIKeyResponse, err error)
 	UpdateAPIKey(ctx context.Context, req *UpdateAPIKeyRequest, opts ...http.CallOption) (rsp *UpdateAPIKeyResponse, err error)
@@ -390,6 +419,19 @@ func (c *ConsoleAPIMgmtHTTPClientImpl) ListUsageLogs(ctx context.Context, in *Li
 	return &out, nil
 }
 
+func (c *ConsoleAPIMgmtHTTPClientImpl) RegenerateEntitlementSecret(ctx context.Context, in *RegenerateEntitlementSecretRequest, opts ...http.CallOption) (*RegenerateEntitlementSecretResponse, error) {
+	var out RegenerateEntitlementSecretResponse
+	pattern := "/console/v1/api_keys/{id}/entitlement_secret/regenerate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAPIMgmtRegenerateEntitlementSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleAPIMgmtHTTPClientImpl) RegeneratePublicChatID(ctx context.Context, in *RegeneratePublicChatIDRequest, opts ...http.CallOption) (*RegeneratePublicChatIDResponse, error) {
 	var out RegeneratePublicChatIDResponse
 	pattern := "/console/v1/api_keys/{id}/public_chat/regenerate"
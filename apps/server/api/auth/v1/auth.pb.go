@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -295,30 +296,1786 @@ func (x *AuthProfile) GetRoles() []string {
 	return nil
 }
 
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+}
+
 type AuthResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Token     string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Profile   *AuthProfile           `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Exchanged for a new token pair at the token/refresh endpoint; every
+	// refresh token can be used once.
+	RefreshToken     string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RefreshExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=refresh_expires_at,json=refreshExpiresAt,proto3" json:"refresh_expires_at,omitempty"`
+	// Set without any token when the password step needs a second factor;
+	// pass mfa_token to login/mfa.
+	MfaRequired  bool                   `protobuf:"varint,6,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken     string                 `protobuf:"bytes,7,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaExpiresAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=mfa_expires_at,json=mfaExpiresAt,proto3" json:"mfa_expires_at,omitempty"`
+	// Policy requires MFA but none is enrolled; call login/mfa/enroll first.
+	MfaEnrollmentRequired bool `protobuf:"varint,9,opt,name=mfa_enrollment_required,json=mfaEnrollmentRequired,proto3" json:"mfa_enrollment_required,omitempty"`
+	// Returned once when MFA was enrolled during the login.
+	RecoveryCodes []string `protobuf:"bytes,10,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthResponse) Reset() {
+	*x = AuthResponse{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthResponse) ProtoMessage() {}
+
+func (x *AuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuthResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AuthResponse) GetProfile() *AuthProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *AuthResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetRefreshExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshExpiresAt
+	}
+	return nil
+}
+
+func (x *AuthResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *AuthResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetMfaExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MfaExpiresAt
+	}
+	return nil
+}
+
+func (x *AuthResponse) GetMfaEnrollmentRequired() bool {
+	if x != nil {
+		return x.MfaEnrollmentRequired
+	}
+	return false
+}
+
+func (x *AuthResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type AcceptInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInviteRequest) Reset() {
+	*x = AcceptInviteRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInviteRequest) ProtoMessage() {}
+
+func (x *AcceptInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcceptInviteRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptInviteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcceptInviteRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RequestPasswordResetRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type StartSSORequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSSORequest) Reset() {
+	*x = StartSSORequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSSORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSSORequest) ProtoMessage() {}
+
+func (x *StartSSORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSSORequest.ProtoReflect.Descriptor instead.
+func (*StartSSORequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartSSORequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type StartSSOResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RedirectUrl   string                 `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	Protocol      string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSSOResponse) Reset() {
+	*x = StartSSOResponse{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSSOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSSOResponse) ProtoMessage() {}
+
+func (x *StartSSOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSSOResponse.ProtoReflect.Descriptor instead.
+func (*StartSSOResponse) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StartSSOResponse) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *StartSSOResponse) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+type ExchangeSSOTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        string                 `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeSSOTicketRequest) Reset() {
+	*x = ExchangeSSOTicketRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeSSOTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeSSOTicketRequest) ProtoMessage() {}
+
+func (x *ExchangeSSOTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeSSOTicketRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeSSOTicketRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExchangeSSOTicketRequest) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type SSORoleMapping struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// OIDC claim or SAML attribute name, e.g. groups.
+	Claim         string `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	RoleId        string `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSORoleMapping) Reset() {
+	*x = SSORoleMapping{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSORoleMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSORoleMapping) ProtoMessage() {}
+
+func (x *SSORoleMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSORoleMapping.ProtoReflect.Descriptor instead.
+func (*SSORoleMapping) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SSORoleMapping) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *SSORoleMapping) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SSORoleMapping) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type SSOConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// oidc or saml.
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Enabled  bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Rejects password logins of the tenant's users.
+	EnforceSso bool `protobuf:"varint,3,opt,name=enforce_sso,json=enforceSso,proto3" json:"enforce_sso,omitempty"`
+	// Creates unknown users on their first SSO login.
+	JitProvisioning bool `protobuf:"varint,4,opt,name=jit_provisioning,json=jitProvisioning,proto3" json:"jit_provisioning,omitempty"`
+	// Assigned to users created by JIT provisioning.
+	DefaultRoleId string `protobuf:"bytes,5,opt,name=default_role_id,json=defaultRoleId,proto3" json:"default_role_id,omitempty"`
+	OidcIssuer    string `protobuf:"bytes,6,opt,name=oidc_issuer,json=oidcIssuer,proto3" json:"oidc_issuer,omitempty"`
+	OidcClientId  string `protobuf:"bytes,7,opt,name=oidc_client_id,json=oidcClientId,proto3" json:"oidc_client_id,omitempty"`
+	// Write-only; empty keeps the stored secret.
+	OidcClientSecret    string   `protobuf:"bytes,8,opt,name=oidc_client_secret,json=oidcClientSecret,proto3" json:"oidc_client_secret,omitempty"`
+	OidcClientSecretSet bool     `protobuf:"varint,9,opt,name=oidc_client_secret_set,json=oidcClientSecretSet,proto3" json:"oidc_client_secret_set,omitempty"`
+	OidcScopes          []string `protobuf:"bytes,10,rep,name=oidc_scopes,json=oidcScopes,proto3" json:"oidc_scopes,omitempty"`
+	SamlIdpEntityId     string   `protobuf:"bytes,11,opt,name=saml_idp_entity_id,json=samlIdpEntityId,proto3" json:"saml_idp_entity_id,omitempty"`
+	SamlIdpSsoUrl       string   `protobuf:"bytes,12,opt,name=saml_idp_sso_url,json=samlIdpSsoUrl,proto3" json:"saml_idp_sso_url,omitempty"`
+	// PEM or base64 DER signing certificate of the IdP.
+	SamlIdpCertificate string `protobuf:"bytes,13,opt,name=saml_idp_certificate,json=samlIdpCertificate,proto3" json:"saml_idp_certificate,omitempty"`
+	// Claim or attribute holding the email; defaults to email.
+	EmailAttribute string `protobuf:"bytes,14,opt,name=email_attribute,json=emailAttribute,proto3" json:"email_attribute,omitempty"`
+	// Claim or attribute holding the display name; defaults to name.
+	NameAttribute string            `protobuf:"bytes,15,opt,name=name_attribute,json=nameAttribute,proto3" json:"name_attribute,omitempty"`
+	RoleMappings  []*SSORoleMapping `protobuf:"bytes,16,rep,name=role_mappings,json=roleMappings,proto3" json:"role_mappings,omitempty"`
+	// Read-only values to register at the IdP.
+	OidcRedirectUri string                 `protobuf:"bytes,17,opt,name=oidc_redirect_uri,json=oidcRedirectUri,proto3" json:"oidc_redirect_uri,omitempty"`
+	SamlSpEntityId  string                 `protobuf:"bytes,18,opt,name=saml_sp_entity_id,json=samlSpEntityId,proto3" json:"saml_sp_entity_id,omitempty"`
+	SamlAcsUrl      string                 `protobuf:"bytes,19,opt,name=saml_acs_url,json=samlAcsUrl,proto3" json:"saml_acs_url,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SSOConfig) Reset() {
+	*x = SSOConfig{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOConfig) ProtoMessage() {}
+
+func (x *SSOConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOConfig.ProtoReflect.Descriptor instead.
+func (*SSOConfig) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SSOConfig) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SSOConfig) GetEnforceSso() bool {
+	if x != nil {
+		return x.EnforceSso
+	}
+	return false
+}
+
+func (x *SSOConfig) GetJitProvisioning() bool {
+	if x != nil {
+		return x.JitProvisioning
+	}
+	return false
+}
+
+func (x *SSOConfig) GetDefaultRoleId() string {
+	if x != nil {
+		return x.DefaultRoleId
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetOidcIssuer() string {
+	if x != nil {
+		return x.OidcIssuer
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetOidcClientId() string {
+	if x != nil {
+		return x.OidcClientId
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetOidcClientSecret() string {
+	if x != nil {
+		return x.OidcClientSecret
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetOidcClientSecretSet() bool {
+	if x != nil {
+		return x.OidcClientSecretSet
+	}
+	return false
+}
+
+func (x *SSOConfig) GetOidcScopes() []string {
+	if x != nil {
+		return x.OidcScopes
+	}
+	return nil
+}
+
+func (x *SSOConfig) GetSamlIdpEntityId() string {
+	if x != nil {
+		return x.SamlIdpEntityId
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetSamlIdpSsoUrl() string {
+	if x != nil {
+		return x.SamlIdpSsoUrl
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetSamlIdpCertificate() string {
+	if x != nil {
+		return x.SamlIdpCertificate
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetEmailAttribute() string {
+	if x != nil {
+		return x.EmailAttribute
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetNameAttribute() string {
+	if x != nil {
+		return x.NameAttribute
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetRoleMappings() []*SSORoleMapping {
+	if x != nil {
+		return x.RoleMappings
+	}
+	return nil
+}
+
+func (x *SSOConfig) GetOidcRedirectUri() string {
+	if x != nil {
+		return x.OidcRedirectUri
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetSamlSpEntityId() string {
+	if x != nil {
+		return x.SamlSpEntityId
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetSamlAcsUrl() string {
+	if x != nil {
+		return x.SamlAcsUrl
+	}
+	return ""
+}
+
+func (x *SSOConfig) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetSSOConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSSOConfigRequest) Reset() {
+	*x = GetSSOConfigRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSSOConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSSOConfigRequest) ProtoMessage() {}
+
+func (x *GetSSOConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSSOConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSSOConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
+type UpdateSSOConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *SSOConfig             `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSSOConfigRequest) Reset() {
+	*x = UpdateSSOConfigRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSSOConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSSOConfigRequest) ProtoMessage() {}
+
+func (x *UpdateSSOConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSSOConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSSOConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateSSOConfigRequest) GetConfig() *SSOConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SSOConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *SSOConfig             `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSOConfigResponse) Reset() {
+	*x = SSOConfigResponse{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOConfigResponse) ProtoMessage() {}
+
+func (x *SSOConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOConfigResponse.ProtoReflect.Descriptor instead.
+func (*SSOConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SSOConfigResponse) GetConfig() *SSOConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type VerifyMFARequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// TOTP code; recovery_code is used when empty.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	RecoveryCode  string `protobuf:"bytes,3,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFARequest) Reset() {
+	*x = VerifyMFARequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFARequest) ProtoMessage() {}
+
+func (x *VerifyMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFARequest.ProtoReflect.Descriptor instead.
+func (*VerifyMFARequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VerifyMFARequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+type EnrollMFAChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollMFAChallengeRequest) Reset() {
+	*x = EnrollMFAChallengeRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollMFAChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollMFAChallengeRequest) ProtoMessage() {}
+
+func (x *EnrollMFAChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollMFAChallengeRequest.ProtoReflect.Descriptor instead.
+func (*EnrollMFAChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EnrollMFAChallengeRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+type MFAEnrollment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base32 secret for manual entry.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// otpauth:// URI to show as QR code.
+	ProvisioningUri string `protobuf:"bytes,2,opt,name=provisioning_uri,json=provisioningUri,proto3" json:"provisioning_uri,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MFAEnrollment) Reset() {
+	*x = MFAEnrollment{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAEnrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAEnrollment) ProtoMessage() {}
+
+func (x *MFAEnrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAEnrollment.ProtoReflect.Descriptor instead.
+func (*MFAEnrollment) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MFAEnrollment) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *MFAEnrollment) GetProvisioningUri() string {
+	if x != nil {
+		return x.ProvisioningUri
+	}
+	return ""
+}
+
+type MFARecoveryCodes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Shown once; each code signs in once.
+	Codes         []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MFARecoveryCodes) Reset() {
+	*x = MFARecoveryCodes{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFARecoveryCodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFARecoveryCodes) ProtoMessage() {}
+
+func (x *MFARecoveryCodes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFARecoveryCodes.ProtoReflect.Descriptor instead.
+func (*MFARecoveryCodes) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MFARecoveryCodes) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type MFAStatus struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// An enrollment was started but not confirmed.
+	Pending bool `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Policy requires MFA for the caller's roles.
+	Required               bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	ConfirmedAt            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	RecoveryCodesRemaining int32                  `protobuf:"varint,5,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3" json:"recovery_codes_remaining,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MFAStatus) Reset() {
+	*x = MFAStatus{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAStatus) ProtoMessage() {}
+
+func (x *MFAStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAStatus.ProtoReflect.Descriptor instead.
+func (*MFAStatus) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MFAStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MFAStatus) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *MFAStatus) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *MFAStatus) GetConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+func (x *MFAStatus) GetRecoveryCodesRemaining() int32 {
+	if x != nil {
+		return x.RecoveryCodesRemaining
+	}
+	return 0
+}
+
+type GetMFAStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMFAStatusRequest) Reset() {
+	*x = GetMFAStatusRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMFAStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMFAStatusRequest) ProtoMessage() {}
+
+func (x *GetMFAStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMFAStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMFAStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{23}
+}
+
+type EnrollMFARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollMFARequest) Reset() {
+	*x = EnrollMFARequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollMFARequest) ProtoMessage() {}
+
+func (x *EnrollMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollMFARequest.ProtoReflect.Descriptor instead.
+func (*EnrollMFARequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{24}
+}
+
+type ConfirmMFARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmMFARequest) Reset() {
+	*x = ConfirmMFARequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmMFARequest) ProtoMessage() {}
+
+func (x *ConfirmMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmMFARequest.ProtoReflect.Descriptor instead.
+func (*ConfirmMFARequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ConfirmMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableMFARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	RecoveryCode  string                 `protobuf:"bytes,2,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableMFARequest) Reset() {
+	*x = DisableMFARequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableMFARequest) ProtoMessage() {}
+
+func (x *DisableMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableMFARequest.ProtoReflect.Descriptor instead.
+func (*DisableMFARequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DisableMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DisableMFARequest) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+type RegenerateRecoveryCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type MFAPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Requires MFA for everyone in the tenant or on the platform.
+	RequireAll bool `protobuf:"varint,1,opt,name=require_all,json=requireAll,proto3" json:"require_all,omitempty"`
+	// Requires MFA for holders of these tenant or platform roles.
+	RequiredRoleIds []string               `protobuf:"bytes,2,rep,name=required_role_ids,json=requiredRoleIds,proto3" json:"required_role_ids,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MFAPolicy) Reset() {
+	*x = MFAPolicy{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAPolicy) ProtoMessage() {}
+
+func (x *MFAPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAPolicy.ProtoReflect.Descriptor instead.
+func (*MFAPolicy) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MFAPolicy) GetRequireAll() bool {
+	if x != nil {
+		return x.RequireAll
+	}
+	return false
+}
+
+func (x *MFAPolicy) GetRequiredRoleIds() []string {
+	if x != nil {
+		return x.RequiredRoleIds
+	}
+	return nil
+}
+
+func (x *MFAPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetMFAPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMFAPolicyRequest) Reset() {
+	*x = GetMFAPolicyRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMFAPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMFAPolicyRequest) ProtoMessage() {}
+
+func (x *GetMFAPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMFAPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetMFAPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{29}
+}
+
+type UpdateMFAPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *MFAPolicy             `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMFAPolicyRequest) Reset() {
+	*x = UpdateMFAPolicyRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMFAPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMFAPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateMFAPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMFAPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMFAPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateMFAPolicyRequest) GetPolicy() *MFAPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type MFAPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *MFAPolicy             `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MFAPolicyResponse) Reset() {
+	*x = MFAPolicyResponse{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAPolicyResponse) ProtoMessage() {}
+
+func (x *MFAPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAPolicyResponse.ProtoReflect.Descriptor instead.
+func (*MFAPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MFAPolicyResponse) GetPolicy() *MFAPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type ImpersonationSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AdminName     string                 `protobuf:"bytes,4,opt,name=admin_name,json=adminName,proto3" json:"admin_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,6,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonationSession) Reset() {
+	*x = ImpersonationSession{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonationSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonationSession) ProtoMessage() {}
+
+func (x *ImpersonationSession) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonationSession.ProtoReflect.Descriptor instead.
+func (*ImpersonationSession) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImpersonationSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImpersonationSession) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ImpersonationSession) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *ImpersonationSession) GetAdminName() string {
+	if x != nil {
+		return x.AdminName
+	}
+	return ""
+}
+
+func (x *ImpersonationSession) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImpersonationSession) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *ImpersonationSession) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ImpersonationSession) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *ImpersonationSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ImpersonateTenantRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Shown to the tenant and recorded in the audit log.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Issues a writable token; needs platform.tenant.impersonate_write.
+	Write bool `protobuf:"varint,3,opt,name=write,proto3" json:"write,omitempty"`
+	// Token lifetime; defaults to 15 minutes, capped at one hour.
+	TtlSeconds    int32 `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateTenantRequest) Reset() {
+	*x = ImpersonateTenantRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateTenantRequest) ProtoMessage() {}
+
+func (x *ImpersonateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateTenantRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImpersonateTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ImpersonateTenantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImpersonateTenantRequest) GetWrite() bool {
+	if x != nil {
+		return x.Write
+	}
+	return false
+}
+
+func (x *ImpersonateTenantRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ImpersonateTenantResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Console access token of the tenant; it cannot be refreshed.
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	Profile       *AuthProfile           `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	Session       *ImpersonationSession  `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthResponse) Reset() {
-	*x = AuthResponse{}
-	mi := &file_api_auth_v1_auth_proto_msgTypes[4]
+func (x *ImpersonateTenantResponse) Reset() {
+	*x = ImpersonateTenantResponse{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthResponse) String() string {
+func (x *ImpersonateTenantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthResponse) ProtoMessage() {}
+func (*ImpersonateTenantResponse) ProtoMessage() {}
 
-func (x *AuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_auth_v1_auth_proto_msgTypes[4]
+func (x *ImpersonateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,28 +2086,221 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
-func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ImpersonateTenantResponse.ProtoReflect.Descriptor instead.
+func (*ImpersonateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *AuthResponse) GetToken() string {
+func (x *ImpersonateTenantResponse) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *AuthResponse) GetExpiresAt() *timestamppb.Timestamp {
+func (x *ImpersonateTenantResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *AuthResponse) GetProfile() *AuthProfile {
+func (x *ImpersonateTenantResponse) GetSession() *ImpersonationSession {
 	if x != nil {
-		return x.Profile
+		return x.Session
+	}
+	return nil
+}
+
+type ListImpersonationSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Platform only; the console always lists its own tenant.
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Limit         int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImpersonationSessionsRequest) Reset() {
+	*x = ListImpersonationSessionsRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImpersonationSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImpersonationSessionsRequest) ProtoMessage() {}
+
+func (x *ListImpersonationSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImpersonationSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListImpersonationSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListImpersonationSessionsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListImpersonationSessionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListImpersonationSessionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListImpersonationSessionsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*ImpersonationSession `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImpersonationSessionsResponse) Reset() {
+	*x = ListImpersonationSessionsResponse{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImpersonationSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImpersonationSessionsResponse) ProtoMessage() {}
+
+func (x *ListImpersonationSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImpersonationSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListImpersonationSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListImpersonationSessionsResponse) GetItems() []*ImpersonationSession {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RevokeImpersonationSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeImpersonationSessionRequest) Reset() {
+	*x = RevokeImpersonationSessionRequest{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeImpersonationSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeImpersonationSessionRequest) ProtoMessage() {}
+
+func (x *RevokeImpersonationSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeImpersonationSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeImpersonationSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RevokeImpersonationSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ImpersonationSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *ImpersonationSession  `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonationSessionResponse) Reset() {
+	*x = ImpersonationSessionResponse{}
+	mi := &file_api_auth_v1_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonationSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonationSessionResponse) ProtoMessage() {}
+
+func (x *ImpersonationSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_v1_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonationSessionResponse.ProtoReflect.Descriptor instead.
+func (*ImpersonationSessionResponse) Descriptor() ([]byte, []int) {
+	return file_api_auth_v1_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImpersonationSessionResponse) GetSession() *ImpersonationSession {
+	if x != nil {
+		return x.Session
 	}
 	return nil
 }
@@ -359,7 +2309,7 @@ var File_api_auth_v1_auth_proto protoreflect.FileDescriptor
 
 const file_api_auth_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x16api/auth/v1/auth.proto\x12\vapi.auth.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"h\n" +
+	"\x16api/auth/v1/auth.proto\x12\vapi.auth.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"h\n" +
 	"\x13ConsoleLoginRequest\x12\x18\n" +
 	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1b\n" +
@@ -383,17 +2333,200 @@ const file_api_auth_v1_auth_proto_rawDesc = "" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x18\n" +
 	"\aaccount\x18\x03 \x01(\tR\aaccount\x12\x12\n" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
-	"\x05roles\x18\x05 \x03(\tR\x05roles\"\x93\x01\n" +
+	"\x05roles\x18\x05 \x03(\tR\x05roles\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x0f\n" +
+	"\rLogoutRequest\"\xe3\x03\n" +
 	"\fAuthResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
 	"\n" +
 	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x122\n" +
-	"\aprofile\x18\x03 \x01(\v2\x18.api.auth.v1.AuthProfileR\aprofile2\xde\x01\n" +
+	"\aprofile\x18\x03 \x01(\v2\x18.api.auth.v1.AuthProfileR\aprofile\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12H\n" +
+	"\x12refresh_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x10refreshExpiresAt\x12!\n" +
+	"\fmfa_required\x18\x06 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\a \x01(\tR\bmfaToken\x12@\n" +
+	"\x0emfa_expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fmfaExpiresAt\x126\n" +
+	"\x17mfa_enrollment_required\x18\t \x01(\bR\x15mfaEnrollmentRequired\x12%\n" +
+	"\x0erecovery_codes\x18\n" +
+	" \x03(\tR\rrecoveryCodes\"[\n" +
+	"\x13AcceptInviteRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"T\n" +
+	"\x1bRequestPasswordResetRequest\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\"H\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\".\n" +
+	"\x0fStartSSORequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"Q\n" +
+	"\x10StartSSOResponse\x12!\n" +
+	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\"2\n" +
+	"\x18ExchangeSSOTicketRequest\x12\x16\n" +
+	"\x06ticket\x18\x01 \x01(\tR\x06ticket\"U\n" +
+	"\x0eSSORoleMapping\x12\x14\n" +
+	"\x05claim\x18\x01 \x01(\tR\x05claim\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\tR\x06roleId\"\xce\x06\n" +
+	"\tSSOConfig\x12\x1a\n" +
+	"\bprotocol\x18\x01 \x01(\tR\bprotocol\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x1f\n" +
+	"\venforce_sso\x18\x03 \x01(\bR\n" +
+	"enforceSso\x12)\n" +
+	"\x10jit_provisioning\x18\x04 \x01(\bR\x0fjitProvisioning\x12&\n" +
+	"\x0fdefault_role_id\x18\x05 \x01(\tR\rdefaultRoleId\x12\x1f\n" +
+	"\voidc_issuer\x18\x06 \x01(\tR\n" +
+	"oidcIssuer\x12$\n" +
+	"\x0eoidc_client_id\x18\a \x01(\tR\foidcClientId\x12,\n" +
+	"\x12oidc_client_secret\x18\b \x01(\tR\x10oidcClientSecret\x123\n" +
+	"\x16oidc_client_secret_set\x18\t \x01(\bR\x13oidcClientSecretSet\x12\x1f\n" +
+	"\voidc_scopes\x18\n" +
+	" \x03(\tR\n" +
+	"oidcScopes\x12+\n" +
+	"\x12saml_idp_entity_id\x18\v \x01(\tR\x0fsamlIdpEntityId\x12'\n" +
+	"\x10saml_idp_sso_url\x18\f \x01(\tR\rsamlIdpSsoUrl\x120\n" +
+	"\x14saml_idp_certificate\x18\r \x01(\tR\x12samlIdpCertificate\x12'\n" +
+	"\x0femail_attribute\x18\x0e \x01(\tR\x0eemailAttribute\x12%\n" +
+	"\x0ename_attribute\x18\x0f \x01(\tR\rnameAttribute\x12@\n" +
+	"\rrole_mappings\x18\x10 \x03(\v2\x1b.api.auth.v1.SSORoleMappingR\froleMappings\x12*\n" +
+	"\x11oidc_redirect_uri\x18\x11 \x01(\tR\x0foidcRedirectUri\x12)\n" +
+	"\x11saml_sp_entity_id\x18\x12 \x01(\tR\x0esamlSpEntityId\x12 \n" +
+	"\fsaml_acs_url\x18\x13 \x01(\tR\n" +
+	"samlAcsUrl\x129\n" +
+	"\n" +
+	"updated_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x15\n" +
+	"\x13GetSSOConfigRequest\"H\n" +
+	"\x16UpdateSSOConfigRequest\x12.\n" +
+	"\x06config\x18\x01 \x01(\v2\x16.api.auth.v1.SSOConfigR\x06config\"C\n" +
+	"\x11SSOConfigResponse\x12.\n" +
+	"\x06config\x18\x01 \x01(\v2\x16.api.auth.v1.SSOConfigR\x06config\"h\n" +
+	"\x10VerifyMFARequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12#\n" +
+	"\rrecovery_code\x18\x03 \x01(\tR\frecoveryCode\"8\n" +
+	"\x19EnrollMFAChallengeRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\"R\n" +
+	"\rMFAEnrollment\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12)\n" +
+	"\x10provisioning_uri\x18\x02 \x01(\tR\x0fprovisioningUri\"(\n" +
+	"\x10MFARecoveryCodes\x12\x14\n" +
+	"\x05codes\x18\x01 \x03(\tR\x05codes\"\xd4\x01\n" +
+	"\tMFAStatus\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\apending\x18\x02 \x01(\bR\apending\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12=\n" +
+	"\fconfirmed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vconfirmedAt\x128\n" +
+	"\x18recovery_codes_remaining\x18\x05 \x01(\x05R\x16recoveryCodesRemaining\"\x15\n" +
+	"\x13GetMFAStatusRequest\"\x12\n" +
+	"\x10EnrollMFARequest\"'\n" +
+	"\x11ConfirmMFARequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"L\n" +
+	"\x11DisableMFARequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12#\n" +
+	"\rrecovery_code\x18\x02 \x01(\tR\frecoveryCode\"4\n" +
+	"\x1eRegenerateRecoveryCodesRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x93\x01\n" +
+	"\tMFAPolicy\x12\x1f\n" +
+	"\vrequire_all\x18\x01 \x01(\bR\n" +
+	"requireAll\x12*\n" +
+	"\x11required_role_ids\x18\x02 \x03(\tR\x0frequiredRoleIds\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x15\n" +
+	"\x13GetMFAPolicyRequest\"H\n" +
+	"\x16UpdateMFAPolicyRequest\x12.\n" +
+	"\x06policy\x18\x01 \x01(\v2\x16.api.auth.v1.MFAPolicyR\x06policy\"C\n" +
+	"\x11MFAPolicyResponse\x12.\n" +
+	"\x06policy\x18\x01 \x01(\v2\x16.api.auth.v1.MFAPolicyR\x06policy\"\xe3\x02\n" +
+	"\x14ImpersonationSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\tR\aadminId\x12\x1d\n" +
+	"\n" +
+	"admin_name\x18\x04 \x01(\tR\tadminName\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tread_only\x18\x06 \x01(\bR\breadOnly\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x86\x01\n" +
+	"\x18ImpersonateTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05write\x18\x03 \x01(\bR\x05write\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x05R\n" +
+	"ttlSeconds\"\xa9\x01\n" +
+	"\x19ImpersonateTenantResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\asession\x18\x03 \x01(\v2!.api.auth.v1.ImpersonationSessionR\asession\"m\n" +
+	" ListImpersonationSessionsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\\\n" +
+	"!ListImpersonationSessionsResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.api.auth.v1.ImpersonationSessionR\x05items\"3\n" +
+	"!RevokeImpersonationSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"[\n" +
+	"\x1cImpersonationSessionResponse\x12;\n" +
+	"\asession\x18\x01 \x01(\v2!.api.auth.v1.ImpersonationSessionR\asession2\xc4\n" +
+	"\n" +
 	"\vConsoleAuth\x12k\n" +
 	"\bRegister\x12#.api.auth.v1.ConsoleRegisterRequest\x1a\x19.api.auth.v1.AuthResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/console/v1/register\x12b\n" +
-	"\x05Login\x12 .api.auth.v1.ConsoleLoginRequest\x1a\x19.api.auth.v1.AuthResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/console/v1/login2t\n" +
+	"\x05Login\x12 .api.auth.v1.ConsoleLoginRequest\x1a\x19.api.auth.v1.AuthResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/console/v1/login\x12l\n" +
+	"\aRefresh\x12 .api.auth.v1.RefreshTokenRequest\x1a\x19.api.auth.v1.AuthResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/console/v1/token/refresh\x12[\n" +
+	"\x06Logout\x12\x1a.api.auth.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/console/v1/logout\x12b\n" +
+	"\tLogoutAll\x12\x1a.api.auth.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/console/v1/logout_all\x12f\n" +
+	"\bStartSSO\x12\x1c.api.auth.v1.StartSSORequest\x1a\x1d.api.auth.v1.StartSSOResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/console/v1/sso/start\x12w\n" +
+	"\x11ExchangeSSOTicket\x12%.api.auth.v1.ExchangeSSOTicketRequest\x1a\x19.api.auth.v1.AuthResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/console/v1/sso/token\x12g\n" +
+	"\tVerifyMFA\x12\x1d.api.auth.v1.VerifyMFARequest\x1a\x19.api.auth.v1.AuthResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/console/v1/login/mfa\x12\x81\x01\n" +
+	"\x12EnrollMFAChallenge\x12&.api.auth.v1.EnrollMFAChallengeRequest\x1a\x1a.api.auth.v1.MFAEnrollment\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/console/v1/login/mfa/enroll\x12q\n" +
+	"\fAcceptInvite\x12 .api.auth.v1.AcceptInviteRequest\x1a\x19.api.auth.v1.AuthResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/console/v1/invite/accept\x12\x80\x01\n" +
+	"\x14RequestPasswordReset\x12(.api.auth.v1.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/console/v1/password/forgot\x12q\n" +
+	"\rResetPassword\x12!.api.auth.v1.ResetPasswordRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/console/v1/password/reset2\xb5\x06\n" +
+	"\n" +
+	"ConsoleMFA\x12a\n" +
+	"\fGetMFAStatus\x12 .api.auth.v1.GetMFAStatusRequest\x1a\x16.api.auth.v1.MFAStatus\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/console/v1/mfa\x12i\n" +
+	"\tEnrollMFA\x12\x1d.api.auth.v1.EnrollMFARequest\x1a\x1a.api.auth.v1.MFAEnrollment\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/console/v1/mfa/enroll\x12o\n" +
+	"\n" +
+	"ConfirmMFA\x12\x1e.api.auth.v1.ConfirmMFARequest\x1a\x1d.api.auth.v1.MFARecoveryCodes\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/console/v1/mfa/confirm\x12h\n" +
+	"\n" +
+	"DisableMFA\x12\x1e.api.auth.v1.DisableMFARequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/console/v1/mfa/disable\x12\x90\x01\n" +
+	"\x17RegenerateRecoveryCodes\x12+.api.auth.v1.RegenerateRecoveryCodesRequest\x1a\x1d.api.auth.v1.MFARecoveryCodes\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/console/v1/mfa/recovery_codes\x12p\n" +
+	"\fGetMFAPolicy\x12 .api.auth.v1.GetMFAPolicyRequest\x1a\x1e.api.auth.v1.MFAPolicyResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/console/v1/mfa/policy\x12y\n" +
+	"\x0fUpdateMFAPolicy\x12#.api.auth.v1.UpdateMFAPolicyRequest\x1a\x1e.api.auth.v1.MFAPolicyResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/console/v1/mfa/policy2\xf9\x01\n" +
+	"\n" +
+	"ConsoleSSO\x12p\n" +
+	"\fGetSSOConfig\x12 .api.auth.v1.GetSSOConfigRequest\x1a\x1e.api.auth.v1.SSOConfigResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/console/v1/sso/config\x12y\n" +
+	"\x0fUpdateSSOConfig\x12#.api.auth.v1.UpdateSSOConfigRequest\x1a\x1e.api.auth.v1.SSOConfigResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/console/v1/sso/config2\x95\x05\n" +
 	"\fPlatformAuth\x12d\n" +
-	"\x05Login\x12!.api.auth.v1.PlatformLoginRequest\x1a\x19.api.auth.v1.AuthResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/platform/v1/loginB5Z3github.com/ZTH7/RagoDesk/apps/server/api/auth/v1;v1b\x06proto3"
+	"\x05Login\x12!.api.auth.v1.PlatformLoginRequest\x1a\x19.api.auth.v1.AuthResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/platform/v1/login\x12m\n" +
+	"\aRefresh\x12 .api.auth.v1.RefreshTokenRequest\x1a\x19.api.auth.v1.AuthResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/platform/v1/token/refresh\x12\\\n" +
+	"\x06Logout\x12\x1a.api.auth.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/platform/v1/logout\x12c\n" +
+	"\tLogoutAll\x12\x1a.api.auth.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/platform/v1/logout_all\x12h\n" +
+	"\tVerifyMFA\x12\x1d.api.auth.v1.VerifyMFARequest\x1a\x19.api.auth.v1.AuthResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/platform/v1/login/mfa\x12\x82\x01\n" +
+	"\x12EnrollMFAChallenge\x12&.api.auth.v1.EnrollMFAChallengeRequest\x1a\x1a.api.auth.v1.MFAEnrollment\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/platform/v1/login/mfa/enroll2\xbd\x06\n" +
+	"\vPlatformMFA\x12b\n" +
+	"\fGetMFAStatus\x12 .api.auth.v1.GetMFAStatusRequest\x1a\x16.api.auth.v1.MFAStatus\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/platform/v1/mfa\x12j\n" +
+	"\tEnrollMFA\x12\x1d.api.auth.v1.EnrollMFARequest\x1a\x1a.api.auth.v1.MFAEnrollment\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/platform/v1/mfa/enroll\x12p\n" +
+	"\n" +
+	"ConfirmMFA\x12\x1e.api.auth.v1.ConfirmMFARequest\x1a\x1d.api.auth.v1.MFARecoveryCodes\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/platform/v1/mfa/confirm\x12i\n" +
+	"\n" +
+	"DisableMFA\x12\x1e.api.auth.v1.DisableMFARequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/platform/v1/mfa/disable\x12\x91\x01\n" +
+	"\x17RegenerateRecoveryCodes\x12+.api.auth.v1.RegenerateRecoveryCodesRequest\x1a\x1d.api.auth.v1.MFARecoveryCodes\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/platform/v1/mfa/recovery_codes\x12q\n" +
+	"\fGetMFAPolicy\x12 .api.auth.v1.GetMFAPolicyRequest\x1a\x1e.api.auth.v1.MFAPolicyResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/platform/v1/mfa/policy\x12z\n" +
+	"\x0fUpdateMFAPolicy\x12#.api.auth.v1.UpdateMFAPolicyRequest\x1a\x1e.api.auth.v1.MFAPolicyResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/platform/v1/mfa/policy2\x95\x04\n" +
+	"\x15PlatformImpersonation\x12\x9b\x01\n" +
+	"\x11ImpersonateTenant\x12%.api.auth.v1.ImpersonateTenantRequest\x1a&.api.auth.v1.ImpersonateTenantResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/platform/v1/tenants/{tenant_id}/impersonate\x12\xa7\x01\n" +
+	"\x19ListImpersonationSessions\x12-.api.auth.v1.ListImpersonationSessionsRequest\x1a..api.auth.v1.ListImpersonationSessionsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/platform/v1/impersonation_sessions\x12\xb3\x01\n" +
+	"\x1aRevokeImpersonationSession\x12..api.auth.v1.RevokeImpersonationSessionRequest\x1a).api.auth.v1.ImpersonationSessionResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//platform/v1/impersonation_sessions/{id}/revoke2\xbf\x01\n" +
+	"\x14ConsoleImpersonation\x12\xa6\x01\n" +
+	"\x19ListImpersonationSessions\x12-.api.auth.v1.ListImpersonationSessionsRequest\x1a..api.auth.v1.ListImpersonationSessionsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/console/v1/impersonation_sessionsB5Z3github.com/ZTH7/RagoDesk/apps/server/api/auth/v1;v1b\x06proto3"
 
 var (
 	file_api_auth_v1_auth_proto_rawDescOnce sync.Once
@@ -407,29 +2540,151 @@ func file_api_auth_v1_auth_proto_rawDescGZIP() []byte {
 	return file_api_auth_v1_auth_proto_rawDescData
 }
 
-var file_api_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_api_auth_v1_auth_proto_goTypes = []any{
-	(*ConsoleLoginRequest)(nil),    // 0: api.auth.v1.ConsoleLoginRequest
-	(*PlatformLoginRequest)(nil),   // 1: api.auth.v1.PlatformLoginRequest
-	(*ConsoleRegisterRequest)(nil), // 2: api.auth.v1.ConsoleRegisterRequest
-	(*AuthProfile)(nil),            // 3: api.auth.v1.AuthProfile
-	(*AuthResponse)(nil),           // 4: api.auth.v1.AuthResponse
-	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+	(*ConsoleLoginRequest)(nil),               // 0: api.auth.v1.ConsoleLoginRequest
+	(*PlatformLoginRequest)(nil),              // 1: api.auth.v1.PlatformLoginRequest
+	(*ConsoleRegisterRequest)(nil),            // 2: api.auth.v1.ConsoleRegisterRequest
+	(*AuthProfile)(nil),                       // 3: api.auth.v1.AuthProfile
+	(*RefreshTokenRequest)(nil),               // 4: api.auth.v1.RefreshTokenRequest
+	(*LogoutRequest)(nil),                     // 5: api.auth.v1.LogoutRequest
+	(*AuthResponse)(nil),                      // 6: api.auth.v1.AuthResponse
+	(*AcceptInviteRequest)(nil),               // 7: api.auth.v1.AcceptInviteRequest
+	(*RequestPasswordResetRequest)(nil),       // 8: api.auth.v1.RequestPasswordResetRequest
+	(*ResetPasswordRequest)(nil),              // 9: api.auth.v1.ResetPasswordRequest
+	(*StartSSORequest)(nil),                   // 10: api.auth.v1.StartSSORequest
+	(*StartSSOResponse)(nil),                  // 11: api.auth.v1.StartSSOResponse
+	(*ExchangeSSOTicketRequest)(nil),          // 12: api.auth.v1.ExchangeSSOTicketRequest
+	(*SSORoleMapping)(nil),                    // 13: api.auth.v1.SSORoleMapping
+	(*SSOConfig)(nil),                         // 14: api.auth.v1.SSOConfig
+	(*GetSSOConfigRequest)(nil),               // 15: api.auth.v1.GetSSOConfigRequest
+	(*UpdateSSOConfigRequest)(nil),            // 16: api.auth.v1.UpdateSSOConfigRequest
+	(*SSOConfigResponse)(nil),                 // 17: api.auth.v1.SSOConfigResponse
+	(*VerifyMFARequest)(nil),                  // 18: api.auth.v1.VerifyMFARequest
+	(*EnrollMFAChallengeRequest)(nil),         // 19: api.auth.v1.EnrollMFAChallengeRequest
+	(*MFAEnrollment)(nil),                     // 20: api.auth.v1.MFAEnrollment
+	(*MFARecoveryCodes)(nil),                  // 21: api.auth.v1.MFARecoveryCodes
+	(*MFAStatus)(nil),                         // 22: api.auth.v1.MFAStatus
+	(*GetMFAStatusRequest)(nil),               // 23: api.auth.v1.GetMFAStatusRequest
+	(*EnrollMFARequest)(nil),                  // 24: api.auth.v1.EnrollMFARequest
+	(*ConfirmMFARequest)(nil),                 // 25: api.auth.v1.ConfirmMFARequest
+	(*DisableMFARequest)(nil),                 // 26: api.auth.v1.DisableMFARequest
+	(*RegenerateRecoveryCodesRequest)(nil),    // 27: api.auth.v1.RegenerateRecoveryCodesRequest
+	(*MFAPolicy)(nil),                         // 28: api.auth.v1.MFAPolicy
+	(*GetMFAPolicyRequest)(nil),               // 29: api.auth.v1.GetMFAPolicyRequest
+	(*UpdateMFAPolicyRequest)(nil),            // 30: api.auth.v1.UpdateMFAPolicyRequest
+	(*MFAPolicyResponse)(nil),                 // 31: api.auth.v1.MFAPolicyResponse
+	(*ImpersonationSession)(nil),              // 32: api.auth.v1.ImpersonationSession
+	(*ImpersonateTenantRequest)(nil),          // 33: api.auth.v1.ImpersonateTenantRequest
+	(*ImpersonateTenantResponse)(nil),         // 34: api.auth.v1.ImpersonateTenantResponse
+	(*ListImpersonationSessionsRequest)(nil),  // 35: api.auth.v1.ListImpersonationSessionsRequest
+	(*ListImpersonationSessionsResponse)(nil), // 36: api.auth.v1.ListImpersonationSessionsResponse
+	(*RevokeImpersonationSessionRequest)(nil), // 37: api.auth.v1.RevokeImpersonationSessionRequest
+	(*ImpersonationSessionResponse)(nil),      // 38: api.auth.v1.ImpersonationSessionResponse
+	(*timestamppb.Timestamp)(nil),             // 39: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 40: google.protobuf.Empty
 }
 var file_api_auth_v1_auth_proto_depIdxs = []int32{
-	5, // 0: api.auth.v1.AuthResponse.expires_at:type_name -> google.protobuf.Timestamp
-	3, // 1: api.auth.v1.AuthResponse.profile:type_name -> api.auth.v1.AuthProfile
-	2, // 2: api.auth.v1.ConsoleAuth.Register:input_type -> api.auth.v1.ConsoleRegisterRequest
-	0, // 3: api.auth.v1.ConsoleAuth.Login:input_type -> api.auth.v1.ConsoleLoginRequest
-	1, // 4: api.auth.v1.PlatformAuth.Login:input_type -> api.auth.v1.PlatformLoginRequest
-	4, // 5: api.auth.v1.ConsoleAuth.Register:output_type -> api.auth.v1.AuthResponse
-	4, // 6: api.auth.v1.ConsoleAuth.Login:output_type -> api.auth.v1.AuthResponse
-	4, // 7: api.auth.v1.PlatformAuth.Login:output_type -> api.auth.v1.AuthResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	39, // 0: api.auth.v1.AuthResponse.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 1: api.auth.v1.AuthResponse.profile:type_name -> api.auth.v1.AuthProfile
+	39, // 2: api.auth.v1.AuthResponse.refresh_expires_at:type_name -> google.protobuf.Timestamp
+	39, // 3: api.auth.v1.AuthResponse.mfa_expires_at:type_name -> google.protobuf.Timestamp
+	13, // 4: api.auth.v1.SSOConfig.role_mappings:type_name -> api.auth.v1.SSORoleMapping
+	39, // 5: api.auth.v1.SSOConfig.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 6: api.auth.v1.UpdateSSOConfigRequest.config:type_name -> api.auth.v1.SSOConfig
+	14, // 7: api.auth.v1.SSOConfigResponse.config:type_name -> api.auth.v1.SSOConfig
+	39, // 8: api.auth.v1.MFAStatus.confirmed_at:type_name -> google.protobuf.Timestamp
+	39, // 9: api.auth.v1.MFAPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	28, // 10: api.auth.v1.UpdateMFAPolicyRequest.policy:type_name -> api.auth.v1.MFAPolicy
+	28, // 11: api.auth.v1.MFAPolicyResponse.policy:type_name -> api.auth.v1.MFAPolicy
+	39, // 12: api.auth.v1.ImpersonationSession.expires_at:type_name -> google.protobuf.Timestamp
+	39, // 13: api.auth.v1.ImpersonationSession.revoked_at:type_name -> google.protobuf.Timestamp
+	39, // 14: api.auth.v1.ImpersonationSession.created_at:type_name -> google.protobuf.Timestamp
+	39, // 15: api.auth.v1.ImpersonateTenantResponse.expires_at:type_name -> google.protobuf.Timestamp
+	32, // 16: api.auth.v1.ImpersonateTenantResponse.session:type_name -> api.auth.v1.ImpersonationSession
+	32, // 17: api.auth.v1.ListImpersonationSessionsResponse.items:type_name -> api.auth.v1.ImpersonationSession
+	32, // 18: api.auth.v1.ImpersonationSessionResponse.session:type_name -> api.auth.v1.ImpersonationSession
+	2,  // 19: api.auth.v1.ConsoleAuth.Register:input_type -> api.auth.v1.ConsoleRegisterRequest
+	0,  // 20: api.auth.v1.ConsoleAuth.Login:input_type -> api.auth.v1.ConsoleLoginRequest
+	4,  // 21: api.auth.v1.ConsoleAuth.Refresh:input_type -> api.auth.v1.RefreshTokenRequest
+	5,  // 22: api.auth.v1.ConsoleAuth.Logout:input_type -> api.auth.v1.LogoutRequest
+	5,  // 23: api.auth.v1.ConsoleAuth.LogoutAll:input_type -> api.auth.v1.LogoutRequest
+	10, // 24: api.auth.v1.ConsoleAuth.StartSSO:input_type -> api.auth.v1.StartSSORequest
+	12, // 25: api.auth.v1.ConsoleAuth.ExchangeSSOTicket:input_type -> api.auth.v1.ExchangeSSOTicketRequest
+	18, // 26: api.auth.v1.ConsoleAuth.VerifyMFA:input_type -> api.auth.v1.VerifyMFARequest
+	19, // 27: api.auth.v1.ConsoleAuth.EnrollMFAChallenge:input_type -> api.auth.v1.EnrollMFAChallengeRequest
+	7,  // 28: api.auth.v1.ConsoleAuth.AcceptInvite:input_type -> api.auth.v1.AcceptInviteRequest
+	8,  // 29: api.auth.v1.ConsoleAuth.RequestPasswordReset:input_type -> api.auth.v1.RequestPasswordResetRequest
+	9,  // 30: api.auth.v1.ConsoleAuth.ResetPassword:input_type -> api.auth.v1.ResetPasswordRequest
+	23, // 31: api.auth.v1.ConsoleMFA.GetMFAStatus:input_type -> api.auth.v1.GetMFAStatusRequest
+	24, // 32: api.auth.v1.ConsoleMFA.EnrollMFA:input_type -> api.auth.v1.EnrollMFARequest
+	25, // 33: api.auth.v1.ConsoleMFA.ConfirmMFA:input_type -> api.auth.v1.ConfirmMFARequest
+	26, // 34: api.auth.v1.ConsoleMFA.DisableMFA:input_type -> api.auth.v1.DisableMFARequest
+	27, // 35: api.auth.v1.ConsoleMFA.RegenerateRecoveryCodes:input_type -> api.auth.v1.RegenerateRecoveryCodesRequest
+	29, // 36: api.auth.v1.ConsoleMFA.GetMFAPolicy:input_type -> api.auth.v1.GetMFAPolicyRequest
+	30, // 37: api.auth.v1.ConsoleMFA.UpdateMFAPolicy:input_type -> api.auth.v1.UpdateMFAPolicyRequest
+	15, // 38: api.auth.v1.ConsoleSSO.GetSSOConfig:input_type -> api.auth.v1.GetSSOConfigRequest
+	16, // 39: api.auth.v1.ConsoleSSO.UpdateSSOConfig:input_type -> api.auth.v1.UpdateSSOConfigRequest
+	1,  // 40: api.auth.v1.PlatformAuth.Login:input_type -> api.auth.v1.PlatformLoginRequest
+	4,  // 41: api.auth.v1.PlatformAuth.Refresh:input_type -> api.auth.v1.RefreshTokenRequest
+	5,  // 42: api.auth.v1.PlatformAuth.Logout:input_type -> api.auth.v1.LogoutRequest
+	5,  // 43: api.auth.v1.PlatformAuth.LogoutAll:input_type -> api.auth.v1.LogoutRequest
+	18, // 44: api.auth.v1.PlatformAuth.VerifyMFA:input_type -> api.auth.v1.VerifyMFARequest
+	19, // 45: api.auth.v1.PlatformAuth.EnrollMFAChallenge:input_type -> api.auth.v1.EnrollMFAChallengeRequest
+	23, // 46: api.auth.v1.PlatformMFA.GetMFAStatus:input_type -> api.auth.v1.GetMFAStatusRequest
+	24, // 47: api.auth.v1.PlatformMFA.EnrollMFA:input_type -> api.auth.v1.EnrollMFARequest
+	25, // 48: api.auth.v1.PlatformMFA.ConfirmMFA:input_type -> api.auth.v1.ConfirmMFARequest
+	26, // 49: api.auth.v1.PlatformMFA.DisableMFA:input_type -> api.auth.v1.DisableMFARequest
+	27, // 50: api.auth.v1.PlatformMFA.RegenerateRecoveryCodes:input_type -> api.auth.v1.RegenerateRecoveryCodesRequest
+	29, // 51: api.auth.v1.PlatformMFA.GetMFAPolicy:input_type -> api.auth.v1.GetMFAPolicyRequest
+	30, // 52: api.auth.v1.PlatformMFA.UpdateMFAPolicy:input_type -> api.auth.v1.UpdateMFAPolicyRequest
+	33, // 53: api.auth.v1.PlatformImpersonation.ImpersonateTenant:input_type -> api.auth.v1.ImpersonateTenantRequest
+	35, // 54: api.auth.v1.PlatformImpersonation.ListImpersonationSessions:input_type -> api.auth.v1.ListImpersonationSessionsRequest
+	37, // 55: api.auth.v1.PlatformImpersonation.RevokeImpersonationSession:input_type -> api.auth.v1.RevokeImpersonationSessionRequest
+	35, // 56: api.auth.v1.ConsoleImpersonation.ListImpersonationSessions:input_type -> api.auth.v1.ListImpersonationSessionsRequest
+	6,  // 57: api.auth.v1.ConsoleAuth.Register:output_type -> api.auth.v1.AuthResponse
+	6,  // 58: api.auth.v1.ConsoleAuth.Login:output_type -> api.auth.v1.AuthResponse
+	6,  // 59: api.auth.v1.ConsoleAuth.Refresh:output_type -> api.auth.v1.AuthResponse
+	40, // 60: api.auth.v1.ConsoleAuth.Logout:output_type -> google.protobuf.Empty
+	40, // 61: api.auth.v1.ConsoleAuth.LogoutAll:output_type -> google.protobuf.Empty
+	11, // 62: api.auth.v1.ConsoleAuth.StartSSO:output_type -> api.auth.v1.StartSSOResponse
+	6,  // 63: api.auth.v1.ConsoleAuth.ExchangeSSOTicket:output_type -> api.auth.v1.AuthResponse
+	6,  // 64: api.auth.v1.ConsoleAuth.VerifyMFA:output_type -> api.auth.v1.AuthResponse
+	20, // 65: api.auth.v1.ConsoleAuth.EnrollMFAChallenge:output_type -> api.auth.v1.MFAEnrollment
+	6,  // 66: api.auth.v1.ConsoleAuth.AcceptInvite:output_type -> api.auth.v1.AuthResponse
+	40, // 67: api.auth.v1.ConsoleAuth.RequestPasswordReset:output_type -> google.protobuf.Empty
+	40, // 68: api.auth.v1.ConsoleAuth.ResetPassword:output_type -> google.protobuf.Empty
+	22, // 69: api.auth.v1.ConsoleMFA.GetMFAStatus:output_type -> api.auth.v1.MFAStatus
+	20, // 70: api.auth.v1.ConsoleMFA.EnrollMFA:output_type -> api.auth.v1.MFAEnrollment
+	21, // 71: api.auth.v1.ConsoleMFA.ConfirmMFA:output_type -> api.auth.v1.MFARecoveryCodes
+	40, // 72: api.auth.v1.ConsoleMFA.DisableMFA:output_type -> google.protobuf.Empty
+	21, // 73: api.auth.v1.ConsoleMFA.RegenerateRecoveryCodes:output_type -> api.auth.v1.MFARecoveryCodes
+	31, // 74: api.auth.v1.ConsoleMFA.GetMFAPolicy:output_type -> api.auth.v1.MFAPolicyResponse
+	31, // 75: api.auth.v1.ConsoleMFA.UpdateMFAPolicy:output_type -> api.auth.v1.MFAPolicyResponse
+	17, // 76: api.auth.v1.ConsoleSSO.GetSSOConfig:output_type -> api.auth.v1.SSOConfigResponse
+	17, // 77: api.auth.v1.ConsoleSSO.UpdateSSOConfig:output_type -> api.auth.v1.SSOConfigResponse
+	6,  // 78: api.auth.v1.PlatformAuth.Login:output_type -> api.auth.v1.AuthResponse
+	6,  // 79: api.auth.v1.PlatformAuth.Refresh:output_type -> api.auth.v1.AuthResponse
+	40, // 80: api.auth.v1.PlatformAuth.Logout:output_type -> google.protobuf.Empty
+	40, // 81: api.auth.v1.PlatformAuth.LogoutAll:output_type -> google.protobuf.Empty
+	6,  // 82: api.auth.v1.PlatformAuth.VerifyMFA:output_type -> api.auth.v1.AuthResponse
+	20, // 83: api.auth.v1.PlatformAuth.EnrollMFAChallenge:output_type -> api.auth.v1.MFAEnrollment
+	22, // 84: api.auth.v1.PlatformMFA.GetMFAStatus:output_type -> api.auth.v1.MFAStatus
+	20, // 85: api.auth.v1.PlatformMFA.EnrollMFA:output_type -> api.auth.v1.MFAEnrollment
+	21, // 86: api.auth.v1.PlatformMFA.ConfirmMFA:output_type -> api.auth.v1.MFARecoveryCodes
+	40, // 87: api.auth.v1.PlatformMFA.DisableMFA:output_type -> google.protobuf.Empty
+	21, // 88: api.auth.v1.PlatformMFA.RegenerateRecoveryCodes:output_type -> api.auth.v1.MFARecoveryCodes
+	31, // 89: api.auth.v1.PlatformMFA.GetMFAPolicy:output_type -> api.auth.v1.MFAPolicyResponse
+	31, // 90: api.auth.v1.PlatformMFA.UpdateMFAPolicy:output_type -> api.auth.v1.MFAPolicyResponse
+	34, // 91: api.auth.v1.PlatformImpersonation.ImpersonateTenant:output_type -> api.auth.v1.ImpersonateTenantResponse
+	36, // 92: api.auth.v1.PlatformImpersonation.ListImpersonationSessions:output_type -> api.auth.v1.ListImpersonationSessionsResponse
+	38, // 93: api.auth.v1.PlatformImpersonation.RevokeImpersonationSession:output_type -> api.auth.v1.ImpersonationSessionResponse
+	36, // 94: api.auth.v1.ConsoleImpersonation.ListImpersonationSessions:output_type -> api.auth.v1.ListImpersonationSessionsResponse
+	57, // [57:95] is the sub-list for method output_type
+	19, // [19:57] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_api_auth_v1_auth_proto_init() }
@@ -443,9 +2698,9 @@ func file_api_auth_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_auth_v1_auth_proto_rawDesc), len(file_api_auth_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   39,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   7,
 		},
 		GoTypes:           file_api_auth_v1_auth_proto_goTypes,
 		DependencyIndexes: file_api_auth_v1_auth_proto_depIdxs,
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,8 +20,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ConsoleAuth_Register_FullMethodName = "/api.auth.v1.ConsoleAuth/Register"
-	ConsoleAuth_Login_FullMethodName    = "/api.auth.v1.ConsoleAuth/Login"
+	ConsoleAuth_Register_FullMethodName             = "/api.auth.v1.ConsoleAuth/Register"
+	ConsoleAuth_Login_FullMethodName                = "/api.auth.v1.ConsoleAuth/Login"
+	ConsoleAuth_Refresh_FullMethodName              = "/api.auth.v1.ConsoleAuth/Refresh"
+	ConsoleAuth_Logout_FullMethodName               = "/api.auth.v1.ConsoleAuth/Logout"
+	ConsoleAuth_LogoutAll_FullMethodName            = "/api.auth.v1.ConsoleAuth/LogoutAll"
+	ConsoleAuth_StartSSO_FullMethodName             = "/api.auth.v1.ConsoleAuth/StartSSO"
+	ConsoleAuth_ExchangeSSOTicket_FullMethodName    = "/api.auth.v1.ConsoleAuth/ExchangeSSOTicket"
+	ConsoleAuth_VerifyMFA_FullMethodName            = "/api.auth.v1.ConsoleAuth/VerifyMFA"
+	ConsoleAuth_EnrollMFAChallenge_FullMethodName   = "/api.auth.v1.ConsoleAuth/EnrollMFAChallenge"
+	ConsoleAuth_AcceptInvite_FullMethodName         = "/api.auth.v1.ConsoleAuth/AcceptInvite"
+	ConsoleAuth_RequestPasswordReset_FullMethodName = "/api.auth.v1.ConsoleAuth/RequestPasswordReset"
+	ConsoleAuth_ResetPassword_FullMethodName        = "/api.auth.v1.ConsoleAuth/ResetPassword"
 )
 
 // ConsoleAuthClient is the client API for ConsoleAuth service.
@@ -29,6 +40,29 @@ const (
 type ConsoleAuthClient interface {
 	Register(ctx context.Context, in *ConsoleRegisterRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	Login(ctx context.Context, in *ConsoleLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Refresh(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// Logout revokes the session of the calling access token.
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// LogoutAll revokes every session of the calling user.
+	LogoutAll(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// StartSSO returns the IdP URL that begins a single sign-on login.
+	StartSSO(ctx context.Context, in *StartSSORequest, opts ...grpc.CallOption) (*StartSSOResponse, error)
+	// ExchangeSSOTicket redeems the one-time ticket the IdP callback
+	// redirected the browser with.
+	ExchangeSSOTicket(ctx context.Context, in *ExchangeSSOTicketRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// VerifyMFA completes a login that returned mfa_required.
+	VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// EnrollMFAChallenge starts the enrollment of a login that returned
+	// mfa_enrollment_required.
+	EnrollMFAChallenge(ctx context.Context, in *EnrollMFAChallengeRequest, opts ...grpc.CallOption) (*MFAEnrollment, error)
+	// AcceptInvite sets the password of an invited user and signs them in.
+	AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// RequestPasswordReset mails a password reset link. It succeeds for
+	// unknown accounts too.
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResetPassword sets a new password with a reset link token and revokes
+	// every session of the user.
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type consoleAuthClient struct {
@@ -59,12 +93,135 @@ func (c *consoleAuthClient) Login(ctx context.Context, in *ConsoleLoginRequest,
 	return out, nil
 }
 
+func (c *consoleAuthClient) Refresh(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, ConsoleAuth_Refresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleAuthClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConsoleAuth_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleAuthClient) LogoutAll(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConsoleAuth_LogoutAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleAuthClient) StartSSO(ctx context.Context, in *StartSSORequest, opts ...grpc.CallOption) (*StartSSOResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartSSOResponse)
+	err := c.cc.Invoke(ctx, ConsoleAuth_StartSSO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleAuthClient) ExchangeSSOTicket(ctx context.Context, in *ExchangeSSOTicketRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, ConsoleAuth_ExchangeSSOTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleAuthClient) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, ConsoleAuth_VerifyMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleAuthClient) EnrollMFAChallenge(ctx context.Context, in *EnrollMFAChallengeRequest, opts ...grpc.CallOption) (*MFAEnrollment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAEnrollment)
+	err := c.cc.Invoke(ctx, ConsoleAuth_EnrollMFAChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleAuthClient) AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, ConsoleAuth_AcceptInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleAuthClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConsoleAuth_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleAuthClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConsoleAuth_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConsoleAuthServer is the server API for ConsoleAuth service.
 // All implementations must embed UnimplementedConsoleAuthServer
 // for forward compatibility.
 type ConsoleAuthServer interface {
 	Register(context.Context, *ConsoleRegisterRequest) (*AuthResponse, error)
 	Login(context.Context, *ConsoleLoginRequest) (*AuthResponse, error)
+	Refresh(context.Context, *RefreshTokenRequest) (*AuthResponse, error)
+	// Logout revokes the session of the calling access token.
+	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	// LogoutAll revokes every session of the calling user.
+	LogoutAll(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	// StartSSO returns the IdP URL that begins a single sign-on login.
+	StartSSO(context.Context, *StartSSORequest) (*StartSSOResponse, error)
+	// ExchangeSSOTicket redeems the one-time ticket the IdP callback
+	// redirected the browser with.
+	ExchangeSSOTicket(context.Context, *ExchangeSSOTicketRequest) (*AuthResponse, error)
+	// VerifyMFA completes a login that returned mfa_required.
+	VerifyMFA(context.Context, *VerifyMFARequest) (*AuthResponse, error)
+	// EnrollMFAChallenge starts the enrollment of a login that returned
+	// mfa_enrollment_required.
+	EnrollMFAChallenge(context.Context, *EnrollMFAChallengeRequest) (*MFAEnrollment, error)
+	// AcceptInvite sets the password of an invited user and signs them in.
+	AcceptInvite(context.Context, *AcceptInviteRequest) (*AuthResponse, error)
+	// RequestPasswordReset mails a password reset link. It succeeds for
+	// unknown accounts too.
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// ResetPassword sets a new password with a reset link token and revokes
+	// every session of the user.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedConsoleAuthServer()
 }
 
@@ -81,6 +238,36 @@ func (UnimplementedConsoleAuthServer) Register(context.Context, *ConsoleRegister
 func (UnimplementedConsoleAuthServer) Login(context.Context, *ConsoleLoginRequest) (*AuthResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedConsoleAuthServer) Refresh(context.Context, *RefreshTokenRequest) (*AuthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedConsoleAuthServer) Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedConsoleAuthServer) LogoutAll(context.Context, *LogoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method LogoutAll not implemented")
+}
+func (UnimplementedConsoleAuthServer) StartSSO(context.Context, *StartSSORequest) (*StartSSOResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartSSO not implemented")
+}
+func (UnimplementedConsoleAuthServer) ExchangeSSOTicket(context.Context, *ExchangeSSOTicketRequest) (*AuthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExchangeSSOTicket not implemented")
+}
+func (UnimplementedConsoleAuthServer) VerifyMFA(context.Context, *VerifyMFARequest) (*AuthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyMFA not implemented")
+}
+func (UnimplementedConsoleAuthServer) EnrollMFAChallenge(context.Context, *EnrollMFAChallengeRequest) (*MFAEnrollment, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollMFAChallenge not implemented")
+}
+func (UnimplementedConsoleAuthServer) AcceptInvite(context.Context, *AcceptInviteRequest) (*AuthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (UnimplementedConsoleAuthServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedConsoleAuthServer) ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetPassword not implemented")
+}
 func (UnimplementedConsoleAuthServer) mustEmbedUnimplementedConsoleAuthServer() {}
 func (UnimplementedConsoleAuthServer) testEmbeddedByValue()                     {}
 
@@ -138,6 +325,186 @@ func _ConsoleAuth_Login_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConsoleAuth_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAuthServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAuth_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAuthServer).Refresh(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleAuth_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAuthServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAuth_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAuthServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleAuth_LogoutAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAuthServer).LogoutAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAuth_LogoutAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAuthServer).LogoutAll(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleAuth_StartSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSSORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAuthServer).StartSSO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAuth_StartSSO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAuthServer).StartSSO(ctx, req.(*StartSSORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleAuth_ExchangeSSOTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeSSOTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAuthServer).ExchangeSSOTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAuth_ExchangeSSOTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAuthServer).ExchangeSSOTicket(ctx, req.(*ExchangeSSOTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleAuth_VerifyMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAuthServer).VerifyMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAuth_VerifyMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAuthServer).VerifyMFA(ctx, req.(*VerifyMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleAuth_EnrollMFAChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollMFAChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAuthServer).EnrollMFAChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAuth_EnrollMFAChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAuthServer).EnrollMFAChallenge(ctx, req.(*EnrollMFAChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleAuth_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAuthServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAuth_AcceptInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAuthServer).AcceptInvite(ctx, req.(*AcceptInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleAuth_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAuthServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAuth_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAuthServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleAuth_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleAuthServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleAuth_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleAuthServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConsoleAuth_ServiceDesc is the grpc.ServiceDesc for ConsoleAuth service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,107 +520,1425 @@ var ConsoleAuth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _ConsoleAuth_Login_Handler,
 		},
+		{
+			MethodName: "Refresh",
+			Handler:    _ConsoleAuth_Refresh_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _ConsoleAuth_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAll",
+			Handler:    _ConsoleAuth_LogoutAll_Handler,
+		},
+		{
+			MethodName: "StartSSO",
+			Handler:    _ConsoleAuth_StartSSO_Handler,
+		},
+		{
+			MethodName: "ExchangeSSOTicket",
+			Handler:    _ConsoleAuth_ExchangeSSOTicket_Handler,
+		},
+		{
+			MethodName: "VerifyMFA",
+			Handler:    _ConsoleAuth_VerifyMFA_Handler,
+		},
+		{
+			MethodName: "EnrollMFAChallenge",
+			Handler:    _ConsoleAuth_EnrollMFAChallenge_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _ConsoleAuth_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _ConsoleAuth_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _ConsoleAuth_ResetPassword_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/auth/v1/auth.proto",
 }
 
 const (
-	PlatformAuth_Login_FullMethodName = "/api.auth.v1.PlatformAuth/Login"
+	ConsoleMFA_GetMFAStatus_FullMethodName            = "/api.auth.v1.ConsoleMFA/GetMFAStatus"
+	ConsoleMFA_EnrollMFA_FullMethodName               = "/api.auth.v1.ConsoleMFA/EnrollMFA"
+	ConsoleMFA_ConfirmMFA_FullMethodName              = "/api.auth.v1.ConsoleMFA/ConfirmMFA"
+	ConsoleMFA_DisableMFA_FullMethodName              = "/api.auth.v1.ConsoleMFA/DisableMFA"
+	ConsoleMFA_RegenerateRecoveryCodes_FullMethodName = "/api.auth.v1.ConsoleMFA/RegenerateRecoveryCodes"
+	ConsoleMFA_GetMFAPolicy_FullMethodName            = "/api.auth.v1.ConsoleMFA/GetMFAPolicy"
+	ConsoleMFA_UpdateMFAPolicy_FullMethodName         = "/api.auth.v1.ConsoleMFA/UpdateMFAPolicy"
 )
 
-// PlatformAuthClient is the client API for PlatformAuth service.
+// ConsoleMFAClient is the client API for ConsoleMFA service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type PlatformAuthClient interface {
-	Login(ctx context.Context, in *PlatformLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+type ConsoleMFAClient interface {
+	GetMFAStatus(ctx context.Context, in *GetMFAStatusRequest, opts ...grpc.CallOption) (*MFAStatus, error)
+	EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...grpc.CallOption) (*MFAEnrollment, error)
+	ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...grpc.CallOption) (*MFARecoveryCodes, error)
+	DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*MFARecoveryCodes, error)
+	GetMFAPolicy(ctx context.Context, in *GetMFAPolicyRequest, opts ...grpc.CallOption) (*MFAPolicyResponse, error)
+	UpdateMFAPolicy(ctx context.Context, in *UpdateMFAPolicyRequest, opts ...grpc.CallOption) (*MFAPolicyResponse, error)
 }
 
-type platformAuthClient struct {
+type consoleMFAClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewPlatformAuthClient(cc grpc.ClientConnInterface) PlatformAuthClient {
-	return &platformAuthClient{cc}
+func NewConsoleMFAClient(cc grpc.ClientConnInterface) ConsoleMFAClient {
+	return &consoleMFAClient{cc}
 }
 
-func (c *platformAuthClient) Login(ctx context.Context, in *PlatformLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+func (c *consoleMFAClient) GetMFAStatus(ctx context.Context, in *GetMFAStatusRequest, opts ...grpc.CallOption) (*MFAStatus, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AuthResponse)
-	err := c.cc.Invoke(ctx, PlatformAuth_Login_FullMethodName, in, out, cOpts...)
+	out := new(MFAStatus)
+	err := c.cc.Invoke(ctx, ConsoleMFA_GetMFAStatus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PlatformAuthServer is the server API for PlatformAuth service.
-// All implementations must embed UnimplementedPlatformAuthServer
-// for forward compatibility.
-type PlatformAuthServer interface {
-	Login(context.Context, *PlatformLoginRequest) (*AuthResponse, error)
-	mustEmbedUnimplementedPlatformAuthServer()
+func (c *consoleMFAClient) EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...grpc.CallOption) (*MFAEnrollment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAEnrollment)
+	err := c.cc.Invoke(ctx, ConsoleMFA_EnrollMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedPlatformAuthServer must be embedded to have
-// forward compatible implementations.
+func (c *consoleMFAClient) ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...grpc.CallOption) (*MFARecoveryCodes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFARecoveryCodes)
+	err := c.cc.Invoke(ctx, ConsoleMFA_ConfirmMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleMFAClient) DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConsoleMFA_DisableMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleMFAClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*MFARecoveryCodes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFARecoveryCodes)
+	err := c.cc.Invoke(ctx, ConsoleMFA_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleMFAClient) GetMFAPolicy(ctx context.Context, in *GetMFAPolicyRequest, opts ...grpc.CallOption) (*MFAPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAPolicyResponse)
+	err := c.cc.Invoke(ctx, ConsoleMFA_GetMFAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleMFAClient) UpdateMFAPolicy(ctx context.Context, in *UpdateMFAPolicyRequest, opts ...grpc.CallOption) (*MFAPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAPolicyResponse)
+	err := c.cc.Invoke(ctx, ConsoleMFA_UpdateMFAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConsoleMFAServer is the server API for ConsoleMFA service.
+// All implementations must embed UnimplementedConsoleMFAServer
+// for forward compatibility.
+type ConsoleMFAServer interface {
+	GetMFAStatus(context.Context, *GetMFAStatusRequest) (*MFAStatus, error)
+	EnrollMFA(context.Context, *EnrollMFARequest) (*MFAEnrollment, error)
+	ConfirmMFA(context.Context, *ConfirmMFARequest) (*MFARecoveryCodes, error)
+	DisableMFA(context.Context, *DisableMFARequest) (*emptypb.Empty, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*MFARecoveryCodes, error)
+	GetMFAPolicy(context.Context, *GetMFAPolicyRequest) (*MFAPolicyResponse, error)
+	UpdateMFAPolicy(context.Context, *UpdateMFAPolicyRequest) (*MFAPolicyResponse, error)
+	mustEmbedUnimplementedConsoleMFAServer()
+}
+
+// UnimplementedConsoleMFAServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConsoleMFAServer struct{}
+
+func (UnimplementedConsoleMFAServer) GetMFAStatus(context.Context, *GetMFAStatusRequest) (*MFAStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMFAStatus not implemented")
+}
+func (UnimplementedConsoleMFAServer) EnrollMFA(context.Context, *EnrollMFARequest) (*MFAEnrollment, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollMFA not implemented")
+}
+func (UnimplementedConsoleMFAServer) ConfirmMFA(context.Context, *ConfirmMFARequest) (*MFARecoveryCodes, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmMFA not implemented")
+}
+func (UnimplementedConsoleMFAServer) DisableMFA(context.Context, *DisableMFARequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableMFA not implemented")
+}
+func (UnimplementedConsoleMFAServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*MFARecoveryCodes, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedConsoleMFAServer) GetMFAPolicy(context.Context, *GetMFAPolicyRequest) (*MFAPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMFAPolicy not implemented")
+}
+func (UnimplementedConsoleMFAServer) UpdateMFAPolicy(context.Context, *UpdateMFAPolicyRequest) (*MFAPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMFAPolicy not implemented")
+}
+func (UnimplementedConsoleMFAServer) mustEmbedUnimplementedConsoleMFAServer() {}
+func (UnimplementedConsoleMFAServer) testEmbeddedByValue()                    {}
+
+// UnsafeConsoleMFAServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConsoleMFAServer will
+// result in compilation errors.
+type UnsafeConsoleMFAServer interface {
+	mustEmbedUnimplementedConsoleMFAServer()
+}
+
+func RegisterConsoleMFAServer(s grpc.ServiceRegistrar, srv ConsoleMFAServer) {
+	// If the following call panics, it indicates UnimplementedConsoleMFAServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConsoleMFA_ServiceDesc, srv)
+}
+
+func _ConsoleMFA_GetMFAStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMFAStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleMFAServer).GetMFAStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleMFA_GetMFAStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleMFAServer).GetMFAStatus(ctx, req.(*GetMFAStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleMFA_EnrollMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleMFAServer).EnrollMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleMFA_EnrollMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleMFAServer).EnrollMFA(ctx, req.(*EnrollMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleMFA_ConfirmMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleMFAServer).ConfirmMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleMFA_ConfirmMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleMFAServer).ConfirmMFA(ctx, req.(*ConfirmMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleMFA_DisableMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleMFAServer).DisableMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleMFA_DisableMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleMFAServer).DisableMFA(ctx, req.(*DisableMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleMFA_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleMFAServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleMFA_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleMFAServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleMFA_GetMFAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMFAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleMFAServer).GetMFAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleMFA_GetMFAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleMFAServer).GetMFAPolicy(ctx, req.(*GetMFAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleMFA_UpdateMFAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMFAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleMFAServer).UpdateMFAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleMFA_UpdateMFAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleMFAServer).UpdateMFAPolicy(ctx, req.(*UpdateMFAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConsoleMFA_ServiceDesc is the grpc.ServiceDesc for ConsoleMFA service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConsoleMFA_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.auth.v1.ConsoleMFA",
+	HandlerType: (*ConsoleMFAServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMFAStatus",
+			Handler:    _ConsoleMFA_GetMFAStatus_Handler,
+		},
+		{
+			MethodName: "EnrollMFA",
+			Handler:    _ConsoleMFA_EnrollMFA_Handler,
+		},
+		{
+			MethodName: "ConfirmMFA",
+			Handler:    _ConsoleMFA_ConfirmMFA_Handler,
+		},
+		{
+			MethodName: "DisableMFA",
+			Handler:    _ConsoleMFA_DisableMFA_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _ConsoleMFA_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "GetMFAPolicy",
+			Handler:    _ConsoleMFA_GetMFAPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateMFAPolicy",
+			Handler:    _ConsoleMFA_UpdateMFAPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/auth/v1/auth.proto",
+}
+
+const (
+	ConsoleSSO_GetSSOConfig_FullMethodName    = "/api.auth.v1.ConsoleSSO/GetSSOConfig"
+	ConsoleSSO_UpdateSSOConfig_FullMethodName = "/api.auth.v1.ConsoleSSO/UpdateSSOConfig"
+)
+
+// ConsoleSSOClient is the client API for ConsoleSSO service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConsoleSSOClient interface {
+	GetSSOConfig(ctx context.Context, in *GetSSOConfigRequest, opts ...grpc.CallOption) (*SSOConfigResponse, error)
+	UpdateSSOConfig(ctx context.Context, in *UpdateSSOConfigRequest, opts ...grpc.CallOption) (*SSOConfigResponse, error)
+}
+
+type consoleSSOClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConsoleSSOClient(cc grpc.ClientConnInterface) ConsoleSSOClient {
+	return &consoleSSOClient{cc}
+}
+
+func (c *consoleSSOClient) GetSSOConfig(ctx context.Context, in *GetSSOConfigRequest, opts ...grpc.CallOption) (*SSOConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSOConfigResponse)
+	err := c.cc.Invoke(ctx, ConsoleSSO_GetSSOConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleSSOClient) UpdateSSOConfig(ctx context.Context, in *UpdateSSOConfigRequest, opts ...grpc.CallOption) (*SSOConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SSOConfigResponse)
+	err := c.cc.Invoke(ctx, ConsoleSSO_UpdateSSOConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConsoleSSOServer is the server API for ConsoleSSO service.
+// All implementations must embed UnimplementedConsoleSSOServer
+// for forward compatibility.
+type ConsoleSSOServer interface {
+	GetSSOConfig(context.Context, *GetSSOConfigRequest) (*SSOConfigResponse, error)
+	UpdateSSOConfig(context.Context, *UpdateSSOConfigRequest) (*SSOConfigResponse, error)
+	mustEmbedUnimplementedConsoleSSOServer()
+}
+
+// UnimplementedConsoleSSOServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConsoleSSOServer struct{}
+
+func (UnimplementedConsoleSSOServer) GetSSOConfig(context.Context, *GetSSOConfigRequest) (*SSOConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSSOConfig not implemented")
+}
+func (UnimplementedConsoleSSOServer) UpdateSSOConfig(context.Context, *UpdateSSOConfigRequest) (*SSOConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSSOConfig not implemented")
+}
+func (UnimplementedConsoleSSOServer) mustEmbedUnimplementedConsoleSSOServer() {}
+func (UnimplementedConsoleSSOServer) testEmbeddedByValue()                    {}
+
+// UnsafeConsoleSSOServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConsoleSSOServer will
+// result in compilation errors.
+type UnsafeConsoleSSOServer interface {
+	mustEmbedUnimplementedConsoleSSOServer()
+}
+
+func RegisterConsoleSSOServer(s grpc.ServiceRegistrar, srv ConsoleSSOServer) {
+	// If the following call panics, it indicates UnimplementedConsoleSSOServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConsoleSSO_ServiceDesc, srv)
+}
+
+func _ConsoleSSO_GetSSOConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSSOConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleSSOServer).GetSSOConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleSSO_GetSSOConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleSSOServer).GetSSOConfig(ctx, req.(*GetSSOConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleSSO_UpdateSSOConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSSOConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleSSOServer).UpdateSSOConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleSSO_UpdateSSOConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleSSOServer).UpdateSSOConfig(ctx, req.(*UpdateSSOConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConsoleSSO_ServiceDesc is the grpc.ServiceDesc for ConsoleSSO service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConsoleSSO_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.auth.v1.ConsoleSSO",
+	HandlerType: (*ConsoleSSOServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSSOConfig",
+			Handler:    _ConsoleSSO_GetSSOConfig_Handler,
+		},
+		{
+			MethodName: "UpdateSSOConfig",
+			Handler:    _ConsoleSSO_UpdateSSOConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/auth/v1/auth.proto",
+}
+
+const (
+	PlatformAuth_Login_FullMethodName              = "/api.auth.v1.PlatformAuth/Login"
+	PlatformAuth_Refresh_FullMethodName            = "/api.auth.v1.PlatformAuth/Refresh"
+	PlatformAuth_Logout_FullMethodName             = "/api.auth.v1.PlatformAuth/Logout"
+	PlatformAuth_LogoutAll_FullMethodName          = "/api.auth.v1.PlatformAuth/LogoutAll"
+	PlatformAuth_VerifyMFA_FullMethodName          = "/api.auth.v1.PlatformAuth/VerifyMFA"
+	PlatformAuth_EnrollMFAChallenge_FullMethodName = "/api.auth.v1.PlatformAuth/EnrollMFAChallenge"
+)
+
+// PlatformAuthClient is the client API for PlatformAuth service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlatformAuthClient interface {
+	Login(ctx context.Context, in *PlatformLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Refresh(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LogoutAll(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	EnrollMFAChallenge(ctx context.Context, in *EnrollMFAChallengeRequest, opts ...grpc.CallOption) (*MFAEnrollment, error)
+}
+
+type platformAuthClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlatformAuthClient(cc grpc.ClientConnInterface) PlatformAuthClient {
+	return &platformAuthClient{cc}
+}
+
+func (c *platformAuthClient) Login(ctx context.Context, in *PlatformLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, PlatformAuth_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformAuthClient) Refresh(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, PlatformAuth_Refresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformAuthClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PlatformAuth_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformAuthClient) LogoutAll(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PlatformAuth_LogoutAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformAuthClient) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, PlatformAuth_VerifyMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformAuthClient) EnrollMFAChallenge(ctx context.Context, in *EnrollMFAChallengeRequest, opts ...grpc.CallOption) (*MFAEnrollment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAEnrollment)
+	err := c.cc.Invoke(ctx, PlatformAuth_EnrollMFAChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlatformAuthServer is the server API for PlatformAuth service.
+// All implementations must embed UnimplementedPlatformAuthServer
+// for forward compatibility.
+type PlatformAuthServer interface {
+	Login(context.Context, *PlatformLoginRequest) (*AuthResponse, error)
+	Refresh(context.Context, *RefreshTokenRequest) (*AuthResponse, error)
+	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	LogoutAll(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	VerifyMFA(context.Context, *VerifyMFARequest) (*AuthResponse, error)
+	EnrollMFAChallenge(context.Context, *EnrollMFAChallengeRequest) (*MFAEnrollment, error)
+	mustEmbedUnimplementedPlatformAuthServer()
+}
+
+// UnimplementedPlatformAuthServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlatformAuthServer struct{}
+
+func (UnimplementedPlatformAuthServer) Login(context.Context, *PlatformLoginRequest) (*AuthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedPlatformAuthServer) Refresh(context.Context, *RefreshTokenRequest) (*AuthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedPlatformAuthServer) Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedPlatformAuthServer) LogoutAll(context.Context, *LogoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method LogoutAll not implemented")
+}
+func (UnimplementedPlatformAuthServer) VerifyMFA(context.Context, *VerifyMFARequest) (*AuthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyMFA not implemented")
+}
+func (UnimplementedPlatformAuthServer) EnrollMFAChallenge(context.Context, *EnrollMFAChallengeRequest) (*MFAEnrollment, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollMFAChallenge not implemented")
+}
+func (UnimplementedPlatformAuthServer) mustEmbedUnimplementedPlatformAuthServer() {}
+func (UnimplementedPlatformAuthServer) testEmbeddedByValue()                      {}
+
+// UnsafePlatformAuthServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlatformAuthServer will
+// result in compilation errors.
+type UnsafePlatformAuthServer interface {
+	mustEmbedUnimplementedPlatformAuthServer()
+}
+
+func RegisterPlatformAuthServer(s grpc.ServiceRegistrar, srv PlatformAuthServer) {
+	// If the following call panics, it indicates UnimplementedPlatformAuthServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlatformAuth_ServiceDesc, srv)
+}
+
+func _PlatformAuth_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformAuthServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformAuth_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformAuthServer).Login(ctx, req.(*PlatformLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformAuth_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformAuthServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformAuth_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformAuthServer).Refresh(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformAuth_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformAuthServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformAuth_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformAuthServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformAuth_LogoutAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformAuthServer).LogoutAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformAuth_LogoutAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformAuthServer).LogoutAll(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformAuth_VerifyMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformAuthServer).VerifyMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformAuth_VerifyMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformAuthServer).VerifyMFA(ctx, req.(*VerifyMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformAuth_EnrollMFAChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollMFAChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformAuthServer).EnrollMFAChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformAuth_EnrollMFAChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformAuthServer).EnrollMFAChallenge(ctx, req.(*EnrollMFAChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlatformAuth_ServiceDesc is the grpc.ServiceDesc for PlatformAuth service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlatformAuth_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.auth.v1.PlatformAuth",
+	HandlerType: (*PlatformAuthServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _PlatformAuth_Login_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _PlatformAuth_Refresh_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _PlatformAuth_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAll",
+			Handler:    _PlatformAuth_LogoutAll_Handler,
+		},
+		{
+			MethodName: "VerifyMFA",
+			Handler:    _PlatformAuth_VerifyMFA_Handler,
+		},
+		{
+			MethodName: "EnrollMFAChallenge",
+			Handler:    _PlatformAuth_EnrollMFAChallenge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/auth/v1/auth.proto",
+}
+
+const (
+	PlatformMFA_GetMFAStatus_FullMethodName            = "/api.auth.v1.PlatformMFA/GetMFAStatus"
+	PlatformMFA_EnrollMFA_FullMethodName               = "/api.auth.v1.PlatformMFA/EnrollMFA"
+	PlatformMFA_ConfirmMFA_FullMethodName              = "/api.auth.v1.PlatformMFA/ConfirmMFA"
+	PlatformMFA_DisableMFA_FullMethodName              = "/api.auth.v1.PlatformMFA/DisableMFA"
+	PlatformMFA_RegenerateRecoveryCodes_FullMethodName = "/api.auth.v1.PlatformMFA/RegenerateRecoveryCodes"
+	PlatformMFA_GetMFAPolicy_FullMethodName            = "/api.auth.v1.PlatformMFA/GetMFAPolicy"
+	PlatformMFA_UpdateMFAPolicy_FullMethodName         = "/api.auth.v1.PlatformMFA/UpdateMFAPolicy"
+)
+
+// PlatformMFAClient is the client API for PlatformMFA service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlatformMFAClient interface {
+	GetMFAStatus(ctx context.Context, in *GetMFAStatusRequest, opts ...grpc.CallOption) (*MFAStatus, error)
+	EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...grpc.CallOption) (*MFAEnrollment, error)
+	ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...grpc.CallOption) (*MFARecoveryCodes, error)
+	DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*MFARecoveryCodes, error)
+	GetMFAPolicy(ctx context.Context, in *GetMFAPolicyRequest, opts ...grpc.CallOption) (*MFAPolicyResponse, error)
+	UpdateMFAPolicy(ctx context.Context, in *UpdateMFAPolicyRequest, opts ...grpc.CallOption) (*MFAPolicyResponse, error)
+}
+
+type platformMFAClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlatformMFAClient(cc grpc.ClientConnInterface) PlatformMFAClient {
+	return &platformMFAClient{cc}
+}
+
+func (c *platformMFAClient) GetMFAStatus(ctx context.Context, in *GetMFAStatusRequest, opts ...grpc.CallOption) (*MFAStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAStatus)
+	err := c.cc.Invoke(ctx, PlatformMFA_GetMFAStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformMFAClient) EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...grpc.CallOption) (*MFAEnrollment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAEnrollment)
+	err := c.cc.Invoke(ctx, PlatformMFA_EnrollMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformMFAClient) ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...grpc.CallOption) (*MFARecoveryCodes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFARecoveryCodes)
+	err := c.cc.Invoke(ctx, PlatformMFA_ConfirmMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformMFAClient) DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PlatformMFA_DisableMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformMFAClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*MFARecoveryCodes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFARecoveryCodes)
+	err := c.cc.Invoke(ctx, PlatformMFA_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformMFAClient) GetMFAPolicy(ctx context.Context, in *GetMFAPolicyRequest, opts ...grpc.CallOption) (*MFAPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAPolicyResponse)
+	err := c.cc.Invoke(ctx, PlatformMFA_GetMFAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformMFAClient) UpdateMFAPolicy(ctx context.Context, in *UpdateMFAPolicyRequest, opts ...grpc.CallOption) (*MFAPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAPolicyResponse)
+	err := c.cc.Invoke(ctx, PlatformMFA_UpdateMFAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlatformMFAServer is the server API for PlatformMFA service.
+// All implementations must embed UnimplementedPlatformMFAServer
+// for forward compatibility.
+type PlatformMFAServer interface {
+	GetMFAStatus(context.Context, *GetMFAStatusRequest) (*MFAStatus, error)
+	EnrollMFA(context.Context, *EnrollMFARequest) (*MFAEnrollment, error)
+	ConfirmMFA(context.Context, *ConfirmMFARequest) (*MFARecoveryCodes, error)
+	DisableMFA(context.Context, *DisableMFARequest) (*emptypb.Empty, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*MFARecoveryCodes, error)
+	GetMFAPolicy(context.Context, *GetMFAPolicyRequest) (*MFAPolicyResponse, error)
+	UpdateMFAPolicy(context.Context, *UpdateMFAPolicyRequest) (*MFAPolicyResponse, error)
+	mustEmbedUnimplementedPlatformMFAServer()
+}
+
+// UnimplementedPlatformMFAServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlatformMFAServer struct{}
+
+func (UnimplementedPlatformMFAServer) GetMFAStatus(context.Context, *GetMFAStatusRequest) (*MFAStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMFAStatus not implemented")
+}
+func (UnimplementedPlatformMFAServer) EnrollMFA(context.Context, *EnrollMFARequest) (*MFAEnrollment, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollMFA not implemented")
+}
+func (UnimplementedPlatformMFAServer) ConfirmMFA(context.Context, *ConfirmMFARequest) (*MFARecoveryCodes, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmMFA not implemented")
+}
+func (UnimplementedPlatformMFAServer) DisableMFA(context.Context, *DisableMFARequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableMFA not implemented")
+}
+func (UnimplementedPlatformMFAServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*MFARecoveryCodes, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedPlatformMFAServer) GetMFAPolicy(context.Context, *GetMFAPolicyRequest) (*MFAPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMFAPolicy not implemented")
+}
+func (UnimplementedPlatformMFAServer) UpdateMFAPolicy(context.Context, *UpdateMFAPolicyRequest) (*MFAPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMFAPolicy not implemented")
+}
+func (UnimplementedPlatformMFAServer) mustEmbedUnimplementedPlatformMFAServer() {}
+func (UnimplementedPlatformMFAServer) testEmbeddedByValue()                     {}
+
+// UnsafePlatformMFAServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlatformMFAServer will
+// result in compilation errors.
+type UnsafePlatformMFAServer interface {
+	mustEmbedUnimplementedPlatformMFAServer()
+}
+
+func RegisterPlatformMFAServer(s grpc.ServiceRegistrar, srv PlatformMFAServer) {
+	// If the following call panics, it indicates UnimplementedPlatformMFAServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlatformMFA_ServiceDesc, srv)
+}
+
+func _PlatformMFA_GetMFAStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMFAStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformMFAServer).GetMFAStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformMFA_GetMFAStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformMFAServer).GetMFAStatus(ctx, req.(*GetMFAStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformMFA_EnrollMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformMFAServer).EnrollMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformMFA_EnrollMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformMFAServer).EnrollMFA(ctx, req.(*EnrollMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformMFA_ConfirmMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformMFAServer).ConfirmMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformMFA_ConfirmMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformMFAServer).ConfirmMFA(ctx, req.(*ConfirmMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformMFA_DisableMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformMFAServer).DisableMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformMFA_DisableMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformMFAServer).DisableMFA(ctx, req.(*DisableMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformMFA_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformMFAServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformMFA_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformMFAServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformMFA_GetMFAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMFAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformMFAServer).GetMFAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformMFA_GetMFAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformMFAServer).GetMFAPolicy(ctx, req.(*GetMFAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformMFA_UpdateMFAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMFAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformMFAServer).UpdateMFAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformMFA_UpdateMFAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformMFAServer).UpdateMFAPolicy(ctx, req.(*UpdateMFAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlatformMFA_ServiceDesc is the grpc.ServiceDesc for PlatformMFA service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlatformMFA_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.auth.v1.PlatformMFA",
+	HandlerType: (*PlatformMFAServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMFAStatus",
+			Handler:    _PlatformMFA_GetMFAStatus_Handler,
+		},
+		{
+			MethodName: "EnrollMFA",
+			Handler:    _PlatformMFA_EnrollMFA_Handler,
+		},
+		{
+			MethodName: "ConfirmMFA",
+			Handler:    _PlatformMFA_ConfirmMFA_Handler,
+		},
+		{
+			MethodName: "DisableMFA",
+			Handler:    _PlatformMFA_DisableMFA_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _PlatformMFA_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "GetMFAPolicy",
+			Handler:    _PlatformMFA_GetMFAPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateMFAPolicy",
+			Handler:    _PlatformMFA_UpdateMFAPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/auth/v1/auth.proto",
+}
+
+const (
+	PlatformImpersonation_ImpersonateTenant_FullMethodName          = "/api.auth.v1.PlatformImpersonation/ImpersonateTenant"
+	PlatformImpersonation_ListImpersonationSessions_FullMethodName  = "/api.auth.v1.PlatformImpersonation/ListImpersonationSessions"
+	PlatformImpersonation_RevokeImpersonationSession_FullMethodName = "/api.auth.v1.PlatformImpersonation/RevokeImpersonationSession"
+)
+
+// PlatformImpersonationClient is the client API for PlatformImpersonation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PlatformImpersonation lets platform support open a tenant console.
+type PlatformImpersonationClient interface {
+	ImpersonateTenant(ctx context.Context, in *ImpersonateTenantRequest, opts ...grpc.CallOption) (*ImpersonateTenantResponse, error)
+	ListImpersonationSessions(ctx context.Context, in *ListImpersonationSessionsRequest, opts ...grpc.CallOption) (*ListImpersonationSessionsResponse, error)
+	RevokeImpersonationSession(ctx context.Context, in *RevokeImpersonationSessionRequest, opts ...grpc.CallOption) (*ImpersonationSessionResponse, error)
+}
+
+type platformImpersonationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlatformImpersonationClient(cc grpc.ClientConnInterface) PlatformImpersonationClient {
+	return &platformImpersonationClient{cc}
+}
+
+func (c *platformImpersonationClient) ImpersonateTenant(ctx context.Context, in *ImpersonateTenantRequest, opts ...grpc.CallOption) (*ImpersonateTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateTenantResponse)
+	err := c.cc.Invoke(ctx, PlatformImpersonation_ImpersonateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformImpersonationClient) ListImpersonationSessions(ctx context.Context, in *ListImpersonationSessionsRequest, opts ...grpc.CallOption) (*ListImpersonationSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImpersonationSessionsResponse)
+	err := c.cc.Invoke(ctx, PlatformImpersonation_ListImpersonationSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformImpersonationClient) RevokeImpersonationSession(ctx context.Context, in *RevokeImpersonationSessionRequest, opts ...grpc.CallOption) (*ImpersonationSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonationSessionResponse)
+	err := c.cc.Invoke(ctx, PlatformImpersonation_RevokeImpersonationSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlatformImpersonationServer is the server API for PlatformImpersonation service.
+// All implementations must embed UnimplementedPlatformImpersonationServer
+// for forward compatibility.
+//
+// PlatformImpersonation lets platform support open a tenant console.
+type PlatformImpersonationServer interface {
+	ImpersonateTenant(context.Context, *ImpersonateTenantRequest) (*ImpersonateTenantResponse, error)
+	ListImpersonationSessions(context.Context, *ListImpersonationSessionsRequest) (*ListImpersonationSessionsResponse, error)
+	RevokeImpersonationSession(context.Context, *RevokeImpersonationSessionRequest) (*ImpersonationSessionResponse, error)
+	mustEmbedUnimplementedPlatformImpersonationServer()
+}
+
+// UnimplementedPlatformImpersonationServer must be embedded to have
+// forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedPlatformAuthServer struct{}
+type UnimplementedPlatformImpersonationServer struct{}
 
-func (UnimplementedPlatformAuthServer) Login(context.Context, *PlatformLoginRequest) (*AuthResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
+func (UnimplementedPlatformImpersonationServer) ImpersonateTenant(context.Context, *ImpersonateTenantRequest) (*ImpersonateTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImpersonateTenant not implemented")
 }
-func (UnimplementedPlatformAuthServer) mustEmbedUnimplementedPlatformAuthServer() {}
-func (UnimplementedPlatformAuthServer) testEmbeddedByValue()                      {}
+func (UnimplementedPlatformImpersonationServer) ListImpersonationSessions(context.Context, *ListImpersonationSessionsRequest) (*ListImpersonationSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListImpersonationSessions not implemented")
+}
+func (UnimplementedPlatformImpersonationServer) RevokeImpersonationSession(context.Context, *RevokeImpersonationSessionRequest) (*ImpersonationSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeImpersonationSession not implemented")
+}
+func (UnimplementedPlatformImpersonationServer) mustEmbedUnimplementedPlatformImpersonationServer() {}
+func (UnimplementedPlatformImpersonationServer) testEmbeddedByValue()                               {}
 
-// UnsafePlatformAuthServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PlatformAuthServer will
+// UnsafePlatformImpersonationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlatformImpersonationServer will
 // result in compilation errors.
-type UnsafePlatformAuthServer interface {
-	mustEmbedUnimplementedPlatformAuthServer()
+type UnsafePlatformImpersonationServer interface {
+	mustEmbedUnimplementedPlatformImpersonationServer()
 }
 
-func RegisterPlatformAuthServer(s grpc.ServiceRegistrar, srv PlatformAuthServer) {
-	// If the following call panics, it indicates UnimplementedPlatformAuthServer was
+func RegisterPlatformImpersonationServer(s grpc.ServiceRegistrar, srv PlatformImpersonationServer) {
+	// If the following call panics, it indicates UnimplementedPlatformImpersonationServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&PlatformAuth_ServiceDesc, srv)
+	s.RegisterService(&PlatformImpersonation_ServiceDesc, srv)
 }
 
-func _PlatformAuth_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PlatformLoginRequest)
+func _PlatformImpersonation_ImpersonateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateTenantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlatformAuthServer).Login(ctx, in)
+		return srv.(PlatformImpersonationServer).ImpersonateTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlatformAuth_Login_FullMethodName,
+		FullMethod: PlatformImpersonation_ImpersonateTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlatformAuthServer).Login(ctx, req.(*PlatformLoginRequest))
+		return srv.(PlatformImpersonationServer).ImpersonateTenant(ctx, req.(*ImpersonateTenantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PlatformAuth_ServiceDesc is the grpc.ServiceDesc for PlatformAuth service.
+func _PlatformImpersonation_ListImpersonationSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImpersonationSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformImpersonationServer).ListImpersonationSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformImpersonation_ListImpersonationSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformImpersonationServer).ListImpersonationSessions(ctx, req.(*ListImpersonationSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformImpersonation_RevokeImpersonationSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeImpersonationSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformImpersonationServer).RevokeImpersonationSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformImpersonation_RevokeImpersonationSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformImpersonationServer).RevokeImpersonationSession(ctx, req.(*RevokeImpersonationSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlatformImpersonation_ServiceDesc is the grpc.ServiceDesc for PlatformImpersonation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var PlatformAuth_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.auth.v1.PlatformAuth",
-	HandlerType: (*PlatformAuthServer)(nil),
+var PlatformImpersonation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.auth.v1.PlatformImpersonation",
+	HandlerType: (*PlatformImpersonationServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Login",
-			Handler:    _PlatformAuth_Login_Handler,
+			MethodName: "ImpersonateTenant",
+			Handler:    _PlatformImpersonation_ImpersonateTenant_Handler,
+		},
+		{
+			MethodName: "ListImpersonationSessions",
+			Handler:    _PlatformImpersonation_ListImpersonationSessions_Handler,
+		},
+		{
+			MethodName: "RevokeImpersonationSession",
+			Handler:    _PlatformImpersonation_RevokeImpersonationSession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/auth/v1/auth.proto",
+}
+
+const (
+	ConsoleImpersonation_ListImpersonationSessions_FullMethodName = "/api.auth.v1.ConsoleImpersonation/ListImpersonationSessions"
+)
+
+// ConsoleImpersonationClient is the client API for ConsoleImpersonation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ConsoleImpersonation shows a tenant who opened its console.
+type ConsoleImpersonationClient interface {
+	ListImpersonationSessions(ctx context.Context, in *ListImpersonationSessionsRequest, opts ...grpc.CallOption) (*ListImpersonationSessionsResponse, error)
+}
+
+type consoleImpersonationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConsoleImpersonationClient(cc grpc.ClientConnInterface) ConsoleImpersonationClient {
+	return &consoleImpersonationClient{cc}
+}
+
+func (c *consoleImpersonationClient) ListImpersonationSessions(ctx context.Context, in *ListImpersonationSessionsRequest, opts ...grpc.CallOption) (*ListImpersonationSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImpersonationSessionsResponse)
+	err := c.cc.Invoke(ctx, ConsoleImpersonation_ListImpersonationSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConsoleImpersonationServer is the server API for ConsoleImpersonation service.
+// All implementations must embed UnimplementedConsoleImpersonationServer
+// for forward compatibility.
+//
+// ConsoleImpersonation shows a tenant who opened its console.
+type ConsoleImpersonationServer interface {
+	ListImpersonationSessions(context.Context, *ListImpersonationSessionsRequest) (*ListImpersonationSessionsResponse, error)
+	mustEmbedUnimplementedConsoleImpersonationServer()
+}
+
+// UnimplementedConsoleImpersonationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConsoleImpersonationServer struct{}
+
+func (UnimplementedConsoleImpersonationServer) ListImpersonationSessions(context.Context, *ListImpersonationSessionsRequest) (*ListImpersonationSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListImpersonationSessions not implemented")
+}
+func (UnimplementedConsoleImpersonationServer) mustEmbedUnimplementedConsoleImpersonationServer() {}
+func (UnimplementedConsoleImpersonationServer) testEmbeddedByValue()                              {}
+
+// UnsafeConsoleImpersonationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConsoleImpersonationServer will
+// result in compilation errors.
+type UnsafeConsoleImpersonationServer interface {
+	mustEmbedUnimplementedConsoleImpersonationServer()
+}
+
+func RegisterConsoleImpersonationServer(s grpc.ServiceRegistrar, srv ConsoleImpersonationServer) {
+	// If the following call panics, it indicates UnimplementedConsoleImpersonationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConsoleImpersonation_ServiceDesc, srv)
+}
+
+func _ConsoleImpersonation_ListImpersonationSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImpersonationSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleImpersonationServer).ListImpersonationSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleImpersonation_ListImpersonationSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleImpersonationServer).ListImpersonationSessions(ctx, req.(*ListImpersonationSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConsoleImpersonation_ServiceDesc is the grpc.ServiceDesc for ConsoleImpersonation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConsoleImpersonation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.auth.v1.ConsoleImpersonation",
+	HandlerType: (*ConsoleImpersonationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListImpersonationSessions",
+			Handler:    _ConsoleImpersonation_ListImpersonationSessions_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
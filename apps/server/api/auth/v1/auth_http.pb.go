@@ -10,6 +10,7 @@ import (
 	context "context"
 	http "github.com/go-kratos/kratos/v2/transport/http"
 	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,18 +20,61 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationConsoleAuthAcceptInvite = "/api.auth.v1.ConsoleAuth/AcceptInvite"
+const OperationConsoleAuthEnrollMFAChallenge = "/api.auth.v1.ConsoleAuth/EnrollMFAChallenge"
+const OperationConsoleAuthExchangeSSOTicket = "/api.auth.v1.ConsoleAuth/ExchangeSSOTicket"
 const OperationConsoleAuthLogin = "/api.auth.v1.ConsoleAuth/Login"
+const OperationConsoleAuthLogout = "/api.auth.v1.ConsoleAuth/Logout"
+const OperationConsoleAuthLogoutAll = "/api.auth.v1.ConsoleAuth/LogoutAll"
+const OperationConsoleAuthRefresh = "/api.auth.v1.ConsoleAuth/Refresh"
 const OperationConsoleAuthRegister = "/api.auth.v1.ConsoleAuth/Register"
+const OperationConsoleAuthRequestPasswordReset = "/api.auth.v1.ConsoleAuth/RequestPasswordReset"
+const OperationConsoleAuthResetPassword = "/api.auth.v1.ConsoleAuth/ResetPassword"
+const OperationConsoleAuthStartSSO = "/api.auth.v1.ConsoleAuth/StartSSO"
+const OperationConsoleAuthVerifyMFA = "/api.auth.v1.ConsoleAuth/VerifyMFA"
 
 type ConsoleAuthHTTPServer interface {
+	// AcceptInvite AcceptInvite sets the password of an invited user and signs them in.
+	AcceptInvite(context.Context, *AcceptInviteRequest) (*AuthResponse, error)
+	// EnrollMFAChallenge EnrollMFAChallenge starts the enrollment of a login that returned
+	// mfa_enrollment_required.
+	EnrollMFAChallenge(context.Context, *EnrollMFAChallengeRequest) (*MFAEnrollment, error)
+	// ExchangeSSOTicket ExchangeSSOTicket redeems the one-time ticket the IdP callback
+	// redirected the browser with.
+	ExchangeSSOTicket(context.Context, *ExchangeSSOTicketRequest) (*AuthResponse, error)
 	Login(context.Context, *ConsoleLoginRequest) (*AuthResponse, error)
+	// Logout Logout revokes the session of the calling access token.
+	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	// LogoutAll LogoutAll revokes every session of the calling user.
+	LogoutAll(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	Refresh(context.Context, *RefreshTokenRequest) (*AuthResponse, error)
 	Register(context.Context, *ConsoleRegisterRequest) (*AuthResponse, error)
+	// RequestPasswordReset RequestPasswordReset mails a password reset link. It succeeds for
+	// unknown accounts too.
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// ResetPassword ResetPassword sets a new password with a reset link token and revokes
+	// every session of the user.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error)
+	// StartSSO StartSSO returns the IdP URL that begins a single sign-on login.
+	StartSSO(context.Context, *StartSSORequest) (*StartSSOResponse, error)
+	// VerifyMFA VerifyMFA completes a login that returned mfa_required.
+	VerifyMFA(context.Context, *VerifyMFARequest) (*AuthResponse, error)
 }
 
 func RegisterConsoleAuthHTTPServer(s *http.Server, srv ConsoleAuthHTTPServer) {
 	r := s.Route("/")
 	r.POST("/console/v1/register", _ConsoleAuth_Register0_HTTP_Handler(srv))
 	r.POST("/console/v1/login", _ConsoleAuth_Login0_HTTP_Handler(srv))
+	r.POST("/console/v1/token/refresh", _ConsoleAuth_Refresh0_HTTP_Handler(srv))
+	r.POST("/console/v1/logout", _ConsoleAuth_Logout0_HTTP_Handler(srv))
+	r.POST("/console/v1/logout_all", _ConsoleAuth_LogoutAll0_HTTP_Handler(srv))
+	r.GET("/console/v1/sso/start", _ConsoleAuth_StartSSO0_HTTP_Handler(srv))
+	r.POST("/console/v1/sso/token", _ConsoleAuth_ExchangeSSOTicket0_HTTP_Handler(srv))
+	r.POST("/console/v1/login/mfa", _ConsoleAuth_VerifyMFA0_HTTP_Handler(srv))
+	r.POST("/console/v1/login/mfa/enroll", _ConsoleAuth_EnrollMFAChallenge0_HTTP_Handler(srv))
+	r.POST("/console/v1/invite/accept", _ConsoleAuth_AcceptInvite0_HTTP_Handler(srv))
+	r.POST("/console/v1/password/forgot", _ConsoleAuth_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/console/v1/password/reset", _ConsoleAuth_ResetPassword0_HTTP_Handler(srv))
 }
 
 func _ConsoleAuth_Register0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
@@ -77,24 +121,1261 @@ func _ConsoleAuth_Login0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.C
 	}
 }
 
+func _ConsoleAuth_Refresh0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAuthRefresh)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Refresh(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuthResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleAuth_Logout0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAuthLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleAuth_LogoutAll0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAuthLogoutAll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LogoutAll(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleAuth_StartSSO0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StartSSORequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAuthStartSSO)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartSSO(ctx, req.(*StartSSORequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*StartSSOResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleAuth_ExchangeSSOTicket0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExchangeSSOTicketRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAuthExchangeSSOTicket)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExchangeSSOTicket(ctx, req.(*ExchangeSSOTicketRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuthResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleAuth_VerifyMFA0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAuthVerifyMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyMFA(ctx, req.(*VerifyMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuthResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleAuth_EnrollMFAChallenge0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollMFAChallengeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAuthEnrollMFAChallenge)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollMFAChallenge(ctx, req.(*EnrollMFAChallengeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAEnrollment)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleAuth_AcceptInvite0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptInviteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAuthAcceptInvite)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptInvite(ctx, req.(*AcceptInviteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuthResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleAuth_RequestPasswordReset0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAuthRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleAuth_ResetPassword0_HTTP_Handler(srv ConsoleAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleAuthResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ConsoleAuthHTTPClient interface {
+	// AcceptInvite AcceptInvite sets the password of an invited user and signs them in.
+	AcceptInvite(ctx context.Context, req *AcceptInviteRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
+	// EnrollMFAChallenge EnrollMFAChallenge starts the enrollment of a login that returned
+	// mfa_enrollment_required.
+	EnrollMFAChallenge(ctx context.Context, req *EnrollMFAChallengeRequest, opts ...http.CallOption) (rsp *MFAEnrollment, err error)
+	// ExchangeSSOTicket ExchangeSSOTicket redeems the one-time ticket the IdP callback
+	// redirected the browser with.
+	ExchangeSSOTicket(ctx context.Context, req *ExchangeSSOTicketRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
 	Login(ctx context.Context, req *ConsoleLoginRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
+	// Logout Logout revokes the session of the calling access token.
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// LogoutAll LogoutAll revokes every session of the calling user.
+	LogoutAll(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	Refresh(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
 	Register(ctx context.Context, req *ConsoleRegisterRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
+	// RequestPasswordReset RequestPasswordReset mails a password reset link. It succeeds for
+	// unknown accounts too.
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ResetPassword ResetPassword sets a new password with a reset link token and revokes
+	// every session of the user.
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// StartSSO StartSSO returns the IdP URL that begins a single sign-on login.
+	StartSSO(ctx context.Context, req *StartSSORequest, opts ...http.CallOption) (rsp *StartSSOResponse, err error)
+	// VerifyMFA VerifyMFA completes a login that returned mfa_required.
+	VerifyMFA(ctx context.Context, req *VerifyMFARequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
+}
+
+type ConsoleAuthHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewConsoleAuthHTTPClient(client *http.Client) ConsoleAuthHTTPClient {
+	return &ConsoleAuthHTTPClientImpl{client}
+}
+
+// AcceptInvite AcceptInvite sets the password of an invited user and signs them in.
+func (c *ConsoleAuthHTTPClientImpl) AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...http.CallOption) (*AuthResponse, error) {
+	var out AuthResponse
+	pattern := "/console/v1/invite/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthAcceptInvite))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// EnrollMFAChallenge EnrollMFAChallenge starts the enrollment of a login that returned
+// mfa_enrollment_required.
+func (c *ConsoleAuthHTTPClientImpl) EnrollMFAChallenge(ctx context.Context, in *EnrollMFAChallengeRequest, opts ...http.CallOption) (*MFAEnrollment, error) {
+	var out MFAEnrollment
+	pattern := "/console/v1/login/mfa/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthEnrollMFAChallenge))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExchangeSSOTicket ExchangeSSOTicket redeems the one-time ticket the IdP callback
+// redirected the browser with.
+func (c *ConsoleAuthHTTPClientImpl) ExchangeSSOTicket(ctx context.Context, in *ExchangeSSOTicketRequest, opts ...http.CallOption) (*AuthResponse, error) {
+	var out AuthResponse
+	pattern := "/console/v1/sso/token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthExchangeSSOTicket))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleAuthHTTPClientImpl) Login(ctx context.Context, in *ConsoleLoginRequest, opts ...http.CallOption) (*AuthResponse, error) {
+	var out AuthResponse
+	pattern := "/console/v1/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Logout Logout revokes the session of the calling access token.
+func (c *ConsoleAuthHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/console/v1/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LogoutAll LogoutAll revokes every session of the calling user.
+func (c *ConsoleAuthHTTPClientImpl) LogoutAll(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/console/v1/logout_all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthLogoutAll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleAuthHTTPClientImpl) Refresh(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*AuthResponse, error) {
+	var out AuthResponse
+	pattern := "/console/v1/token/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthRefresh))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleAuthHTTPClientImpl) Register(ctx context.Context, in *ConsoleRegisterRequest, opts ...http.CallOption) (*AuthResponse, error) {
+	var out AuthResponse
+	pattern := "/console/v1/register"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthRegister))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestPasswordReset RequestPasswordReset mails a password reset link. It succeeds for
+// unknown accounts too.
+func (c *ConsoleAuthHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/console/v1/password/forgot"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResetPassword ResetPassword sets a new password with a reset link token and revokes
+// every session of the user.
+func (c *ConsoleAuthHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/console/v1/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartSSO StartSSO returns the IdP URL that begins a single sign-on login.
+func (c *ConsoleAuthHTTPClientImpl) StartSSO(ctx context.Context, in *StartSSORequest, opts ...http.CallOption) (*StartSSOResponse, error) {
+	var out StartSSOResponse
+	pattern := "/console/v1/sso/start"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleAuthStartSSO))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// VerifyMFA VerifyMFA completes a login that returned mfa_required.
+func (c *ConsoleAuthHTTPClientImpl) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...http.CallOption) (*AuthResponse, error) {
+	var out AuthResponse
+	pattern := "/console/v1/login/mfa"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleAuthVerifyMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationConsoleMFAConfirmMFA = "/api.auth.v1.ConsoleMFA/ConfirmMFA"
+const OperationConsoleMFADisableMFA = "/api.auth.v1.ConsoleMFA/DisableMFA"
+const OperationConsoleMFAEnrollMFA = "/api.auth.v1.ConsoleMFA/EnrollMFA"
+const OperationConsoleMFAGetMFAPolicy = "/api.auth.v1.ConsoleMFA/GetMFAPolicy"
+const OperationConsoleMFAGetMFAStatus = "/api.auth.v1.ConsoleMFA/GetMFAStatus"
+const OperationConsoleMFARegenerateRecoveryCodes = "/api.auth.v1.ConsoleMFA/RegenerateRecoveryCodes"
+const OperationConsoleMFAUpdateMFAPolicy = "/api.auth.v1.ConsoleMFA/UpdateMFAPolicy"
+
+type ConsoleMFAHTTPServer interface {
+	ConfirmMFA(context.Context, *ConfirmMFARequest) (*MFARecoveryCodes, error)
+	DisableMFA(context.Context, *DisableMFARequest) (*emptypb.Empty, error)
+	EnrollMFA(context.Context, *EnrollMFARequest) (*MFAEnrollment, error)
+	GetMFAPolicy(context.Context, *GetMFAPolicyRequest) (*MFAPolicyResponse, error)
+	GetMFAStatus(context.Context, *GetMFAStatusRequest) (*MFAStatus, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*MFARecoveryCodes, error)
+	UpdateMFAPolicy(context.Context, *UpdateMFAPolicyRequest) (*MFAPolicyResponse, error)
+}
+
+func RegisterConsoleMFAHTTPServer(s *http.Server, srv ConsoleMFAHTTPServer) {
+	r := s.Route("/")
+	r.GET("/console/v1/mfa", _ConsoleMFA_GetMFAStatus0_HTTP_Handler(srv))
+	r.POST("/console/v1/mfa/enroll", _ConsoleMFA_EnrollMFA0_HTTP_Handler(srv))
+	r.POST("/console/v1/mfa/confirm", _ConsoleMFA_ConfirmMFA0_HTTP_Handler(srv))
+	r.POST("/console/v1/mfa/disable", _ConsoleMFA_DisableMFA0_HTTP_Handler(srv))
+	r.POST("/console/v1/mfa/recovery_codes", _ConsoleMFA_RegenerateRecoveryCodes0_HTTP_Handler(srv))
+	r.GET("/console/v1/mfa/policy", _ConsoleMFA_GetMFAPolicy0_HTTP_Handler(srv))
+	r.PUT("/console/v1/mfa/policy", _ConsoleMFA_UpdateMFAPolicy0_HTTP_Handler(srv))
+}
+
+func _ConsoleMFA_GetMFAStatus0_HTTP_Handler(srv ConsoleMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMFAStatusRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleMFAGetMFAStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMFAStatus(ctx, req.(*GetMFAStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAStatus)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleMFA_EnrollMFA0_HTTP_Handler(srv ConsoleMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleMFAEnrollMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollMFA(ctx, req.(*EnrollMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAEnrollment)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleMFA_ConfirmMFA0_HTTP_Handler(srv ConsoleMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleMFAConfirmMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmMFA(ctx, req.(*ConfirmMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFARecoveryCodes)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleMFA_DisableMFA0_HTTP_Handler(srv ConsoleMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DisableMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleMFADisableMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableMFA(ctx, req.(*DisableMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleMFA_RegenerateRecoveryCodes0_HTTP_Handler(srv ConsoleMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RegenerateRecoveryCodesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleMFARegenerateRecoveryCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFARecoveryCodes)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleMFA_GetMFAPolicy0_HTTP_Handler(srv ConsoleMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMFAPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleMFAGetMFAPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMFAPolicy(ctx, req.(*GetMFAPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleMFA_UpdateMFAPolicy0_HTTP_Handler(srv ConsoleMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMFAPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleMFAUpdateMFAPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMFAPolicy(ctx, req.(*UpdateMFAPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ConsoleMFAHTTPClient interface {
+	ConfirmMFA(ctx context.Context, req *ConfirmMFARequest, opts ...http.CallOption) (rsp *MFARecoveryCodes, err error)
+	DisableMFA(ctx context.Context, req *DisableMFARequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	EnrollMFA(ctx context.Context, req *EnrollMFARequest, opts ...http.CallOption) (rsp *MFAEnrollment, err error)
+	GetMFAPolicy(ctx context.Context, req *GetMFAPolicyRequest, opts ...http.CallOption) (rsp *MFAPolicyResponse, err error)
+	GetMFAStatus(ctx context.Context, req *GetMFAStatusRequest, opts ...http.CallOption) (rsp *MFAStatus, err error)
+	RegenerateRecoveryCodes(ctx context.Context, req *RegenerateRecoveryCodesRequest, opts ...http.CallOption) (rsp *MFARecoveryCodes, err error)
+	UpdateMFAPolicy(ctx context.Context, req *UpdateMFAPolicyRequest, opts ...http.CallOption) (rsp *MFAPolicyResponse, err error)
+}
+
+type ConsoleMFAHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewConsoleMFAHTTPClient(client *http.Client) ConsoleMFAHTTPClient {
+	return &ConsoleMFAHTTPClientImpl{client}
+}
+
+func (c *ConsoleMFAHTTPClientImpl) ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...http.CallOption) (*MFARecoveryCodes, error) {
+	var out MFARecoveryCodes
+	pattern := "/console/v1/mfa/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleMFAConfirmMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleMFAHTTPClientImpl) DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/console/v1/mfa/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleMFADisableMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleMFAHTTPClientImpl) EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...http.CallOption) (*MFAEnrollment, error) {
+	var out MFAEnrollment
+	pattern := "/console/v1/mfa/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleMFAEnrollMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleMFAHTTPClientImpl) GetMFAPolicy(ctx context.Context, in *GetMFAPolicyRequest, opts ...http.CallOption) (*MFAPolicyResponse, error) {
+	var out MFAPolicyResponse
+	pattern := "/console/v1/mfa/policy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleMFAGetMFAPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleMFAHTTPClientImpl) GetMFAStatus(ctx context.Context, in *GetMFAStatusRequest, opts ...http.CallOption) (*MFAStatus, error) {
+	var out MFAStatus
+	pattern := "/console/v1/mfa"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleMFAGetMFAStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleMFAHTTPClientImpl) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...http.CallOption) (*MFARecoveryCodes, error) {
+	var out MFARecoveryCodes
+	pattern := "/console/v1/mfa/recovery_codes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleMFARegenerateRecoveryCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleMFAHTTPClientImpl) UpdateMFAPolicy(ctx context.Context, in *UpdateMFAPolicyRequest, opts ...http.CallOption) (*MFAPolicyResponse, error) {
+	var out MFAPolicyResponse
+	pattern := "/console/v1/mfa/policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleMFAUpdateMFAPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationConsoleSSOGetSSOConfig = "/api.auth.v1.ConsoleSSO/GetSSOConfig"
+const OperationConsoleSSOUpdateSSOConfig = "/api.auth.v1.ConsoleSSO/UpdateSSOConfig"
+
+type ConsoleSSOHTTPServer interface {
+	GetSSOConfig(context.Context, *GetSSOConfigRequest) (*SSOConfigResponse, error)
+	UpdateSSOConfig(context.Context, *UpdateSSOConfigRequest) (*SSOConfigResponse, error)
+}
+
+func RegisterConsoleSSOHTTPServer(s *http.Server, srv ConsoleSSOHTTPServer) {
+	r := s.Route("/")
+	r.GET("/console/v1/sso/config", _ConsoleSSO_GetSSOConfig0_HTTP_Handler(srv))
+	r.PUT("/console/v1/sso/config", _ConsoleSSO_UpdateSSOConfig0_HTTP_Handler(srv))
+}
+
+func _ConsoleSSO_GetSSOConfig0_HTTP_Handler(srv ConsoleSSOHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSSOConfigRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleSSOGetSSOConfig)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSSOConfig(ctx, req.(*GetSSOConfigRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SSOConfigResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleSSO_UpdateSSOConfig0_HTTP_Handler(srv ConsoleSSOHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateSSOConfigRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleSSOUpdateSSOConfig)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSSOConfig(ctx, req.(*UpdateSSOConfigRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SSOConfigResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ConsoleSSOHTTPClient interface {
+	GetSSOConfig(ctx context.Context, req *GetSSOConfigRequest, opts ...http.CallOption) (rsp *SSOConfigResponse, err error)
+	UpdateSSOConfig(ctx context.Context, req *UpdateSSOConfigRequest, opts ...http.CallOption) (rsp *SSOConfigResponse, err error)
+}
+
+type ConsoleSSOHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewConsoleSSOHTTPClient(client *http.Client) ConsoleSSOHTTPClient {
+	return &ConsoleSSOHTTPClientImpl{client}
+}
+
+func (c *ConsoleSSOHTTPClientImpl) GetSSOConfig(ctx context.Context, in *GetSSOConfigRequest, opts ...http.CallOption) (*SSOConfigResponse, error) {
+	var out SSOConfigResponse
+	pattern := "/console/v1/sso/config"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleSSOGetSSOConfig))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleSSOHTTPClientImpl) UpdateSSOConfig(ctx context.Context, in *UpdateSSOConfigRequest, opts ...http.CallOption) (*SSOConfigResponse, error) {
+	var out SSOConfigResponse
+	pattern := "/console/v1/sso/config"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleSSOUpdateSSOConfig))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationPlatformAuthEnrollMFAChallenge = "/api.auth.v1.PlatformAuth/EnrollMFAChallenge"
+const OperationPlatformAuthLogin = "/api.auth.v1.PlatformAuth/Login"
+const OperationPlatformAuthLogout = "/api.auth.v1.PlatformAuth/Logout"
+const OperationPlatformAuthLogoutAll = "/api.auth.v1.PlatformAuth/LogoutAll"
+const OperationPlatformAuthRefresh = "/api.auth.v1.PlatformAuth/Refresh"
+const OperationPlatformAuthVerifyMFA = "/api.auth.v1.PlatformAuth/VerifyMFA"
+
+type PlatformAuthHTTPServer interface {
+	EnrollMFAChallenge(context.Context, *EnrollMFAChallengeRequest) (*MFAEnrollment, error)
+	Login(context.Context, *PlatformLoginRequest) (*AuthResponse, error)
+	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	LogoutAll(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	Refresh(context.Context, *RefreshTokenRequest) (*AuthResponse, error)
+	VerifyMFA(context.Context, *VerifyMFARequest) (*AuthResponse, error)
+}
+
+func RegisterPlatformAuthHTTPServer(s *http.Server, srv PlatformAuthHTTPServer) {
+	r := s.Route("/")
+	r.POST("/platform/v1/login", _PlatformAuth_Login1_HTTP_Handler(srv))
+	r.POST("/platform/v1/token/refresh", _PlatformAuth_Refresh1_HTTP_Handler(srv))
+	r.POST("/platform/v1/logout", _PlatformAuth_Logout1_HTTP_Handler(srv))
+	r.POST("/platform/v1/logout_all", _PlatformAuth_LogoutAll1_HTTP_Handler(srv))
+	r.POST("/platform/v1/login/mfa", _PlatformAuth_VerifyMFA1_HTTP_Handler(srv))
+	r.POST("/platform/v1/login/mfa/enroll", _PlatformAuth_EnrollMFAChallenge1_HTTP_Handler(srv))
+}
+
+func _PlatformAuth_Login1_HTTP_Handler(srv PlatformAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PlatformLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformAuthLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Login(ctx, req.(*PlatformLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuthResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformAuth_Refresh1_HTTP_Handler(srv PlatformAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformAuthRefresh)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Refresh(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuthResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformAuth_Logout1_HTTP_Handler(srv PlatformAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformAuthLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformAuth_LogoutAll1_HTTP_Handler(srv PlatformAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformAuthLogoutAll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LogoutAll(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformAuth_VerifyMFA1_HTTP_Handler(srv PlatformAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformAuthVerifyMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyMFA(ctx, req.(*VerifyMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuthResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformAuth_EnrollMFAChallenge1_HTTP_Handler(srv PlatformAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollMFAChallengeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformAuthEnrollMFAChallenge)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollMFAChallenge(ctx, req.(*EnrollMFAChallengeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAEnrollment)
+		return ctx.Result(200, reply)
+	}
+}
+
+type PlatformAuthHTTPClient interface {
+	EnrollMFAChallenge(ctx context.Context, req *EnrollMFAChallengeRequest, opts ...http.CallOption) (rsp *MFAEnrollment, err error)
+	Login(ctx context.Context, req *PlatformLoginRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	LogoutAll(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	Refresh(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
+	VerifyMFA(ctx context.Context, req *VerifyMFARequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
+}
+
+type PlatformAuthHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewPlatformAuthHTTPClient(client *http.Client) PlatformAuthHTTPClient {
+	return &PlatformAuthHTTPClientImpl{client}
+}
+
+func (c *PlatformAuthHTTPClientImpl) EnrollMFAChallenge(ctx context.Context, in *EnrollMFAChallengeRequest, opts ...http.CallOption) (*MFAEnrollment, error) {
+	var out MFAEnrollment
+	pattern := "/platform/v1/login/mfa/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformAuthEnrollMFAChallenge))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformAuthHTTPClientImpl) Login(ctx context.Context, in *PlatformLoginRequest, opts ...http.CallOption) (*AuthResponse, error) {
+	var out AuthResponse
+	pattern := "/platform/v1/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformAuthLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformAuthHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/platform/v1/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformAuthLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformAuthHTTPClientImpl) LogoutAll(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/platform/v1/logout_all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformAuthLogoutAll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformAuthHTTPClientImpl) Refresh(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*AuthResponse, error) {
+	var out AuthResponse
+	pattern := "/platform/v1/token/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformAuthRefresh))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformAuthHTTPClientImpl) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...http.CallOption) (*AuthResponse, error) {
+	var out AuthResponse
+	pattern := "/platform/v1/login/mfa"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformAuthVerifyMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationPlatformMFAConfirmMFA = "/api.auth.v1.PlatformMFA/ConfirmMFA"
+const OperationPlatformMFADisableMFA = "/api.auth.v1.PlatformMFA/DisableMFA"
+const OperationPlatformMFAEnrollMFA = "/api.auth.v1.PlatformMFA/EnrollMFA"
+const OperationPlatformMFAGetMFAPolicy = "/api.auth.v1.PlatformMFA/GetMFAPolicy"
+const OperationPlatformMFAGetMFAStatus = "/api.auth.v1.PlatformMFA/GetMFAStatus"
+const OperationPlatformMFARegenerateRecoveryCodes = "/api.auth.v1.PlatformMFA/RegenerateRecoveryCodes"
+const OperationPlatformMFAUpdateMFAPolicy = "/api.auth.v1.PlatformMFA/UpdateMFAPolicy"
+
+type PlatformMFAHTTPServer interface {
+	ConfirmMFA(context.Context, *ConfirmMFARequest) (*MFARecoveryCodes, error)
+	DisableMFA(context.Context, *DisableMFARequest) (*emptypb.Empty, error)
+	EnrollMFA(context.Context, *EnrollMFARequest) (*MFAEnrollment, error)
+	GetMFAPolicy(context.Context, *GetMFAPolicyRequest) (*MFAPolicyResponse, error)
+	GetMFAStatus(context.Context, *GetMFAStatusRequest) (*MFAStatus, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*MFARecoveryCodes, error)
+	UpdateMFAPolicy(context.Context, *UpdateMFAPolicyRequest) (*MFAPolicyResponse, error)
+}
+
+func RegisterPlatformMFAHTTPServer(s *http.Server, srv PlatformMFAHTTPServer) {
+	r := s.Route("/")
+	r.GET("/platform/v1/mfa", _PlatformMFA_GetMFAStatus1_HTTP_Handler(srv))
+	r.POST("/platform/v1/mfa/enroll", _PlatformMFA_EnrollMFA1_HTTP_Handler(srv))
+	r.POST("/platform/v1/mfa/confirm", _PlatformMFA_ConfirmMFA1_HTTP_Handler(srv))
+	r.POST("/platform/v1/mfa/disable", _PlatformMFA_DisableMFA1_HTTP_Handler(srv))
+	r.POST("/platform/v1/mfa/recovery_codes", _PlatformMFA_RegenerateRecoveryCodes1_HTTP_Handler(srv))
+	r.GET("/platform/v1/mfa/policy", _PlatformMFA_GetMFAPolicy1_HTTP_Handler(srv))
+	r.PUT("/platform/v1/mfa/policy", _PlatformMFA_UpdateMFAPolicy1_HTTP_Handler(srv))
+}
+
+func _PlatformMFA_GetMFAStatus1_HTTP_Handler(srv PlatformMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMFAStatusRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformMFAGetMFAStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMFAStatus(ctx, req.(*GetMFAStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAStatus)
+		return ctx.Result(200, reply)
+	}
 }
 
-type ConsoleAuthHTTPClientImpl struct {
+func _PlatformMFA_EnrollMFA1_HTTP_Handler(srv PlatformMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformMFAEnrollMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollMFA(ctx, req.(*EnrollMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAEnrollment)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformMFA_ConfirmMFA1_HTTP_Handler(srv PlatformMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformMFAConfirmMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmMFA(ctx, req.(*ConfirmMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFARecoveryCodes)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformMFA_DisableMFA1_HTTP_Handler(srv PlatformMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DisableMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformMFADisableMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableMFA(ctx, req.(*DisableMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformMFA_RegenerateRecoveryCodes1_HTTP_Handler(srv PlatformMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RegenerateRecoveryCodesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformMFARegenerateRecoveryCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFARecoveryCodes)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformMFA_GetMFAPolicy1_HTTP_Handler(srv PlatformMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMFAPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformMFAGetMFAPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMFAPolicy(ctx, req.(*GetMFAPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformMFA_UpdateMFAPolicy1_HTTP_Handler(srv PlatformMFAHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMFAPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformMFAUpdateMFAPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMFAPolicy(ctx, req.(*UpdateMFAPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type PlatformMFAHTTPClient interface {
+	ConfirmMFA(ctx context.Context, req *ConfirmMFARequest, opts ...http.CallOption) (rsp *MFARecoveryCodes, err error)
+	DisableMFA(ctx context.Context, req *DisableMFARequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	EnrollMFA(ctx context.Context, req *EnrollMFARequest, opts ...http.CallOption) (rsp *MFAEnrollment, err error)
+	GetMFAPolicy(ctx context.Context, req *GetMFAPolicyRequest, opts ...http.CallOption) (rsp *MFAPolicyResponse, err error)
+	GetMFAStatus(ctx context.Context, req *GetMFAStatusRequest, opts ...http.CallOption) (rsp *MFAStatus, err error)
+	RegenerateRecoveryCodes(ctx context.Context, req *RegenerateRecoveryCodesRequest, opts ...http.CallOption) (rsp *MFARecoveryCodes, err error)
+	UpdateMFAPolicy(ctx context.Context, req *UpdateMFAPolicyRequest, opts ...http.CallOption) (rsp *MFAPolicyResponse, err error)
+}
+
+type PlatformMFAHTTPClientImpl struct {
 	cc *http.Client
 }
 
-func NewConsoleAuthHTTPClient(client *http.Client) ConsoleAuthHTTPClient {
-	return &ConsoleAuthHTTPClientImpl{client}
+func NewPlatformMFAHTTPClient(client *http.Client) PlatformMFAHTTPClient {
+	return &PlatformMFAHTTPClientImpl{client}
 }
 
-func (c *ConsoleAuthHTTPClientImpl) Login(ctx context.Context, in *ConsoleLoginRequest, opts ...http.CallOption) (*AuthResponse, error) {
-	var out AuthResponse
-	pattern := "/console/v1/login"
+func (c *PlatformMFAHTTPClientImpl) ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...http.CallOption) (*MFARecoveryCodes, error) {
+	var out MFARecoveryCodes
+	pattern := "/platform/v1/mfa/confirm"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationConsoleAuthLogin))
+	opts = append(opts, http.Operation(OperationPlatformMFAConfirmMFA))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -103,11 +1384,11 @@ func (c *ConsoleAuthHTTPClientImpl) Login(ctx context.Context, in *ConsoleLoginR
 	return &out, nil
 }
 
-func (c *ConsoleAuthHTTPClientImpl) Register(ctx context.Context, in *ConsoleRegisterRequest, opts ...http.CallOption) (*AuthResponse, error) {
-	var out AuthResponse
-	pattern := "/console/v1/register"
+func (c *PlatformMFAHTTPClientImpl) DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/platform/v1/mfa/disable"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationConsoleAuthRegister))
+	opts = append(opts, http.Operation(OperationPlatformMFADisableMFA))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -116,56 +1397,202 @@ func (c *ConsoleAuthHTTPClientImpl) Register(ctx context.Context, in *ConsoleReg
 	return &out, nil
 }
 
-const OperationPlatformAuthLogin = "/api.auth.v1.PlatformAuth/Login"
+func (c *PlatformMFAHTTPClientImpl) EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...http.CallOption) (*MFAEnrollment, error) {
+	var out MFAEnrollment
+	pattern := "/platform/v1/mfa/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformMFAEnrollMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
 
-type PlatformAuthHTTPServer interface {
-	Login(context.Context, *PlatformLoginRequest) (*AuthResponse, error)
+func (c *PlatformMFAHTTPClientImpl) GetMFAPolicy(ctx context.Context, in *GetMFAPolicyRequest, opts ...http.CallOption) (*MFAPolicyResponse, error) {
+	var out MFAPolicyResponse
+	pattern := "/platform/v1/mfa/policy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPlatformMFAGetMFAPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-func RegisterPlatformAuthHTTPServer(s *http.Server, srv PlatformAuthHTTPServer) {
+func (c *PlatformMFAHTTPClientImpl) GetMFAStatus(ctx context.Context, in *GetMFAStatusRequest, opts ...http.CallOption) (*MFAStatus, error) {
+	var out MFAStatus
+	pattern := "/platform/v1/mfa"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPlatformMFAGetMFAStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformMFAHTTPClientImpl) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...http.CallOption) (*MFARecoveryCodes, error) {
+	var out MFARecoveryCodes
+	pattern := "/platform/v1/mfa/recovery_codes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformMFARegenerateRecoveryCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformMFAHTTPClientImpl) UpdateMFAPolicy(ctx context.Context, in *UpdateMFAPolicyRequest, opts ...http.CallOption) (*MFAPolicyResponse, error) {
+	var out MFAPolicyResponse
+	pattern := "/platform/v1/mfa/policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformMFAUpdateMFAPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationPlatformImpersonationImpersonateTenant = "/api.auth.v1.PlatformImpersonation/ImpersonateTenant"
+const OperationPlatformImpersonationListImpersonationSessions = "/api.auth.v1.PlatformImpersonation/ListImpersonationSessions"
+const OperationPlatformImpersonationRevokeImpersonationSession = "/api.auth.v1.PlatformImpersonation/RevokeImpersonationSession"
+
+type PlatformImpersonationHTTPServer interface {
+	ImpersonateTenant(context.Context, *ImpersonateTenantRequest) (*ImpersonateTenantResponse, error)
+	ListImpersonationSessions(context.Context, *ListImpersonationSessionsRequest) (*ListImpersonationSessionsResponse, error)
+	RevokeImpersonationSession(context.Context, *RevokeImpersonationSessionRequest) (*ImpersonationSessionResponse, error)
+}
+
+func RegisterPlatformImpersonationHTTPServer(s *http.Server, srv PlatformImpersonationHTTPServer) {
 	r := s.Route("/")
-	r.POST("/platform/v1/login", _PlatformAuth_Login1_HTTP_Handler(srv))
+	r.POST("/platform/v1/tenants/{tenant_id}/impersonate", _PlatformImpersonation_ImpersonateTenant0_HTTP_Handler(srv))
+	r.GET("/platform/v1/impersonation_sessions", _PlatformImpersonation_ListImpersonationSessions0_HTTP_Handler(srv))
+	r.POST("/platform/v1/impersonation_sessions/{id}/revoke", _PlatformImpersonation_RevokeImpersonationSession0_HTTP_Handler(srv))
 }
 
-func _PlatformAuth_Login1_HTTP_Handler(srv PlatformAuthHTTPServer) func(ctx http.Context) error {
+func _PlatformImpersonation_ImpersonateTenant0_HTTP_Handler(srv PlatformImpersonationHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in PlatformLoginRequest
+		var in ImpersonateTenantRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationPlatformAuthLogin)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformImpersonationImpersonateTenant)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.Login(ctx, req.(*PlatformLoginRequest))
+			return srv.ImpersonateTenant(ctx, req.(*ImpersonateTenantRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AuthResponse)
+		reply := out.(*ImpersonateTenantResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-type PlatformAuthHTTPClient interface {
-	Login(ctx context.Context, req *PlatformLoginRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
+func _PlatformImpersonation_ListImpersonationSessions0_HTTP_Handler(srv PlatformImpersonationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListImpersonationSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformImpersonationListImpersonationSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListImpersonationSessions(ctx, req.(*ListImpersonationSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListImpersonationSessionsResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-type PlatformAuthHTTPClientImpl struct {
+func _PlatformImpersonation_RevokeImpersonationSession0_HTTP_Handler(srv PlatformImpersonationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeImpersonationSessionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformImpersonationRevokeImpersonationSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeImpersonationSession(ctx, req.(*RevokeImpersonationSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImpersonationSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type PlatformImpersonationHTTPClient interface {
+	ImpersonateTenant(ctx context.Context, req *ImpersonateTenantRequest, opts ...http.CallOption) (rsp *ImpersonateTenantResponse, err error)
+	ListImpersonationSessions(ctx context.Context, req *ListImpersonationSessionsRequest, opts ...http.CallOption) (rsp *ListImpersonationSessionsResponse, err error)
+	RevokeImpersonationSession(ctx context.Context, req *RevokeImpersonationSessionRequest, opts ...http.CallOption) (rsp *ImpersonationSessionResponse, err error)
+}
+
+type PlatformImpersonationHTTPClientImpl struct {
 	cc *http.Client
 }
 
-func NewPlatformAuthHTTPClient(client *http.Client) PlatformAuthHTTPClient {
-	return &PlatformAuthHTTPClientImpl{client}
+func NewPlatformImpersonationHTTPClient(client *http.Client) PlatformImpersonationHTTPClient {
+	return &PlatformImpersonationHTTPClientImpl{client}
 }
 
-func (c *PlatformAuthHTTPClientImpl) Login(ctx context.Context, in *PlatformLoginRequest, opts ...http.CallOption) (*AuthResponse, error) {
-	var out AuthResponse
-	pattern := "/platform/v1/login"
+func (c *PlatformImpersonationHTTPClientImpl) ImpersonateTenant(ctx context.Context, in *ImpersonateTenantRequest, opts ...http.CallOption) (*ImpersonateTenantResponse, error) {
+	var out ImpersonateTenantResponse
+	pattern := "/platform/v1/tenants/{tenant_id}/impersonate"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationPlatformAuthLogin))
+	opts = append(opts, http.Operation(OperationPlatformImpersonationImpersonateTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformImpersonationHTTPClientImpl) ListImpersonationSessions(ctx context.Context, in *ListImpersonationSessionsRequest, opts ...http.CallOption) (*ListImpersonationSessionsResponse, error) {
+	var out ListImpersonationSessionsResponse
+	pattern := "/platform/v1/impersonation_sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPlatformImpersonationListImpersonationSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformImpersonationHTTPClientImpl) RevokeImpersonationSession(ctx context.Context, in *RevokeImpersonationSessionRequest, opts ...http.CallOption) (*ImpersonationSessionResponse, error) {
+	var out ImpersonationSessionResponse
+	pattern := "/platform/v1/impersonation_sessions/{id}/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformImpersonationRevokeImpersonationSession))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -173,3 +1600,58 @@ func (c *PlatformAuthHTTPClientImpl) Login(ctx context.Context, in *PlatformLogi
 	}
 	return &out, nil
 }
+
+const OperationConsoleImpersonationListImpersonationSessions = "/api.auth.v1.ConsoleImpersonation/ListImpersonationSessions"
+
+type ConsoleImpersonationHTTPServer interface {
+	ListImpersonationSessions(context.Context, *ListImpersonationSessionsRequest) (*ListImpersonationSessionsResponse, error)
+}
+
+func RegisterConsoleImpersonationHTTPServer(s *http.Server, srv ConsoleImpersonationHTTPServer) {
+	r := s.Route("/")
+	r.GET("/console/v1/impersonation_sessions", _ConsoleImpersonation_ListImpersonationSessions1_HTTP_Handler(srv))
+}
+
+func _ConsoleImpersonation_ListImpersonationSessions1_HTTP_Handler(srv ConsoleImpersonationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListImpersonationSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleImpersonationListImpersonationSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListImpersonationSessions(ctx, req.(*ListImpersonationSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListImpersonationSessionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ConsoleImpersonationHTTPClient interface {
+	ListImpersonationSessions(ctx context.Context, req *ListImpersonationSessionsRequest, opts ...http.CallOption) (rsp *ListImpersonationSessionsResponse, err error)
+}
+
+type ConsoleImpersonationHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewConsoleImpersonationHTTPClient(client *http.Client) ConsoleImpersonationHTTPClient {
+	return &ConsoleImpersonationHTTPClientImpl{client}
+}
+
+func (c *ConsoleImpersonationHTTPClientImpl) ListImpersonationSessions(ctx context.Context, in *ListImpersonationSessionsRequest, opts ...http.CallOption) (*ListImpersonationSessionsResponse, error) {
+	var out ListImpersonationSessionsResponse
+	pattern := "/console/v1/impersonation_sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleImpersonationListImpersonationSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
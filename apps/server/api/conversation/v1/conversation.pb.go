@@ -213,8 +213,10 @@ type Session struct {
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	ClosedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Verified access labels of the end user.
+	Entitlements  []string `protobuf:"bytes,11,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Session) Reset() {
@@ -317,12 +319,22 @@ func (x *Session) GetClosedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Session) GetEntitlements() []string {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
 type CreateSessionRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserExternalId string                 `protobuf:"bytes,2,opt,name=user_external_id,json=userExternalId,proto3" json:"user_external_id,omitempty"`
 	Metadata       *structpb.Struct       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// HS256 JWT signed by the tenant's backend with the API key's entitlement
+	// secret; its "entitlements" claim lists the end user's access labels.
+	EntitlementToken string `protobuf:"bytes,4,opt,name=entitlement_token,json=entitlementToken,proto3" json:"entitlement_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CreateSessionRequest) Reset() {
@@ -369,6 +381,13 @@ func (x *CreateSessionRequest) GetMetadata() *structpb.Struct {
 	return nil
 }
 
+func (x *CreateSessionRequest) GetEntitlementToken() string {
+	if x != nil {
+		return x.EntitlementToken
+	}
+	return ""
+}
+
 type CreateSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
@@ -887,7 +906,7 @@ const file_api_conversation_v1_conversation_proto_rawDesc = "" +
 	"references\x18\x06 \x03(\v2\x1e.api.conversation.v1.ReferenceR\n" +
 	"references\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x96\x03\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xba\x03\n" +
 	"\aSession\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
@@ -901,10 +920,12 @@ const file_api_conversation_v1_conversation_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x127\n" +
 	"\tclosed_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\"{\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12\"\n" +
+	"\fentitlements\x18\v \x03(\tR\fentitlements\"\xa8\x01\n" +
 	"\x14CreateSessionRequest\x12(\n" +
 	"\x10user_external_id\x18\x02 \x01(\tR\x0euserExternalId\x123\n" +
-	"\bmetadata\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetadataJ\x04\b\x01\x10\x02\"O\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12+\n" +
+	"\x11entitlement_token\x18\x04 \x01(\tR\x10entitlementTokenJ\x04\b\x01\x10\x02\"O\n" +
 	"\x15CreateSessionResponse\x126\n" +
 	"\asession\x18\x01 \x01(\v2\x1c.api.conversation.v1.SessionR\asession\"\x8b\x01\n" +
 	"\x11GetSessionRequest\x12\x1d\n" +
@@ -27,56 +27,123 @@ var File_api_iam_v1_console_iam_proto protoreflect.FileDescriptor
 const file_api_iam_v1_console_iam_proto_rawDesc = "" +
 	"\n" +
 	"\x1capi/iam/v1/console_iam.proto\x12\n" +
-	"api.iam.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x14api/iam/v1/iam.proto2\xde\a\n" +
+	"api.iam.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x14api/iam/v1/iam.proto2\x8b\x17\n" +
 	"\n" +
 	"ConsoleIAM\x12w\n" +
 	"\n" +
 	"CreateUser\x12\x1d.api.iam.v1.CreateUserRequest\x1a\x18.api.iam.v1.UserResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/console/v1/tenants/{tenant_id}/users\x12w\n" +
-	"\tListUsers\x12\x1c.api.iam.v1.ListUsersRequest\x1a\x1d.api.iam.v1.ListUsersResponse\"-\x82\xd3\xe4\x93\x02'\x12%/console/v1/tenants/{tenant_id}/users\x12c\n" +
+	"\tListUsers\x12\x1c.api.iam.v1.ListUsersRequest\x1a\x1d.api.iam.v1.ListUsersResponse\"-\x82\xd3\xe4\x93\x02'\x12%/console/v1/tenants/{tenant_id}/users\x12m\n" +
+	"\n" +
+	"UpdateUser\x12\x1d.api.iam.v1.UpdateUserRequest\x1a\x18.api.iam.v1.UserResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/console/v1/users/{user_id}\x12h\n" +
+	"\n" +
+	"DeleteUser\x12\x1d.api.iam.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/console/v1/users/{user_id}\x12x\n" +
+	"\fResendInvite\x12\x1f.api.iam.v1.ResendInviteRequest\x1a\x18.api.iam.v1.UserResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/console/v1/users/{user_id}/invite\x12\x88\x01\n" +
+	"\x11ResetUserPassword\x12$.api.iam.v1.ResetUserPasswordRequest\x1a\x16.google.protobuf.Empty\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/console/v1/users/{user_id}/password_reset\x12c\n" +
 	"\n" +
 	"CreateRole\x12\x1d.api.iam.v1.CreateRoleRequest\x1a\x18.api.iam.v1.RoleResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/console/v1/roles\x12c\n" +
-	"\tListRoles\x12\x1c.api.iam.v1.ListRolesRequest\x1a\x1d.api.iam.v1.ListRolesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/console/v1/roles\x12q\n" +
+	"\tListRoles\x12\x1c.api.iam.v1.ListRolesRequest\x1a\x1d.api.iam.v1.ListRolesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/console/v1/roles\x12m\n" +
+	"\n" +
+	"UpdateRole\x12\x1d.api.iam.v1.UpdateRoleRequest\x1a\x18.api.iam.v1.RoleResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/console/v1/roles/{role_id}\x12h\n" +
+	"\n" +
+	"DeleteRole\x12\x1d.api.iam.v1.DeleteRoleRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/console/v1/roles/{role_id}\x12q\n" +
 	"\n" +
 	"AssignRole\x12\x1d.api.iam.v1.AssignRoleRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/console/v1/users/{user_id}/roles\x12{\n" +
+	"\rListUserRoles\x12 .api.iam.v1.ListUserRolesRequest\x1a\x1d.api.iam.v1.ListRolesResponse\")\x82\xd3\xe4\x93\x02#\x12!/console/v1/users/{user_id}/roles\x12x\n" +
+	"\n" +
+	"RemoveRole\x12\x1d.api.iam.v1.RemoveRoleRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-*+/console/v1/users/{user_id}/roles/{role_id}\x12\x95\x01\n" +
+	"\x14ListUserRoleBindings\x12 .api.iam.v1.ListUserRolesRequest\x1a(.api.iam.v1.ListUserRoleBindingsResponse\"1\x82\xd3\xe4\x93\x02+\x12)/console/v1/users/{user_id}/role_bindings\x12\x88\x01\n" +
+	"\x13CreateResourceGroup\x12&.api.iam.v1.CreateResourceGroupRequest\x1a!.api.iam.v1.ResourceGroupResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/console/v1/resource_groups\x12\x88\x01\n" +
+	"\x12ListResourceGroups\x12%.api.iam.v1.ListResourceGroupsRequest\x1a&.api.iam.v1.ListResourceGroupsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/console/v1/resource_groups\x12\x8a\x01\n" +
+	"\x10GetResourceGroup\x12#.api.iam.v1.GetResourceGroupRequest\x1a!.api.iam.v1.ResourceGroupResponse\".\x82\xd3\xe4\x93\x02(\x12&/console/v1/resource_groups/{group_id}\x12\x93\x01\n" +
+	"\x13UpdateResourceGroup\x12&.api.iam.v1.UpdateResourceGroupRequest\x1a!.api.iam.v1.ResourceGroupResponse\"1\x82\xd3\xe4\x93\x02+:\x01*2&/console/v1/resource_groups/{group_id}\x12\xa3\x01\n" +
+	"\x17SetResourceGroupMembers\x12*.api.iam.v1.SetResourceGroupMembersRequest\x1a!.api.iam.v1.ResourceGroupResponse\"9\x82\xd3\xe4\x93\x023:\x01*\x1a./console/v1/resource_groups/{group_id}/members\x12\x85\x01\n" +
+	"\x13DeleteResourceGroup\x12&.api.iam.v1.DeleteResourceGroupRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(*&/console/v1/resource_groups/{group_id}\x12{\n" +
 	"\x0fListPermissions\x12\".api.iam.v1.ListPermissionsRequest\x1a#.api.iam.v1.ListPermissionsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/console/v1/permissions\x12\x8d\x01\n" +
 	"\x15AssignRolePermissions\x12(.api.iam.v1.AssignRolePermissionsRequest\x1a\x16.google.protobuf.Empty\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/console/v1/roles/{role_id}/permissions\x12\x93\x01\n" +
 	"\x13ListRolePermissions\x12&.api.iam.v1.ListRolePermissionsRequest\x1a#.api.iam.v1.ListPermissionsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/console/v1/roles/{role_id}/permissionsB4Z2github.com/ZTH7/RagoDesk/apps/server/api/iam/v1;v1b\x06proto3"
 
 var file_api_iam_v1_console_iam_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),            // 0: api.iam.v1.CreateUserRequest
-	(*ListUsersRequest)(nil),             // 1: api.iam.v1.ListUsersRequest
-	(*CreateRoleRequest)(nil),            // 2: api.iam.v1.CreateRoleRequest
-	(*ListRolesRequest)(nil),             // 3: api.iam.v1.ListRolesRequest
-	(*AssignRoleRequest)(nil),            // 4: api.iam.v1.AssignRoleRequest
-	(*ListPermissionsRequest)(nil),       // 5: api.iam.v1.ListPermissionsRequest
-	(*AssignRolePermissionsRequest)(nil), // 6: api.iam.v1.AssignRolePermissionsRequest
-	(*ListRolePermissionsRequest)(nil),   // 7: api.iam.v1.ListRolePermissionsRequest
-	(*UserResponse)(nil),                 // 8: api.iam.v1.UserResponse
-	(*ListUsersResponse)(nil),            // 9: api.iam.v1.ListUsersResponse
-	(*RoleResponse)(nil),                 // 10: api.iam.v1.RoleResponse
-	(*ListRolesResponse)(nil),            // 11: api.iam.v1.ListRolesResponse
-	(*emptypb.Empty)(nil),                // 12: google.protobuf.Empty
-	(*ListPermissionsResponse)(nil),      // 13: api.iam.v1.ListPermissionsResponse
+	(*CreateUserRequest)(nil),              // 0: api.iam.v1.CreateUserRequest
+	(*ListUsersRequest)(nil),               // 1: api.iam.v1.ListUsersRequest
+	(*UpdateUserRequest)(nil),              // 2: api.iam.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),              // 3: api.iam.v1.DeleteUserRequest
+	(*ResendInviteRequest)(nil),            // 4: api.iam.v1.ResendInviteRequest
+	(*ResetUserPasswordRequest)(nil),       // 5: api.iam.v1.ResetUserPasswordRequest
+	(*CreateRoleRequest)(nil),              // 6: api.iam.v1.CreateRoleRequest
+	(*ListRolesRequest)(nil),               // 7: api.iam.v1.ListRolesRequest
+	(*UpdateRoleRequest)(nil),              // 8: api.iam.v1.UpdateRoleRequest
+	(*DeleteRoleRequest)(nil),              // 9: api.iam.v1.DeleteRoleRequest
+	(*AssignRoleRequest)(nil),              // 10: api.iam.v1.AssignRoleRequest
+	(*ListUserRolesRequest)(nil),           // 11: api.iam.v1.ListUserRolesRequest
+	(*RemoveRoleRequest)(nil),              // 12: api.iam.v1.RemoveRoleRequest
+	(*CreateResourceGroupRequest)(nil),     // 13: api.iam.v1.CreateResourceGroupRequest
+	(*ListResourceGroupsRequest)(nil),      // 14: api.iam.v1.ListResourceGroupsRequest
+	(*GetResourceGroupRequest)(nil),        // 15: api.iam.v1.GetResourceGroupRequest
+	(*UpdateResourceGroupRequest)(nil),     // 16: api.iam.v1.UpdateResourceGroupRequest
+	(*SetResourceGroupMembersRequest)(nil), // 17: api.iam.v1.SetResourceGroupMembersRequest
+	(*DeleteResourceGroupRequest)(nil),     // 18: api.iam.v1.DeleteResourceGroupRequest
+	(*ListPermissionsRequest)(nil),         // 19: api.iam.v1.ListPermissionsRequest
+	(*AssignRolePermissionsRequest)(nil),   // 20: api.iam.v1.AssignRolePermissionsRequest
+	(*ListRolePermissionsRequest)(nil),     // 21: api.iam.v1.ListRolePermissionsRequest
+	(*UserResponse)(nil),                   // 22: api.iam.v1.UserResponse
+	(*ListUsersResponse)(nil),              // 23: api.iam.v1.ListUsersResponse
+	(*emptypb.Empty)(nil),                  // 24: google.protobuf.Empty
+	(*RoleResponse)(nil),                   // 25: api.iam.v1.RoleResponse
+	(*ListRolesResponse)(nil),              // 26: api.iam.v1.ListRolesResponse
+	(*ListUserRoleBindingsResponse)(nil),   // 27: api.iam.v1.ListUserRoleBindingsResponse
+	(*ResourceGroupResponse)(nil),          // 28: api.iam.v1.ResourceGroupResponse
+	(*ListResourceGroupsResponse)(nil),     // 29: api.iam.v1.ListResourceGroupsResponse
+	(*ListPermissionsResponse)(nil),        // 30: api.iam.v1.ListPermissionsResponse
 }
 var file_api_iam_v1_console_iam_proto_depIdxs = []int32{
 	0,  // 0: api.iam.v1.ConsoleIAM.CreateUser:input_type -> api.iam.v1.CreateUserRequest
 	1,  // 1: api.iam.v1.ConsoleIAM.ListUsers:input_type -> api.iam.v1.ListUsersRequest
-	2,  // 2: api.iam.v1.ConsoleIAM.CreateRole:input_type -> api.iam.v1.CreateRoleRequest
-	3,  // 3: api.iam.v1.ConsoleIAM.ListRoles:input_type -> api.iam.v1.ListRolesRequest
-	4,  // 4: api.iam.v1.ConsoleIAM.AssignRole:input_type -> api.iam.v1.AssignRoleRequest
-	5,  // 5: api.iam.v1.ConsoleIAM.ListPermissions:input_type -> api.iam.v1.ListPermissionsRequest
-	6,  // 6: api.iam.v1.ConsoleIAM.AssignRolePermissions:input_type -> api.iam.v1.AssignRolePermissionsRequest
-	7,  // 7: api.iam.v1.ConsoleIAM.ListRolePermissions:input_type -> api.iam.v1.ListRolePermissionsRequest
-	8,  // 8: api.iam.v1.ConsoleIAM.CreateUser:output_type -> api.iam.v1.UserResponse
-	9,  // 9: api.iam.v1.ConsoleIAM.ListUsers:output_type -> api.iam.v1.ListUsersResponse
-	10, // 10: api.iam.v1.ConsoleIAM.CreateRole:output_type -> api.iam.v1.RoleResponse
-	11, // 11: api.iam.v1.ConsoleIAM.ListRoles:output_type -> api.iam.v1.ListRolesResponse
-	12, // 12: api.iam.v1.ConsoleIAM.AssignRole:output_type -> google.protobuf.Empty
-	13, // 13: api.iam.v1.ConsoleIAM.ListPermissions:output_type -> api.iam.v1.ListPermissionsResponse
-	12, // 14: api.iam.v1.ConsoleIAM.AssignRolePermissions:output_type -> google.protobuf.Empty
-	13, // 15: api.iam.v1.ConsoleIAM.ListRolePermissions:output_type -> api.iam.v1.ListPermissionsResponse
-	8,  // [8:16] is the sub-list for method output_type
-	0,  // [0:8] is the sub-list for method input_type
+	2,  // 2: api.iam.v1.ConsoleIAM.UpdateUser:input_type -> api.iam.v1.UpdateUserRequest
+	3,  // 3: api.iam.v1.ConsoleIAM.DeleteUser:input_type -> api.iam.v1.DeleteUserRequest
+	4,  // 4: api.iam.v1.ConsoleIAM.ResendInvite:input_type -> api.iam.v1.ResendInviteRequest
+	5,  // 5: api.iam.v1.ConsoleIAM.ResetUserPassword:input_type -> api.iam.v1.ResetUserPasswordRequest
+	6,  // 6: api.iam.v1.ConsoleIAM.CreateRole:input_type -> api.iam.v1.CreateRoleRequest
+	7,  // 7: api.iam.v1.ConsoleIAM.ListRoles:input_type -> api.iam.v1.ListRolesRequest
+	8,  // 8: api.iam.v1.ConsoleIAM.UpdateRole:input_type -> api.iam.v1.UpdateRoleRequest
+	9,  // 9: api.iam.v1.ConsoleIAM.DeleteRole:input_type -> api.iam.v1.DeleteRoleRequest
+	10, // 10: api.iam.v1.ConsoleIAM.AssignRole:input_type -> api.iam.v1.AssignRoleRequest
+	11, // 11: api.iam.v1.ConsoleIAM.ListUserRoles:input_type -> api.iam.v1.ListUserRolesRequest
+	12, // 12: api.iam.v1.ConsoleIAM.RemoveRole:input_type -> api.iam.v1.RemoveRoleRequest
+	11, // 13: api.iam.v1.ConsoleIAM.ListUserRoleBindings:input_type -> api.iam.v1.ListUserRolesRequest
+	13, // 14: api.iam.v1.ConsoleIAM.CreateResourceGroup:input_type -> api.iam.v1.CreateResourceGroupRequest
+	14, // 15: api.iam.v1.ConsoleIAM.ListResourceGroups:input_type -> api.iam.v1.ListResourceGroupsRequest
+	15, // 16: api.iam.v1.ConsoleIAM.GetResourceGroup:input_type -> api.iam.v1.GetResourceGroupRequest
+	16, // 17: api.iam.v1.ConsoleIAM.UpdateResourceGroup:input_type -> api.iam.v1.UpdateResourceGroupRequest
+	17, // 18: api.iam.v1.ConsoleIAM.SetResourceGroupMembers:input_type -> api.iam.v1.SetResourceGroupMembersRequest
+	18, // 19: api.iam.v1.ConsoleIAM.DeleteResourceGroup:input_type -> api.iam.v1.DeleteResourceGroupRequest
+	19, // 20: api.iam.v1.ConsoleIAM.ListPermissions:input_type -> api.iam.v1.ListPermissionsRequest
+	20, // 21: api.iam.v1.ConsoleIAM.AssignRolePermissions:input_type -> api.iam.v1.AssignRolePermissionsRequest
+	21, // 22: api.iam.v1.ConsoleIAM.ListRolePermissions:input_type -> api.iam.v1.ListRolePermissionsRequest
+	22, // 23: api.iam.v1.ConsoleIAM.CreateUser:output_type -> api.iam.v1.UserResponse
+	23, // 24: api.iam.v1.ConsoleIAM.ListUsers:output_type -> api.iam.v1.ListUsersResponse
+	22, // 25: api.iam.v1.ConsoleIAM.UpdateUser:output_type -> api.iam.v1.UserResponse
+	24, // 26: api.iam.v1.ConsoleIAM.DeleteUser:output_type -> google.protobuf.Empty
+	22, // 27: api.iam.v1.ConsoleIAM.ResendInvite:output_type -> api.iam.v1.UserResponse
+	24, // 28: api.iam.v1.ConsoleIAM.ResetUserPassword:output_type -> google.protobuf.Empty
+	25, // 29: api.iam.v1.ConsoleIAM.CreateRole:output_type -> api.iam.v1.RoleResponse
+	26, // 30: api.iam.v1.ConsoleIAM.ListRoles:output_type -> api.iam.v1.ListRolesResponse
+	25, // 31: api.iam.v1.ConsoleIAM.UpdateRole:output_type -> api.iam.v1.RoleResponse
+	24, // 32: api.iam.v1.ConsoleIAM.DeleteRole:output_type -> google.protobuf.Empty
+	24, // 33: api.iam.v1.ConsoleIAM.AssignRole:output_type -> google.protobuf.Empty
+	26, // 34: api.iam.v1.ConsoleIAM.ListUserRoles:output_type -> api.iam.v1.ListRolesResponse
+	24, // 35: api.iam.v1.ConsoleIAM.RemoveRole:output_type -> google.protobuf.Empty
+	27, // 36: api.iam.v1.ConsoleIAM.ListUserRoleBindings:output_type -> api.iam.v1.ListUserRoleBindingsResponse
+	28, // 37: api.iam.v1.ConsoleIAM.CreateResourceGroup:output_type -> api.iam.v1.ResourceGroupResponse
+	29, // 38: api.iam.v1.ConsoleIAM.ListResourceGroups:output_type -> api.iam.v1.ListResourceGroupsResponse
+	28, // 39: api.iam.v1.ConsoleIAM.GetResourceGroup:output_type -> api.iam.v1.ResourceGroupResponse
+	28, // 40: api.iam.v1.ConsoleIAM.UpdateResourceGroup:output_type -> api.iam.v1.ResourceGroupResponse
+	28, // 41: api.iam.v1.ConsoleIAM.SetResourceGroupMembers:output_type -> api.iam.v1.ResourceGroupResponse
+	24, // 42: api.iam.v1.ConsoleIAM.DeleteResourceGroup:output_type -> google.protobuf.Empty
+	30, // 43: api.iam.v1.ConsoleIAM.ListPermissions:output_type -> api.iam.v1.ListPermissionsResponse
+	24, // 44: api.iam.v1.ConsoleIAM.AssignRolePermissions:output_type -> google.protobuf.Empty
+	30, // 45: api.iam.v1.ConsoleIAM.ListRolePermissions:output_type -> api.iam.v1.ListPermissionsResponse
+	23, // [23:46] is the sub-list for method output_type
+	0,  // [0:23] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -20,14 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ConsoleIAM_CreateUser_FullMethodName            = "/api.iam.v1.ConsoleIAM/CreateUser"
-	ConsoleIAM_ListUsers_FullMethodName             = "/api.iam.v1.ConsoleIAM/ListUsers"
-	ConsoleIAM_CreateRole_FullMethodName            = "/api.iam.v1.ConsoleIAM/CreateRole"
-	ConsoleIAM_ListRoles_FullMethodName             = "/api.iam.v1.ConsoleIAM/ListRoles"
-	ConsoleIAM_AssignRole_FullMethodName            = "/api.iam.v1.ConsoleIAM/AssignRole"
-	ConsoleIAM_ListPermissions_FullMethodName       = "/api.iam.v1.ConsoleIAM/ListPermissions"
-	ConsoleIAM_AssignRolePermissions_FullMethodName = "/api.iam.v1.ConsoleIAM/AssignRolePermissions"
-	ConsoleIAM_ListRolePermissions_FullMethodName   = "/api.iam.v1.ConsoleIAM/ListRolePermissions"
+	ConsoleIAM_CreateUser_FullMethodName              = "/api.iam.v1.ConsoleIAM/CreateUser"
+	ConsoleIAM_ListUsers_FullMethodName               = "/api.iam.v1.ConsoleIAM/ListUsers"
+	ConsoleIAM_UpdateUser_FullMethodName              = "/api.iam.v1.ConsoleIAM/UpdateUser"
+	ConsoleIAM_DeleteUser_FullMethodName              = "/api.iam.v1.ConsoleIAM/DeleteUser"
+	ConsoleIAM_ResendInvite_FullMethodName            = "/api.iam.v1.ConsoleIAM/ResendInvite"
+	ConsoleIAM_ResetUserPassword_FullMethodName       = "/api.iam.v1.ConsoleIAM/ResetUserPassword"
+	ConsoleIAM_CreateRole_FullMethodName              = "/api.iam.v1.ConsoleIAM/CreateRole"
+	ConsoleIAM_ListRoles_FullMethodName               = "/api.iam.v1.ConsoleIAM/ListRoles"
+	ConsoleIAM_UpdateRole_FullMethodName              = "/api.iam.v1.ConsoleIAM/UpdateRole"
+	ConsoleIAM_DeleteRole_FullMethodName              = "/api.iam.v1.ConsoleIAM/DeleteRole"
+	ConsoleIAM_AssignRole_FullMethodName              = "/api.iam.v1.ConsoleIAM/AssignRole"
+	ConsoleIAM_ListUserRoles_FullMethodName           = "/api.iam.v1.ConsoleIAM/ListUserRoles"
+	ConsoleIAM_RemoveRole_FullMethodName              = "/api.iam.v1.ConsoleIAM/RemoveRole"
+	ConsoleIAM_ListUserRoleBindings_FullMethodName    = "/api.iam.v1.ConsoleIAM/ListUserRoleBindings"
+	ConsoleIAM_CreateResourceGroup_FullMethodName     = "/api.iam.v1.ConsoleIAM/CreateResourceGroup"
+	ConsoleIAM_ListResourceGroups_FullMethodName      = "/api.iam.v1.ConsoleIAM/ListResourceGroups"
+	ConsoleIAM_GetResourceGroup_FullMethodName        = "/api.iam.v1.ConsoleIAM/GetResourceGroup"
+	ConsoleIAM_UpdateResourceGroup_FullMethodName     = "/api.iam.v1.ConsoleIAM/UpdateResourceGroup"
+	ConsoleIAM_SetResourceGroupMembers_FullMethodName = "/api.iam.v1.ConsoleIAM/SetResourceGroupMembers"
+	ConsoleIAM_DeleteResourceGroup_FullMethodName     = "/api.iam.v1.ConsoleIAM/DeleteResourceGroup"
+	ConsoleIAM_ListPermissions_FullMethodName         = "/api.iam.v1.ConsoleIAM/ListPermissions"
+	ConsoleIAM_AssignRolePermissions_FullMethodName   = "/api.iam.v1.ConsoleIAM/AssignRolePermissions"
+	ConsoleIAM_ListRolePermissions_FullMethodName     = "/api.iam.v1.ConsoleIAM/ListRolePermissions"
 )
 
 // ConsoleIAMClient is the client API for ConsoleIAM service.
@@ -36,9 +51,33 @@ const (
 type ConsoleIAMClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// UpdateUser changes the profile or status (active/disabled) of a user;
+	// empty fields are kept.
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResendInvite mails a new invitation link to an invited user.
+	ResendInvite(ctx context.Context, in *ResendInviteRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// ResetUserPassword mails a password reset link to a user.
+	ResetUserPassword(ctx context.Context, in *ResetUserPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*RoleResponse, error)
 	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*RoleResponse, error)
+	// DeleteRole fails with ROLE_IN_USE while users hold the role.
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	RemoveRole(ctx context.Context, in *RemoveRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUserRoleBindings lists the roles of a user with their scopes.
+	ListUserRoleBindings(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListUserRoleBindingsResponse, error)
+	CreateResourceGroup(ctx context.Context, in *CreateResourceGroupRequest, opts ...grpc.CallOption) (*ResourceGroupResponse, error)
+	ListResourceGroups(ctx context.Context, in *ListResourceGroupsRequest, opts ...grpc.CallOption) (*ListResourceGroupsResponse, error)
+	GetResourceGroup(ctx context.Context, in *GetResourceGroupRequest, opts ...grpc.CallOption) (*ResourceGroupResponse, error)
+	UpdateResourceGroup(ctx context.Context, in *UpdateResourceGroupRequest, opts ...grpc.CallOption) (*ResourceGroupResponse, error)
+	// SetResourceGroupMembers replaces the bots and knowledge bases of a group.
+	SetResourceGroupMembers(ctx context.Context, in *SetResourceGroupMembersRequest, opts ...grpc.CallOption) (*ResourceGroupResponse, error)
+	// DeleteResourceGroup fails with RESOURCE_GROUP_IN_USE while role bindings
+	// are scoped to the group.
+	DeleteResourceGroup(ctx context.Context, in *DeleteResourceGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
 	AssignRolePermissions(ctx context.Context, in *AssignRolePermissionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListRolePermissions(ctx context.Context, in *ListRolePermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
@@ -72,6 +111,46 @@ func (c *consoleIAMClient) ListUsers(ctx context.Context, in *ListUsersRequest,
 	return out, nil
 }
 
+func (c *consoleIAMClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, ConsoleIAM_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConsoleIAM_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) ResendInvite(ctx context.Context, in *ResendInviteRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, ConsoleIAM_ResendInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) ResetUserPassword(ctx context.Context, in *ResetUserPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConsoleIAM_ResetUserPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleIAMClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*RoleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RoleResponse)
@@ -92,6 +171,26 @@ func (c *consoleIAMClient) ListRoles(ctx context.Context, in *ListRolesRequest,
 	return out, nil
 }
 
+func (c *consoleIAMClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*RoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleResponse)
+	err := c.cc.Invoke(ctx, ConsoleIAM_UpdateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConsoleIAM_DeleteRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleIAMClient) AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -102,6 +201,96 @@ func (c *consoleIAMClient) AssignRole(ctx context.Context, in *AssignRoleRequest
 	return out, nil
 }
 
+func (c *consoleIAMClient) ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, ConsoleIAM_ListUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) RemoveRole(ctx context.Context, in *RemoveRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConsoleIAM_RemoveRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) ListUserRoleBindings(ctx context.Context, in *ListUserRolesRequest, opts ...grpc.CallOption) (*ListUserRoleBindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserRoleBindingsResponse)
+	err := c.cc.Invoke(ctx, ConsoleIAM_ListUserRoleBindings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) CreateResourceGroup(ctx context.Context, in *CreateResourceGroupRequest, opts ...grpc.CallOption) (*ResourceGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceGroupResponse)
+	err := c.cc.Invoke(ctx, ConsoleIAM_CreateResourceGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) ListResourceGroups(ctx context.Context, in *ListResourceGroupsRequest, opts ...grpc.CallOption) (*ListResourceGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResourceGroupsResponse)
+	err := c.cc.Invoke(ctx, ConsoleIAM_ListResourceGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) GetResourceGroup(ctx context.Context, in *GetResourceGroupRequest, opts ...grpc.CallOption) (*ResourceGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceGroupResponse)
+	err := c.cc.Invoke(ctx, ConsoleIAM_GetResourceGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) UpdateResourceGroup(ctx context.Context, in *UpdateResourceGroupRequest, opts ...grpc.CallOption) (*ResourceGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceGroupResponse)
+	err := c.cc.Invoke(ctx, ConsoleIAM_UpdateResourceGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) SetResourceGroupMembers(ctx context.Context, in *SetResourceGroupMembersRequest, opts ...grpc.CallOption) (*ResourceGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceGroupResponse)
+	err := c.cc.Invoke(ctx, ConsoleIAM_SetResourceGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleIAMClient) DeleteResourceGroup(ctx context.Context, in *DeleteResourceGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConsoleIAM_DeleteResourceGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleIAMClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPermissionsResponse)
@@ -138,9 +327,33 @@ func (c *consoleIAMClient) ListRolePermissions(ctx context.Context, in *ListRole
 type ConsoleIAMServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*UserResponse, error)
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// UpdateUser changes the profile or status (active/disabled) of a user;
+	// empty fields are kept.
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	// ResendInvite mails a new invitation link to an invited user.
+	ResendInvite(context.Context, *ResendInviteRequest) (*UserResponse, error)
+	// ResetUserPassword mails a password reset link to a user.
+	ResetUserPassword(context.Context, *ResetUserPasswordRequest) (*emptypb.Empty, error)
 	CreateRole(context.Context, *CreateRoleRequest) (*RoleResponse, error)
 	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	UpdateRole(context.Context, *UpdateRoleRequest) (*RoleResponse, error)
+	// DeleteRole fails with ROLE_IN_USE while users hold the role.
+	DeleteRole(context.Context, *DeleteRoleRequest) (*emptypb.Empty, error)
 	AssignRole(context.Context, *AssignRoleRequest) (*emptypb.Empty, error)
+	ListUserRoles(context.Context, *ListUserRolesRequest) (*ListRolesResponse, error)
+	RemoveRole(context.Context, *RemoveRoleRequest) (*emptypb.Empty, error)
+	// ListUserRoleBindings lists the roles of a user with their scopes.
+	ListUserRoleBindings(context.Context, *ListUserRolesRequest) (*ListUserRoleBindingsResponse, error)
+	CreateResourceGroup(context.Context, *CreateResourceGroupRequest) (*ResourceGroupResponse, error)
+	ListResourceGroups(context.Context, *ListResourceGroupsRequest) (*ListResourceGroupsResponse, error)
+	GetResourceGroup(context.Context, *GetResourceGroupRequest) (*ResourceGroupResponse, error)
+	UpdateResourceGroup(context.Context, *UpdateResourceGroupRequest) (*ResourceGroupResponse, error)
+	// SetResourceGroupMembers replaces the bots and knowledge bases of a group.
+	SetResourceGroupMembers(context.Context, *SetResourceGroupMembersRequest) (*ResourceGroupResponse, error)
+	// DeleteResourceGroup fails with RESOURCE_GROUP_IN_USE while role bindings
+	// are scoped to the group.
+	DeleteResourceGroup(context.Context, *DeleteResourceGroupRequest) (*emptypb.Empty, error)
 	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
 	AssignRolePermissions(context.Context, *AssignRolePermissionsRequest) (*emptypb.Empty, error)
 	ListRolePermissions(context.Context, *ListRolePermissionsRequest) (*ListPermissionsResponse, error)
@@ -160,15 +373,60 @@ func (UnimplementedConsoleIAMServer) CreateUser(context.Context, *CreateUserRequ
 func (UnimplementedConsoleIAMServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedConsoleIAMServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedConsoleIAMServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedConsoleIAMServer) ResendInvite(context.Context, *ResendInviteRequest) (*UserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResendInvite not implemented")
+}
+func (UnimplementedConsoleIAMServer) ResetUserPassword(context.Context, *ResetUserPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetUserPassword not implemented")
+}
 func (UnimplementedConsoleIAMServer) CreateRole(context.Context, *CreateRoleRequest) (*RoleResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateRole not implemented")
 }
 func (UnimplementedConsoleIAMServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListRoles not implemented")
 }
+func (UnimplementedConsoleIAMServer) UpdateRole(context.Context, *UpdateRoleRequest) (*RoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedConsoleIAMServer) DeleteRole(context.Context, *DeleteRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRole not implemented")
+}
 func (UnimplementedConsoleIAMServer) AssignRole(context.Context, *AssignRoleRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method AssignRole not implemented")
 }
+func (UnimplementedConsoleIAMServer) ListUserRoles(context.Context, *ListUserRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserRoles not implemented")
+}
+func (UnimplementedConsoleIAMServer) RemoveRole(context.Context, *RemoveRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveRole not implemented")
+}
+func (UnimplementedConsoleIAMServer) ListUserRoleBindings(context.Context, *ListUserRolesRequest) (*ListUserRoleBindingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserRoleBindings not implemented")
+}
+func (UnimplementedConsoleIAMServer) CreateResourceGroup(context.Context, *CreateResourceGroupRequest) (*ResourceGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateResourceGroup not implemented")
+}
+func (UnimplementedConsoleIAMServer) ListResourceGroups(context.Context, *ListResourceGroupsRequest) (*ListResourceGroupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListResourceGroups not implemented")
+}
+func (UnimplementedConsoleIAMServer) GetResourceGroup(context.Context, *GetResourceGroupRequest) (*ResourceGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetResourceGroup not implemented")
+}
+func (UnimplementedConsoleIAMServer) UpdateResourceGroup(context.Context, *UpdateResourceGroupRequest) (*ResourceGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateResourceGroup not implemented")
+}
+func (UnimplementedConsoleIAMServer) SetResourceGroupMembers(context.Context, *SetResourceGroupMembersRequest) (*ResourceGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetResourceGroupMembers not implemented")
+}
+func (UnimplementedConsoleIAMServer) DeleteResourceGroup(context.Context, *DeleteResourceGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteResourceGroup not implemented")
+}
 func (UnimplementedConsoleIAMServer) ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListPermissions not implemented")
 }
@@ -235,6 +493,78 @@ func _ConsoleIAM_ListUsers_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConsoleIAM_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_ResendInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).ResendInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_ResendInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).ResendInvite(ctx, req.(*ResendInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_ResetUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).ResetUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_ResetUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).ResetUserPassword(ctx, req.(*ResetUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConsoleIAM_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRoleRequest)
 	if err := dec(in); err != nil {
@@ -271,6 +601,42 @@ func _ConsoleIAM_ListRoles_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConsoleIAM_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_DeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConsoleIAM_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AssignRoleRequest)
 	if err := dec(in); err != nil {
@@ -289,6 +655,168 @@ func _ConsoleIAM_AssignRole_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConsoleIAM_ListUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).ListUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_ListUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).ListUserRoles(ctx, req.(*ListUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_RemoveRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).RemoveRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_RemoveRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).RemoveRole(ctx, req.(*RemoveRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_ListUserRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).ListUserRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_ListUserRoleBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).ListUserRoleBindings(ctx, req.(*ListUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_CreateResourceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResourceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).CreateResourceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_CreateResourceGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).CreateResourceGroup(ctx, req.(*CreateResourceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_ListResourceGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).ListResourceGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_ListResourceGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).ListResourceGroups(ctx, req.(*ListResourceGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_GetResourceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).GetResourceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_GetResourceGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).GetResourceGroup(ctx, req.(*GetResourceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_UpdateResourceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResourceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).UpdateResourceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_UpdateResourceGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).UpdateResourceGroup(ctx, req.(*UpdateResourceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_SetResourceGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetResourceGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).SetResourceGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_SetResourceGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).SetResourceGroupMembers(ctx, req.(*SetResourceGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleIAM_DeleteResourceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleIAMServer).DeleteResourceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleIAM_DeleteResourceGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleIAMServer).DeleteResourceGroup(ctx, req.(*DeleteResourceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConsoleIAM_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPermissionsRequest)
 	if err := dec(in); err != nil {
@@ -358,6 +886,22 @@ var ConsoleIAM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _ConsoleIAM_ListUsers_Handler,
 		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _ConsoleIAM_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _ConsoleIAM_DeleteUser_Handler,
+		},
+		{
+			MethodName: "ResendInvite",
+			Handler:    _ConsoleIAM_ResendInvite_Handler,
+		},
+		{
+			MethodName: "ResetUserPassword",
+			Handler:    _ConsoleIAM_ResetUserPassword_Handler,
+		},
 		{
 			MethodName: "CreateRole",
 			Handler:    _ConsoleIAM_CreateRole_Handler,
@@ -366,10 +910,54 @@ var ConsoleIAM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRoles",
 			Handler:    _ConsoleIAM_ListRoles_Handler,
 		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _ConsoleIAM_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _ConsoleIAM_DeleteRole_Handler,
+		},
 		{
 			MethodName: "AssignRole",
 			Handler:    _ConsoleIAM_AssignRole_Handler,
 		},
+		{
+			MethodName: "ListUserRoles",
+			Handler:    _ConsoleIAM_ListUserRoles_Handler,
+		},
+		{
+			MethodName: "RemoveRole",
+			Handler:    _ConsoleIAM_RemoveRole_Handler,
+		},
+		{
+			MethodName: "ListUserRoleBindings",
+			Handler:    _ConsoleIAM_ListUserRoleBindings_Handler,
+		},
+		{
+			MethodName: "CreateResourceGroup",
+			Handler:    _ConsoleIAM_CreateResourceGroup_Handler,
+		},
+		{
+			MethodName: "ListResourceGroups",
+			Handler:    _ConsoleIAM_ListResourceGroups_Handler,
+		},
+		{
+			MethodName: "GetResourceGroup",
+			Handler:    _ConsoleIAM_GetResourceGroup_Handler,
+		},
+		{
+			MethodName: "UpdateResourceGroup",
+			Handler:    _ConsoleIAM_UpdateResourceGroup_Handler,
+		},
+		{
+			MethodName: "SetResourceGroupMembers",
+			Handler:    _ConsoleIAM_SetResourceGroupMembers_Handler,
+		},
+		{
+			MethodName: "DeleteResourceGroup",
+			Handler:    _ConsoleIAM_DeleteResourceGroup_Handler,
+		},
 		{
 			MethodName: "ListPermissions",
 			Handler:    _ConsoleIAM_ListPermissions_Handler,
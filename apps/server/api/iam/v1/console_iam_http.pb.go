@@ -22,31 +22,85 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationConsoleIAMAssignRole = "/api.iam.v1.ConsoleIAM/AssignRole"
 const OperationConsoleIAMAssignRolePermissions = "/api.iam.v1.ConsoleIAM/AssignRolePermissions"
+const OperationConsoleIAMCreateResourceGroup = "/api.iam.v1.ConsoleIAM/CreateResourceGroup"
 const OperationConsoleIAMCreateRole = "/api.iam.v1.ConsoleIAM/CreateRole"
 const OperationConsoleIAMCreateUser = "/api.iam.v1.ConsoleIAM/CreateUser"
+const OperationConsoleIAMDeleteResourceGroup = "/api.iam.v1.ConsoleIAM/DeleteResourceGroup"
+const OperationConsoleIAMDeleteRole = "/api.iam.v1.ConsoleIAM/DeleteRole"
+const OperationConsoleIAMDeleteUser = "/api.iam.v1.ConsoleIAM/DeleteUser"
+const OperationConsoleIAMGetResourceGroup = "/api.iam.v1.ConsoleIAM/GetResourceGroup"
 const OperationConsoleIAMListPermissions = "/api.iam.v1.ConsoleIAM/ListPermissions"
+const OperationConsoleIAMListResourceGroups = "/api.iam.v1.ConsoleIAM/ListResourceGroups"
 const OperationConsoleIAMListRolePermissions = "/api.iam.v1.ConsoleIAM/ListRolePermissions"
 const OperationConsoleIAMListRoles = "/api.iam.v1.ConsoleIAM/ListRoles"
+const OperationConsoleIAMListUserRoleBindings = "/api.iam.v1.ConsoleIAM/ListUserRoleBindings"
+const OperationConsoleIAMListUserRoles = "/api.iam.v1.ConsoleIAM/ListUserRoles"
 const OperationConsoleIAMListUsers = "/api.iam.v1.ConsoleIAM/ListUsers"
+const OperationConsoleIAMRemoveRole = "/api.iam.v1.ConsoleIAM/RemoveRole"
+const OperationConsoleIAMResendInvite = "/api.iam.v1.ConsoleIAM/ResendInvite"
+const OperationConsoleIAMResetUserPassword = "/api.iam.v1.ConsoleIAM/ResetUserPassword"
+const OperationConsoleIAMSetResourceGroupMembers = "/api.iam.v1.ConsoleIAM/SetResourceGroupMembers"
+const OperationConsoleIAMUpdateResourceGroup = "/api.iam.v1.ConsoleIAM/UpdateResourceGroup"
+const OperationConsoleIAMUpdateRole = "/api.iam.v1.ConsoleIAM/UpdateRole"
+const OperationConsoleIAMUpdateUser = "/api.iam.v1.ConsoleIAM/UpdateUser"
 
 type ConsoleIAMHTTPServer interface {
 	AssignRole(context.Context, *AssignRoleRequest) (*emptypb.Empty, error)
 	AssignRolePermissions(context.Context, *AssignRolePermissionsRequest) (*emptypb.Empty, error)
+	CreateResourceGroup(context.Context, *CreateResourceGroupRequest) (*ResourceGroupResponse, error)
 	CreateRole(context.Context, *CreateRoleRequest) (*RoleResponse, error)
 	CreateUser(context.Context, *CreateUserRequest) (*UserResponse, error)
+	// DeleteResourceGroup DeleteResourceGroup fails with RESOURCE_GROUP_IN_USE while role bindings
+	// are scoped to the group.
+	DeleteResourceGroup(context.Context, *DeleteResourceGroupRequest) (*emptypb.Empty, error)
+	// DeleteRole DeleteRole fails with ROLE_IN_USE while users hold the role.
+	DeleteRole(context.Context, *DeleteRoleRequest) (*emptypb.Empty, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	GetResourceGroup(context.Context, *GetResourceGroupRequest) (*ResourceGroupResponse, error)
 	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	ListResourceGroups(context.Context, *ListResourceGroupsRequest) (*ListResourceGroupsResponse, error)
 	ListRolePermissions(context.Context, *ListRolePermissionsRequest) (*ListPermissionsResponse, error)
 	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	// ListUserRoleBindings ListUserRoleBindings lists the roles of a user with their scopes.
+	ListUserRoleBindings(context.Context, *ListUserRolesRequest) (*ListUserRoleBindingsResponse, error)
+	ListUserRoles(context.Context, *ListUserRolesRequest) (*ListRolesResponse, error)
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	RemoveRole(context.Context, *RemoveRoleRequest) (*emptypb.Empty, error)
+	// ResendInvite ResendInvite mails a new invitation link to an invited user.
+	ResendInvite(context.Context, *ResendInviteRequest) (*UserResponse, error)
+	// ResetUserPassword ResetUserPassword mails a password reset link to a user.
+	ResetUserPassword(context.Context, *ResetUserPasswordRequest) (*emptypb.Empty, error)
+	// SetResourceGroupMembers SetResourceGroupMembers replaces the bots and knowledge bases of a group.
+	SetResourceGroupMembers(context.Context, *SetResourceGroupMembersRequest) (*ResourceGroupResponse, error)
+	UpdateResourceGroup(context.Context, *UpdateResourceGroupRequest) (*ResourceGroupResponse, error)
+	UpdateRole(context.Context, *UpdateRoleRequest) (*RoleResponse, error)
+	// UpdateUser UpdateUser changes the profile or status (active/disabled) of a user;
+	// empty fields are kept.
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
 }
 
 func RegisterConsoleIAMHTTPServer(s *http.Server, srv ConsoleIAMHTTPServer) {
 	r := s.Route("/")
 	r.POST("/console/v1/tenants/{tenant_id}/users", _ConsoleIAM_CreateUser0_HTTP_Handler(srv))
 	r.GET("/console/v1/tenants/{tenant_id}/users", _ConsoleIAM_ListUsers0_HTTP_Handler(srv))
+	r.PATCH("/console/v1/users/{user_id}", _ConsoleIAM_UpdateUser0_HTTP_Handler(srv))
+	r.DELETE("/console/v1/users/{user_id}", _ConsoleIAM_DeleteUser0_HTTP_Handler(srv))
+	r.POST("/console/v1/users/{user_id}/invite", _ConsoleIAM_ResendInvite0_HTTP_Handler(srv))
+	r.POST("/console/v1/users/{user_id}/password_reset", _ConsoleIAM_ResetUserPassword0_HTTP_Handler(srv))
 	r.POST("/console/v1/roles", _ConsoleIAM_CreateRole0_HTTP_Handler(srv))
 	r.GET("/console/v1/roles", _ConsoleIAM_ListRoles0_HTTP_Handler(srv))
+	r.PATCH("/console/v1/roles/{role_id}", _ConsoleIAM_UpdateRole0_HTTP_Handler(srv))
+	r.DELETE("/console/v1/roles/{role_id}", _ConsoleIAM_DeleteRole0_HTTP_Handler(srv))
 	r.POST("/console/v1/users/{user_id}/roles", _ConsoleIAM_AssignRole0_HTTP_Handler(srv))
+	r.GET("/console/v1/users/{user_id}/roles", _ConsoleIAM_ListUserRoles0_HTTP_Handler(srv))
+	r.DELETE("/console/v1/users/{user_id}/roles/{role_id}", _ConsoleIAM_RemoveRole0_HTTP_Handler(srv))
+	r.GET("/console/v1/users/{user_id}/role_bindings", _ConsoleIAM_ListUserRoleBindings0_HTTP_Handler(srv))
+	r.POST("/console/v1/resource_groups", _ConsoleIAM_CreateResourceGroup0_HTTP_Handler(srv))
+	r.GET("/console/v1/resource_groups", _ConsoleIAM_ListResourceGroups0_HTTP_Handler(srv))
+	r.GET("/console/v1/resource_groups/{group_id}", _ConsoleIAM_GetResourceGroup0_HTTP_Handler(srv))
+	r.PATCH("/console/v1/resource_groups/{group_id}", _ConsoleIAM_UpdateResourceGroup0_HTTP_Handler(srv))
+	r.PUT("/console/v1/resource_groups/{group_id}/members", _ConsoleIAM_SetResourceGroupMembers0_HTTP_Handler(srv))
+	r.DELETE("/console/v1/resource_groups/{group_id}", _ConsoleIAM_DeleteResourceGroup0_HTTP_Handler(srv))
 	r.GET("/console/v1/permissions", _ConsoleIAM_ListPermissions0_HTTP_Handler(srv))
 	r.POST("/console/v1/roles/{role_id}/permissions", _ConsoleIAM_AssignRolePermissions0_HTTP_Handler(srv))
 	r.GET("/console/v1/roles/{role_id}/permissions", _ConsoleIAM_ListRolePermissions0_HTTP_Handler(srv))
@@ -99,6 +153,103 @@ func _ConsoleIAM_ListUsers0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http
 	}
 }
 
+func _ConsoleIAM_UpdateUser0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMUpdateUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateUser(ctx, req.(*UpdateUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_DeleteUser0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMDeleteUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteUser(ctx, req.(*DeleteUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_ResendInvite0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResendInviteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMResendInvite)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResendInvite(ctx, req.(*ResendInviteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_ResetUserPassword0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetUserPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMResetUserPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetUserPassword(ctx, req.(*ResetUserPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ConsoleIAM_CreateRole0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateRoleRequest
@@ -140,6 +291,53 @@ func _ConsoleIAM_ListRoles0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http
 	}
 }
 
+func _ConsoleIAM_UpdateRole0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMUpdateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateRole(ctx, req.(*UpdateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_DeleteRole0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMDeleteRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteRole(ctx, req.(*DeleteRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ConsoleIAM_AssignRole0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AssignRoleRequest
@@ -165,6 +363,207 @@ func _ConsoleIAM_AssignRole0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx htt
 	}
 }
 
+func _ConsoleIAM_ListUserRoles0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserRolesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMListUserRoles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserRoles(ctx, req.(*ListUserRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRolesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_RemoveRole0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMRemoveRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveRole(ctx, req.(*RemoveRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_ListUserRoleBindings0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserRolesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMListUserRoleBindings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserRoleBindings(ctx, req.(*ListUserRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUserRoleBindingsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_CreateResourceGroup0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateResourceGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMCreateResourceGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateResourceGroup(ctx, req.(*CreateResourceGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResourceGroupResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_ListResourceGroups0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListResourceGroupsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMListResourceGroups)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListResourceGroups(ctx, req.(*ListResourceGroupsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListResourceGroupsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_GetResourceGroup0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetResourceGroupRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMGetResourceGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetResourceGroup(ctx, req.(*GetResourceGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResourceGroupResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_UpdateResourceGroup0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateResourceGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMUpdateResourceGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateResourceGroup(ctx, req.(*UpdateResourceGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResourceGroupResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_SetResourceGroupMembers0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetResourceGroupMembersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMSetResourceGroupMembers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetResourceGroupMembers(ctx, req.(*SetResourceGroupMembersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResourceGroupResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleIAM_DeleteResourceGroup0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteResourceGroupRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleIAMDeleteResourceGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteResourceGroup(ctx, req.(*DeleteResourceGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ConsoleIAM_ListPermissions0_HTTP_Handler(srv ConsoleIAMHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListPermissionsRequest
@@ -234,12 +633,36 @@ func _ConsoleIAM_ListRolePermissions0_HTTP_Handler(srv ConsoleIAMHTTPServer) fun
 type ConsoleIAMHTTPClient interface {
 	AssignRole(ctx context.Context, req *AssignRoleRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	AssignRolePermissions(ctx context.Context, req *AssignRolePermissionsRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	CreateResourceGroup(ctx context.Context, req *CreateResourceGroupRequest, opts ...http.CallOption) (rsp *ResourceGroupResponse, err error)
 	CreateRole(ctx context.Context, req *CreateRoleRequest, opts ...http.CallOption) (rsp *RoleResponse, err error)
 	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
+	// DeleteResourceGroup DeleteResourceGroup fails with RESOURCE_GROUP_IN_USE while role bindings
+	// are scoped to the group.
+	DeleteResourceGroup(ctx context.Context, req *DeleteResourceGroupRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteRole DeleteRole fails with ROLE_IN_USE while users hold the role.
+	DeleteRole(ctx context.Context, req *DeleteRoleRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	GetResourceGroup(ctx context.Context, req *GetResourceGroupRequest, opts ...http.CallOption) (rsp *ResourceGroupResponse, err error)
 	ListPermissions(ctx context.Context, req *ListPermissionsRequest, opts ...http.CallOption) (rsp *ListPermissionsResponse, err error)
+	ListResourceGroups(ctx context.Context, req *ListResourceGroupsRequest, opts ...http.CallOption) (rsp *ListResourceGroupsResponse, err error)
 	ListRolePermissions(ctx context.Context, req *ListRolePermissionsRequest, opts ...http.CallOption) (rsp *ListPermissionsResponse, err error)
 	ListRoles(ctx context.Context, req *ListRolesRequest, opts ...http.CallOption) (rsp *ListRolesResponse, err error)
+	// ListUserRoleBindings ListUserRoleBindings lists the roles of a user with their scopes.
+	ListUserRoleBindings(ctx context.Context, req *ListUserRolesRequest, opts ...http.CallOption) (rsp *ListUserRoleBindingsResponse, err error)
+	ListUserRoles(ctx context.Context, req *ListUserRolesRequest, opts ...http.CallOption) (rsp *ListRolesResponse, err error)
 	ListUsers(ctx context.Context, req *ListUsersRequest, opts ...http.CallOption) (rsp *ListUsersResponse, err error)
+	RemoveRole(ctx context.Context, req *RemoveRoleRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ResendInvite ResendInvite mails a new invitation link to an invited user.
+	ResendInvite(ctx context.Context, req *ResendInviteRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
+	// ResetUserPassword ResetUserPassword mails a password reset link to a user.
+	ResetUserPassword(ctx context.Context, req *ResetUserPasswordRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// SetResourceGroupMembers SetResourceGroupMembers replaces the bots and knowledge bases of a group.
+	SetResourceGroupMembers(ctx context.Context, req *SetResourceGroupMembersRequest, opts ...http.CallOption) (rsp *ResourceGroupResponse, err error)
+	UpdateResourceGroup(ctx context.Context, req *UpdateResourceGroupRequest, opts ...http.CallOption) (rsp *ResourceGroupResponse, err error)
+	UpdateRole(ctx context.Context, req *UpdateRoleRequest, opts ...http.CallOption) (rsp *RoleResponse, err error)
+	// UpdateUser UpdateUser changes the profile or status (active/disabled) of a user;
+	// empty fields are kept.
+	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
 }
 
 type ConsoleIAMHTTPClientImpl struct {
@@ -276,6 +699,19 @@ func (c *ConsoleIAMHTTPClientImpl) AssignRolePermissions(ctx context.Context, in
 	return &out, nil
 }
 
+func (c *ConsoleIAMHTTPClientImpl) CreateResourceGroup(ctx context.Context, in *CreateResourceGroupRequest, opts ...http.CallOption) (*ResourceGroupResponse, error) {
+	var out ResourceGroupResponse
+	pattern := "/console/v1/resource_groups"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleIAMCreateResourceGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleIAMHTTPClientImpl) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...http.CallOption) (*RoleResponse, error) {
 	var out RoleResponse
 	pattern := "/console/v1/roles"
@@ -302,6 +738,61 @@ func (c *ConsoleIAMHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUse
 	return &out, nil
 }
 
+// DeleteResourceGroup DeleteResourceGroup fails with RESOURCE_GROUP_IN_USE while role bindings
+// are scoped to the group.
+func (c *ConsoleIAMHTTPClientImpl) DeleteResourceGroup(ctx context.Context, in *DeleteResourceGroupRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/console/v1/resource_groups/{group_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleIAMDeleteResourceGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteRole DeleteRole fails with ROLE_IN_USE while users hold the role.
+func (c *ConsoleIAMHTTPClientImpl) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/console/v1/roles/{role_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleIAMDeleteRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleIAMHTTPClientImpl) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/console/v1/users/{user_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleIAMDeleteUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleIAMHTTPClientImpl) GetResourceGroup(ctx context.Context, in *GetResourceGroupRequest, opts ...http.CallOption) (*ResourceGroupResponse, error) {
+	var out ResourceGroupResponse
+	pattern := "/console/v1/resource_groups/{group_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleIAMGetResourceGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleIAMHTTPClientImpl) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...http.CallOption) (*ListPermissionsResponse, error) {
 	var out ListPermissionsResponse
 	pattern := "/console/v1/permissions"
@@ -315,6 +806,19 @@ func (c *ConsoleIAMHTTPClientImpl) ListPermissions(ctx context.Context, in *List
 	return &out, nil
 }
 
+func (c *ConsoleIAMHTTPClientImpl) ListResourceGroups(ctx context.Context, in *ListResourceGroupsRequest, opts ...http.CallOption) (*ListResourceGroupsResponse, error) {
+	var out ListResourceGroupsResponse
+	pattern := "/console/v1/resource_groups"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleIAMListResourceGroups))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleIAMHTTPClientImpl) ListRolePermissions(ctx context.Context, in *ListRolePermissionsRequest, opts ...http.CallOption) (*ListPermissionsResponse, error) {
 	var out ListPermissionsResponse
 	pattern := "/console/v1/roles/{role_id}/permissions"
@@ -341,6 +845,33 @@ func (c *ConsoleIAMHTTPClientImpl) ListRoles(ctx context.Context, in *ListRolesR
 	return &out, nil
 }
 
+// ListUserRoleBindings ListUserRoleBindings lists the roles of a user with their scopes.
+func (c *ConsoleIAMHTTPClientImpl) ListUserRoleBindings(ctx context.Context, in *ListUserRolesRequest, opts ...http.CallOption) (*ListUserRoleBindingsResponse, error) {
+	var out ListUserRoleBindingsResponse
+	pattern := "/console/v1/users/{user_id}/role_bindings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleIAMListUserRoleBindings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleIAMHTTPClientImpl) ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...http.CallOption) (*ListRolesResponse, error) {
+	var out ListRolesResponse
+	pattern := "/console/v1/users/{user_id}/roles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleIAMListUserRoles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleIAMHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...http.CallOption) (*ListUsersResponse, error) {
 	var out ListUsersResponse
 	pattern := "/console/v1/tenants/{tenant_id}/users"
@@ -353,3 +884,99 @@ func (c *ConsoleIAMHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsersR
 	}
 	return &out, nil
 }
+
+func (c *ConsoleIAMHTTPClientImpl) RemoveRole(ctx context.Context, in *RemoveRoleRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/console/v1/users/{user_id}/roles/{role_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleIAMRemoveRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResendInvite ResendInvite mails a new invitation link to an invited user.
+func (c *ConsoleIAMHTTPClientImpl) ResendInvite(ctx context.Context, in *ResendInviteRequest, opts ...http.CallOption) (*UserResponse, error) {
+	var out UserResponse
+	pattern := "/console/v1/users/{user_id}/invite"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleIAMResendInvite))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResetUserPassword ResetUserPassword mails a password reset link to a user.
+func (c *ConsoleIAMHTTPClientImpl) ResetUserPassword(ctx context.Context, in *ResetUserPasswordRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/console/v1/users/{user_id}/password_reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleIAMResetUserPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetResourceGroupMembers SetResourceGroupMembers replaces the bots and knowledge bases of a group.
+func (c *ConsoleIAMHTTPClientImpl) SetResourceGroupMembers(ctx context.Context, in *SetResourceGroupMembersRequest, opts ...http.CallOption) (*ResourceGroupResponse, error) {
+	var out ResourceGroupResponse
+	pattern := "/console/v1/resource_groups/{group_id}/members"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleIAMSetResourceGroupMembers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleIAMHTTPClientImpl) UpdateResourceGroup(ctx context.Context, in *UpdateResourceGroupRequest, opts ...http.CallOption) (*ResourceGroupResponse, error) {
+	var out ResourceGroupResponse
+	pattern := "/console/v1/resource_groups/{group_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleIAMUpdateResourceGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PATCH", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleIAMHTTPClientImpl) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...http.CallOption) (*RoleResponse, error) {
+	var out RoleResponse
+	pattern := "/console/v1/roles/{role_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleIAMUpdateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PATCH", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateUser UpdateUser changes the profile or status (active/disabled) of a user;
+// empty fields are kept.
+func (c *ConsoleIAMHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...http.CallOption) (*UserResponse, error) {
+	var out UserResponse
+	pattern := "/console/v1/users/{user_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleIAMUpdateUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PATCH", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -654,35 +654,1965 @@ func (x *ListTenantsResponse) GetItems() []*Tenant {
 	return nil
 }
 
-type CreateUserRequest struct {
+// Fields left empty are unchanged.
+type UpdateTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
-	SendInvite    bool                   `protobuf:"varint,7,opt,name=send_invite,json=sendInvite,proto3" json:"send_invite,omitempty"`
-	InviteBaseUrl string                 `protobuf:"bytes,8,opt,name=invite_base_url,json=inviteBaseUrl,proto3" json:"invite_base_url,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Plan          string                 `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
+func (x *UpdateTenantRequest) Reset() {
+	*x = UpdateTenantRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantRequest) ProtoMessage() {}
+
+func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_iam_v1_iam_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type SuspendTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendTenantRequest) Reset() {
+	*x = SuspendTenantRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendTenantRequest) ProtoMessage() {}
+
+func (x *SuspendTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendTenantRequest.ProtoReflect.Descriptor instead.
+func (*SuspendTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SuspendTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResumeTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTenantRequest) Reset() {
+	*x = ResumeTenantRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTenantRequest) ProtoMessage() {}
+
+func (x *ResumeTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTenantRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResumeTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Limits of a plan; 0 means unlimited.
+type PlanLimits struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaxBots           int64                  `protobuf:"varint,1,opt,name=max_bots,json=maxBots,proto3" json:"max_bots,omitempty"`
+	MaxKnowledgeBases int64                  `protobuf:"varint,2,opt,name=max_knowledge_bases,json=maxKnowledgeBases,proto3" json:"max_knowledge_bases,omitempty"`
+	MaxDocuments      int64                  `protobuf:"varint,3,opt,name=max_documents,json=maxDocuments,proto3" json:"max_documents,omitempty"`
+	MaxStorageBytes   int64                  `protobuf:"varint,4,opt,name=max_storage_bytes,json=maxStorageBytes,proto3" json:"max_storage_bytes,omitempty"`
+	MonthlyQueries    int64                  `protobuf:"varint,5,opt,name=monthly_queries,json=monthlyQueries,proto3" json:"monthly_queries,omitempty"`
+	MonthlyTokens     int64                  `protobuf:"varint,6,opt,name=monthly_tokens,json=monthlyTokens,proto3" json:"monthly_tokens,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlanLimits) Reset() {
+	*x = PlanLimits{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanLimits) ProtoMessage() {}
+
+func (x *PlanLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanLimits.ProtoReflect.Descriptor instead.
+func (*PlanLimits) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PlanLimits) GetMaxBots() int64 {
+	if x != nil {
+		return x.MaxBots
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMaxKnowledgeBases() int64 {
+	if x != nil {
+		return x.MaxKnowledgeBases
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMaxDocuments() int64 {
+	if x != nil {
+		return x.MaxDocuments
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMaxStorageBytes() int64 {
+	if x != nil {
+		return x.MaxStorageBytes
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMonthlyQueries() int64 {
+	if x != nil {
+		return x.MonthlyQueries
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMonthlyTokens() int64 {
+	if x != nil {
+		return x.MonthlyTokens
+	}
+	return 0
+}
+
+type Plan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Limits        *PlanLimits            `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Plan) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Plan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Plan) GetLimits() *PlanLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Plan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansRequest) Reset() {
+	*x = ListPlansRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansRequest) ProtoMessage() {}
+
+func (x *ListPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListPlansRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{16}
+}
+
+type ListPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Plan                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansResponse) Reset() {
+	*x = ListPlansResponse{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansResponse) ProtoMessage() {}
+
+func (x *ListPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListPlansResponse) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListPlansResponse) GetItems() []*Plan {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdatePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Limits        *PlanLimits            `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlanRequest) Reset() {
+	*x = UpdatePlanRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlanRequest) ProtoMessage() {}
+
+func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdatePlanRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdatePlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePlanRequest) GetLimits() *PlanLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type PlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *Plan                  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResponse) Reset() {
+	*x = PlanResponse{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResponse) ProtoMessage() {}
+
+func (x *PlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResponse.ProtoReflect.Descriptor instead.
+func (*PlanResponse) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PlanResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// What a tenant purge removed so far.
+type TenantPurgeReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deleted rows per table.
+	Rows        map[string]int64 `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Objects     int64            `protobuf:"varint,2,opt,name=objects,proto3" json:"objects,omitempty"`
+	Collections []string         `protobuf:"bytes,3,rep,name=collections,proto3" json:"collections,omitempty"`
+	// Deleted vector points per collection.
+	VectorPoints  map[string]int64 `protobuf:"bytes,4,rep,name=vector_points,json=vectorPoints,proto3" json:"vector_points,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantPurgeReport) Reset() {
+	*x = TenantPurgeReport{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantPurgeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantPurgeReport) ProtoMessage() {}
+
+func (x *TenantPurgeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantPurgeReport.ProtoReflect.Descriptor instead.
+func (*TenantPurgeReport) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TenantPurgeReport) GetRows() map[string]int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *TenantPurgeReport) GetObjects() int64 {
+	if x != nil {
+		return x.Objects
+	}
+	return 0
+}
+
+func (x *TenantPurgeReport) GetCollections() []string {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *TenantPurgeReport) GetVectorPoints() map[string]int64 {
+	if x != nil {
+		return x.VectorPoints
+	}
+	return nil
+}
+
+type TenantPurgeJob struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId   string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	TenantName string                 `protobuf:"bytes,3,opt,name=tenant_name,json=tenantName,proto3" json:"tenant_name,omitempty"`
+	// pending, running, succeeded or failed.
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Report        *TenantPurgeReport     `protobuf:"bytes,6,opt,name=report,proto3" json:"report,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantPurgeJob) Reset() {
+	*x = TenantPurgeJob{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantPurgeJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantPurgeJob) ProtoMessage() {}
+
+func (x *TenantPurgeJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantPurgeJob.ProtoReflect.Descriptor instead.
+func (*TenantPurgeJob) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TenantPurgeJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TenantPurgeJob) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantPurgeJob) GetTenantName() string {
+	if x != nil {
+		return x.TenantName
+	}
+	return ""
+}
+
+func (x *TenantPurgeJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TenantPurgeJob) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *TenantPurgeJob) GetReport() *TenantPurgeReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *TenantPurgeJob) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *TenantPurgeJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TenantPurgeJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TenantPurgeJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type GetTenantPurgeJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantPurgeJobRequest) Reset() {
+	*x = GetTenantPurgeJobRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantPurgeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantPurgeJobRequest) ProtoMessage() {}
+
+func (x *GetTenantPurgeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantPurgeJobRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantPurgeJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTenantPurgeJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTenantPurgeJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantPurgeJobsRequest) Reset() {
+	*x = ListTenantPurgeJobsRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantPurgeJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantPurgeJobsRequest) ProtoMessage() {}
+
+func (x *ListTenantPurgeJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantPurgeJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantPurgeJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTenantPurgeJobsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListTenantPurgeJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TenantPurgeJob      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantPurgeJobsResponse) Reset() {
+	*x = ListTenantPurgeJobsResponse{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantPurgeJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantPurgeJobsResponse) ProtoMessage() {}
+
+func (x *ListTenantPurgeJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantPurgeJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantPurgeJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTenantPurgeJobsResponse) GetItems() []*TenantPurgeJob {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type TenantPurgeJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *TenantPurgeJob        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantPurgeJobResponse) Reset() {
+	*x = TenantPurgeJobResponse{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantPurgeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantPurgeJobResponse) ProtoMessage() {}
+
+func (x *TenantPurgeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantPurgeJobResponse.ProtoReflect.Descriptor instead.
+func (*TenantPurgeJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TenantPurgeJobResponse) GetJob() *TenantPurgeJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	SendInvite    bool                   `protobuf:"varint,7,opt,name=send_invite,json=sendInvite,proto3" json:"send_invite,omitempty"`
+	InviteBaseUrl string                 `protobuf:"bytes,8,opt,name=invite_base_url,json=inviteBaseUrl,proto3" json:"invite_base_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateUserRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetSendInvite() bool {
+	if x != nil {
+		return x.SendInvite
+	}
+	return false
+}
+
+func (x *CreateUserRequest) GetInviteBaseUrl() string {
+	if x != nil {
+		return x.InviteBaseUrl
+	}
+	return ""
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListUsersRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*User                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListUsersResponse) GetItems() []*User {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResendInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InviteBaseUrl string                 `protobuf:"bytes,2,opt,name=invite_base_url,json=inviteBaseUrl,proto3" json:"invite_base_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInviteRequest) Reset() {
+	*x = ResendInviteRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInviteRequest) ProtoMessage() {}
+
+func (x *ResendInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInviteRequest.ProtoReflect.Descriptor instead.
+func (*ResendInviteRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResendInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResendInviteRequest) GetInviteBaseUrl() string {
+	if x != nil {
+		return x.InviteBaseUrl
+	}
+	return ""
+}
+
+type ResetUserPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserPasswordRequest) Reset() {
+	*x = ResetUserPasswordRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserPasswordRequest) ProtoMessage() {}
+
+func (x *ResetUserPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetUserPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResetUserPasswordRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequest) ProtoMessage() {}
+
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{34}
+}
+
+type ListRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Role                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListRolesResponse) GetItems() []*Role {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+// ResourceRef names a bot, knowledge base or resource group by type
+// ("bot", "knowledge_base", "resource_group") and id.
+type ResourceRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceRef) Reset() {
+	*x = ResourceRef{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRef) ProtoMessage() {}
+
+func (x *ResourceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRef.ProtoReflect.Descriptor instead.
+func (*ResourceRef) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ResourceRef) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResourceRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AssignRoleRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// scopes limit the binding to these resources and replace the scopes of an
+	// existing binding; empty makes it tenant-wide. tenant_admin cannot be
+	// scoped.
+	Scopes        []*ResourceRef `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleRequest) Reset() {
+	*x = AssignRoleRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleRequest) ProtoMessage() {}
+
+func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AssignRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetScopes() []*ResourceRef {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// RoleBinding is a role held by a user with the resources it is limited to.
+type RoleBinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Scopes        []*ResourceRef         `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleBinding) Reset() {
+	*x = RoleBinding{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBinding) ProtoMessage() {}
+
+func (x *RoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBinding.ProtoReflect.Descriptor instead.
+func (*RoleBinding) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RoleBinding) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *RoleBinding) GetScopes() []*ResourceRef {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type ListUserRoleBindingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RoleBinding         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserRoleBindingsResponse) Reset() {
+	*x = ListUserRoleBindingsResponse{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserRoleBindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRoleBindingsResponse) ProtoMessage() {}
+
+func (x *ListUserRoleBindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRoleBindingsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserRoleBindingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListUserRoleBindingsResponse) GetItems() []*RoleBinding {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ResourceGroup is a named set of bots and knowledge bases that role
+// bindings can be scoped to.
+type ResourceGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Members       []*ResourceRef         `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceGroup) Reset() {
+	*x = ResourceGroup{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceGroup) ProtoMessage() {}
+
+func (x *ResourceGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceGroup.ProtoReflect.Descriptor instead.
+func (*ResourceGroup) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ResourceGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResourceGroup) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ResourceGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ResourceGroup) GetMembers() []*ResourceRef {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ResourceGroup) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ResourceGroup) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateResourceGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Members       []*ResourceRef         `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResourceGroupRequest) Reset() {
+	*x = CreateResourceGroupRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResourceGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResourceGroupRequest) ProtoMessage() {}
+
+func (x *CreateResourceGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResourceGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateResourceGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateResourceGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateResourceGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateResourceGroupRequest) GetMembers() []*ResourceRef {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetResourceGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceGroupRequest) Reset() {
+	*x = GetResourceGroupRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceGroupRequest) ProtoMessage() {}
+
+func (x *GetResourceGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetResourceGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListResourceGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourceGroupsRequest) Reset() {
+	*x = ListResourceGroupsRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *ListResourceGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*ListResourceGroupsRequest) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[10]
+func (x *ListResourceGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,89 +2623,79 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *CreateUserRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
+// Deprecated: Use ListResourceGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListResourceGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CreateUserRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+type ListResourceGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ResourceGroup       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+func (x *ListResourceGroupsResponse) Reset() {
+	*x = ListResourceGroupsResponse{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *ListResourceGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateUserRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
+func (*ListResourceGroupsResponse) ProtoMessage() {}
 
-func (x *CreateUserRequest) GetPassword() string {
+func (x *ListResourceGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[46]
 	if x != nil {
-		return x.Password
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateUserRequest) GetSendInvite() bool {
-	if x != nil {
-		return x.SendInvite
-	}
-	return false
+// Deprecated: Use ListResourceGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListResourceGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CreateUserRequest) GetInviteBaseUrl() string {
+func (x *ListResourceGroupsResponse) GetItems() []*ResourceGroup {
 	if x != nil {
-		return x.InviteBaseUrl
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type ListUsersRequest struct {
+type UpdateResourceGroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersRequest) Reset() {
-	*x = ListUsersRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[11]
+func (x *UpdateResourceGroupRequest) Reset() {
+	*x = UpdateResourceGroupRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersRequest) String() string {
+func (x *UpdateResourceGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersRequest) ProtoMessage() {}
+func (*UpdateResourceGroupRequest) ProtoMessage() {}
 
-func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[11]
+func (x *UpdateResourceGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,40 +2706,55 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UpdateResourceGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResourceGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListUsersRequest) GetTenantId() string {
+func (x *UpdateResourceGroupRequest) GetGroupId() string {
 	if x != nil {
-		return x.TenantId
+		return x.GroupId
 	}
 	return ""
 }
 
-type ListUsersResponse struct {
+func (x *UpdateResourceGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateResourceGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetResourceGroupMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*User                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Members       []*ResourceRef         `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[12]
+func (x *SetResourceGroupMembersRequest) Reset() {
+	*x = SetResourceGroupMembersRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *SetResourceGroupMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*SetResourceGroupMembersRequest) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[12]
+func (x *SetResourceGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,40 +2765,47 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SetResourceGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*SetResourceGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListUsersResponse) GetItems() []*User {
+func (x *SetResourceGroupMembersRequest) GetGroupId() string {
 	if x != nil {
-		return x.Items
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetResourceGroupMembersRequest) GetMembers() []*ResourceRef {
+	if x != nil {
+		return x.Members
 	}
 	return nil
 }
 
-type CreateRoleRequest struct {
+type DeleteResourceGroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoleRequest) Reset() {
-	*x = CreateRoleRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[13]
+func (x *DeleteResourceGroupRequest) Reset() {
+	*x = DeleteResourceGroupRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoleRequest) String() string {
+func (x *DeleteResourceGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoleRequest) ProtoMessage() {}
+func (*DeleteResourceGroupRequest) ProtoMessage() {}
 
-func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[13]
+func (x *DeleteResourceGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,39 +2816,40 @@ func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DeleteResourceGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateRoleRequest) GetName() string {
+func (x *DeleteResourceGroupRequest) GetGroupId() string {
 	if x != nil {
-		return x.Name
+		return x.GroupId
 	}
 	return ""
 }
 
-type ListRolesRequest struct {
+type ResourceGroupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *ResourceGroup         `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRolesRequest) Reset() {
-	*x = ListRolesRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[14]
+func (x *ResourceGroupResponse) Reset() {
+	*x = ResourceGroupResponse{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRolesRequest) String() string {
+func (x *ResourceGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolesRequest) ProtoMessage() {}
+func (*ResourceGroupResponse) ProtoMessage() {}
 
-func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[14]
+func (x *ResourceGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,33 +2860,40 @@ func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
-func (*ListRolesRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ResourceGroupResponse.ProtoReflect.Descriptor instead.
+func (*ResourceGroupResponse) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{50}
 }
 
-type ListRolesResponse struct {
+func (x *ResourceGroupResponse) GetGroup() *ResourceGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type ListUserRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Role                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRolesResponse) Reset() {
-	*x = ListRolesResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[15]
+func (x *ListUserRolesRequest) Reset() {
+	*x = ListUserRolesRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRolesResponse) String() string {
+func (x *ListUserRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolesResponse) ProtoMessage() {}
+func (*ListUserRolesRequest) ProtoMessage() {}
 
-func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[15]
+func (x *ListUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,19 +2904,19 @@ func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
-func (*ListRolesResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ListRolesResponse) GetItems() []*Role {
+func (x *ListUserRolesRequest) GetUserId() string {
 	if x != nil {
-		return x.Items
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type AssignRoleRequest struct {
+type RemoveRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
@@ -974,21 +2924,21 @@ type AssignRoleRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignRoleRequest) Reset() {
-	*x = AssignRoleRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[16]
+func (x *RemoveRoleRequest) Reset() {
+	*x = RemoveRoleRequest{}
+	mi := &file_api_iam_v1_iam_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignRoleRequest) String() string {
+func (x *RemoveRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignRoleRequest) ProtoMessage() {}
+func (*RemoveRoleRequest) ProtoMessage() {}
 
-func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[16]
+func (x *RemoveRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iam_v1_iam_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,19 +2949,19 @@ func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
-func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RemoveRoleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AssignRoleRequest) GetUserId() string {
+func (x *RemoveRoleRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *AssignRoleRequest) GetRoleId() string {
+func (x *RemoveRoleRequest) GetRoleId() string {
 	if x != nil {
 		return x.RoleId
 	}
@@ -1029,7 +2979,7 @@ type CreatePermissionRequest struct {
 
 func (x *CreatePermissionRequest) Reset() {
 	*x = CreatePermissionRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[17]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1041,7 +2991,7 @@ func (x *CreatePermissionRequest) String() string {
 func (*CreatePermissionRequest) ProtoMessage() {}
 
 func (x *CreatePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[17]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,7 +3004,7 @@ func (x *CreatePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePermissionRequest.ProtoReflect.Descriptor instead.
 func (*CreatePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{17}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *CreatePermissionRequest) GetCode() string {
@@ -1086,7 +3036,7 @@ type ListPermissionsRequest struct {
 
 func (x *ListPermissionsRequest) Reset() {
 	*x = ListPermissionsRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[18]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1098,7 +3048,7 @@ func (x *ListPermissionsRequest) String() string {
 func (*ListPermissionsRequest) ProtoMessage() {}
 
 func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[18]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +3061,7 @@ func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{18}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{54}
 }
 
 type ListPermissionsResponse struct {
@@ -1123,7 +3073,7 @@ type ListPermissionsResponse struct {
 
 func (x *ListPermissionsResponse) Reset() {
 	*x = ListPermissionsResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[19]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1135,7 +3085,7 @@ func (x *ListPermissionsResponse) String() string {
 func (*ListPermissionsResponse) ProtoMessage() {}
 
 func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[19]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,7 +3098,7 @@ func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{19}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ListPermissionsResponse) GetItems() []*Permission {
@@ -1168,7 +3118,7 @@ type AssignRolePermissionsRequest struct {
 
 func (x *AssignRolePermissionsRequest) Reset() {
 	*x = AssignRolePermissionsRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[20]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1180,7 +3130,7 @@ func (x *AssignRolePermissionsRequest) String() string {
 func (*AssignRolePermissionsRequest) ProtoMessage() {}
 
 func (x *AssignRolePermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[20]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1193,7 +3143,7 @@ func (x *AssignRolePermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRolePermissionsRequest.ProtoReflect.Descriptor instead.
 func (*AssignRolePermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{20}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *AssignRolePermissionsRequest) GetRoleId() string {
@@ -1219,7 +3169,7 @@ type ListRolePermissionsRequest struct {
 
 func (x *ListRolePermissionsRequest) Reset() {
 	*x = ListRolePermissionsRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[21]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1231,7 +3181,7 @@ func (x *ListRolePermissionsRequest) String() string {
 func (*ListRolePermissionsRequest) ProtoMessage() {}
 
 func (x *ListRolePermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[21]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1244,7 +3194,7 @@ func (x *ListRolePermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRolePermissionsRequest.ProtoReflect.Descriptor instead.
 func (*ListRolePermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{21}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ListRolePermissionsRequest) GetRoleId() string {
@@ -1269,7 +3219,7 @@ type CreatePlatformAdminRequest struct {
 
 func (x *CreatePlatformAdminRequest) Reset() {
 	*x = CreatePlatformAdminRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[22]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1281,7 +3231,7 @@ func (x *CreatePlatformAdminRequest) String() string {
 func (*CreatePlatformAdminRequest) ProtoMessage() {}
 
 func (x *CreatePlatformAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[22]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,7 +3244,7 @@ func (x *CreatePlatformAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePlatformAdminRequest.ProtoReflect.Descriptor instead.
 func (*CreatePlatformAdminRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{22}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *CreatePlatformAdminRequest) GetEmail() string {
@@ -1354,7 +3304,7 @@ type ListPlatformAdminsRequest struct {
 
 func (x *ListPlatformAdminsRequest) Reset() {
 	*x = ListPlatformAdminsRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[23]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1366,7 +3316,7 @@ func (x *ListPlatformAdminsRequest) String() string {
 func (*ListPlatformAdminsRequest) ProtoMessage() {}
 
 func (x *ListPlatformAdminsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[23]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1379,7 +3329,7 @@ func (x *ListPlatformAdminsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPlatformAdminsRequest.ProtoReflect.Descriptor instead.
 func (*ListPlatformAdminsRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{23}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{59}
 }
 
 type ListPlatformAdminsResponse struct {
@@ -1391,7 +3341,7 @@ type ListPlatformAdminsResponse struct {
 
 func (x *ListPlatformAdminsResponse) Reset() {
 	*x = ListPlatformAdminsResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[24]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1403,7 +3353,7 @@ func (x *ListPlatformAdminsResponse) String() string {
 func (*ListPlatformAdminsResponse) ProtoMessage() {}
 
 func (x *ListPlatformAdminsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[24]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +3366,7 @@ func (x *ListPlatformAdminsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPlatformAdminsResponse.ProtoReflect.Descriptor instead.
 func (*ListPlatformAdminsResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{24}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ListPlatformAdminsResponse) GetItems() []*PlatformAdmin {
@@ -1435,7 +3385,7 @@ type GetPlatformAdminRequest struct {
 
 func (x *GetPlatformAdminRequest) Reset() {
 	*x = GetPlatformAdminRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[25]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1447,7 +3397,7 @@ func (x *GetPlatformAdminRequest) String() string {
 func (*GetPlatformAdminRequest) ProtoMessage() {}
 
 func (x *GetPlatformAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[25]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1460,7 +3410,7 @@ func (x *GetPlatformAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlatformAdminRequest.ProtoReflect.Descriptor instead.
 func (*GetPlatformAdminRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{25}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetPlatformAdminRequest) GetId() string {
@@ -1479,7 +3429,7 @@ type CreatePlatformRoleRequest struct {
 
 func (x *CreatePlatformRoleRequest) Reset() {
 	*x = CreatePlatformRoleRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[26]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1491,7 +3441,7 @@ func (x *CreatePlatformRoleRequest) String() string {
 func (*CreatePlatformRoleRequest) ProtoMessage() {}
 
 func (x *CreatePlatformRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[26]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1504,7 +3454,7 @@ func (x *CreatePlatformRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePlatformRoleRequest.ProtoReflect.Descriptor instead.
 func (*CreatePlatformRoleRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{26}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *CreatePlatformRoleRequest) GetName() string {
@@ -1522,7 +3472,7 @@ type ListPlatformRolesRequest struct {
 
 func (x *ListPlatformRolesRequest) Reset() {
 	*x = ListPlatformRolesRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[27]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1534,7 +3484,7 @@ func (x *ListPlatformRolesRequest) String() string {
 func (*ListPlatformRolesRequest) ProtoMessage() {}
 
 func (x *ListPlatformRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[27]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1547,7 +3497,7 @@ func (x *ListPlatformRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPlatformRolesRequest.ProtoReflect.Descriptor instead.
 func (*ListPlatformRolesRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{27}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{63}
 }
 
 type ListPlatformRolesResponse struct {
@@ -1559,7 +3509,7 @@ type ListPlatformRolesResponse struct {
 
 func (x *ListPlatformRolesResponse) Reset() {
 	*x = ListPlatformRolesResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[28]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1571,7 +3521,7 @@ func (x *ListPlatformRolesResponse) String() string {
 func (*ListPlatformRolesResponse) ProtoMessage() {}
 
 func (x *ListPlatformRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[28]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,7 +3534,7 @@ func (x *ListPlatformRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPlatformRolesResponse.ProtoReflect.Descriptor instead.
 func (*ListPlatformRolesResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{28}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ListPlatformRolesResponse) GetItems() []*PlatformRole {
@@ -1603,7 +3553,7 @@ type GetPlatformRoleRequest struct {
 
 func (x *GetPlatformRoleRequest) Reset() {
 	*x = GetPlatformRoleRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[29]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1615,7 +3565,7 @@ func (x *GetPlatformRoleRequest) String() string {
 func (*GetPlatformRoleRequest) ProtoMessage() {}
 
 func (x *GetPlatformRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[29]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1628,7 +3578,7 @@ func (x *GetPlatformRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlatformRoleRequest.ProtoReflect.Descriptor instead.
 func (*GetPlatformRoleRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{29}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GetPlatformRoleRequest) GetId() string {
@@ -1648,7 +3598,7 @@ type AssignPlatformAdminRoleRequest struct {
 
 func (x *AssignPlatformAdminRoleRequest) Reset() {
 	*x = AssignPlatformAdminRoleRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[30]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1660,7 +3610,7 @@ func (x *AssignPlatformAdminRoleRequest) String() string {
 func (*AssignPlatformAdminRoleRequest) ProtoMessage() {}
 
 func (x *AssignPlatformAdminRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[30]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1673,7 +3623,7 @@ func (x *AssignPlatformAdminRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignPlatformAdminRoleRequest.ProtoReflect.Descriptor instead.
 func (*AssignPlatformAdminRoleRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{30}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *AssignPlatformAdminRoleRequest) GetAdminId() string {
@@ -1699,7 +3649,7 @@ type ListPlatformAdminRolesRequest struct {
 
 func (x *ListPlatformAdminRolesRequest) Reset() {
 	*x = ListPlatformAdminRolesRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[31]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1711,7 +3661,7 @@ func (x *ListPlatformAdminRolesRequest) String() string {
 func (*ListPlatformAdminRolesRequest) ProtoMessage() {}
 
 func (x *ListPlatformAdminRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[31]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1724,7 +3674,7 @@ func (x *ListPlatformAdminRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPlatformAdminRolesRequest.ProtoReflect.Descriptor instead.
 func (*ListPlatformAdminRolesRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{31}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ListPlatformAdminRolesRequest) GetAdminId() string {
@@ -1744,7 +3694,7 @@ type RemovePlatformAdminRoleRequest struct {
 
 func (x *RemovePlatformAdminRoleRequest) Reset() {
 	*x = RemovePlatformAdminRoleRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[32]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1756,7 +3706,7 @@ func (x *RemovePlatformAdminRoleRequest) String() string {
 func (*RemovePlatformAdminRoleRequest) ProtoMessage() {}
 
 func (x *RemovePlatformAdminRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[32]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1769,7 +3719,7 @@ func (x *RemovePlatformAdminRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemovePlatformAdminRoleRequest.ProtoReflect.Descriptor instead.
 func (*RemovePlatformAdminRoleRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{32}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *RemovePlatformAdminRoleRequest) GetAdminId() string {
@@ -1796,7 +3746,7 @@ type AssignPlatformRolePermissionsRequest struct {
 
 func (x *AssignPlatformRolePermissionsRequest) Reset() {
 	*x = AssignPlatformRolePermissionsRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[33]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1808,7 +3758,7 @@ func (x *AssignPlatformRolePermissionsRequest) String() string {
 func (*AssignPlatformRolePermissionsRequest) ProtoMessage() {}
 
 func (x *AssignPlatformRolePermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[33]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,7 +3771,7 @@ func (x *AssignPlatformRolePermissionsRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use AssignPlatformRolePermissionsRequest.ProtoReflect.Descriptor instead.
 func (*AssignPlatformRolePermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{33}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *AssignPlatformRolePermissionsRequest) GetRoleId() string {
@@ -1847,7 +3797,7 @@ type ListPlatformRolePermissionsRequest struct {
 
 func (x *ListPlatformRolePermissionsRequest) Reset() {
 	*x = ListPlatformRolePermissionsRequest{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[34]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1859,7 +3809,7 @@ func (x *ListPlatformRolePermissionsRequest) String() string {
 func (*ListPlatformRolePermissionsRequest) ProtoMessage() {}
 
 func (x *ListPlatformRolePermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[34]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1872,7 +3822,7 @@ func (x *ListPlatformRolePermissionsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListPlatformRolePermissionsRequest.ProtoReflect.Descriptor instead.
 func (*ListPlatformRolePermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{34}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ListPlatformRolePermissionsRequest) GetRoleId() string {
@@ -1891,7 +3841,7 @@ type TenantResponse struct {
 
 func (x *TenantResponse) Reset() {
 	*x = TenantResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[35]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1903,7 +3853,7 @@ func (x *TenantResponse) String() string {
 func (*TenantResponse) ProtoMessage() {}
 
 func (x *TenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[35]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1916,7 +3866,7 @@ func (x *TenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantResponse.ProtoReflect.Descriptor instead.
 func (*TenantResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{35}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *TenantResponse) GetTenant() *Tenant {
@@ -1936,7 +3886,7 @@ type UserResponse struct {
 
 func (x *UserResponse) Reset() {
 	*x = UserResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[36]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1948,7 +3898,7 @@ func (x *UserResponse) String() string {
 func (*UserResponse) ProtoMessage() {}
 
 func (x *UserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[36]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1961,7 +3911,7 @@ func (x *UserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
 func (*UserResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{36}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *UserResponse) GetUser() *User {
@@ -1987,7 +3937,7 @@ type RoleResponse struct {
 
 func (x *RoleResponse) Reset() {
 	*x = RoleResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[37]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1999,7 +3949,7 @@ func (x *RoleResponse) String() string {
 func (*RoleResponse) ProtoMessage() {}
 
 func (x *RoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[37]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,7 +3962,7 @@ func (x *RoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoleResponse.ProtoReflect.Descriptor instead.
 func (*RoleResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{37}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *RoleResponse) GetRole() *Role {
@@ -2031,7 +3981,7 @@ type PermissionResponse struct {
 
 func (x *PermissionResponse) Reset() {
 	*x = PermissionResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[38]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2043,7 +3993,7 @@ func (x *PermissionResponse) String() string {
 func (*PermissionResponse) ProtoMessage() {}
 
 func (x *PermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[38]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2056,7 +4006,7 @@ func (x *PermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionResponse.ProtoReflect.Descriptor instead.
 func (*PermissionResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{38}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *PermissionResponse) GetPermission() *Permission {
@@ -2076,7 +4026,7 @@ type PlatformAdminResponse struct {
 
 func (x *PlatformAdminResponse) Reset() {
 	*x = PlatformAdminResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[39]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2088,7 +4038,7 @@ func (x *PlatformAdminResponse) String() string {
 func (*PlatformAdminResponse) ProtoMessage() {}
 
 func (x *PlatformAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[39]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2101,7 +4051,7 @@ func (x *PlatformAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlatformAdminResponse.ProtoReflect.Descriptor instead.
 func (*PlatformAdminResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{39}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *PlatformAdminResponse) GetAdmin() *PlatformAdmin {
@@ -2127,7 +4077,7 @@ type PlatformRoleResponse struct {
 
 func (x *PlatformRoleResponse) Reset() {
 	*x = PlatformRoleResponse{}
-	mi := &file_api_iam_v1_iam_proto_msgTypes[40]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2139,7 +4089,7 @@ func (x *PlatformRoleResponse) String() string {
 func (*PlatformRoleResponse) ProtoMessage() {}
 
 func (x *PlatformRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_iam_v1_iam_proto_msgTypes[40]
+	mi := &file_api_iam_v1_iam_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2152,7 +4102,7 @@ func (x *PlatformRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlatformRoleResponse.ProtoReflect.Descriptor instead.
 func (*PlatformRoleResponse) Descriptor() ([]byte, []int) {
-	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{40}
+	return file_api_iam_v1_iam_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *PlatformRoleResponse) GetRole() *PlatformRole {
@@ -2215,7 +4165,76 @@ const file_api_iam_v1_iam_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
 	"\x12ListTenantsRequest\"?\n" +
 	"\x13ListTenantsResponse\x12(\n" +
-	"\x05items\x18\x01 \x03(\v2\x12.api.iam.v1.TenantR\x05items\"\xed\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.api.iam.v1.TenantR\x05items\"a\n" +
+	"\x13UpdateTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04plan\x18\x03 \x01(\tR\x04plan\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\"&\n" +
+	"\x14SuspendTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
+	"\x13ResumeTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
+	"\x13DeleteTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xf8\x01\n" +
+	"\n" +
+	"PlanLimits\x12\x19\n" +
+	"\bmax_bots\x18\x01 \x01(\x03R\amaxBots\x12.\n" +
+	"\x13max_knowledge_bases\x18\x02 \x01(\x03R\x11maxKnowledgeBases\x12#\n" +
+	"\rmax_documents\x18\x03 \x01(\x03R\fmaxDocuments\x12*\n" +
+	"\x11max_storage_bytes\x18\x04 \x01(\x03R\x0fmaxStorageBytes\x12'\n" +
+	"\x0fmonthly_queries\x18\x05 \x01(\x03R\x0emonthlyQueries\x12%\n" +
+	"\x0emonthly_tokens\x18\x06 \x01(\x03R\rmonthlyTokens\"\x99\x01\n" +
+	"\x04Plan\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x06limits\x18\x03 \x01(\v2\x16.api.iam.v1.PlanLimitsR\x06limits\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x12\n" +
+	"\x10ListPlansRequest\";\n" +
+	"\x11ListPlansResponse\x12&\n" +
+	"\x05items\x18\x01 \x03(\v2\x10.api.iam.v1.PlanR\x05items\"k\n" +
+	"\x11UpdatePlanRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x06limits\x18\x03 \x01(\v2\x16.api.iam.v1.PlanLimitsR\x06limits\"4\n" +
+	"\fPlanResponse\x12$\n" +
+	"\x04plan\x18\x01 \x01(\v2\x10.api.iam.v1.PlanR\x04plan\"\xdc\x02\n" +
+	"\x11TenantPurgeReport\x12;\n" +
+	"\x04rows\x18\x01 \x03(\v2'.api.iam.v1.TenantPurgeReport.RowsEntryR\x04rows\x12\x18\n" +
+	"\aobjects\x18\x02 \x01(\x03R\aobjects\x12 \n" +
+	"\vcollections\x18\x03 \x03(\tR\vcollections\x12T\n" +
+	"\rvector_points\x18\x04 \x03(\v2/.api.iam.v1.TenantPurgeReport.VectorPointsEntryR\fvectorPoints\x1a7\n" +
+	"\tRowsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a?\n" +
+	"\x11VectorPointsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xa8\x03\n" +
+	"\x0eTenantPurgeJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1f\n" +
+	"\vtenant_name\x18\x03 \x01(\tR\n" +
+	"tenantName\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\x125\n" +
+	"\x06report\x18\x06 \x01(\v2\x1d.api.iam.v1.TenantPurgeReportR\x06report\x12#\n" +
+	"\rerror_message\x18\a \x01(\tR\ferrorMessage\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"*\n" +
+	"\x18GetTenantPurgeJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x1aListTenantPurgeJobsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"O\n" +
+	"\x1bListTenantPurgeJobsResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.api.iam.v1.TenantPurgeJobR\x05items\"F\n" +
+	"\x16TenantPurgeJobResponse\x12,\n" +
+	"\x03job\x18\x01 \x01(\v2\x1a.api.iam.v1.TenantPurgeJobR\x03job\"\xed\x01\n" +
 	"\x11CreateUserRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
@@ -2229,14 +4248,76 @@ const file_api_iam_v1_iam_proto_rawDesc = "" +
 	"\x10ListUsersRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\";\n" +
 	"\x11ListUsersResponse\x12&\n" +
-	"\x05items\x18\x01 \x03(\v2\x10.api.iam.v1.UserR\x05items\"'\n" +
+	"\x05items\x18\x01 \x03(\v2\x10.api.iam.v1.UserR\x05items\"\x84\x01\n" +
+	"\x11UpdateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\",\n" +
+	"\x11DeleteUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"V\n" +
+	"\x13ResendInviteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0finvite_base_url\x18\x02 \x01(\tR\rinviteBaseUrl\"3\n" +
+	"\x18ResetUserPasswordRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"'\n" +
 	"\x11CreateRoleRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"\x12\n" +
 	"\x10ListRolesRequest\";\n" +
 	"\x11ListRolesResponse\x12&\n" +
-	"\x05items\x18\x01 \x03(\v2\x10.api.iam.v1.RoleR\x05items\"E\n" +
+	"\x05items\x18\x01 \x03(\v2\x10.api.iam.v1.RoleR\x05items\"@\n" +
+	"\x11UpdateRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\",\n" +
+	"\x11DeleteRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\"1\n" +
+	"\vResourceRef\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"v\n" +
 	"\x11AssignRoleRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\x12/\n" +
+	"\x06scopes\x18\x03 \x03(\v2\x17.api.iam.v1.ResourceRefR\x06scopes\"d\n" +
+	"\vRoleBinding\x12$\n" +
+	"\x04role\x18\x01 \x01(\v2\x10.api.iam.v1.RoleR\x04role\x12/\n" +
+	"\x06scopes\x18\x02 \x03(\v2\x17.api.iam.v1.ResourceRefR\x06scopes\"M\n" +
+	"\x1cListUserRoleBindingsResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.api.iam.v1.RoleBindingR\x05items\"\x9b\x02\n" +
+	"\rResourceGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x121\n" +
+	"\amembers\x18\x05 \x03(\v2\x17.api.iam.v1.ResourceRefR\amembers\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x85\x01\n" +
+	"\x1aCreateResourceGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x121\n" +
+	"\amembers\x18\x03 \x03(\v2\x17.api.iam.v1.ResourceRefR\amembers\"4\n" +
+	"\x17GetResourceGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"\x1b\n" +
+	"\x19ListResourceGroupsRequest\"M\n" +
+	"\x1aListResourceGroupsResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.api.iam.v1.ResourceGroupR\x05items\"m\n" +
+	"\x1aUpdateResourceGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"n\n" +
+	"\x1eSetResourceGroupMembersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x121\n" +
+	"\amembers\x18\x02 \x03(\v2\x17.api.iam.v1.ResourceRefR\amembers\"7\n" +
+	"\x1aDeleteResourceGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"H\n" +
+	"\x15ResourceGroupResponse\x12/\n" +
+	"\x05group\x18\x01 \x01(\v2\x19.api.iam.v1.ResourceGroupR\x05group\"/\n" +
+	"\x14ListUserRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"E\n" +
+	"\x11RemoveRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\arole_id\x18\x02 \x01(\tR\x06roleId\"e\n" +
 	"\x17CreatePermissionRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
@@ -2315,7 +4396,7 @@ func file_api_iam_v1_iam_proto_rawDescGZIP() []byte {
 	return file_api_iam_v1_iam_proto_rawDescData
 }
 
-var file_api_iam_v1_iam_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_api_iam_v1_iam_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_api_iam_v1_iam_proto_goTypes = []any{
 	(*Tenant)(nil),                               // 0: api.iam.v1.Tenant
 	(*User)(nil),                                 // 1: api.iam.v1.User
@@ -2327,60 +4408,122 @@ var file_api_iam_v1_iam_proto_goTypes = []any{
 	(*GetTenantRequest)(nil),                     // 7: api.iam.v1.GetTenantRequest
 	(*ListTenantsRequest)(nil),                   // 8: api.iam.v1.ListTenantsRequest
 	(*ListTenantsResponse)(nil),                  // 9: api.iam.v1.ListTenantsResponse
-	(*CreateUserRequest)(nil),                    // 10: api.iam.v1.CreateUserRequest
-	(*ListUsersRequest)(nil),                     // 11: api.iam.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),                    // 12: api.iam.v1.ListUsersResponse
-	(*CreateRoleRequest)(nil),                    // 13: api.iam.v1.CreateRoleRequest
-	(*ListRolesRequest)(nil),                     // 14: api.iam.v1.ListRolesRequest
-	(*ListRolesResponse)(nil),                    // 15: api.iam.v1.ListRolesResponse
-	(*AssignRoleRequest)(nil),                    // 16: api.iam.v1.AssignRoleRequest
-	(*CreatePermissionRequest)(nil),              // 17: api.iam.v1.CreatePermissionRequest
-	(*ListPermissionsRequest)(nil),               // 18: api.iam.v1.ListPermissionsRequest
-	(*ListPermissionsResponse)(nil),              // 19: api.iam.v1.ListPermissionsResponse
-	(*AssignRolePermissionsRequest)(nil),         // 20: api.iam.v1.AssignRolePermissionsRequest
-	(*ListRolePermissionsRequest)(nil),           // 21: api.iam.v1.ListRolePermissionsRequest
-	(*CreatePlatformAdminRequest)(nil),           // 22: api.iam.v1.CreatePlatformAdminRequest
-	(*ListPlatformAdminsRequest)(nil),            // 23: api.iam.v1.ListPlatformAdminsRequest
-	(*ListPlatformAdminsResponse)(nil),           // 24: api.iam.v1.ListPlatformAdminsResponse
-	(*GetPlatformAdminRequest)(nil),              // 25: api.iam.v1.GetPlatformAdminRequest
-	(*CreatePlatformRoleRequest)(nil),            // 26: api.iam.v1.CreatePlatformRoleRequest
-	(*ListPlatformRolesRequest)(nil),             // 27: api.iam.v1.ListPlatformRolesRequest
-	(*ListPlatformRolesResponse)(nil),            // 28: api.iam.v1.ListPlatformRolesResponse
-	(*GetPlatformRoleRequest)(nil),               // 29: api.iam.v1.GetPlatformRoleRequest
-	(*AssignPlatformAdminRoleRequest)(nil),       // 30: api.iam.v1.AssignPlatformAdminRoleRequest
-	(*ListPlatformAdminRolesRequest)(nil),        // 31: api.iam.v1.ListPlatformAdminRolesRequest
-	(*RemovePlatformAdminRoleRequest)(nil),       // 32: api.iam.v1.RemovePlatformAdminRoleRequest
-	(*AssignPlatformRolePermissionsRequest)(nil), // 33: api.iam.v1.AssignPlatformRolePermissionsRequest
-	(*ListPlatformRolePermissionsRequest)(nil),   // 34: api.iam.v1.ListPlatformRolePermissionsRequest
-	(*TenantResponse)(nil),                       // 35: api.iam.v1.TenantResponse
-	(*UserResponse)(nil),                         // 36: api.iam.v1.UserResponse
-	(*RoleResponse)(nil),                         // 37: api.iam.v1.RoleResponse
-	(*PermissionResponse)(nil),                   // 38: api.iam.v1.PermissionResponse
-	(*PlatformAdminResponse)(nil),                // 39: api.iam.v1.PlatformAdminResponse
-	(*PlatformRoleResponse)(nil),                 // 40: api.iam.v1.PlatformRoleResponse
-	(*timestamppb.Timestamp)(nil),                // 41: google.protobuf.Timestamp
+	(*UpdateTenantRequest)(nil),                  // 10: api.iam.v1.UpdateTenantRequest
+	(*SuspendTenantRequest)(nil),                 // 11: api.iam.v1.SuspendTenantRequest
+	(*ResumeTenantRequest)(nil),                  // 12: api.iam.v1.ResumeTenantRequest
+	(*DeleteTenantRequest)(nil),                  // 13: api.iam.v1.DeleteTenantRequest
+	(*PlanLimits)(nil),                           // 14: api.iam.v1.PlanLimits
+	(*Plan)(nil),                                 // 15: api.iam.v1.Plan
+	(*ListPlansRequest)(nil),                     // 16: api.iam.v1.ListPlansRequest
+	(*ListPlansResponse)(nil),                    // 17: api.iam.v1.ListPlansResponse
+	(*UpdatePlanRequest)(nil),                    // 18: api.iam.v1.UpdatePlanRequest
+	(*PlanResponse)(nil),                         // 19: api.iam.v1.PlanResponse
+	(*TenantPurgeReport)(nil),                    // 20: api.iam.v1.TenantPurgeReport
+	(*TenantPurgeJob)(nil),                       // 21: api.iam.v1.TenantPurgeJob
+	(*GetTenantPurgeJobRequest)(nil),             // 22: api.iam.v1.GetTenantPurgeJobRequest
+	(*ListTenantPurgeJobsRequest)(nil),           // 23: api.iam.v1.ListTenantPurgeJobsRequest
+	(*ListTenantPurgeJobsResponse)(nil),          // 24: api.iam.v1.ListTenantPurgeJobsResponse
+	(*TenantPurgeJobResponse)(nil),               // 25: api.iam.v1.TenantPurgeJobResponse
+	(*CreateUserRequest)(nil),                    // 26: api.iam.v1.CreateUserRequest
+	(*ListUsersRequest)(nil),                     // 27: api.iam.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                    // 28: api.iam.v1.ListUsersResponse
+	(*UpdateUserRequest)(nil),                    // 29: api.iam.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                    // 30: api.iam.v1.DeleteUserRequest
+	(*ResendInviteRequest)(nil),                  // 31: api.iam.v1.ResendInviteRequest
+	(*ResetUserPasswordRequest)(nil),             // 32: api.iam.v1.ResetUserPasswordRequest
+	(*CreateRoleRequest)(nil),                    // 33: api.iam.v1.CreateRoleRequest
+	(*ListRolesRequest)(nil),                     // 34: api.iam.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),                    // 35: api.iam.v1.ListRolesResponse
+	(*UpdateRoleRequest)(nil),                    // 36: api.iam.v1.UpdateRoleRequest
+	(*DeleteRoleRequest)(nil),                    // 37: api.iam.v1.DeleteRoleRequest
+	(*ResourceRef)(nil),                          // 38: api.iam.v1.ResourceRef
+	(*AssignRoleRequest)(nil),                    // 39: api.iam.v1.AssignRoleRequest
+	(*RoleBinding)(nil),                          // 40: api.iam.v1.RoleBinding
+	(*ListUserRoleBindingsResponse)(nil),         // 41: api.iam.v1.ListUserRoleBindingsResponse
+	(*ResourceGroup)(nil),                        // 42: api.iam.v1.ResourceGroup
+	(*CreateResourceGroupRequest)(nil),           // 43: api.iam.v1.CreateResourceGroupRequest
+	(*GetResourceGroupRequest)(nil),              // 44: api.iam.v1.GetResourceGroupRequest
+	(*ListResourceGroupsRequest)(nil),            // 45: api.iam.v1.ListResourceGroupsRequest
+	(*ListResourceGroupsResponse)(nil),           // 46: api.iam.v1.ListResourceGroupsResponse
+	(*UpdateResourceGroupRequest)(nil),           // 47: api.iam.v1.UpdateResourceGroupRequest
+	(*SetResourceGroupMembersRequest)(nil),       // 48: api.iam.v1.SetResourceGroupMembersRequest
+	(*DeleteResourceGroupRequest)(nil),           // 49: api.iam.v1.DeleteResourceGroupRequest
+	(*ResourceGroupResponse)(nil),                // 50: api.iam.v1.ResourceGroupResponse
+	(*ListUserRolesRequest)(nil),                 // 51: api.iam.v1.ListUserRolesRequest
+	(*RemoveRoleRequest)(nil),                    // 52: api.iam.v1.RemoveRoleRequest
+	(*CreatePermissionRequest)(nil),              // 53: api.iam.v1.CreatePermissionRequest
+	(*ListPermissionsRequest)(nil),               // 54: api.iam.v1.ListPermissionsRequest
+	(*ListPermissionsResponse)(nil),              // 55: api.iam.v1.ListPermissionsResponse
+	(*AssignRolePermissionsRequest)(nil),         // 56: api.iam.v1.AssignRolePermissionsRequest
+	(*ListRolePermissionsRequest)(nil),           // 57: api.iam.v1.ListRolePermissionsRequest
+	(*CreatePlatformAdminRequest)(nil),           // 58: api.iam.v1.CreatePlatformAdminRequest
+	(*ListPlatformAdminsRequest)(nil),            // 59: api.iam.v1.ListPlatformAdminsRequest
+	(*ListPlatformAdminsResponse)(nil),           // 60: api.iam.v1.ListPlatformAdminsResponse
+	(*GetPlatformAdminRequest)(nil),              // 61: api.iam.v1.GetPlatformAdminRequest
+	(*CreatePlatformRoleRequest)(nil),            // 62: api.iam.v1.CreatePlatformRoleRequest
+	(*ListPlatformRolesRequest)(nil),             // 63: api.iam.v1.ListPlatformRolesRequest
+	(*ListPlatformRolesResponse)(nil),            // 64: api.iam.v1.ListPlatformRolesResponse
+	(*GetPlatformRoleRequest)(nil),               // 65: api.iam.v1.GetPlatformRoleRequest
+	(*AssignPlatformAdminRoleRequest)(nil),       // 66: api.iam.v1.AssignPlatformAdminRoleRequest
+	(*ListPlatformAdminRolesRequest)(nil),        // 67: api.iam.v1.ListPlatformAdminRolesRequest
+	(*RemovePlatformAdminRoleRequest)(nil),       // 68: api.iam.v1.RemovePlatformAdminRoleRequest
+	(*AssignPlatformRolePermissionsRequest)(nil), // 69: api.iam.v1.AssignPlatformRolePermissionsRequest
+	(*ListPlatformRolePermissionsRequest)(nil),   // 70: api.iam.v1.ListPlatformRolePermissionsRequest
+	(*TenantResponse)(nil),                       // 71: api.iam.v1.TenantResponse
+	(*UserResponse)(nil),                         // 72: api.iam.v1.UserResponse
+	(*RoleResponse)(nil),                         // 73: api.iam.v1.RoleResponse
+	(*PermissionResponse)(nil),                   // 74: api.iam.v1.PermissionResponse
+	(*PlatformAdminResponse)(nil),                // 75: api.iam.v1.PlatformAdminResponse
+	(*PlatformRoleResponse)(nil),                 // 76: api.iam.v1.PlatformRoleResponse
+	nil,                                          // 77: api.iam.v1.TenantPurgeReport.RowsEntry
+	nil,                                          // 78: api.iam.v1.TenantPurgeReport.VectorPointsEntry
+	(*timestamppb.Timestamp)(nil),                // 79: google.protobuf.Timestamp
 }
 var file_api_iam_v1_iam_proto_depIdxs = []int32{
-	41, // 0: api.iam.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
-	41, // 1: api.iam.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	41, // 2: api.iam.v1.PlatformAdmin.created_at:type_name -> google.protobuf.Timestamp
+	79, // 0: api.iam.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	79, // 1: api.iam.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	79, // 2: api.iam.v1.PlatformAdmin.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 3: api.iam.v1.ListTenantsResponse.items:type_name -> api.iam.v1.Tenant
-	1,  // 4: api.iam.v1.ListUsersResponse.items:type_name -> api.iam.v1.User
-	2,  // 5: api.iam.v1.ListRolesResponse.items:type_name -> api.iam.v1.Role
-	3,  // 6: api.iam.v1.ListPermissionsResponse.items:type_name -> api.iam.v1.Permission
-	4,  // 7: api.iam.v1.ListPlatformAdminsResponse.items:type_name -> api.iam.v1.PlatformAdmin
-	5,  // 8: api.iam.v1.ListPlatformRolesResponse.items:type_name -> api.iam.v1.PlatformRole
-	0,  // 9: api.iam.v1.TenantResponse.tenant:type_name -> api.iam.v1.Tenant
-	1,  // 10: api.iam.v1.UserResponse.user:type_name -> api.iam.v1.User
-	2,  // 11: api.iam.v1.RoleResponse.role:type_name -> api.iam.v1.Role
-	3,  // 12: api.iam.v1.PermissionResponse.permission:type_name -> api.iam.v1.Permission
-	4,  // 13: api.iam.v1.PlatformAdminResponse.admin:type_name -> api.iam.v1.PlatformAdmin
-	5,  // 14: api.iam.v1.PlatformRoleResponse.role:type_name -> api.iam.v1.PlatformRole
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	14, // 4: api.iam.v1.Plan.limits:type_name -> api.iam.v1.PlanLimits
+	79, // 5: api.iam.v1.Plan.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 6: api.iam.v1.ListPlansResponse.items:type_name -> api.iam.v1.Plan
+	14, // 7: api.iam.v1.UpdatePlanRequest.limits:type_name -> api.iam.v1.PlanLimits
+	15, // 8: api.iam.v1.PlanResponse.plan:type_name -> api.iam.v1.Plan
+	77, // 9: api.iam.v1.TenantPurgeReport.rows:type_name -> api.iam.v1.TenantPurgeReport.RowsEntry
+	78, // 10: api.iam.v1.TenantPurgeReport.vector_points:type_name -> api.iam.v1.TenantPurgeReport.VectorPointsEntry
+	20, // 11: api.iam.v1.TenantPurgeJob.report:type_name -> api.iam.v1.TenantPurgeReport
+	79, // 12: api.iam.v1.TenantPurgeJob.created_at:type_name -> google.protobuf.Timestamp
+	79, // 13: api.iam.v1.TenantPurgeJob.started_at:type_name -> google.protobuf.Timestamp
+	79, // 14: api.iam.v1.TenantPurgeJob.finished_at:type_name -> google.protobuf.Timestamp
+	21, // 15: api.iam.v1.ListTenantPurgeJobsResponse.items:type_name -> api.iam.v1.TenantPurgeJob
+	21, // 16: api.iam.v1.TenantPurgeJobResponse.job:type_name -> api.iam.v1.TenantPurgeJob
+	1,  // 17: api.iam.v1.ListUsersResponse.items:type_name -> api.iam.v1.User
+	2,  // 18: api.iam.v1.ListRolesResponse.items:type_name -> api.iam.v1.Role
+	38, // 19: api.iam.v1.AssignRoleRequest.scopes:type_name -> api.iam.v1.ResourceRef
+	2,  // 20: api.iam.v1.RoleBinding.role:type_name -> api.iam.v1.Role
+	38, // 21: api.iam.v1.RoleBinding.scopes:type_name -> api.iam.v1.ResourceRef
+	40, // 22: api.iam.v1.ListUserRoleBindingsResponse.items:type_name -> api.iam.v1.RoleBinding
+	38, // 23: api.iam.v1.ResourceGroup.members:type_name -> api.iam.v1.ResourceRef
+	79, // 24: api.iam.v1.ResourceGroup.created_at:type_name -> google.protobuf.Timestamp
+	79, // 25: api.iam.v1.ResourceGroup.updated_at:type_name -> google.protobuf.Timestamp
+	38, // 26: api.iam.v1.CreateResourceGroupRequest.members:type_name -> api.iam.v1.ResourceRef
+	42, // 27: api.iam.v1.ListResourceGroupsResponse.items:type_name -> api.iam.v1.ResourceGroup
+	38, // 28: api.iam.v1.SetResourceGroupMembersRequest.members:type_name -> api.iam.v1.ResourceRef
+	42, // 29: api.iam.v1.ResourceGroupResponse.group:type_name -> api.iam.v1.ResourceGroup
+	3,  // 30: api.iam.v1.ListPermissionsResponse.items:type_name -> api.iam.v1.Permission
+	4,  // 31: api.iam.v1.ListPlatformAdminsResponse.items:type_name -> api.iam.v1.PlatformAdmin
+	5,  // 32: api.iam.v1.ListPlatformRolesResponse.items:type_name -> api.iam.v1.PlatformRole
+	0,  // 33: api.iam.v1.TenantResponse.tenant:type_name -> api.iam.v1.Tenant
+	1,  // 34: api.iam.v1.UserResponse.user:type_name -> api.iam.v1.User
+	2,  // 35: api.iam.v1.RoleResponse.role:type_name -> api.iam.v1.Role
+	3,  // 36: api.iam.v1.PermissionResponse.permission:type_name -> api.iam.v1.Permission
+	4,  // 37: api.iam.v1.PlatformAdminResponse.admin:type_name -> api.iam.v1.PlatformAdmin
+	5,  // 38: api.iam.v1.PlatformRoleResponse.role:type_name -> api.iam.v1.PlatformRole
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_api_iam_v1_iam_proto_init() }
@@ -2394,7 +4537,7 @@ func file_api_iam_v1_iam_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_iam_v1_iam_proto_rawDesc), len(file_api_iam_v1_iam_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   41,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -27,11 +27,20 @@ var File_api_iam_v1_platform_iam_proto protoreflect.FileDescriptor
 const file_api_iam_v1_platform_iam_proto_rawDesc = "" +
 	"\n" +
 	"\x1dapi/iam/v1/platform_iam.proto\x12\n" +
-	"api.iam.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x14api/iam/v1/iam.proto2\xd5\x10\n" +
+	"api.iam.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x14api/iam/v1/iam.proto2\xa8\x18\n" +
 	"\vPlatformIAM\x12l\n" +
 	"\fCreateTenant\x12\x1f.api.iam.v1.CreateTenantRequest\x1a\x1a.api.iam.v1.TenantResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/platform/v1/tenants\x12h\n" +
 	"\tGetTenant\x12\x1c.api.iam.v1.GetTenantRequest\x1a\x1a.api.iam.v1.TenantResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/platform/v1/tenants/{id}\x12l\n" +
-	"\vListTenants\x12\x1e.api.iam.v1.ListTenantsRequest\x1a\x1f.api.iam.v1.ListTenantsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/platform/v1/tenants\x12|\n" +
+	"\vListTenants\x12\x1e.api.iam.v1.ListTenantsRequest\x1a\x1f.api.iam.v1.ListTenantsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/platform/v1/tenants\x12q\n" +
+	"\fUpdateTenant\x12\x1f.api.iam.v1.UpdateTenantRequest\x1a\x1a.api.iam.v1.TenantResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/platform/v1/tenants/{id}\x12{\n" +
+	"\rSuspendTenant\x12 .api.iam.v1.SuspendTenantRequest\x1a\x1a.api.iam.v1.TenantResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/platform/v1/tenants/{id}/suspend\x12x\n" +
+	"\fResumeTenant\x12\x1f.api.iam.v1.ResumeTenantRequest\x1a\x1a.api.iam.v1.TenantResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /platform/v1/tenants/{id}/resume\x12v\n" +
+	"\fDeleteTenant\x12\x1f.api.iam.v1.DeleteTenantRequest\x1a\".api.iam.v1.TenantPurgeJobResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/platform/v1/tenants/{id}\x12\x8a\x01\n" +
+	"\x11GetTenantPurgeJob\x12$.api.iam.v1.GetTenantPurgeJobRequest\x1a\".api.iam.v1.TenantPurgeJobResponse\"+\x82\xd3\xe4\x93\x02%\x12#/platform/v1/tenant_purge_jobs/{id}\x12\x8e\x01\n" +
+	"\x13ListTenantPurgeJobs\x12&.api.iam.v1.ListTenantPurgeJobsRequest\x1a'.api.iam.v1.ListTenantPurgeJobsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/platform/v1/tenant_purge_jobs\x12d\n" +
+	"\tListPlans\x12\x1c.api.iam.v1.ListPlansRequest\x1a\x1d.api.iam.v1.ListPlansResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/platform/v1/plans\x12k\n" +
+	"\n" +
+	"UpdatePlan\x12\x1d.api.iam.v1.UpdatePlanRequest\x1a\x18.api.iam.v1.PlanResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/platform/v1/plans/{code}\x12|\n" +
 	"\x10CreatePermission\x12#.api.iam.v1.CreatePermissionRequest\x1a\x1e.api.iam.v1.PermissionResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/platform/v1/permissions\x12|\n" +
 	"\x0fListPermissions\x12\".api.iam.v1.ListPermissionsRequest\x1a#.api.iam.v1.ListPermissionsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/platform/v1/permissions\x12\x80\x01\n" +
 	"\x13CreatePlatformAdmin\x12&.api.iam.v1.CreatePlatformAdminRequest\x1a!.api.iam.v1.PlatformAdminResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/platform/v1/admins\x12\x80\x01\n" +
@@ -50,64 +59,92 @@ var file_api_iam_v1_platform_iam_proto_goTypes = []any{
 	(*CreateTenantRequest)(nil),                  // 0: api.iam.v1.CreateTenantRequest
 	(*GetTenantRequest)(nil),                     // 1: api.iam.v1.GetTenantRequest
 	(*ListTenantsRequest)(nil),                   // 2: api.iam.v1.ListTenantsRequest
-	(*CreatePermissionRequest)(nil),              // 3: api.iam.v1.CreatePermissionRequest
-	(*ListPermissionsRequest)(nil),               // 4: api.iam.v1.ListPermissionsRequest
-	(*CreatePlatformAdminRequest)(nil),           // 5: api.iam.v1.CreatePlatformAdminRequest
-	(*ListPlatformAdminsRequest)(nil),            // 6: api.iam.v1.ListPlatformAdminsRequest
-	(*GetPlatformAdminRequest)(nil),              // 7: api.iam.v1.GetPlatformAdminRequest
-	(*CreatePlatformRoleRequest)(nil),            // 8: api.iam.v1.CreatePlatformRoleRequest
-	(*ListPlatformRolesRequest)(nil),             // 9: api.iam.v1.ListPlatformRolesRequest
-	(*GetPlatformRoleRequest)(nil),               // 10: api.iam.v1.GetPlatformRoleRequest
-	(*AssignPlatformAdminRoleRequest)(nil),       // 11: api.iam.v1.AssignPlatformAdminRoleRequest
-	(*ListPlatformAdminRolesRequest)(nil),        // 12: api.iam.v1.ListPlatformAdminRolesRequest
-	(*RemovePlatformAdminRoleRequest)(nil),       // 13: api.iam.v1.RemovePlatformAdminRoleRequest
-	(*AssignPlatformRolePermissionsRequest)(nil), // 14: api.iam.v1.AssignPlatformRolePermissionsRequest
-	(*ListPlatformRolePermissionsRequest)(nil),   // 15: api.iam.v1.ListPlatformRolePermissionsRequest
-	(*TenantResponse)(nil),                       // 16: api.iam.v1.TenantResponse
-	(*ListTenantsResponse)(nil),                  // 17: api.iam.v1.ListTenantsResponse
-	(*PermissionResponse)(nil),                   // 18: api.iam.v1.PermissionResponse
-	(*ListPermissionsResponse)(nil),              // 19: api.iam.v1.ListPermissionsResponse
-	(*PlatformAdminResponse)(nil),                // 20: api.iam.v1.PlatformAdminResponse
-	(*ListPlatformAdminsResponse)(nil),           // 21: api.iam.v1.ListPlatformAdminsResponse
-	(*PlatformRoleResponse)(nil),                 // 22: api.iam.v1.PlatformRoleResponse
-	(*ListPlatformRolesResponse)(nil),            // 23: api.iam.v1.ListPlatformRolesResponse
-	(*emptypb.Empty)(nil),                        // 24: google.protobuf.Empty
+	(*UpdateTenantRequest)(nil),                  // 3: api.iam.v1.UpdateTenantRequest
+	(*SuspendTenantRequest)(nil),                 // 4: api.iam.v1.SuspendTenantRequest
+	(*ResumeTenantRequest)(nil),                  // 5: api.iam.v1.ResumeTenantRequest
+	(*DeleteTenantRequest)(nil),                  // 6: api.iam.v1.DeleteTenantRequest
+	(*GetTenantPurgeJobRequest)(nil),             // 7: api.iam.v1.GetTenantPurgeJobRequest
+	(*ListTenantPurgeJobsRequest)(nil),           // 8: api.iam.v1.ListTenantPurgeJobsRequest
+	(*ListPlansRequest)(nil),                     // 9: api.iam.v1.ListPlansRequest
+	(*UpdatePlanRequest)(nil),                    // 10: api.iam.v1.UpdatePlanRequest
+	(*CreatePermissionRequest)(nil),              // 11: api.iam.v1.CreatePermissionRequest
+	(*ListPermissionsRequest)(nil),               // 12: api.iam.v1.ListPermissionsRequest
+	(*CreatePlatformAdminRequest)(nil),           // 13: api.iam.v1.CreatePlatformAdminRequest
+	(*ListPlatformAdminsRequest)(nil),            // 14: api.iam.v1.ListPlatformAdminsRequest
+	(*GetPlatformAdminRequest)(nil),              // 15: api.iam.v1.GetPlatformAdminRequest
+	(*CreatePlatformRoleRequest)(nil),            // 16: api.iam.v1.CreatePlatformRoleRequest
+	(*ListPlatformRolesRequest)(nil),             // 17: api.iam.v1.ListPlatformRolesRequest
+	(*GetPlatformRoleRequest)(nil),               // 18: api.iam.v1.GetPlatformRoleRequest
+	(*AssignPlatformAdminRoleRequest)(nil),       // 19: api.iam.v1.AssignPlatformAdminRoleRequest
+	(*ListPlatformAdminRolesRequest)(nil),        // 20: api.iam.v1.ListPlatformAdminRolesRequest
+	(*RemovePlatformAdminRoleRequest)(nil),       // 21: api.iam.v1.RemovePlatformAdminRoleRequest
+	(*AssignPlatformRolePermissionsRequest)(nil), // 22: api.iam.v1.AssignPlatformRolePermissionsRequest
+	(*ListPlatformRolePermissionsRequest)(nil),   // 23: api.iam.v1.ListPlatformRolePermissionsRequest
+	(*TenantResponse)(nil),                       // 24: api.iam.v1.TenantResponse
+	(*ListTenantsResponse)(nil),                  // 25: api.iam.v1.ListTenantsResponse
+	(*TenantPurgeJobResponse)(nil),               // 26: api.iam.v1.TenantPurgeJobResponse
+	(*ListTenantPurgeJobsResponse)(nil),          // 27: api.iam.v1.ListTenantPurgeJobsResponse
+	(*ListPlansResponse)(nil),                    // 28: api.iam.v1.ListPlansResponse
+	(*PlanResponse)(nil),                         // 29: api.iam.v1.PlanResponse
+	(*PermissionResponse)(nil),                   // 30: api.iam.v1.PermissionResponse
+	(*ListPermissionsResponse)(nil),              // 31: api.iam.v1.ListPermissionsResponse
+	(*PlatformAdminResponse)(nil),                // 32: api.iam.v1.PlatformAdminResponse
+	(*ListPlatformAdminsResponse)(nil),           // 33: api.iam.v1.ListPlatformAdminsResponse
+	(*PlatformRoleResponse)(nil),                 // 34: api.iam.v1.PlatformRoleResponse
+	(*ListPlatformRolesResponse)(nil),            // 35: api.iam.v1.ListPlatformRolesResponse
+	(*emptypb.Empty)(nil),                        // 36: google.protobuf.Empty
 }
 var file_api_iam_v1_platform_iam_proto_depIdxs = []int32{
 	0,  // 0: api.iam.v1.PlatformIAM.CreateTenant:input_type -> api.iam.v1.CreateTenantRequest
 	1,  // 1: api.iam.v1.PlatformIAM.GetTenant:input_type -> api.iam.v1.GetTenantRequest
 	2,  // 2: api.iam.v1.PlatformIAM.ListTenants:input_type -> api.iam.v1.ListTenantsRequest
-	3,  // 3: api.iam.v1.PlatformIAM.CreatePermission:input_type -> api.iam.v1.CreatePermissionRequest
-	4,  // 4: api.iam.v1.PlatformIAM.ListPermissions:input_type -> api.iam.v1.ListPermissionsRequest
-	5,  // 5: api.iam.v1.PlatformIAM.CreatePlatformAdmin:input_type -> api.iam.v1.CreatePlatformAdminRequest
-	6,  // 6: api.iam.v1.PlatformIAM.ListPlatformAdmins:input_type -> api.iam.v1.ListPlatformAdminsRequest
-	7,  // 7: api.iam.v1.PlatformIAM.GetPlatformAdmin:input_type -> api.iam.v1.GetPlatformAdminRequest
-	8,  // 8: api.iam.v1.PlatformIAM.CreatePlatformRole:input_type -> api.iam.v1.CreatePlatformRoleRequest
-	9,  // 9: api.iam.v1.PlatformIAM.ListPlatformRoles:input_type -> api.iam.v1.ListPlatformRolesRequest
-	10, // 10: api.iam.v1.PlatformIAM.GetPlatformRole:input_type -> api.iam.v1.GetPlatformRoleRequest
-	11, // 11: api.iam.v1.PlatformIAM.AssignPlatformAdminRole:input_type -> api.iam.v1.AssignPlatformAdminRoleRequest
-	12, // 12: api.iam.v1.PlatformIAM.ListPlatformAdminRoles:input_type -> api.iam.v1.ListPlatformAdminRolesRequest
-	13, // 13: api.iam.v1.PlatformIAM.RemovePlatformAdminRole:input_type -> api.iam.v1.RemovePlatformAdminRoleRequest
-	14, // 14: api.iam.v1.PlatformIAM.AssignPlatformRolePermissions:input_type -> api.iam.v1.AssignPlatformRolePermissionsRequest
-	15, // 15: api.iam.v1.PlatformIAM.ListPlatformRolePermissions:input_type -> api.iam.v1.ListPlatformRolePermissionsRequest
-	16, // 16: api.iam.v1.PlatformIAM.CreateTenant:output_type -> api.iam.v1.TenantResponse
-	16, // 17: api.iam.v1.PlatformIAM.GetTenant:output_type -> api.iam.v1.TenantResponse
-	17, // 18: api.iam.v1.PlatformIAM.ListTenants:output_type -> api.iam.v1.ListTenantsResponse
-	18, // 19: api.iam.v1.PlatformIAM.CreatePermission:output_type -> api.iam.v1.PermissionResponse
-	19, // 20: api.iam.v1.PlatformIAM.ListPermissions:output_type -> api.iam.v1.ListPermissionsResponse
-	20, // 21: api.iam.v1.PlatformIAM.CreatePlatformAdmin:output_type -> api.iam.v1.PlatformAdminResponse
-	21, // 22: api.iam.v1.PlatformIAM.ListPlatformAdmins:output_type -> api.iam.v1.ListPlatformAdminsResponse
-	20, // 23: api.iam.v1.PlatformIAM.GetPlatformAdmin:output_type -> api.iam.v1.PlatformAdminResponse
-	22, // 24: api.iam.v1.PlatformIAM.CreatePlatformRole:output_type -> api.iam.v1.PlatformRoleResponse
-	23, // 25: api.iam.v1.PlatformIAM.ListPlatformRoles:output_type -> api.iam.v1.ListPlatformRolesResponse
-	22, // 26: api.iam.v1.PlatformIAM.GetPlatformRole:output_type -> api.iam.v1.PlatformRoleResponse
-	24, // 27: api.iam.v1.PlatformIAM.AssignPlatformAdminRole:output_type -> google.protobuf.Empty
-	23, // 28: api.iam.v1.PlatformIAM.ListPlatformAdminRoles:output_type -> api.iam.v1.ListPlatformRolesResponse
-	24, // 29: api.iam.v1.PlatformIAM.RemovePlatformAdminRole:output_type -> google.protobuf.Empty
-	24, // 30: api.iam.v1.PlatformIAM.AssignPlatformRolePermissions:output_type -> google.protobuf.Empty
-	19, // 31: api.iam.v1.PlatformIAM.ListPlatformRolePermissions:output_type -> api.iam.v1.ListPermissionsResponse
-	16, // [16:32] is the sub-list for method output_type
-	0,  // [0:16] is the sub-list for method input_type
+	3,  // 3: api.iam.v1.PlatformIAM.UpdateTenant:input_type -> api.iam.v1.UpdateTenantRequest
+	4,  // 4: api.iam.v1.PlatformIAM.SuspendTenant:input_type -> api.iam.v1.SuspendTenantRequest
+	5,  // 5: api.iam.v1.PlatformIAM.ResumeTenant:input_type -> api.iam.v1.ResumeTenantRequest
+	6,  // 6: api.iam.v1.PlatformIAM.DeleteTenant:input_type -> api.iam.v1.DeleteTenantRequest
+	7,  // 7: api.iam.v1.PlatformIAM.GetTenantPurgeJob:input_type -> api.iam.v1.GetTenantPurgeJobRequest
+	8,  // 8: api.iam.v1.PlatformIAM.ListTenantPurgeJobs:input_type -> api.iam.v1.ListTenantPurgeJobsRequest
+	9,  // 9: api.iam.v1.PlatformIAM.ListPlans:input_type -> api.iam.v1.ListPlansRequest
+	10, // 10: api.iam.v1.PlatformIAM.UpdatePlan:input_type -> api.iam.v1.UpdatePlanRequest
+	11, // 11: api.iam.v1.PlatformIAM.CreatePermission:input_type -> api.iam.v1.CreatePermissionRequest
+	12, // 12: api.iam.v1.PlatformIAM.ListPermissions:input_type -> api.iam.v1.ListPermissionsRequest
+	13, // 13: api.iam.v1.PlatformIAM.CreatePlatformAdmin:input_type -> api.iam.v1.CreatePlatformAdminRequest
+	14, // 14: api.iam.v1.PlatformIAM.ListPlatformAdmins:input_type -> api.iam.v1.ListPlatformAdminsRequest
+	15, // 15: api.iam.v1.PlatformIAM.GetPlatformAdmin:input_type -> api.iam.v1.GetPlatformAdminRequest
+	16, // 16: api.iam.v1.PlatformIAM.CreatePlatformRole:input_type -> api.iam.v1.CreatePlatformRoleRequest
+	17, // 17: api.iam.v1.PlatformIAM.ListPlatformRoles:input_type -> api.iam.v1.ListPlatformRolesRequest
+	18, // 18: api.iam.v1.PlatformIAM.GetPlatformRole:input_type -> api.iam.v1.GetPlatformRoleRequest
+	19, // 19: api.iam.v1.PlatformIAM.AssignPlatformAdminRole:input_type -> api.iam.v1.AssignPlatformAdminRoleRequest
+	20, // 20: api.iam.v1.PlatformIAM.ListPlatformAdminRoles:input_type -> api.iam.v1.ListPlatformAdminRolesRequest
+	21, // 21: api.iam.v1.PlatformIAM.RemovePlatformAdminRole:input_type -> api.iam.v1.RemovePlatformAdminRoleRequest
+	22, // 22: api.iam.v1.PlatformIAM.AssignPlatformRolePermissions:input_type -> api.iam.v1.AssignPlatformRolePermissionsRequest
+	23, // 23: api.iam.v1.PlatformIAM.ListPlatformRolePermissions:input_type -> api.iam.v1.ListPlatformRolePermissionsRequest
+	24, // 24: api.iam.v1.PlatformIAM.CreateTenant:output_type -> api.iam.v1.TenantResponse
+	24, // 25: api.iam.v1.PlatformIAM.GetTenant:output_type -> api.iam.v1.TenantResponse
+	25, // 26: api.iam.v1.PlatformIAM.ListTenants:output_type -> api.iam.v1.ListTenantsResponse
+	24, // 27: api.iam.v1.PlatformIAM.UpdateTenant:output_type -> api.iam.v1.TenantResponse
+	24, // 28: api.iam.v1.PlatformIAM.SuspendTenant:output_type -> api.iam.v1.TenantResponse
+	24, // 29: api.iam.v1.PlatformIAM.ResumeTenant:output_type -> api.iam.v1.TenantResponse
+	26, // 30: api.iam.v1.PlatformIAM.DeleteTenant:output_type -> api.iam.v1.TenantPurgeJobResponse
+	26, // 31: api.iam.v1.PlatformIAM.GetTenantPurgeJob:output_type -> api.iam.v1.TenantPurgeJobResponse
+	27, // 32: api.iam.v1.PlatformIAM.ListTenantPurgeJobs:output_type -> api.iam.v1.ListTenantPurgeJobsResponse
+	28, // 33: api.iam.v1.PlatformIAM.ListPlans:output_type -> api.iam.v1.ListPlansResponse
+	29, // 34: api.iam.v1.PlatformIAM.UpdatePlan:output_type -> api.iam.v1.PlanResponse
+	30, // 35: api.iam.v1.PlatformIAM.CreatePermission:output_type -> api.iam.v1.PermissionResponse
+	31, // 36: api.iam.v1.PlatformIAM.ListPermissions:output_type -> api.iam.v1.ListPermissionsResponse
+	32, // 37: api.iam.v1.PlatformIAM.CreatePlatformAdmin:output_type -> api.iam.v1.PlatformAdminResponse
+	33, // 38: api.iam.v1.PlatformIAM.ListPlatformAdmins:output_type -> api.iam.v1.ListPlatformAdminsResponse
+	32, // 39: api.iam.v1.PlatformIAM.GetPlatformAdmin:output_type -> api.iam.v1.PlatformAdminResponse
+	34, // 40: api.iam.v1.PlatformIAM.CreatePlatformRole:output_type -> api.iam.v1.PlatformRoleResponse
+	35, // 41: api.iam.v1.PlatformIAM.ListPlatformRoles:output_type -> api.iam.v1.ListPlatformRolesResponse
+	34, // 42: api.iam.v1.PlatformIAM.GetPlatformRole:output_type -> api.iam.v1.PlatformRoleResponse
+	36, // 43: api.iam.v1.PlatformIAM.AssignPlatformAdminRole:output_type -> google.protobuf.Empty
+	35, // 44: api.iam.v1.PlatformIAM.ListPlatformAdminRoles:output_type -> api.iam.v1.ListPlatformRolesResponse
+	36, // 45: api.iam.v1.PlatformIAM.RemovePlatformAdminRole:output_type -> google.protobuf.Empty
+	36, // 46: api.iam.v1.PlatformIAM.AssignPlatformRolePermissions:output_type -> google.protobuf.Empty
+	31, // 47: api.iam.v1.PlatformIAM.ListPlatformRolePermissions:output_type -> api.iam.v1.ListPermissionsResponse
+	24, // [24:48] is the sub-list for method output_type
+	0,  // [0:24] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -23,6 +23,14 @@ const (
 	PlatformIAM_CreateTenant_FullMethodName                  = "/api.iam.v1.PlatformIAM/CreateTenant"
 	PlatformIAM_GetTenant_FullMethodName                     = "/api.iam.v1.PlatformIAM/GetTenant"
 	PlatformIAM_ListTenants_FullMethodName                   = "/api.iam.v1.PlatformIAM/ListTenants"
+	PlatformIAM_UpdateTenant_FullMethodName                  = "/api.iam.v1.PlatformIAM/UpdateTenant"
+	PlatformIAM_SuspendTenant_FullMethodName                 = "/api.iam.v1.PlatformIAM/SuspendTenant"
+	PlatformIAM_ResumeTenant_FullMethodName                  = "/api.iam.v1.PlatformIAM/ResumeTenant"
+	PlatformIAM_DeleteTenant_FullMethodName                  = "/api.iam.v1.PlatformIAM/DeleteTenant"
+	PlatformIAM_GetTenantPurgeJob_FullMethodName             = "/api.iam.v1.PlatformIAM/GetTenantPurgeJob"
+	PlatformIAM_ListTenantPurgeJobs_FullMethodName           = "/api.iam.v1.PlatformIAM/ListTenantPurgeJobs"
+	PlatformIAM_ListPlans_FullMethodName                     = "/api.iam.v1.PlatformIAM/ListPlans"
+	PlatformIAM_UpdatePlan_FullMethodName                    = "/api.iam.v1.PlatformIAM/UpdatePlan"
 	PlatformIAM_CreatePermission_FullMethodName              = "/api.iam.v1.PlatformIAM/CreatePermission"
 	PlatformIAM_ListPermissions_FullMethodName               = "/api.iam.v1.PlatformIAM/ListPermissions"
 	PlatformIAM_CreatePlatformAdmin_FullMethodName           = "/api.iam.v1.PlatformIAM/CreatePlatformAdmin"
@@ -45,6 +53,14 @@ type PlatformIAMClient interface {
 	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error)
 	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error)
 	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error)
+	UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error)
+	SuspendTenant(ctx context.Context, in *SuspendTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error)
+	ResumeTenant(ctx context.Context, in *ResumeTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error)
+	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*TenantPurgeJobResponse, error)
+	GetTenantPurgeJob(ctx context.Context, in *GetTenantPurgeJobRequest, opts ...grpc.CallOption) (*TenantPurgeJobResponse, error)
+	ListTenantPurgeJobs(ctx context.Context, in *ListTenantPurgeJobsRequest, opts ...grpc.CallOption) (*ListTenantPurgeJobsResponse, error)
+	ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error)
+	UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...grpc.CallOption) (*PlanResponse, error)
 	CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*PermissionResponse, error)
 	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
 	CreatePlatformAdmin(ctx context.Context, in *CreatePlatformAdminRequest, opts ...grpc.CallOption) (*PlatformAdminResponse, error)
@@ -98,6 +114,86 @@ func (c *platformIAMClient) ListTenants(ctx context.Context, in *ListTenantsRequ
 	return out, nil
 }
 
+func (c *platformIAMClient) UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantResponse)
+	err := c.cc.Invoke(ctx, PlatformIAM_UpdateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIAMClient) SuspendTenant(ctx context.Context, in *SuspendTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantResponse)
+	err := c.cc.Invoke(ctx, PlatformIAM_SuspendTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIAMClient) ResumeTenant(ctx context.Context, in *ResumeTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantResponse)
+	err := c.cc.Invoke(ctx, PlatformIAM_ResumeTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIAMClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*TenantPurgeJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantPurgeJobResponse)
+	err := c.cc.Invoke(ctx, PlatformIAM_DeleteTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIAMClient) GetTenantPurgeJob(ctx context.Context, in *GetTenantPurgeJobRequest, opts ...grpc.CallOption) (*TenantPurgeJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantPurgeJobResponse)
+	err := c.cc.Invoke(ctx, PlatformIAM_GetTenantPurgeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIAMClient) ListTenantPurgeJobs(ctx context.Context, in *ListTenantPurgeJobsRequest, opts ...grpc.CallOption) (*ListTenantPurgeJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantPurgeJobsResponse)
+	err := c.cc.Invoke(ctx, PlatformIAM_ListTenantPurgeJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIAMClient) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlansResponse)
+	err := c.cc.Invoke(ctx, PlatformIAM_ListPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIAMClient) UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...grpc.CallOption) (*PlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanResponse)
+	err := c.cc.Invoke(ctx, PlatformIAM_UpdatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *platformIAMClient) CreatePermission(ctx context.Context, in *CreatePermissionRequest, opts ...grpc.CallOption) (*PermissionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PermissionResponse)
@@ -235,6 +331,14 @@ type PlatformIAMServer interface {
 	CreateTenant(context.Context, *CreateTenantRequest) (*TenantResponse, error)
 	GetTenant(context.Context, *GetTenantRequest) (*TenantResponse, error)
 	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
+	UpdateTenant(context.Context, *UpdateTenantRequest) (*TenantResponse, error)
+	SuspendTenant(context.Context, *SuspendTenantRequest) (*TenantResponse, error)
+	ResumeTenant(context.Context, *ResumeTenantRequest) (*TenantResponse, error)
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*TenantPurgeJobResponse, error)
+	GetTenantPurgeJob(context.Context, *GetTenantPurgeJobRequest) (*TenantPurgeJobResponse, error)
+	ListTenantPurgeJobs(context.Context, *ListTenantPurgeJobsRequest) (*ListTenantPurgeJobsResponse, error)
+	ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error)
+	UpdatePlan(context.Context, *UpdatePlanRequest) (*PlanResponse, error)
 	CreatePermission(context.Context, *CreatePermissionRequest) (*PermissionResponse, error)
 	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
 	CreatePlatformAdmin(context.Context, *CreatePlatformAdminRequest) (*PlatformAdminResponse, error)
@@ -267,6 +371,30 @@ func (UnimplementedPlatformIAMServer) GetTenant(context.Context, *GetTenantReque
 func (UnimplementedPlatformIAMServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTenants not implemented")
 }
+func (UnimplementedPlatformIAMServer) UpdateTenant(context.Context, *UpdateTenantRequest) (*TenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (UnimplementedPlatformIAMServer) SuspendTenant(context.Context, *SuspendTenantRequest) (*TenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendTenant not implemented")
+}
+func (UnimplementedPlatformIAMServer) ResumeTenant(context.Context, *ResumeTenantRequest) (*TenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeTenant not implemented")
+}
+func (UnimplementedPlatformIAMServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*TenantPurgeJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTenant not implemented")
+}
+func (UnimplementedPlatformIAMServer) GetTenantPurgeJob(context.Context, *GetTenantPurgeJobRequest) (*TenantPurgeJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTenantPurgeJob not implemented")
+}
+func (UnimplementedPlatformIAMServer) ListTenantPurgeJobs(context.Context, *ListTenantPurgeJobsRequest) (*ListTenantPurgeJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTenantPurgeJobs not implemented")
+}
+func (UnimplementedPlatformIAMServer) ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPlans not implemented")
+}
+func (UnimplementedPlatformIAMServer) UpdatePlan(context.Context, *UpdatePlanRequest) (*PlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePlan not implemented")
+}
 func (UnimplementedPlatformIAMServer) CreatePermission(context.Context, *CreatePermissionRequest) (*PermissionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreatePermission not implemented")
 }
@@ -381,6 +509,150 @@ func _PlatformIAM_ListTenants_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlatformIAM_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIAMServer).UpdateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIAM_UpdateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIAMServer).UpdateTenant(ctx, req.(*UpdateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIAM_SuspendTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIAMServer).SuspendTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIAM_SuspendTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIAMServer).SuspendTenant(ctx, req.(*SuspendTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIAM_ResumeTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIAMServer).ResumeTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIAM_ResumeTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIAMServer).ResumeTenant(ctx, req.(*ResumeTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIAM_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIAMServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIAM_DeleteTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIAMServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIAM_GetTenantPurgeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantPurgeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIAMServer).GetTenantPurgeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIAM_GetTenantPurgeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIAMServer).GetTenantPurgeJob(ctx, req.(*GetTenantPurgeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIAM_ListTenantPurgeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantPurgeJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIAMServer).ListTenantPurgeJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIAM_ListTenantPurgeJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIAMServer).ListTenantPurgeJobs(ctx, req.(*ListTenantPurgeJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIAM_ListPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIAMServer).ListPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIAM_ListPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIAMServer).ListPlans(ctx, req.(*ListPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIAM_UpdatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIAMServer).UpdatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIAM_UpdatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIAMServer).UpdatePlan(ctx, req.(*UpdatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlatformIAM_CreatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePermissionRequest)
 	if err := dec(in); err != nil {
@@ -634,6 +906,38 @@ var PlatformIAM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTenants",
 			Handler:    _PlatformIAM_ListTenants_Handler,
 		},
+		{
+			MethodName: "UpdateTenant",
+			Handler:    _PlatformIAM_UpdateTenant_Handler,
+		},
+		{
+			MethodName: "SuspendTenant",
+			Handler:    _PlatformIAM_SuspendTenant_Handler,
+		},
+		{
+			MethodName: "ResumeTenant",
+			Handler:    _PlatformIAM_ResumeTenant_Handler,
+		},
+		{
+			MethodName: "DeleteTenant",
+			Handler:    _PlatformIAM_DeleteTenant_Handler,
+		},
+		{
+			MethodName: "GetTenantPurgeJob",
+			Handler:    _PlatformIAM_GetTenantPurgeJob_Handler,
+		},
+		{
+			MethodName: "ListTenantPurgeJobs",
+			Handler:    _PlatformIAM_ListTenantPurgeJobs_Handler,
+		},
+		{
+			MethodName: "ListPlans",
+			Handler:    _PlatformIAM_ListPlans_Handler,
+		},
+		{
+			MethodName: "UpdatePlan",
+			Handler:    _PlatformIAM_UpdatePlan_Handler,
+		},
 		{
 			MethodName: "CreatePermission",
 			Handler:    _PlatformIAM_CreatePermission_Handler,
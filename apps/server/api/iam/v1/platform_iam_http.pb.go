@@ -26,16 +26,24 @@ const OperationPlatformIAMCreatePermission = "/api.iam.v1.PlatformIAM/CreatePerm
 const OperationPlatformIAMCreatePlatformAdmin = "/api.iam.v1.PlatformIAM/CreatePlatformAdmin"
 const OperationPlatformIAMCreatePlatformRole = "/api.iam.v1.PlatformIAM/CreatePlatformRole"
 const OperationPlatformIAMCreateTenant = "/api.iam.v1.PlatformIAM/CreateTenant"
+const OperationPlatformIAMDeleteTenant = "/api.iam.v1.PlatformIAM/DeleteTenant"
 const OperationPlatformIAMGetPlatformAdmin = "/api.iam.v1.PlatformIAM/GetPlatformAdmin"
 const OperationPlatformIAMGetPlatformRole = "/api.iam.v1.PlatformIAM/GetPlatformRole"
 const OperationPlatformIAMGetTenant = "/api.iam.v1.PlatformIAM/GetTenant"
+const OperationPlatformIAMGetTenantPurgeJob = "/api.iam.v1.PlatformIAM/GetTenantPurgeJob"
 const OperationPlatformIAMListPermissions = "/api.iam.v1.PlatformIAM/ListPermissions"
+const OperationPlatformIAMListPlans = "/api.iam.v1.PlatformIAM/ListPlans"
 const OperationPlatformIAMListPlatformAdminRoles = "/api.iam.v1.PlatformIAM/ListPlatformAdminRoles"
 const OperationPlatformIAMListPlatformAdmins = "/api.iam.v1.PlatformIAM/ListPlatformAdmins"
 const OperationPlatformIAMListPlatformRolePermissions = "/api.iam.v1.PlatformIAM/ListPlatformRolePermissions"
 const OperationPlatformIAMListPlatformRoles = "/api.iam.v1.PlatformIAM/ListPlatformRoles"
+const OperationPlatformIAMListTenantPurgeJobs = "/api.iam.v1.PlatformIAM/ListTenantPurgeJobs"
 const OperationPlatformIAMListTenants = "/api.iam.v1.PlatformIAM/ListTenants"
 const OperationPlatformIAMRemovePlatformAdminRole = "/api.iam.v1.PlatformIAM/RemovePlatformAdminRole"
+const OperationPlatformIAMResumeTenant = "/api.iam.v1.PlatformIAM/ResumeTenant"
+const OperationPlatformIAMSuspendTenant = "/api.iam.v1.PlatformIAM/SuspendTenant"
+const OperationPlatformIAMUpdatePlan = "/api.iam.v1.PlatformIAM/UpdatePlan"
+const OperationPlatformIAMUpdateTenant = "/api.iam.v1.PlatformIAM/UpdateTenant"
 
 type PlatformIAMHTTPServer interface {
 	AssignPlatformAdminRole(context.Context, *AssignPlatformAdminRoleRequest) (*emptypb.Empty, error)
@@ -44,16 +52,24 @@ type PlatformIAMHTTPServer interface {
 	CreatePlatformAdmin(context.Context, *CreatePlatformAdminRequest) (*PlatformAdminResponse, error)
 	CreatePlatformRole(context.Context, *CreatePlatformRoleRequest) (*PlatformRoleResponse, error)
 	CreateTenant(context.Context, *CreateTenantRequest) (*TenantResponse, error)
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*TenantPurgeJobResponse, error)
 	GetPlatformAdmin(context.Context, *GetPlatformAdminRequest) (*PlatformAdminResponse, error)
 	GetPlatformRole(context.Context, *GetPlatformRoleRequest) (*PlatformRoleResponse, error)
 	GetTenant(context.Context, *GetTenantRequest) (*TenantResponse, error)
+	GetTenantPurgeJob(context.Context, *GetTenantPurgeJobRequest) (*TenantPurgeJobResponse, error)
 	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error)
 	ListPlatformAdminRoles(context.Context, *ListPlatformAdminRolesRequest) (*ListPlatformRolesResponse, error)
 	ListPlatformAdmins(context.Context, *ListPlatformAdminsRequest) (*ListPlatformAdminsResponse, error)
 	ListPlatformRolePermissions(context.Context, *ListPlatformRolePermissionsRequest) (*ListPermissionsResponse, error)
 	ListPlatformRoles(context.Context, *ListPlatformRolesRequest) (*ListPlatformRolesResponse, error)
+	ListTenantPurgeJobs(context.Context, *ListTenantPurgeJobsRequest) (*ListTenantPurgeJobsResponse, error)
 	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
 	RemovePlatformAdminRole(context.Context, *RemovePlatformAdminRoleRequest) (*emptypb.Empty, error)
+	ResumeTenant(context.Context, *ResumeTenantRequest) (*TenantResponse, error)
+	SuspendTenant(context.Context, *SuspendTenantRequest) (*TenantResponse, error)
+	UpdatePlan(context.Context, *UpdatePlanRequest) (*PlanResponse, error)
+	UpdateTenant(context.Context, *UpdateTenantRequest) (*TenantResponse, error)
 }
 
 func RegisterPlatformIAMHTTPServer(s *http.Server, srv PlatformIAMHTTPServer) {
@@ -61,6 +77,14 @@ func RegisterPlatformIAMHTTPServer(s *http.Server, srv PlatformIAMHTTPServer) {
 	r.POST("/platform/v1/tenants", _PlatformIAM_CreateTenant0_HTTP_Handler(srv))
 	r.GET("/platform/v1/tenants/{id}", _PlatformIAM_GetTenant0_HTTP_Handler(srv))
 	r.GET("/platform/v1/tenants", _PlatformIAM_ListTenants0_HTTP_Handler(srv))
+	r.PATCH("/platform/v1/tenants/{id}", _PlatformIAM_UpdateTenant0_HTTP_Handler(srv))
+	r.POST("/platform/v1/tenants/{id}/suspend", _PlatformIAM_SuspendTenant0_HTTP_Handler(srv))
+	r.POST("/platform/v1/tenants/{id}/resume", _PlatformIAM_ResumeTenant0_HTTP_Handler(srv))
+	r.DELETE("/platform/v1/tenants/{id}", _PlatformIAM_DeleteTenant0_HTTP_Handler(srv))
+	r.GET("/platform/v1/tenant_purge_jobs/{id}", _PlatformIAM_GetTenantPurgeJob0_HTTP_Handler(srv))
+	r.GET("/platform/v1/tenant_purge_jobs", _PlatformIAM_ListTenantPurgeJobs0_HTTP_Handler(srv))
+	r.GET("/platform/v1/plans", _PlatformIAM_ListPlans0_HTTP_Handler(srv))
+	r.PUT("/platform/v1/plans/{code}", _PlatformIAM_UpdatePlan0_HTTP_Handler(srv))
 	r.POST("/platform/v1/permissions", _PlatformIAM_CreatePermission0_HTTP_Handler(srv))
 	r.GET("/platform/v1/permissions", _PlatformIAM_ListPermissions1_HTTP_Handler(srv))
 	r.POST("/platform/v1/admins", _PlatformIAM_CreatePlatformAdmin0_HTTP_Handler(srv))
@@ -139,6 +163,188 @@ func _PlatformIAM_ListTenants0_HTTP_Handler(srv PlatformIAMHTTPServer) func(ctx
 	}
 }
 
+func _PlatformIAM_UpdateTenant0_HTTP_Handler(srv PlatformIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformIAMUpdateTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTenant(ctx, req.(*UpdateTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TenantResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformIAM_SuspendTenant0_HTTP_Handler(srv PlatformIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuspendTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformIAMSuspendTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SuspendTenant(ctx, req.(*SuspendTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TenantResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformIAM_ResumeTenant0_HTTP_Handler(srv PlatformIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResumeTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformIAMResumeTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResumeTenant(ctx, req.(*ResumeTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TenantResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformIAM_DeleteTenant0_HTTP_Handler(srv PlatformIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTenantRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformIAMDeleteTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTenant(ctx, req.(*DeleteTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TenantPurgeJobResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformIAM_GetTenantPurgeJob0_HTTP_Handler(srv PlatformIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantPurgeJobRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformIAMGetTenantPurgeJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTenantPurgeJob(ctx, req.(*GetTenantPurgeJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TenantPurgeJobResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformIAM_ListTenantPurgeJobs0_HTTP_Handler(srv PlatformIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTenantPurgeJobsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformIAMListTenantPurgeJobs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantPurgeJobs(ctx, req.(*ListTenantPurgeJobsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantPurgeJobsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformIAM_ListPlans0_HTTP_Handler(srv PlatformIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPlansRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformIAMListPlans)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPlans(ctx, req.(*ListPlansRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPlansResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlatformIAM_UpdatePlan0_HTTP_Handler(srv PlatformIAMHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePlanRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlatformIAMUpdatePlan)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePlan(ctx, req.(*UpdatePlanRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PlanResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _PlatformIAM_CreatePermission0_HTTP_Handler(srv PlatformIAMHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreatePermissionRequest
@@ -429,16 +635,24 @@ type PlatformIAMHTTPClient interface {
 	CreatePlatformAdmin(ctx context.Context, req *CreatePlatformAdminRequest, opts ...http.CallOption) (rsp *PlatformAdminResponse, err error)
 	CreatePlatformRole(ctx context.Context, req *CreatePlatformRoleRequest, opts ...http.CallOption) (rsp *PlatformRoleResponse, err error)
 	CreateTenant(ctx context.Context, req *CreateTenantRequest, opts ...http.CallOption) (rsp *TenantResponse, err error)
+	DeleteTenant(ctx context.Context, req *DeleteTenantRequest, opts ...http.CallOption) (rsp *TenantPurgeJobResponse, err error)
 	GetPlatformAdmin(ctx context.Context, req *GetPlatformAdminRequest, opts ...http.CallOption) (rsp *PlatformAdminResponse, err error)
 	GetPlatformRole(ctx context.Context, req *GetPlatformRoleRequest, opts ...http.CallOption) (rsp *PlatformRoleResponse, err error)
 	GetTenant(ctx context.Context, req *GetTenantRequest, opts ...http.CallOption) (rsp *TenantResponse, err error)
+	GetTenantPurgeJob(ctx context.Context, req *GetTenantPurgeJobRequest, opts ...http.CallOption) (rsp *TenantPurgeJobResponse, err error)
 	ListPermissions(ctx context.Context, req *ListPermissionsRequest, opts ...http.CallOption) (rsp *ListPermissionsResponse, err error)
+	ListPlans(ctx context.Context, req *ListPlansRequest, opts ...http.CallOption) (rsp *ListPlansResponse, err error)
 	ListPlatformAdminRoles(ctx context.Context, req *ListPlatformAdminRolesRequest, opts ...http.CallOption) (rsp *ListPlatformRolesResponse, err error)
 	ListPlatformAdmins(ctx context.Context, req *ListPlatformAdminsRequest, opts ...http.CallOption) (rsp *ListPlatformAdminsResponse, err error)
 	ListPlatformRolePermissions(ctx context.Context, req *ListPlatformRolePermissionsRequest, opts ...http.CallOption) (rsp *ListPermissionsResponse, err error)
 	ListPlatformRoles(ctx context.Context, req *ListPlatformRolesRequest, opts ...http.CallOption) (rsp *ListPlatformRolesResponse, err error)
+	ListTenantPurgeJobs(ctx context.Context, req *ListTenantPurgeJobsRequest, opts ...http.CallOption) (rsp *ListTenantPurgeJobsResponse, err error)
 	ListTenants(ctx context.Context, req *ListTenantsRequest, opts ...http.CallOption) (rsp *ListTenantsResponse, err error)
 	RemovePlatformAdminRole(ctx context.Context, req *RemovePlatformAdminRoleRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	ResumeTenant(ctx context.Context, req *ResumeTenantRequest, opts ...http.CallOption) (rsp *TenantResponse, err error)
+	SuspendTenant(ctx context.Context, req *SuspendTenantRequest, opts ...http.CallOption) (rsp *TenantResponse, err error)
+	UpdatePlan(ctx context.Context, req *UpdatePlanRequest, opts ...http.CallOption) (rsp *PlanResponse, err error)
+	UpdateTenant(ctx context.Context, req *UpdateTenantRequest, opts ...http.CallOption) (rsp *TenantResponse, err error)
 }
 
 type PlatformIAMHTTPClientImpl struct {
@@ -527,6 +741,19 @@ func (c *PlatformIAMHTTPClientImpl) CreateTenant(ctx context.Context, in *Create
 	return &out, nil
 }
 
+func (c *PlatformIAMHTTPClientImpl) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...http.CallOption) (*TenantPurgeJobResponse, error) {
+	var out TenantPurgeJobResponse
+	pattern := "/platform/v1/tenants/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPlatformIAMDeleteTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *PlatformIAMHTTPClientImpl) GetPlatformAdmin(ctx context.Context, in *GetPlatformAdminRequest, opts ...http.CallOption) (*PlatformAdminResponse, error) {
 	var out PlatformAdminResponse
 	pattern := "/platform/v1/admins/{id}"
@@ -566,6 +793,19 @@ func (c *PlatformIAMHTTPClientImpl) GetTenant(ctx context.Context, in *GetTenant
 	return &out, nil
 }
 
+func (c *PlatformIAMHTTPClientImpl) GetTenantPurgeJob(ctx context.Context, in *GetTenantPurgeJobRequest, opts ...http.CallOption) (*TenantPurgeJobResponse, error) {
+	var out TenantPurgeJobResponse
+	pattern := "/platform/v1/tenant_purge_jobs/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPlatformIAMGetTenantPurgeJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *PlatformIAMHTTPClientImpl) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...http.CallOption) (*ListPermissionsResponse, error) {
 	var out ListPermissionsResponse
 	pattern := "/platform/v1/permissions"
@@ -579,6 +819,19 @@ func (c *PlatformIAMHTTPClientImpl) ListPermissions(ctx context.Context, in *Lis
 	return &out, nil
 }
 
+func (c *PlatformIAMHTTPClientImpl) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...http.CallOption) (*ListPlansResponse, error) {
+	var out ListPlansResponse
+	pattern := "/platform/v1/plans"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPlatformIAMListPlans))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *PlatformIAMHTTPClientImpl) ListPlatformAdminRoles(ctx context.Context, in *ListPlatformAdminRolesRequest, opts ...http.CallOption) (*ListPlatformRolesResponse, error) {
 	var out ListPlatformRolesResponse
 	pattern := "/platform/v1/admins/{admin_id}/roles"
@@ -631,6 +884,19 @@ func (c *PlatformIAMHTTPClientImpl) ListPlatformRoles(ctx context.Context, in *L
 	return &out, nil
 }
 
+func (c *PlatformIAMHTTPClientImpl) ListTenantPurgeJobs(ctx context.Context, in *ListTenantPurgeJobsRequest, opts ...http.CallOption) (*ListTenantPurgeJobsResponse, error) {
+	var out ListTenantPurgeJobsResponse
+	pattern := "/platform/v1/tenant_purge_jobs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPlatformIAMListTenantPurgeJobs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *PlatformIAMHTTPClientImpl) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...http.CallOption) (*ListTenantsResponse, error) {
 	var out ListTenantsResponse
 	pattern := "/platform/v1/tenants"
@@ -656,3 +922,55 @@ func (c *PlatformIAMHTTPClientImpl) RemovePlatformAdminRole(ctx context.Context,
 	}
 	return &out, nil
 }
+
+func (c *PlatformIAMHTTPClientImpl) ResumeTenant(ctx context.Context, in *ResumeTenantRequest, opts ...http.CallOption) (*TenantResponse, error) {
+	var out TenantResponse
+	pattern := "/platform/v1/tenants/{id}/resume"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformIAMResumeTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformIAMHTTPClientImpl) SuspendTenant(ctx context.Context, in *SuspendTenantRequest, opts ...http.CallOption) (*TenantResponse, error) {
+	var out TenantResponse
+	pattern := "/platform/v1/tenants/{id}/suspend"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformIAMSuspendTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformIAMHTTPClientImpl) UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...http.CallOption) (*PlanResponse, error) {
+	var out PlanResponse
+	pattern := "/platform/v1/plans/{code}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformIAMUpdatePlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PlatformIAMHTTPClientImpl) UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...http.CallOption) (*TenantResponse, error) {
+	var out TenantResponse
+	pattern := "/platform/v1/tenants/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlatformIAMUpdateTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PATCH", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
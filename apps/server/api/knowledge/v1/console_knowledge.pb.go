@@ -12,6 +12,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -25,15 +26,20 @@ const (
 )
 
 type KnowledgeBase struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId    string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Chunking    *ChunkingConfig        `protobuf:"bytes,7,opt,name=chunking,proto3" json:"chunking,omitempty"`
+	Embedding   *EmbeddingConfig       `protobuf:"bytes,8,opt,name=embedding,proto3" json:"embedding,omitempty"`
+	// Bumped by every completed migration; each generation has its own vector collection.
+	IndexGeneration int32        `protobuf:"varint,9,opt,name=index_generation,json=indexGeneration,proto3" json:"index_generation,omitempty"`
+	Dedup           *DedupConfig `protobuf:"bytes,10,opt,name=dedup,proto3" json:"dedup,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *KnowledgeBase) Reset() {
@@ -108,6 +114,254 @@ func (x *KnowledgeBase) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *KnowledgeBase) GetChunking() *ChunkingConfig {
+	if x != nil {
+		return x.Chunking
+	}
+	return nil
+}
+
+func (x *KnowledgeBase) GetEmbedding() *EmbeddingConfig {
+	if x != nil {
+		return x.Embedding
+	}
+	return nil
+}
+
+func (x *KnowledgeBase) GetIndexGeneration() int32 {
+	if x != nil {
+		return x.IndexGeneration
+	}
+	return 0
+}
+
+func (x *KnowledgeBase) GetDedup() *DedupConfig {
+	if x != nil {
+		return x.Dedup
+	}
+	return nil
+}
+
+// ChunkingConfig selects how documents of a knowledge base are chunked.
+// Zero values fall back to the server defaults (data.knowledge.chunking).
+type ChunkingConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token | markdown | sentence_window | semantic
+	Strategy      string `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	MaxTokens     int32  `protobuf:"varint,2,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	OverlapTokens int32  `protobuf:"varint,3,opt,name=overlap_tokens,json=overlapTokens,proto3" json:"overlap_tokens,omitempty"`
+	// sentence_window: sentences per chunk and sentences shared by neighbours.
+	WindowSentences  int32 `protobuf:"varint,4,opt,name=window_sentences,json=windowSentences,proto3" json:"window_sentences,omitempty"`
+	OverlapSentences int32 `protobuf:"varint,5,opt,name=overlap_sentences,json=overlapSentences,proto3" json:"overlap_sentences,omitempty"`
+	// semantic: split where adjacent-sentence cosine similarity drops below this.
+	SimilarityThreshold float64 `protobuf:"fixed64,6,opt,name=similarity_threshold,json=similarityThreshold,proto3" json:"similarity_threshold,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ChunkingConfig) Reset() {
+	*x = ChunkingConfig{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkingConfig) ProtoMessage() {}
+
+func (x *ChunkingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkingConfig.ProtoReflect.Descriptor instead.
+func (*ChunkingConfig) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChunkingConfig) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *ChunkingConfig) GetMaxTokens() int32 {
+	if x != nil {
+		return x.MaxTokens
+	}
+	return 0
+}
+
+func (x *ChunkingConfig) GetOverlapTokens() int32 {
+	if x != nil {
+		return x.OverlapTokens
+	}
+	return 0
+}
+
+func (x *ChunkingConfig) GetWindowSentences() int32 {
+	if x != nil {
+		return x.WindowSentences
+	}
+	return 0
+}
+
+func (x *ChunkingConfig) GetOverlapSentences() int32 {
+	if x != nil {
+		return x.OverlapSentences
+	}
+	return 0
+}
+
+func (x *ChunkingConfig) GetSimilarityThreshold() float64 {
+	if x != nil {
+		return x.SimilarityThreshold
+	}
+	return 0
+}
+
+// EmbeddingConfig selects the embedding model of a knowledge base. Unset means
+// the server default (data.knowledge.embedding); endpoint and API key are shared.
+// Each (model, dim) pair is stored in its own vector collection, so changing it
+// requires reindexing the documents of the knowledge base.
+type EmbeddingConfig struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model    string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	// 0 lets the provider report its dimension.
+	Dim           int32 `protobuf:"varint,3,opt,name=dim,proto3" json:"dim,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbeddingConfig) Reset() {
+	*x = EmbeddingConfig{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbeddingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingConfig) ProtoMessage() {}
+
+func (x *EmbeddingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingConfig.ProtoReflect.Descriptor instead.
+func (*EmbeddingConfig) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EmbeddingConfig) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *EmbeddingConfig) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *EmbeddingConfig) GetDim() int32 {
+	if x != nil {
+		return x.Dim
+	}
+	return 0
+}
+
+// DedupConfig is the near-duplicate policy of a knowledge base. New chunks are
+// compared with the ready chunks of the other documents by content hash,
+// SimHash and embedding similarity. Zero values fall back to the defaults.
+type DedupConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// flag (default) records duplicates | skip does not index them | off
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Largest SimHash Hamming distance of a near-duplicate; default 3.
+	MaxDistance int32 `protobuf:"varint,2,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	// Embedding cosine similarity from which chunks are duplicates; default 0.95.
+	SimilarityThreshold float64 `protobuf:"fixed64,3,opt,name=similarity_threshold,json=similarityThreshold,proto3" json:"similarity_threshold,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DedupConfig) Reset() {
+	*x = DedupConfig{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DedupConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DedupConfig) ProtoMessage() {}
+
+func (x *DedupConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DedupConfig.ProtoReflect.Descriptor instead.
+func (*DedupConfig) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DedupConfig) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *DedupConfig) GetMaxDistance() int32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *DedupConfig) GetSimilarityThreshold() float64 {
+	if x != nil {
+		return x.SimilarityThreshold
+	}
+	return 0
+}
+
 type BotKnowledgeBase struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -122,7 +376,7 @@ type BotKnowledgeBase struct {
 
 func (x *BotKnowledgeBase) Reset() {
 	*x = BotKnowledgeBase{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[1]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -134,7 +388,7 @@ func (x *BotKnowledgeBase) String() string {
 func (*BotKnowledgeBase) ProtoMessage() {}
 
 func (x *BotKnowledgeBase) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[1]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -147,7 +401,7 @@ func (x *BotKnowledgeBase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BotKnowledgeBase.ProtoReflect.Descriptor instead.
 func (*BotKnowledgeBase) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{1}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BotKnowledgeBase) GetId() string {
@@ -203,13 +457,19 @@ type Document struct {
 	CurrentVersion int32                  `protobuf:"varint,7,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	Tags           []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Prepended to the section of every chunk.
+	SectionPrefix string `protobuf:"bytes,11,opt,name=section_prefix,json=sectionPrefix,proto3" json:"section_prefix,omitempty"`
+	// End users need one of these entitlements to see the document; empty
+	// means every end user.
+	AccessLabels  []string `protobuf:"bytes,12,rep,name=access_labels,json=accessLabels,proto3" json:"access_labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Document) Reset() {
 	*x = Document{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[2]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -221,7 +481,7 @@ func (x *Document) String() string {
 func (*Document) ProtoMessage() {}
 
 func (x *Document) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[2]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,7 +494,7 @@ func (x *Document) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Document.ProtoReflect.Descriptor instead.
 func (*Document) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{2}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Document) GetId() string {
@@ -300,21 +560,48 @@ func (x *Document) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Document) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Document) GetSectionPrefix() string {
+	if x != nil {
+		return x.SectionPrefix
+	}
+	return ""
+}
+
+func (x *Document) GetAccessLabels() []string {
+	if x != nil {
+		return x.AccessLabels
+	}
+	return nil
+}
+
 type DocumentVersion struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	DocumentId    string                 `protobuf:"bytes,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
-	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId   string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	DocumentId string                 `protobuf:"bytes,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Version    int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Status     string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Chunks whose vector was reused from an indexed chunk with the same content hash.
+	ReusedChunks int32 `protobuf:"varint,7,opt,name=reused_chunks,json=reusedChunks,proto3" json:"reused_chunks,omitempty"`
+	// Chunks sent to the embedding provider.
+	EmbeddedChunks int32 `protobuf:"varint,8,opt,name=embedded_chunks,json=embeddedChunks,proto3" json:"embedded_chunks,omitempty"`
+	// Chunks not indexed because they duplicate chunks of other documents.
+	SkippedChunks int32 `protobuf:"varint,9,opt,name=skipped_chunks,json=skippedChunks,proto3" json:"skipped_chunks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DocumentVersion) Reset() {
 	*x = DocumentVersion{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[3]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -326,7 +613,7 @@ func (x *DocumentVersion) String() string {
 func (*DocumentVersion) ProtoMessage() {}
 
 func (x *DocumentVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[3]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +626,7 @@ func (x *DocumentVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DocumentVersion.ProtoReflect.Descriptor instead.
 func (*DocumentVersion) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{3}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DocumentVersion) GetId() string {
@@ -384,17 +671,41 @@ func (x *DocumentVersion) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *DocumentVersion) GetReusedChunks() int32 {
+	if x != nil {
+		return x.ReusedChunks
+	}
+	return 0
+}
+
+func (x *DocumentVersion) GetEmbeddedChunks() int32 {
+	if x != nil {
+		return x.EmbeddedChunks
+	}
+	return 0
+}
+
+func (x *DocumentVersion) GetSkippedChunks() int32 {
+	if x != nil {
+		return x.SkippedChunks
+	}
+	return 0
+}
+
 type CreateKnowledgeBaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Chunking      *ChunkingConfig        `protobuf:"bytes,3,opt,name=chunking,proto3" json:"chunking,omitempty"`
+	Embedding     *EmbeddingConfig       `protobuf:"bytes,4,opt,name=embedding,proto3" json:"embedding,omitempty"`
+	Dedup         *DedupConfig           `protobuf:"bytes,5,opt,name=dedup,proto3" json:"dedup,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateKnowledgeBaseRequest) Reset() {
 	*x = CreateKnowledgeBaseRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[4]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +717,7 @@ func (x *CreateKnowledgeBaseRequest) String() string {
 func (*CreateKnowledgeBaseRequest) ProtoMessage() {}
 
 func (x *CreateKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[4]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +730,7 @@ func (x *CreateKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
 func (*CreateKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{4}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateKnowledgeBaseRequest) GetName() string {
@@ -436,6 +747,27 @@ func (x *CreateKnowledgeBaseRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateKnowledgeBaseRequest) GetChunking() *ChunkingConfig {
+	if x != nil {
+		return x.Chunking
+	}
+	return nil
+}
+
+func (x *CreateKnowledgeBaseRequest) GetEmbedding() *EmbeddingConfig {
+	if x != nil {
+		return x.Embedding
+	}
+	return nil
+}
+
+func (x *CreateKnowledgeBaseRequest) GetDedup() *DedupConfig {
+	if x != nil {
+		return x.Dedup
+	}
+	return nil
+}
+
 type GetKnowledgeBaseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -445,7 +777,7 @@ type GetKnowledgeBaseRequest struct {
 
 func (x *GetKnowledgeBaseRequest) Reset() {
 	*x = GetKnowledgeBaseRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[5]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +789,7 @@ func (x *GetKnowledgeBaseRequest) String() string {
 func (*GetKnowledgeBaseRequest) ProtoMessage() {}
 
 func (x *GetKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[5]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +802,7 @@ func (x *GetKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
 func (*GetKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{5}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetKnowledgeBaseRequest) GetId() string {
@@ -485,13 +817,16 @@ type UpdateKnowledgeBaseRequest struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Chunking      *ChunkingConfig        `protobuf:"bytes,4,opt,name=chunking,proto3" json:"chunking,omitempty"`
+	Embedding     *EmbeddingConfig       `protobuf:"bytes,5,opt,name=embedding,proto3" json:"embedding,omitempty"`
+	Dedup         *DedupConfig           `protobuf:"bytes,6,opt,name=dedup,proto3" json:"dedup,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateKnowledgeBaseRequest) Reset() {
 	*x = UpdateKnowledgeBaseRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[6]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -503,7 +838,7 @@ func (x *UpdateKnowledgeBaseRequest) String() string {
 func (*UpdateKnowledgeBaseRequest) ProtoMessage() {}
 
 func (x *UpdateKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[6]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +851,7 @@ func (x *UpdateKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{6}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateKnowledgeBaseRequest) GetId() string {
@@ -540,16 +875,37 @@ func (x *UpdateKnowledgeBaseRequest) GetDescription() string {
 	return ""
 }
 
-type DeleteKnowledgeBaseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateKnowledgeBaseRequest) GetChunking() *ChunkingConfig {
+	if x != nil {
+		return x.Chunking
+	}
+	return nil
+}
+
+func (x *UpdateKnowledgeBaseRequest) GetEmbedding() *EmbeddingConfig {
+	if x != nil {
+		return x.Embedding
+	}
+	return nil
+}
+
+func (x *UpdateKnowledgeBaseRequest) GetDedup() *DedupConfig {
+	if x != nil {
+		return x.Dedup
+	}
+	return nil
+}
+
+type DeleteKnowledgeBaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteKnowledgeBaseRequest) Reset() {
 	*x = DeleteKnowledgeBaseRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[7]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -561,7 +917,7 @@ func (x *DeleteKnowledgeBaseRequest) String() string {
 func (*DeleteKnowledgeBaseRequest) ProtoMessage() {}
 
 func (x *DeleteKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[7]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +930,7 @@ func (x *DeleteKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
 func (*DeleteKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{7}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteKnowledgeBaseRequest) GetId() string {
@@ -592,7 +948,7 @@ type ListKnowledgeBasesRequest struct {
 
 func (x *ListKnowledgeBasesRequest) Reset() {
 	*x = ListKnowledgeBasesRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[8]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -604,7 +960,7 @@ func (x *ListKnowledgeBasesRequest) String() string {
 func (*ListKnowledgeBasesRequest) ProtoMessage() {}
 
 func (x *ListKnowledgeBasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[8]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +973,7 @@ func (x *ListKnowledgeBasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKnowledgeBasesRequest.ProtoReflect.Descriptor instead.
 func (*ListKnowledgeBasesRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{8}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{11}
 }
 
 type ListKnowledgeBasesResponse struct {
@@ -629,7 +985,7 @@ type ListKnowledgeBasesResponse struct {
 
 func (x *ListKnowledgeBasesResponse) Reset() {
 	*x = ListKnowledgeBasesResponse{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[9]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -641,7 +997,7 @@ func (x *ListKnowledgeBasesResponse) String() string {
 func (*ListKnowledgeBasesResponse) ProtoMessage() {}
 
 func (x *ListKnowledgeBasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[9]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +1010,7 @@ func (x *ListKnowledgeBasesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListKnowledgeBasesResponse.ProtoReflect.Descriptor instead.
 func (*ListKnowledgeBasesResponse) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{9}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListKnowledgeBasesResponse) GetItems() []*KnowledgeBase {
@@ -675,7 +1031,7 @@ type ListDocumentsRequest struct {
 
 func (x *ListDocumentsRequest) Reset() {
 	*x = ListDocumentsRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[10]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -687,7 +1043,7 @@ func (x *ListDocumentsRequest) String() string {
 func (*ListDocumentsRequest) ProtoMessage() {}
 
 func (x *ListDocumentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[10]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +1056,7 @@ func (x *ListDocumentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDocumentsRequest.ProtoReflect.Descriptor instead.
 func (*ListDocumentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{10}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListDocumentsRequest) GetKbId() string {
@@ -733,7 +1089,7 @@ type ListDocumentsResponse struct {
 
 func (x *ListDocumentsResponse) Reset() {
 	*x = ListDocumentsResponse{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[11]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +1101,7 @@ func (x *ListDocumentsResponse) String() string {
 func (*ListDocumentsResponse) ProtoMessage() {}
 
 func (x *ListDocumentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[11]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +1114,7 @@ func (x *ListDocumentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDocumentsResponse.ProtoReflect.Descriptor instead.
 func (*ListDocumentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{11}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListDocumentsResponse) GetItems() []*Document {
@@ -777,7 +1133,7 @@ type ListBotKnowledgeBasesRequest struct {
 
 func (x *ListBotKnowledgeBasesRequest) Reset() {
 	*x = ListBotKnowledgeBasesRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[12]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -789,7 +1145,7 @@ func (x *ListBotKnowledgeBasesRequest) String() string {
 func (*ListBotKnowledgeBasesRequest) ProtoMessage() {}
 
 func (x *ListBotKnowledgeBasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[12]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,7 +1158,7 @@ func (x *ListBotKnowledgeBasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBotKnowledgeBasesRequest.ProtoReflect.Descriptor instead.
 func (*ListBotKnowledgeBasesRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{12}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListBotKnowledgeBasesRequest) GetBotId() string {
@@ -821,7 +1177,7 @@ type ListBotKnowledgeBasesResponse struct {
 
 func (x *ListBotKnowledgeBasesResponse) Reset() {
 	*x = ListBotKnowledgeBasesResponse{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[13]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -833,7 +1189,7 @@ func (x *ListBotKnowledgeBasesResponse) String() string {
 func (*ListBotKnowledgeBasesResponse) ProtoMessage() {}
 
 func (x *ListBotKnowledgeBasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[13]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,7 +1202,7 @@ func (x *ListBotKnowledgeBasesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBotKnowledgeBasesResponse.ProtoReflect.Descriptor instead.
 func (*ListBotKnowledgeBasesResponse) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{13}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListBotKnowledgeBasesResponse) GetItems() []*BotKnowledgeBase {
@@ -865,7 +1221,7 @@ type KnowledgeBaseResponse struct {
 
 func (x *KnowledgeBaseResponse) Reset() {
 	*x = KnowledgeBaseResponse{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[14]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -877,7 +1233,7 @@ func (x *KnowledgeBaseResponse) String() string {
 func (*KnowledgeBaseResponse) ProtoMessage() {}
 
 func (x *KnowledgeBaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[14]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +1246,7 @@ func (x *KnowledgeBaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KnowledgeBaseResponse.ProtoReflect.Descriptor instead.
 func (*KnowledgeBaseResponse) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{14}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *KnowledgeBaseResponse) GetKnowledgeBase() *KnowledgeBase {
@@ -909,7 +1265,7 @@ type BotKnowledgeBaseResponse struct {
 
 func (x *BotKnowledgeBaseResponse) Reset() {
 	*x = BotKnowledgeBaseResponse{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[15]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1277,7 @@ func (x *BotKnowledgeBaseResponse) String() string {
 func (*BotKnowledgeBaseResponse) ProtoMessage() {}
 
 func (x *BotKnowledgeBaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[15]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1290,7 @@ func (x *BotKnowledgeBaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BotKnowledgeBaseResponse.ProtoReflect.Descriptor instead.
 func (*BotKnowledgeBaseResponse) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{15}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BotKnowledgeBaseResponse) GetBotKb() *BotKnowledgeBase {
@@ -950,13 +1306,14 @@ type UploadDocumentRequest struct {
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	SourceType    string                 `protobuf:"bytes,3,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
 	RawUri        string                 `protobuf:"bytes,4,opt,name=raw_uri,json=rawUri,proto3" json:"raw_uri,omitempty"`
+	AccessLabels  []string               `protobuf:"bytes,5,rep,name=access_labels,json=accessLabels,proto3" json:"access_labels,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UploadDocumentRequest) Reset() {
 	*x = UploadDocumentRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[16]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -968,7 +1325,7 @@ func (x *UploadDocumentRequest) String() string {
 func (*UploadDocumentRequest) ProtoMessage() {}
 
 func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[16]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1338,7 @@ func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadDocumentRequest.ProtoReflect.Descriptor instead.
 func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{16}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UploadDocumentRequest) GetKbId() string {
@@ -1012,6 +1369,13 @@ func (x *UploadDocumentRequest) GetRawUri() string {
 	return ""
 }
 
+func (x *UploadDocumentRequest) GetAccessLabels() []string {
+	if x != nil {
+		return x.AccessLabels
+	}
+	return nil
+}
+
 type UploadDocumentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
@@ -1022,7 +1386,7 @@ type UploadDocumentResponse struct {
 
 func (x *UploadDocumentResponse) Reset() {
 	*x = UploadDocumentResponse{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[17]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1034,7 +1398,7 @@ func (x *UploadDocumentResponse) String() string {
 func (*UploadDocumentResponse) ProtoMessage() {}
 
 func (x *UploadDocumentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[17]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1411,7 @@ func (x *UploadDocumentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadDocumentResponse.ProtoReflect.Descriptor instead.
 func (*UploadDocumentResponse) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{17}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UploadDocumentResponse) GetDocument() *Document {
@@ -1073,7 +1437,7 @@ type GetDocumentRequest struct {
 
 func (x *GetDocumentRequest) Reset() {
 	*x = GetDocumentRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[18]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1085,7 +1449,7 @@ func (x *GetDocumentRequest) String() string {
 func (*GetDocumentRequest) ProtoMessage() {}
 
 func (x *GetDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[18]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1098,7 +1462,7 @@ func (x *GetDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentRequest.ProtoReflect.Descriptor instead.
 func (*GetDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{18}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetDocumentRequest) GetId() string {
@@ -1118,7 +1482,7 @@ type GetDocumentResponse struct {
 
 func (x *GetDocumentResponse) Reset() {
 	*x = GetDocumentResponse{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[19]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1130,7 +1494,7 @@ func (x *GetDocumentResponse) String() string {
 func (*GetDocumentResponse) ProtoMessage() {}
 
 func (x *GetDocumentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[19]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +1507,7 @@ func (x *GetDocumentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentResponse.ProtoReflect.Descriptor instead.
 func (*GetDocumentResponse) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{19}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetDocumentResponse) GetDocument() *Document {
@@ -1169,7 +1533,7 @@ type DeleteDocumentRequest struct {
 
 func (x *DeleteDocumentRequest) Reset() {
 	*x = DeleteDocumentRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[20]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1181,7 +1545,7 @@ func (x *DeleteDocumentRequest) String() string {
 func (*DeleteDocumentRequest) ProtoMessage() {}
 
 func (x *DeleteDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[20]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1194,7 +1558,7 @@ func (x *DeleteDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDocumentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{20}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DeleteDocumentRequest) GetId() string {
@@ -1214,7 +1578,7 @@ type UpdateDocumentRequest struct {
 
 func (x *UpdateDocumentRequest) Reset() {
 	*x = UpdateDocumentRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[21]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1226,7 +1590,7 @@ func (x *UpdateDocumentRequest) String() string {
 func (*UpdateDocumentRequest) ProtoMessage() {}
 
 func (x *UpdateDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[21]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,7 +1603,7 @@ func (x *UpdateDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDocumentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{21}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UpdateDocumentRequest) GetId() string {
@@ -1265,7 +1629,7 @@ type DocumentResponse struct {
 
 func (x *DocumentResponse) Reset() {
 	*x = DocumentResponse{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[22]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1277,7 +1641,7 @@ func (x *DocumentResponse) String() string {
 func (*DocumentResponse) ProtoMessage() {}
 
 func (x *DocumentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[22]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,7 +1654,7 @@ func (x *DocumentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DocumentResponse.ProtoReflect.Descriptor instead.
 func (*DocumentResponse) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{22}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DocumentResponse) GetDocument() *Document {
@@ -1300,6 +1664,59 @@ func (x *DocumentResponse) GetDocument() *Document {
 	return nil
 }
 
+type SetDocumentAccessLabelsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Replaces the labels; empty makes the document public.
+	AccessLabels  []string `protobuf:"bytes,2,rep,name=access_labels,json=accessLabels,proto3" json:"access_labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDocumentAccessLabelsRequest) Reset() {
+	*x = SetDocumentAccessLabelsRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDocumentAccessLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDocumentAccessLabelsRequest) ProtoMessage() {}
+
+func (x *SetDocumentAccessLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDocumentAccessLabelsRequest.ProtoReflect.Descriptor instead.
+func (*SetDocumentAccessLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetDocumentAccessLabelsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetDocumentAccessLabelsRequest) GetAccessLabels() []string {
+	if x != nil {
+		return x.AccessLabels
+	}
+	return nil
+}
+
 type ReindexDocumentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1309,7 +1726,7 @@ type ReindexDocumentRequest struct {
 
 func (x *ReindexDocumentRequest) Reset() {
 	*x = ReindexDocumentRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[23]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1321,7 +1738,7 @@ func (x *ReindexDocumentRequest) String() string {
 func (*ReindexDocumentRequest) ProtoMessage() {}
 
 func (x *ReindexDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[23]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,7 +1751,7 @@ func (x *ReindexDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReindexDocumentRequest.ProtoReflect.Descriptor instead.
 func (*ReindexDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{23}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ReindexDocumentRequest) GetId() string {
@@ -1354,7 +1771,7 @@ type RollbackDocumentRequest struct {
 
 func (x *RollbackDocumentRequest) Reset() {
 	*x = RollbackDocumentRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[24]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1366,7 +1783,7 @@ func (x *RollbackDocumentRequest) String() string {
 func (*RollbackDocumentRequest) ProtoMessage() {}
 
 func (x *RollbackDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[24]
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1379,7 +1796,7 @@ func (x *RollbackDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RollbackDocumentRequest.ProtoReflect.Descriptor instead.
 func (*RollbackDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{24}
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *RollbackDocumentRequest) GetId() string {
@@ -1396,30 +1813,31 @@ func (x *RollbackDocumentRequest) GetVersion() int32 {
 	return 0
 }
 
-type BindBotKnowledgeBaseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
-	KbId          string                 `protobuf:"bytes,2,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
-	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+type DiffDocumentVersionsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromVersion int32                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// Defaults to the current version.
+	ToVersion     int32 `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BindBotKnowledgeBaseRequest) Reset() {
-	*x = BindBotKnowledgeBaseRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[25]
+func (x *DiffDocumentVersionsRequest) Reset() {
+	*x = DiffDocumentVersionsRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BindBotKnowledgeBaseRequest) String() string {
+func (x *DiffDocumentVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BindBotKnowledgeBaseRequest) ProtoMessage() {}
+func (*DiffDocumentVersionsRequest) ProtoMessage() {}
 
-func (x *BindBotKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[25]
+func (x *DiffDocumentVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1430,55 +1848,62 @@ func (x *BindBotKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BindBotKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
-func (*BindBotKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DiffDocumentVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffDocumentVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *BindBotKnowledgeBaseRequest) GetBotId() string {
+func (x *DiffDocumentVersionsRequest) GetId() string {
 	if x != nil {
-		return x.BotId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BindBotKnowledgeBaseRequest) GetKbId() string {
+func (x *DiffDocumentVersionsRequest) GetFromVersion() int32 {
 	if x != nil {
-		return x.KbId
+		return x.FromVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *BindBotKnowledgeBaseRequest) GetWeight() float64 {
+func (x *DiffDocumentVersionsRequest) GetToVersion() int32 {
 	if x != nil {
-		return x.Weight
+		return x.ToVersion
 	}
 	return 0
 }
 
-type UnbindBotKnowledgeBaseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
-	KbId          string                 `protobuf:"bytes,2,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+type DiffChunk struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChunkIndex  int32                  `protobuf:"varint,2,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	Content     string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	ContentHash string                 `protobuf:"bytes,4,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	Section     string                 `protobuf:"bytes,5,opt,name=section,proto3" json:"section,omitempty"`
+	PageNo      int32                  `protobuf:"varint,6,opt,name=page_no,json=pageNo,proto3" json:"page_no,omitempty"`
+	TokenCount  int32                  `protobuf:"varint,7,opt,name=token_count,json=tokenCount,proto3" json:"token_count,omitempty"`
+	// Answers that cited the chunk.
+	Citations     int32 `protobuf:"varint,8,opt,name=citations,proto3" json:"citations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnbindBotKnowledgeBaseRequest) Reset() {
-	*x = UnbindBotKnowledgeBaseRequest{}
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[26]
+func (x *DiffChunk) Reset() {
+	*x = DiffChunk{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnbindBotKnowledgeBaseRequest) String() string {
+func (x *DiffChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnbindBotKnowledgeBaseRequest) ProtoMessage() {}
+func (*DiffChunk) ProtoMessage() {}
 
-func (x *UnbindBotKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[26]
+func (x *DiffChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1489,78 +1914,3158 @@ func (x *UnbindBotKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnbindBotKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
-func (*UnbindBotKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
-	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use DiffChunk.ProtoReflect.Descriptor instead.
+func (*DiffChunk) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UnbindBotKnowledgeBaseRequest) GetBotId() string {
+func (x *DiffChunk) GetId() string {
 	if x != nil {
-		return x.BotId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UnbindBotKnowledgeBaseRequest) GetKbId() string {
+func (x *DiffChunk) GetChunkIndex() int32 {
 	if x != nil {
-		return x.KbId
+		return x.ChunkIndex
+	}
+	return 0
+}
+
+func (x *DiffChunk) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-var File_api_knowledge_v1_console_knowledge_proto protoreflect.FileDescriptor
+func (x *DiffChunk) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
 
-const file_api_knowledge_v1_console_knowledge_proto_rawDesc = "" +
-	"\n" +
-	"(api/knowledge/v1/console_knowledge.proto\x12\x10api.knowledge.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\x01\n" +
-	"\rKnowledgeBase\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc4\x01\n" +
-	"\x10BotKnowledgeBase\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
-	"\x06bot_id\x18\x03 \x01(\tR\x05botId\x12\x13\n" +
-	"\x05kb_id\x18\x04 \x01(\tR\x04kbId\x12\x16\n" +
-	"\x06weight\x18\x06 \x01(\x01R\x06weight\x129\n" +
-	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtJ\x04\b\x05\x10\x06\"\xba\x02\n" +
-	"\bDocument\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x13\n" +
-	"\x05kb_id\x18\x03 \x01(\tR\x04kbId\x12\x14\n" +
-	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1f\n" +
-	"\vsource_type\x18\x05 \x01(\tR\n" +
-	"sourceType\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\x12'\n" +
-	"\x0fcurrent_version\x18\a \x01(\x05R\x0ecurrentVersion\x129\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xcc\x01\n" +
-	"\x0fDocumentVersion\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1f\n" +
-	"\vdocument_id\x18\x03 \x01(\tR\n" +
-	"documentId\x12\x18\n" +
-	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"R\n" +
-	"\x1aCreateKnowledgeBaseRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\")\n" +
-	"\x17GetKnowledgeBaseRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"b\n" +
-	"\x1aUpdateKnowledgeBaseRequest\x12\x0e\n" +
+func (x *DiffChunk) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *DiffChunk) GetPageNo() int32 {
+	if x != nil {
+		return x.PageNo
+	}
+	return 0
+}
+
+func (x *DiffChunk) GetTokenCount() int32 {
+	if x != nil {
+		return x.TokenCount
+	}
+	return 0
+}
+
+func (x *DiffChunk) GetCitations() int32 {
+	if x != nil {
+		return x.Citations
+	}
+	return 0
+}
+
+type ChunkChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *DiffChunk             `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *DiffChunk             `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkChange) Reset() {
+	*x = ChunkChange{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkChange) ProtoMessage() {}
+
+func (x *ChunkChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkChange.ProtoReflect.Descriptor instead.
+func (*ChunkChange) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChunkChange) GetFrom() *DiffChunk {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ChunkChange) GetTo() *DiffChunk {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ChunkCitation struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ChunkId string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	// -1 when the cited chunk is not among the chunks of the version.
+	ChunkIndex  int32                  `protobuf:"varint,2,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	ContentHash string                 `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	Citations   int32                  `protobuf:"varint,4,opt,name=citations,proto3" json:"citations,omitempty"`
+	LastCitedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_cited_at,json=lastCitedAt,proto3" json:"last_cited_at,omitempty"`
+	// Snippet of the latest citing answer.
+	Snippet       string `protobuf:"bytes,6,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkCitation) Reset() {
+	*x = ChunkCitation{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkCitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkCitation) ProtoMessage() {}
+
+func (x *ChunkCitation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkCitation.ProtoReflect.Descriptor instead.
+func (*ChunkCitation) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChunkCitation) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *ChunkCitation) GetChunkIndex() int32 {
+	if x != nil {
+		return x.ChunkIndex
+	}
+	return 0
+}
+
+func (x *ChunkCitation) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *ChunkCitation) GetCitations() int32 {
+	if x != nil {
+		return x.Citations
+	}
+	return 0
+}
+
+func (x *ChunkCitation) GetLastCitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCitedAt
+	}
+	return nil
+}
+
+func (x *ChunkCitation) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type DocumentVersionDiffSide struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Version *DocumentVersion       `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The chunks were no longer stored and were rebuilt from the raw object
+	// with the current chunking settings.
+	ChunksRebuilt bool `protobuf:"varint,2,opt,name=chunks_rebuilt,json=chunksRebuilt,proto3" json:"chunks_rebuilt,omitempty"`
+	// Chunks cited in answers while the version was indexed.
+	Citations     []*ChunkCitation `protobuf:"bytes,3,rep,name=citations,proto3" json:"citations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentVersionDiffSide) Reset() {
+	*x = DocumentVersionDiffSide{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentVersionDiffSide) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentVersionDiffSide) ProtoMessage() {}
+
+func (x *DocumentVersionDiffSide) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentVersionDiffSide.ProtoReflect.Descriptor instead.
+func (*DocumentVersionDiffSide) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DocumentVersionDiffSide) GetVersion() *DocumentVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *DocumentVersionDiffSide) GetChunksRebuilt() bool {
+	if x != nil {
+		return x.ChunksRebuilt
+	}
+	return false
+}
+
+func (x *DocumentVersionDiffSide) GetCitations() []*ChunkCitation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+type DocumentVersionDiff struct {
+	state      protoimpl.MessageState   `protogen:"open.v1"`
+	DocumentId string                   `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	From       *DocumentVersionDiffSide `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To         *DocumentVersionDiffSide `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Added      []*DiffChunk             `protobuf:"bytes,4,rep,name=added,proto3" json:"added,omitempty"`
+	Removed    []*DiffChunk             `protobuf:"bytes,5,rep,name=removed,proto3" json:"removed,omitempty"`
+	// Chunks at the same position whose content changed.
+	Modified []*ChunkChange `protobuf:"bytes,6,rep,name=modified,proto3" json:"modified,omitempty"`
+	// Chunks with the same content hash in both versions.
+	UnchangedChunks int32 `protobuf:"varint,7,opt,name=unchanged_chunks,json=unchangedChunks,proto3" json:"unchanged_chunks,omitempty"`
+	// Unified diff of the parsed content.
+	TextDiff          string `protobuf:"bytes,8,opt,name=text_diff,json=textDiff,proto3" json:"text_diff,omitempty"`
+	TextDiffTruncated bool   `protobuf:"varint,9,opt,name=text_diff_truncated,json=textDiffTruncated,proto3" json:"text_diff_truncated,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DocumentVersionDiff) Reset() {
+	*x = DocumentVersionDiff{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentVersionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentVersionDiff) ProtoMessage() {}
+
+func (x *DocumentVersionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentVersionDiff.ProtoReflect.Descriptor instead.
+func (*DocumentVersionDiff) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DocumentVersionDiff) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DocumentVersionDiff) GetFrom() *DocumentVersionDiffSide {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DocumentVersionDiff) GetTo() *DocumentVersionDiffSide {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DocumentVersionDiff) GetAdded() []*DiffChunk {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *DocumentVersionDiff) GetRemoved() []*DiffChunk {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *DocumentVersionDiff) GetModified() []*ChunkChange {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+func (x *DocumentVersionDiff) GetUnchangedChunks() int32 {
+	if x != nil {
+		return x.UnchangedChunks
+	}
+	return 0
+}
+
+func (x *DocumentVersionDiff) GetTextDiff() string {
+	if x != nil {
+		return x.TextDiff
+	}
+	return ""
+}
+
+func (x *DocumentVersionDiff) GetTextDiffTruncated() bool {
+	if x != nil {
+		return x.TextDiffTruncated
+	}
+	return false
+}
+
+type DocumentVersionDiffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diff          *DocumentVersionDiff   `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentVersionDiffResponse) Reset() {
+	*x = DocumentVersionDiffResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentVersionDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentVersionDiffResponse) ProtoMessage() {}
+
+func (x *DocumentVersionDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentVersionDiffResponse.ProtoReflect.Descriptor instead.
+func (*DocumentVersionDiffResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DocumentVersionDiffResponse) GetDiff() *DocumentVersionDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type Chunk struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DocumentId        string                 `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	DocumentVersionId string                 `protobuf:"bytes,3,opt,name=document_version_id,json=documentVersionId,proto3" json:"document_version_id,omitempty"`
+	DocumentTitle     string                 `protobuf:"bytes,4,opt,name=document_title,json=documentTitle,proto3" json:"document_title,omitempty"`
+	ChunkIndex        int32                  `protobuf:"varint,5,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	Content           string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	ContentHash       string                 `protobuf:"bytes,7,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	Section           string                 `protobuf:"bytes,8,opt,name=section,proto3" json:"section,omitempty"`
+	PageNo            int32                  `protobuf:"varint,9,opt,name=page_no,json=pageNo,proto3" json:"page_no,omitempty"`
+	TokenCount        int32                  `protobuf:"varint,10,opt,name=token_count,json=tokenCount,proto3" json:"token_count,omitempty"`
+	Language          string                 `protobuf:"bytes,11,opt,name=language,proto3" json:"language,omitempty"`
+	// Excluded chunks stay indexed but are never retrieved.
+	Excluded bool     `protobuf:"varint,12,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	Keywords []string `protobuf:"bytes,13,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	// Set when the content was edited; hash of the chunked content.
+	OriginHash string `protobuf:"bytes,14,opt,name=origin_hash,json=originHash,proto3" json:"origin_hash,omitempty"`
+	// Answers that cited the chunk.
+	Citations     int32 `protobuf:"varint,15,opt,name=citations,proto3" json:"citations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chunk) Reset() {
+	*x = Chunk{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chunk) ProtoMessage() {}
+
+func (x *Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chunk.ProtoReflect.Descriptor instead.
+func (*Chunk) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Chunk) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Chunk) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *Chunk) GetDocumentVersionId() string {
+	if x != nil {
+		return x.DocumentVersionId
+	}
+	return ""
+}
+
+func (x *Chunk) GetDocumentTitle() string {
+	if x != nil {
+		return x.DocumentTitle
+	}
+	return ""
+}
+
+func (x *Chunk) GetChunkIndex() int32 {
+	if x != nil {
+		return x.ChunkIndex
+	}
+	return 0
+}
+
+func (x *Chunk) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Chunk) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *Chunk) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Chunk) GetPageNo() int32 {
+	if x != nil {
+		return x.PageNo
+	}
+	return 0
+}
+
+func (x *Chunk) GetTokenCount() int32 {
+	if x != nil {
+		return x.TokenCount
+	}
+	return 0
+}
+
+func (x *Chunk) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Chunk) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+func (x *Chunk) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *Chunk) GetOriginHash() string {
+	if x != nil {
+		return x.OriginHash
+	}
+	return ""
+}
+
+func (x *Chunk) GetCitations() int32 {
+	if x != nil {
+		return x.Citations
+	}
+	return 0
+}
+
+type ListDocumentChunksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to the current version.
+	Version       int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentChunksRequest) Reset() {
+	*x = ListDocumentChunksRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentChunksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentChunksRequest) ProtoMessage() {}
+
+func (x *ListDocumentChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentChunksRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentChunksRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListDocumentChunksRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListDocumentChunksRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ListDocumentChunksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDocumentChunksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchChunksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KbId          string                 `protobuf:"bytes,1,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchChunksRequest) Reset() {
+	*x = SearchChunksRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchChunksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchChunksRequest) ProtoMessage() {}
+
+func (x *SearchChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchChunksRequest.ProtoReflect.Descriptor instead.
+func (*SearchChunksRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SearchChunksRequest) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *SearchChunksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchChunksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchChunksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListChunksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*Chunk               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Set when listing the chunks of a document version.
+	Total         int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChunksResponse) Reset() {
+	*x = ListChunksResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChunksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChunksResponse) ProtoMessage() {}
+
+func (x *ListChunksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChunksResponse.ProtoReflect.Descriptor instead.
+func (*ListChunksResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListChunksResponse) GetItems() []*Chunk {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListChunksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ChunkKeywords struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkKeywords) Reset() {
+	*x = ChunkKeywords{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkKeywords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkKeywords) ProtoMessage() {}
+
+func (x *ChunkKeywords) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkKeywords.ProtoReflect.Descriptor instead.
+func (*ChunkKeywords) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChunkKeywords) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type UpdateChunkRequest struct {
+	state    protoimpl.MessageState  `protogen:"open.v1"`
+	Id       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content  *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Excluded *wrapperspb.BoolValue   `protobuf:"bytes,3,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	// Replaces the pinned keywords; an empty list clears them.
+	Keywords      *ChunkKeywords `protobuf:"bytes,4,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChunkRequest) Reset() {
+	*x = UpdateChunkRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChunkRequest) ProtoMessage() {}
+
+func (x *UpdateChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChunkRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChunkRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateChunkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateChunkRequest) GetContent() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UpdateChunkRequest) GetExcluded() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
+func (x *UpdateChunkRequest) GetKeywords() *ChunkKeywords {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type ChunkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         *Chunk                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkResponse) Reset() {
+	*x = ChunkResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkResponse) ProtoMessage() {}
+
+func (x *ChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkResponse.ProtoReflect.Descriptor instead.
+func (*ChunkResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ChunkResponse) GetChunk() *Chunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ListChunkEditsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChunkEditsRequest) Reset() {
+	*x = ListChunkEditsRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChunkEditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChunkEditsRequest) ProtoMessage() {}
+
+func (x *ListChunkEditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChunkEditsRequest.ProtoReflect.Descriptor instead.
+func (*ListChunkEditsRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListChunkEditsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListChunkEditsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListChunkEditsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ChunkState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentHash   string                 `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	Excluded      bool                   `protobuf:"varint,3,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	Keywords      []string               `protobuf:"bytes,4,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkState) Reset() {
+	*x = ChunkState{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkState) ProtoMessage() {}
+
+func (x *ChunkState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkState.ProtoReflect.Descriptor instead.
+func (*ChunkState) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ChunkState) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChunkState) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *ChunkState) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+func (x *ChunkState) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type ChunkEdit struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DocumentId        string                 `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	DocumentVersionId string                 `protobuf:"bytes,3,opt,name=document_version_id,json=documentVersionId,proto3" json:"document_version_id,omitempty"`
+	ChunkId           string                 `protobuf:"bytes,4,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	OriginHash        string                 `protobuf:"bytes,5,opt,name=origin_hash,json=originHash,proto3" json:"origin_hash,omitempty"`
+	ActorId           string                 `protobuf:"bytes,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Before            *ChunkState            `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After             *ChunkState            `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChunkEdit) Reset() {
+	*x = ChunkEdit{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkEdit) ProtoMessage() {}
+
+func (x *ChunkEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkEdit.ProtoReflect.Descriptor instead.
+func (*ChunkEdit) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ChunkEdit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChunkEdit) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *ChunkEdit) GetDocumentVersionId() string {
+	if x != nil {
+		return x.DocumentVersionId
+	}
+	return ""
+}
+
+func (x *ChunkEdit) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *ChunkEdit) GetOriginHash() string {
+	if x != nil {
+		return x.OriginHash
+	}
+	return ""
+}
+
+func (x *ChunkEdit) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ChunkEdit) GetBefore() *ChunkState {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ChunkEdit) GetAfter() *ChunkState {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ChunkEdit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListChunkEditsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ChunkEdit           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChunkEditsResponse) Reset() {
+	*x = ListChunkEditsResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChunkEditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChunkEditsResponse) ProtoMessage() {}
+
+func (x *ListChunkEditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChunkEditsResponse.ProtoReflect.Descriptor instead.
+func (*ListChunkEditsResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListChunkEditsResponse) GetItems() []*ChunkEdit {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BindBotKnowledgeBaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	KbId          string                 `protobuf:"bytes,2,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BindBotKnowledgeBaseRequest) Reset() {
+	*x = BindBotKnowledgeBaseRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BindBotKnowledgeBaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindBotKnowledgeBaseRequest) ProtoMessage() {}
+
+func (x *BindBotKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindBotKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
+func (*BindBotKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BindBotKnowledgeBaseRequest) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *BindBotKnowledgeBaseRequest) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *BindBotKnowledgeBaseRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type UnbindBotKnowledgeBaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	KbId          string                 `protobuf:"bytes,2,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbindBotKnowledgeBaseRequest) Reset() {
+	*x = UnbindBotKnowledgeBaseRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbindBotKnowledgeBaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbindBotKnowledgeBaseRequest) ProtoMessage() {}
+
+func (x *UnbindBotKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbindBotKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
+func (*UnbindBotKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UnbindBotKnowledgeBaseRequest) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *UnbindBotKnowledgeBaseRequest) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+// KnowledgeBaseMigration rebuilds every document of a knowledge base into a new
+// index generation; retrieval keeps using the current one until the switch.
+type KnowledgeBaseMigration struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	KbId     string                 `protobuf:"bytes,3,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	// pending | running | switching | collecting | cancelling | completed | failed | cancelled
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Generation         int32                  `protobuf:"varint,5,opt,name=generation,proto3" json:"generation,omitempty"`
+	Chunking           *ChunkingConfig        `protobuf:"bytes,6,opt,name=chunking,proto3" json:"chunking,omitempty"`
+	Embedding          *EmbeddingConfig       `protobuf:"bytes,7,opt,name=embedding,proto3" json:"embedding,omitempty"`
+	TotalDocuments     int32                  `protobuf:"varint,8,opt,name=total_documents,json=totalDocuments,proto3" json:"total_documents,omitempty"`
+	ProcessedDocuments int32                  `protobuf:"varint,9,opt,name=processed_documents,json=processedDocuments,proto3" json:"processed_documents,omitempty"`
+	FailedDocuments    int32                  `protobuf:"varint,10,opt,name=failed_documents,json=failedDocuments,proto3" json:"failed_documents,omitempty"`
+	ErrorMessage       string                 `protobuf:"bytes,11,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *KnowledgeBaseMigration) Reset() {
+	*x = KnowledgeBaseMigration{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KnowledgeBaseMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeBaseMigration) ProtoMessage() {}
+
+func (x *KnowledgeBaseMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeBaseMigration.ProtoReflect.Descriptor instead.
+func (*KnowledgeBaseMigration) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *KnowledgeBaseMigration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KnowledgeBaseMigration) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *KnowledgeBaseMigration) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *KnowledgeBaseMigration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KnowledgeBaseMigration) GetGeneration() int32 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *KnowledgeBaseMigration) GetChunking() *ChunkingConfig {
+	if x != nil {
+		return x.Chunking
+	}
+	return nil
+}
+
+func (x *KnowledgeBaseMigration) GetEmbedding() *EmbeddingConfig {
+	if x != nil {
+		return x.Embedding
+	}
+	return nil
+}
+
+func (x *KnowledgeBaseMigration) GetTotalDocuments() int32 {
+	if x != nil {
+		return x.TotalDocuments
+	}
+	return 0
+}
+
+func (x *KnowledgeBaseMigration) GetProcessedDocuments() int32 {
+	if x != nil {
+		return x.ProcessedDocuments
+	}
+	return 0
+}
+
+func (x *KnowledgeBaseMigration) GetFailedDocuments() int32 {
+	if x != nil {
+		return x.FailedDocuments
+	}
+	return 0
+}
+
+func (x *KnowledgeBaseMigration) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *KnowledgeBaseMigration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *KnowledgeBaseMigration) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *KnowledgeBaseMigration) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type StartKnowledgeBaseMigrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KbId  string                 `protobuf:"bytes,1,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	// Target configuration; unset keeps the current one of the knowledge base.
+	Chunking      *ChunkingConfig  `protobuf:"bytes,2,opt,name=chunking,proto3" json:"chunking,omitempty"`
+	Embedding     *EmbeddingConfig `protobuf:"bytes,3,opt,name=embedding,proto3" json:"embedding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartKnowledgeBaseMigrationRequest) Reset() {
+	*x = StartKnowledgeBaseMigrationRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartKnowledgeBaseMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartKnowledgeBaseMigrationRequest) ProtoMessage() {}
+
+func (x *StartKnowledgeBaseMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartKnowledgeBaseMigrationRequest.ProtoReflect.Descriptor instead.
+func (*StartKnowledgeBaseMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *StartKnowledgeBaseMigrationRequest) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *StartKnowledgeBaseMigrationRequest) GetChunking() *ChunkingConfig {
+	if x != nil {
+		return x.Chunking
+	}
+	return nil
+}
+
+func (x *StartKnowledgeBaseMigrationRequest) GetEmbedding() *EmbeddingConfig {
+	if x != nil {
+		return x.Embedding
+	}
+	return nil
+}
+
+type GetKnowledgeBaseMigrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KbId          string                 `protobuf:"bytes,1,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKnowledgeBaseMigrationRequest) Reset() {
+	*x = GetKnowledgeBaseMigrationRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKnowledgeBaseMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKnowledgeBaseMigrationRequest) ProtoMessage() {}
+
+func (x *GetKnowledgeBaseMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKnowledgeBaseMigrationRequest.ProtoReflect.Descriptor instead.
+func (*GetKnowledgeBaseMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetKnowledgeBaseMigrationRequest) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *GetKnowledgeBaseMigrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListKnowledgeBaseMigrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KbId          string                 `protobuf:"bytes,1,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKnowledgeBaseMigrationsRequest) Reset() {
+	*x = ListKnowledgeBaseMigrationsRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKnowledgeBaseMigrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnowledgeBaseMigrationsRequest) ProtoMessage() {}
+
+func (x *ListKnowledgeBaseMigrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnowledgeBaseMigrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListKnowledgeBaseMigrationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListKnowledgeBaseMigrationsRequest) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+type ListKnowledgeBaseMigrationsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*KnowledgeBaseMigration `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKnowledgeBaseMigrationsResponse) Reset() {
+	*x = ListKnowledgeBaseMigrationsResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKnowledgeBaseMigrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnowledgeBaseMigrationsResponse) ProtoMessage() {}
+
+func (x *ListKnowledgeBaseMigrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnowledgeBaseMigrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListKnowledgeBaseMigrationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListKnowledgeBaseMigrationsResponse) GetItems() []*KnowledgeBaseMigration {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CancelKnowledgeBaseMigrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KbId          string                 `protobuf:"bytes,1,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelKnowledgeBaseMigrationRequest) Reset() {
+	*x = CancelKnowledgeBaseMigrationRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelKnowledgeBaseMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelKnowledgeBaseMigrationRequest) ProtoMessage() {}
+
+func (x *CancelKnowledgeBaseMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelKnowledgeBaseMigrationRequest.ProtoReflect.Descriptor instead.
+func (*CancelKnowledgeBaseMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CancelKnowledgeBaseMigrationRequest) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *CancelKnowledgeBaseMigrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type KnowledgeBaseMigrationResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Migration     *KnowledgeBaseMigration `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KnowledgeBaseMigrationResponse) Reset() {
+	*x = KnowledgeBaseMigrationResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KnowledgeBaseMigrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeBaseMigrationResponse) ProtoMessage() {}
+
+func (x *KnowledgeBaseMigrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeBaseMigrationResponse.ProtoReflect.Descriptor instead.
+func (*KnowledgeBaseMigrationResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *KnowledgeBaseMigrationResponse) GetMigration() *KnowledgeBaseMigration {
+	if x != nil {
+		return x.Migration
+	}
+	return nil
+}
+
+// IngestionJob is one upload or reindex of a document version.
+type IngestionJob struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId          string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	KbId              string                 `protobuf:"bytes,3,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	DocumentId        string                 `protobuf:"bytes,4,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	DocumentVersionId string                 `protobuf:"bytes,5,opt,name=document_version_id,json=documentVersionId,proto3" json:"document_version_id,omitempty"`
+	// queued | running | retrying | succeeded | failed | dead | cancelled
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// load | chunk | embed | index
+	CurrentStep    string `protobuf:"bytes,7,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
+	Attempts       int32  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ChunkCount     int32  `protobuf:"varint,9,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	ReusedChunks   int32  `protobuf:"varint,10,opt,name=reused_chunks,json=reusedChunks,proto3" json:"reused_chunks,omitempty"`
+	EmbeddedChunks int32  `protobuf:"varint,11,opt,name=embedded_chunks,json=embeddedChunks,proto3" json:"embedded_chunks,omitempty"`
+	// Duration in ms of each finished step of the latest attempt.
+	StepDurations map[string]int64       `protobuf:"bytes,12,rep,name=step_durations,json=stepDurations,proto3" json:"step_durations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ErrorMessage  string                 `protobuf:"bytes,13,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestionJob) Reset() {
+	*x = IngestionJob{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestionJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestionJob) ProtoMessage() {}
+
+func (x *IngestionJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestionJob.ProtoReflect.Descriptor instead.
+func (*IngestionJob) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *IngestionJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetDocumentVersionId() string {
+	if x != nil {
+		return x.DocumentVersionId
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetCurrentStep() string {
+	if x != nil {
+		return x.CurrentStep
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *IngestionJob) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *IngestionJob) GetReusedChunks() int32 {
+	if x != nil {
+		return x.ReusedChunks
+	}
+	return 0
+}
+
+func (x *IngestionJob) GetEmbeddedChunks() int32 {
+	if x != nil {
+		return x.EmbeddedChunks
+	}
+	return 0
+}
+
+func (x *IngestionJob) GetStepDurations() map[string]int64 {
+	if x != nil {
+		return x.StepDurations
+	}
+	return nil
+}
+
+func (x *IngestionJob) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *IngestionJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *IngestionJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *IngestionJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *IngestionJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type ListIngestionJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KbId          string                 `protobuf:"bytes,1,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngestionJobsRequest) Reset() {
+	*x = ListIngestionJobsRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngestionJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngestionJobsRequest) ProtoMessage() {}
+
+func (x *ListIngestionJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngestionJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListIngestionJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListIngestionJobsRequest) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *ListIngestionJobsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListIngestionJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListIngestionJobsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListIngestionJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*IngestionJob        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngestionJobsResponse) Reset() {
+	*x = ListIngestionJobsResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngestionJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngestionJobsResponse) ProtoMessage() {}
+
+func (x *ListIngestionJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngestionJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListIngestionJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListIngestionJobsResponse) GetItems() []*IngestionJob {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetIngestionJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIngestionJobRequest) Reset() {
+	*x = GetIngestionJobRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIngestionJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIngestionJobRequest) ProtoMessage() {}
+
+func (x *GetIngestionJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIngestionJobRequest.ProtoReflect.Descriptor instead.
+func (*GetIngestionJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetIngestionJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RetryIngestionJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryIngestionJobRequest) Reset() {
+	*x = RetryIngestionJobRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryIngestionJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryIngestionJobRequest) ProtoMessage() {}
+
+func (x *RetryIngestionJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryIngestionJobRequest.ProtoReflect.Descriptor instead.
+func (*RetryIngestionJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RetryIngestionJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelIngestionJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelIngestionJobRequest) Reset() {
+	*x = CancelIngestionJobRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelIngestionJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelIngestionJobRequest) ProtoMessage() {}
+
+func (x *CancelIngestionJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelIngestionJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelIngestionJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CancelIngestionJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type IngestionJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *IngestionJob          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestionJobResponse) Reset() {
+	*x = IngestionJobResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestionJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestionJobResponse) ProtoMessage() {}
+
+func (x *IngestionJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestionJobResponse.ProtoReflect.Descriptor instead.
+func (*IngestionJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *IngestionJobResponse) GetJob() *IngestionJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// ImportBatch is a bulk import created by POST /console/v1/documents/import.
+type ImportBatch struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	KbId     string                 `protobuf:"bytes,3,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	// none | tags | section
+	FolderMode string `protobuf:"bytes,4,opt,name=folder_mode,json=folderMode,proto3" json:"folder_mode,omitempty"`
+	// zip | files | kb_archive
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	// processing | completed | partial | failed
+	Status              string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	TotalFiles          int32                  `protobuf:"varint,7,opt,name=total_files,json=totalFiles,proto3" json:"total_files,omitempty"`
+	SkippedFiles        int32                  `protobuf:"varint,8,opt,name=skipped_files,json=skippedFiles,proto3" json:"skipped_files,omitempty"`
+	FailedFiles         int32                  `protobuf:"varint,9,opt,name=failed_files,json=failedFiles,proto3" json:"failed_files,omitempty"`
+	DocumentsProcessing int32                  `protobuf:"varint,10,opt,name=documents_processing,json=documentsProcessing,proto3" json:"documents_processing,omitempty"`
+	DocumentsReady      int32                  `protobuf:"varint,11,opt,name=documents_ready,json=documentsReady,proto3" json:"documents_ready,omitempty"`
+	DocumentsFailed     int32                  `protobuf:"varint,12,opt,name=documents_failed,json=documentsFailed,proto3" json:"documents_failed,omitempty"`
+	Items               []*ImportBatchItem     `protobuf:"bytes,13,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ImportBatch) Reset() {
+	*x = ImportBatch{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBatch) ProtoMessage() {}
+
+func (x *ImportBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBatch.ProtoReflect.Descriptor instead.
+func (*ImportBatch) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ImportBatch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportBatch) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ImportBatch) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *ImportBatch) GetFolderMode() string {
+	if x != nil {
+		return x.FolderMode
+	}
+	return ""
+}
+
+func (x *ImportBatch) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportBatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportBatch) GetTotalFiles() int32 {
+	if x != nil {
+		return x.TotalFiles
+	}
+	return 0
+}
+
+func (x *ImportBatch) GetSkippedFiles() int32 {
+	if x != nil {
+		return x.SkippedFiles
+	}
+	return 0
+}
+
+func (x *ImportBatch) GetFailedFiles() int32 {
+	if x != nil {
+		return x.FailedFiles
+	}
+	return 0
+}
+
+func (x *ImportBatch) GetDocumentsProcessing() int32 {
+	if x != nil {
+		return x.DocumentsProcessing
+	}
+	return 0
+}
+
+func (x *ImportBatch) GetDocumentsReady() int32 {
+	if x != nil {
+		return x.DocumentsReady
+	}
+	return 0
+}
+
+func (x *ImportBatch) GetDocumentsFailed() int32 {
+	if x != nil {
+		return x.DocumentsFailed
+	}
+	return 0
+}
+
+func (x *ImportBatch) GetItems() []*ImportBatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ImportBatch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ImportBatchItem struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Path       string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	SourceType string                 `protobuf:"bytes,2,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	DocumentId string                 `protobuf:"bytes,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// created | failed | skipped
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Current status of the created document; empty once it is deleted.
+	DocumentStatus string `protobuf:"bytes,5,opt,name=document_status,json=documentStatus,proto3" json:"document_status,omitempty"`
+	ErrorMessage   string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportBatchItem) Reset() {
+	*x = ImportBatchItem{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBatchItem) ProtoMessage() {}
+
+func (x *ImportBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBatchItem.ProtoReflect.Descriptor instead.
+func (*ImportBatchItem) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ImportBatchItem) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ImportBatchItem) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *ImportBatchItem) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *ImportBatchItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportBatchItem) GetDocumentStatus() string {
+	if x != nil {
+		return x.DocumentStatus
+	}
+	return ""
+}
+
+func (x *ImportBatchItem) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetImportBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportBatchRequest) Reset() {
+	*x = GetImportBatchRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportBatchRequest) ProtoMessage() {}
+
+func (x *GetImportBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetImportBatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetImportBatchRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ImportBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *ImportBatch           `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBatchResponse) Reset() {
+	*x = ImportBatchResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBatchResponse) ProtoMessage() {}
+
+func (x *ImportBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBatchResponse.ProtoReflect.Descriptor instead.
+func (*ImportBatchResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ImportBatchResponse) GetBatch() *ImportBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+// KnowledgeBaseImportResponse is returned by POST
+// /console/v1/knowledge_bases/import and knowledge base clones. The batch has
+// one item per archived document.
+type KnowledgeBaseImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeBase *KnowledgeBase         `protobuf:"bytes,1,opt,name=knowledge_base,json=knowledgeBase,proto3" json:"knowledge_base,omitempty"`
+	Batch         *ImportBatch           `protobuf:"bytes,2,opt,name=batch,proto3" json:"batch,omitempty"`
+	// Documents indexed from the archived chunks and vectors.
+	RestoredDocuments int32 `protobuf:"varint,3,opt,name=restored_documents,json=restoredDocuments,proto3" json:"restored_documents,omitempty"`
+	// Documents queued for parsing and embedding.
+	ReembeddedDocuments int32 `protobuf:"varint,4,opt,name=reembedded_documents,json=reembeddedDocuments,proto3" json:"reembedded_documents,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *KnowledgeBaseImportResponse) Reset() {
+	*x = KnowledgeBaseImportResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KnowledgeBaseImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeBaseImportResponse) ProtoMessage() {}
+
+func (x *KnowledgeBaseImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeBaseImportResponse.ProtoReflect.Descriptor instead.
+func (*KnowledgeBaseImportResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *KnowledgeBaseImportResponse) GetKnowledgeBase() *KnowledgeBase {
+	if x != nil {
+		return x.KnowledgeBase
+	}
+	return nil
+}
+
+func (x *KnowledgeBaseImportResponse) GetBatch() *ImportBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *KnowledgeBaseImportResponse) GetRestoredDocuments() int32 {
+	if x != nil {
+		return x.RestoredDocuments
+	}
+	return 0
+}
+
+func (x *KnowledgeBaseImportResponse) GetReembeddedDocuments() int32 {
+	if x != nil {
+		return x.ReembeddedDocuments
+	}
+	return 0
+}
+
+type GetDuplicateReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KbId  string                 `protobuf:"bytes,1,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	// Maximum clusters of each kind; default 50.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDuplicateReportRequest) Reset() {
+	*x = GetDuplicateReportRequest{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDuplicateReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDuplicateReportRequest) ProtoMessage() {}
+
+func (x *GetDuplicateReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDuplicateReportRequest.ProtoReflect.Descriptor instead.
+func (*GetDuplicateReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetDuplicateReportRequest) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *GetDuplicateReportRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DuplicateChunk struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChunkId        string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	DocumentId     string                 `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	DocumentTitle  string                 `protobuf:"bytes,3,opt,name=document_title,json=documentTitle,proto3" json:"document_title,omitempty"`
+	ContentPreview string                 `protobuf:"bytes,4,opt,name=content_preview,json=contentPreview,proto3" json:"content_preview,omitempty"`
+	// exact | simhash | embedding; empty for the original chunk.
+	Method  string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Skipped bool   `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// The chunk this one duplicates is gone; reindex the document to index it.
+	OriginalMissing bool `protobuf:"varint,7,opt,name=original_missing,json=originalMissing,proto3" json:"original_missing,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DuplicateChunk) Reset() {
+	*x = DuplicateChunk{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateChunk) ProtoMessage() {}
+
+func (x *DuplicateChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateChunk.ProtoReflect.Descriptor instead.
+func (*DuplicateChunk) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DuplicateChunk) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *DuplicateChunk) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DuplicateChunk) GetDocumentTitle() string {
+	if x != nil {
+		return x.DocumentTitle
+	}
+	return ""
+}
+
+func (x *DuplicateChunk) GetContentPreview() string {
+	if x != nil {
+		return x.ContentPreview
+	}
+	return ""
+}
+
+func (x *DuplicateChunk) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *DuplicateChunk) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *DuplicateChunk) GetOriginalMissing() bool {
+	if x != nil {
+		return x.OriginalMissing
+	}
+	return false
+}
+
+type DuplicateChunkCluster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunks        []*DuplicateChunk      `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateChunkCluster) Reset() {
+	*x = DuplicateChunkCluster{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateChunkCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateChunkCluster) ProtoMessage() {}
+
+func (x *DuplicateChunkCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateChunkCluster.ProtoReflect.Descriptor instead.
+func (*DuplicateChunkCluster) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DuplicateChunkCluster) GetChunks() []*DuplicateChunk {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+type DuplicateDocumentPair struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId             string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	DocumentTitle          string                 `protobuf:"bytes,2,opt,name=document_title,json=documentTitle,proto3" json:"document_title,omitempty"`
+	DuplicateDocumentId    string                 `protobuf:"bytes,3,opt,name=duplicate_document_id,json=duplicateDocumentId,proto3" json:"duplicate_document_id,omitempty"`
+	DuplicateDocumentTitle string                 `protobuf:"bytes,4,opt,name=duplicate_document_title,json=duplicateDocumentTitle,proto3" json:"duplicate_document_title,omitempty"`
+	DuplicateChunks        int32                  `protobuf:"varint,5,opt,name=duplicate_chunks,json=duplicateChunks,proto3" json:"duplicate_chunks,omitempty"`
+	TotalChunks            int32                  `protobuf:"varint,6,opt,name=total_chunks,json=totalChunks,proto3" json:"total_chunks,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DuplicateDocumentPair) Reset() {
+	*x = DuplicateDocumentPair{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateDocumentPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateDocumentPair) ProtoMessage() {}
+
+func (x *DuplicateDocumentPair) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateDocumentPair.ProtoReflect.Descriptor instead.
+func (*DuplicateDocumentPair) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DuplicateDocumentPair) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *DuplicateDocumentPair) GetDocumentTitle() string {
+	if x != nil {
+		return x.DocumentTitle
+	}
+	return ""
+}
+
+func (x *DuplicateDocumentPair) GetDuplicateDocumentId() string {
+	if x != nil {
+		return x.DuplicateDocumentId
+	}
+	return ""
+}
+
+func (x *DuplicateDocumentPair) GetDuplicateDocumentTitle() string {
+	if x != nil {
+		return x.DuplicateDocumentTitle
+	}
+	return ""
+}
+
+func (x *DuplicateDocumentPair) GetDuplicateChunks() int32 {
+	if x != nil {
+		return x.DuplicateChunks
+	}
+	return 0
+}
+
+func (x *DuplicateDocumentPair) GetTotalChunks() int32 {
+	if x != nil {
+		return x.TotalChunks
+	}
+	return 0
+}
+
+type DuplicateDocumentCluster struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pairs         []*DuplicateDocumentPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateDocumentCluster) Reset() {
+	*x = DuplicateDocumentCluster{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateDocumentCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateDocumentCluster) ProtoMessage() {}
+
+func (x *DuplicateDocumentCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateDocumentCluster.ProtoReflect.Descriptor instead.
+func (*DuplicateDocumentCluster) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DuplicateDocumentCluster) GetPairs() []*DuplicateDocumentPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+type DuplicateReport struct {
+	state            protoimpl.MessageState      `protogen:"open.v1"`
+	KbId             string                      `protobuf:"bytes,1,opt,name=kb_id,json=kbId,proto3" json:"kb_id,omitempty"`
+	Links            int32                       `protobuf:"varint,2,opt,name=links,proto3" json:"links,omitempty"`
+	SkippedChunks    int32                       `protobuf:"varint,3,opt,name=skipped_chunks,json=skippedChunks,proto3" json:"skipped_chunks,omitempty"`
+	ChunkClusters    []*DuplicateChunkCluster    `protobuf:"bytes,4,rep,name=chunk_clusters,json=chunkClusters,proto3" json:"chunk_clusters,omitempty"`
+	DocumentClusters []*DuplicateDocumentCluster `protobuf:"bytes,5,rep,name=document_clusters,json=documentClusters,proto3" json:"document_clusters,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DuplicateReport) Reset() {
+	*x = DuplicateReport{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateReport) ProtoMessage() {}
+
+func (x *DuplicateReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateReport.ProtoReflect.Descriptor instead.
+func (*DuplicateReport) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DuplicateReport) GetKbId() string {
+	if x != nil {
+		return x.KbId
+	}
+	return ""
+}
+
+func (x *DuplicateReport) GetLinks() int32 {
+	if x != nil {
+		return x.Links
+	}
+	return 0
+}
+
+func (x *DuplicateReport) GetSkippedChunks() int32 {
+	if x != nil {
+		return x.SkippedChunks
+	}
+	return 0
+}
+
+func (x *DuplicateReport) GetChunkClusters() []*DuplicateChunkCluster {
+	if x != nil {
+		return x.ChunkClusters
+	}
+	return nil
+}
+
+func (x *DuplicateReport) GetDocumentClusters() []*DuplicateDocumentCluster {
+	if x != nil {
+		return x.DocumentClusters
+	}
+	return nil
+}
+
+type DuplicateReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *DuplicateReport       `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateReportResponse) Reset() {
+	*x = DuplicateReportResponse{}
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateReportResponse) ProtoMessage() {}
+
+func (x *DuplicateReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_knowledge_v1_console_knowledge_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateReportResponse.ProtoReflect.Descriptor instead.
+func (*DuplicateReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DuplicateReportResponse) GetReport() *DuplicateReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+var File_api_knowledge_v1_console_knowledge_proto protoreflect.FileDescriptor
+
+const file_api_knowledge_v1_console_knowledge_proto_rawDesc = "" +
+	"\n" +
+	"(api/knowledge/v1/console_knowledge.proto\x12\x10api.knowledge.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xc7\x03\n" +
+	"\rKnowledgeBase\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12<\n" +
+	"\bchunking\x18\a \x01(\v2 .api.knowledge.v1.ChunkingConfigR\bchunking\x12?\n" +
+	"\tembedding\x18\b \x01(\v2!.api.knowledge.v1.EmbeddingConfigR\tembedding\x12)\n" +
+	"\x10index_generation\x18\t \x01(\x05R\x0findexGeneration\x123\n" +
+	"\x05dedup\x18\n" +
+	" \x01(\v2\x1d.api.knowledge.v1.DedupConfigR\x05dedup\"\xfd\x01\n" +
+	"\x0eChunkingConfig\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x12\x1d\n" +
+	"\n" +
+	"max_tokens\x18\x02 \x01(\x05R\tmaxTokens\x12%\n" +
+	"\x0eoverlap_tokens\x18\x03 \x01(\x05R\roverlapTokens\x12)\n" +
+	"\x10window_sentences\x18\x04 \x01(\x05R\x0fwindowSentences\x12+\n" +
+	"\x11overlap_sentences\x18\x05 \x01(\x05R\x10overlapSentences\x121\n" +
+	"\x14similarity_threshold\x18\x06 \x01(\x01R\x13similarityThreshold\"U\n" +
+	"\x0fEmbeddingConfig\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x10\n" +
+	"\x03dim\x18\x03 \x01(\x05R\x03dim\"w\n" +
+	"\vDedupConfig\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12!\n" +
+	"\fmax_distance\x18\x02 \x01(\x05R\vmaxDistance\x121\n" +
+	"\x14similarity_threshold\x18\x03 \x01(\x01R\x13similarityThreshold\"\xc4\x01\n" +
+	"\x10BotKnowledgeBase\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06bot_id\x18\x03 \x01(\tR\x05botId\x12\x13\n" +
+	"\x05kb_id\x18\x04 \x01(\tR\x04kbId\x12\x16\n" +
+	"\x06weight\x18\x06 \x01(\x01R\x06weight\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtJ\x04\b\x05\x10\x06\"\x9a\x03\n" +
+	"\bDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x13\n" +
+	"\x05kb_id\x18\x03 \x01(\tR\x04kbId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1f\n" +
+	"\vsource_type\x18\x05 \x01(\tR\n" +
+	"sourceType\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12'\n" +
+	"\x0fcurrent_version\x18\a \x01(\x05R\x0ecurrentVersion\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12%\n" +
+	"\x0esection_prefix\x18\v \x01(\tR\rsectionPrefix\x12#\n" +
+	"\raccess_labels\x18\f \x03(\tR\faccessLabels\"\xc1\x02\n" +
+	"\x0fDocumentVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1f\n" +
+	"\vdocument_id\x18\x03 \x01(\tR\n" +
+	"documentId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\rreused_chunks\x18\a \x01(\x05R\freusedChunks\x12'\n" +
+	"\x0fembedded_chunks\x18\b \x01(\x05R\x0eembeddedChunks\x12%\n" +
+	"\x0eskipped_chunks\x18\t \x01(\x05R\rskippedChunks\"\x86\x02\n" +
+	"\x1aCreateKnowledgeBaseRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12<\n" +
+	"\bchunking\x18\x03 \x01(\v2 .api.knowledge.v1.ChunkingConfigR\bchunking\x12?\n" +
+	"\tembedding\x18\x04 \x01(\v2!.api.knowledge.v1.EmbeddingConfigR\tembedding\x123\n" +
+	"\x05dedup\x18\x05 \x01(\v2\x1d.api.knowledge.v1.DedupConfigR\x05dedup\")\n" +
+	"\x17GetKnowledgeBaseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x96\x02\n" +
+	"\x1aUpdateKnowledgeBaseRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\",\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12<\n" +
+	"\bchunking\x18\x04 \x01(\v2 .api.knowledge.v1.ChunkingConfigR\bchunking\x12?\n" +
+	"\tembedding\x18\x05 \x01(\v2!.api.knowledge.v1.EmbeddingConfigR\tembedding\x123\n" +
+	"\x05dedup\x18\x06 \x01(\v2\x1d.api.knowledge.v1.DedupConfigR\x05dedup\",\n" +
 	"\x1aDeleteKnowledgeBaseRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
 	"\x19ListKnowledgeBasesRequest\"S\n" +
@@ -1579,13 +5084,14 @@ const file_api_knowledge_v1_console_knowledge_proto_rawDesc = "" +
 	"\x15KnowledgeBaseResponse\x12F\n" +
 	"\x0eknowledge_base\x18\x01 \x01(\v2\x1f.api.knowledge.v1.KnowledgeBaseR\rknowledgeBase\"U\n" +
 	"\x18BotKnowledgeBaseResponse\x129\n" +
-	"\x06bot_kb\x18\x01 \x01(\v2\".api.knowledge.v1.BotKnowledgeBaseR\x05botKb\"|\n" +
+	"\x06bot_kb\x18\x01 \x01(\v2\".api.knowledge.v1.BotKnowledgeBaseR\x05botKb\"\xa1\x01\n" +
 	"\x15UploadDocumentRequest\x12\x13\n" +
 	"\x05kb_id\x18\x01 \x01(\tR\x04kbId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
 	"\vsource_type\x18\x03 \x01(\tR\n" +
 	"sourceType\x12\x17\n" +
-	"\araw_uri\x18\x04 \x01(\tR\x06rawUri\"\x8d\x01\n" +
+	"\araw_uri\x18\x04 \x01(\tR\x06rawUri\x12#\n" +
+	"\raccess_labels\x18\x05 \x03(\tR\faccessLabels\"\x8d\x01\n" +
 	"\x16UploadDocumentResponse\x126\n" +
 	"\bdocument\x18\x01 \x01(\v2\x1a.api.knowledge.v1.DocumentR\bdocument\x12;\n" +
 	"\aversion\x18\x02 \x01(\v2!.api.knowledge.v1.DocumentVersionR\aversion\"$\n" +
@@ -1600,19 +5106,283 @@ const file_api_knowledge_v1_console_knowledge_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x13\n" +
 	"\x05kb_id\x18\x02 \x01(\tR\x04kbId\"J\n" +
 	"\x10DocumentResponse\x126\n" +
-	"\bdocument\x18\x01 \x01(\v2\x1a.api.knowledge.v1.DocumentR\bdocument\"(\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1a.api.knowledge.v1.DocumentR\bdocument\"U\n" +
+	"\x1eSetDocumentAccessLabelsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\raccess_labels\x18\x02 \x03(\tR\faccessLabels\"(\n" +
 	"\x16ReindexDocumentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
 	"\x17RollbackDocumentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\x05R\aversion\"g\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"o\n" +
+	"\x1bDiffDocumentVersionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\x05R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x05R\ttoVersion\"\xeb\x01\n" +
+	"\tDiffChunk\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vchunk_index\x18\x02 \x01(\x05R\n" +
+	"chunkIndex\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12!\n" +
+	"\fcontent_hash\x18\x04 \x01(\tR\vcontentHash\x12\x18\n" +
+	"\asection\x18\x05 \x01(\tR\asection\x12\x17\n" +
+	"\apage_no\x18\x06 \x01(\x05R\x06pageNo\x12\x1f\n" +
+	"\vtoken_count\x18\a \x01(\x05R\n" +
+	"tokenCount\x12\x1c\n" +
+	"\tcitations\x18\b \x01(\x05R\tcitations\"k\n" +
+	"\vChunkChange\x12/\n" +
+	"\x04from\x18\x01 \x01(\v2\x1b.api.knowledge.v1.DiffChunkR\x04from\x12+\n" +
+	"\x02to\x18\x02 \x01(\v2\x1b.api.knowledge.v1.DiffChunkR\x02to\"\xe6\x01\n" +
+	"\rChunkCitation\x12\x19\n" +
+	"\bchunk_id\x18\x01 \x01(\tR\achunkId\x12\x1f\n" +
+	"\vchunk_index\x18\x02 \x01(\x05R\n" +
+	"chunkIndex\x12!\n" +
+	"\fcontent_hash\x18\x03 \x01(\tR\vcontentHash\x12\x1c\n" +
+	"\tcitations\x18\x04 \x01(\x05R\tcitations\x12>\n" +
+	"\rlast_cited_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastCitedAt\x12\x18\n" +
+	"\asnippet\x18\x06 \x01(\tR\asnippet\"\xbc\x01\n" +
+	"\x17DocumentVersionDiffSide\x12;\n" +
+	"\aversion\x18\x01 \x01(\v2!.api.knowledge.v1.DocumentVersionR\aversion\x12%\n" +
+	"\x0echunks_rebuilt\x18\x02 \x01(\bR\rchunksRebuilt\x12=\n" +
+	"\tcitations\x18\x03 \x03(\v2\x1f.api.knowledge.v1.ChunkCitationR\tcitations\"\xcd\x03\n" +
+	"\x13DocumentVersionDiff\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\x12=\n" +
+	"\x04from\x18\x02 \x01(\v2).api.knowledge.v1.DocumentVersionDiffSideR\x04from\x129\n" +
+	"\x02to\x18\x03 \x01(\v2).api.knowledge.v1.DocumentVersionDiffSideR\x02to\x121\n" +
+	"\x05added\x18\x04 \x03(\v2\x1b.api.knowledge.v1.DiffChunkR\x05added\x125\n" +
+	"\aremoved\x18\x05 \x03(\v2\x1b.api.knowledge.v1.DiffChunkR\aremoved\x129\n" +
+	"\bmodified\x18\x06 \x03(\v2\x1d.api.knowledge.v1.ChunkChangeR\bmodified\x12)\n" +
+	"\x10unchanged_chunks\x18\a \x01(\x05R\x0funchangedChunks\x12\x1b\n" +
+	"\ttext_diff\x18\b \x01(\tR\btextDiff\x12.\n" +
+	"\x13text_diff_truncated\x18\t \x01(\bR\x11textDiffTruncated\"X\n" +
+	"\x1bDocumentVersionDiffResponse\x129\n" +
+	"\x04diff\x18\x01 \x01(\v2%.api.knowledge.v1.DocumentVersionDiffR\x04diff\"\xd4\x03\n" +
+	"\x05Chunk\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\x12.\n" +
+	"\x13document_version_id\x18\x03 \x01(\tR\x11documentVersionId\x12%\n" +
+	"\x0edocument_title\x18\x04 \x01(\tR\rdocumentTitle\x12\x1f\n" +
+	"\vchunk_index\x18\x05 \x01(\x05R\n" +
+	"chunkIndex\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\tR\acontent\x12!\n" +
+	"\fcontent_hash\x18\a \x01(\tR\vcontentHash\x12\x18\n" +
+	"\asection\x18\b \x01(\tR\asection\x12\x17\n" +
+	"\apage_no\x18\t \x01(\x05R\x06pageNo\x12\x1f\n" +
+	"\vtoken_count\x18\n" +
+	" \x01(\x05R\n" +
+	"tokenCount\x12\x1a\n" +
+	"\blanguage\x18\v \x01(\tR\blanguage\x12\x1a\n" +
+	"\bexcluded\x18\f \x01(\bR\bexcluded\x12\x1a\n" +
+	"\bkeywords\x18\r \x03(\tR\bkeywords\x12\x1f\n" +
+	"\vorigin_hash\x18\x0e \x01(\tR\n" +
+	"originHash\x12\x1c\n" +
+	"\tcitations\x18\x0f \x01(\x05R\tcitations\"s\n" +
+	"\x19ListDocumentChunksRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"n\n" +
+	"\x13SearchChunksRequest\x12\x13\n" +
+	"\x05kb_id\x18\x01 \x01(\tR\x04kbId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"Y\n" +
+	"\x12ListChunksResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.api.knowledge.v1.ChunkR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"'\n" +
+	"\rChunkKeywords\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xd1\x01\n" +
+	"\x12UpdateChunkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\acontent\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\acontent\x126\n" +
+	"\bexcluded\x18\x03 \x01(\v2\x1a.google.protobuf.BoolValueR\bexcluded\x12;\n" +
+	"\bkeywords\x18\x04 \x01(\v2\x1f.api.knowledge.v1.ChunkKeywordsR\bkeywords\">\n" +
+	"\rChunkResponse\x12-\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x17.api.knowledge.v1.ChunkR\x05chunk\"U\n" +
+	"\x15ListChunkEditsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\x81\x01\n" +
+	"\n" +
+	"ChunkState\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12!\n" +
+	"\fcontent_hash\x18\x02 \x01(\tR\vcontentHash\x12\x1a\n" +
+	"\bexcluded\x18\x03 \x01(\bR\bexcluded\x12\x1a\n" +
+	"\bkeywords\x18\x04 \x03(\tR\bkeywords\"\xe8\x02\n" +
+	"\tChunkEdit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\x12.\n" +
+	"\x13document_version_id\x18\x03 \x01(\tR\x11documentVersionId\x12\x19\n" +
+	"\bchunk_id\x18\x04 \x01(\tR\achunkId\x12\x1f\n" +
+	"\vorigin_hash\x18\x05 \x01(\tR\n" +
+	"originHash\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\tR\aactorId\x124\n" +
+	"\x06before\x18\a \x01(\v2\x1c.api.knowledge.v1.ChunkStateR\x06before\x122\n" +
+	"\x05after\x18\b \x01(\v2\x1c.api.knowledge.v1.ChunkStateR\x05after\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"K\n" +
+	"\x16ListChunkEditsResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.api.knowledge.v1.ChunkEditR\x05items\"g\n" +
 	"\x1bBindBotKnowledgeBaseRequest\x12\x15\n" +
 	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x13\n" +
 	"\x05kb_id\x18\x02 \x01(\tR\x04kbId\x12\x16\n" +
 	"\x06weight\x18\x04 \x01(\x01R\x06weightJ\x04\b\x03\x10\x04\"K\n" +
 	"\x1dUnbindBotKnowledgeBaseRequest\x12\x15\n" +
 	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x13\n" +
-	"\x05kb_id\x18\x02 \x01(\tR\x04kbId2\x89\x11\n" +
+	"\x05kb_id\x18\x02 \x01(\tR\x04kbId\"\xee\x04\n" +
+	"\x16KnowledgeBaseMigration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x13\n" +
+	"\x05kb_id\x18\x03 \x01(\tR\x04kbId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x05 \x01(\x05R\n" +
+	"generation\x12<\n" +
+	"\bchunking\x18\x06 \x01(\v2 .api.knowledge.v1.ChunkingConfigR\bchunking\x12?\n" +
+	"\tembedding\x18\a \x01(\v2!.api.knowledge.v1.EmbeddingConfigR\tembedding\x12'\n" +
+	"\x0ftotal_documents\x18\b \x01(\x05R\x0etotalDocuments\x12/\n" +
+	"\x13processed_documents\x18\t \x01(\x05R\x12processedDocuments\x12)\n" +
+	"\x10failed_documents\x18\n" +
+	" \x01(\x05R\x0ffailedDocuments\x12#\n" +
+	"\rerror_message\x18\v \x01(\tR\ferrorMessage\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vfinished_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"\xb8\x01\n" +
+	"\"StartKnowledgeBaseMigrationRequest\x12\x13\n" +
+	"\x05kb_id\x18\x01 \x01(\tR\x04kbId\x12<\n" +
+	"\bchunking\x18\x02 \x01(\v2 .api.knowledge.v1.ChunkingConfigR\bchunking\x12?\n" +
+	"\tembedding\x18\x03 \x01(\v2!.api.knowledge.v1.EmbeddingConfigR\tembedding\"G\n" +
+	" GetKnowledgeBaseMigrationRequest\x12\x13\n" +
+	"\x05kb_id\x18\x01 \x01(\tR\x04kbId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"9\n" +
+	"\"ListKnowledgeBaseMigrationsRequest\x12\x13\n" +
+	"\x05kb_id\x18\x01 \x01(\tR\x04kbId\"e\n" +
+	"#ListKnowledgeBaseMigrationsResponse\x12>\n" +
+	"\x05items\x18\x01 \x03(\v2(.api.knowledge.v1.KnowledgeBaseMigrationR\x05items\"J\n" +
+	"#CancelKnowledgeBaseMigrationRequest\x12\x13\n" +
+	"\x05kb_id\x18\x01 \x01(\tR\x04kbId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"h\n" +
+	"\x1eKnowledgeBaseMigrationResponse\x12F\n" +
+	"\tmigration\x18\x01 \x01(\v2(.api.knowledge.v1.KnowledgeBaseMigrationR\tmigration\"\x96\x06\n" +
+	"\fIngestionJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x13\n" +
+	"\x05kb_id\x18\x03 \x01(\tR\x04kbId\x12\x1f\n" +
+	"\vdocument_id\x18\x04 \x01(\tR\n" +
+	"documentId\x12.\n" +
+	"\x13document_version_id\x18\x05 \x01(\tR\x11documentVersionId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12!\n" +
+	"\fcurrent_step\x18\a \x01(\tR\vcurrentStep\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12\x1f\n" +
+	"\vchunk_count\x18\t \x01(\x05R\n" +
+	"chunkCount\x12#\n" +
+	"\rreused_chunks\x18\n" +
+	" \x01(\x05R\freusedChunks\x12'\n" +
+	"\x0fembedded_chunks\x18\v \x01(\x05R\x0eembeddedChunks\x12X\n" +
+	"\x0estep_durations\x18\f \x03(\v21.api.knowledge.v1.IngestionJob.StepDurationsEntryR\rstepDurations\x12#\n" +
+	"\rerror_message\x18\r \x01(\tR\ferrorMessage\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x1a@\n" +
+	"\x12StepDurationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"u\n" +
+	"\x18ListIngestionJobsRequest\x12\x13\n" +
+	"\x05kb_id\x18\x01 \x01(\tR\x04kbId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"Q\n" +
+	"\x19ListIngestionJobsResponse\x124\n" +
+	"\x05items\x18\x01 \x03(\v2\x1e.api.knowledge.v1.IngestionJobR\x05items\"(\n" +
+	"\x16GetIngestionJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x18RetryIngestionJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x19CancelIngestionJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x14IngestionJobResponse\x120\n" +
+	"\x03job\x18\x01 \x01(\v2\x1e.api.knowledge.v1.IngestionJobR\x03job\"\x84\x04\n" +
+	"\vImportBatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x13\n" +
+	"\x05kb_id\x18\x03 \x01(\tR\x04kbId\x12\x1f\n" +
+	"\vfolder_mode\x18\x04 \x01(\tR\n" +
+	"folderMode\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vtotal_files\x18\a \x01(\x05R\n" +
+	"totalFiles\x12#\n" +
+	"\rskipped_files\x18\b \x01(\x05R\fskippedFiles\x12!\n" +
+	"\ffailed_files\x18\t \x01(\x05R\vfailedFiles\x121\n" +
+	"\x14documents_processing\x18\n" +
+	" \x01(\x05R\x13documentsProcessing\x12'\n" +
+	"\x0fdocuments_ready\x18\v \x01(\x05R\x0edocumentsReady\x12)\n" +
+	"\x10documents_failed\x18\f \x01(\x05R\x0fdocumentsFailed\x127\n" +
+	"\x05items\x18\r \x03(\v2!.api.knowledge.v1.ImportBatchItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xcd\x01\n" +
+	"\x0fImportBatchItem\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1f\n" +
+	"\vsource_type\x18\x02 \x01(\tR\n" +
+	"sourceType\x12\x1f\n" +
+	"\vdocument_id\x18\x03 \x01(\tR\n" +
+	"documentId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12'\n" +
+	"\x0fdocument_status\x18\x05 \x01(\tR\x0edocumentStatus\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"'\n" +
+	"\x15GetImportBatchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x13ImportBatchResponse\x123\n" +
+	"\x05batch\x18\x01 \x01(\v2\x1d.api.knowledge.v1.ImportBatchR\x05batch\"\xfc\x01\n" +
+	"\x1bKnowledgeBaseImportResponse\x12F\n" +
+	"\x0eknowledge_base\x18\x01 \x01(\v2\x1f.api.knowledge.v1.KnowledgeBaseR\rknowledgeBase\x123\n" +
+	"\x05batch\x18\x02 \x01(\v2\x1d.api.knowledge.v1.ImportBatchR\x05batch\x12-\n" +
+	"\x12restored_documents\x18\x03 \x01(\x05R\x11restoredDocuments\x121\n" +
+	"\x14reembedded_documents\x18\x04 \x01(\x05R\x13reembeddedDocuments\"F\n" +
+	"\x19GetDuplicateReportRequest\x12\x13\n" +
+	"\x05kb_id\x18\x01 \x01(\tR\x04kbId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xf9\x01\n" +
+	"\x0eDuplicateChunk\x12\x19\n" +
+	"\bchunk_id\x18\x01 \x01(\tR\achunkId\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\x12%\n" +
+	"\x0edocument_title\x18\x03 \x01(\tR\rdocumentTitle\x12'\n" +
+	"\x0fcontent_preview\x18\x04 \x01(\tR\x0econtentPreview\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x18\n" +
+	"\askipped\x18\x06 \x01(\bR\askipped\x12)\n" +
+	"\x10original_missing\x18\a \x01(\bR\x0foriginalMissing\"Q\n" +
+	"\x15DuplicateChunkCluster\x128\n" +
+	"\x06chunks\x18\x01 \x03(\v2 .api.knowledge.v1.DuplicateChunkR\x06chunks\"\x9b\x02\n" +
+	"\x15DuplicateDocumentPair\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\x12%\n" +
+	"\x0edocument_title\x18\x02 \x01(\tR\rdocumentTitle\x122\n" +
+	"\x15duplicate_document_id\x18\x03 \x01(\tR\x13duplicateDocumentId\x128\n" +
+	"\x18duplicate_document_title\x18\x04 \x01(\tR\x16duplicateDocumentTitle\x12)\n" +
+	"\x10duplicate_chunks\x18\x05 \x01(\x05R\x0fduplicateChunks\x12!\n" +
+	"\ftotal_chunks\x18\x06 \x01(\x05R\vtotalChunks\"Y\n" +
+	"\x18DuplicateDocumentCluster\x12=\n" +
+	"\x05pairs\x18\x01 \x03(\v2'.api.knowledge.v1.DuplicateDocumentPairR\x05pairs\"\x8c\x02\n" +
+	"\x0fDuplicateReport\x12\x13\n" +
+	"\x05kb_id\x18\x01 \x01(\tR\x04kbId\x12\x14\n" +
+	"\x05links\x18\x02 \x01(\x05R\x05links\x12%\n" +
+	"\x0eskipped_chunks\x18\x03 \x01(\x05R\rskippedChunks\x12N\n" +
+	"\x0echunk_clusters\x18\x04 \x03(\v2'.api.knowledge.v1.DuplicateChunkClusterR\rchunkClusters\x12W\n" +
+	"\x11document_clusters\x18\x05 \x03(\v2*.api.knowledge.v1.DuplicateDocumentClusterR\x10documentClusters\"T\n" +
+	"\x17DuplicateReportResponse\x129\n" +
+	"\x06report\x18\x01 \x01(\v2!.api.knowledge.v1.DuplicateReportR\x06report2\xd0%\n" +
 	"\x10ConsoleKnowledge\x12\x94\x01\n" +
 	"\x13CreateKnowledgeBase\x12,.api.knowledge.v1.CreateKnowledgeBaseRequest\x1a'.api.knowledge.v1.KnowledgeBaseResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/console/v1/knowledge_bases\x12\x90\x01\n" +
 	"\x10GetKnowledgeBase\x12).api.knowledge.v1.GetKnowledgeBaseRequest\x1a'.api.knowledge.v1.KnowledgeBaseResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /console/v1/knowledge_bases/{id}\x12\x99\x01\n" +
@@ -1626,9 +5396,25 @@ const file_api_knowledge_v1_console_knowledge_proto_rawDesc = "" +
 	"\x0eUploadDocument\x12'.api.knowledge.v1.UploadDocumentRequest\x1a(.api.knowledge.v1.UploadDocumentResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/console/v1/documents/upload\x12~\n" +
 	"\vGetDocument\x12$.api.knowledge.v1.GetDocumentRequest\x1a%.api.knowledge.v1.GetDocumentResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/console/v1/documents/{id}\x12u\n" +
 	"\x0eDeleteDocument\x12'.api.knowledge.v1.DeleteDocumentRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/console/v1/documents/{id}\x12\x84\x01\n" +
-	"\x0eUpdateDocument\x12'.api.knowledge.v1.UpdateDocumentRequest\x1a\".api.knowledge.v1.DocumentResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*2\x1a/console/v1/documents/{id}\x12\x82\x01\n" +
+	"\x0eUpdateDocument\x12'.api.knowledge.v1.UpdateDocumentRequest\x1a\".api.knowledge.v1.DocumentResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*2\x1a/console/v1/documents/{id}\x12\xa4\x01\n" +
+	"\x17SetDocumentAccessLabels\x120.api.knowledge.v1.SetDocumentAccessLabelsRequest\x1a\".api.knowledge.v1.DocumentResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\x1a(/console/v1/documents/{id}/access_labels\x12\x82\x01\n" +
 	"\x0fReindexDocument\x12(.api.knowledge.v1.ReindexDocumentRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/console/v1/documents/{id}/reindex\x12\x85\x01\n" +
-	"\x10RollbackDocument\x12).api.knowledge.v1.RollbackDocumentRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(:\x01*\"#/console/v1/documents/{id}/rollbackB:Z8github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1;v1b\x06proto3"
+	"\x10RollbackDocument\x12).api.knowledge.v1.RollbackDocumentRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(:\x01*\"#/console/v1/documents/{id}/rollback\x12\x9d\x01\n" +
+	"\x14DiffDocumentVersions\x12-.api.knowledge.v1.DiffDocumentVersionsRequest\x1a-.api.knowledge.v1.DocumentVersionDiffResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/console/v1/documents/{id}/diff\x12\x92\x01\n" +
+	"\x12ListDocumentChunks\x12+.api.knowledge.v1.ListDocumentChunksRequest\x1a$.api.knowledge.v1.ListChunksResponse\")\x82\xd3\xe4\x93\x02#\x12!/console/v1/documents/{id}/chunks\x12\x96\x01\n" +
+	"\fSearchChunks\x12%.api.knowledge.v1.SearchChunksRequest\x1a$.api.knowledge.v1.ListChunksResponse\"9\x82\xd3\xe4\x93\x023\x121/console/v1/knowledge_bases/{kb_id}/chunks/search\x12x\n" +
+	"\vUpdateChunk\x12$.api.knowledge.v1.UpdateChunkRequest\x1a\x1f.api.knowledge.v1.ChunkResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/console/v1/chunks/{id}\x12\x93\x01\n" +
+	"\x0eListChunkEdits\x12'.api.knowledge.v1.ListChunkEditsRequest\x1a(.api.knowledge.v1.ListChunkEditsResponse\".\x82\xd3\xe4\x93\x02(\x12&/console/v1/documents/{id}/chunk_edits\x12\xc0\x01\n" +
+	"\x1bStartKnowledgeBaseMigration\x124.api.knowledge.v1.StartKnowledgeBaseMigrationRequest\x1a0.api.knowledge.v1.KnowledgeBaseMigrationResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./console/v1/knowledge_bases/{kb_id}/migrations\x12\xbe\x01\n" +
+	"\x19GetKnowledgeBaseMigration\x122.api.knowledge.v1.GetKnowledgeBaseMigrationRequest\x1a0.api.knowledge.v1.KnowledgeBaseMigrationResponse\";\x82\xd3\xe4\x93\x025\x123/console/v1/knowledge_bases/{kb_id}/migrations/{id}\x12\xc2\x01\n" +
+	"\x1bListKnowledgeBaseMigrations\x124.api.knowledge.v1.ListKnowledgeBaseMigrationsRequest\x1a5.api.knowledge.v1.ListKnowledgeBaseMigrationsResponse\"6\x82\xd3\xe4\x93\x020\x12./console/v1/knowledge_bases/{kb_id}/migrations\x12\xce\x01\n" +
+	"\x1cCancelKnowledgeBaseMigration\x125.api.knowledge.v1.CancelKnowledgeBaseMigrationRequest\x1a0.api.knowledge.v1.KnowledgeBaseMigrationResponse\"E\x82\xd3\xe4\x93\x02?:\x01*\":/console/v1/knowledge_bases/{kb_id}/migrations/{id}/cancel\x12\x89\x01\n" +
+	"\x0eGetImportBatch\x12'.api.knowledge.v1.GetImportBatchRequest\x1a%.api.knowledge.v1.ImportBatchResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/console/v1/import_batches/{id}\x12\xa4\x01\n" +
+	"\x12GetDuplicateReport\x12+.api.knowledge.v1.GetDuplicateReportRequest\x1a).api.knowledge.v1.DuplicateReportResponse\"6\x82\xd3\xe4\x93\x020\x12./console/v1/knowledge_bases/{kb_id}/duplicates\x12\xa8\x01\n" +
+	"\x11ListIngestionJobs\x12*.api.knowledge.v1.ListIngestionJobsRequest\x1a+.api.knowledge.v1.ListIngestionJobsResponse\":\x82\xd3\xe4\x93\x024\x122/console/v1/knowledge_bases/{kb_id}/ingestion_jobs\x12\x8c\x01\n" +
+	"\x0fGetIngestionJob\x12(.api.knowledge.v1.GetIngestionJobRequest\x1a&.api.knowledge.v1.IngestionJobResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/console/v1/ingestion_jobs/{id}\x12\x99\x01\n" +
+	"\x11RetryIngestionJob\x12*.api.knowledge.v1.RetryIngestionJobRequest\x1a&.api.knowledge.v1.IngestionJobResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/console/v1/ingestion_jobs/{id}/retry\x12\x9c\x01\n" +
+	"\x12CancelIngestionJob\x12+.api.knowledge.v1.CancelIngestionJobRequest\x1a&.api.knowledge.v1.IngestionJobResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/console/v1/ingestion_jobs/{id}/cancelB:Z8github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1;v1b\x06proto3"
 
 var (
 	file_api_knowledge_v1_console_knowledge_proto_rawDescOnce sync.Once
@@ -1642,90 +5428,228 @@ func file_api_knowledge_v1_console_knowledge_proto_rawDescGZIP() []byte {
 	return file_api_knowledge_v1_console_knowledge_proto_rawDescData
 }
 
-var file_api_knowledge_v1_console_knowledge_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_api_knowledge_v1_console_knowledge_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_api_knowledge_v1_console_knowledge_proto_goTypes = []any{
-	(*KnowledgeBase)(nil),                 // 0: api.knowledge.v1.KnowledgeBase
-	(*BotKnowledgeBase)(nil),              // 1: api.knowledge.v1.BotKnowledgeBase
-	(*Document)(nil),                      // 2: api.knowledge.v1.Document
-	(*DocumentVersion)(nil),               // 3: api.knowledge.v1.DocumentVersion
-	(*CreateKnowledgeBaseRequest)(nil),    // 4: api.knowledge.v1.CreateKnowledgeBaseRequest
-	(*GetKnowledgeBaseRequest)(nil),       // 5: api.knowledge.v1.GetKnowledgeBaseRequest
-	(*UpdateKnowledgeBaseRequest)(nil),    // 6: api.knowledge.v1.UpdateKnowledgeBaseRequest
-	(*DeleteKnowledgeBaseRequest)(nil),    // 7: api.knowledge.v1.DeleteKnowledgeBaseRequest
-	(*ListKnowledgeBasesRequest)(nil),     // 8: api.knowledge.v1.ListKnowledgeBasesRequest
-	(*ListKnowledgeBasesResponse)(nil),    // 9: api.knowledge.v1.ListKnowledgeBasesResponse
-	(*ListDocumentsRequest)(nil),          // 10: api.knowledge.v1.ListDocumentsRequest
-	(*ListDocumentsResponse)(nil),         // 11: api.knowledge.v1.ListDocumentsResponse
-	(*ListBotKnowledgeBasesRequest)(nil),  // 12: api.knowledge.v1.ListBotKnowledgeBasesRequest
-	(*ListBotKnowledgeBasesResponse)(nil), // 13: api.knowledge.v1.ListBotKnowledgeBasesResponse
-	(*KnowledgeBaseResponse)(nil),         // 14: api.knowledge.v1.KnowledgeBaseResponse
-	(*BotKnowledgeBaseResponse)(nil),      // 15: api.knowledge.v1.BotKnowledgeBaseResponse
-	(*UploadDocumentRequest)(nil),         // 16: api.knowledge.v1.UploadDocumentRequest
-	(*UploadDocumentResponse)(nil),        // 17: api.knowledge.v1.UploadDocumentResponse
-	(*GetDocumentRequest)(nil),            // 18: api.knowledge.v1.GetDocumentRequest
-	(*GetDocumentResponse)(nil),           // 19: api.knowledge.v1.GetDocumentResponse
-	(*DeleteDocumentRequest)(nil),         // 20: api.knowledge.v1.DeleteDocumentRequest
-	(*UpdateDocumentRequest)(nil),         // 21: api.knowledge.v1.UpdateDocumentRequest
-	(*DocumentResponse)(nil),              // 22: api.knowledge.v1.DocumentResponse
-	(*ReindexDocumentRequest)(nil),        // 23: api.knowledge.v1.ReindexDocumentRequest
-	(*RollbackDocumentRequest)(nil),       // 24: api.knowledge.v1.RollbackDocumentRequest
-	(*BindBotKnowledgeBaseRequest)(nil),   // 25: api.knowledge.v1.BindBotKnowledgeBaseRequest
-	(*UnbindBotKnowledgeBaseRequest)(nil), // 26: api.knowledge.v1.UnbindBotKnowledgeBaseRequest
-	(*timestamppb.Timestamp)(nil),         // 27: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                 // 28: google.protobuf.Empty
+	(*KnowledgeBase)(nil),                       // 0: api.knowledge.v1.KnowledgeBase
+	(*ChunkingConfig)(nil),                      // 1: api.knowledge.v1.ChunkingConfig
+	(*EmbeddingConfig)(nil),                     // 2: api.knowledge.v1.EmbeddingConfig
+	(*DedupConfig)(nil),                         // 3: api.knowledge.v1.DedupConfig
+	(*BotKnowledgeBase)(nil),                    // 4: api.knowledge.v1.BotKnowledgeBase
+	(*Document)(nil),                            // 5: api.knowledge.v1.Document
+	(*DocumentVersion)(nil),                     // 6: api.knowledge.v1.DocumentVersion
+	(*CreateKnowledgeBaseRequest)(nil),          // 7: api.knowledge.v1.CreateKnowledgeBaseRequest
+	(*GetKnowledgeBaseRequest)(nil),             // 8: api.knowledge.v1.GetKnowledgeBaseRequest
+	(*UpdateKnowledgeBaseRequest)(nil),          // 9: api.knowledge.v1.UpdateKnowledgeBaseRequest
+	(*DeleteKnowledgeBaseRequest)(nil),          // 10: api.knowledge.v1.DeleteKnowledgeBaseRequest
+	(*ListKnowledgeBasesRequest)(nil),           // 11: api.knowledge.v1.ListKnowledgeBasesRequest
+	(*ListKnowledgeBasesResponse)(nil),          // 12: api.knowledge.v1.ListKnowledgeBasesResponse
+	(*ListDocumentsRequest)(nil),                // 13: api.knowledge.v1.ListDocumentsRequest
+	(*ListDocumentsResponse)(nil),               // 14: api.knowledge.v1.ListDocumentsResponse
+	(*ListBotKnowledgeBasesRequest)(nil),        // 15: api.knowledge.v1.ListBotKnowledgeBasesRequest
+	(*ListBotKnowledgeBasesResponse)(nil),       // 16: api.knowledge.v1.ListBotKnowledgeBasesResponse
+	(*KnowledgeBaseResponse)(nil),               // 17: api.knowledge.v1.KnowledgeBaseResponse
+	(*BotKnowledgeBaseResponse)(nil),            // 18: api.knowledge.v1.BotKnowledgeBaseResponse
+	(*UploadDocumentRequest)(nil),               // 19: api.knowledge.v1.UploadDocumentRequest
+	(*UploadDocumentResponse)(nil),              // 20: api.knowledge.v1.UploadDocumentResponse
+	(*GetDocumentRequest)(nil),                  // 21: api.knowledge.v1.GetDocumentRequest
+	(*GetDocumentResponse)(nil),                 // 22: api.knowledge.v1.GetDocumentResponse
+	(*DeleteDocumentRequest)(nil),               // 23: api.knowledge.v1.DeleteDocumentRequest
+	(*UpdateDocumentRequest)(nil),               // 24: api.knowledge.v1.UpdateDocumentRequest
+	(*DocumentResponse)(nil),                    // 25: api.knowledge.v1.DocumentResponse
+	(*SetDocumentAccessLabelsRequest)(nil),      // 26: api.knowledge.v1.SetDocumentAccessLabelsRequest
+	(*ReindexDocumentRequest)(nil),              // 27: api.knowledge.v1.ReindexDocumentRequest
+	(*RollbackDocumentRequest)(nil),             // 28: api.knowledge.v1.RollbackDocumentRequest
+	(*DiffDocumentVersionsRequest)(nil),         // 29: api.knowledge.v1.DiffDocumentVersionsRequest
+	(*DiffChunk)(nil),                           // 30: api.knowledge.v1.DiffChunk
+	(*ChunkChange)(nil),                         // 31: api.knowledge.v1.ChunkChange
+	(*ChunkCitation)(nil),                       // 32: api.knowledge.v1.ChunkCitation
+	(*DocumentVersionDiffSide)(nil),             // 33: api.knowledge.v1.DocumentVersionDiffSide
+	(*DocumentVersionDiff)(nil),                 // 34: api.knowledge.v1.DocumentVersionDiff
+	(*DocumentVersionDiffResponse)(nil),         // 35: api.knowledge.v1.DocumentVersionDiffResponse
+	(*Chunk)(nil),                               // 36: api.knowledge.v1.Chunk
+	(*ListDocumentChunksRequest)(nil),           // 37: api.knowledge.v1.ListDocumentChunksRequest
+	(*SearchChunksRequest)(nil),                 // 38: api.knowledge.v1.SearchChunksRequest
+	(*ListChunksResponse)(nil),                  // 39: api.knowledge.v1.ListChunksResponse
+	(*ChunkKeywords)(nil),                       // 40: api.knowledge.v1.ChunkKeywords
+	(*UpdateChunkRequest)(nil),                  // 41: api.knowledge.v1.UpdateChunkRequest
+	(*ChunkResponse)(nil),                       // 42: api.knowledge.v1.ChunkResponse
+	(*ListChunkEditsRequest)(nil),               // 43: api.knowledge.v1.ListChunkEditsRequest
+	(*ChunkState)(nil),                          // 44: api.knowledge.v1.ChunkState
+	(*ChunkEdit)(nil),                           // 45: api.knowledge.v1.ChunkEdit
+	(*ListChunkEditsResponse)(nil),              // 46: api.knowledge.v1.ListChunkEditsResponse
+	(*BindBotKnowledgeBaseRequest)(nil),         // 47: api.knowledge.v1.BindBotKnowledgeBaseRequest
+	(*UnbindBotKnowledgeBaseRequest)(nil),       // 48: api.knowledge.v1.UnbindBotKnowledgeBaseRequest
+	(*KnowledgeBaseMigration)(nil),              // 49: api.knowledge.v1.KnowledgeBaseMigration
+	(*StartKnowledgeBaseMigrationRequest)(nil),  // 50: api.knowledge.v1.StartKnowledgeBaseMigrationRequest
+	(*GetKnowledgeBaseMigrationRequest)(nil),    // 51: api.knowledge.v1.GetKnowledgeBaseMigrationRequest
+	(*ListKnowledgeBaseMigrationsRequest)(nil),  // 52: api.knowledge.v1.ListKnowledgeBaseMigrationsRequest
+	(*ListKnowledgeBaseMigrationsResponse)(nil), // 53: api.knowledge.v1.ListKnowledgeBaseMigrationsResponse
+	(*CancelKnowledgeBaseMigrationRequest)(nil), // 54: api.knowledge.v1.CancelKnowledgeBaseMigrationRequest
+	(*KnowledgeBaseMigrationResponse)(nil),      // 55: api.knowledge.v1.KnowledgeBaseMigrationResponse
+	(*IngestionJob)(nil),                        // 56: api.knowledge.v1.IngestionJob
+	(*ListIngestionJobsRequest)(nil),            // 57: api.knowledge.v1.ListIngestionJobsRequest
+	(*ListIngestionJobsResponse)(nil),           // 58: api.knowledge.v1.ListIngestionJobsResponse
+	(*GetIngestionJobRequest)(nil),              // 59: api.knowledge.v1.GetIngestionJobRequest
+	(*RetryIngestionJobRequest)(nil),            // 60: api.knowledge.v1.RetryIngestionJobRequest
+	(*CancelIngestionJobRequest)(nil),           // 61: api.knowledge.v1.CancelIngestionJobRequest
+	(*IngestionJobResponse)(nil),                // 62: api.knowledge.v1.IngestionJobResponse
+	(*ImportBatch)(nil),                         // 63: api.knowledge.v1.ImportBatch
+	(*ImportBatchItem)(nil),                     // 64: api.knowledge.v1.ImportBatchItem
+	(*GetImportBatchRequest)(nil),               // 65: api.knowledge.v1.GetImportBatchRequest
+	(*ImportBatchResponse)(nil),                 // 66: api.knowledge.v1.ImportBatchResponse
+	(*KnowledgeBaseImportResponse)(nil),         // 67: api.knowledge.v1.KnowledgeBaseImportResponse
+	(*GetDuplicateReportRequest)(nil),           // 68: api.knowledge.v1.GetDuplicateReportRequest
+	(*DuplicateChunk)(nil),                      // 69: api.knowledge.v1.DuplicateChunk
+	(*DuplicateChunkCluster)(nil),               // 70: api.knowledge.v1.DuplicateChunkCluster
+	(*DuplicateDocumentPair)(nil),               // 71: api.knowledge.v1.DuplicateDocumentPair
+	(*DuplicateDocumentCluster)(nil),            // 72: api.knowledge.v1.DuplicateDocumentCluster
+	(*DuplicateReport)(nil),                     // 73: api.knowledge.v1.DuplicateReport
+	(*DuplicateReportResponse)(nil),             // 74: api.knowledge.v1.DuplicateReportResponse
+	nil,                                         // 75: api.knowledge.v1.IngestionJob.StepDurationsEntry
+	(*timestamppb.Timestamp)(nil),               // 76: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),              // 77: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),                // 78: google.protobuf.BoolValue
+	(*emptypb.Empty)(nil),                       // 79: google.protobuf.Empty
 }
 var file_api_knowledge_v1_console_knowledge_proto_depIdxs = []int32{
-	27, // 0: api.knowledge.v1.KnowledgeBase.created_at:type_name -> google.protobuf.Timestamp
-	27, // 1: api.knowledge.v1.KnowledgeBase.updated_at:type_name -> google.protobuf.Timestamp
-	27, // 2: api.knowledge.v1.BotKnowledgeBase.created_at:type_name -> google.protobuf.Timestamp
-	27, // 3: api.knowledge.v1.Document.created_at:type_name -> google.protobuf.Timestamp
-	27, // 4: api.knowledge.v1.Document.updated_at:type_name -> google.protobuf.Timestamp
-	27, // 5: api.knowledge.v1.DocumentVersion.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: api.knowledge.v1.ListKnowledgeBasesResponse.items:type_name -> api.knowledge.v1.KnowledgeBase
-	2,  // 7: api.knowledge.v1.ListDocumentsResponse.items:type_name -> api.knowledge.v1.Document
-	1,  // 8: api.knowledge.v1.ListBotKnowledgeBasesResponse.items:type_name -> api.knowledge.v1.BotKnowledgeBase
-	0,  // 9: api.knowledge.v1.KnowledgeBaseResponse.knowledge_base:type_name -> api.knowledge.v1.KnowledgeBase
-	1,  // 10: api.knowledge.v1.BotKnowledgeBaseResponse.bot_kb:type_name -> api.knowledge.v1.BotKnowledgeBase
-	2,  // 11: api.knowledge.v1.UploadDocumentResponse.document:type_name -> api.knowledge.v1.Document
-	3,  // 12: api.knowledge.v1.UploadDocumentResponse.version:type_name -> api.knowledge.v1.DocumentVersion
-	2,  // 13: api.knowledge.v1.GetDocumentResponse.document:type_name -> api.knowledge.v1.Document
-	3,  // 14: api.knowledge.v1.GetDocumentResponse.versions:type_name -> api.knowledge.v1.DocumentVersion
-	2,  // 15: api.knowledge.v1.DocumentResponse.document:type_name -> api.knowledge.v1.Document
-	4,  // 16: api.knowledge.v1.ConsoleKnowledge.CreateKnowledgeBase:input_type -> api.knowledge.v1.CreateKnowledgeBaseRequest
-	5,  // 17: api.knowledge.v1.ConsoleKnowledge.GetKnowledgeBase:input_type -> api.knowledge.v1.GetKnowledgeBaseRequest
-	6,  // 18: api.knowledge.v1.ConsoleKnowledge.UpdateKnowledgeBase:input_type -> api.knowledge.v1.UpdateKnowledgeBaseRequest
-	7,  // 19: api.knowledge.v1.ConsoleKnowledge.DeleteKnowledgeBase:input_type -> api.knowledge.v1.DeleteKnowledgeBaseRequest
-	8,  // 20: api.knowledge.v1.ConsoleKnowledge.ListKnowledgeBases:input_type -> api.knowledge.v1.ListKnowledgeBasesRequest
-	10, // 21: api.knowledge.v1.ConsoleKnowledge.ListDocuments:input_type -> api.knowledge.v1.ListDocumentsRequest
-	12, // 22: api.knowledge.v1.ConsoleKnowledge.ListBotKnowledgeBases:input_type -> api.knowledge.v1.ListBotKnowledgeBasesRequest
-	25, // 23: api.knowledge.v1.ConsoleKnowledge.BindBotKnowledgeBase:input_type -> api.knowledge.v1.BindBotKnowledgeBaseRequest
-	26, // 24: api.knowledge.v1.ConsoleKnowledge.UnbindBotKnowledgeBase:input_type -> api.knowledge.v1.UnbindBotKnowledgeBaseRequest
-	16, // 25: api.knowledge.v1.ConsoleKnowledge.UploadDocument:input_type -> api.knowledge.v1.UploadDocumentRequest
-	18, // 26: api.knowledge.v1.ConsoleKnowledge.GetDocument:input_type -> api.knowledge.v1.GetDocumentRequest
-	20, // 27: api.knowledge.v1.ConsoleKnowledge.DeleteDocument:input_type -> api.knowledge.v1.DeleteDocumentRequest
-	21, // 28: api.knowledge.v1.ConsoleKnowledge.UpdateDocument:input_type -> api.knowledge.v1.UpdateDocumentRequest
-	23, // 29: api.knowledge.v1.ConsoleKnowledge.ReindexDocument:input_type -> api.knowledge.v1.ReindexDocumentRequest
-	24, // 30: api.knowledge.v1.ConsoleKnowledge.RollbackDocument:input_type -> api.knowledge.v1.RollbackDocumentRequest
-	14, // 31: api.knowledge.v1.ConsoleKnowledge.CreateKnowledgeBase:output_type -> api.knowledge.v1.KnowledgeBaseResponse
-	14, // 32: api.knowledge.v1.ConsoleKnowledge.GetKnowledgeBase:output_type -> api.knowledge.v1.KnowledgeBaseResponse
-	14, // 33: api.knowledge.v1.ConsoleKnowledge.UpdateKnowledgeBase:output_type -> api.knowledge.v1.KnowledgeBaseResponse
-	28, // 34: api.knowledge.v1.ConsoleKnowledge.DeleteKnowledgeBase:output_type -> google.protobuf.Empty
-	9,  // 35: api.knowledge.v1.ConsoleKnowledge.ListKnowledgeBases:output_type -> api.knowledge.v1.ListKnowledgeBasesResponse
-	11, // 36: api.knowledge.v1.ConsoleKnowledge.ListDocuments:output_type -> api.knowledge.v1.ListDocumentsResponse
-	13, // 37: api.knowledge.v1.ConsoleKnowledge.ListBotKnowledgeBases:output_type -> api.knowledge.v1.ListBotKnowledgeBasesResponse
-	15, // 38: api.knowledge.v1.ConsoleKnowledge.BindBotKnowledgeBase:output_type -> api.knowledge.v1.BotKnowledgeBaseResponse
-	28, // 39: api.knowledge.v1.ConsoleKnowledge.UnbindBotKnowledgeBase:output_type -> google.protobuf.Empty
-	17, // 40: api.knowledge.v1.ConsoleKnowledge.UploadDocument:output_type -> api.knowledge.v1.UploadDocumentResponse
-	19, // 41: api.knowledge.v1.ConsoleKnowledge.GetDocument:output_type -> api.knowledge.v1.GetDocumentResponse
-	28, // 42: api.knowledge.v1.ConsoleKnowledge.DeleteDocument:output_type -> google.protobuf.Empty
-	22, // 43: api.knowledge.v1.ConsoleKnowledge.UpdateDocument:output_type -> api.knowledge.v1.DocumentResponse
-	28, // 44: api.knowledge.v1.ConsoleKnowledge.ReindexDocument:output_type -> google.protobuf.Empty
-	28, // 45: api.knowledge.v1.ConsoleKnowledge.RollbackDocument:output_type -> google.protobuf.Empty
-	31, // [31:46] is the sub-list for method output_type
-	16, // [16:31] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	76,  // 0: api.knowledge.v1.KnowledgeBase.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 1: api.knowledge.v1.KnowledgeBase.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 2: api.knowledge.v1.KnowledgeBase.chunking:type_name -> api.knowledge.v1.ChunkingConfig
+	2,   // 3: api.knowledge.v1.KnowledgeBase.embedding:type_name -> api.knowledge.v1.EmbeddingConfig
+	3,   // 4: api.knowledge.v1.KnowledgeBase.dedup:type_name -> api.knowledge.v1.DedupConfig
+	76,  // 5: api.knowledge.v1.BotKnowledgeBase.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 6: api.knowledge.v1.Document.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 7: api.knowledge.v1.Document.updated_at:type_name -> google.protobuf.Timestamp
+	76,  // 8: api.knowledge.v1.DocumentVersion.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 9: api.knowledge.v1.CreateKnowledgeBaseRequest.chunking:type_name -> api.knowledge.v1.ChunkingConfig
+	2,   // 10: api.knowledge.v1.CreateKnowledgeBaseRequest.embedding:type_name -> api.knowledge.v1.EmbeddingConfig
+	3,   // 11: api.knowledge.v1.CreateKnowledgeBaseRequest.dedup:type_name -> api.knowledge.v1.DedupConfig
+	1,   // 12: api.knowledge.v1.UpdateKnowledgeBaseRequest.chunking:type_name -> api.knowledge.v1.ChunkingConfig
+	2,   // 13: api.knowledge.v1.UpdateKnowledgeBaseRequest.embedding:type_name -> api.knowledge.v1.EmbeddingConfig
+	3,   // 14: api.knowledge.v1.UpdateKnowledgeBaseRequest.dedup:type_name -> api.knowledge.v1.DedupConfig
+	0,   // 15: api.knowledge.v1.ListKnowledgeBasesResponse.items:type_name -> api.knowledge.v1.KnowledgeBase
+	5,   // 16: api.knowledge.v1.ListDocumentsResponse.items:type_name -> api.knowledge.v1.Document
+	4,   // 17: api.knowledge.v1.ListBotKnowledgeBasesResponse.items:type_name -> api.knowledge.v1.BotKnowledgeBase
+	0,   // 18: api.knowledge.v1.KnowledgeBaseResponse.knowledge_base:type_name -> api.knowledge.v1.KnowledgeBase
+	4,   // 19: api.knowledge.v1.BotKnowledgeBaseResponse.bot_kb:type_name -> api.knowledge.v1.BotKnowledgeBase
+	5,   // 20: api.knowledge.v1.UploadDocumentResponse.document:type_name -> api.knowledge.v1.Document
+	6,   // 21: api.knowledge.v1.UploadDocumentResponse.version:type_name -> api.knowledge.v1.DocumentVersion
+	5,   // 22: api.knowledge.v1.GetDocumentResponse.document:type_name -> api.knowledge.v1.Document
+	6,   // 23: api.knowledge.v1.GetDocumentResponse.versions:type_name -> api.knowledge.v1.DocumentVersion
+	5,   // 24: api.knowledge.v1.DocumentResponse.document:type_name -> api.knowledge.v1.Document
+	30,  // 25: api.knowledge.v1.ChunkChange.from:type_name -> api.knowledge.v1.DiffChunk
+	30,  // 26: api.knowledge.v1.ChunkChange.to:type_name -> api.knowledge.v1.DiffChunk
+	76,  // 27: api.knowledge.v1.ChunkCitation.last_cited_at:type_name -> google.protobuf.Timestamp
+	6,   // 28: api.knowledge.v1.DocumentVersionDiffSide.version:type_name -> api.knowledge.v1.DocumentVersion
+	32,  // 29: api.knowledge.v1.DocumentVersionDiffSide.citations:type_name -> api.knowledge.v1.ChunkCitation
+	33,  // 30: api.knowledge.v1.DocumentVersionDiff.from:type_name -> api.knowledge.v1.DocumentVersionDiffSide
+	33,  // 31: api.knowledge.v1.DocumentVersionDiff.to:type_name -> api.knowledge.v1.DocumentVersionDiffSide
+	30,  // 32: api.knowledge.v1.DocumentVersionDiff.added:type_name -> api.knowledge.v1.DiffChunk
+	30,  // 33: api.knowledge.v1.DocumentVersionDiff.removed:type_name -> api.knowledge.v1.DiffChunk
+	31,  // 34: api.knowledge.v1.DocumentVersionDiff.modified:type_name -> api.knowledge.v1.ChunkChange
+	34,  // 35: api.knowledge.v1.DocumentVersionDiffResponse.diff:type_name -> api.knowledge.v1.DocumentVersionDiff
+	36,  // 36: api.knowledge.v1.ListChunksResponse.items:type_name -> api.knowledge.v1.Chunk
+	77,  // 37: api.knowledge.v1.UpdateChunkRequest.content:type_name -> google.protobuf.StringValue
+	78,  // 38: api.knowledge.v1.UpdateChunkRequest.excluded:type_name -> google.protobuf.BoolValue
+	40,  // 39: api.knowledge.v1.UpdateChunkRequest.keywords:type_name -> api.knowledge.v1.ChunkKeywords
+	36,  // 40: api.knowledge.v1.ChunkResponse.chunk:type_name -> api.knowledge.v1.Chunk
+	44,  // 41: api.knowledge.v1.ChunkEdit.before:type_name -> api.knowledge.v1.ChunkState
+	44,  // 42: api.knowledge.v1.ChunkEdit.after:type_name -> api.knowledge.v1.ChunkState
+	76,  // 43: api.knowledge.v1.ChunkEdit.created_at:type_name -> google.protobuf.Timestamp
+	45,  // 44: api.knowledge.v1.ListChunkEditsResponse.items:type_name -> api.knowledge.v1.ChunkEdit
+	1,   // 45: api.knowledge.v1.KnowledgeBaseMigration.chunking:type_name -> api.knowledge.v1.ChunkingConfig
+	2,   // 46: api.knowledge.v1.KnowledgeBaseMigration.embedding:type_name -> api.knowledge.v1.EmbeddingConfig
+	76,  // 47: api.knowledge.v1.KnowledgeBaseMigration.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 48: api.knowledge.v1.KnowledgeBaseMigration.updated_at:type_name -> google.protobuf.Timestamp
+	76,  // 49: api.knowledge.v1.KnowledgeBaseMigration.finished_at:type_name -> google.protobuf.Timestamp
+	1,   // 50: api.knowledge.v1.StartKnowledgeBaseMigrationRequest.chunking:type_name -> api.knowledge.v1.ChunkingConfig
+	2,   // 51: api.knowledge.v1.StartKnowledgeBaseMigrationRequest.embedding:type_name -> api.knowledge.v1.EmbeddingConfig
+	49,  // 52: api.knowledge.v1.ListKnowledgeBaseMigrationsResponse.items:type_name -> api.knowledge.v1.KnowledgeBaseMigration
+	49,  // 53: api.knowledge.v1.KnowledgeBaseMigrationResponse.migration:type_name -> api.knowledge.v1.KnowledgeBaseMigration
+	75,  // 54: api.knowledge.v1.IngestionJob.step_durations:type_name -> api.knowledge.v1.IngestionJob.StepDurationsEntry
+	76,  // 55: api.knowledge.v1.IngestionJob.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 56: api.knowledge.v1.IngestionJob.updated_at:type_name -> google.protobuf.Timestamp
+	76,  // 57: api.knowledge.v1.IngestionJob.started_at:type_name -> google.protobuf.Timestamp
+	76,  // 58: api.knowledge.v1.IngestionJob.finished_at:type_name -> google.protobuf.Timestamp
+	56,  // 59: api.knowledge.v1.ListIngestionJobsResponse.items:type_name -> api.knowledge.v1.IngestionJob
+	56,  // 60: api.knowledge.v1.IngestionJobResponse.job:type_name -> api.knowledge.v1.IngestionJob
+	64,  // 61: api.knowledge.v1.ImportBatch.items:type_name -> api.knowledge.v1.ImportBatchItem
+	76,  // 62: api.knowledge.v1.ImportBatch.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 63: api.knowledge.v1.ImportBatchResponse.batch:type_name -> api.knowledge.v1.ImportBatch
+	0,   // 64: api.knowledge.v1.KnowledgeBaseImportResponse.knowledge_base:type_name -> api.knowledge.v1.KnowledgeBase
+	63,  // 65: api.knowledge.v1.KnowledgeBaseImportResponse.batch:type_name -> api.knowledge.v1.ImportBatch
+	69,  // 66: api.knowledge.v1.DuplicateChunkCluster.chunks:type_name -> api.knowledge.v1.DuplicateChunk
+	71,  // 67: api.knowledge.v1.DuplicateDocumentCluster.pairs:type_name -> api.knowledge.v1.DuplicateDocumentPair
+	70,  // 68: api.knowledge.v1.DuplicateReport.chunk_clusters:type_name -> api.knowledge.v1.DuplicateChunkCluster
+	72,  // 69: api.knowledge.v1.DuplicateReport.document_clusters:type_name -> api.knowledge.v1.DuplicateDocumentCluster
+	73,  // 70: api.knowledge.v1.DuplicateReportResponse.report:type_name -> api.knowledge.v1.DuplicateReport
+	7,   // 71: api.knowledge.v1.ConsoleKnowledge.CreateKnowledgeBase:input_type -> api.knowledge.v1.CreateKnowledgeBaseRequest
+	8,   // 72: api.knowledge.v1.ConsoleKnowledge.GetKnowledgeBase:input_type -> api.knowledge.v1.GetKnowledgeBaseRequest
+	9,   // 73: api.knowledge.v1.ConsoleKnowledge.UpdateKnowledgeBase:input_type -> api.knowledge.v1.UpdateKnowledgeBaseRequest
+	10,  // 74: api.knowledge.v1.ConsoleKnowledge.DeleteKnowledgeBase:input_type -> api.knowledge.v1.DeleteKnowledgeBaseRequest
+	11,  // 75: api.knowledge.v1.ConsoleKnowledge.ListKnowledgeBases:input_type -> api.knowledge.v1.ListKnowledgeBasesRequest
+	13,  // 76: api.knowledge.v1.ConsoleKnowledge.ListDocuments:input_type -> api.knowledge.v1.ListDocumentsRequest
+	15,  // 77: api.knowledge.v1.ConsoleKnowledge.ListBotKnowledgeBases:input_type -> api.knowledge.v1.ListBotKnowledgeBasesRequest
+	47,  // 78: api.knowledge.v1.ConsoleKnowledge.BindBotKnowledgeBase:input_type -> api.knowledge.v1.BindBotKnowledgeBaseRequest
+	48,  // 79: api.knowledge.v1.ConsoleKnowledge.UnbindBotKnowledgeBase:input_type -> api.knowledge.v1.UnbindBotKnowledgeBaseRequest
+	19,  // 80: api.knowledge.v1.ConsoleKnowledge.UploadDocument:input_type -> api.knowledge.v1.UploadDocumentRequest
+	21,  // 81: api.knowledge.v1.ConsoleKnowledge.GetDocument:input_type -> api.knowledge.v1.GetDocumentRequest
+	23,  // 82: api.knowledge.v1.ConsoleKnowledge.DeleteDocument:input_type -> api.knowledge.v1.DeleteDocumentRequest
+	24,  // 83: api.knowledge.v1.ConsoleKnowledge.UpdateDocument:input_type -> api.knowledge.v1.UpdateDocumentRequest
+	26,  // 84: api.knowledge.v1.ConsoleKnowledge.SetDocumentAccessLabels:input_type -> api.knowledge.v1.SetDocumentAccessLabelsRequest
+	27,  // 85: api.knowledge.v1.ConsoleKnowledge.ReindexDocument:input_type -> api.knowledge.v1.ReindexDocumentRequest
+	28,  // 86: api.knowledge.v1.ConsoleKnowledge.RollbackDocument:input_type -> api.knowledge.v1.RollbackDocumentRequest
+	29,  // 87: api.knowledge.v1.ConsoleKnowledge.DiffDocumentVersions:input_type -> api.knowledge.v1.DiffDocumentVersionsRequest
+	37,  // 88: api.knowledge.v1.ConsoleKnowledge.ListDocumentChunks:input_type -> api.knowledge.v1.ListDocumentChunksRequest
+	38,  // 89: api.knowledge.v1.ConsoleKnowledge.SearchChunks:input_type -> api.knowledge.v1.SearchChunksRequest
+	41,  // 90: api.knowledge.v1.ConsoleKnowledge.UpdateChunk:input_type -> api.knowledge.v1.UpdateChunkRequest
+	43,  // 91: api.knowledge.v1.ConsoleKnowledge.ListChunkEdits:input_type -> api.knowledge.v1.ListChunkEditsRequest
+	50,  // 92: api.knowledge.v1.ConsoleKnowledge.StartKnowledgeBaseMigration:input_type -> api.knowledge.v1.StartKnowledgeBaseMigrationRequest
+	51,  // 93: api.knowledge.v1.ConsoleKnowledge.GetKnowledgeBaseMigration:input_type -> api.knowledge.v1.GetKnowledgeBaseMigrationRequest
+	52,  // 94: api.knowledge.v1.ConsoleKnowledge.ListKnowledgeBaseMigrations:input_type -> api.knowledge.v1.ListKnowledgeBaseMigrationsRequest
+	54,  // 95: api.knowledge.v1.ConsoleKnowledge.CancelKnowledgeBaseMigration:input_type -> api.knowledge.v1.CancelKnowledgeBaseMigrationRequest
+	65,  // 96: api.knowledge.v1.ConsoleKnowledge.GetImportBatch:input_type -> api.knowledge.v1.GetImportBatchRequest
+	68,  // 97: api.knowledge.v1.ConsoleKnowledge.GetDuplicateReport:input_type -> api.knowledge.v1.GetDuplicateReportRequest
+	57,  // 98: api.knowledge.v1.ConsoleKnowledge.ListIngestionJobs:input_type -> api.knowledge.v1.ListIngestionJobsRequest
+	59,  // 99: api.knowledge.v1.ConsoleKnowledge.GetIngestionJob:input_type -> api.knowledge.v1.GetIngestionJobRequest
+	60,  // 100: api.knowledge.v1.ConsoleKnowledge.RetryIngestionJob:input_type -> api.knowledge.v1.RetryIngestionJobRequest
+	61,  // 101: api.knowledge.v1.ConsoleKnowledge.CancelIngestionJob:input_type -> api.knowledge.v1.CancelIngestionJobRequest
+	17,  // 102: api.knowledge.v1.ConsoleKnowledge.CreateKnowledgeBase:output_type -> api.knowledge.v1.KnowledgeBaseResponse
+	17,  // 103: api.knowledge.v1.ConsoleKnowledge.GetKnowledgeBase:output_type -> api.knowledge.v1.KnowledgeBaseResponse
+	17,  // 104: api.knowledge.v1.ConsoleKnowledge.UpdateKnowledgeBase:output_type -> api.knowledge.v1.KnowledgeBaseResponse
+	79,  // 105: api.knowledge.v1.ConsoleKnowledge.DeleteKnowledgeBase:output_type -> google.protobuf.Empty
+	12,  // 106: api.knowledge.v1.ConsoleKnowledge.ListKnowledgeBases:output_type -> api.knowledge.v1.ListKnowledgeBasesResponse
+	14,  // 107: api.knowledge.v1.ConsoleKnowledge.ListDocuments:output_type -> api.knowledge.v1.ListDocumentsResponse
+	16,  // 108: api.knowledge.v1.ConsoleKnowledge.ListBotKnowledgeBases:output_type -> api.knowledge.v1.ListBotKnowledgeBasesResponse
+	18,  // 109: api.knowledge.v1.ConsoleKnowledge.BindBotKnowledgeBase:output_type -> api.knowledge.v1.BotKnowledgeBaseResponse
+	79,  // 110: api.knowledge.v1.ConsoleKnowledge.UnbindBotKnowledgeBase:output_type -> google.protobuf.Empty
+	20,  // 111: api.knowledge.v1.ConsoleKnowledge.UploadDocument:output_type -> api.knowledge.v1.UploadDocumentResponse
+	22,  // 112: api.knowledge.v1.ConsoleKnowledge.GetDocument:output_type -> api.knowledge.v1.GetDocumentResponse
+	79,  // 113: api.knowledge.v1.ConsoleKnowledge.DeleteDocument:output_type -> google.protobuf.Empty
+	25,  // 114: api.knowledge.v1.ConsoleKnowledge.UpdateDocument:output_type -> api.knowledge.v1.DocumentResponse
+	25,  // 115: api.knowledge.v1.ConsoleKnowledge.SetDocumentAccessLabels:output_type -> api.knowledge.v1.DocumentResponse
+	79,  // 116: api.knowledge.v1.ConsoleKnowledge.ReindexDocument:output_type -> google.protobuf.Empty
+	79,  // 117: api.knowledge.v1.ConsoleKnowledge.RollbackDocument:output_type -> google.protobuf.Empty
+	35,  // 118: api.knowledge.v1.ConsoleKnowledge.DiffDocumentVersions:output_type -> api.knowledge.v1.DocumentVersionDiffResponse
+	39,  // 119: api.knowledge.v1.ConsoleKnowledge.ListDocumentChunks:output_type -> api.knowledge.v1.ListChunksResponse
+	39,  // 120: api.knowledge.v1.ConsoleKnowledge.SearchChunks:output_type -> api.knowledge.v1.ListChunksResponse
+	42,  // 121: api.knowledge.v1.ConsoleKnowledge.UpdateChunk:output_type -> api.knowledge.v1.ChunkResponse
+	46,  // 122: api.knowledge.v1.ConsoleKnowledge.ListChunkEdits:output_type -> api.knowledge.v1.ListChunkEditsResponse
+	55,  // 123: api.knowledge.v1.ConsoleKnowledge.StartKnowledgeBaseMigration:output_type -> api.knowledge.v1.KnowledgeBaseMigrationResponse
+	55,  // 124: api.knowledge.v1.ConsoleKnowledge.GetKnowledgeBaseMigration:output_type -> api.knowledge.v1.KnowledgeBaseMigrationResponse
+	53,  // 125: api.knowledge.v1.ConsoleKnowledge.ListKnowledgeBaseMigrations:output_type -> api.knowledge.v1.ListKnowledgeBaseMigrationsResponse
+	55,  // 126: api.knowledge.v1.ConsoleKnowledge.CancelKnowledgeBaseMigration:output_type -> api.knowledge.v1.KnowledgeBaseMigrationResponse
+	66,  // 127: api.knowledge.v1.ConsoleKnowledge.GetImportBatch:output_type -> api.knowledge.v1.ImportBatchResponse
+	74,  // 128: api.knowledge.v1.ConsoleKnowledge.GetDuplicateReport:output_type -> api.knowledge.v1.DuplicateReportResponse
+	58,  // 129: api.knowledge.v1.ConsoleKnowledge.ListIngestionJobs:output_type -> api.knowledge.v1.ListIngestionJobsResponse
+	62,  // 130: api.knowledge.v1.ConsoleKnowledge.GetIngestionJob:output_type -> api.knowledge.v1.IngestionJobResponse
+	62,  // 131: api.knowledge.v1.ConsoleKnowledge.RetryIngestionJob:output_type -> api.knowledge.v1.IngestionJobResponse
+	62,  // 132: api.knowledge.v1.ConsoleKnowledge.CancelIngestionJob:output_type -> api.knowledge.v1.IngestionJobResponse
+	102, // [102:133] is the sub-list for method output_type
+	71,  // [71:102] is the sub-list for method input_type
+	71,  // [71:71] is the sub-list for extension type_name
+	71,  // [71:71] is the sub-list for extension extendee
+	0,   // [0:71] is the sub-list for field type_name
 }
 
 func init() { file_api_knowledge_v1_console_knowledge_proto_init() }
@@ -1739,7 +5663,7 @@ func file_api_knowledge_v1_console_knowledge_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_knowledge_v1_console_knowledge_proto_rawDesc), len(file_api_knowledge_v1_console_knowledge_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,21 +20,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ConsoleKnowledge_CreateKnowledgeBase_FullMethodName    = "/api.knowledge.v1.ConsoleKnowledge/CreateKnowledgeBase"
-	ConsoleKnowledge_GetKnowledgeBase_FullMethodName       = "/api.knowledge.v1.ConsoleKnowledge/GetKnowledgeBase"
-	ConsoleKnowledge_UpdateKnowledgeBase_FullMethodName    = "/api.knowledge.v1.ConsoleKnowledge/UpdateKnowledgeBase"
-	ConsoleKnowledge_DeleteKnowledgeBase_FullMethodName    = "/api.knowledge.v1.ConsoleKnowledge/DeleteKnowledgeBase"
-	ConsoleKnowledge_ListKnowledgeBases_FullMethodName     = "/api.knowledge.v1.ConsoleKnowledge/ListKnowledgeBases"
-	ConsoleKnowledge_ListDocuments_FullMethodName          = "/api.knowledge.v1.ConsoleKnowledge/ListDocuments"
-	ConsoleKnowledge_ListBotKnowledgeBases_FullMethodName  = "/api.knowledge.v1.ConsoleKnowledge/ListBotKnowledgeBases"
-	ConsoleKnowledge_BindBotKnowledgeBase_FullMethodName   = "/api.knowledge.v1.ConsoleKnowledge/BindBotKnowledgeBase"
-	ConsoleKnowledge_UnbindBotKnowledgeBase_FullMethodName = "/api.knowledge.v1.ConsoleKnowledge/UnbindBotKnowledgeBase"
-	ConsoleKnowledge_UploadDocument_FullMethodName         = "/api.knowledge.v1.ConsoleKnowledge/UploadDocument"
-	ConsoleKnowledge_GetDocument_FullMethodName            = "/api.knowledge.v1.ConsoleKnowledge/GetDocument"
-	ConsoleKnowledge_DeleteDocument_FullMethodName         = "/api.knowledge.v1.ConsoleKnowledge/DeleteDocument"
-	ConsoleKnowledge_UpdateDocument_FullMethodName         = "/api.knowledge.v1.ConsoleKnowledge/UpdateDocument"
-	ConsoleKnowledge_ReindexDocument_FullMethodName        = "/api.knowledge.v1.ConsoleKnowledge/ReindexDocument"
-	ConsoleKnowledge_RollbackDocument_FullMethodName       = "/api.knowledge.v1.ConsoleKnowledge/RollbackDocument"
+	ConsoleKnowledge_CreateKnowledgeBase_FullMethodName          = "/api.knowledge.v1.ConsoleKnowledge/CreateKnowledgeBase"
+	ConsoleKnowledge_GetKnowledgeBase_FullMethodName             = "/api.knowledge.v1.ConsoleKnowledge/GetKnowledgeBase"
+	ConsoleKnowledge_UpdateKnowledgeBase_FullMethodName          = "/api.knowledge.v1.ConsoleKnowledge/UpdateKnowledgeBase"
+	ConsoleKnowledge_DeleteKnowledgeBase_FullMethodName          = "/api.knowledge.v1.ConsoleKnowledge/DeleteKnowledgeBase"
+	ConsoleKnowledge_ListKnowledgeBases_FullMethodName           = "/api.knowledge.v1.ConsoleKnowledge/ListKnowledgeBases"
+	ConsoleKnowledge_ListDocuments_FullMethodName                = "/api.knowledge.v1.ConsoleKnowledge/ListDocuments"
+	ConsoleKnowledge_ListBotKnowledgeBases_FullMethodName        = "/api.knowledge.v1.ConsoleKnowledge/ListBotKnowledgeBases"
+	ConsoleKnowledge_BindBotKnowledgeBase_FullMethodName         = "/api.knowledge.v1.ConsoleKnowledge/BindBotKnowledgeBase"
+	ConsoleKnowledge_UnbindBotKnowledgeBase_FullMethodName       = "/api.knowledge.v1.ConsoleKnowledge/UnbindBotKnowledgeBase"
+	ConsoleKnowledge_UploadDocument_FullMethodName               = "/api.knowledge.v1.ConsoleKnowledge/UploadDocument"
+	ConsoleKnowledge_GetDocument_FullMethodName                  = "/api.knowledge.v1.ConsoleKnowledge/GetDocument"
+	ConsoleKnowledge_DeleteDocument_FullMethodName               = "/api.knowledge.v1.ConsoleKnowledge/DeleteDocument"
+	ConsoleKnowledge_UpdateDocument_FullMethodName               = "/api.knowledge.v1.ConsoleKnowledge/UpdateDocument"
+	ConsoleKnowledge_SetDocumentAccessLabels_FullMethodName      = "/api.knowledge.v1.ConsoleKnowledge/SetDocumentAccessLabels"
+	ConsoleKnowledge_ReindexDocument_FullMethodName              = "/api.knowledge.v1.ConsoleKnowledge/ReindexDocument"
+	ConsoleKnowledge_RollbackDocument_FullMethodName             = "/api.knowledge.v1.ConsoleKnowledge/RollbackDocument"
+	ConsoleKnowledge_DiffDocumentVersions_FullMethodName         = "/api.knowledge.v1.ConsoleKnowledge/DiffDocumentVersions"
+	ConsoleKnowledge_ListDocumentChunks_FullMethodName           = "/api.knowledge.v1.ConsoleKnowledge/ListDocumentChunks"
+	ConsoleKnowledge_SearchChunks_FullMethodName                 = "/api.knowledge.v1.ConsoleKnowledge/SearchChunks"
+	ConsoleKnowledge_UpdateChunk_FullMethodName                  = "/api.knowledge.v1.ConsoleKnowledge/UpdateChunk"
+	ConsoleKnowledge_ListChunkEdits_FullMethodName               = "/api.knowledge.v1.ConsoleKnowledge/ListChunkEdits"
+	ConsoleKnowledge_StartKnowledgeBaseMigration_FullMethodName  = "/api.knowledge.v1.ConsoleKnowledge/StartKnowledgeBaseMigration"
+	ConsoleKnowledge_GetKnowledgeBaseMigration_FullMethodName    = "/api.knowledge.v1.ConsoleKnowledge/GetKnowledgeBaseMigration"
+	ConsoleKnowledge_ListKnowledgeBaseMigrations_FullMethodName  = "/api.knowledge.v1.ConsoleKnowledge/ListKnowledgeBaseMigrations"
+	ConsoleKnowledge_CancelKnowledgeBaseMigration_FullMethodName = "/api.knowledge.v1.ConsoleKnowledge/CancelKnowledgeBaseMigration"
+	ConsoleKnowledge_GetImportBatch_FullMethodName               = "/api.knowledge.v1.ConsoleKnowledge/GetImportBatch"
+	ConsoleKnowledge_GetDuplicateReport_FullMethodName           = "/api.knowledge.v1.ConsoleKnowledge/GetDuplicateReport"
+	ConsoleKnowledge_ListIngestionJobs_FullMethodName            = "/api.knowledge.v1.ConsoleKnowledge/ListIngestionJobs"
+	ConsoleKnowledge_GetIngestionJob_FullMethodName              = "/api.knowledge.v1.ConsoleKnowledge/GetIngestionJob"
+	ConsoleKnowledge_RetryIngestionJob_FullMethodName            = "/api.knowledge.v1.ConsoleKnowledge/RetryIngestionJob"
+	ConsoleKnowledge_CancelIngestionJob_FullMethodName           = "/api.knowledge.v1.ConsoleKnowledge/CancelIngestionJob"
 )
 
 // ConsoleKnowledgeClient is the client API for ConsoleKnowledge service.
@@ -54,8 +70,24 @@ type ConsoleKnowledgeClient interface {
 	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error)
 	DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateDocument(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*DocumentResponse, error)
+	SetDocumentAccessLabels(ctx context.Context, in *SetDocumentAccessLabelsRequest, opts ...grpc.CallOption) (*DocumentResponse, error)
 	ReindexDocument(ctx context.Context, in *ReindexDocumentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RollbackDocument(ctx context.Context, in *RollbackDocumentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DiffDocumentVersions(ctx context.Context, in *DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*DocumentVersionDiffResponse, error)
+	ListDocumentChunks(ctx context.Context, in *ListDocumentChunksRequest, opts ...grpc.CallOption) (*ListChunksResponse, error)
+	SearchChunks(ctx context.Context, in *SearchChunksRequest, opts ...grpc.CallOption) (*ListChunksResponse, error)
+	UpdateChunk(ctx context.Context, in *UpdateChunkRequest, opts ...grpc.CallOption) (*ChunkResponse, error)
+	ListChunkEdits(ctx context.Context, in *ListChunkEditsRequest, opts ...grpc.CallOption) (*ListChunkEditsResponse, error)
+	StartKnowledgeBaseMigration(ctx context.Context, in *StartKnowledgeBaseMigrationRequest, opts ...grpc.CallOption) (*KnowledgeBaseMigrationResponse, error)
+	GetKnowledgeBaseMigration(ctx context.Context, in *GetKnowledgeBaseMigrationRequest, opts ...grpc.CallOption) (*KnowledgeBaseMigrationResponse, error)
+	ListKnowledgeBaseMigrations(ctx context.Context, in *ListKnowledgeBaseMigrationsRequest, opts ...grpc.CallOption) (*ListKnowledgeBaseMigrationsResponse, error)
+	CancelKnowledgeBaseMigration(ctx context.Context, in *CancelKnowledgeBaseMigrationRequest, opts ...grpc.CallOption) (*KnowledgeBaseMigrationResponse, error)
+	GetImportBatch(ctx context.Context, in *GetImportBatchRequest, opts ...grpc.CallOption) (*ImportBatchResponse, error)
+	GetDuplicateReport(ctx context.Context, in *GetDuplicateReportRequest, opts ...grpc.CallOption) (*DuplicateReportResponse, error)
+	ListIngestionJobs(ctx context.Context, in *ListIngestionJobsRequest, opts ...grpc.CallOption) (*ListIngestionJobsResponse, error)
+	GetIngestionJob(ctx context.Context, in *GetIngestionJobRequest, opts ...grpc.CallOption) (*IngestionJobResponse, error)
+	RetryIngestionJob(ctx context.Context, in *RetryIngestionJobRequest, opts ...grpc.CallOption) (*IngestionJobResponse, error)
+	CancelIngestionJob(ctx context.Context, in *CancelIngestionJobRequest, opts ...grpc.CallOption) (*IngestionJobResponse, error)
 }
 
 type consoleKnowledgeClient struct {
@@ -196,6 +228,16 @@ func (c *consoleKnowledgeClient) UpdateDocument(ctx context.Context, in *UpdateD
 	return out, nil
 }
 
+func (c *consoleKnowledgeClient) SetDocumentAccessLabels(ctx context.Context, in *SetDocumentAccessLabelsRequest, opts ...grpc.CallOption) (*DocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocumentResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_SetDocumentAccessLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleKnowledgeClient) ReindexDocument(ctx context.Context, in *ReindexDocumentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -216,6 +258,156 @@ func (c *consoleKnowledgeClient) RollbackDocument(ctx context.Context, in *Rollb
 	return out, nil
 }
 
+func (c *consoleKnowledgeClient) DiffDocumentVersions(ctx context.Context, in *DiffDocumentVersionsRequest, opts ...grpc.CallOption) (*DocumentVersionDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocumentVersionDiffResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_DiffDocumentVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) ListDocumentChunks(ctx context.Context, in *ListDocumentChunksRequest, opts ...grpc.CallOption) (*ListChunksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChunksResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_ListDocumentChunks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) SearchChunks(ctx context.Context, in *SearchChunksRequest, opts ...grpc.CallOption) (*ListChunksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChunksResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_SearchChunks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) UpdateChunk(ctx context.Context, in *UpdateChunkRequest, opts ...grpc.CallOption) (*ChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChunkResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_UpdateChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) ListChunkEdits(ctx context.Context, in *ListChunkEditsRequest, opts ...grpc.CallOption) (*ListChunkEditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChunkEditsResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_ListChunkEdits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) StartKnowledgeBaseMigration(ctx context.Context, in *StartKnowledgeBaseMigrationRequest, opts ...grpc.CallOption) (*KnowledgeBaseMigrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgeBaseMigrationResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_StartKnowledgeBaseMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) GetKnowledgeBaseMigration(ctx context.Context, in *GetKnowledgeBaseMigrationRequest, opts ...grpc.CallOption) (*KnowledgeBaseMigrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgeBaseMigrationResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_GetKnowledgeBaseMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) ListKnowledgeBaseMigrations(ctx context.Context, in *ListKnowledgeBaseMigrationsRequest, opts ...grpc.CallOption) (*ListKnowledgeBaseMigrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKnowledgeBaseMigrationsResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_ListKnowledgeBaseMigrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) CancelKnowledgeBaseMigration(ctx context.Context, in *CancelKnowledgeBaseMigrationRequest, opts ...grpc.CallOption) (*KnowledgeBaseMigrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KnowledgeBaseMigrationResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_CancelKnowledgeBaseMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) GetImportBatch(ctx context.Context, in *GetImportBatchRequest, opts ...grpc.CallOption) (*ImportBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBatchResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_GetImportBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) GetDuplicateReport(ctx context.Context, in *GetDuplicateReportRequest, opts ...grpc.CallOption) (*DuplicateReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DuplicateReportResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_GetDuplicateReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) ListIngestionJobs(ctx context.Context, in *ListIngestionJobsRequest, opts ...grpc.CallOption) (*ListIngestionJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIngestionJobsResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_ListIngestionJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) GetIngestionJob(ctx context.Context, in *GetIngestionJobRequest, opts ...grpc.CallOption) (*IngestionJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestionJobResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_GetIngestionJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) RetryIngestionJob(ctx context.Context, in *RetryIngestionJobRequest, opts ...grpc.CallOption) (*IngestionJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestionJobResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_RetryIngestionJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleKnowledgeClient) CancelIngestionJob(ctx context.Context, in *CancelIngestionJobRequest, opts ...grpc.CallOption) (*IngestionJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestionJobResponse)
+	err := c.cc.Invoke(ctx, ConsoleKnowledge_CancelIngestionJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConsoleKnowledgeServer is the server API for ConsoleKnowledge service.
 // All implementations must embed UnimplementedConsoleKnowledgeServer
 // for forward compatibility.
@@ -233,8 +425,24 @@ type ConsoleKnowledgeServer interface {
 	GetDocument(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error)
 	DeleteDocument(context.Context, *DeleteDocumentRequest) (*emptypb.Empty, error)
 	UpdateDocument(context.Context, *UpdateDocumentRequest) (*DocumentResponse, error)
+	SetDocumentAccessLabels(context.Context, *SetDocumentAccessLabelsRequest) (*DocumentResponse, error)
 	ReindexDocument(context.Context, *ReindexDocumentRequest) (*emptypb.Empty, error)
 	RollbackDocument(context.Context, *RollbackDocumentRequest) (*emptypb.Empty, error)
+	DiffDocumentVersions(context.Context, *DiffDocumentVersionsRequest) (*DocumentVersionDiffResponse, error)
+	ListDocumentChunks(context.Context, *ListDocumentChunksRequest) (*ListChunksResponse, error)
+	SearchChunks(context.Context, *SearchChunksRequest) (*ListChunksResponse, error)
+	UpdateChunk(context.Context, *UpdateChunkRequest) (*ChunkResponse, error)
+	ListChunkEdits(context.Context, *ListChunkEditsRequest) (*ListChunkEditsResponse, error)
+	StartKnowledgeBaseMigration(context.Context, *StartKnowledgeBaseMigrationRequest) (*KnowledgeBaseMigrationResponse, error)
+	GetKnowledgeBaseMigration(context.Context, *GetKnowledgeBaseMigrationRequest) (*KnowledgeBaseMigrationResponse, error)
+	ListKnowledgeBaseMigrations(context.Context, *ListKnowledgeBaseMigrationsRequest) (*ListKnowledgeBaseMigrationsResponse, error)
+	CancelKnowledgeBaseMigration(context.Context, *CancelKnowledgeBaseMigrationRequest) (*KnowledgeBaseMigrationResponse, error)
+	GetImportBatch(context.Context, *GetImportBatchRequest) (*ImportBatchResponse, error)
+	GetDuplicateReport(context.Context, *GetDuplicateReportRequest) (*DuplicateReportResponse, error)
+	ListIngestionJobs(context.Context, *ListIngestionJobsRequest) (*ListIngestionJobsResponse, error)
+	GetIngestionJob(context.Context, *GetIngestionJobRequest) (*IngestionJobResponse, error)
+	RetryIngestionJob(context.Context, *RetryIngestionJobRequest) (*IngestionJobResponse, error)
+	CancelIngestionJob(context.Context, *CancelIngestionJobRequest) (*IngestionJobResponse, error)
 	mustEmbedUnimplementedConsoleKnowledgeServer()
 }
 
@@ -284,12 +492,60 @@ func (UnimplementedConsoleKnowledgeServer) DeleteDocument(context.Context, *Dele
 func (UnimplementedConsoleKnowledgeServer) UpdateDocument(context.Context, *UpdateDocumentRequest) (*DocumentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateDocument not implemented")
 }
+func (UnimplementedConsoleKnowledgeServer) SetDocumentAccessLabels(context.Context, *SetDocumentAccessLabelsRequest) (*DocumentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDocumentAccessLabels not implemented")
+}
 func (UnimplementedConsoleKnowledgeServer) ReindexDocument(context.Context, *ReindexDocumentRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReindexDocument not implemented")
 }
 func (UnimplementedConsoleKnowledgeServer) RollbackDocument(context.Context, *RollbackDocumentRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RollbackDocument not implemented")
 }
+func (UnimplementedConsoleKnowledgeServer) DiffDocumentVersions(context.Context, *DiffDocumentVersionsRequest) (*DocumentVersionDiffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffDocumentVersions not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) ListDocumentChunks(context.Context, *ListDocumentChunksRequest) (*ListChunksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDocumentChunks not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) SearchChunks(context.Context, *SearchChunksRequest) (*ListChunksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchChunks not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) UpdateChunk(context.Context, *UpdateChunkRequest) (*ChunkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateChunk not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) ListChunkEdits(context.Context, *ListChunkEditsRequest) (*ListChunkEditsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChunkEdits not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) StartKnowledgeBaseMigration(context.Context, *StartKnowledgeBaseMigrationRequest) (*KnowledgeBaseMigrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartKnowledgeBaseMigration not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) GetKnowledgeBaseMigration(context.Context, *GetKnowledgeBaseMigrationRequest) (*KnowledgeBaseMigrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetKnowledgeBaseMigration not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) ListKnowledgeBaseMigrations(context.Context, *ListKnowledgeBaseMigrationsRequest) (*ListKnowledgeBaseMigrationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListKnowledgeBaseMigrations not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) CancelKnowledgeBaseMigration(context.Context, *CancelKnowledgeBaseMigrationRequest) (*KnowledgeBaseMigrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelKnowledgeBaseMigration not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) GetImportBatch(context.Context, *GetImportBatchRequest) (*ImportBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetImportBatch not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) GetDuplicateReport(context.Context, *GetDuplicateReportRequest) (*DuplicateReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDuplicateReport not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) ListIngestionJobs(context.Context, *ListIngestionJobsRequest) (*ListIngestionJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIngestionJobs not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) GetIngestionJob(context.Context, *GetIngestionJobRequest) (*IngestionJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIngestionJob not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) RetryIngestionJob(context.Context, *RetryIngestionJobRequest) (*IngestionJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryIngestionJob not implemented")
+}
+func (UnimplementedConsoleKnowledgeServer) CancelIngestionJob(context.Context, *CancelIngestionJobRequest) (*IngestionJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelIngestionJob not implemented")
+}
 func (UnimplementedConsoleKnowledgeServer) mustEmbedUnimplementedConsoleKnowledgeServer() {}
 func (UnimplementedConsoleKnowledgeServer) testEmbeddedByValue()                          {}
 
@@ -545,6 +801,24 @@ func _ConsoleKnowledge_UpdateDocument_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConsoleKnowledge_SetDocumentAccessLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDocumentAccessLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).SetDocumentAccessLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_SetDocumentAccessLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).SetDocumentAccessLabels(ctx, req.(*SetDocumentAccessLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConsoleKnowledge_ReindexDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReindexDocumentRequest)
 	if err := dec(in); err != nil {
@@ -581,6 +855,276 @@ func _ConsoleKnowledge_RollbackDocument_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConsoleKnowledge_DiffDocumentVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).DiffDocumentVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_DiffDocumentVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).DiffDocumentVersions(ctx, req.(*DiffDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_ListDocumentChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).ListDocumentChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_ListDocumentChunks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).ListDocumentChunks(ctx, req.(*ListDocumentChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_SearchChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).SearchChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_SearchChunks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).SearchChunks(ctx, req.(*SearchChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_UpdateChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).UpdateChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_UpdateChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).UpdateChunk(ctx, req.(*UpdateChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_ListChunkEdits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChunkEditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).ListChunkEdits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_ListChunkEdits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).ListChunkEdits(ctx, req.(*ListChunkEditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_StartKnowledgeBaseMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartKnowledgeBaseMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).StartKnowledgeBaseMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_StartKnowledgeBaseMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).StartKnowledgeBaseMigration(ctx, req.(*StartKnowledgeBaseMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_GetKnowledgeBaseMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKnowledgeBaseMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).GetKnowledgeBaseMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_GetKnowledgeBaseMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).GetKnowledgeBaseMigration(ctx, req.(*GetKnowledgeBaseMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_ListKnowledgeBaseMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKnowledgeBaseMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).ListKnowledgeBaseMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_ListKnowledgeBaseMigrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).ListKnowledgeBaseMigrations(ctx, req.(*ListKnowledgeBaseMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_CancelKnowledgeBaseMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelKnowledgeBaseMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).CancelKnowledgeBaseMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_CancelKnowledgeBaseMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).CancelKnowledgeBaseMigration(ctx, req.(*CancelKnowledgeBaseMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_GetImportBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).GetImportBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_GetImportBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).GetImportBatch(ctx, req.(*GetImportBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_GetDuplicateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDuplicateReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).GetDuplicateReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_GetDuplicateReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).GetDuplicateReport(ctx, req.(*GetDuplicateReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_ListIngestionJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIngestionJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).ListIngestionJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_ListIngestionJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).ListIngestionJobs(ctx, req.(*ListIngestionJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_GetIngestionJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIngestionJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).GetIngestionJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_GetIngestionJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).GetIngestionJob(ctx, req.(*GetIngestionJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_RetryIngestionJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryIngestionJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).RetryIngestionJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_RetryIngestionJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).RetryIngestionJob(ctx, req.(*RetryIngestionJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsoleKnowledge_CancelIngestionJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIngestionJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleKnowledgeServer).CancelIngestionJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConsoleKnowledge_CancelIngestionJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleKnowledgeServer).CancelIngestionJob(ctx, req.(*CancelIngestionJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConsoleKnowledge_ServiceDesc is the grpc.ServiceDesc for ConsoleKnowledge service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -640,6 +1184,10 @@ var ConsoleKnowledge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDocument",
 			Handler:    _ConsoleKnowledge_UpdateDocument_Handler,
 		},
+		{
+			MethodName: "SetDocumentAccessLabels",
+			Handler:    _ConsoleKnowledge_SetDocumentAccessLabels_Handler,
+		},
 		{
 			MethodName: "ReindexDocument",
 			Handler:    _ConsoleKnowledge_ReindexDocument_Handler,
@@ -648,6 +1196,66 @@ var ConsoleKnowledge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RollbackDocument",
 			Handler:    _ConsoleKnowledge_RollbackDocument_Handler,
 		},
+		{
+			MethodName: "DiffDocumentVersions",
+			Handler:    _ConsoleKnowledge_DiffDocumentVersions_Handler,
+		},
+		{
+			MethodName: "ListDocumentChunks",
+			Handler:    _ConsoleKnowledge_ListDocumentChunks_Handler,
+		},
+		{
+			MethodName: "SearchChunks",
+			Handler:    _ConsoleKnowledge_SearchChunks_Handler,
+		},
+		{
+			MethodName: "UpdateChunk",
+			Handler:    _ConsoleKnowledge_UpdateChunk_Handler,
+		},
+		{
+			MethodName: "ListChunkEdits",
+			Handler:    _ConsoleKnowledge_ListChunkEdits_Handler,
+		},
+		{
+			MethodName: "StartKnowledgeBaseMigration",
+			Handler:    _ConsoleKnowledge_StartKnowledgeBaseMigration_Handler,
+		},
+		{
+			MethodName: "GetKnowledgeBaseMigration",
+			Handler:    _ConsoleKnowledge_GetKnowledgeBaseMigration_Handler,
+		},
+		{
+			MethodName: "ListKnowledgeBaseMigrations",
+			Handler:    _ConsoleKnowledge_ListKnowledgeBaseMigrations_Handler,
+		},
+		{
+			MethodName: "CancelKnowledgeBaseMigration",
+			Handler:    _ConsoleKnowledge_CancelKnowledgeBaseMigration_Handler,
+		},
+		{
+			MethodName: "GetImportBatch",
+			Handler:    _ConsoleKnowledge_GetImportBatch_Handler,
+		},
+		{
+			MethodName: "GetDuplicateReport",
+			Handler:    _ConsoleKnowledge_GetDuplicateReport_Handler,
+		},
+		{
+			MethodName: "ListIngestionJobs",
+			Handler:    _ConsoleKnowledge_ListIngestionJobs_Handler,
+		},
+		{
+			MethodName: "GetIngestionJob",
+			Handler:    _ConsoleKnowledge_GetIngestionJob_Handler,
+		},
+		{
+			MethodName: "RetryIngestionJob",
+			Handler:    _ConsoleKnowledge_RetryIngestionJob_Handler,
+		},
+		{
+			MethodName: "CancelIngestionJob",
+			Handler:    _ConsoleKnowledge_CancelIngestionJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/knowledge/v1/console_knowledge.proto",
@@ -21,34 +21,66 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationConsoleKnowledgeBindBotKnowledgeBase = "/api.knowledge.v1.ConsoleKnowledge/BindBotKnowledgeBase"
+const OperationConsoleKnowledgeCancelIngestionJob = "/api.knowledge.v1.ConsoleKnowledge/CancelIngestionJob"
+const OperationConsoleKnowledgeCancelKnowledgeBaseMigration = "/api.knowledge.v1.ConsoleKnowledge/CancelKnowledgeBaseMigration"
 const OperationConsoleKnowledgeCreateKnowledgeBase = "/api.knowledge.v1.ConsoleKnowledge/CreateKnowledgeBase"
 const OperationConsoleKnowledgeDeleteDocument = "/api.knowledge.v1.ConsoleKnowledge/DeleteDocument"
 const OperationConsoleKnowledgeDeleteKnowledgeBase = "/api.knowledge.v1.ConsoleKnowledge/DeleteKnowledgeBase"
+const OperationConsoleKnowledgeDiffDocumentVersions = "/api.knowledge.v1.ConsoleKnowledge/DiffDocumentVersions"
 const OperationConsoleKnowledgeGetDocument = "/api.knowledge.v1.ConsoleKnowledge/GetDocument"
+const OperationConsoleKnowledgeGetDuplicateReport = "/api.knowledge.v1.ConsoleKnowledge/GetDuplicateReport"
+const OperationConsoleKnowledgeGetImportBatch = "/api.knowledge.v1.ConsoleKnowledge/GetImportBatch"
+const OperationConsoleKnowledgeGetIngestionJob = "/api.knowledge.v1.ConsoleKnowledge/GetIngestionJob"
 const OperationConsoleKnowledgeGetKnowledgeBase = "/api.knowledge.v1.ConsoleKnowledge/GetKnowledgeBase"
+const OperationConsoleKnowledgeGetKnowledgeBaseMigration = "/api.knowledge.v1.ConsoleKnowledge/GetKnowledgeBaseMigration"
 const OperationConsoleKnowledgeListBotKnowledgeBases = "/api.knowledge.v1.ConsoleKnowledge/ListBotKnowledgeBases"
+const OperationConsoleKnowledgeListChunkEdits = "/api.knowledge.v1.ConsoleKnowledge/ListChunkEdits"
+const OperationConsoleKnowledgeListDocumentChunks = "/api.knowledge.v1.ConsoleKnowledge/ListDocumentChunks"
 const OperationConsoleKnowledgeListDocuments = "/api.knowledge.v1.ConsoleKnowledge/ListDocuments"
+const OperationConsoleKnowledgeListIngestionJobs = "/api.knowledge.v1.ConsoleKnowledge/ListIngestionJobs"
+const OperationConsoleKnowledgeListKnowledgeBaseMigrations = "/api.knowledge.v1.ConsoleKnowledge/ListKnowledgeBaseMigrations"
 const OperationConsoleKnowledgeListKnowledgeBases = "/api.knowledge.v1.ConsoleKnowledge/ListKnowledgeBases"
 const OperationConsoleKnowledgeReindexDocument = "/api.knowledge.v1.ConsoleKnowledge/ReindexDocument"
+const OperationConsoleKnowledgeRetryIngestionJob = "/api.knowledge.v1.ConsoleKnowledge/RetryIngestionJob"
 const OperationConsoleKnowledgeRollbackDocument = "/api.knowledge.v1.ConsoleKnowledge/RollbackDocument"
+const OperationConsoleKnowledgeSearchChunks = "/api.knowledge.v1.ConsoleKnowledge/SearchChunks"
+const OperationConsoleKnowledgeSetDocumentAccessLabels = "/api.knowledge.v1.ConsoleKnowledge/SetDocumentAccessLabels"
+const OperationConsoleKnowledgeStartKnowledgeBaseMigration = "/api.knowledge.v1.ConsoleKnowledge/StartKnowledgeBaseMigration"
 const OperationConsoleKnowledgeUnbindBotKnowledgeBase = "/api.knowledge.v1.ConsoleKnowledge/UnbindBotKnowledgeBase"
+const OperationConsoleKnowledgeUpdateChunk = "/api.knowledge.v1.ConsoleKnowledge/UpdateChunk"
 const OperationConsoleKnowledgeUpdateDocument = "/api.knowledge.v1.ConsoleKnowledge/UpdateDocument"
 const OperationConsoleKnowledgeUpdateKnowledgeBase = "/api.knowledge.v1.ConsoleKnowledge/UpdateKnowledgeBase"
 const OperationConsoleKnowledgeUploadDocument = "/api.knowledge.v1.ConsoleKnowledge/UploadDocument"
 
 type ConsoleKnowledgeHTTPServer interface {
 	BindBotKnowledgeBase(context.Context, *BindBotKnowledgeBaseRequest) (*BotKnowledgeBaseResponse, error)
+	CancelIngestionJob(context.Context, *CancelIngestionJobRequest) (*IngestionJobResponse, error)
+	CancelKnowledgeBaseMigration(context.Context, *CancelKnowledgeBaseMigrationRequest) (*KnowledgeBaseMigrationResponse, error)
 	CreateKnowledgeBase(context.Context, *CreateKnowledgeBaseRequest) (*KnowledgeBaseResponse, error)
 	DeleteDocument(context.Context, *DeleteDocumentRequest) (*emptypb.Empty, error)
 	DeleteKnowledgeBase(context.Context, *DeleteKnowledgeBaseRequest) (*emptypb.Empty, error)
+	DiffDocumentVersions(context.Context, *DiffDocumentVersionsRequest) (*DocumentVersionDiffResponse, error)
 	GetDocument(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error)
+	GetDuplicateReport(context.Context, *GetDuplicateReportRequest) (*DuplicateReportResponse, error)
+	GetImportBatch(context.Context, *GetImportBatchRequest) (*ImportBatchResponse, error)
+	GetIngestionJob(context.Context, *GetIngestionJobRequest) (*IngestionJobResponse, error)
 	GetKnowledgeBase(context.Context, *GetKnowledgeBaseRequest) (*KnowledgeBaseResponse, error)
+	GetKnowledgeBaseMigration(context.Context, *GetKnowledgeBaseMigrationRequest) (*KnowledgeBaseMigrationResponse, error)
 	ListBotKnowledgeBases(context.Context, *ListBotKnowledgeBasesRequest) (*ListBotKnowledgeBasesResponse, error)
+	ListChunkEdits(context.Context, *ListChunkEditsRequest) (*ListChunkEditsResponse, error)
+	ListDocumentChunks(context.Context, *ListDocumentChunksRequest) (*ListChunksResponse, error)
 	ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error)
+	ListIngestionJobs(context.Context, *ListIngestionJobsRequest) (*ListIngestionJobsResponse, error)
+	ListKnowledgeBaseMigrations(context.Context, *ListKnowledgeBaseMigrationsRequest) (*ListKnowledgeBaseMigrationsResponse, error)
 	ListKnowledgeBases(context.Context, *ListKnowledgeBasesRequest) (*ListKnowledgeBasesResponse, error)
 	ReindexDocument(context.Context, *ReindexDocumentRequest) (*emptypb.Empty, error)
+	RetryIngestionJob(context.Context, *RetryIngestionJobRequest) (*IngestionJobResponse, error)
 	RollbackDocument(context.Context, *RollbackDocumentRequest) (*emptypb.Empty, error)
+	SearchChunks(context.Context, *SearchChunksRequest) (*ListChunksResponse, error)
+	SetDocumentAccessLabels(context.Context, *SetDocumentAccessLabelsRequest) (*DocumentResponse, error)
+	StartKnowledgeBaseMigration(context.Context, *StartKnowledgeBaseMigrationRequest) (*KnowledgeBaseMigrationResponse, error)
 	UnbindBotKnowledgeBase(context.Context, *UnbindBotKnowledgeBaseRequest) (*emptypb.Empty, error)
+	UpdateChunk(context.Context, *UpdateChunkRequest) (*ChunkResponse, error)
 	UpdateDocument(context.Context, *UpdateDocumentRequest) (*DocumentResponse, error)
 	UpdateKnowledgeBase(context.Context, *UpdateKnowledgeBaseRequest) (*KnowledgeBaseResponse, error)
 	UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error)
@@ -69,8 +101,24 @@ func RegisterConsoleKnowledgeHTTPServer(s *http.Server, srv ConsoleKnowledgeHTTP
 	r.GET("/console/v1/documents/{id}", _ConsoleKnowledge_GetDocument0_HTTP_Handler(srv))
 	r.DELETE("/console/v1/documents/{id}", _ConsoleKnowledge_DeleteDocument0_HTTP_Handler(srv))
 	r.PATCH("/console/v1/documents/{id}", _ConsoleKnowledge_UpdateDocument0_HTTP_Handler(srv))
+	r.PUT("/console/v1/documents/{id}/access_labels", _ConsoleKnowledge_SetDocumentAccessLabels0_HTTP_Handler(srv))
 	r.POST("/console/v1/documents/{id}/reindex", _ConsoleKnowledge_ReindexDocument0_HTTP_Handler(srv))
 	r.POST("/console/v1/documents/{id}/rollback", _ConsoleKnowledge_RollbackDocument0_HTTP_Handler(srv))
+	r.GET("/console/v1/documents/{id}/diff", _ConsoleKnowledge_DiffDocumentVersions0_HTTP_Handler(srv))
+	r.GET("/console/v1/documents/{id}/chunks", _ConsoleKnowledge_ListDocumentChunks0_HTTP_Handler(srv))
+	r.GET("/console/v1/knowledge_bases/{kb_id}/chunks/search", _ConsoleKnowledge_SearchChunks0_HTTP_Handler(srv))
+	r.PATCH("/console/v1/chunks/{id}", _ConsoleKnowledge_UpdateChunk0_HTTP_Handler(srv))
+	r.GET("/console/v1/documents/{id}/chunk_edits", _ConsoleKnowledge_ListChunkEdits0_HTTP_Handler(srv))
+	r.POST("/console/v1/knowledge_bases/{kb_id}/migrations", _ConsoleKnowledge_StartKnowledgeBaseMigration0_HTTP_Handler(srv))
+	r.GET("/console/v1/knowledge_bases/{kb_id}/migrations/{id}", _ConsoleKnowledge_GetKnowledgeBaseMigration0_HTTP_Handler(srv))
+	r.GET("/console/v1/knowledge_bases/{kb_id}/migrations", _ConsoleKnowledge_ListKnowledgeBaseMigrations0_HTTP_Handler(srv))
+	r.POST("/console/v1/knowledge_bases/{kb_id}/migrations/{id}/cancel", _ConsoleKnowledge_CancelKnowledgeBaseMigration0_HTTP_Handler(srv))
+	r.GET("/console/v1/import_batches/{id}", _ConsoleKnowledge_GetImportBatch0_HTTP_Handler(srv))
+	r.GET("/console/v1/knowledge_bases/{kb_id}/duplicates", _ConsoleKnowledge_GetDuplicateReport0_HTTP_Handler(srv))
+	r.GET("/console/v1/knowledge_bases/{kb_id}/ingestion_jobs", _ConsoleKnowledge_ListIngestionJobs0_HTTP_Handler(srv))
+	r.GET("/console/v1/ingestion_jobs/{id}", _ConsoleKnowledge_GetIngestionJob0_HTTP_Handler(srv))
+	r.POST("/console/v1/ingestion_jobs/{id}/retry", _ConsoleKnowledge_RetryIngestionJob0_HTTP_Handler(srv))
+	r.POST("/console/v1/ingestion_jobs/{id}/cancel", _ConsoleKnowledge_CancelIngestionJob0_HTTP_Handler(srv))
 }
 
 func _ConsoleKnowledge_CreateKnowledgeBase0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
@@ -362,6 +410,31 @@ func _ConsoleKnowledge_UpdateDocument0_HTTP_Handler(srv ConsoleKnowledgeHTTPServ
 	}
 }
 
+func _ConsoleKnowledge_SetDocumentAccessLabels0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetDocumentAccessLabelsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeSetDocumentAccessLabels)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetDocumentAccessLabels(ctx, req.(*SetDocumentAccessLabelsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DocumentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _ConsoleKnowledge_ReindexDocument0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ReindexDocumentRequest
@@ -412,19 +485,380 @@ func _ConsoleKnowledge_RollbackDocument0_HTTP_Handler(srv ConsoleKnowledgeHTTPSe
 	}
 }
 
+func _ConsoleKnowledge_DiffDocumentVersions0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DiffDocumentVersionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeDiffDocumentVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DiffDocumentVersions(ctx, req.(*DiffDocumentVersionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DocumentVersionDiffResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_ListDocumentChunks0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDocumentChunksRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeListDocumentChunks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDocumentChunks(ctx, req.(*ListDocumentChunksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListChunksResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_SearchChunks0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchChunksRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeSearchChunks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchChunks(ctx, req.(*SearchChunksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListChunksResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_UpdateChunk0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateChunkRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeUpdateChunk)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateChunk(ctx, req.(*UpdateChunkRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChunkResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_ListChunkEdits0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListChunkEditsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeListChunkEdits)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListChunkEdits(ctx, req.(*ListChunkEditsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListChunkEditsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_StartKnowledgeBaseMigration0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StartKnowledgeBaseMigrationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeStartKnowledgeBaseMigration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartKnowledgeBaseMigration(ctx, req.(*StartKnowledgeBaseMigrationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*KnowledgeBaseMigrationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_GetKnowledgeBaseMigration0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetKnowledgeBaseMigrationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeGetKnowledgeBaseMigration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetKnowledgeBaseMigration(ctx, req.(*GetKnowledgeBaseMigrationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*KnowledgeBaseMigrationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_ListKnowledgeBaseMigrations0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListKnowledgeBaseMigrationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeListKnowledgeBaseMigrations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListKnowledgeBaseMigrations(ctx, req.(*ListKnowledgeBaseMigrationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListKnowledgeBaseMigrationsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_CancelKnowledgeBaseMigration0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelKnowledgeBaseMigrationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeCancelKnowledgeBaseMigration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelKnowledgeBaseMigration(ctx, req.(*CancelKnowledgeBaseMigrationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*KnowledgeBaseMigrationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_GetImportBatch0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetImportBatchRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeGetImportBatch)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetImportBatch(ctx, req.(*GetImportBatchRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportBatchResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_GetDuplicateReport0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetDuplicateReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeGetDuplicateReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDuplicateReport(ctx, req.(*GetDuplicateReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DuplicateReportResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_ListIngestionJobs0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListIngestionJobsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeListIngestionJobs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListIngestionJobs(ctx, req.(*ListIngestionJobsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListIngestionJobsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_GetIngestionJob0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetIngestionJobRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeGetIngestionJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetIngestionJob(ctx, req.(*GetIngestionJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*IngestionJobResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_RetryIngestionJob0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RetryIngestionJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeRetryIngestionJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RetryIngestionJob(ctx, req.(*RetryIngestionJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*IngestionJobResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ConsoleKnowledge_CancelIngestionJob0_HTTP_Handler(srv ConsoleKnowledgeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelIngestionJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationConsoleKnowledgeCancelIngestionJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelIngestionJob(ctx, req.(*CancelIngestionJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*IngestionJobResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ConsoleKnowledgeHTTPClient interface {
 	BindBotKnowledgeBase(ctx context.Context, req *BindBotKnowledgeBaseRequest, opts ...http.CallOption) (rsp *BotKnowledgeBaseResponse, err error)
+	CancelIngestionJob(ctx context.Context, req *CancelIngestionJobRequest, opts ...http.CallOption) (rsp *IngestionJobResponse, err error)
+	CancelKnowledgeBaseMigration(ctx context.Context, req *CancelKnowledgeBaseMigrationRequest, opts ...http.CallOption) (rsp *KnowledgeBaseMigrationResponse, err error)
 	CreateKnowledgeBase(ctx context.Context, req *CreateKnowledgeBaseRequest, opts ...http.CallOption) (rsp *KnowledgeBaseResponse, err error)
 	DeleteDocument(ctx context.Context, req *DeleteDocumentRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	DeleteKnowledgeBase(ctx context.Context, req *DeleteKnowledgeBaseRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DiffDocumentVersions(ctx context.Context, req *DiffDocumentVersionsRequest, opts ...http.CallOption) (rsp *DocumentVersionDiffResponse, err error)
 	GetDocument(ctx context.Context, req *GetDocumentRequest, opts ...http.CallOption) (rsp *GetDocumentResponse, err error)
+	GetDuplicateReport(ctx context.Context, req *GetDuplicateReportRequest, opts ...http.CallOption) (rsp *DuplicateReportResponse, err error)
+	GetImportBatch(ctx context.Context, req *GetImportBatchRequest, opts ...http.CallOption) (rsp *ImportBatchResponse, err error)
+	GetIngestionJob(ctx context.Context, req *GetIngestionJobRequest, opts ...http.CallOption) (rsp *IngestionJobResponse, err error)
 	GetKnowledgeBase(ctx context.Context, req *GetKnowledgeBaseRequest, opts ...http.CallOption) (rsp *KnowledgeBaseResponse, err error)
+	GetKnowledgeBaseMigration(ctx context.Context, req *GetKnowledgeBaseMigrationRequest, opts ...http.CallOption) (rsp *KnowledgeBaseMigrationResponse, err error)
 	ListBotKnowledgeBases(ctx context.Context, req *ListBotKnowledgeBasesRequest, opts ...http.CallOption) (rsp *ListBotKnowledgeBasesResponse, err error)
+	ListChunkEdits(ctx context.Context, req *ListChunkEditsRequest, opts ...http.CallOption) (rsp *ListChunkEditsResponse, err error)
+	ListDocumentChunks(ctx context.Context, req *ListDocumentChunksRequest, opts ...http.CallOption) (rsp *ListChunksResponse, err error)
 	ListDocuments(ctx context.Context, req *ListDocumentsRequest, opts ...http.CallOption) (rsp *ListDocumentsResponse, err error)
+	ListIngestionJobs(ctx context.Context, req *ListIngestionJobsRequest, opts ...http.CallOption) (rsp *ListIngestionJobsResponse, err error)
+	ListKnowledgeBaseMigrations(ctx context.Context, req *ListKnowledgeBaseMigrationsRequest, opts ...http.CallOption) (rsp *ListKnowledgeBaseMigrationsResponse, err error)
 	ListKnowledgeBases(ctx context.Context, req *ListKnowledgeBasesRequest, opts ...http.CallOption) (rsp *ListKnowledgeBasesResponse, err error)
 	ReindexDocument(ctx context.Context, req *ReindexDocumentRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	RetryIngestionJob(ctx context.Context, req *RetryIngestionJobRequest, opts ...http.CallOption) (rsp *IngestionJobResponse, err error)
 	RollbackDocument(ctx context.Context, req *RollbackDocumentRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	SearchChunks(ctx context.Context, req *SearchChunksRequest, opts ...http.CallOption) (rsp *ListChunksResponse, err error)
+	SetDocumentAccessLabels(ctx context.Context, req *SetDocumentAccessLabelsRequest, opts ...http.CallOption) (rsp *DocumentResponse, err error)
+	StartKnowledgeBaseMigration(ctx context.Context, req *StartKnowledgeBaseMigrationRequest, opts ...http.CallOption) (rsp *KnowledgeBaseMigrationResponse, err error)
 	UnbindBotKnowledgeBase(ctx context.Context, req *UnbindBotKnowledgeBaseRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	UpdateChunk(ctx context.Context, req *UpdateChunkRequest, opts ...http.CallOption) (rsp *ChunkResponse, err error)
 	UpdateDocument(ctx context.Context, req *UpdateDocumentRequest, opts ...http.CallOption) (rsp *DocumentResponse, err error)
 	UpdateKnowledgeBase(ctx context.Context, req *UpdateKnowledgeBaseRequest, opts ...http.CallOption) (rsp *KnowledgeBaseResponse, err error)
 	UploadDocument(ctx context.Context, req *UploadDocumentRequest, opts ...http.CallOption) (rsp *UploadDocumentResponse, err error)
@@ -451,6 +885,32 @@ func (c *ConsoleKnowledgeHTTPClientImpl) BindBotKnowledgeBase(ctx context.Contex
 	return &out, nil
 }
 
+func (c *ConsoleKnowledgeHTTPClientImpl) CancelIngestionJob(ctx context.Context, in *CancelIngestionJobRequest, opts ...http.CallOption) (*IngestionJobResponse, error) {
+	var out IngestionJobResponse
+	pattern := "/console/v1/ingestion_jobs/{id}/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeCancelIngestionJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleKnowledgeHTTPClientImpl) CancelKnowledgeBaseMigration(ctx context.Context, in *CancelKnowledgeBaseMigrationRequest, opts ...http.CallOption) (*KnowledgeBaseMigrationResponse, error) {
+	var out KnowledgeBaseMigrationResponse
+	pattern := "/console/v1/knowledge_bases/{kb_id}/migrations/{id}/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeCancelKnowledgeBaseMigration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleKnowledgeHTTPClientImpl) CreateKnowledgeBase(ctx context.Context, in *CreateKnowledgeBaseRequest, opts ...http.CallOption) (*KnowledgeBaseResponse, error) {
 	var out KnowledgeBaseResponse
 	pattern := "/console/v1/knowledge_bases"
@@ -490,6 +950,19 @@ func (c *ConsoleKnowledgeHTTPClientImpl) DeleteKnowledgeBase(ctx context.Context
 	return &out, nil
 }
 
+func (c *ConsoleKnowledgeHTTPClientImpl) DiffDocumentVersions(ctx context.Context, in *DiffDocumentVersionsRequest, opts ...http.CallOption) (*DocumentVersionDiffResponse, error) {
+	var out DocumentVersionDiffResponse
+	pattern := "/console/v1/documents/{id}/diff"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeDiffDocumentVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleKnowledgeHTTPClientImpl) GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...http.CallOption) (*GetDocumentResponse, error) {
 	var out GetDocumentResponse
 	pattern := "/console/v1/documents/{id}"
@@ -503,6 +976,45 @@ func (c *ConsoleKnowledgeHTTPClientImpl) GetDocument(ctx context.Context, in *Ge
 	return &out, nil
 }
 
+func (c *ConsoleKnowledgeHTTPClientImpl) GetDuplicateReport(ctx context.Context, in *GetDuplicateReportRequest, opts ...http.CallOption) (*DuplicateReportResponse, error) {
+	var out DuplicateReportResponse
+	pattern := "/console/v1/knowledge_bases/{kb_id}/duplicates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeGetDuplicateReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleKnowledgeHTTPClientImpl) GetImportBatch(ctx context.Context, in *GetImportBatchRequest, opts ...http.CallOption) (*ImportBatchResponse, error) {
+	var out ImportBatchResponse
+	pattern := "/console/v1/import_batches/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeGetImportBatch))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleKnowledgeHTTPClientImpl) GetIngestionJob(ctx context.Context, in *GetIngestionJobRequest, opts ...http.CallOption) (*IngestionJobResponse, error) {
+	var out IngestionJobResponse
+	pattern := "/console/v1/ingestion_jobs/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeGetIngestionJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleKnowledgeHTTPClientImpl) GetKnowledgeBase(ctx context.Context, in *GetKnowledgeBaseRequest, opts ...http.CallOption) (*KnowledgeBaseResponse, error) {
 	var out KnowledgeBaseResponse
 	pattern := "/console/v1/knowledge_bases/{id}"
@@ -516,6 +1028,19 @@ func (c *ConsoleKnowledgeHTTPClientImpl) GetKnowledgeBase(ctx context.Context, i
 	return &out, nil
 }
 
+func (c *ConsoleKnowledgeHTTPClientImpl) GetKnowledgeBaseMigration(ctx context.Context, in *GetKnowledgeBaseMigrationRequest, opts ...http.CallOption) (*KnowledgeBaseMigrationResponse, error) {
+	var out KnowledgeBaseMigrationResponse
+	pattern := "/console/v1/knowledge_bases/{kb_id}/migrations/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeGetKnowledgeBaseMigration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleKnowledgeHTTPClientImpl) ListBotKnowledgeBases(ctx context.Context, in *ListBotKnowledgeBasesRequest, opts ...http.CallOption) (*ListBotKnowledgeBasesResponse, error) {
 	var out ListBotKnowledgeBasesResponse
 	pattern := "/console/v1/bots/{bot_id}/knowledge_bases"
@@ -529,6 +1054,32 @@ func (c *ConsoleKnowledgeHTTPClientImpl) ListBotKnowledgeBases(ctx context.Conte
 	return &out, nil
 }
 
+func (c *ConsoleKnowledgeHTTPClientImpl) ListChunkEdits(ctx context.Context, in *ListChunkEditsRequest, opts ...http.CallOption) (*ListChunkEditsResponse, error) {
+	var out ListChunkEditsResponse
+	pattern := "/console/v1/documents/{id}/chunk_edits"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeListChunkEdits))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleKnowledgeHTTPClientImpl) ListDocumentChunks(ctx context.Context, in *ListDocumentChunksRequest, opts ...http.CallOption) (*ListChunksResponse, error) {
+	var out ListChunksResponse
+	pattern := "/console/v1/documents/{id}/chunks"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeListDocumentChunks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleKnowledgeHTTPClientImpl) ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...http.CallOption) (*ListDocumentsResponse, error) {
 	var out ListDocumentsResponse
 	pattern := "/console/v1/documents"
@@ -542,6 +1093,32 @@ func (c *ConsoleKnowledgeHTTPClientImpl) ListDocuments(ctx context.Context, in *
 	return &out, nil
 }
 
+func (c *ConsoleKnowledgeHTTPClientImpl) ListIngestionJobs(ctx context.Context, in *ListIngestionJobsRequest, opts ...http.CallOption) (*ListIngestionJobsResponse, error) {
+	var out ListIngestionJobsResponse
+	pattern := "/console/v1/knowledge_bases/{kb_id}/ingestion_jobs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeListIngestionJobs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleKnowledgeHTTPClientImpl) ListKnowledgeBaseMigrations(ctx context.Context, in *ListKnowledgeBaseMigrationsRequest, opts ...http.CallOption) (*ListKnowledgeBaseMigrationsResponse, error) {
+	var out ListKnowledgeBaseMigrationsResponse
+	pattern := "/console/v1/knowledge_bases/{kb_id}/migrations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeListKnowledgeBaseMigrations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleKnowledgeHTTPClientImpl) ListKnowledgeBases(ctx context.Context, in *ListKnowledgeBasesRequest, opts ...http.CallOption) (*ListKnowledgeBasesResponse, error) {
 	var out ListKnowledgeBasesResponse
 	pattern := "/console/v1/knowledge_bases"
@@ -568,6 +1145,19 @@ func (c *ConsoleKnowledgeHTTPClientImpl) ReindexDocument(ctx context.Context, in
 	return &out, nil
 }
 
+func (c *ConsoleKnowledgeHTTPClientImpl) RetryIngestionJob(ctx context.Context, in *RetryIngestionJobRequest, opts ...http.CallOption) (*IngestionJobResponse, error) {
+	var out IngestionJobResponse
+	pattern := "/console/v1/ingestion_jobs/{id}/retry"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeRetryIngestionJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleKnowledgeHTTPClientImpl) RollbackDocument(ctx context.Context, in *RollbackDocumentRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/console/v1/documents/{id}/rollback"
@@ -581,6 +1171,45 @@ func (c *ConsoleKnowledgeHTTPClientImpl) RollbackDocument(ctx context.Context, i
 	return &out, nil
 }
 
+func (c *ConsoleKnowledgeHTTPClientImpl) SearchChunks(ctx context.Context, in *SearchChunksRequest, opts ...http.CallOption) (*ListChunksResponse, error) {
+	var out ListChunksResponse
+	pattern := "/console/v1/knowledge_bases/{kb_id}/chunks/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeSearchChunks))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleKnowledgeHTTPClientImpl) SetDocumentAccessLabels(ctx context.Context, in *SetDocumentAccessLabelsRequest, opts ...http.CallOption) (*DocumentResponse, error) {
+	var out DocumentResponse
+	pattern := "/console/v1/documents/{id}/access_labels"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeSetDocumentAccessLabels))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ConsoleKnowledgeHTTPClientImpl) StartKnowledgeBaseMigration(ctx context.Context, in *StartKnowledgeBaseMigrationRequest, opts ...http.CallOption) (*KnowledgeBaseMigrationResponse, error) {
+	var out KnowledgeBaseMigrationResponse
+	pattern := "/console/v1/knowledge_bases/{kb_id}/migrations"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeStartKnowledgeBaseMigration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleKnowledgeHTTPClientImpl) UnbindBotKnowledgeBase(ctx context.Context, in *UnbindBotKnowledgeBaseRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/console/v1/bots/{bot_id}/knowledge_bases/{kb_id}"
@@ -594,6 +1223,19 @@ func (c *ConsoleKnowledgeHTTPClientImpl) UnbindBotKnowledgeBase(ctx context.Cont
 	return &out, nil
 }
 
+func (c *ConsoleKnowledgeHTTPClientImpl) UpdateChunk(ctx context.Context, in *UpdateChunkRequest, opts ...http.CallOption) (*ChunkResponse, error) {
+	var out ChunkResponse
+	pattern := "/console/v1/chunks/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationConsoleKnowledgeUpdateChunk))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PATCH", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ConsoleKnowledgeHTTPClientImpl) UpdateDocument(ctx context.Context, in *UpdateDocumentRequest, opts ...http.CallOption) (*DocumentResponse, error) {
 	var out DocumentResponse
 	pattern := "/console/v1/documents/{id}"
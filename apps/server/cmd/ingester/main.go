@@ -89,7 +89,8 @@ func main() {
 	}()
 
 	repo := knowledgedata.NewKnowledgeRepo(dataData, bc.Data, logger)
-	uc := knowledgebiz.NewKnowledgeUsecase(repo, queue, bc.Data, logger)
+	ocr := knowledgedata.NewOCREngine(bc.Data, logger)
+	uc := knowledgebiz.NewKnowledgeUsecase(repo, queue, ocr, knowledgedata.NewCredentialRepo(dataData), bc.Data, logger)
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
@@ -99,6 +100,9 @@ func main() {
 		return
 	}
 
+	uc.StartMigrationWorker(ctx)
+	uc.StartVectorOutboxWorker(ctx)
+
 	helper.Info("ingestion worker started")
 	<-ctx.Done()
 	helper.Info("ingestion worker stopped")
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	iambiz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
 	knowledgebiz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
 
 	"github.com/go-kratos/kratos/v2"
@@ -38,7 +39,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, knowledgeUC *knowledgebiz.KnowledgeUsecase) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, knowledgeUC *knowledgebiz.KnowledgeUsecase, iamUC *iambiz.IAMUsecase) *kratos.App {
 	options := []kratos.Option{
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -50,6 +51,19 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, knowledgeUC *kn
 			hs,
 		),
 	}
+	if knowledgeUC != nil {
+		options = append(options, kratos.AfterStart(func(ctx context.Context) error {
+			knowledgeUC.StartMigrationWorker(ctx)
+			knowledgeUC.StartVectorOutboxWorker(ctx)
+			return nil
+		}))
+	}
+	if iamUC != nil {
+		options = append(options, kratos.AfterStart(func(ctx context.Context) error {
+			iamUC.StartTenantPurgeWorker(ctx)
+			return nil
+		}))
+	}
 	if knowledgeUC != nil && knowledgeUC.AsyncEnabled() {
 		helper := log.NewHelper(logger)
 		options = append(options,
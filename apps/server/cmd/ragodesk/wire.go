@@ -8,11 +8,13 @@ package main
 import (
 	analyticsdata "github.com/ZTH7/RagoDesk/apps/server/internal/analytics/data"
 	apimgmtdata "github.com/ZTH7/RagoDesk/apps/server/internal/apimgmt/data"
+	auditdata "github.com/ZTH7/RagoDesk/apps/server/internal/audit/data"
 	authdata "github.com/ZTH7/RagoDesk/apps/server/internal/auth/data"
 	botdata "github.com/ZTH7/RagoDesk/apps/server/internal/bot/data"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/biz"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
 	conversationdata "github.com/ZTH7/RagoDesk/apps/server/internal/conversation/data"
+	credentialdata "github.com/ZTH7/RagoDesk/apps/server/internal/credential/data"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/data"
 	iamdata "github.com/ZTH7/RagoDesk/apps/server/internal/iam/data"
 	knowledgedata "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/data"
@@ -32,9 +34,11 @@ func wireApp(*conf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error)
 		data.NewData,
 		analyticsdata.ProviderSet,
 		apimgmtdata.ProviderSet,
+		auditdata.ProviderSet,
 		authdata.ProviderSet,
 		botdata.ProviderSet,
 		conversationdata.ProviderSet,
+		credentialdata.ProviderSet,
 		iamdata.ProviderSet,
 		knowledgedata.ProviderSet,
 		ragdata.ProviderSet,
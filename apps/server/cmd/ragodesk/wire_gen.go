@@ -13,6 +13,9 @@ import (
 	apimgmtbiz "github.com/ZTH7/RagoDesk/apps/server/internal/apimgmt/biz"
 	apimgmtdata "github.com/ZTH7/RagoDesk/apps/server/internal/apimgmt/data"
 	apimgmtservice "github.com/ZTH7/RagoDesk/apps/server/internal/apimgmt/service"
+	auditbiz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
+	auditdata "github.com/ZTH7/RagoDesk/apps/server/internal/audit/data"
+	auditservice "github.com/ZTH7/RagoDesk/apps/server/internal/audit/service"
 	authbiz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
 	authdata "github.com/ZTH7/RagoDesk/apps/server/internal/auth/data"
 	authservice "github.com/ZTH7/RagoDesk/apps/server/internal/auth/service"
@@ -23,6 +26,9 @@ import (
 	conversationbiz "github.com/ZTH7/RagoDesk/apps/server/internal/conversation/biz"
 	conversationdata "github.com/ZTH7/RagoDesk/apps/server/internal/conversation/data"
 	conversationservice "github.com/ZTH7/RagoDesk/apps/server/internal/conversation/service"
+	credentialbiz "github.com/ZTH7/RagoDesk/apps/server/internal/credential/biz"
+	credentialdata "github.com/ZTH7/RagoDesk/apps/server/internal/credential/data"
+	credentialservice "github.com/ZTH7/RagoDesk/apps/server/internal/credential/service"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/data"
 	iambiz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
 	iamdata "github.com/ZTH7/RagoDesk/apps/server/internal/iam/data"
@@ -59,14 +65,28 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	conversationUsecase := conversationbiz.NewConversationUsecase(conversationRepo, confData)
 	analyticsRepo := analyticsdata.NewAnalyticsRepo(dataData)
 	analyticsUsecase := analyticsbiz.NewAnalyticsUsecase(analyticsRepo, logger)
-	conversationService := conversationservice.NewConversationService(conversationUsecase, apimgmtUsecase, analyticsUsecase)
-	iamRepo := iamdata.NewIAMRepo(dataData, logger)
+	iamRepo := iamdata.NewIAMRepo(dataData, confData, logger)
 	iamUsecase := iambiz.NewIAMUsecase(iamRepo, logger)
-	iamService := iamservice.NewIAMService(iamUsecase, logger)
+	conversationService := conversationservice.NewConversationService(conversationUsecase, apimgmtUsecase, analyticsUsecase, iamUsecase)
 	authRepo := authdata.NewAuthRepo(dataData, logger)
-	authUsecase := authbiz.NewAuthUsecase(authRepo, confServer, logger)
-	consoleAuthService := authservice.NewConsoleAuthService(authUsecase)
+	sessionCache := authdata.NewSessionCache(confData, logger)
+	mfaRepo := authdata.NewMFARepo(dataData, logger)
+	signingKeyRepo := authdata.NewSigningKeyRepo(dataData, logger)
+	accountTokenRepo := authdata.NewAccountTokenRepo(dataData, logger)
+	impersonationRepo := authdata.NewImpersonationRepo(dataData, logger)
+	sender := authdata.NewMailSender(confServer, logger)
+	authUsecase := authbiz.NewAuthUsecase(authRepo, mfaRepo, signingKeyRepo, accountTokenRepo, impersonationRepo, sender, sessionCache, confServer, logger)
+	iamService := iamservice.NewIAMService(iamUsecase, authUsecase, logger)
+	ssoRepo := authdata.NewSSORepo(dataData, logger)
+	ssoUsecase := authbiz.NewSSOUsecase(ssoRepo, authUsecase, confServer, logger)
+	consoleAuthService := authservice.NewConsoleAuthService(authUsecase, ssoUsecase)
 	platformAuthService := authservice.NewPlatformAuthService(authUsecase)
+	ssoService := authservice.NewSSOService(ssoUsecase, iamUsecase, logger)
+	consoleMFAService := authservice.NewConsoleMFAService(authUsecase, iamUsecase)
+	platformMFAService := authservice.NewPlatformMFAService(authUsecase, iamUsecase)
+	platformImpersonationService := authservice.NewPlatformImpersonationService(authUsecase, iamUsecase)
+	consoleImpersonationService := authservice.NewConsoleImpersonationService(authUsecase, iamUsecase)
+	jwksService := authservice.NewJWKSService(authUsecase)
 	botRepo := botdata.NewBotRepo(dataData, logger)
 	botUsecase := botbiz.NewBotUsecase(botRepo, logger)
 	botService := botservice.NewBotService(botUsecase, iamUsecase)
@@ -74,19 +94,29 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	analyticsService := analyticsservice.NewAnalyticsService(analyticsUsecase, iamUsecase, logger)
 	knowledgeRepo := knowledgedata.NewKnowledgeRepo(dataData, confData, logger)
 	ingestionQueue := knowledgedata.NewIngestionQueue(confData, logger)
-	knowledgeUsecase := knowledgebiz.NewKnowledgeUsecase(knowledgeRepo, ingestionQueue, confData, logger)
-	knowledgeService := knowledgeservice.NewKnowledgeService(knowledgeUsecase, iamUsecase, confServer, logger)
+	ocrEngine := knowledgedata.NewOCREngine(confData, logger)
+	knowledgeCredentialRepo := knowledgedata.NewCredentialRepo(dataData)
+	knowledgeUsecase := knowledgebiz.NewKnowledgeUsecase(knowledgeRepo, ingestionQueue, ocrEngine, knowledgeCredentialRepo, confData, logger)
+	auditRepo := auditdata.NewAuditRepo(dataData)
+	auditUsecase := auditbiz.NewAuditUsecase(auditRepo, confData, logger)
+	knowledgeService := knowledgeservice.NewKnowledgeService(knowledgeUsecase, iamUsecase, authUsecase, auditUsecase, logger)
 	ragKBRepo := ragdata.NewKBRepo(dataData)
 	ragVectorRepo := ragdata.NewVectorRepo(confData)
 	ragChunkRepo := ragdata.NewChunkRepo(dataData)
-	ragUsecase, err := ragbiz.NewRAGUsecase(ragKBRepo, ragVectorRepo, ragChunkRepo, confData, logger)
+	ragCredentialRepo := ragdata.NewCredentialRepo(dataData)
+	ragUsecase, err := ragbiz.NewRAGUsecase(ragKBRepo, ragVectorRepo, ragChunkRepo, ragCredentialRepo, confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	ragService := ragservice.NewRAGService(ragUsecase, conversationUsecase, apimgmtUsecase, analyticsUsecase, logger)
-	grpcServer := server.NewGRPCServer(confServer, logger, iamService, knowledgeService, ragService, conversationService, apimgmtService, analyticsService, botService, consoleAuthService, platformAuthService)
-	httpServer := server.NewHTTPServer(confServer, logger, iamService, knowledgeService, ragService, conversationService, apimgmtService, analyticsService, botService, consoleAuthService, platformAuthService)
-	app := newApp(logger, grpcServer, httpServer, knowledgeUsecase)
+	consoleAuditService := auditservice.NewConsoleAuditService(auditUsecase, iamUsecase)
+	platformAuditService := auditservice.NewPlatformAuditService(auditUsecase, iamUsecase)
+	credentialRepo := credentialdata.NewCredentialRepo(dataData, logger)
+	credentialUsecase := credentialbiz.NewCredentialUsecase(credentialRepo, logger)
+	consoleCredentialService := credentialservice.NewConsoleCredentialService(credentialUsecase, ragUsecase, iamUsecase)
+	grpcServer := server.NewGRPCServer(confServer, logger, authUsecase, auditUsecase, iamService, knowledgeService, ragService, conversationService, apimgmtService, analyticsService, botService, consoleAuthService, platformAuthService, ssoService, consoleMFAService, platformMFAService, platformImpersonationService, consoleImpersonationService, consoleAuditService, platformAuditService, consoleCredentialService)
+	httpServer := server.NewHTTPServer(confServer, logger, authUsecase, auditUsecase, iamService, knowledgeService, ragService, conversationService, apimgmtService, analyticsService, botService, consoleAuthService, platformAuthService, ssoService, consoleMFAService, platformMFAService, platformImpersonationService, consoleImpersonationService, consoleAuditService, platformAuditService, consoleCredentialService, jwksService)
+	app := newApp(logger, grpcServer, httpServer, knowledgeUsecase, iamUsecase)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	knowledgebiz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	knowledgedata "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/data"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// go build -ldflags "-X main.Name=ragodesk-reconciler -X main.Version=x.y.z"
+var (
+	// Name is the name of the compiled software.
+	Name = "ragodesk-reconciler"
+	// Version is the version of the compiled software.
+	Version string
+
+	flagconf   string
+	flagTenant string
+	flagKB     string
+	flagRepair bool
+	flagLimit  int
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&flagTenant, "tenant", "", "tenant id to scan (required)")
+	flag.StringVar(&flagKB, "kb", "", "knowledge base id to scan; empty scans the whole tenant")
+	flag.BoolVar(&flagRepair, "repair", false, "delete orphan points and re-embed missing vectors")
+	flag.IntVar(&flagLimit, "limit", 20, "drift entries printed per kind")
+}
+
+// The reconciler diffs doc_chunk against Qdrant points for a tenant or
+// knowledge base. It exits with status 2 when drift was found and not
+// repaired.
+func main() {
+	flag.Parse()
+	if strings.TrimSpace(flagTenant) == "" {
+		fmt.Fprintln(os.Stderr, "-tenant is required")
+		os.Exit(1)
+	}
+	logger := log.With(log.NewStdLogger(os.Stderr),
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+		"service.name", Name,
+		"service.version", Version,
+	)
+
+	sources, err := loadConfigSources(flagconf)
+	if err != nil {
+		panic(err)
+	}
+	c := config.New(config.WithSource(sources...))
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	dataData, cleanup, err := data.NewData(bc.Data)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	repo := knowledgedata.NewKnowledgeRepo(dataData, bc.Data, logger)
+	uc := knowledgebiz.NewKnowledgeUsecase(repo, nil, nil, knowledgedata.NewCredentialRepo(dataData), bc.Data, logger)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx = tenant.WithTenantID(ctx, strings.TrimSpace(flagTenant))
+
+	report, err := uc.ReconcileVectors(ctx, flagKB, flagRepair)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "reconcile failed: %v\n", err)
+		os.Exit(1)
+	}
+	printReport(report)
+	if report.Drift() && (!flagRepair || report.Unrepaired > 0) {
+		os.Exit(2)
+	}
+}
+
+func printReport(report knowledgebiz.VectorReconcileReport) {
+	scope := "tenant=" + flagTenant
+	if report.KBID != "" {
+		scope += " kb=" + report.KBID
+	}
+	fmt.Printf("%s chunks=%d points=%d missing_vectors=%d orphan_points=%d skipped_documents=%d\n",
+		scope,
+		report.Chunks,
+		report.Points,
+		len(report.MissingVectors),
+		len(report.OrphanPoints),
+		report.SkippedDocuments,
+	)
+	for i, ch := range report.MissingVectors {
+		if i >= flagLimit {
+			fmt.Printf("  ... %d more missing vectors\n", len(report.MissingVectors)-flagLimit)
+			break
+		}
+		fmt.Printf("  missing chunk=%s document=%s version=%s collection=%q\n", ch.ChunkID, ch.DocumentID, ch.DocumentVersionID, ch.VectorCollection)
+	}
+	for i, p := range report.OrphanPoints {
+		if i >= flagLimit {
+			fmt.Printf("  ... %d more orphan points\n", len(report.OrphanPoints)-flagLimit)
+			break
+		}
+		fmt.Printf("  orphan point=%s document=%s collection=%q\n", p.PointID, p.DocumentID, p.VectorCollection)
+	}
+	if flagRepair {
+		fmt.Printf("repaired restored_vectors=%d deleted_points=%d unrepaired=%d\n", report.RestoredVectors, report.DeletedPoints, report.Unrepaired)
+	}
+}
+
+func loadConfigSources(confPath string) ([]config.Source, error) {
+	info, err := os.Stat(confPath)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []config.Source{file.NewSource(confPath)}, nil
+	}
+	entries, err := os.ReadDir(confPath)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		ext := strings.ToLower(filepath.Ext(name))
+		if ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+		files = append(files, filepath.Join(confPath, name))
+	}
+	sort.Slice(files, func(i, j int) bool {
+		pi := configPriority(files[i])
+		pj := configPriority(files[j])
+		if pi == pj {
+			return strings.ToLower(filepath.Base(files[i])) < strings.ToLower(filepath.Base(files[j]))
+		}
+		return pi < pj
+	})
+	sources := make([]config.Source, 0, len(files))
+	for _, f := range files {
+		sources = append(sources, file.NewSource(f))
+	}
+	return sources, nil
+}
+
+func configPriority(path string) int {
+	base := strings.ToLower(filepath.Base(path))
+	switch {
+	case strings.Contains(base, ".local."):
+		return 2
+	case base == "config.yaml" || base == "config.yml":
+		return 0
+	default:
+		return 1
+	}
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strings"
 )
 
@@ -111,3 +112,29 @@ func NewLLMProvider(cfg LLMConfig) LLMProvider {
 	}
 	return newTemplateLLMProvider(cfg)
 }
+
+// CollectionKey derives a vector collection suffix from an embedding model and
+// dimension, so every (model, dim) pair is stored separately.
+func CollectionKey(model string, dim int) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(model)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	return fmt.Sprintf("%s_%d", strings.Trim(b.String(), "_"), dim)
+}
+
+// GenerationCollectionKey appends the index generation of a knowledge base to a
+// collection key. Generation 0 keeps the key, so existing indexes stay in place.
+func GenerationCollectionKey(key string, generation int32) string {
+	if generation <= 0 {
+		return key
+	}
+	if key == "" {
+		return fmt.Sprintf("g%d", generation)
+	}
+	return fmt.Sprintf("%s_g%d", key, generation)
+}
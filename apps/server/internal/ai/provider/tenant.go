@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// credentialTTL bounds how long a looked-up tenant credential, or its
+// absence, is reused before the store is asked again.
+const credentialTTL = 30 * time.Second
+
+// Credential is an API key a tenant brought for a provider.
+type Credential struct {
+	ID        string
+	Provider  string
+	APIKey    string
+	UpdatedAt time.Time
+}
+
+// CredentialSource looks up the credential a tenant stored for a provider.
+type CredentialSource interface {
+	GetProviderCredential(ctx context.Context, tenantID string, providerName string) (Credential, bool, error)
+}
+
+// TenantClients builds and caches the providers of tenants that use their
+// own API keys. Clients are rebuilt when a credential is replaced.
+type TenantClients struct {
+	source CredentialSource
+
+	mu        sync.Mutex
+	lookups   map[string]credentialLookup
+	embedders map[string]tenantClient[Provider]
+	llms      map[string]tenantClient[LLMProvider]
+}
+
+type credentialLookup struct {
+	cred      Credential
+	ok        bool
+	expiresAt time.Time
+}
+
+type tenantClient[T any] struct {
+	version string
+	client  T
+}
+
+// NewTenantClients creates a TenantClients. A nil source disables tenant
+// credentials.
+func NewTenantClients(source CredentialSource) *TenantClients {
+	return &TenantClients{
+		source:    source,
+		lookups:   make(map[string]credentialLookup),
+		embedders: make(map[string]tenantClient[Provider]),
+		llms:      make(map[string]tenantClient[LLMProvider]),
+	}
+}
+
+// Embedder returns the embedding provider of cfg built with the tenant's
+// own credential, or false when the tenant has none for cfg.Provider.
+func (c *TenantClients) Embedder(ctx context.Context, tenantID string, cfg Config) (Provider, bool, error) {
+	cred, ok, err := c.credential(ctx, tenantID, providerName(cfg.Provider))
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	key := strings.Join([]string{tenantID, cred.Provider, cfg.Endpoint, cfg.Model, strconv.Itoa(cfg.Dim)}, "|")
+	version := credentialVersion(cred)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.embedders[key]; ok && cached.version == version {
+		return cached.client, true, nil
+	}
+	cfg.APIKey = cred.APIKey
+	client := &redactingProvider{Provider: NewProvider(cfg), apiKey: cred.APIKey}
+	c.embedders[key] = tenantClient[Provider]{version: version, client: client}
+	return client, true, nil
+}
+
+// LLM returns the LLM provider of cfg built with the tenant's own
+// credential, or false when the tenant has none for cfg.Provider.
+func (c *TenantClients) LLM(ctx context.Context, tenantID string, cfg LLMConfig) (LLMProvider, bool, error) {
+	cred, ok, err := c.credential(ctx, tenantID, providerName(cfg.Provider))
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	key := strings.Join([]string{tenantID, cred.Provider, cfg.Endpoint, cfg.Model}, "|")
+	version := credentialVersion(cred)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.llms[key]; ok && cached.version == version {
+		return cached.client, true, nil
+	}
+	cfg.APIKey = cred.APIKey
+	client := &redactingLLMProvider{LLMProvider: NewLLMProvider(cfg), apiKey: cred.APIKey}
+	c.llms[key] = tenantClient[LLMProvider]{version: version, client: client}
+	return client, true, nil
+}
+
+func (c *TenantClients) credential(ctx context.Context, tenantID string, name string) (Credential, bool, error) {
+	if c == nil || c.source == nil || tenantID == "" {
+		return Credential{}, false, nil
+	}
+	key := tenantID + "|" + name
+	now := time.Now()
+	c.mu.Lock()
+	cached, ok := c.lookups[key]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.cred, cached.ok, nil
+	}
+	cred, found, err := c.source.GetProviderCredential(ctx, tenantID, name)
+	if err != nil {
+		return Credential{}, false, err
+	}
+	cred.Provider = name
+	c.mu.Lock()
+	c.lookups[key] = credentialLookup{cred: cred, ok: found, expiresAt: now.Add(credentialTTL)}
+	c.mu.Unlock()
+	return cred, found, nil
+}
+
+func providerName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "openai"
+	}
+	return name
+}
+
+func credentialVersion(cred Credential) string {
+	return cred.ID + "@" + strconv.FormatInt(cred.UpdatedAt.UnixNano(), 10)
+}
+
+// RedactSecret removes secret from an error message, so upstream responses
+// that echo a key do not leak it into logs or API errors.
+func RedactSecret(err error, secret string) error {
+	if err == nil || secret == "" {
+		return err
+	}
+	se := errors.FromError(err)
+	if se == nil || !strings.Contains(se.Message, secret) {
+		if strings.Contains(err.Error(), secret) {
+			return errors.InternalServer("PROVIDER_REQUEST_FAILED", strings.ReplaceAll(err.Error(), secret, "[REDACTED]"))
+		}
+		return err
+	}
+	out := errors.New(int(se.Code), se.Reason, strings.ReplaceAll(se.Message, secret, "[REDACTED]"))
+	return out.WithMetadata(se.Metadata)
+}
+
+type redactingProvider struct {
+	Provider
+	apiKey string
+}
+
+func (p *redactingProvider) Embed(ctx context.Context, inputs []string) ([][]float32, error) {
+	out, err := p.Provider.Embed(ctx, inputs)
+	return out, RedactSecret(err, p.apiKey)
+}
+
+type redactingLLMProvider struct {
+	LLMProvider
+	apiKey string
+}
+
+func (p *redactingLLMProvider) Generate(ctx context.Context, req LLMRequest) (LLMResponse, error) {
+	out, err := p.LLMProvider.Generate(ctx, req)
+	return out, RedactSecret(err, p.apiKey)
+}
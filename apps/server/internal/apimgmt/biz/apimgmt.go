@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/csv"
 	"encoding/hex"
+	stderrors "errors"
 	"fmt"
 	"io"
 	"strconv"
@@ -16,7 +17,10 @@ import (
 
 	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/access"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/paging"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
@@ -65,6 +69,9 @@ type APIKey struct {
 	KeyHash           string
 	PublicChatID      string
 	PublicChatEnabled bool
+	// EntitlementSecret signs the end-user entitlement tokens accepted by
+	// sessions of this key; empty disables them.
+	EntitlementSecret string
 	APIVersions       []string
 	Scopes            []string
 	Status            APIKeyStatus
@@ -123,6 +130,8 @@ type UsageFilter struct {
 	End        time.Time
 	Limit      int
 	Offset     int
+	// BotIDs restricts results to these bots unless it is nil.
+	BotIDs []string
 }
 
 // UsageEvent is an analytics hook payload.
@@ -148,9 +157,12 @@ type APIMgmtRepo interface {
 	CreateAPIKey(ctx context.Context, key APIKey) (APIKey, error)
 	GetAPIKey(ctx context.Context, keyID string) (APIKey, error)
 	GetAPIKeyByPublicChatID(ctx context.Context, chatID string) (APIKey, error)
-	ListAPIKeys(ctx context.Context, botID string, limit int, offset int) ([]APIKey, error)
+	// ListAPIKeys lists the API keys of the tenant, only those of botIDs
+	// unless it is nil.
+	ListAPIKeys(ctx context.Context, botIDs []string, limit int, offset int) ([]APIKey, error)
 	UpdateAPIKey(ctx context.Context, key APIKey) (APIKey, error)
 	RegeneratePublicChatID(ctx context.Context, keyID string, chatID string) (APIKey, error)
+	SetEntitlementSecret(ctx context.Context, keyID string, secret string) (APIKey, error)
 	DeleteAPIKey(ctx context.Context, keyID string) error
 	RotateKey(ctx context.Context, keyID string, newHash string, graceUntil time.Time) (APIKey, error)
 	UpdateLastUsedAt(ctx context.Context, keyID string, lastUsedAt time.Time) error
@@ -158,11 +170,14 @@ type APIMgmtRepo interface {
 	GetAPIKeyByHash(ctx context.Context, keyHash string) (APIKey, error)
 	ListUsageLogs(ctx context.Context, filter UsageFilter) ([]UsageLog, error)
 	GetUsageSummary(ctx context.Context, filter UsageFilter) (UsageSummary, error)
+	GetTenantSubscription(ctx context.Context, tenantID string) (plan.Subscription, error)
 }
 
-// RateLimiter enforces QPS/quota limits.
+// RateLimiter enforces QPS/quota limits and the monthly plan quotas.
 type RateLimiter interface {
-	Check(ctx context.Context, key APIKey) error
+	Check(ctx context.Context, key APIKey, limits plan.Limits) error
+	// AddTokens counts tokens towards the monthly token quota of a tenant.
+	AddTokens(ctx context.Context, tenantID string, tokens int64)
 }
 
 // UsageExporter writes usage exports to object storage.
@@ -270,9 +285,9 @@ func (uc *APIMgmtUsecase) CreateAPIKey(ctx context.Context, name string, botID s
 	return APIKey{}, "", errors.InternalServer("API_KEY_GENERATE_FAILED", "generate api key failed")
 }
 
-func (uc *APIMgmtUsecase) ListAPIKeys(ctx context.Context, botID string, limit int, offset int) ([]APIKey, error) {
+func (uc *APIMgmtUsecase) ListAPIKeys(ctx context.Context, botIDs []string, limit int, offset int) ([]APIKey, error) {
 	limit, offset = paging.Normalize(limit, offset)
-	return uc.repo.ListAPIKeys(ctx, strings.TrimSpace(botID), limit, offset)
+	return uc.repo.ListAPIKeys(ctx, botIDs, limit, offset)
 }
 
 func (uc *APIMgmtUsecase) GetAPIKey(ctx context.Context, keyID string) (APIKey, error) {
@@ -366,6 +381,54 @@ func (uc *APIMgmtUsecase) RegeneratePublicChatID(ctx context.Context, keyID stri
 	return APIKey{}, errors.InternalServer("PUBLIC_CHAT_ID_GENERATE_FAILED", "generate public chat id failed")
 }
 
+// RegenerateEntitlementSecret replaces the entitlement secret of a key and
+// returns it; tokens signed with the previous secret stop verifying.
+func (uc *APIMgmtUsecase) RegenerateEntitlementSecret(ctx context.Context, keyID string) (APIKey, string, error) {
+	keyID = strings.TrimSpace(keyID)
+	if keyID == "" {
+		return APIKey{}, "", errors.BadRequest("API_KEY_ID_MISSING", "api key id missing")
+	}
+	secret := generateEntitlementSecret()
+	updated, err := uc.repo.SetEntitlementSecret(ctx, keyID, secret)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	return updated, secret, nil
+}
+
+// EndUser is the identity and entitlements asserted by an entitlement token.
+type EndUser struct {
+	ExternalID   string
+	Entitlements []string
+}
+
+// VerifyEntitlementToken verifies an HS256 token signed by the tenant's
+// backend with the entitlement secret of key. Tokens must expire.
+func (uc *APIMgmtUsecase) VerifyEntitlementToken(key APIKey, token string) (EndUser, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return EndUser{}, nil
+	}
+	if strings.TrimSpace(key.EntitlementSecret) == "" {
+		return EndUser{}, errors.Forbidden("ENTITLEMENT_SECRET_MISSING", "api key has no entitlement secret")
+	}
+	claims, err := jwt.ParseHS256(token, key.EntitlementSecret, "", "", time.Now())
+	if err != nil {
+		if stderrors.Is(err, jwt.ErrExpiredToken) {
+			return EndUser{}, errors.Unauthorized("ENTITLEMENT_TOKEN_EXPIRED", "entitlement token expired")
+		}
+		return EndUser{}, errors.Unauthorized("ENTITLEMENT_TOKEN_INVALID", "entitlement token invalid")
+	}
+	if claims.Expiry == 0 {
+		return EndUser{}, errors.Unauthorized("ENTITLEMENT_TOKEN_INVALID", "entitlement token has no exp")
+	}
+	entitlements, err := access.Normalize(claims.Entitlements)
+	if err != nil {
+		return EndUser{}, errors.BadRequest("ENTITLEMENT_INVALID", err.Error())
+	}
+	return EndUser{ExternalID: strings.TrimSpace(claims.Subject), Entitlements: entitlements}, nil
+}
+
 func (uc *APIMgmtUsecase) ResolveAPIKey(ctx context.Context, rawKey string) (APIKey, error) {
 	rawKey = strings.TrimSpace(rawKey)
 	if rawKey == "" {
@@ -386,15 +449,35 @@ func (uc *APIMgmtUsecase) AuthorizeAPIKey(ctx context.Context, rawKey string) (A
 	if err != nil {
 		return APIKey{}, err
 	}
-	if uc.limiter != nil {
-		if err := uc.limiter.Check(ctx, key); err != nil {
-			return key, err
-		}
+	if err := uc.admit(ctx, key); err != nil {
+		return key, err
 	}
 	_ = uc.repo.UpdateLastUsedAt(ctx, key.ID, time.Now())
 	return key, nil
 }
 
+// admit rejects keys of inactive tenants and applies rate limits and plan
+// quotas.
+func (uc *APIMgmtUsecase) admit(ctx context.Context, key APIKey) error {
+	sub, err := uc.repo.GetTenantSubscription(ctx, key.TenantID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.Forbidden("TENANT_INACTIVE", "tenant not found")
+		}
+		return err
+	}
+	if !sub.Active() {
+		if sub.Status == plan.TenantSuspended {
+			return errors.Forbidden("TENANT_SUSPENDED", "tenant suspended")
+		}
+		return errors.Forbidden("TENANT_INACTIVE", "tenant is "+sub.Status)
+	}
+	if uc.limiter != nil {
+		return uc.limiter.Check(ctx, key, sub.Limits)
+	}
+	return nil
+}
+
 func (uc *APIMgmtUsecase) AuthorizeAPIKeyWithScope(ctx context.Context, rawKey string, requiredScope string, requiredVersion string) (APIKey, error) {
 	key, err := uc.AuthorizeAPIKey(ctx, rawKey)
 	if err != nil {
@@ -424,10 +507,8 @@ func (uc *APIMgmtUsecase) AuthorizePublicChatIDWithScope(ctx context.Context, ch
 	if !key.PublicChatEnabled {
 		return APIKey{}, errors.Forbidden("CHAT_KEY_DISABLED", "public chat disabled")
 	}
-	if uc.limiter != nil {
-		if err := uc.limiter.Check(ctx, key); err != nil {
-			return key, err
-		}
+	if err := uc.admit(ctx, key); err != nil {
+		return key, err
 	}
 	_ = uc.repo.UpdateLastUsedAt(ctx, key.ID, time.Now())
 	if requiredScope != "" && !scopeAllowed(key.Scopes, requiredScope) {
@@ -465,6 +546,9 @@ func (uc *APIMgmtUsecase) RecordUsage(ctx context.Context, key APIKey, operation
 	if err := uc.repo.CreateUsageLog(ctx, log); err != nil && uc.log != nil {
 		uc.log.Warnf("record usage failed: %v", err)
 	}
+	if uc.limiter != nil && usage.TotalTokens > 0 {
+		uc.limiter.AddTokens(ctx, key.TenantID, int64(usage.TotalTokens))
+	}
 	if uc.sink != nil {
 		_ = uc.sink.CaptureAPIUsage(ctx, UsageEvent{
 			TenantID:         key.TenantID,
@@ -567,6 +651,14 @@ func generatePublicChatID() string {
 	return "chat_" + base64.RawURLEncoding.EncodeToString(payload)
 }
 
+func generateEntitlementSecret() string {
+	payload := make([]byte, 32)
+	if _, err := rand.Read(payload); err != nil {
+		return "ent_" + strings.ReplaceAll(uuid.NewString()+uuid.NewString(), "-", "")
+	}
+	return "ent_" + base64.RawURLEncoding.EncodeToString(payload)
+}
+
 func isDuplicateKeyError(err error) bool {
 	if err == nil {
 		return false
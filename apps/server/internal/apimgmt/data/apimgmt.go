@@ -11,6 +11,7 @@ import (
 	biz "github.com/ZTH7/RagoDesk/apps/server/internal/apimgmt/biz"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
 	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -38,7 +39,8 @@ func (r *apimgmtRepo) GetAPIKeyByHash(ctx context.Context, keyHash string) (biz.
 	var versionsRaw sql.NullString
 	err := r.db.QueryRowContext(
 		ctx,
-		`SELECT id, tenant_id, bot_id, status, scopes, api_versions, quota_daily, qps_limit, public_chat_id, public_chat_enabled
+		`SELECT id, tenant_id, bot_id, status, scopes, api_versions, quota_daily, qps_limit, public_chat_id, public_chat_enabled,
+			COALESCE(entitlement_secret, '')
 		FROM api_key
 		WHERE key_hash = ?
 			OR (prev_key_hash = ? AND prev_expires_at IS NOT NULL AND prev_expires_at > ?)
@@ -46,7 +48,7 @@ func (r *apimgmtRepo) GetAPIKeyByHash(ctx context.Context, keyHash string) (biz.
 		keyHash,
 		keyHash,
 		time.Now(),
-	).Scan(&key.ID, &key.TenantID, &key.BotID, &key.Status, &scopesRaw, &versionsRaw, &key.QuotaDaily, &key.QPSLimit, &key.PublicChatID, &key.PublicChatEnabled)
+	).Scan(&key.ID, &key.TenantID, &key.BotID, &key.Status, &scopesRaw, &versionsRaw, &key.QuotaDaily, &key.QPSLimit, &key.PublicChatID, &key.PublicChatEnabled, &key.EntitlementSecret)
 	if err != nil {
 		return biz.APIKey{}, err
 	}
@@ -55,6 +57,10 @@ func (r *apimgmtRepo) GetAPIKeyByHash(ctx context.Context, keyHash string) (biz.
 	return key, nil
 }
 
+func (r *apimgmtRepo) GetTenantSubscription(ctx context.Context, tenantID string) (plan.Subscription, error) {
+	return internaldata.LoadSubscription(ctx, r.db, tenantID)
+}
+
 func (r *apimgmtRepo) CreateAPIKey(ctx context.Context, key biz.APIKey) (biz.APIKey, error) {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
@@ -101,7 +107,8 @@ func (r *apimgmtRepo) GetAPIKey(ctx context.Context, keyID string) (biz.APIKey,
 	var lastUsedAt sql.NullTime
 	err = r.db.QueryRowContext(
 		ctx,
-		`SELECT id, tenant_id, bot_id, name, key_hash, public_chat_id, public_chat_enabled, scopes, api_versions, status, quota_daily, qps_limit, created_at, last_used_at
+		`SELECT id, tenant_id, bot_id, name, key_hash, public_chat_id, public_chat_enabled, scopes, api_versions, status, quota_daily, qps_limit, created_at, last_used_at,
+			COALESCE(entitlement_secret, '')
 		FROM api_key WHERE tenant_id = ? AND id = ?`,
 		tenantID,
 		keyID,
@@ -120,6 +127,7 @@ func (r *apimgmtRepo) GetAPIKey(ctx context.Context, keyID string) (biz.APIKey,
 		&key.QPSLimit,
 		&key.CreatedAt,
 		&lastUsedAt,
+		&key.EntitlementSecret,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -145,7 +153,8 @@ func (r *apimgmtRepo) GetAPIKeyByPublicChatID(ctx context.Context, chatID string
 	var lastUsedAt sql.NullTime
 	err := r.db.QueryRowContext(
 		ctx,
-		`SELECT id, tenant_id, bot_id, name, key_hash, public_chat_id, public_chat_enabled, scopes, api_versions, status, quota_daily, qps_limit, created_at, last_used_at
+		`SELECT id, tenant_id, bot_id, name, key_hash, public_chat_id, public_chat_enabled, scopes, api_versions, status, quota_daily, qps_limit, created_at, last_used_at,
+			COALESCE(entitlement_secret, '')
 		FROM api_key WHERE public_chat_id = ?`,
 		strings.TrimSpace(chatID),
 	).Scan(
@@ -163,6 +172,7 @@ func (r *apimgmtRepo) GetAPIKeyByPublicChatID(ctx context.Context, chatID string
 		&key.QPSLimit,
 		&key.CreatedAt,
 		&lastUsedAt,
+		&key.EntitlementSecret,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -178,18 +188,16 @@ func (r *apimgmtRepo) GetAPIKeyByPublicChatID(ctx context.Context, chatID string
 	return key, nil
 }
 
-func (r *apimgmtRepo) ListAPIKeys(ctx context.Context, botID string, limit int, offset int) ([]biz.APIKey, error) {
+func (r *apimgmtRepo) ListAPIKeys(ctx context.Context, botIDs []string, limit int, offset int) ([]biz.APIKey, error) {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
 		return nil, err
 	}
-	query := `SELECT id, tenant_id, bot_id, name, key_hash, public_chat_id, public_chat_enabled, scopes, api_versions, status, quota_daily, qps_limit, created_at, last_used_at
+	query := `SELECT id, tenant_id, bot_id, name, key_hash, public_chat_id, public_chat_enabled, scopes, api_versions, status, quota_daily, qps_limit, created_at, last_used_at,
+			COALESCE(entitlement_secret, '')
 		FROM api_key WHERE tenant_id = ?`
 	args := []any{tenantID}
-	if strings.TrimSpace(botID) != "" {
-		query += " AND bot_id = ?"
-		args = append(args, strings.TrimSpace(botID))
-	}
+	query, args = appendBotIDs(query, args, botIDs)
 	query += " ORDER BY created_at DESC LIMIT ? OFFSET ?"
 	args = append(args, limit, offset)
 
@@ -219,6 +227,7 @@ func (r *apimgmtRepo) ListAPIKeys(ctx context.Context, botID string, limit int,
 			&key.QPSLimit,
 			&key.CreatedAt,
 			&lastUsedAt,
+			&key.EntitlementSecret,
 		); err != nil {
 			return nil, err
 		}
@@ -279,6 +288,28 @@ func (r *apimgmtRepo) RegeneratePublicChatID(ctx context.Context, keyID string,
 	return r.GetAPIKey(ctx, keyID)
 }
 
+func (r *apimgmtRepo) SetEntitlementSecret(ctx context.Context, keyID string, secret string) (biz.APIKey, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.APIKey{}, err
+	}
+	res, err := r.db.ExecContext(
+		ctx,
+		`UPDATE api_key SET entitlement_secret = ? WHERE tenant_id = ? AND id = ?`,
+		nullString(secret),
+		tenantID,
+		strings.TrimSpace(keyID),
+	)
+	if err != nil {
+		return biz.APIKey{}, err
+	}
+	rows, rowsErr := res.RowsAffected()
+	if rowsErr == nil && rows == 0 {
+		return biz.APIKey{}, errors.NotFound("API_KEY_NOT_FOUND", "api key not found")
+	}
+	return r.GetAPIKey(ctx, keyID)
+}
+
 func (r *apimgmtRepo) DeleteAPIKey(ctx context.Context, keyID string) error {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
@@ -395,6 +426,7 @@ func (r *apimgmtRepo) ListUsageLogs(ctx context.Context, filter biz.UsageFilter)
 		query += " AND bot_id = ?"
 		args = append(args, strings.TrimSpace(filter.BotID))
 	}
+	query, args = appendBotIDs(query, args, filter.BotIDs)
 	if strings.TrimSpace(filter.APIVersion) != "" {
 		query += " AND api_version = ?"
 		args = append(args, strings.TrimSpace(filter.APIVersion))
@@ -484,6 +516,7 @@ func (r *apimgmtRepo) GetUsageSummary(ctx context.Context, filter biz.UsageFilte
 		query += " AND bot_id = ?"
 		args = append(args, strings.TrimSpace(filter.BotID))
 	}
+	query, args = appendBotIDs(query, args, filter.BotIDs)
 	if strings.TrimSpace(filter.APIVersion) != "" {
 		query += " AND api_version = ?"
 		args = append(args, strings.TrimSpace(filter.APIVersion))
@@ -524,6 +557,22 @@ func (r *apimgmtRepo) GetUsageSummary(ctx context.Context, filter biz.UsageFilte
 	return summary, nil
 }
 
+// appendBotIDs restricts query to botIDs unless it is nil; an empty list
+// matches no rows.
+func appendBotIDs(query string, args []any, botIDs []string) (string, []any) {
+	if botIDs == nil {
+		return query, args
+	}
+	if len(botIDs) == 0 {
+		return query + " AND 1 = 0", args
+	}
+	query += " AND bot_id IN (" + strings.TrimSuffix(strings.Repeat("?, ", len(botIDs)), ", ") + ")"
+	for _, id := range botIDs {
+		args = append(args, id)
+	}
+	return query, args
+}
+
 // ProviderSet is apimgmt data providers.
 var ProviderSet = wire.NewSet(NewAPIMgmtRepo, NewRateLimiter, NewUsageExporter)
 
@@ -561,7 +610,10 @@ func NewRateLimiter(cfg *conf.Data, logger log.Logger) biz.RateLimiter {
 	return &rateLimiter{client: client, log: log.NewHelper(logger), tenantQPSLimit: tenantQPS, tenantQuotaDaily: tenantQuota}
 }
 
-func (l *rateLimiter) Check(ctx context.Context, key biz.APIKey) error {
+// monthlyQuotaTTL keeps a monthly counter until the month is over.
+const monthlyQuotaTTL = 32 * 24 * time.Hour
+
+func (l *rateLimiter) Check(ctx context.Context, key biz.APIKey, limits plan.Limits) error {
 	if l == nil || l.client == nil {
 		return nil
 	}
@@ -590,7 +642,42 @@ func (l *rateLimiter) Check(ctx context.Context, key biz.APIKey) error {
 			return err
 		}
 	}
-	return nil
+	if key.TenantID == "" {
+		return nil
+	}
+	month := plan.Month(now)
+	if limits.MonthlyTokens > 0 {
+		used, err := l.client.Get(ctx, monthlyTokensKey(key.TenantID, month)).Int64()
+		if err != nil && err != redis.Nil {
+			if l.log != nil {
+				l.log.Warnf("limiter get tokens failed: %v", err)
+			}
+		} else if used >= limits.MonthlyTokens {
+			return errors.New(429, "PLAN_TOKEN_QUOTA_EXCEEDED", "monthly token quota of the plan exceeded")
+		}
+	}
+	return l.checkLimit(ctx, "ragodesk:quota:tenant-month:"+key.TenantID+":"+month, limits.MonthlyQueries, monthlyQuotaTTL, "PLAN_QUERY_QUOTA_EXCEEDED", "monthly query quota of the plan exceeded")
+}
+
+func (l *rateLimiter) AddTokens(ctx context.Context, tenantID string, tokens int64) {
+	if l == nil || l.client == nil || tenantID == "" || tokens <= 0 {
+		return
+	}
+	key := monthlyTokensKey(tenantID, plan.Month(time.Now()))
+	total, err := l.client.IncrBy(ctx, key, tokens).Result()
+	if err != nil {
+		if l.log != nil {
+			l.log.Warnf("limiter add tokens failed: %v", err)
+		}
+		return
+	}
+	if total == tokens {
+		_ = l.client.Expire(ctx, key, monthlyQuotaTTL).Err()
+	}
+}
+
+func monthlyTokensKey(tenantID string, month string) string {
+	return "ragodesk:tokens:tenant-month:" + tenantID + ":" + month
 }
 
 func (l *rateLimiter) checkLimit(ctx context.Context, key string, limit int64, ttl time.Duration, code string, message string) error {
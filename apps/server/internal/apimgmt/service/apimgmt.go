@@ -37,7 +37,7 @@ func (s *APIMgmtService) CreateAPIKey(ctx context.Context, req *v1.CreateAPIKeyR
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIKeyWrite); err != nil {
+	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIKeyWrite, iambiz.BotRef(req.GetBotId())); err != nil {
 		return nil, err
 	}
 	var publicChatEnabled *bool
@@ -62,10 +62,11 @@ func (s *APIMgmtService) ListAPIKeys(ctx context.Context, req *v1.ListAPIKeysReq
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIKeyRead); err != nil {
+	botIDs, err := s.visibleBotIDs(ctx, biz.PermissionAPIKeyRead, req.GetBotId())
+	if err != nil {
 		return nil, err
 	}
-	items, err := s.uc.ListAPIKeys(ctx, req.GetBotId(), int(req.GetLimit()), int(req.GetOffset()))
+	items, err := s.uc.ListAPIKeys(ctx, botIDs, int(req.GetLimit()), int(req.GetOffset()))
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +84,7 @@ func (s *APIMgmtService) GetAPIKey(ctx context.Context, req *v1.GetAPIKeyRequest
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIKeyRead); err != nil {
+	if err := s.requireKeyPermission(ctx, biz.PermissionAPIKeyRead, req.GetId()); err != nil {
 		return nil, err
 	}
 	item, err := s.uc.GetAPIKey(ctx, req.GetId())
@@ -100,7 +101,7 @@ func (s *APIMgmtService) UpdateAPIKey(ctx context.Context, req *v1.UpdateAPIKeyR
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIKeyWrite); err != nil {
+	if err := s.requireKeyPermission(ctx, biz.PermissionAPIKeyWrite, req.GetId()); err != nil {
 		return nil, err
 	}
 	var quotaDaily *int32
@@ -132,7 +133,7 @@ func (s *APIMgmtService) DeleteAPIKey(ctx context.Context, req *v1.DeleteAPIKeyR
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIKeyDelete); err != nil {
+	if err := s.requireKeyPermission(ctx, biz.PermissionAPIKeyDelete, req.GetId()); err != nil {
 		return nil, err
 	}
 	if err := s.uc.DeleteAPIKey(ctx, req.GetId()); err != nil {
@@ -148,7 +149,7 @@ func (s *APIMgmtService) RotateAPIKey(ctx context.Context, req *v1.RotateAPIKeyR
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIKeyRotate); err != nil {
+	if err := s.requireKeyPermission(ctx, biz.PermissionAPIKeyRotate, req.GetId()); err != nil {
 		return nil, err
 	}
 	updated, rawKey, err := s.uc.RotateAPIKey(ctx, req.GetId())
@@ -168,7 +169,7 @@ func (s *APIMgmtService) RegeneratePublicChatID(ctx context.Context, req *v1.Reg
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIKeyRotate); err != nil {
+	if err := s.requireKeyPermission(ctx, biz.PermissionAPIKeyRotate, req.GetId()); err != nil {
 		return nil, err
 	}
 	updated, err := s.uc.RegeneratePublicChatID(ctx, req.GetId())
@@ -178,6 +179,23 @@ func (s *APIMgmtService) RegeneratePublicChatID(ctx context.Context, req *v1.Reg
 	return &v1.RegeneratePublicChatIDResponse{ApiKey: toAPIKey(updated)}, nil
 }
 
+func (s *APIMgmtService) RegenerateEntitlementSecret(ctx context.Context, req *v1.RegenerateEntitlementSecretRequest) (*v1.RegenerateEntitlementSecretResponse, error) {
+	if req == nil {
+		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
+	}
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireKeyPermission(ctx, biz.PermissionAPIKeyRotate, req.GetId()); err != nil {
+		return nil, err
+	}
+	updated, secret, err := s.uc.RegenerateEntitlementSecret(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RegenerateEntitlementSecretResponse{ApiKey: toAPIKey(updated), EntitlementSecret: secret}, nil
+}
+
 func (s *APIMgmtService) ListUsageLogs(ctx context.Context, req *v1.ListUsageLogsRequest) (*v1.ListUsageLogsResponse, error) {
 	if req == nil {
 		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
@@ -185,7 +203,8 @@ func (s *APIMgmtService) ListUsageLogs(ctx context.Context, req *v1.ListUsageLog
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIUsageRead); err != nil {
+	botIDs, err := s.visibleBotIDs(ctx, biz.PermissionAPIUsageRead, req.GetBotId())
+	if err != nil {
 		return nil, err
 	}
 	filter := biz.UsageFilter{
@@ -197,6 +216,7 @@ func (s *APIMgmtService) ListUsageLogs(ctx context.Context, req *v1.ListUsageLog
 		End:        fromTimestamp(req.GetEndTime()),
 		Limit:      int(req.GetLimit()),
 		Offset:     int(req.GetOffset()),
+		BotIDs:     botIDs,
 	}
 	items, err := s.uc.ListUsageLogs(ctx, filter)
 	if err != nil {
@@ -216,7 +236,8 @@ func (s *APIMgmtService) GetUsageSummary(ctx context.Context, req *v1.GetUsageSu
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIUsageRead); err != nil {
+	botIDs, err := s.visibleBotIDs(ctx, biz.PermissionAPIUsageRead, req.GetBotId())
+	if err != nil {
 		return nil, err
 	}
 	summary, err := s.uc.GetUsageSummary(ctx, biz.UsageFilter{
@@ -226,6 +247,7 @@ func (s *APIMgmtService) GetUsageSummary(ctx context.Context, req *v1.GetUsageSu
 		Model:      req.GetModel(),
 		Start:      fromTimestamp(req.GetStartTime()),
 		End:        fromTimestamp(req.GetEndTime()),
+		BotIDs:     botIDs,
 	})
 	if err != nil {
 		return nil, err
@@ -248,7 +270,8 @@ func (s *APIMgmtService) ExportUsageLogs(ctx context.Context, req *v1.ExportUsag
 	if err != nil {
 		return nil, errors.Forbidden("TENANT_MISSING", "tenant missing")
 	}
-	if err := s.iam.RequirePermission(ctx, biz.PermissionAPIUsageRead); err != nil {
+	botIDs, err := s.visibleBotIDs(ctx, biz.PermissionAPIUsageRead, req.GetBotId())
+	if err != nil {
 		return nil, err
 	}
 	format := strings.ToLower(strings.TrimSpace(req.GetFormat()))
@@ -267,6 +290,7 @@ func (s *APIMgmtService) ExportUsageLogs(ctx context.Context, req *v1.ExportUsag
 		End:        fromTimestamp(req.GetEndTime()),
 		Limit:      int(req.GetLimit()),
 		Offset:     int(req.GetOffset()),
+		BotIDs:     botIDs,
 	})
 	if err != nil {
 		return nil, err
@@ -283,6 +307,39 @@ func (s *APIMgmtService) ExportUsageLogs(ctx context.Context, req *v1.ExportUsag
 // ProviderSet is apimgmt service providers.
 var ProviderSet = wire.NewSet(NewAPIMgmtService)
 
+// requireKeyPermission checks permission on the bot the API key belongs to.
+func (s *APIMgmtService) requireKeyPermission(ctx context.Context, permission string, keyID string) error {
+	visible, err := s.iam.VisibleResources(ctx, permission, iambiz.ResourceTypeBot)
+	if err != nil {
+		return err
+	}
+	if visible.All {
+		return nil
+	}
+	key, err := s.uc.GetAPIKey(ctx, keyID)
+	if err != nil {
+		return err
+	}
+	if !visible.Allows(key.BotID) {
+		return errors.Forbidden("RBAC_FORBIDDEN", "permission denied")
+	}
+	return nil
+}
+
+// visibleBotIDs returns the bots a listing may cover: nil for every bot
+// (or only botID once it is checked), else those the caller's scoped grants
+// reach.
+func (s *APIMgmtService) visibleBotIDs(ctx context.Context, permission string, botID string) ([]string, error) {
+	if botID = strings.TrimSpace(botID); botID != "" {
+		return nil, s.iam.RequirePermission(ctx, permission, iambiz.BotRef(botID))
+	}
+	visible, err := s.iam.VisibleResources(ctx, permission, iambiz.ResourceTypeBot)
+	if err != nil || visible.All {
+		return nil, err
+	}
+	return visible.IDs, nil
+}
+
 func requireTenantContext(ctx context.Context) error {
 	if _, err := tenant.RequireTenantID(ctx); err != nil {
 		return errors.Forbidden("TENANT_MISSING", "tenant missing")
@@ -295,19 +352,20 @@ func toAPIKey(key biz.APIKey) *v1.APIKey {
 		return nil
 	}
 	return &v1.APIKey{
-		Id:                key.ID,
-		TenantId:          key.TenantID,
-		BotId:             key.BotID,
-		Name:              key.Name,
-		Status:            string(key.Status),
-		Scopes:            key.Scopes,
-		ApiVersions:       key.APIVersions,
-		QuotaDaily:        key.QuotaDaily,
-		QpsLimit:          key.QPSLimit,
-		CreatedAt:         toTimestamp(key.CreatedAt),
-		LastUsedAt:        toTimestamp(key.LastUsedAt),
-		PublicChatId:      key.PublicChatID,
-		PublicChatEnabled: key.PublicChatEnabled,
+		Id:                   key.ID,
+		TenantId:             key.TenantID,
+		BotId:                key.BotID,
+		Name:                 key.Name,
+		Status:               string(key.Status),
+		Scopes:               key.Scopes,
+		ApiVersions:          key.APIVersions,
+		QuotaDaily:           key.QuotaDaily,
+		QpsLimit:             key.QPSLimit,
+		CreatedAt:            toTimestamp(key.CreatedAt),
+		LastUsedAt:           toTimestamp(key.LastUsedAt),
+		PublicChatId:         key.PublicChatID,
+		PublicChatEnabled:    key.PublicChatEnabled,
+		EntitlementSecretSet: key.EntitlementSecret != "",
 	}
 }
 
@@ -0,0 +1,365 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/uuid"
+	"github.com/google/wire"
+)
+
+const (
+	PermissionAuditLogRead         = "tenant.audit_log.read"
+	PermissionPlatformAuditLogRead = "platform.audit_log.read"
+)
+
+// Audit results.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Actor types.
+const (
+	ActorTenant   = "tenant"
+	ActorPlatform = "platform"
+)
+
+const (
+	defaultRetentionDays        = 0
+	defaultPurgeIntervalMinutes = 60
+	defaultAuditWindow          = 30 * 24 * time.Hour
+	maxListLimit                = 200
+	maxExportLimit              = 10000
+	maxUserAgentLength          = 255
+)
+
+// AuditLog records one mutation made through the console or platform APIs.
+type AuditLog struct {
+	ID             string
+	TenantID       string
+	ActorID        string
+	ActorType      string
+	Operation      string
+	ResourceType   string
+	ResourceID     string
+	RequestSummary string
+	Result         string
+	ErrorReason    string
+	StatusCode     int32
+	ClientIP       string
+	UserAgent      string
+	// ImpersonationID is the impersonation session of a platform admin
+	// acting in the tenant console.
+	ImpersonationID string
+	CreatedAt       time.Time
+}
+
+// AuditFilter defines audit log query filters. An empty TenantID matches
+// every tenant.
+type AuditFilter struct {
+	TenantID     string
+	ActorID      string
+	Operation    string
+	ResourceType string
+	ResourceID   string
+	Result       string
+	// ImpersonationID narrows the logs to one impersonation session.
+	ImpersonationID string
+	Start           time.Time
+	End             time.Time
+	Limit           int
+	Offset          int
+}
+
+// AuditExportResult is a rendered audit log export.
+type AuditExportResult struct {
+	Content     string
+	ContentType string
+	Filename    string
+}
+
+// AuditRepo is a repository interface.
+type AuditRepo interface {
+	CreateAuditLog(ctx context.Context, entry AuditLog) error
+	ListAuditLogs(ctx context.Context, filter AuditFilter) ([]AuditLog, error)
+	PurgeExpired(ctx context.Context, cutoff time.Time) error
+}
+
+// AuditUsecase handles audit business logic.
+type AuditUsecase struct {
+	repo          AuditRepo
+	log           *log.Helper
+	retentionDays int
+	purgeInterval time.Duration
+	lastPurge     time.Time
+	mu            sync.Mutex
+}
+
+// NewAuditUsecase creates a new AuditUsecase.
+func NewAuditUsecase(repo AuditRepo, cfg *conf.Data, logger log.Logger) *AuditUsecase {
+	retentionDays, purgeInterval := loadRetentionPolicy(cfg)
+	return &AuditUsecase{
+		repo:          repo,
+		log:           log.NewHelper(logger),
+		retentionDays: retentionDays,
+		purgeInterval: purgeInterval,
+	}
+}
+
+// Record stores an audit entry. The actor comes from the access token of the
+// request and the client from its headers. Failures are logged rather than
+// returned so that auditing never fails the audited call.
+func (uc *AuditUsecase) Record(ctx context.Context, entry AuditLog) {
+	if uc == nil || uc.repo == nil {
+		return
+	}
+	claims, ok := jwt.ClaimsFromContext(ctx)
+	if !ok || claims == nil {
+		return
+	}
+	entry.ActorID = claims.Subject
+	entry.ActorType = ActorPlatform
+	if claims.TenantID != "" {
+		entry.ActorType = ActorTenant
+		entry.TenantID = claims.TenantID
+		if claims.Impersonated() {
+			entry.ActorID = claims.Actor.Subject
+			entry.ActorType = ActorPlatform
+			entry.ImpersonationID = claims.SessionID
+		}
+	} else if entry.TenantID == "" {
+		if tenantID, ok := tenant.TenantID(ctx); ok {
+			entry.TenantID = tenantID
+		}
+	}
+	if entry.Result == "" {
+		entry.Result = ResultSuccess
+	}
+	if entry.StatusCode == 0 {
+		entry.StatusCode = 200
+	}
+	entry.ClientIP, entry.UserAgent = clientInfoFromContext(ctx)
+	if entry.ID == "" {
+		entry.ID = uuid.NewString()
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	// The request may be cancelled as soon as it returns; the entry must
+	// still be written.
+	if err := uc.repo.CreateAuditLog(context.WithoutCancel(ctx), entry); err != nil && uc.log != nil {
+		uc.log.Warnf("record audit log failed: operation=%s err=%v", entry.Operation, err)
+	}
+	uc.maybePurge()
+}
+
+// RecordError stores an audit entry with the outcome of err.
+func (uc *AuditUsecase) RecordError(ctx context.Context, entry AuditLog, err error) {
+	entry.Result = ResultSuccess
+	entry.StatusCode = 200
+	if err != nil {
+		entry.Result = ResultFailure
+		entry.StatusCode = 500
+		if kratosErr := errors.FromError(err); kratosErr != nil {
+			entry.ErrorReason = kratosErr.Reason
+			if kratosErr.Code > 0 {
+				entry.StatusCode = kratosErr.Code
+			}
+		}
+	}
+	uc.Record(ctx, entry)
+}
+
+// ListAuditLogs lists audit logs of the current tenant.
+func (uc *AuditUsecase) ListAuditLogs(ctx context.Context, filter AuditFilter) ([]AuditLog, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter.TenantID = tenantID
+	return uc.repo.ListAuditLogs(ctx, normalizeFilter(filter, maxListLimit))
+}
+
+// ListPlatformAuditLogs lists audit logs across tenants, narrowed to one
+// tenant when filter.TenantID is set.
+func (uc *AuditUsecase) ListPlatformAuditLogs(ctx context.Context, filter AuditFilter) ([]AuditLog, error) {
+	return uc.repo.ListAuditLogs(ctx, normalizeFilter(filter, maxListLimit))
+}
+
+// ExportAuditLogs renders audit logs of the current tenant as CSV.
+func (uc *AuditUsecase) ExportAuditLogs(ctx context.Context, filter AuditFilter) (AuditExportResult, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return AuditExportResult{}, err
+	}
+	filter.TenantID = tenantID
+	return uc.export(ctx, filter)
+}
+
+// ExportPlatformAuditLogs renders audit logs across tenants as CSV.
+func (uc *AuditUsecase) ExportPlatformAuditLogs(ctx context.Context, filter AuditFilter) (AuditExportResult, error) {
+	return uc.export(ctx, filter)
+}
+
+func (uc *AuditUsecase) export(ctx context.Context, filter AuditFilter) (AuditExportResult, error) {
+	filter.Offset = 0
+	items, err := uc.repo.ListAuditLogs(ctx, normalizeFilter(filter, maxExportLimit))
+	if err != nil {
+		return AuditExportResult{}, err
+	}
+	var buf bytes.Buffer
+	if err := writeAuditCSV(&buf, items); err != nil {
+		return AuditExportResult{}, err
+	}
+	return AuditExportResult{
+		Content:     buf.String(),
+		ContentType: "text/csv",
+		Filename:    fmt.Sprintf("audit_log_%s.csv", time.Now().UTC().Format("20060102_150405")),
+	}, nil
+}
+
+func loadRetentionPolicy(cfg *conf.Data) (int, time.Duration) {
+	retentionDays := defaultRetentionDays
+	purgeMinutes := defaultPurgeIntervalMinutes
+	if cfg != nil && cfg.Audit != nil {
+		if cfg.Audit.RetentionDays > 0 {
+			retentionDays = int(cfg.Audit.RetentionDays)
+		}
+		if cfg.Audit.PurgeIntervalMinutes > 0 {
+			purgeMinutes = int(cfg.Audit.PurgeIntervalMinutes)
+		}
+	}
+	return retentionDays, time.Duration(purgeMinutes) * time.Minute
+}
+
+func (uc *AuditUsecase) maybePurge() {
+	if uc.retentionDays <= 0 || uc.purgeInterval <= 0 {
+		return
+	}
+	now := time.Now()
+	uc.mu.Lock()
+	if !uc.lastPurge.IsZero() && now.Sub(uc.lastPurge) < uc.purgeInterval {
+		uc.mu.Unlock()
+		return
+	}
+	uc.lastPurge = now
+	uc.mu.Unlock()
+	cutoff := now.AddDate(0, 0, -uc.retentionDays)
+	go func() {
+		if err := uc.repo.PurgeExpired(context.Background(), cutoff); err != nil && uc.log != nil {
+			uc.log.Warnf("purge audit logs failed: %v", err)
+		}
+	}()
+}
+
+func normalizeFilter(filter AuditFilter, maxLimit int) AuditFilter {
+	filter.TenantID = strings.TrimSpace(filter.TenantID)
+	filter.ActorID = strings.TrimSpace(filter.ActorID)
+	filter.Operation = strings.TrimSpace(filter.Operation)
+	filter.ResourceType = strings.TrimSpace(filter.ResourceType)
+	filter.ResourceID = strings.TrimSpace(filter.ResourceID)
+	filter.Result = strings.ToLower(strings.TrimSpace(filter.Result))
+	filter.ImpersonationID = strings.TrimSpace(filter.ImpersonationID)
+	if filter.Limit <= 0 || filter.Limit > maxLimit {
+		filter.Limit = maxLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	if filter.Start.IsZero() && filter.End.IsZero() {
+		filter.End = time.Now()
+		filter.Start = filter.End.Add(-defaultAuditWindow)
+	}
+	if !filter.Start.IsZero() && !filter.End.IsZero() && filter.End.Before(filter.Start) {
+		filter.Start, filter.End = filter.End, filter.Start
+	}
+	return filter
+}
+
+func clientInfoFromContext(ctx context.Context) (string, string) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	header := tr.RequestHeader()
+	forwarded := strings.TrimSpace(header.Get("X-Forwarded-For"))
+	if forwarded != "" {
+		if parts := strings.Split(forwarded, ","); len(parts) > 0 {
+			forwarded = strings.TrimSpace(parts[0])
+		}
+	}
+	if forwarded == "" {
+		forwarded = strings.TrimSpace(header.Get("X-Real-IP"))
+	}
+	userAgent := strings.TrimSpace(header.Get("User-Agent"))
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+	return forwarded, userAgent
+}
+
+func writeAuditCSV(writer io.Writer, items []AuditLog) error {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write([]string{
+		"id",
+		"tenant_id",
+		"actor_id",
+		"actor_type",
+		"operation",
+		"resource_type",
+		"resource_id",
+		"result",
+		"error_reason",
+		"status_code",
+		"client_ip",
+		"user_agent",
+		"impersonation_id",
+		"request_summary",
+		"created_at",
+	}); err != nil {
+		csvWriter.Flush()
+		return err
+	}
+	for _, item := range items {
+		record := []string{
+			item.ID,
+			item.TenantID,
+			item.ActorID,
+			item.ActorType,
+			item.Operation,
+			item.ResourceType,
+			item.ResourceID,
+			item.Result,
+			item.ErrorReason,
+			strconv.Itoa(int(item.StatusCode)),
+			item.ClientIP,
+			item.UserAgent,
+			item.ImpersonationID,
+			item.RequestSummary,
+			item.CreatedAt.Format(time.RFC3339),
+		}
+		if err := csvWriter.Write(record); err != nil {
+			csvWriter.Flush()
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// ProviderSet is audit biz providers.
+var ProviderSet = wire.NewSet(NewAuditUsecase)
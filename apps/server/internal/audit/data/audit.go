@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
+	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	"github.com/google/wire"
+)
+
+// purgeBatchSize bounds each purge statement so that a large backlog does
+// not hold locks on audit_log for long.
+const purgeBatchSize = 5000
+
+type auditRepo struct {
+	db *sql.DB
+}
+
+// NewAuditRepo creates a new audit repo.
+func NewAuditRepo(data *internaldata.Data) biz.AuditRepo {
+	repo := &auditRepo{}
+	if data != nil {
+		repo.db = data.DB
+	}
+	return repo
+}
+
+func (r *auditRepo) CreateAuditLog(ctx context.Context, entry biz.AuditLog) error {
+	if r == nil || r.db == nil {
+		return sql.ErrConnDone
+	}
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO audit_log
+			(id, tenant_id, actor_id, actor_type, operation, resource_type, resource_id, request_summary, result, error_reason, status_code, client_ip, user_agent, impersonation_id, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		entry.ID,
+		entry.TenantID,
+		entry.ActorID,
+		entry.ActorType,
+		entry.Operation,
+		entry.ResourceType,
+		emptyToNull(entry.ResourceID),
+		emptyToNull(entry.RequestSummary),
+		entry.Result,
+		emptyToNull(entry.ErrorReason),
+		entry.StatusCode,
+		emptyToNull(entry.ClientIP),
+		emptyToNull(entry.UserAgent),
+		emptyToNull(entry.ImpersonationID),
+		entry.CreatedAt,
+	)
+	return err
+}
+
+func (r *auditRepo) ListAuditLogs(ctx context.Context, filter biz.AuditFilter) ([]biz.AuditLog, error) {
+	if r == nil || r.db == nil {
+		return nil, sql.ErrConnDone
+	}
+	query := `SELECT id, tenant_id, actor_id, actor_type, operation, resource_type, resource_id, request_summary, result, error_reason, status_code, client_ip, user_agent, impersonation_id, created_at
+		FROM audit_log
+		WHERE 1 = 1`
+	args := make([]any, 0, 10)
+	for _, cond := range []struct {
+		column string
+		value  string
+	}{
+		{"tenant_id", filter.TenantID},
+		{"actor_id", filter.ActorID},
+		{"operation", filter.Operation},
+		{"resource_type", filter.ResourceType},
+		{"resource_id", filter.ResourceID},
+		{"result", filter.Result},
+		{"impersonation_id", filter.ImpersonationID},
+	} {
+		if cond.value != "" {
+			query += " AND " + cond.column + " = ?"
+			args = append(args, cond.value)
+		}
+	}
+	if !filter.Start.IsZero() {
+		query += " AND created_at >= ?"
+		args = append(args, filter.Start)
+	}
+	if !filter.End.IsZero() {
+		query += " AND created_at <= ?"
+		args = append(args, filter.End)
+	}
+	query += " ORDER BY created_at DESC LIMIT ? OFFSET ?"
+	args = append(args, filter.Limit, filter.Offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.AuditLog, 0)
+	for rows.Next() {
+		var (
+			item           biz.AuditLog
+			resourceID     sql.NullString
+			requestSummary sql.NullString
+			errorReason    sql.NullString
+			clientIP       sql.NullString
+			userAgent      sql.NullString
+			impersonation  sql.NullString
+		)
+		if err := rows.Scan(
+			&item.ID,
+			&item.TenantID,
+			&item.ActorID,
+			&item.ActorType,
+			&item.Operation,
+			&item.ResourceType,
+			&resourceID,
+			&requestSummary,
+			&item.Result,
+			&errorReason,
+			&item.StatusCode,
+			&clientIP,
+			&userAgent,
+			&impersonation,
+			&item.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		item.ResourceID = resourceID.String
+		item.RequestSummary = requestSummary.String
+		item.ErrorReason = errorReason.String
+		item.ClientIP = clientIP.String
+		item.UserAgent = userAgent.String
+		item.ImpersonationID = impersonation.String
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func (r *auditRepo) PurgeExpired(ctx context.Context, cutoff time.Time) error {
+	if r == nil || r.db == nil {
+		return sql.ErrConnDone
+	}
+	if cutoff.IsZero() {
+		return nil
+	}
+	for {
+		res, err := r.db.ExecContext(ctx, "DELETE FROM audit_log WHERE created_at < ? LIMIT ?", cutoff, purgeBatchSize)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil || affected < purgeBatchSize {
+			return err
+		}
+	}
+}
+
+func emptyToNull(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// ProviderSet is audit data providers.
+var ProviderSet = wire.NewSet(NewAuditRepo)
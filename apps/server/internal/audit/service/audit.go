@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/audit/v1"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
+	iambiz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/wire"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ConsoleAuditService lets tenants query and export their audit logs.
+type ConsoleAuditService struct {
+	v1.UnimplementedConsoleAuditServer
+
+	uc  *biz.AuditUsecase
+	iam *iambiz.IAMUsecase
+}
+
+// PlatformAuditService gives platform admins a cross-tenant audit view.
+type PlatformAuditService struct {
+	v1.UnimplementedPlatformAuditServer
+
+	uc  *biz.AuditUsecase
+	iam *iambiz.IAMUsecase
+}
+
+// NewConsoleAuditService creates a new ConsoleAuditService.
+func NewConsoleAuditService(uc *biz.AuditUsecase, iam *iambiz.IAMUsecase) *ConsoleAuditService {
+	return &ConsoleAuditService{uc: uc, iam: iam}
+}
+
+// NewPlatformAuditService creates a new PlatformAuditService.
+func NewPlatformAuditService(uc *biz.AuditUsecase, iam *iambiz.IAMUsecase) *PlatformAuditService {
+	return &PlatformAuditService{uc: uc, iam: iam}
+}
+
+func (s *ConsoleAuditService) ListAuditLogs(ctx context.Context, req *v1.ListAuditLogsRequest) (*v1.ListAuditLogsResponse, error) {
+	if req == nil {
+		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
+	}
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.iam.RequirePermission(ctx, biz.PermissionAuditLogRead); err != nil {
+		return nil, err
+	}
+	items, err := s.uc.ListAuditLogs(ctx, listFilter(req))
+	if err != nil {
+		return nil, err
+	}
+	return toListResponse(items), nil
+}
+
+func (s *ConsoleAuditService) ExportAuditLogs(ctx context.Context, req *v1.ExportAuditLogsRequest) (*v1.ExportAuditLogsResponse, error) {
+	if req == nil {
+		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
+	}
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.iam.RequirePermission(ctx, biz.PermissionAuditLogRead); err != nil {
+		return nil, err
+	}
+	if err := validateExportFormat(req.GetFormat()); err != nil {
+		return nil, err
+	}
+	result, err := s.uc.ExportAuditLogs(ctx, exportFilter(req))
+	if err != nil {
+		return nil, err
+	}
+	return toExportResponse(result), nil
+}
+
+func (s *PlatformAuditService) ListAuditLogs(ctx context.Context, req *v1.ListAuditLogsRequest) (*v1.ListAuditLogsResponse, error) {
+	if req == nil {
+		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
+	}
+	if err := s.iam.RequirePermission(ctx, biz.PermissionPlatformAuditLogRead); err != nil {
+		return nil, err
+	}
+	filter := listFilter(req)
+	filter.TenantID = req.GetTenantId()
+	items, err := s.uc.ListPlatformAuditLogs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return toListResponse(items), nil
+}
+
+func (s *PlatformAuditService) ExportAuditLogs(ctx context.Context, req *v1.ExportAuditLogsRequest) (*v1.ExportAuditLogsResponse, error) {
+	if req == nil {
+		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
+	}
+	if err := s.iam.RequirePermission(ctx, biz.PermissionPlatformAuditLogRead); err != nil {
+		return nil, err
+	}
+	if err := validateExportFormat(req.GetFormat()); err != nil {
+		return nil, err
+	}
+	filter := exportFilter(req)
+	filter.TenantID = req.GetTenantId()
+	result, err := s.uc.ExportPlatformAuditLogs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return toExportResponse(result), nil
+}
+
+// ProviderSet is audit service providers.
+var ProviderSet = wire.NewSet(NewConsoleAuditService, NewPlatformAuditService)
+
+func requireTenantContext(ctx context.Context) error {
+	if _, err := tenant.RequireTenantID(ctx); err != nil {
+		return errors.Forbidden("TENANT_MISSING", "tenant missing")
+	}
+	return nil
+}
+
+func validateExportFormat(format string) error {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format != "" && format != "csv" {
+		return errors.BadRequest("EXPORT_FORMAT_INVALID", "unsupported export format")
+	}
+	return nil
+}
+
+func listFilter(req *v1.ListAuditLogsRequest) biz.AuditFilter {
+	return biz.AuditFilter{
+		ActorID:         req.GetActorId(),
+		Operation:       req.GetOperation(),
+		ResourceType:    req.GetResourceType(),
+		ResourceID:      req.GetResourceId(),
+		Result:          req.GetResult(),
+		ImpersonationID: req.GetImpersonationId(),
+		Start:           fromTimestamp(req.GetStartTime()),
+		End:             fromTimestamp(req.GetEndTime()),
+		Limit:           int(req.GetLimit()),
+		Offset:          int(req.GetOffset()),
+	}
+}
+
+func exportFilter(req *v1.ExportAuditLogsRequest) biz.AuditFilter {
+	return biz.AuditFilter{
+		ActorID:         req.GetActorId(),
+		Operation:       req.GetOperation(),
+		ResourceType:    req.GetResourceType(),
+		ResourceID:      req.GetResourceId(),
+		Result:          req.GetResult(),
+		ImpersonationID: req.GetImpersonationId(),
+		Start:           fromTimestamp(req.GetStartTime()),
+		End:             fromTimestamp(req.GetEndTime()),
+		Limit:           int(req.GetLimit()),
+	}
+}
+
+func toListResponse(items []biz.AuditLog) *v1.ListAuditLogsResponse {
+	resp := &v1.ListAuditLogsResponse{Items: make([]*v1.AuditLog, 0, len(items))}
+	for _, item := range items {
+		resp.Items = append(resp.Items, toAuditLog(item))
+	}
+	return resp
+}
+
+func toExportResponse(result biz.AuditExportResult) *v1.ExportAuditLogsResponse {
+	return &v1.ExportAuditLogsResponse{
+		Content:     result.Content,
+		ContentType: result.ContentType,
+		Filename:    result.Filename,
+	}
+}
+
+func toAuditLog(item biz.AuditLog) *v1.AuditLog {
+	return &v1.AuditLog{
+		Id:              item.ID,
+		TenantId:        item.TenantID,
+		ActorId:         item.ActorID,
+		ActorType:       item.ActorType,
+		Operation:       item.Operation,
+		ResourceType:    item.ResourceType,
+		ResourceId:      item.ResourceID,
+		RequestSummary:  item.RequestSummary,
+		Result:          item.Result,
+		ErrorReason:     item.ErrorReason,
+		StatusCode:      item.StatusCode,
+		ClientIp:        item.ClientIP,
+		UserAgent:       item.UserAgent,
+		CreatedAt:       toTimestamp(item.CreatedAt),
+		ImpersonationId: item.ImpersonationID,
+	}
+}
+
+func toTimestamp(value time.Time) *timestamppb.Timestamp {
+	if value.IsZero() {
+		return nil
+	}
+	return timestamppb.New(value)
+}
+
+func fromTimestamp(value *timestamppb.Timestamp) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+	return value.AsTime()
+}
@@ -0,0 +1,251 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/mail"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	"github.com/go-kratos/kratos/v2/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Account token purposes.
+const (
+	AccountTokenInvite        = "invite"
+	AccountTokenPasswordReset = "password_reset"
+)
+
+// Tenant user statuses managed by the account lifecycle.
+const (
+	UserStatusActive   = "active"
+	UserStatusInvited  = "invited"
+	UserStatusDisabled = "disabled"
+)
+
+const (
+	defaultInviteTTL        = 72 * time.Hour
+	defaultPasswordResetTTL = time.Hour
+	defaultDashboardURL     = "http://localhost:5173"
+	minPasswordLength       = 8
+)
+
+// AccountToken is a single-use invitation or password reset token of a
+// tenant user. Only its hash is stored.
+type AccountToken struct {
+	TokenHash string
+	Purpose   string
+	TenantID  string
+	UserID    string
+	ExpiresAt time.Time
+	UsedAt    time.Time
+	CreatedAt time.Time
+}
+
+// AccountUpdate is applied to the user of a redeemed token.
+type AccountUpdate struct {
+	// RequireStatus is the status the user must still have.
+	RequireStatus string
+	PasswordHash  string
+	// Name replaces the name when set.
+	Name string
+	// Activate sets the status to active.
+	Activate bool
+}
+
+// AccountTokenRepo stores invitation and password reset tokens.
+type AccountTokenRepo interface {
+	// CreateAccountToken stores a token and drops the unused tokens of the
+	// same user and purpose, so only the latest link works.
+	CreateAccountToken(ctx context.Context, token AccountToken) error
+	// RedeemAccountToken marks an unused, unexpired token of the purpose as
+	// used and applies update to its user in one transaction.
+	RedeemAccountToken(ctx context.Context, tokenHash string, purpose string, update AccountUpdate, now time.Time) (AccountToken, error)
+}
+
+// InviteUser sends an invitation to an invited user of the current tenant
+// and returns the link. baseURL overrides the configured dashboard URL.
+func (uc *AuthUsecase) InviteUser(ctx context.Context, userID string, baseURL string) (string, error) {
+	user, err := uc.tenantUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if user.Status != UserStatusInvited {
+		return "", errors.Conflict("USER_NOT_INVITED", "user already accepted the invitation")
+	}
+	if user.Email == "" {
+		return "", errors.BadRequest("USER_EMAIL_REQUIRED", "invitations need an email")
+	}
+	return uc.sendAccountToken(ctx, user, AccountTokenInvite, uc.inviteTTL, baseURL, "/console/invite")
+}
+
+// SendPasswordReset mails a password reset link to an active user of the
+// current tenant on behalf of an admin.
+func (uc *AuthUsecase) SendPasswordReset(ctx context.Context, userID string) error {
+	user, err := uc.tenantUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isActiveStatus(user.Status) {
+		return errors.Conflict("USER_NOT_ACTIVE", "user is not active")
+	}
+	if user.Email == "" {
+		return errors.BadRequest("USER_EMAIL_REQUIRED", "password reset needs an email")
+	}
+	_, err = uc.sendAccountToken(ctx, user, AccountTokenPasswordReset, uc.resetTTL, "", "/console/reset-password")
+	return err
+}
+
+// RequestPasswordReset mails a password reset link to a user that forgot
+// their password. It reports success for unknown accounts too, so that it
+// cannot be used to probe them.
+func (uc *AuthUsecase) RequestPasswordReset(ctx context.Context, account string, tenantID string) error {
+	account = strings.TrimSpace(account)
+	if account == "" {
+		return errors.BadRequest("ACCOUNT_REQUIRED", "account required")
+	}
+	user, err := uc.repo.FindTenantAccount(ctx, account, strings.TrimSpace(tenantID))
+	if err != nil {
+		if errors.IsNotFound(err) || errors.Reason(err) == "ACCOUNT_AMBIGUOUS" {
+			return nil
+		}
+		return err
+	}
+	if !isActiveStatus(user.Status) || user.Email == "" {
+		return nil
+	}
+	if enforced, err := uc.repo.IsSSOEnforced(ctx, user.TenantID); err != nil || enforced {
+		return err
+	}
+	// The link always points at the configured dashboard: a caller-supplied
+	// URL would send the token to whoever asked.
+	_, err = uc.sendAccountToken(ctx, user, AccountTokenPasswordReset, uc.resetTTL, "", "/console/reset-password")
+	return err
+}
+
+// AcceptInvite sets the password of an invited user, activates them and
+// signs them in.
+func (uc *AuthUsecase) AcceptInvite(ctx context.Context, token string, name string, password string) (AuthSession, error) {
+	passwordHash, err := hashNewPassword(password)
+	if err != nil {
+		return AuthSession{}, err
+	}
+	redeemed, err := uc.redeemAccountToken(ctx, token, AccountTokenInvite, AccountUpdate{
+		RequireStatus: UserStatusInvited,
+		PasswordHash:  passwordHash,
+		Name:          strings.TrimSpace(name),
+		Activate:      true,
+	})
+	if err != nil {
+		return AuthSession{}, err
+	}
+	profile, active, err := uc.loadProfile(ctx, SubjectTypeTenant, redeemed.UserID)
+	if err != nil {
+		return AuthSession{}, err
+	}
+	if !active {
+		return AuthSession{}, errors.Forbidden("ACCOUNT_DISABLED", "account disabled")
+	}
+	return uc.completeLogin(ctx, SubjectTypeTenant, profile)
+}
+
+// ResetPassword sets a new password with a reset token and signs the user
+// out everywhere.
+func (uc *AuthUsecase) ResetPassword(ctx context.Context, token string, password string) error {
+	passwordHash, err := hashNewPassword(password)
+	if err != nil {
+		return err
+	}
+	redeemed, err := uc.redeemAccountToken(ctx, token, AccountTokenPasswordReset, AccountUpdate{
+		RequireStatus: UserStatusActive,
+		PasswordHash:  passwordHash,
+	})
+	if err != nil {
+		return err
+	}
+	return uc.RevokeSubjectSessions(ctx, SubjectTypeTenant, redeemed.UserID, RevokeReasonPasswordReset)
+}
+
+// tenantUser loads a user of the tenant in ctx.
+func (uc *AuthUsecase) tenantUser(ctx context.Context, userID string) (TenantAccount, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return TenantAccount{}, errors.Forbidden("TENANT_MISSING", "tenant missing")
+	}
+	user, err := uc.repo.GetTenantAccount(ctx, strings.TrimSpace(userID))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return TenantAccount{}, errors.NotFound("USER_NOT_FOUND", "user not found")
+		}
+		return TenantAccount{}, err
+	}
+	if user.TenantID != tenantID {
+		return TenantAccount{}, errors.NotFound("USER_NOT_FOUND", "user not found")
+	}
+	return user, nil
+}
+
+// sendAccountToken issues a token of the purpose and mails its link.
+func (uc *AuthUsecase) sendAccountToken(ctx context.Context, user TenantAccount, purpose string, ttl time.Duration, baseURL string, path string) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", errors.InternalServer("ACCOUNT_TOKEN_FAILED", "generate token failed")
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	now := time.Now()
+	record := AccountToken{
+		TokenHash: hashToken(token),
+		Purpose:   purpose,
+		TenantID:  user.TenantID,
+		UserID:    user.ID,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+	if err := uc.accounts.CreateAccountToken(ctx, record); err != nil {
+		return "", err
+	}
+	base := strings.TrimRight(strings.TrimSpace(baseURL), "/")
+	if base == "" {
+		base = uc.dashboardURL
+	}
+	link := base + path + "?token=" + url.QueryEscape(token)
+	msg := mail.Message{To: user.Email}
+	switch purpose {
+	case AccountTokenInvite:
+		msg.Subject = "You have been invited to RagoDesk"
+		msg.Body = fmt.Sprintf("You have been invited to join a RagoDesk workspace.\n\nSet your password to accept the invitation:\n%s\n\nThe link expires at %s.\n", link, record.ExpiresAt.UTC().Format(time.RFC1123))
+	default:
+		msg.Subject = "Reset your RagoDesk password"
+		msg.Body = fmt.Sprintf("A password reset was requested for your RagoDesk account.\n\nChoose a new password:\n%s\n\nThe link expires at %s and works once. If you did not ask for this, ignore this mail.\n", link, record.ExpiresAt.UTC().Format(time.RFC1123))
+	}
+	if err := uc.mailer.Send(ctx, msg); err != nil {
+		uc.log.Warnf("send %s mail failed: user=%s err=%v", purpose, user.ID, err)
+		return "", errors.InternalServer("MAIL_SEND_FAILED", "send mail failed")
+	}
+	return link, nil
+}
+
+func (uc *AuthUsecase) redeemAccountToken(ctx context.Context, token string, purpose string, update AccountUpdate) (AccountToken, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return AccountToken{}, errors.BadRequest("ACCOUNT_TOKEN_INVALID", "invalid or expired link")
+	}
+	return uc.accounts.RedeemAccountToken(ctx, hashToken(token), purpose, update, time.Now())
+}
+
+func hashNewPassword(password string) (string, error) {
+	password = strings.TrimSpace(password)
+	if len(password) < minPasswordLength {
+		return "", errors.BadRequest("PASSWORD_TOO_SHORT", fmt.Sprintf("password needs at least %d characters", minPasswordLength))
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", errors.InternalServer("PASSWORD_HASH_FAILED", "password hash failed")
+	}
+	return string(hash), nil
+}
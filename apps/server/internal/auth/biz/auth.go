@@ -5,8 +5,10 @@ import (
 	"strings"
 	"time"
 
-	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/mail"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
@@ -14,7 +16,12 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-const defaultTokenTTL = 24 * time.Hour
+const (
+	defaultAccessTokenTTL      = 15 * time.Minute
+	defaultRefreshTokenTTL     = 30 * 24 * time.Hour
+	defaultKeyRotationInterval = 30 * 24 * time.Hour
+	defaultKeyPublishAhead     = 24 * time.Hour
+)
 
 // Tenant represents a tenant for registration.
 type Tenant struct {
@@ -60,7 +67,16 @@ type AuthProfile struct {
 type AuthSession struct {
 	Token     string
 	ExpiresAt time.Time
-	Profile   AuthProfile
+	// RefreshToken is returned once; only its hash is stored.
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+	Profile          AuthProfile
+	// MFAToken replaces the tokens when the login needs a second factor.
+	MFAToken              string
+	MFAExpiresAt          time.Time
+	MFAEnrollmentRequired bool
+	// RecoveryCodes are returned once, when MFA was enrolled during login.
+	RecoveryCodes []string
 }
 
 // ConsoleRegisterInput captures self-service registration input.
@@ -79,25 +95,107 @@ type AuthRepo interface {
 	FindPlatformAccount(ctx context.Context, account string) (PlatformAccount, error)
 	ListUserRoles(ctx context.Context, userID string) ([]string, error)
 	CreateTenantWithAdmin(ctx context.Context, tenant Tenant, admin TenantAccount, roleName string) (Tenant, TenantAccount, error)
+	GetTenantAccount(ctx context.Context, id string) (TenantAccount, error)
+	GetPlatformAccount(ctx context.Context, id string) (PlatformAccount, error)
+	IsSSOEnforced(ctx context.Context, tenantID string) (bool, error)
+	GetTenantSubscription(ctx context.Context, tenantID string) (plan.Subscription, error)
+
+	CreateRefreshToken(ctx context.Context, token RefreshToken) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, usedID string, next RefreshToken) error
+	RevokeSession(ctx context.Context, subjectID string, sessionID string, reason string) ([]RevokedToken, error)
+	RevokeSubjectSessions(ctx context.Context, subjectType string, subjectID string, reason string) ([]RevokedToken, error)
+	RevokeToken(ctx context.Context, token RevokedToken) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
 }
 
 // AuthUsecase handles authentication logic.
 type AuthUsecase struct {
-	repo      AuthRepo
-	log       *log.Helper
-	secret    string
-	issuer    string
-	audience  string
-	tokenTTL  time.Duration
+	repo           AuthRepo
+	mfa            MFARepo
+	keys           SigningKeyRepo
+	accounts       AccountTokenRepo
+	impersonations ImpersonationRepo
+	mailer         mail.Sender
+	cache          SessionCache
+	log            *log.Helper
+	secret         string
+	issuer         string
+	audience       string
+	tokenTTL       time.Duration
+	refreshTTL     time.Duration
+
+	algorithm    string
+	rotation     time.Duration
+	publishAhead time.Duration
+	keyring      keyring
+	// hs256Until bounds the HS256 migration window after switching to an
+	// asymmetric algorithm.
+	hs256Until time.Time
+
+	inviteTTL    time.Duration
+	resetTTL     time.Duration
+	dashboardURL string
 }
 
 // NewAuthUsecase creates a new AuthUsecase.
-func NewAuthUsecase(repo AuthRepo, cfg *conf.Server, logger log.Logger) *AuthUsecase {
-	uc := &AuthUsecase{repo: repo, log: log.NewHelper(logger), tokenTTL: defaultTokenTTL}
+func NewAuthUsecase(repo AuthRepo, mfa MFARepo, keys SigningKeyRepo, accounts AccountTokenRepo, impersonations ImpersonationRepo, mailer mail.Sender, cache SessionCache, cfg *conf.Server, logger log.Logger) *AuthUsecase {
+	uc := &AuthUsecase{
+		repo:           repo,
+		mfa:            mfa,
+		keys:           keys,
+		accounts:       accounts,
+		impersonations: impersonations,
+		mailer:         mailer,
+		cache:          cache,
+		log:            log.NewHelper(logger),
+		tokenTTL:       defaultAccessTokenTTL,
+		refreshTTL:     defaultRefreshTokenTTL,
+		algorithm:      jwt.AlgHS256,
+		rotation:       defaultKeyRotationInterval,
+		publishAhead:   defaultKeyPublishAhead,
+		inviteTTL:      defaultInviteTTL,
+		resetTTL:       defaultPasswordResetTTL,
+		dashboardURL:   defaultDashboardURL,
+	}
 	if cfg != nil && cfg.Auth != nil {
 		uc.secret = strings.TrimSpace(cfg.Auth.JwtSecret)
 		uc.issuer = strings.TrimSpace(cfg.Auth.Issuer)
 		uc.audience = strings.TrimSpace(cfg.Auth.Audience)
+		if cfg.Auth.AccessTokenTtl != nil && cfg.Auth.AccessTokenTtl.AsDuration() > 0 {
+			uc.tokenTTL = cfg.Auth.AccessTokenTtl.AsDuration()
+		}
+		if cfg.Auth.RefreshTokenTtl != nil && cfg.Auth.RefreshTokenTtl.AsDuration() > 0 {
+			uc.refreshTTL = cfg.Auth.RefreshTokenTtl.AsDuration()
+		}
+		uc.algorithm = jwt.NormalizeAlgorithm(cfg.Auth.SigningAlgorithm)
+		if cfg.Auth.KeyRotationInterval != nil && cfg.Auth.KeyRotationInterval.AsDuration() > 0 {
+			uc.rotation = cfg.Auth.KeyRotationInterval.AsDuration()
+		}
+		if cfg.Auth.KeyPublishAhead != nil && cfg.Auth.KeyPublishAhead.AsDuration() >= 0 {
+			uc.publishAhead = cfg.Auth.KeyPublishAhead.AsDuration()
+		}
+		if cfg.Auth.Hs256AcceptUntil != nil {
+			uc.hs256Until = cfg.Auth.Hs256AcceptUntil.AsTime()
+		}
+		if cfg.Auth.InviteTtl != nil && cfg.Auth.InviteTtl.AsDuration() > 0 {
+			uc.inviteTTL = cfg.Auth.InviteTtl.AsDuration()
+		}
+		if cfg.Auth.PasswordResetTtl != nil && cfg.Auth.PasswordResetTtl.AsDuration() > 0 {
+			uc.resetTTL = cfg.Auth.PasswordResetTtl.AsDuration()
+		}
+		if cfg.Auth.Sso != nil && strings.TrimSpace(cfg.Auth.Sso.DashboardUrl) != "" {
+			uc.dashboardURL = strings.TrimRight(strings.TrimSpace(cfg.Auth.Sso.DashboardUrl), "/")
+		}
+	}
+	if cfg != nil && cfg.Mail != nil && strings.TrimSpace(cfg.Mail.DashboardUrl) != "" {
+		uc.dashboardURL = strings.TrimRight(strings.TrimSpace(cfg.Mail.DashboardUrl), "/")
+	}
+	if uc.publishAhead > uc.rotation {
+		uc.publishAhead = uc.rotation
+	}
+	if uc.algorithm != jwt.AlgHS256 && uc.secret != "" && time.Now().Before(uc.hs256Until) {
+		uc.log.Warnf("accepting HS256 access tokens until %s", uc.hs256Until.Format(time.RFC3339))
 	}
 	return uc
 }
@@ -124,6 +222,13 @@ func (uc *AuthUsecase) ConsoleLogin(ctx context.Context, account string, passwor
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
 		return AuthSession{}, errors.Unauthorized("LOGIN_FAILED", "invalid credentials")
 	}
+	enforced, err := uc.repo.IsSSOEnforced(ctx, user.TenantID)
+	if err != nil {
+		return AuthSession{}, err
+	}
+	if enforced {
+		return AuthSession{}, errors.Forbidden("SSO_REQUIRED", "tenant requires single sign-on")
+	}
 	roles, err := uc.repo.ListUserRoles(ctx, user.ID)
 	if err != nil {
 		return AuthSession{}, err
@@ -135,7 +240,7 @@ func (uc *AuthUsecase) ConsoleLogin(ctx context.Context, account string, passwor
 		Name:      user.Name,
 		Roles:     roles,
 	}
-	return uc.buildSession(profile)
+	return uc.completeLogin(ctx, SubjectTypeTenant, profile)
 }
 
 func (uc *AuthUsecase) ConsoleRegister(ctx context.Context, input ConsoleRegisterInput) (AuthSession, error) {
@@ -191,7 +296,7 @@ func (uc *AuthUsecase) ConsoleRegister(ctx context.Context, input ConsoleRegiste
 		Name:      createdAdmin.Name,
 		Roles:     roles,
 	}
-	return uc.buildSession(profile)
+	return uc.issueSession(ctx, SubjectTypeTenant, profile)
 }
 
 func (uc *AuthUsecase) PlatformLogin(ctx context.Context, account string, password string) (AuthSession, error) {
@@ -219,36 +324,7 @@ func (uc *AuthUsecase) PlatformLogin(ctx context.Context, account string, passwo
 		Name:      admin.Name,
 		Roles:     []string{"platform_admin"},
 	}
-	return uc.buildSession(profile)
-}
-
-func (uc *AuthUsecase) buildSession(profile AuthProfile) (AuthSession, error) {
-	if strings.TrimSpace(uc.secret) == "" {
-		return AuthSession{}, errors.InternalServer("JWT_SECRET_MISSING", "jwt secret missing")
-	}
-	now := time.Now()
-	expires := now.Add(uc.tokenTTL)
-	claims := jwt.Claims{
-		TenantID:  profile.TenantID,
-		Subject:   profile.SubjectID,
-		Issuer:    uc.issuer,
-		Expiry:    expires.Unix(),
-		NotBefore: now.Unix(),
-		IssuedAt:  now.Unix(),
-		Roles:     profile.Roles,
-	}
-	if uc.audience != "" {
-		claims.Audience = uc.audience
-	}
-	token, err := jwt.SignHS256(claims, uc.secret)
-	if err != nil {
-		return AuthSession{}, errors.InternalServer("JWT_SIGN_FAILED", "sign token failed")
-	}
-	return AuthSession{
-		Token:     token,
-		ExpiresAt: expires,
-		Profile:   profile,
-	}, nil
+	return uc.completeLogin(ctx, SubjectTypePlatform, profile)
 }
 
 func primaryAccount(email string, phone string) string {
@@ -263,4 +339,4 @@ func isActiveStatus(status string) bool {
 }
 
 // ProviderSet is auth biz providers.
-var ProviderSet = wire.NewSet(NewAuthUsecase)
+var ProviderSet = wire.NewSet(NewAuthUsecase, NewSSOUsecase)
@@ -0,0 +1,201 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// Impersonation permissions.
+const (
+	PermissionTenantImpersonate      = "platform.tenant.impersonate"
+	PermissionTenantImpersonateWrite = "platform.tenant.impersonate_write"
+)
+
+const (
+	defaultImpersonationTTL = 15 * time.Minute
+	maxImpersonationTTL     = time.Hour
+	maxImpersonationReason  = 512
+	// impersonationRole is granted to impersonation tokens so they see the
+	// whole tenant; writes are still cut off by ReadOnly.
+	impersonationRole = "tenant_admin"
+	// RevokeReasonImpersonationEnded revokes the token of an ended session.
+	RevokeReasonImpersonationEnded = "impersonation_ended"
+)
+
+// ImpersonationSession records a console token issued to a platform admin
+// for a tenant.
+type ImpersonationSession struct {
+	ID        string
+	TenantID  string
+	AdminID   string
+	AdminName string
+	Reason    string
+	ReadOnly  bool
+	AccessJTI string
+	ExpiresAt time.Time
+	RevokedAt time.Time
+	CreatedAt time.Time
+}
+
+// ImpersonationInput is a request of a platform admin to open a tenant
+// console.
+type ImpersonationInput struct {
+	TenantID string
+	Reason   string
+	Write    bool
+	TTL      time.Duration
+}
+
+// Impersonation is an issued impersonation token.
+type Impersonation struct {
+	Token     string
+	ExpiresAt time.Time
+	Session   ImpersonationSession
+}
+
+// ImpersonationRepo stores impersonation sessions.
+type ImpersonationRepo interface {
+	CreateImpersonationSession(ctx context.Context, session ImpersonationSession) error
+	GetImpersonationSession(ctx context.Context, id string) (ImpersonationSession, error)
+	// ListImpersonationSessions lists the sessions of a tenant, or of every
+	// tenant when tenantID is empty, newest first.
+	ListImpersonationSessions(ctx context.Context, tenantID string, limit int, offset int) ([]ImpersonationSession, error)
+	RevokeImpersonationSession(ctx context.Context, id string, now time.Time) error
+}
+
+// Impersonate issues a short-lived console token of a tenant to the calling
+// platform admin. The token is read-only unless input.Write is set; the
+// caller checks the permissions for either.
+func (uc *AuthUsecase) Impersonate(ctx context.Context, input ImpersonationInput) (Impersonation, error) {
+	claims, err := requireClaims(ctx)
+	if err != nil {
+		return Impersonation{}, err
+	}
+	if claims.TenantID != "" || claims.Impersonated() {
+		return Impersonation{}, errors.Forbidden("IMPERSONATION_FORBIDDEN", "only platform admins can impersonate tenants")
+	}
+	input.TenantID = strings.TrimSpace(input.TenantID)
+	input.Reason = strings.TrimSpace(input.Reason)
+	if input.TenantID == "" {
+		return Impersonation{}, errors.BadRequest("TENANT_MISSING", "tenant_id missing")
+	}
+	if input.Reason == "" {
+		return Impersonation{}, errors.BadRequest("IMPERSONATION_REASON_MISSING", "reason missing")
+	}
+	if len(input.Reason) > maxImpersonationReason {
+		return Impersonation{}, errors.BadRequest("IMPERSONATION_REASON_TOO_LONG", "reason too long")
+	}
+	ttl := input.TTL
+	switch {
+	case ttl <= 0:
+		ttl = defaultImpersonationTTL
+	case ttl > maxImpersonationTTL:
+		ttl = maxImpersonationTTL
+	}
+	if err := uc.RequireTenantActive(ctx, input.TenantID); err != nil {
+		return Impersonation{}, err
+	}
+	admin, err := uc.repo.GetPlatformAccount(ctx, claims.Subject)
+	if err != nil {
+		return Impersonation{}, err
+	}
+
+	now := time.Now()
+	session := ImpersonationSession{
+		ID:        uuid.NewString(),
+		TenantID:  input.TenantID,
+		AdminID:   admin.ID,
+		AdminName: admin.Name,
+		Reason:    input.Reason,
+		ReadOnly:  !input.Write,
+		AccessJTI: uuid.NewString(),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+	token := jwt.Claims{
+		TenantID:  session.TenantID,
+		Subject:   admin.ID,
+		Issuer:    uc.issuer,
+		Expiry:    session.ExpiresAt.Unix(),
+		NotBefore: now.Unix(),
+		IssuedAt:  now.Unix(),
+		Roles:     []string{impersonationRole},
+		ID:        session.AccessJTI,
+		SessionID: session.ID,
+		Actor:     &jwt.Actor{Subject: admin.ID},
+		ReadOnly:  session.ReadOnly,
+	}
+	if uc.audience != "" {
+		token.Audience = uc.audience
+	}
+	signed, err := uc.signToken(ctx, token)
+	if err != nil {
+		return Impersonation{}, err
+	}
+	if err := uc.impersonations.CreateImpersonationSession(ctx, session); err != nil {
+		return Impersonation{}, err
+	}
+	uc.log.Infof("impersonation started: session=%s tenant=%s admin=%s read_only=%t", session.ID, session.TenantID, session.AdminID, session.ReadOnly)
+	return Impersonation{Token: signed, ExpiresAt: session.ExpiresAt, Session: session}, nil
+}
+
+// ListImpersonationSessions lists impersonation sessions. Tenant callers
+// only see the sessions of their own tenant.
+func (uc *AuthUsecase) ListImpersonationSessions(ctx context.Context, tenantID string, limit int, offset int) ([]ImpersonationSession, error) {
+	claims, err := requireClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TenantID != "" {
+		tenantID = claims.TenantID
+	}
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return uc.impersonations.ListImpersonationSessions(ctx, strings.TrimSpace(tenantID), limit, offset)
+}
+
+// RevokeImpersonationSession ends an impersonation session before it
+// expires and revokes its token.
+func (uc *AuthUsecase) RevokeImpersonationSession(ctx context.Context, id string) (ImpersonationSession, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ImpersonationSession{}, errors.BadRequest("IMPERSONATION_SESSION_MISSING", "id missing")
+	}
+	session, err := uc.impersonations.GetImpersonationSession(ctx, id)
+	if err != nil {
+		return ImpersonationSession{}, err
+	}
+	if !session.RevokedAt.IsZero() {
+		return session, nil
+	}
+	if err := uc.endImpersonation(ctx, session, RevokeReasonImpersonationEnded); err != nil {
+		return ImpersonationSession{}, err
+	}
+	return uc.impersonations.GetImpersonationSession(ctx, id)
+}
+
+// endImpersonation marks a session revoked and revokes its access token.
+func (uc *AuthUsecase) endImpersonation(ctx context.Context, session ImpersonationSession, reason string) error {
+	now := time.Now()
+	if err := uc.impersonations.RevokeImpersonationSession(ctx, session.ID, now); err != nil {
+		return err
+	}
+	if !now.Before(session.ExpiresAt) {
+		return nil
+	}
+	return uc.revokeAccessToken(ctx, &jwt.Claims{
+		Subject: session.AdminID,
+		ID:      session.AccessJTI,
+		Expiry:  session.ExpiresAt.Unix(),
+		Actor:   &jwt.Actor{Subject: session.AdminID},
+	}, reason)
+}
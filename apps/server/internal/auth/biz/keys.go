@@ -0,0 +1,296 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// keyRefreshInterval bounds how long a replica may miss keys created by
+	// another one; superseded keys stay valid this much longer to cover it.
+	keyRefreshInterval = time.Minute
+	// keyMissRefreshInterval rate-limits reloads for tokens naming an
+	// unknown kid.
+	keyMissRefreshInterval = 10 * time.Second
+)
+
+// SigningKeyRecord is a stored asymmetric signing key. A key signs from
+// ActivatesAt until the next key activates and verifies until ExpiresAt,
+// which is set once it is superseded.
+type SigningKeyRecord struct {
+	ID            string
+	Algorithm     string
+	PrivateKeyPEM string
+	ActivatesAt   time.Time
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+}
+
+// SigningKeyRepo stores the asymmetric signing keys.
+type SigningKeyRepo interface {
+	// ListSigningKeys returns the keys not expired at now, oldest first.
+	ListSigningKeys(ctx context.Context, now time.Time) ([]SigningKeyRecord, error)
+	// CreateSigningKey stores a key unless one with its ID exists, and
+	// expires the keys activated before it at supersededExpiresAt.
+	CreateSigningKey(ctx context.Context, key SigningKeyRecord, supersededExpiresAt time.Time) error
+}
+
+type loadedKey struct {
+	record SigningKeyRecord
+	key    jwt.SigningKey
+}
+
+// keyring caches the parsed signing keys of the replica.
+type keyring struct {
+	mu       sync.Mutex
+	keys     []loadedKey
+	loadedAt time.Time
+}
+
+// VerifyToken verifies an access token against the signing key its kid
+// names, or jwt_secret for HS256, and rejects revoked sessions.
+func (uc *AuthUsecase) VerifyToken(ctx context.Context, token string) (*jwt.Claims, error) {
+	var keyErr error
+	now := time.Now()
+	claims, err := jwt.Parse(token, func(alg string, kid string) (any, error) {
+		switch alg {
+		case jwt.AlgHS256:
+			if uc.secret == "" || !uc.acceptsHS256(now) {
+				return nil, jwt.ErrUnknownKey
+			}
+			return []byte(uc.secret), nil
+		case jwt.AlgRS256, jwt.AlgES256:
+			key, ok, err := uc.verificationKey(ctx, kid)
+			if err != nil {
+				keyErr = err
+				return nil, err
+			}
+			if !ok || key.Algorithm != alg {
+				return nil, jwt.ErrUnknownKey
+			}
+			return key.PublicKey(), nil
+		default:
+			return nil, jwt.ErrInvalidToken
+		}
+	}, uc.issuer, uc.audience, now)
+	if keyErr != nil {
+		return nil, keyErr
+	}
+	if err != nil {
+		return nil, errors.Unauthorized("ADMIN_UNAUTHORIZED", err.Error())
+	}
+	if err := uc.CheckSession(ctx, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// acceptsHS256 reports whether HS256 tokens verify: always when HS256 signs,
+// otherwise only within the configured migration window, so a leaked
+// jwt_secret stops minting tokens once the window closes.
+func (uc *AuthUsecase) acceptsHS256(now time.Time) bool {
+	return uc.algorithm == jwt.AlgHS256 || now.Before(uc.hs256Until)
+}
+
+// JWKS returns the public keys that verify access tokens, including the next
+// key ahead of its activation. It is empty when tokens are signed with HS256.
+func (uc *AuthUsecase) JWKS(ctx context.Context) (jwt.JWKS, error) {
+	out := jwt.JWKS{Keys: []jwt.JWK{}}
+	if uc.algorithm == jwt.AlgHS256 {
+		return out, nil
+	}
+	if _, err := uc.signingKey(ctx); err != nil {
+		return out, err
+	}
+	keys, err := uc.loadKeys(ctx, false)
+	if err != nil {
+		return out, err
+	}
+	for _, item := range keys {
+		jwk, err := item.key.PublicJWK()
+		if err != nil {
+			continue
+		}
+		out.Keys = append(out.Keys, jwk)
+	}
+	return out, nil
+}
+
+// signToken signs claims with the configured algorithm.
+func (uc *AuthUsecase) signToken(ctx context.Context, claims jwt.Claims) (string, error) {
+	switch uc.algorithm {
+	case jwt.AlgHS256:
+		if uc.secret == "" {
+			return "", errors.InternalServer("JWT_SECRET_MISSING", "jwt secret missing")
+		}
+		token, err := jwt.SignHS256(claims, uc.secret)
+		if err != nil {
+			return "", errors.InternalServer("JWT_SIGN_FAILED", "sign token failed")
+		}
+		return token, nil
+	case jwt.AlgRS256, jwt.AlgES256:
+		key, err := uc.signingKey(ctx)
+		if err != nil {
+			return "", err
+		}
+		token, err := jwt.Sign(claims, key)
+		if err != nil {
+			return "", errors.InternalServer("JWT_SIGN_FAILED", "sign token failed")
+		}
+		return token, nil
+	default:
+		return "", errors.InternalServer("JWT_ALGORITHM_UNSUPPORTED", "unsupported signing algorithm")
+	}
+}
+
+// signingKey returns the key that signs now, creating the first key of the
+// configured algorithm and publishing the next one ahead of its activation.
+func (uc *AuthUsecase) signingKey(ctx context.Context) (jwt.SigningKey, error) {
+	keys, err := uc.loadKeys(ctx, false)
+	if err != nil {
+		return jwt.SigningKey{}, err
+	}
+	now := time.Now()
+	current, next := currentSigningKey(keys, uc.algorithm, now)
+	if current == nil {
+		if err := uc.createSigningKey(ctx, now, false); err != nil {
+			return jwt.SigningKey{}, err
+		}
+	} else if next == nil && !now.Before(current.record.ActivatesAt.Add(uc.rotation-uc.publishAhead)) {
+		activatesAt, scheduled := current.record.ActivatesAt.Add(uc.rotation), true
+		if activatesAt.Before(now) {
+			// No replica signed since the rotation was due.
+			activatesAt, scheduled = now, false
+		}
+		if err := uc.createSigningKey(ctx, activatesAt, scheduled); err != nil {
+			return jwt.SigningKey{}, err
+		}
+	} else {
+		return current.key, nil
+	}
+	uc.keyring.mu.Lock()
+	uc.keyring.loadedAt = time.Time{}
+	uc.keyring.mu.Unlock()
+	if keys, err = uc.loadKeys(ctx, false); err != nil {
+		return jwt.SigningKey{}, err
+	}
+	if current, _ = currentSigningKey(keys, uc.algorithm, now); current == nil {
+		return jwt.SigningKey{}, errors.InternalServer("JWT_SIGNING_KEY_MISSING", "signing key missing")
+	}
+	return current.key, nil
+}
+
+// createSigningKey generates and stores a key activating at activatesAt.
+// Scheduled keys get an ID derived from the activation, so that replicas
+// rotating at once agree on the key; keys needed immediately do not.
+func (uc *AuthUsecase) createSigningKey(ctx context.Context, activatesAt time.Time, scheduled bool) error {
+	private, err := jwt.GenerateSigningKey(uc.algorithm)
+	if err != nil {
+		return errors.InternalServer("JWT_ALGORITHM_UNSUPPORTED", "unsupported signing algorithm")
+	}
+	pemData, err := jwt.MarshalPrivateKeyPEM(private)
+	if err != nil {
+		return errors.InternalServer("JWT_SIGNING_KEY_FAILED", "generate signing key failed")
+	}
+	activatesAt = activatesAt.UTC().Truncate(time.Second)
+	id := fmt.Sprintf("%s-%d", strings.ToLower(uc.algorithm), activatesAt.Unix())
+	if !scheduled {
+		id += "-" + strings.ReplaceAll(uuid.NewString(), "-", "")[:8]
+	}
+	record := SigningKeyRecord{
+		ID:            id,
+		Algorithm:     uc.algorithm,
+		PrivateKeyPEM: pemData,
+		ActivatesAt:   activatesAt,
+		CreatedAt:     time.Now(),
+	}
+	// Tokens signed by a superseded key stay verifiable until they expire.
+	supersededExpiresAt := activatesAt.Add(uc.tokenTTL + keyRefreshInterval)
+	if err := uc.keys.CreateSigningKey(ctx, record, supersededExpiresAt); err != nil {
+		return err
+	}
+	uc.log.Infof("created jwt signing key: kid=%s activates_at=%s", record.ID, record.ActivatesAt.Format(time.RFC3339))
+	return nil
+}
+
+// verificationKey finds a key by kid, reloading once when it is unknown so
+// that keys created by other replicas are picked up.
+func (uc *AuthUsecase) verificationKey(ctx context.Context, kid string) (jwt.SigningKey, bool, error) {
+	if kid == "" {
+		return jwt.SigningKey{}, false, nil
+	}
+	for _, force := range []bool{false, true} {
+		keys, err := uc.loadKeys(ctx, force)
+		if err != nil {
+			return jwt.SigningKey{}, false, err
+		}
+		for _, item := range keys {
+			if item.record.ID == kid {
+				return item.key, true, nil
+			}
+		}
+	}
+	return jwt.SigningKey{}, false, nil
+}
+
+// loadKeys returns the cached keys, reloading them after keyRefreshInterval
+// or, when forced, after keyMissRefreshInterval.
+func (uc *AuthUsecase) loadKeys(ctx context.Context, force bool) ([]loadedKey, error) {
+	if uc.keys == nil {
+		return nil, nil
+	}
+	uc.keyring.mu.Lock()
+	defer uc.keyring.mu.Unlock()
+	now := time.Now()
+	maxAge := keyRefreshInterval
+	if force {
+		maxAge = keyMissRefreshInterval
+	}
+	if !uc.keyring.loadedAt.IsZero() && now.Sub(uc.keyring.loadedAt) < maxAge {
+		return uc.keyring.keys, nil
+	}
+	records, err := uc.keys.ListSigningKeys(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]loadedKey, 0, len(records))
+	for _, record := range records {
+		private, err := jwt.ParsePrivateKeyPEM(record.PrivateKeyPEM)
+		if err != nil {
+			uc.log.Warnf("skip invalid jwt signing key: kid=%s err=%v", record.ID, err)
+			continue
+		}
+		keys = append(keys, loadedKey{
+			record: record,
+			key:    jwt.SigningKey{ID: record.ID, Algorithm: record.Algorithm, Private: private},
+		})
+	}
+	uc.keyring.keys = keys
+	uc.keyring.loadedAt = now
+	return keys, nil
+}
+
+// currentSigningKey picks the latest activated key of the algorithm and the
+// key scheduled after it, if any.
+func currentSigningKey(keys []loadedKey, algorithm string, now time.Time) (*loadedKey, *loadedKey) {
+	var current, next *loadedKey
+	for i := range keys {
+		item := &keys[i]
+		if item.record.Algorithm != algorithm {
+			continue
+		}
+		if !item.record.ActivatesAt.After(now) {
+			current = item
+		} else if next == nil {
+			next = item
+		}
+	}
+	return current, next
+}
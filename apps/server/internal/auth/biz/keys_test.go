@@ -0,0 +1,482 @@
+package biz
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/oidc/oidctest"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testJWTSecret = "test-jwt-secret"
+
+// fakeKeyRepo stores signing keys like the MySQL repo. elapsed moves its
+// clock forward to expire superseded keys.
+type fakeKeyRepo struct {
+	mu      sync.Mutex
+	records []SigningKeyRecord
+	elapsed time.Duration
+}
+
+func (r *fakeKeyRepo) ListSigningKeys(_ context.Context, now time.Time) ([]SigningKeyRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now = now.Add(r.elapsed)
+	var out []SigningKeyRecord
+	for _, record := range r.records {
+		if record.ExpiresAt.IsZero() || record.ExpiresAt.After(now) {
+			out = append(out, record)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeKeyRepo) CreateSigningKey(_ context.Context, key SigningKeyRecord, supersededExpiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, record := range r.records {
+		if record.ID == key.ID {
+			return nil
+		}
+	}
+	for i := range r.records {
+		if r.records[i].ExpiresAt.IsZero() && r.records[i].ActivatesAt.Before(key.ActivatesAt) {
+			r.records[i].ExpiresAt = supersededExpiresAt
+		}
+	}
+	r.records = append(r.records, key)
+	return nil
+}
+
+func (r *fakeKeyRepo) record(t *testing.T, id string) SigningKeyRecord {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, record := range r.records {
+		if record.ID == id {
+			return record
+		}
+	}
+	t.Fatalf("no signing key %s", id)
+	return SigningKeyRecord{}
+}
+
+type keysConfig struct {
+	algorithm  string
+	hs256Until time.Time
+}
+
+func newKeysUsecase(t *testing.T, cfg keysConfig) (*AuthUsecase, *fakeKeyRepo) {
+	t.Helper()
+	auth := &conf.Server_Auth{
+		JwtSecret:           testJWTSecret,
+		Issuer:              "ragodesk",
+		Audience:            "console",
+		SigningAlgorithm:    cfg.algorithm,
+		AccessTokenTtl:      durationpb.New(15 * time.Minute),
+		KeyRotationInterval: durationpb.New(24 * time.Hour),
+		KeyPublishAhead:     durationpb.New(time.Hour),
+	}
+	if !cfg.hs256Until.IsZero() {
+		auth.Hs256AcceptUntil = timestamppb.New(cfg.hs256Until)
+	}
+	keys := &fakeKeyRepo{}
+	uc := NewAuthUsecase(fakeAuthRepo{}, nil, keys, nil, nil, nil, nil, &conf.Server{Auth: auth}, log.NewStdLogger(io.Discard))
+	return uc, keys
+}
+
+// newKeyRecord generates a stored key of alg activating at activatesAt.
+func newKeyRecord(t *testing.T, id string, alg string, activatesAt time.Time) (SigningKeyRecord, jwt.SigningKey) {
+	t.Helper()
+	private, err := jwt.GenerateSigningKey(alg)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	pemData, err := jwt.MarshalPrivateKeyPEM(private)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	record := SigningKeyRecord{ID: id, Algorithm: alg, PrivateKeyPEM: pemData, ActivatesAt: activatesAt, CreatedAt: activatesAt}
+	return record, jwt.SigningKey{ID: id, Algorithm: alg, Private: private}
+}
+
+// testAccessClaims carries no jti, so verification skips the session check.
+func testAccessClaims() jwt.Claims {
+	now := time.Now()
+	return jwt.Claims{
+		TenantID: testTenantID,
+		Subject:  "user-1",
+		Issuer:   "ragodesk",
+		Audience: "console",
+		IssuedAt: now.Unix(),
+		Expiry:   now.Add(15 * time.Minute).Unix(),
+	}
+}
+
+// signWithHeader signs claims with alg and key under an arbitrary header,
+// the way a forger would.
+func signWithHeader(t *testing.T, alg string, kid string, key any, claims jwt.Claims) string {
+	t.Helper()
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("claims: %v", err)
+	}
+	if signer, ok := key.(crypto.Signer); ok {
+		var fields map[string]any
+		if err := json.Unmarshal(payload, &fields); err != nil {
+			t.Fatalf("claims: %v", err)
+		}
+		token, err := oidctest.Sign(alg, kid, signer, fields)
+		if err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		return token
+	}
+	headerJSON, _ := json.Marshal(map[string]string{"alg": alg, "typ": "JWT", "kid": kid})
+	input := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, key.([]byte))
+	_, _ = mac.Write([]byte(input))
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyTokenKeys(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	tests := []struct {
+		name string
+		cfg  keysConfig
+		// token builds the token to verify; signer is the key the usecase
+		// signs with.
+		token   func(t *testing.T, uc *AuthUsecase, signer jwt.SigningKey) string
+		wantErr bool
+	}{
+		{
+			name: "current rs256 key",
+			cfg:  keysConfig{algorithm: jwt.AlgRS256},
+			token: func(t *testing.T, uc *AuthUsecase, _ jwt.SigningKey) string {
+				token, err := uc.signToken(ctx, testAccessClaims())
+				if err != nil {
+					t.Fatalf("sign: %v", err)
+				}
+				return token
+			},
+		},
+		{
+			name: "current es256 key",
+			cfg:  keysConfig{algorithm: jwt.AlgES256},
+			token: func(t *testing.T, uc *AuthUsecase, _ jwt.SigningKey) string {
+				token, err := uc.signToken(ctx, testAccessClaims())
+				if err != nil {
+					t.Fatalf("sign: %v", err)
+				}
+				return token
+			},
+		},
+		{
+			name: "unknown kid",
+			cfg:  keysConfig{algorithm: jwt.AlgRS256},
+			token: func(t *testing.T, _ *AuthUsecase, _ jwt.SigningKey) string {
+				_, other := newKeyRecord(t, "rs256-unknown", jwt.AlgRS256, now)
+				return signWithHeader(t, jwt.AlgRS256, other.ID, other.Private, testAccessClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "known kid signed by another key",
+			cfg:  keysConfig{algorithm: jwt.AlgRS256},
+			token: func(t *testing.T, _ *AuthUsecase, signer jwt.SigningKey) string {
+				_, other := newKeyRecord(t, "rs256-other", jwt.AlgRS256, now)
+				return signWithHeader(t, jwt.AlgRS256, signer.ID, other.Private, testAccessClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "no kid",
+			cfg:  keysConfig{algorithm: jwt.AlgRS256},
+			token: func(t *testing.T, _ *AuthUsecase, signer jwt.SigningKey) string {
+				return signWithHeader(t, jwt.AlgRS256, "", signer.Private, testAccessClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "es256 token naming an rsa kid",
+			cfg:  keysConfig{algorithm: jwt.AlgRS256},
+			token: func(t *testing.T, _ *AuthUsecase, signer jwt.SigningKey) string {
+				_, ec := newKeyRecord(t, "es256-forged", jwt.AlgES256, now)
+				return signWithHeader(t, jwt.AlgES256, signer.ID, ec.Private, testAccessClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "hs256 token naming an rsa kid",
+			cfg:  keysConfig{algorithm: jwt.AlgRS256},
+			token: func(t *testing.T, _ *AuthUsecase, signer jwt.SigningKey) string {
+				return signWithHeader(t, jwt.AlgHS256, signer.ID, []byte(testJWTSecret), testAccessClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "hs256 token keyed with the rsa public key",
+			cfg:  keysConfig{algorithm: jwt.AlgRS256, hs256Until: now.Add(time.Hour)},
+			token: func(t *testing.T, _ *AuthUsecase, signer jwt.SigningKey) string {
+				publicPEM, err := jwt.MarshalPublicKeyPEM(signer.Private)
+				if err != nil {
+					t.Fatalf("public pem: %v", err)
+				}
+				return signWithHeader(t, jwt.AlgHS256, signer.ID, []byte(publicPEM), testAccessClaims())
+			},
+			wantErr: true,
+		},
+		{
+			name: "hs256 within the migration window",
+			cfg:  keysConfig{algorithm: jwt.AlgRS256, hs256Until: now.Add(time.Hour)},
+			token: func(t *testing.T, _ *AuthUsecase, _ jwt.SigningKey) string {
+				token, err := jwt.SignHS256(testAccessClaims(), testJWTSecret)
+				if err != nil {
+					t.Fatalf("sign: %v", err)
+				}
+				return token
+			},
+		},
+		{
+			name: "hs256 after the migration window",
+			cfg:  keysConfig{algorithm: jwt.AlgRS256, hs256Until: now.Add(-time.Second)},
+			token: func(t *testing.T, _ *AuthUsecase, _ jwt.SigningKey) string {
+				token, err := jwt.SignHS256(testAccessClaims(), testJWTSecret)
+				if err != nil {
+					t.Fatalf("sign: %v", err)
+				}
+				return token
+			},
+			wantErr: true,
+		},
+		{
+			name: "hs256 without a migration window",
+			cfg:  keysConfig{algorithm: jwt.AlgES256},
+			token: func(t *testing.T, _ *AuthUsecase, _ jwt.SigningKey) string {
+				token, err := jwt.SignHS256(testAccessClaims(), testJWTSecret)
+				if err != nil {
+					t.Fatalf("sign: %v", err)
+				}
+				return token
+			},
+			wantErr: true,
+		},
+		{
+			name: "hs256 signing",
+			cfg:  keysConfig{algorithm: jwt.AlgHS256},
+			token: func(t *testing.T, uc *AuthUsecase, _ jwt.SigningKey) string {
+				token, err := uc.signToken(ctx, testAccessClaims())
+				if err != nil {
+					t.Fatalf("sign: %v", err)
+				}
+				return token
+			},
+		},
+		{
+			name: "rs256 token while hs256 signs",
+			cfg:  keysConfig{algorithm: jwt.AlgHS256},
+			token: func(t *testing.T, _ *AuthUsecase, _ jwt.SigningKey) string {
+				_, key := newKeyRecord(t, "rs256-1", jwt.AlgRS256, now)
+				return signWithHeader(t, jwt.AlgRS256, key.ID, key.Private, testAccessClaims())
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _ := newKeysUsecase(t, tt.cfg)
+			var signer jwt.SigningKey
+			if tt.cfg.algorithm != jwt.AlgHS256 {
+				var err error
+				if signer, err = uc.signingKey(ctx); err != nil {
+					t.Fatalf("signing key: %v", err)
+				}
+			}
+			claims, err := uc.VerifyToken(ctx, tt.token(t, uc, signer))
+			if tt.wantErr {
+				if reason := errors.Reason(err); reason != "ADMIN_UNAUTHORIZED" {
+					t.Fatalf("err = %v, want ADMIN_UNAUTHORIZED", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("verify: %v", err)
+			}
+			if claims.Subject != "user-1" {
+				t.Fatalf("claims = %+v", claims)
+			}
+		})
+	}
+}
+
+func TestSupersededKeyVerifiesUntilTokensExpire(t *testing.T) {
+	ctx := context.Background()
+	uc, keys := newKeysUsecase(t, keysConfig{algorithm: jwt.AlgRS256})
+	activatesAt := time.Now().UTC().Truncate(time.Second)
+	record, old := newKeyRecord(t, "rs256-old", jwt.AlgRS256, activatesAt.Add(-time.Hour))
+	keys.records = []SigningKeyRecord{record}
+	token, err := uc.signToken(ctx, testAccessClaims())
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	if kid := jwtHeaderKid(t, token); kid != old.ID {
+		t.Fatalf("signed with %s, want %s", kid, old.ID)
+	}
+	if err := uc.createSigningKey(ctx, activatesAt, false); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	expiresAt := keys.record(t, old.ID).ExpiresAt
+	if want := activatesAt.Add(uc.tokenTTL + keyRefreshInterval); !expiresAt.Equal(want) {
+		t.Fatalf("superseded key expires at %s, want %s", expiresAt, want)
+	}
+
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		wantErr bool
+	}{
+		{name: "right after the rotation"},
+		{name: "when its last tokens expire", elapsed: uc.tokenTTL},
+		{name: "within the refresh interval", elapsed: uc.tokenTTL + keyRefreshInterval - 5*time.Second},
+		{name: "after expiry", elapsed: uc.tokenTTL + keyRefreshInterval + 5*time.Second, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys.elapsed = tt.elapsed
+			uc.keyring.loadedAt = time.Time{}
+			_, err := uc.VerifyToken(ctx, token)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("verify err = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	keys.elapsed = 0
+	uc.keyring.loadedAt = time.Time{}
+	current, err := uc.signingKey(ctx)
+	if err != nil {
+		t.Fatalf("signing key: %v", err)
+	}
+	if current.ID == old.ID {
+		t.Fatal("still signing with the superseded key")
+	}
+}
+
+func TestSigningKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	tests := []struct {
+		name string
+		// activated is how long ago the current key activated.
+		activated time.Duration
+		// wantNext is the activation of the key published next, if any.
+		wantNext    time.Duration
+		wantNextID  bool
+		wantSigning string
+	}{
+		{name: "fresh key", activated: time.Hour, wantSigning: "current"},
+		{name: "next key published ahead", activated: 23*time.Hour + 30*time.Minute, wantNext: 30 * time.Minute, wantNextID: true, wantSigning: "current"},
+		// No replica signed since the rotation was due: the new key signs at once.
+		{name: "overdue rotation", activated: 48 * time.Hour, wantNext: 0, wantSigning: "new"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, keys := newKeysUsecase(t, keysConfig{algorithm: jwt.AlgRS256})
+			record, current := newKeyRecord(t, "rs256-current", jwt.AlgRS256, now.Add(-tt.activated))
+			keys.records = []SigningKeyRecord{record}
+
+			signer, err := uc.signingKey(ctx)
+			if err != nil {
+				t.Fatalf("signing key: %v", err)
+			}
+			if (tt.wantSigning == "current") != (signer.ID == current.ID) {
+				t.Fatalf("signing with %s, want the %s key", signer.ID, tt.wantSigning)
+			}
+			jwks, err := uc.JWKS(ctx)
+			if err != nil {
+				t.Fatalf("jwks: %v", err)
+			}
+			if tt.wantSigning == "current" && !tt.wantNextID {
+				if len(keys.records) != 1 || len(jwks.Keys) != 1 || jwks.Keys[0].Kid != current.ID {
+					t.Fatalf("records = %d, jwks = %+v", len(keys.records), jwks.Keys)
+				}
+				return
+			}
+			if len(keys.records) != 2 {
+				t.Fatalf("records = %d, want 2", len(keys.records))
+			}
+			next := keys.records[1]
+			if want := now.Add(-tt.activated + 24*time.Hour); tt.wantNextID && !next.ActivatesAt.Equal(want) {
+				t.Fatalf("next key activates at %s, want %s", next.ActivatesAt, want)
+			}
+			// Scheduled keys get a deterministic ID so that replicas agree.
+			if wantID := fmt.Sprintf("rs256-%d", next.ActivatesAt.Unix()); tt.wantNextID != (next.ID == wantID) {
+				t.Fatalf("next key id = %s", next.ID)
+			}
+			kids := map[string]bool{}
+			for _, jwk := range jwks.Keys {
+				kids[jwk.Kid] = true
+				if jwk.Alg != jwt.AlgRS256 || jwk.Kty != "RSA" || jwk.N == "" {
+					t.Fatalf("jwk = %+v", jwk)
+				}
+			}
+			if len(jwks.Keys) != 2 || !kids[current.ID] || !kids[next.ID] {
+				t.Fatalf("jwks = %+v, want the current and next keys", jwks.Keys)
+			}
+		})
+	}
+}
+
+func TestVerifyTokenReloadsUnknownKid(t *testing.T) {
+	ctx := context.Background()
+	uc, keys := newKeysUsecase(t, keysConfig{algorithm: jwt.AlgES256})
+	if _, err := uc.signingKey(ctx); err != nil {
+		t.Fatalf("signing key: %v", err)
+	}
+	// Another replica rotates.
+	record, key := newKeyRecord(t, "es256-replica", jwt.AlgES256, time.Now())
+	if err := keys.CreateSigningKey(ctx, record, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	token := signWithHeader(t, jwt.AlgES256, key.ID, key.Private, testAccessClaims())
+
+	// Misses only reload every keyMissRefreshInterval.
+	if _, err := uc.VerifyToken(ctx, token); err == nil {
+		t.Fatal("verified a kid loaded less than keyMissRefreshInterval ago")
+	}
+	uc.keyring.loadedAt = time.Now().Add(-keyMissRefreshInterval - time.Second)
+	if _, err := uc.VerifyToken(ctx, token); err != nil {
+		t.Fatalf("verify after reload: %v", err)
+	}
+}
+
+func jwtHeaderKid(t *testing.T, token string) string {
+	t.Helper()
+	raw, err := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[0])
+	if err != nil {
+		t.Fatalf("header: %v", err)
+	}
+	var hdr struct {
+		Kid string `json:"kid"`
+	}
+	if err := json.Unmarshal(raw, &hdr); err != nil {
+		t.Fatalf("header: %v", err)
+	}
+	return hdr.Kid
+}
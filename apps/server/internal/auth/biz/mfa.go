@@ -0,0 +1,571 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/totp"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// MFA policy permissions.
+const (
+	PermissionTenantMFAPolicyRead    = "tenant.mfa_policy.read"
+	PermissionTenantMFAPolicyWrite   = "tenant.mfa_policy.write"
+	PermissionPlatformMFAPolicyRead  = "platform.mfa_policy.read"
+	PermissionPlatformMFAPolicyWrite = "platform.mfa_policy.write"
+)
+
+const (
+	// mfaChallengeTTL bounds the time between the password and code steps.
+	mfaChallengeTTL = 5 * time.Minute
+	// mfaChallengeAttempts is the number of wrong codes a challenge allows.
+	mfaChallengeAttempts = 5
+	// mfaSkew also accepts codes of the previous and next time step.
+	mfaSkew = 1
+	// recoveryCodeCount codes of recoveryCodeLength base32 characters are
+	// issued at a time.
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	defaultTOTPIssuer  = "RagoDesk"
+)
+
+// MFAFactor is the TOTP factor of a subject. A factor without ConfirmedAt
+// is an enrollment in progress and does not protect logins yet.
+type MFAFactor struct {
+	SubjectType  string
+	SubjectID    string
+	Secret       string
+	ConfirmedAt  time.Time
+	LastUsedStep int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// MFAChallenge links the password step of a login to its code step.
+type MFAChallenge struct {
+	TokenHash   string
+	SubjectType string
+	SubjectID   string
+	TenantID    string
+	// Enrollment is set when policy requires MFA but the subject has no
+	// confirmed factor; the challenge then allows enrolling one.
+	Enrollment bool
+	Attempts   int
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+// MFAPolicy makes MFA mandatory for the members of a tenant or the
+// platform admins holding any of the listed roles, or for all of them.
+type MFAPolicy struct {
+	SubjectType     string
+	TenantID        string
+	RequireAll      bool
+	RequiredRoleIDs []string
+	UpdatedAt       time.Time
+}
+
+// MFAStatus describes the MFA state of the caller.
+type MFAStatus struct {
+	Enabled                bool
+	Pending                bool
+	Required               bool
+	ConfirmedAt            time.Time
+	RecoveryCodesRemaining int
+}
+
+// MFAEnrollment is a TOTP secret to add to an authenticator app.
+type MFAEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// MFARepo persists factors, recovery codes, login challenges and policies.
+type MFARepo interface {
+	GetMFAFactor(ctx context.Context, subjectType string, subjectID string) (MFAFactor, error)
+	// SaveMFAFactorSecret starts an enrollment; a confirmed factor is kept.
+	SaveMFAFactorSecret(ctx context.Context, factor MFAFactor) error
+	// ConfirmMFAFactor confirms an enrollment and replaces the recovery codes.
+	ConfirmMFAFactor(ctx context.Context, subjectType string, subjectID string, step int64, codeHashes []string) error
+	// UseMFAStep records a used time step; false when it was used already.
+	UseMFAStep(ctx context.Context, subjectType string, subjectID string, step int64) (bool, error)
+	DeleteMFAFactor(ctx context.Context, subjectType string, subjectID string) error
+	ReplaceRecoveryCodes(ctx context.Context, subjectType string, subjectID string, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, subjectType string, subjectID string, codeHash string) (bool, error)
+	CountRecoveryCodes(ctx context.Context, subjectType string, subjectID string) (int, error)
+
+	CreateMFAChallenge(ctx context.Context, challenge MFAChallenge) error
+	GetMFAChallenge(ctx context.Context, tokenHash string) (MFAChallenge, error)
+	// FailMFAChallenge counts a wrong code and drops the challenge once
+	// maxAttempts is reached.
+	FailMFAChallenge(ctx context.Context, tokenHash string, maxAttempts int) error
+	ConsumeMFAChallenge(ctx context.Context, tokenHash string) (bool, error)
+
+	GetMFAPolicy(ctx context.Context, subjectType string, tenantID string) (MFAPolicy, error)
+	SaveMFAPolicy(ctx context.Context, policy MFAPolicy) (MFAPolicy, error)
+	ListSubjectRoleIDs(ctx context.Context, subjectType string, subjectID string) ([]string, error)
+	CountScopeRoles(ctx context.Context, subjectType string, tenantID string, roleIDs []string) (int, error)
+}
+
+// completeLogin finishes a password or SSO login: it issues the session, or a
+// challenge when the subject has a confirmed factor or policy requires one.
+func (uc *AuthUsecase) completeLogin(ctx context.Context, subjectType string, profile AuthProfile) (AuthSession, error) {
+	enrolled := false
+	factor, err := uc.mfa.GetMFAFactor(ctx, subjectType, profile.SubjectID)
+	if err == nil {
+		enrolled = !factor.ConfirmedAt.IsZero()
+	} else if !errors.IsNotFound(err) {
+		return AuthSession{}, err
+	}
+	if !enrolled {
+		required, err := uc.mfaRequired(ctx, subjectType, profile.SubjectID, profile.TenantID)
+		if err != nil {
+			return AuthSession{}, err
+		}
+		if !required {
+			return uc.issueSession(ctx, subjectType, profile)
+		}
+	}
+	token, err := randomToken()
+	if err != nil {
+		return AuthSession{}, err
+	}
+	now := time.Now()
+	challenge := MFAChallenge{
+		TokenHash:   hashToken(token),
+		SubjectType: subjectType,
+		SubjectID:   profile.SubjectID,
+		TenantID:    profile.TenantID,
+		Enrollment:  !enrolled,
+		ExpiresAt:   now.Add(mfaChallengeTTL),
+		CreatedAt:   now,
+	}
+	if err := uc.mfa.CreateMFAChallenge(ctx, challenge); err != nil {
+		return AuthSession{}, err
+	}
+	return AuthSession{
+		MFAToken:              token,
+		MFAExpiresAt:          challenge.ExpiresAt,
+		MFAEnrollmentRequired: challenge.Enrollment,
+	}, nil
+}
+
+// EnrollMFAChallenge starts the enrollment a challenge requires and returns
+// the secret to add to an authenticator app.
+func (uc *AuthUsecase) EnrollMFAChallenge(ctx context.Context, subjectType string, mfaToken string) (MFAEnrollment, error) {
+	challenge, err := uc.loadMFAChallenge(ctx, subjectType, mfaToken)
+	if err != nil {
+		return MFAEnrollment{}, err
+	}
+	if !challenge.Enrollment {
+		return MFAEnrollment{}, errors.Conflict("MFA_ALREADY_ENABLED", "mfa already enabled")
+	}
+	profile, active, err := uc.loadProfile(ctx, subjectType, challenge.SubjectID)
+	if err != nil {
+		return MFAEnrollment{}, err
+	}
+	if !active {
+		return MFAEnrollment{}, errors.Forbidden("ACCOUNT_DISABLED", "account disabled")
+	}
+	return uc.startEnrollment(ctx, subjectType, profile)
+}
+
+// VerifyMFA completes a login with a TOTP or recovery code. A challenge that
+// requires enrollment confirms the new factor and returns its recovery
+// codes with the session.
+func (uc *AuthUsecase) VerifyMFA(ctx context.Context, subjectType string, mfaToken string, code string, recoveryCode string) (AuthSession, error) {
+	challenge, err := uc.loadMFAChallenge(ctx, subjectType, mfaToken)
+	if err != nil {
+		return AuthSession{}, err
+	}
+	factor, err := uc.mfa.GetMFAFactor(ctx, subjectType, challenge.SubjectID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return AuthSession{}, errors.BadRequest("MFA_NOT_ENROLLED", "enroll mfa first")
+		}
+		return AuthSession{}, err
+	}
+	var recoveryCodes []string
+	if factor.ConfirmedAt.IsZero() {
+		if !challenge.Enrollment {
+			return AuthSession{}, errors.BadRequest("MFA_NOT_ENROLLED", "enroll mfa first")
+		}
+		step, ok := totp.Validate(factor.Secret, code, time.Now(), mfaSkew)
+		if !ok {
+			return AuthSession{}, uc.failMFAChallenge(ctx, challenge)
+		}
+		recoveryCodes, err = uc.confirmFactor(ctx, factor, step)
+		if err != nil {
+			return AuthSession{}, err
+		}
+	} else if err := uc.verifyFactor(ctx, factor, code, recoveryCode); err != nil {
+		if errors.Reason(err) == "MFA_CODE_INVALID" {
+			return AuthSession{}, uc.failMFAChallenge(ctx, challenge)
+		}
+		return AuthSession{}, err
+	}
+	consumed, err := uc.mfa.ConsumeMFAChallenge(ctx, challenge.TokenHash)
+	if err != nil {
+		return AuthSession{}, err
+	}
+	if !consumed {
+		return AuthSession{}, errors.Unauthorized("MFA_CHALLENGE_INVALID", "mfa challenge already used")
+	}
+	profile, active, err := uc.loadProfile(ctx, subjectType, challenge.SubjectID)
+	if err != nil {
+		return AuthSession{}, err
+	}
+	if !active {
+		return AuthSession{}, errors.Forbidden("ACCOUNT_DISABLED", "account disabled")
+	}
+	session, err := uc.issueSession(ctx, subjectType, profile)
+	if err != nil {
+		return AuthSession{}, err
+	}
+	session.RecoveryCodes = recoveryCodes
+	return session, nil
+}
+
+// GetMFAStatus returns the MFA state of the caller.
+func (uc *AuthUsecase) GetMFAStatus(ctx context.Context, subjectType string) (MFAStatus, error) {
+	claims, err := requireSubjectType(ctx, subjectType)
+	if err != nil {
+		return MFAStatus{}, err
+	}
+	var status MFAStatus
+	factor, err := uc.mfa.GetMFAFactor(ctx, subjectType, claims.Subject)
+	switch {
+	case err == nil:
+		status.Enabled = !factor.ConfirmedAt.IsZero()
+		status.Pending = factor.ConfirmedAt.IsZero()
+		status.ConfirmedAt = factor.ConfirmedAt
+	case !errors.IsNotFound(err):
+		return MFAStatus{}, err
+	}
+	if status.Enabled {
+		status.RecoveryCodesRemaining, err = uc.mfa.CountRecoveryCodes(ctx, subjectType, claims.Subject)
+		if err != nil {
+			return MFAStatus{}, err
+		}
+	}
+	status.Required, err = uc.mfaRequired(ctx, subjectType, claims.Subject, claims.TenantID)
+	if err != nil {
+		return MFAStatus{}, err
+	}
+	return status, nil
+}
+
+// EnrollMFA starts an enrollment for the caller. Starting again replaces the
+// pending secret.
+func (uc *AuthUsecase) EnrollMFA(ctx context.Context, subjectType string) (MFAEnrollment, error) {
+	claims, err := requireSubjectType(ctx, subjectType)
+	if err != nil {
+		return MFAEnrollment{}, err
+	}
+	factor, err := uc.mfa.GetMFAFactor(ctx, subjectType, claims.Subject)
+	if err != nil && !errors.IsNotFound(err) {
+		return MFAEnrollment{}, err
+	}
+	if err == nil && !factor.ConfirmedAt.IsZero() {
+		return MFAEnrollment{}, errors.Conflict("MFA_ALREADY_ENABLED", "mfa already enabled")
+	}
+	profile, _, err := uc.loadProfile(ctx, subjectType, claims.Subject)
+	if err != nil {
+		return MFAEnrollment{}, err
+	}
+	return uc.startEnrollment(ctx, subjectType, profile)
+}
+
+// ConfirmMFA confirms the pending enrollment of the caller with a first
+// code and returns the recovery codes, which are shown once.
+func (uc *AuthUsecase) ConfirmMFA(ctx context.Context, subjectType string, code string) ([]string, error) {
+	claims, err := requireSubjectType(ctx, subjectType)
+	if err != nil {
+		return nil, err
+	}
+	factor, err := uc.mfa.GetMFAFactor(ctx, subjectType, claims.Subject)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.BadRequest("MFA_NOT_ENROLLED", "start an enrollment first")
+		}
+		return nil, err
+	}
+	if !factor.ConfirmedAt.IsZero() {
+		return nil, errors.Conflict("MFA_ALREADY_ENABLED", "mfa already enabled")
+	}
+	step, ok := totp.Validate(factor.Secret, code, time.Now(), mfaSkew)
+	if !ok {
+		return nil, errors.Unauthorized("MFA_CODE_INVALID", "invalid mfa code")
+	}
+	return uc.confirmFactor(ctx, factor, step)
+}
+
+// DisableMFA removes the factor of the caller after checking a code. It is
+// refused while policy requires MFA for the caller.
+func (uc *AuthUsecase) DisableMFA(ctx context.Context, subjectType string, code string, recoveryCode string) error {
+	claims, err := requireSubjectType(ctx, subjectType)
+	if err != nil {
+		return err
+	}
+	factor, err := uc.requireConfirmedFactor(ctx, subjectType, claims.Subject)
+	if err != nil {
+		return err
+	}
+	required, err := uc.mfaRequired(ctx, subjectType, claims.Subject, claims.TenantID)
+	if err != nil {
+		return err
+	}
+	if required {
+		return errors.Forbidden("MFA_REQUIRED_BY_POLICY", "mfa is required for your roles")
+	}
+	if err := uc.verifyFactor(ctx, factor, code, recoveryCode); err != nil {
+		return err
+	}
+	return uc.mfa.DeleteMFAFactor(ctx, subjectType, claims.Subject)
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the caller.
+func (uc *AuthUsecase) RegenerateRecoveryCodes(ctx context.Context, subjectType string, code string) ([]string, error) {
+	claims, err := requireSubjectType(ctx, subjectType)
+	if err != nil {
+		return nil, err
+	}
+	factor, err := uc.requireConfirmedFactor(ctx, subjectType, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.verifyFactor(ctx, factor, code, ""); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.mfa.ReplaceRecoveryCodes(ctx, subjectType, claims.Subject, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// GetMFAPolicy returns the policy of the caller's tenant, or the platform
+// policy for platform admins.
+func (uc *AuthUsecase) GetMFAPolicy(ctx context.Context, subjectType string) (MFAPolicy, error) {
+	claims, err := requireSubjectType(ctx, subjectType)
+	if err != nil {
+		return MFAPolicy{}, err
+	}
+	return uc.loadMFAPolicy(ctx, subjectType, claims.TenantID)
+}
+
+// UpdateMFAPolicy replaces the policy of the caller's tenant or platform.
+// Members that become subject to it enroll at their next login.
+func (uc *AuthUsecase) UpdateMFAPolicy(ctx context.Context, subjectType string, policy MFAPolicy) (MFAPolicy, error) {
+	claims, err := requireSubjectType(ctx, subjectType)
+	if err != nil {
+		return MFAPolicy{}, err
+	}
+	policy.SubjectType = subjectType
+	policy.TenantID = claims.TenantID
+	policy.RequiredRoleIDs = uniqueStrings(policy.RequiredRoleIDs)
+	if len(policy.RequiredRoleIDs) > 0 {
+		count, err := uc.mfa.CountScopeRoles(ctx, subjectType, policy.TenantID, policy.RequiredRoleIDs)
+		if err != nil {
+			return MFAPolicy{}, err
+		}
+		if count != len(policy.RequiredRoleIDs) {
+			return MFAPolicy{}, errors.BadRequest("MFA_POLICY_INVALID", "unknown role in policy")
+		}
+	}
+	policy.UpdatedAt = time.Now()
+	return uc.mfa.SaveMFAPolicy(ctx, policy)
+}
+
+func (uc *AuthUsecase) loadMFAPolicy(ctx context.Context, subjectType string, tenantID string) (MFAPolicy, error) {
+	policy, err := uc.mfa.GetMFAPolicy(ctx, subjectType, tenantID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return MFAPolicy{SubjectType: subjectType, TenantID: tenantID}, nil
+		}
+		return MFAPolicy{}, err
+	}
+	return policy, nil
+}
+
+// mfaRequired reports whether the policy of the subject's scope covers it.
+func (uc *AuthUsecase) mfaRequired(ctx context.Context, subjectType string, subjectID string, tenantID string) (bool, error) {
+	policy, err := uc.loadMFAPolicy(ctx, subjectType, tenantID)
+	if err != nil {
+		return false, err
+	}
+	if policy.RequireAll {
+		return true, nil
+	}
+	if len(policy.RequiredRoleIDs) == 0 {
+		return false, nil
+	}
+	roleIDs, err := uc.mfa.ListSubjectRoleIDs(ctx, subjectType, subjectID)
+	if err != nil {
+		return false, err
+	}
+	for _, roleID := range roleIDs {
+		for _, required := range policy.RequiredRoleIDs {
+			if roleID == required {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (uc *AuthUsecase) startEnrollment(ctx context.Context, subjectType string, profile AuthProfile) (MFAEnrollment, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return MFAEnrollment{}, errors.InternalServer("RANDOM_FAILED", "generate secret failed")
+	}
+	now := time.Now()
+	if err := uc.mfa.SaveMFAFactorSecret(ctx, MFAFactor{
+		SubjectType: subjectType,
+		SubjectID:   profile.SubjectID,
+		Secret:      secret,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}); err != nil {
+		return MFAEnrollment{}, err
+	}
+	return MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(uc.totpIssuer(), profile.Account, secret),
+	}, nil
+}
+
+func (uc *AuthUsecase) confirmFactor(ctx context.Context, factor MFAFactor, step int64) ([]string, error) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.mfa.ConfirmMFAFactor(ctx, factor.SubjectType, factor.SubjectID, step, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// verifyFactor checks a TOTP code, rejecting reused time steps, or else a
+// single-use recovery code.
+func (uc *AuthUsecase) verifyFactor(ctx context.Context, factor MFAFactor, code string, recoveryCode string) error {
+	if strings.TrimSpace(code) != "" {
+		step, ok := totp.Validate(factor.Secret, code, time.Now(), mfaSkew)
+		if !ok || step <= factor.LastUsedStep {
+			return errors.Unauthorized("MFA_CODE_INVALID", "invalid mfa code")
+		}
+		used, err := uc.mfa.UseMFAStep(ctx, factor.SubjectType, factor.SubjectID, step)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return errors.Unauthorized("MFA_CODE_INVALID", "invalid mfa code")
+		}
+		return nil
+	}
+	recoveryCode = normalizeRecoveryCode(recoveryCode)
+	if recoveryCode == "" {
+		return errors.BadRequest("MFA_CODE_REQUIRED", "code or recovery_code required")
+	}
+	used, err := uc.mfa.UseRecoveryCode(ctx, factor.SubjectType, factor.SubjectID, hashToken(recoveryCode))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return errors.Unauthorized("MFA_CODE_INVALID", "invalid mfa code")
+	}
+	return nil
+}
+
+func (uc *AuthUsecase) requireConfirmedFactor(ctx context.Context, subjectType string, subjectID string) (MFAFactor, error) {
+	factor, err := uc.mfa.GetMFAFactor(ctx, subjectType, subjectID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return MFAFactor{}, errors.BadRequest("MFA_NOT_ENABLED", "mfa not enabled")
+		}
+		return MFAFactor{}, err
+	}
+	if factor.ConfirmedAt.IsZero() {
+		return MFAFactor{}, errors.BadRequest("MFA_NOT_ENABLED", "mfa not enabled")
+	}
+	return factor, nil
+}
+
+func (uc *AuthUsecase) loadMFAChallenge(ctx context.Context, subjectType string, mfaToken string) (MFAChallenge, error) {
+	mfaToken = strings.TrimSpace(mfaToken)
+	if mfaToken == "" {
+		return MFAChallenge{}, errors.BadRequest("MFA_TOKEN_REQUIRED", "mfa_token required")
+	}
+	challenge, err := uc.mfa.GetMFAChallenge(ctx, hashToken(mfaToken))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return MFAChallenge{}, errors.Unauthorized("MFA_CHALLENGE_INVALID", "invalid or expired mfa challenge")
+		}
+		return MFAChallenge{}, err
+	}
+	if challenge.SubjectType != subjectType || !time.Now().Before(challenge.ExpiresAt) {
+		return MFAChallenge{}, errors.Unauthorized("MFA_CHALLENGE_INVALID", "invalid or expired mfa challenge")
+	}
+	return challenge, nil
+}
+
+func (uc *AuthUsecase) failMFAChallenge(ctx context.Context, challenge MFAChallenge) error {
+	if err := uc.mfa.FailMFAChallenge(ctx, challenge.TokenHash, mfaChallengeAttempts); err != nil {
+		return err
+	}
+	if challenge.Attempts+1 >= mfaChallengeAttempts {
+		return errors.Unauthorized("MFA_CHALLENGE_INVALID", "too many wrong codes, sign in again")
+	}
+	return errors.Unauthorized("MFA_CODE_INVALID", "invalid mfa code")
+}
+
+func (uc *AuthUsecase) totpIssuer() string {
+	if uc.issuer != "" {
+		return uc.issuer
+	}
+	return defaultTOTPIssuer
+}
+
+// requireSubjectType returns the claims of the caller, which must be of the
+// subject type the endpoint serves.
+func requireSubjectType(ctx context.Context, subjectType string) (*jwt.Claims, error) {
+	claims, err := requireClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claimsSubjectType(claims) != subjectType {
+		return nil, errors.Forbidden("SCOPE_MISMATCH", "token not valid for this scope")
+	}
+	return claims, nil
+}
+
+// newRecoveryCodes returns recovery codes formatted as xxxxx-xxxxx and
+// their hashes.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+	for len(codes) < recoveryCodeCount {
+		raw := make([]byte, 8)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, errors.InternalServer("RANDOM_FAILED", "generate recovery codes failed")
+		}
+		code := strings.ToLower(encoding.EncodeToString(raw)[:recoveryCodeLength])
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, hashToken(code))
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
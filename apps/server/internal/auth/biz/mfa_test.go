@@ -0,0 +1,354 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/totp"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeMFARepo keeps factors, recovery codes, challenges and the policy in
+// memory.
+type fakeMFARepo struct {
+	MFARepo
+	mu         sync.Mutex
+	factors    map[string]MFAFactor
+	recovery   map[string]bool
+	challenges map[string]MFAChallenge
+	policy     *MFAPolicy
+}
+
+func newFakeMFARepo() *fakeMFARepo {
+	return &fakeMFARepo{
+		factors:    make(map[string]MFAFactor),
+		recovery:   make(map[string]bool),
+		challenges: make(map[string]MFAChallenge),
+	}
+}
+
+func (r *fakeMFARepo) GetMFAFactor(_ context.Context, _ string, subjectID string) (MFAFactor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	factor, ok := r.factors[subjectID]
+	if !ok {
+		return MFAFactor{}, errors.NotFound("MFA_FACTOR_NOT_FOUND", "mfa factor not found")
+	}
+	return factor, nil
+}
+
+func (r *fakeMFARepo) ConfirmMFAFactor(_ context.Context, _ string, subjectID string, step int64, codeHashes []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	factor := r.factors[subjectID]
+	factor.ConfirmedAt = time.Now()
+	factor.LastUsedStep = step
+	r.factors[subjectID] = factor
+	r.recovery = make(map[string]bool, len(codeHashes))
+	for _, hash := range codeHashes {
+		r.recovery[hash] = true
+	}
+	return nil
+}
+
+func (r *fakeMFARepo) UseMFAStep(_ context.Context, _ string, subjectID string, step int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	factor := r.factors[subjectID]
+	if step <= factor.LastUsedStep {
+		return false, nil
+	}
+	factor.LastUsedStep = step
+	r.factors[subjectID] = factor
+	return true, nil
+}
+
+func (r *fakeMFARepo) UseRecoveryCode(_ context.Context, _ string, _ string, codeHash string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.recovery[codeHash] {
+		return false, nil
+	}
+	delete(r.recovery, codeHash)
+	return true, nil
+}
+
+func (r *fakeMFARepo) CreateMFAChallenge(_ context.Context, challenge MFAChallenge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.challenges[challenge.TokenHash] = challenge
+	return nil
+}
+
+func (r *fakeMFARepo) GetMFAChallenge(_ context.Context, tokenHash string) (MFAChallenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	challenge, ok := r.challenges[tokenHash]
+	if !ok {
+		return MFAChallenge{}, errors.NotFound("MFA_CHALLENGE_NOT_FOUND", "mfa challenge not found")
+	}
+	return challenge, nil
+}
+
+func (r *fakeMFARepo) FailMFAChallenge(_ context.Context, tokenHash string, maxAttempts int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	challenge := r.challenges[tokenHash]
+	challenge.Attempts++
+	if challenge.Attempts >= maxAttempts {
+		delete(r.challenges, tokenHash)
+		return nil
+	}
+	r.challenges[tokenHash] = challenge
+	return nil
+}
+
+func (r *fakeMFARepo) ConsumeMFAChallenge(_ context.Context, tokenHash string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.challenges[tokenHash]; !ok {
+		return false, nil
+	}
+	delete(r.challenges, tokenHash)
+	return true, nil
+}
+
+func (r *fakeMFARepo) GetMFAPolicy(context.Context, string, string) (MFAPolicy, error) {
+	if r.policy == nil {
+		return MFAPolicy{}, errors.NotFound("MFA_POLICY_NOT_FOUND", "mfa policy not found")
+	}
+	return *r.policy, nil
+}
+
+func (r *fakeMFARepo) ListSubjectRoleIDs(context.Context, string, string) ([]string, error) {
+	return nil, nil
+}
+
+// sessionAuthRepo adds what issuing a session needs to fakeAuthRepo.
+type sessionAuthRepo struct {
+	fakeAuthRepo
+	mu            sync.Mutex
+	refreshTokens []RefreshToken
+}
+
+func (*sessionAuthRepo) ListUserRoles(context.Context, string) ([]string, error) {
+	return []string{"admin"}, nil
+}
+
+func (*sessionAuthRepo) GetTenantSubscription(context.Context, string) (plan.Subscription, error) {
+	return plan.Subscription{Status: plan.TenantActive}, nil
+}
+
+func (r *sessionAuthRepo) CreateRefreshToken(_ context.Context, token RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.refreshTokens = append(r.refreshTokens, token)
+	return nil
+}
+
+func newMFAUsecase(t *testing.T, mfa *fakeMFARepo) *AuthUsecase {
+	t.Helper()
+	cfg := &conf.Server{Auth: &conf.Server_Auth{JwtSecret: testJWTSecret, Issuer: "ragodesk"}}
+	return NewAuthUsecase(&sessionAuthRepo{}, mfa, nil, nil, nil, nil, nil, cfg, log.NewStdLogger(io.Discard))
+}
+
+// enrollFactor gives user-1 a confirmed factor and returns its secret and
+// recovery codes.
+func enrollFactor(t *testing.T, uc *AuthUsecase, mfa *fakeMFARepo) (string, []string) {
+	t.Helper()
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("generate secret: %v", err)
+	}
+	mfa.factors["user-1"] = MFAFactor{SubjectType: SubjectTypeTenant, SubjectID: "user-1", Secret: secret}
+	codes, err := uc.confirmFactor(context.Background(), mfa.factors["user-1"], 0)
+	if err != nil {
+		t.Fatalf("confirm factor: %v", err)
+	}
+	return secret, codes
+}
+
+// passwordStep completes the first login step of user-1 and returns the
+// challenge token.
+func passwordStep(t *testing.T, uc *AuthUsecase) string {
+	t.Helper()
+	profile, _, err := uc.loadProfile(context.Background(), SubjectTypeTenant, "user-1")
+	if err != nil {
+		t.Fatalf("load profile: %v", err)
+	}
+	session, err := uc.completeLogin(context.Background(), SubjectTypeTenant, profile)
+	if err != nil {
+		t.Fatalf("complete login: %v", err)
+	}
+	if session.MFAToken == "" || session.Token != "" {
+		t.Fatalf("session = %+v, want an mfa challenge", session)
+	}
+	return session.MFAToken
+}
+
+func TestVerifyMFARecoveryCodeSingleUse(t *testing.T) {
+	ctx := context.Background()
+	mfa := newFakeMFARepo()
+	uc := newMFAUsecase(t, mfa)
+	_, codes := enrollFactor(t, uc, mfa)
+	if len(codes) != recoveryCodeCount || len(mfa.recovery) != recoveryCodeCount {
+		t.Fatalf("issued %d codes, stored %d", len(codes), len(mfa.recovery))
+	}
+
+	token := passwordStep(t, uc)
+	session, err := uc.VerifyMFA(ctx, SubjectTypeTenant, token, "", codes[0])
+	if err != nil {
+		t.Fatalf("verify with recovery code: %v", err)
+	}
+	if session.Token == "" || session.RefreshToken == "" {
+		t.Fatalf("session = %+v", session)
+	}
+	// The challenge is spent with the login.
+	if _, err := uc.VerifyMFA(ctx, SubjectTypeTenant, token, "", codes[1]); errors.Reason(err) != "MFA_CHALLENGE_INVALID" {
+		t.Fatalf("reused challenge err = %v, want MFA_CHALLENGE_INVALID", err)
+	}
+
+	token = passwordStep(t, uc)
+	if _, err := uc.VerifyMFA(ctx, SubjectTypeTenant, token, "", codes[0]); errors.Reason(err) != "MFA_CODE_INVALID" {
+		t.Fatalf("reused recovery code err = %v, want MFA_CODE_INVALID", err)
+	}
+	// The failed attempt leaves the challenge open for another code, which
+	// may be typed without the dash and in upper case.
+	typed := strings.ToUpper(strings.ReplaceAll(codes[1], "-", ""))
+	if _, err := uc.VerifyMFA(ctx, SubjectTypeTenant, token, "", typed); err != nil {
+		t.Fatalf("verify with second recovery code: %v", err)
+	}
+	if len(mfa.recovery) != recoveryCodeCount-2 {
+		t.Fatalf("%d recovery codes left, want %d", len(mfa.recovery), recoveryCodeCount-2)
+	}
+}
+
+func TestVerifyMFARejectsReplayedCode(t *testing.T) {
+	ctx := context.Background()
+	mfa := newFakeMFARepo()
+	uc := newMFAUsecase(t, mfa)
+	secret, _ := enrollFactor(t, uc, mfa)
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatalf("code: %v", err)
+	}
+
+	if _, err := uc.VerifyMFA(ctx, SubjectTypeTenant, passwordStep(t, uc), code, ""); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	token := passwordStep(t, uc)
+	if _, err := uc.VerifyMFA(ctx, SubjectTypeTenant, token, code, ""); errors.Reason(err) != "MFA_CODE_INVALID" {
+		t.Fatalf("replayed code err = %v, want MFA_CODE_INVALID", err)
+	}
+	// An older step is rejected too once a later one was used.
+	previous, err := totp.Code(secret, totp.Step(time.Now())-1)
+	if err != nil {
+		t.Fatalf("code: %v", err)
+	}
+	if _, err := uc.VerifyMFA(ctx, SubjectTypeTenant, token, previous, ""); errors.Reason(err) != "MFA_CODE_INVALID" {
+		t.Fatalf("earlier code err = %v, want MFA_CODE_INVALID", err)
+	}
+}
+
+func TestVerifyMFAAttempts(t *testing.T) {
+	ctx := context.Background()
+	mfa := newFakeMFARepo()
+	uc := newMFAUsecase(t, mfa)
+	_, codes := enrollFactor(t, uc, mfa)
+	token := passwordStep(t, uc)
+	for i := 1; i <= mfaChallengeAttempts; i++ {
+		want := "MFA_CODE_INVALID"
+		if i == mfaChallengeAttempts {
+			want = "MFA_CHALLENGE_INVALID"
+		}
+		if _, err := uc.VerifyMFA(ctx, SubjectTypeTenant, token, "", "aaaaa-aaaaa"); errors.Reason(err) != want {
+			t.Fatalf("attempt %d err = %v, want %s", i, err, want)
+		}
+	}
+	if _, err := uc.VerifyMFA(ctx, SubjectTypeTenant, token, "", codes[0]); errors.Reason(err) != "MFA_CHALLENGE_INVALID" {
+		t.Fatalf("err after the last attempt = %v, want MFA_CHALLENGE_INVALID", err)
+	}
+	if len(mfa.recovery) != recoveryCodeCount {
+		t.Fatal("a dropped challenge used a recovery code")
+	}
+}
+
+// SSO logins go through the same MFA step as password logins.
+func TestSSOExchangeTicketMFA(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name           string
+		setup          func(t *testing.T, uc *AuthUsecase, mfa *fakeMFARepo)
+		wantChallenge  bool
+		wantEnrollment bool
+	}{
+		{name: "no factor"},
+		{
+			name: "confirmed factor",
+			setup: func(t *testing.T, uc *AuthUsecase, mfa *fakeMFARepo) {
+				enrollFactor(t, uc, mfa)
+			},
+			wantChallenge: true,
+		},
+		{
+			name: "pending enrollment",
+			setup: func(t *testing.T, uc *AuthUsecase, mfa *fakeMFARepo) {
+				mfa.factors["user-1"] = MFAFactor{SubjectType: SubjectTypeTenant, SubjectID: "user-1", Secret: "GEZDGNBVGY3TQOJQ"}
+			},
+		},
+		{
+			name: "required by policy",
+			setup: func(t *testing.T, uc *AuthUsecase, mfa *fakeMFARepo) {
+				mfa.policy = &MFAPolicy{SubjectType: SubjectTypeTenant, TenantID: testTenantID, RequireAll: true}
+			},
+			wantChallenge:  true,
+			wantEnrollment: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newSSOEnv(t)
+			mfa := newFakeMFARepo()
+			e.uc.auth = newMFAUsecase(t, mfa)
+			if tt.setup != nil {
+				tt.setup(t, e.uc.auth, mfa)
+			}
+			state, code := e.login(t)
+			ticket, err := e.uc.CompleteOIDC(ctx, state, code, "")
+			if err != nil {
+				t.Fatalf("complete: %v", err)
+			}
+			session, err := e.uc.ExchangeTicket(ctx, ticket)
+			if err != nil {
+				t.Fatalf("exchange: %v", err)
+			}
+			if !tt.wantChallenge {
+				if session.Token == "" || session.MFAToken != "" {
+					t.Fatalf("session = %+v, want tokens", session)
+				}
+				return
+			}
+			if session.Token != "" || session.RefreshToken != "" || session.MFAToken == "" {
+				t.Fatalf("session = %+v, want an mfa challenge only", session)
+			}
+			if session.MFAEnrollmentRequired != tt.wantEnrollment {
+				t.Fatalf("enrollment required = %v, want %v", session.MFAEnrollmentRequired, tt.wantEnrollment)
+			}
+			challenge, err := mfa.GetMFAChallenge(ctx, hashToken(session.MFAToken))
+			if err != nil || challenge.SubjectID != "user-1" || challenge.TenantID != testTenantID {
+				t.Fatalf("challenge = %+v, %v", challenge, err)
+			}
+			// The ticket is spent even though no session was issued.
+			if _, err := e.uc.ExchangeTicket(ctx, ticket); errors.Reason(err) != "SSO_TICKET_INVALID" {
+				t.Fatalf("reused ticket err = %v, want SSO_TICKET_INVALID", err)
+			}
+		})
+	}
+}
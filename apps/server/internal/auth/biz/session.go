@@ -0,0 +1,434 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// Subject types of a session.
+const (
+	SubjectTypeTenant   = "tenant"
+	SubjectTypePlatform = "platform"
+)
+
+// Revocation reasons.
+const (
+	RevokeReasonLogout          = "logout"
+	RevokeReasonLogoutAll       = "logout_all"
+	RevokeReasonRefreshReuse    = "refresh_reuse"
+	RevokeReasonAccountDisabled = "account_disabled"
+	RevokeReasonAccountDeleted  = "account_deleted"
+	RevokeReasonPasswordReset   = "password_reset"
+	RevokeReasonRoleRemoved     = "role_removed"
+)
+
+// sessionCheckTTL bounds how long a token that passed the revocation check
+// is trusted without asking MySQL again.
+const sessionCheckTTL = 30 * time.Second
+
+// RefreshToken is an issued refresh token. Every login starts a session;
+// every refresh rotates the token within the session and issues a new
+// access token recorded with it.
+type RefreshToken struct {
+	ID              string
+	SessionID       string
+	SubjectType     string
+	SubjectID       string
+	TenantID        string
+	TokenHash       string
+	AccessJTI       string
+	AccessExpiresAt time.Time
+	ExpiresAt       time.Time
+	UsedAt          time.Time
+	RevokedAt       time.Time
+	CreatedAt       time.Time
+}
+
+// RevokedToken is an access token revoked before it expires.
+type RevokedToken struct {
+	JTI         string
+	SubjectType string
+	SubjectID   string
+	Reason      string
+	ExpiresAt   time.Time
+}
+
+// SessionCache caches the revocation state of access tokens.
+type SessionCache interface {
+	Get(ctx context.Context, jti string) (revoked bool, ok bool)
+	Set(ctx context.Context, jti string, revoked bool, ttl time.Duration)
+}
+
+// RefreshSession exchanges a refresh token for a new access and refresh
+// token. A refresh token presented twice revokes its whole session.
+func (uc *AuthUsecase) RefreshSession(ctx context.Context, subjectType string, refreshToken string) (AuthSession, error) {
+	refreshToken = strings.TrimSpace(refreshToken)
+	if refreshToken == "" {
+		return AuthSession{}, errors.BadRequest("REFRESH_TOKEN_MISSING", "refresh token missing")
+	}
+	current, err := uc.repo.GetRefreshToken(ctx, hashToken(refreshToken))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return AuthSession{}, errors.Unauthorized("REFRESH_TOKEN_INVALID", "invalid refresh token")
+		}
+		return AuthSession{}, err
+	}
+	if current.SubjectType != subjectType {
+		return AuthSession{}, errors.Unauthorized("REFRESH_TOKEN_INVALID", "invalid refresh token")
+	}
+	if !current.RevokedAt.IsZero() {
+		return AuthSession{}, errors.Unauthorized("REFRESH_TOKEN_REVOKED", "refresh token revoked")
+	}
+	if !current.UsedAt.IsZero() {
+		uc.revokeSession(ctx, current.SubjectID, current.SessionID, RevokeReasonRefreshReuse)
+		return AuthSession{}, errors.Unauthorized("REFRESH_TOKEN_REUSED", "refresh token already used")
+	}
+	if !time.Now().Before(current.ExpiresAt) {
+		return AuthSession{}, errors.Unauthorized("REFRESH_TOKEN_EXPIRED", "refresh token expired")
+	}
+	profile, active, err := uc.loadProfile(ctx, current.SubjectType, current.SubjectID)
+	if err != nil {
+		return AuthSession{}, err
+	}
+	if !active {
+		if err := uc.RevokeSubjectSessions(ctx, current.SubjectType, current.SubjectID, RevokeReasonAccountDisabled); err != nil {
+			return AuthSession{}, err
+		}
+		return AuthSession{}, errors.Forbidden("ACCOUNT_DISABLED", "account disabled")
+	}
+	session, next, err := uc.newSession(ctx, current.SubjectType, profile, current.SessionID)
+	if err != nil {
+		return AuthSession{}, err
+	}
+	if err := uc.repo.RotateRefreshToken(ctx, current.ID, next); err != nil {
+		if errors.Reason(err) == "REFRESH_TOKEN_REUSED" {
+			uc.revokeSession(ctx, current.SubjectID, current.SessionID, RevokeReasonRefreshReuse)
+		}
+		return AuthSession{}, err
+	}
+	return session, nil
+}
+
+// Logout revokes the session of the calling access token.
+func (uc *AuthUsecase) Logout(ctx context.Context) error {
+	claims, err := requireClaims(ctx)
+	if err != nil {
+		return err
+	}
+	if claims.Impersonated() {
+		session, err := uc.impersonations.GetImpersonationSession(ctx, claims.SessionID)
+		if err != nil {
+			return err
+		}
+		return uc.endImpersonation(ctx, session, RevokeReasonLogout)
+	}
+	if claims.SessionID != "" {
+		revoked, err := uc.repo.RevokeSession(ctx, claims.Subject, claims.SessionID, RevokeReasonLogout)
+		if err != nil {
+			return err
+		}
+		uc.cacheRevoked(ctx, revoked)
+	}
+	return uc.revokeAccessToken(ctx, claims, RevokeReasonLogout)
+}
+
+// LogoutAll revokes every session of the calling subject. An impersonation
+// token only ends its own session.
+func (uc *AuthUsecase) LogoutAll(ctx context.Context) error {
+	claims, err := requireClaims(ctx)
+	if err != nil {
+		return err
+	}
+	if claims.Impersonated() {
+		return uc.Logout(ctx)
+	}
+	if err := uc.RevokeSubjectSessions(ctx, claimsSubjectType(claims), claims.Subject, RevokeReasonLogoutAll); err != nil {
+		return err
+	}
+	return uc.revokeAccessToken(ctx, claims, RevokeReasonLogoutAll)
+}
+
+// RevokeSubjectSessions revokes the refresh tokens and outstanding access
+// tokens of a user or platform admin.
+func (uc *AuthUsecase) RevokeSubjectSessions(ctx context.Context, subjectType string, subjectID string, reason string) error {
+	revoked, err := uc.repo.RevokeSubjectSessions(ctx, subjectType, subjectID, reason)
+	if err != nil {
+		return err
+	}
+	uc.cacheRevoked(ctx, revoked)
+	return nil
+}
+
+// CheckSession rejects revoked access tokens and tokens of accounts that are
+// no longer active; the latter also revokes every session of the account.
+// Tokens issued without a jti cannot be revoked and pass until they expire.
+func (uc *AuthUsecase) CheckSession(ctx context.Context, claims *jwt.Claims) error {
+	if claims == nil || claims.ID == "" {
+		return nil
+	}
+	if uc.cache != nil {
+		if revoked, ok := uc.cache.Get(ctx, claims.ID); ok {
+			if revoked {
+				return errors.Unauthorized("TOKEN_REVOKED", "token revoked")
+			}
+			return nil
+		}
+	}
+	revoked, err := uc.repo.IsTokenRevoked(ctx, claims.ID)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		uc.cacheRevoked(ctx, []RevokedToken{{JTI: claims.ID, ExpiresAt: time.Unix(claims.Expiry, 0)}})
+		return errors.Unauthorized("TOKEN_REVOKED", "token revoked")
+	}
+	subjectType := claimsSubjectType(claims)
+	active, err := uc.subjectActive(ctx, subjectType, claims.Subject)
+	if err != nil {
+		return err
+	}
+	if !active {
+		if err := uc.RevokeSubjectSessions(ctx, subjectType, claims.Subject, RevokeReasonAccountDisabled); err != nil {
+			return err
+		}
+		if err := uc.revokeAccessToken(ctx, claims, RevokeReasonAccountDisabled); err != nil {
+			return err
+		}
+		return errors.Unauthorized("TOKEN_REVOKED", "account disabled")
+	}
+	if uc.cache != nil {
+		ttl := sessionCheckTTL
+		if remaining := time.Until(time.Unix(claims.Expiry, 0)); claims.Expiry != 0 && remaining < ttl {
+			ttl = remaining
+		}
+		if ttl > 0 {
+			uc.cache.Set(ctx, claims.ID, false, ttl)
+		}
+	}
+	return nil
+}
+
+// RequireTenantActive rejects requests of tenants that are suspended or
+// being deleted.
+func (uc *AuthUsecase) RequireTenantActive(ctx context.Context, tenantID string) error {
+	sub, err := uc.repo.GetTenantSubscription(ctx, tenantID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.Forbidden("TENANT_INACTIVE", "tenant not found")
+		}
+		return err
+	}
+	switch {
+	case sub.Active():
+		return nil
+	case sub.Status == plan.TenantSuspended:
+		return errors.Forbidden("TENANT_SUSPENDED", "tenant suspended")
+	default:
+		return errors.Forbidden("TENANT_INACTIVE", "tenant is "+sub.Status)
+	}
+}
+
+// issueSession starts a new session for a freshly authenticated subject.
+func (uc *AuthUsecase) issueSession(ctx context.Context, subjectType string, profile AuthProfile) (AuthSession, error) {
+	session, token, err := uc.newSession(ctx, subjectType, profile, "")
+	if err != nil {
+		return AuthSession{}, err
+	}
+	if err := uc.repo.CreateRefreshToken(ctx, token); err != nil {
+		return AuthSession{}, err
+	}
+	return session, nil
+}
+
+// newSession signs an access token and generates the refresh token issued
+// with it.
+func (uc *AuthUsecase) newSession(ctx context.Context, subjectType string, profile AuthProfile, sessionID string) (AuthSession, RefreshToken, error) {
+	if profile.TenantID != "" {
+		if err := uc.RequireTenantActive(ctx, profile.TenantID); err != nil {
+			return AuthSession{}, RefreshToken{}, err
+		}
+	}
+	if sessionID == "" {
+		sessionID = uuid.NewString()
+	}
+	now := time.Now()
+	expires := now.Add(uc.tokenTTL)
+	claims := jwt.Claims{
+		TenantID:  profile.TenantID,
+		Subject:   profile.SubjectID,
+		Issuer:    uc.issuer,
+		Expiry:    expires.Unix(),
+		NotBefore: now.Unix(),
+		IssuedAt:  now.Unix(),
+		Roles:     profile.Roles,
+		ID:        uuid.NewString(),
+		SessionID: sessionID,
+	}
+	if uc.audience != "" {
+		claims.Audience = uc.audience
+	}
+	token, err := uc.signToken(ctx, claims)
+	if err != nil {
+		return AuthSession{}, RefreshToken{}, err
+	}
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return AuthSession{}, RefreshToken{}, errors.InternalServer("REFRESH_TOKEN_FAILED", "generate refresh token failed")
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(raw)
+	refreshExpires := now.Add(uc.refreshTTL)
+	return AuthSession{
+		Token:            token,
+		ExpiresAt:        expires,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpires,
+		Profile:          profile,
+	}, RefreshToken{
+		ID:              uuid.NewString(),
+		SessionID:       sessionID,
+		SubjectType:     subjectType,
+		SubjectID:       profile.SubjectID,
+		TenantID:        profile.TenantID,
+		TokenHash:       hashToken(refreshToken),
+		AccessJTI:       claims.ID,
+		AccessExpiresAt: expires,
+		ExpiresAt:       refreshExpires,
+		CreatedAt:       now,
+	}, nil
+}
+
+// loadProfile loads the current profile of a subject and whether it may
+// still sign in. A deleted account is reported as inactive.
+func (uc *AuthUsecase) loadProfile(ctx context.Context, subjectType string, subjectID string) (AuthProfile, bool, error) {
+	if subjectType == SubjectTypePlatform {
+		admin, err := uc.repo.GetPlatformAccount(ctx, subjectID)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return AuthProfile{}, false, nil
+			}
+			return AuthProfile{}, false, err
+		}
+		return AuthProfile{
+			SubjectID: admin.ID,
+			Account:   primaryAccount(admin.Email, admin.Phone),
+			Name:      admin.Name,
+			Roles:     []string{"platform_admin"},
+		}, isActiveStatus(admin.Status), nil
+	}
+	user, err := uc.repo.GetTenantAccount(ctx, subjectID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return AuthProfile{}, false, nil
+		}
+		return AuthProfile{}, false, err
+	}
+	roles, err := uc.repo.ListUserRoles(ctx, user.ID)
+	if err != nil {
+		return AuthProfile{}, false, err
+	}
+	return AuthProfile{
+		SubjectID: user.ID,
+		TenantID:  user.TenantID,
+		Account:   primaryAccount(user.Email, user.Phone),
+		Name:      user.Name,
+		Roles:     roles,
+	}, isActiveStatus(user.Status), nil
+}
+
+// subjectActive reports whether a subject may still sign in.
+func (uc *AuthUsecase) subjectActive(ctx context.Context, subjectType string, subjectID string) (bool, error) {
+	var status string
+	if subjectType == SubjectTypePlatform {
+		admin, err := uc.repo.GetPlatformAccount(ctx, subjectID)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		status = admin.Status
+	} else {
+		user, err := uc.repo.GetTenantAccount(ctx, subjectID)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		status = user.Status
+	}
+	return isActiveStatus(status), nil
+}
+
+// revokeSession revokes a session after a refresh token was replayed; the
+// caller already fails the request, so errors are only logged.
+func (uc *AuthUsecase) revokeSession(ctx context.Context, subjectID string, sessionID string, reason string) {
+	revoked, err := uc.repo.RevokeSession(ctx, subjectID, sessionID, reason)
+	if err != nil {
+		uc.log.Warnf("revoke session failed: session=%s err=%v", sessionID, err)
+		return
+	}
+	uc.cacheRevoked(ctx, revoked)
+}
+
+func (uc *AuthUsecase) revokeAccessToken(ctx context.Context, claims *jwt.Claims, reason string) error {
+	if claims.ID == "" || claims.Expiry == 0 {
+		return nil
+	}
+	token := RevokedToken{
+		JTI:         claims.ID,
+		SubjectType: claimsSubjectType(claims),
+		SubjectID:   claims.Subject,
+		Reason:      reason,
+		ExpiresAt:   time.Unix(claims.Expiry, 0),
+	}
+	if err := uc.repo.RevokeToken(ctx, token); err != nil {
+		return err
+	}
+	uc.cacheRevoked(ctx, []RevokedToken{token})
+	return nil
+}
+
+func (uc *AuthUsecase) cacheRevoked(ctx context.Context, tokens []RevokedToken) {
+	if uc.cache == nil {
+		return
+	}
+	for _, token := range tokens {
+		if ttl := time.Until(token.ExpiresAt); ttl > 0 {
+			uc.cache.Set(ctx, token.JTI, true, ttl)
+		}
+	}
+}
+
+func requireClaims(ctx context.Context) (*jwt.Claims, error) {
+	claims, ok := jwt.ClaimsFromContext(ctx)
+	if !ok || claims.Subject == "" {
+		return nil, errors.Unauthorized("ADMIN_UNAUTHORIZED", "missing authorization")
+	}
+	return claims, nil
+}
+
+// claimsSubjectType derives the subject type of an access token: tenant
+// users always carry their tenant, while impersonation tokens carry the
+// tenant of a platform admin.
+func claimsSubjectType(claims *jwt.Claims) string {
+	if claims.TenantID != "" && !claims.Impersonated() {
+		return SubjectTypeTenant
+	}
+	return SubjectTypePlatform
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,719 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/oidc"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/saml"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// SSO protocols.
+const (
+	SSOProtocolOIDC = "oidc"
+	SSOProtocolSAML = "saml"
+)
+
+// Permission codes for SSO configuration.
+const (
+	PermissionSSORead  = "tenant.sso.read"
+	PermissionSSOWrite = "tenant.sso.write"
+)
+
+// SSO endpoints, relative to the configured public URL.
+const (
+	SSOOIDCCallbackPath = "/console/v1/sso/oidc/callback"
+	SSOSAMLACSPath      = "/console/v1/sso/saml/acs"
+	SSOSAMLMetadataPath = "/console/v1/sso/saml/%s/metadata"
+)
+
+const (
+	defaultSSOStateTTL = 10 * time.Minute
+	// ssoTicketTTL bounds the hand-off from the callback redirect to the
+	// dashboard exchanging the ticket for tokens.
+	ssoTicketTTL    = 2 * time.Minute
+	ssoHTTPTimeout  = 10 * time.Second
+	defaultSSOEmail = "email"
+	defaultSSOName  = "name"
+)
+
+var defaultOIDCScopes = []string{"openid", "email", "profile"}
+
+// SSORoleMapping grants a role to users whose IdP claim (OIDC) or attribute
+// (SAML) contains the value.
+type SSORoleMapping struct {
+	Claim  string `json:"claim"`
+	Value  string `json:"value"`
+	RoleID string `json:"role_id"`
+}
+
+// SSOConfig is the single sign-on setup of a tenant.
+type SSOConfig struct {
+	TenantID string
+	Protocol string
+	Enabled  bool
+	// EnforceSSO rejects password logins of the tenant's users.
+	EnforceSSO bool
+	// JITProvisioning creates unknown users on their first SSO login.
+	JITProvisioning bool
+	// DefaultRoleID is assigned to users created by JIT provisioning.
+	DefaultRoleID string
+
+	OIDCIssuer       string
+	OIDCClientID     string
+	OIDCClientSecret string
+	OIDCScopes       []string
+
+	SAMLIdPEntityID    string
+	SAMLIdPSSOURL      string
+	SAMLIdPCertificate string
+
+	EmailAttribute string
+	NameAttribute  string
+	RoleMappings   []SSORoleMapping
+	UpdatedAt      time.Time
+}
+
+// SSOEndpoints are the URLs a tenant registers at its IdP.
+type SSOEndpoints struct {
+	OIDCRedirectURI string
+	SAMLEntityID    string
+	SAMLACSURL      string
+}
+
+// SSOLoginState tracks one login between the redirect to the IdP and its
+// callback.
+type SSOLoginState struct {
+	State        string
+	TenantID     string
+	Protocol     string
+	Nonce        string
+	CodeVerifier string
+	RequestID    string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+// SSOTicket is a one-time code the dashboard exchanges for a session after
+// the IdP callback.
+type SSOTicket struct {
+	TicketHash string
+	TenantID   string
+	UserID     string
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+// SSOIdentity is a user identity asserted by an IdP.
+type SSOIdentity struct {
+	Protocol   string
+	Issuer     string
+	Subject    string
+	Email      string
+	Name       string
+	Attributes map[string][]string
+}
+
+// SSORepo persists SSO configuration, login state and linked identities.
+type SSORepo interface {
+	GetSSOConfig(ctx context.Context, tenantID string) (SSOConfig, error)
+	SaveSSOConfig(ctx context.Context, cfg SSOConfig) error
+	CountTenantRoles(ctx context.Context, tenantID string, roleIDs []string) (int, error)
+
+	CreateLoginState(ctx context.Context, state SSOLoginState) error
+	ConsumeLoginState(ctx context.Context, state string) (SSOLoginState, error)
+	CreateTicket(ctx context.Context, ticket SSOTicket) error
+	ConsumeTicket(ctx context.Context, ticketHash string) (SSOTicket, error)
+
+	FindIdentityUser(ctx context.Context, tenantID string, issuer string, subject string) (string, error)
+	LinkIdentity(ctx context.Context, tenantID string, userID string, identity SSOIdentity) error
+	CreateSSOUser(ctx context.Context, user TenantAccount) error
+	SyncMappedRoles(ctx context.Context, tenantID string, userID string, managed []string, granted []string) error
+}
+
+// SSOUsecase runs OIDC and SAML logins for tenant console users.
+type SSOUsecase struct {
+	repo         SSORepo
+	auth         *AuthUsecase
+	client       *http.Client
+	publicURL    string
+	dashboardURL string
+	stateTTL     time.Duration
+	log          *log.Helper
+}
+
+// NewSSOUsecase creates a new SSOUsecase.
+func NewSSOUsecase(repo SSORepo, auth *AuthUsecase, cfg *conf.Server, logger log.Logger) *SSOUsecase {
+	uc := &SSOUsecase{
+		repo:     repo,
+		auth:     auth,
+		client:   &http.Client{Timeout: ssoHTTPTimeout},
+		stateTTL: defaultSSOStateTTL,
+		log:      log.NewHelper(logger),
+	}
+	if cfg != nil && cfg.Auth != nil && cfg.Auth.Sso != nil {
+		uc.publicURL = strings.TrimRight(strings.TrimSpace(cfg.Auth.Sso.PublicUrl), "/")
+		uc.dashboardURL = strings.TrimRight(strings.TrimSpace(cfg.Auth.Sso.DashboardUrl), "/")
+		if cfg.Auth.Sso.StateTtl != nil && cfg.Auth.Sso.StateTtl.AsDuration() > 0 {
+			uc.stateTTL = cfg.Auth.Sso.StateTtl.AsDuration()
+		}
+	}
+	return uc
+}
+
+// Endpoints returns the redirect URI, entity ID and ACS URL of a tenant.
+func (uc *SSOUsecase) Endpoints(tenantID string) SSOEndpoints {
+	if uc.publicURL == "" {
+		return SSOEndpoints{}
+	}
+	return SSOEndpoints{
+		OIDCRedirectURI: uc.publicURL + SSOOIDCCallbackPath,
+		SAMLEntityID:    uc.publicURL + fmt.Sprintf(SSOSAMLMetadataPath, url.PathEscape(tenantID)),
+		SAMLACSURL:      uc.publicURL + SSOSAMLACSPath,
+	}
+}
+
+// GetConfig returns the SSO configuration of the caller's tenant; tenants
+// without one get a disabled OIDC default.
+func (uc *SSOUsecase) GetConfig(ctx context.Context) (SSOConfig, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return SSOConfig{}, err
+	}
+	cfg, err := uc.repo.GetSSOConfig(ctx, tenantID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return SSOConfig{TenantID: tenantID, Protocol: SSOProtocolOIDC}, nil
+		}
+		return SSOConfig{}, err
+	}
+	return cfg, nil
+}
+
+// UpdateConfig replaces the SSO configuration of the caller's tenant. An
+// empty client secret keeps the stored one.
+func (uc *SSOUsecase) UpdateConfig(ctx context.Context, cfg SSOConfig) (SSOConfig, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return SSOConfig{}, err
+	}
+	current, err := uc.repo.GetSSOConfig(ctx, tenantID)
+	if err != nil && !errors.IsNotFound(err) {
+		return SSOConfig{}, err
+	}
+	cfg.TenantID = tenantID
+	cfg.Protocol = strings.ToLower(strings.TrimSpace(cfg.Protocol))
+	if cfg.Protocol == "" {
+		cfg.Protocol = SSOProtocolOIDC
+	}
+	cfg.OIDCIssuer = strings.TrimSpace(cfg.OIDCIssuer)
+	cfg.OIDCClientID = strings.TrimSpace(cfg.OIDCClientID)
+	cfg.OIDCClientSecret = strings.TrimSpace(cfg.OIDCClientSecret)
+	if cfg.OIDCClientSecret == "" {
+		cfg.OIDCClientSecret = current.OIDCClientSecret
+	}
+	cfg.OIDCScopes = normalizeScopes(cfg.OIDCScopes)
+	cfg.SAMLIdPEntityID = strings.TrimSpace(cfg.SAMLIdPEntityID)
+	cfg.SAMLIdPSSOURL = strings.TrimSpace(cfg.SAMLIdPSSOURL)
+	cfg.SAMLIdPCertificate = strings.TrimSpace(cfg.SAMLIdPCertificate)
+	cfg.EmailAttribute = strings.TrimSpace(cfg.EmailAttribute)
+	cfg.NameAttribute = strings.TrimSpace(cfg.NameAttribute)
+	cfg.DefaultRoleID = strings.TrimSpace(cfg.DefaultRoleID)
+	if err := uc.validateConfig(ctx, &cfg); err != nil {
+		return SSOConfig{}, err
+	}
+	cfg.UpdatedAt = time.Now()
+	if err := uc.repo.SaveSSOConfig(ctx, cfg); err != nil {
+		return SSOConfig{}, err
+	}
+	return cfg, nil
+}
+
+func (uc *SSOUsecase) validateConfig(ctx context.Context, cfg *SSOConfig) error {
+	switch cfg.Protocol {
+	case SSOProtocolOIDC:
+		if cfg.Enabled && (!isHTTPURL(cfg.OIDCIssuer) || cfg.OIDCClientID == "") {
+			return errors.BadRequest("SSO_CONFIG_INVALID", "oidc issuer URL and client id required")
+		}
+	case SSOProtocolSAML:
+		if cfg.Enabled && !isHTTPURL(cfg.SAMLIdPSSOURL) {
+			return errors.BadRequest("SSO_CONFIG_INVALID", "saml idp sso URL required")
+		}
+		if cfg.Enabled || cfg.SAMLIdPCertificate != "" {
+			if _, err := saml.ParseCertificate(cfg.SAMLIdPCertificate); err != nil {
+				return errors.BadRequest("SSO_CONFIG_INVALID", "invalid saml idp certificate")
+			}
+		}
+	default:
+		return errors.BadRequest("SSO_CONFIG_INVALID", "protocol must be oidc or saml")
+	}
+	if cfg.EnforceSSO && !cfg.Enabled {
+		return errors.BadRequest("SSO_CONFIG_INVALID", "sso must be enabled to be enforced")
+	}
+	roleIDs := make([]string, 0, len(cfg.RoleMappings)+1)
+	mappings := make([]SSORoleMapping, 0, len(cfg.RoleMappings))
+	for _, mapping := range cfg.RoleMappings {
+		mapping.Claim = strings.TrimSpace(mapping.Claim)
+		mapping.Value = strings.TrimSpace(mapping.Value)
+		mapping.RoleID = strings.TrimSpace(mapping.RoleID)
+		if mapping.Claim == "" || mapping.Value == "" || mapping.RoleID == "" {
+			return errors.BadRequest("SSO_CONFIG_INVALID", "role mappings need claim, value and role_id")
+		}
+		mappings = append(mappings, mapping)
+		roleIDs = append(roleIDs, mapping.RoleID)
+	}
+	cfg.RoleMappings = mappings
+	if cfg.DefaultRoleID != "" {
+		roleIDs = append(roleIDs, cfg.DefaultRoleID)
+	}
+	roleIDs = uniqueStrings(roleIDs)
+	if len(roleIDs) > 0 {
+		found, err := uc.repo.CountTenantRoles(ctx, cfg.TenantID, roleIDs)
+		if err != nil {
+			return err
+		}
+		if found != len(roleIDs) {
+			return errors.BadRequest("SSO_ROLE_INVALID", "role not found in tenant")
+		}
+	}
+	return nil
+}
+
+// StartLogin returns the IdP URL that begins an SSO login for a tenant.
+func (uc *SSOUsecase) StartLogin(ctx context.Context, tenantID string) (string, string, error) {
+	tenantID = strings.TrimSpace(tenantID)
+	if tenantID == "" {
+		return "", "", errors.BadRequest("TENANT_REQUIRED", "tenant_id required")
+	}
+	if uc.publicURL == "" {
+		return "", "", errors.ServiceUnavailable("SSO_UNAVAILABLE", "sso public url not configured")
+	}
+	cfg, err := uc.enabledConfig(ctx, tenantID)
+	if err != nil {
+		return "", "", err
+	}
+	state, err := randomToken()
+	if err != nil {
+		return "", "", err
+	}
+	now := time.Now()
+	loginState := SSOLoginState{
+		State:     state,
+		TenantID:  tenantID,
+		Protocol:  cfg.Protocol,
+		ExpiresAt: now.Add(uc.stateTTL),
+		CreatedAt: now,
+	}
+	endpoints := uc.Endpoints(tenantID)
+	var redirectURL string
+	switch cfg.Protocol {
+	case SSOProtocolOIDC:
+		provider, err := oidc.Discover(ctx, uc.client, cfg.OIDCIssuer)
+		if err != nil {
+			return "", "", errors.New(http.StatusBadGateway, "SSO_IDP_UNAVAILABLE", err.Error())
+		}
+		nonce, err := randomToken()
+		if err != nil {
+			return "", "", err
+		}
+		verifier, challenge, err := oidc.NewPKCE()
+		if err != nil {
+			return "", "", err
+		}
+		loginState.Nonce = nonce
+		loginState.CodeVerifier = verifier
+		redirectURL, err = oidc.AuthCodeURL(provider, cfg.OIDCClientID, endpoints.OIDCRedirectURI, scopesOrDefault(cfg.OIDCScopes), state, nonce, challenge)
+		if err != nil {
+			return "", "", errors.BadRequest("SSO_CONFIG_INVALID", err.Error())
+		}
+	case SSOProtocolSAML:
+		raw := make([]byte, 20)
+		if _, err := rand.Read(raw); err != nil {
+			return "", "", err
+		}
+		// SAML IDs must not start with a digit.
+		loginState.RequestID = "_" + hex.EncodeToString(raw)
+		redirectURL, err = saml.AuthnRequestURL(
+			saml.ServiceProvider{EntityID: endpoints.SAMLEntityID, ACSURL: endpoints.SAMLACSURL},
+			saml.IdentityProvider{EntityID: cfg.SAMLIdPEntityID, SSOURL: cfg.SAMLIdPSSOURL},
+			loginState.RequestID,
+			state,
+			now,
+		)
+		if err != nil {
+			return "", "", errors.BadRequest("SSO_CONFIG_INVALID", err.Error())
+		}
+	}
+	if err := uc.repo.CreateLoginState(ctx, loginState); err != nil {
+		return "", "", err
+	}
+	return redirectURL, cfg.Protocol, nil
+}
+
+// CompleteOIDC handles the authorization response and returns a login
+// ticket for the dashboard.
+func (uc *SSOUsecase) CompleteOIDC(ctx context.Context, state string, code string, idpError string) (string, error) {
+	loginState, err := uc.consumeState(ctx, state, SSOProtocolOIDC)
+	if err != nil {
+		return "", err
+	}
+	if idpError != "" {
+		return "", errors.Unauthorized("SSO_IDP_ERROR", idpError)
+	}
+	if strings.TrimSpace(code) == "" {
+		return "", errors.BadRequest("SSO_CODE_MISSING", "authorization code missing")
+	}
+	cfg, err := uc.enabledConfig(ctx, loginState.TenantID)
+	if err != nil {
+		return "", err
+	}
+	if cfg.Protocol != SSOProtocolOIDC {
+		return "", errors.Unauthorized("SSO_STATE_INVALID", "sso protocol changed during login")
+	}
+	provider, err := oidc.Discover(ctx, uc.client, cfg.OIDCIssuer)
+	if err != nil {
+		return "", errors.New(http.StatusBadGateway, "SSO_IDP_UNAVAILABLE", err.Error())
+	}
+	token, err := oidc.Exchange(ctx, uc.client, provider, cfg.OIDCClientID, cfg.OIDCClientSecret, code, uc.Endpoints(loginState.TenantID).OIDCRedirectURI, loginState.CodeVerifier)
+	if err != nil {
+		return "", errors.Unauthorized("SSO_EXCHANGE_FAILED", err.Error())
+	}
+	claims, err := oidc.VerifyIDToken(ctx, uc.client, provider, token.IDToken, cfg.OIDCClientID, loginState.Nonce, time.Now())
+	if err != nil {
+		return "", errors.Unauthorized("SSO_ID_TOKEN_INVALID", err.Error())
+	}
+	emailAttr := attributeOrDefault(cfg.EmailAttribute, defaultSSOEmail)
+	if claims.String(emailAttr) == "" && provider.UserinfoEndpoint != "" && token.AccessToken != "" {
+		info, err := oidc.UserInfo(ctx, uc.client, provider, token.AccessToken)
+		if err != nil {
+			uc.log.Warnf("oidc userinfo failed: tenant=%s err=%v", loginState.TenantID, err)
+		} else if info.String("sub") == claims.String("sub") {
+			for key, value := range info {
+				if _, ok := claims[key]; !ok {
+					claims[key] = value
+				}
+			}
+		}
+	}
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return "", errors.Forbidden("SSO_EMAIL_UNVERIFIED", "idp email not verified")
+	}
+	identity := SSOIdentity{
+		Protocol:   SSOProtocolOIDC,
+		Issuer:     provider.Issuer,
+		Subject:    claims.String("sub"),
+		Attributes: make(map[string][]string, len(claims)),
+	}
+	for key := range claims {
+		identity.Attributes[key] = claims.Strings(key)
+	}
+	identity.Email = firstValue(identity.Attributes[emailAttr])
+	identity.Name = firstValue(identity.Attributes[attributeOrDefault(cfg.NameAttribute, defaultSSOName)])
+	return uc.signIn(ctx, cfg, identity)
+}
+
+// CompleteSAML handles a response posted to the ACS and returns a login
+// ticket for the dashboard.
+func (uc *SSOUsecase) CompleteSAML(ctx context.Context, samlResponse string, relayState string) (string, error) {
+	loginState, err := uc.consumeState(ctx, relayState, SSOProtocolSAML)
+	if err != nil {
+		return "", err
+	}
+	cfg, err := uc.enabledConfig(ctx, loginState.TenantID)
+	if err != nil {
+		return "", err
+	}
+	if cfg.Protocol != SSOProtocolSAML {
+		return "", errors.Unauthorized("SSO_STATE_INVALID", "sso protocol changed during login")
+	}
+	cert, err := saml.ParseCertificate(cfg.SAMLIdPCertificate)
+	if err != nil {
+		return "", errors.BadRequest("SSO_CONFIG_INVALID", "invalid saml idp certificate")
+	}
+	endpoints := uc.Endpoints(loginState.TenantID)
+	assertion, err := saml.ParseResponse(
+		samlResponse,
+		saml.ServiceProvider{EntityID: endpoints.SAMLEntityID, ACSURL: endpoints.SAMLACSURL},
+		saml.IdentityProvider{EntityID: cfg.SAMLIdPEntityID, SSOURL: cfg.SAMLIdPSSOURL, Certificate: cert},
+		loginState.RequestID,
+		time.Now(),
+	)
+	if err != nil {
+		return "", errors.Unauthorized("SSO_ASSERTION_INVALID", err.Error())
+	}
+	identity := SSOIdentity{
+		Protocol:   SSOProtocolSAML,
+		Issuer:     assertion.Issuer,
+		Subject:    assertion.NameID,
+		Attributes: assertion.Attributes,
+	}
+	if identity.Issuer == "" {
+		identity.Issuer = cfg.SAMLIdPEntityID
+	}
+	identity.Email = firstValue(assertion.Attributes[attributeOrDefault(cfg.EmailAttribute, defaultSSOEmail)])
+	if identity.Email == "" && strings.Contains(assertion.NameID, "@") {
+		identity.Email = assertion.NameID
+	}
+	identity.Name = firstValue(assertion.Attributes[attributeOrDefault(cfg.NameAttribute, defaultSSOName)])
+	return uc.signIn(ctx, cfg, identity)
+}
+
+// ExchangeTicket redeems a login ticket for a session. Like a password login
+// it returns an MFA challenge instead when the user enrolled a factor or the
+// tenant's MFA policy requires one.
+func (uc *SSOUsecase) ExchangeTicket(ctx context.Context, ticket string) (AuthSession, error) {
+	ticket = strings.TrimSpace(ticket)
+	if ticket == "" {
+		return AuthSession{}, errors.BadRequest("SSO_TICKET_MISSING", "ticket missing")
+	}
+	stored, err := uc.repo.ConsumeTicket(ctx, hashToken(ticket))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return AuthSession{}, errors.Unauthorized("SSO_TICKET_INVALID", "invalid ticket")
+		}
+		return AuthSession{}, err
+	}
+	if !time.Now().Before(stored.ExpiresAt) {
+		return AuthSession{}, errors.Unauthorized("SSO_TICKET_INVALID", "ticket expired")
+	}
+	profile, active, err := uc.auth.loadProfile(ctx, SubjectTypeTenant, stored.UserID)
+	if err != nil {
+		return AuthSession{}, err
+	}
+	if !active || profile.TenantID != stored.TenantID {
+		return AuthSession{}, errors.Forbidden("ACCOUNT_DISABLED", "account disabled")
+	}
+	return uc.auth.completeLogin(ctx, SubjectTypeTenant, profile)
+}
+
+// SAMLMetadata returns the SP metadata of a tenant.
+func (uc *SSOUsecase) SAMLMetadata(ctx context.Context, tenantID string) ([]byte, error) {
+	if uc.publicURL == "" {
+		return nil, errors.ServiceUnavailable("SSO_UNAVAILABLE", "sso public url not configured")
+	}
+	cfg, err := uc.repo.GetSSOConfig(ctx, strings.TrimSpace(tenantID))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Protocol != SSOProtocolSAML {
+		return nil, errors.NotFound("SSO_NOT_CONFIGURED", "saml not configured")
+	}
+	endpoints := uc.Endpoints(cfg.TenantID)
+	return saml.Metadata(saml.ServiceProvider{EntityID: endpoints.SAMLEntityID, ACSURL: endpoints.SAMLACSURL}), nil
+}
+
+// CallbackURL is where the browser goes after an IdP callback: the
+// dashboard SSO page with either the ticket or the error reason.
+func (uc *SSOUsecase) CallbackURL(ticket string, err error) string {
+	query := url.Values{}
+	if err != nil {
+		reason := errors.Reason(err)
+		if reason == "" {
+			reason = "SSO_FAILED"
+		}
+		query.Set("error", reason)
+	} else {
+		query.Set("ticket", ticket)
+	}
+	return uc.dashboardURL + "/console/sso/callback?" + query.Encode()
+}
+
+// signIn resolves the tenant user of an IdP identity, provisioning it when
+// allowed, syncs its mapped roles and issues a login ticket.
+func (uc *SSOUsecase) signIn(ctx context.Context, cfg SSOConfig, identity SSOIdentity) (string, error) {
+	identity.Email = strings.TrimSpace(identity.Email)
+	identity.Name = strings.TrimSpace(identity.Name)
+	if identity.Subject == "" {
+		return "", errors.Unauthorized("SSO_SUBJECT_MISSING", "idp subject missing")
+	}
+	userID, err := uc.repo.FindIdentityUser(ctx, cfg.TenantID, identity.Issuer, identity.Subject)
+	if err != nil && !errors.IsNotFound(err) {
+		return "", err
+	}
+	created := false
+	if userID == "" {
+		if identity.Email == "" {
+			return "", errors.BadRequest("SSO_EMAIL_MISSING", "idp did not provide an email")
+		}
+		user, err := uc.auth.repo.FindTenantAccount(ctx, identity.Email, cfg.TenantID)
+		switch {
+		case err == nil:
+			userID = user.ID
+		case errors.IsNotFound(err):
+			if !cfg.JITProvisioning {
+				return "", errors.Forbidden("SSO_USER_NOT_PROVISIONED", "user not provisioned in tenant")
+			}
+			name := identity.Name
+			if name == "" {
+				name = identity.Email
+			}
+			userID = uuid.NewString()
+			if err := uc.repo.CreateSSOUser(ctx, TenantAccount{
+				ID:       userID,
+				TenantID: cfg.TenantID,
+				Email:    identity.Email,
+				Name:     name,
+				Status:   "active",
+			}); err != nil {
+				return "", err
+			}
+			created = true
+		default:
+			return "", err
+		}
+	}
+	active, err := uc.auth.subjectActive(ctx, SubjectTypeTenant, userID)
+	if err != nil {
+		return "", err
+	}
+	if !active {
+		return "", errors.Forbidden("ACCOUNT_DISABLED", "account disabled")
+	}
+	if err := uc.repo.LinkIdentity(ctx, cfg.TenantID, userID, identity); err != nil {
+		return "", err
+	}
+	managed, granted := mappedRoles(cfg.RoleMappings, identity.Attributes)
+	if created && cfg.DefaultRoleID != "" {
+		granted = uniqueStrings(append(granted, cfg.DefaultRoleID))
+	}
+	if len(managed) > 0 || len(granted) > 0 {
+		if err := uc.repo.SyncMappedRoles(ctx, cfg.TenantID, userID, managed, granted); err != nil {
+			return "", err
+		}
+	}
+	ticket, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	if err := uc.repo.CreateTicket(ctx, SSOTicket{
+		TicketHash: hashToken(ticket),
+		TenantID:   cfg.TenantID,
+		UserID:     userID,
+		ExpiresAt:  now.Add(ssoTicketTTL),
+		CreatedAt:  now,
+	}); err != nil {
+		return "", err
+	}
+	return ticket, nil
+}
+
+func (uc *SSOUsecase) enabledConfig(ctx context.Context, tenantID string) (SSOConfig, error) {
+	cfg, err := uc.repo.GetSSOConfig(ctx, tenantID)
+	if err != nil {
+		return SSOConfig{}, err
+	}
+	if !cfg.Enabled {
+		return SSOConfig{}, errors.NotFound("SSO_NOT_CONFIGURED", "sso not enabled for tenant")
+	}
+	return cfg, nil
+}
+
+func (uc *SSOUsecase) consumeState(ctx context.Context, state string, protocol string) (SSOLoginState, error) {
+	state = strings.TrimSpace(state)
+	if state == "" {
+		return SSOLoginState{}, errors.Unauthorized("SSO_STATE_INVALID", "login state missing")
+	}
+	loginState, err := uc.repo.ConsumeLoginState(ctx, state)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return SSOLoginState{}, errors.Unauthorized("SSO_STATE_INVALID", "unknown or used login state")
+		}
+		return SSOLoginState{}, err
+	}
+	if loginState.Protocol != protocol || !time.Now().Before(loginState.ExpiresAt) {
+		return SSOLoginState{}, errors.Unauthorized("SSO_STATE_INVALID", "login state expired")
+	}
+	return loginState, nil
+}
+
+// mappedRoles returns every role managed by the mappings and the ones the
+// identity qualifies for. Managed roles the identity no longer qualifies for
+// are removed on login; other roles are left alone.
+func mappedRoles(mappings []SSORoleMapping, attributes map[string][]string) ([]string, []string) {
+	managed := make([]string, 0, len(mappings))
+	granted := make([]string, 0)
+	for _, mapping := range mappings {
+		managed = append(managed, mapping.RoleID)
+		for _, value := range attributes[mapping.Claim] {
+			if value == mapping.Value {
+				granted = append(granted, mapping.RoleID)
+				break
+			}
+		}
+	}
+	return uniqueStrings(managed), uniqueStrings(granted)
+}
+
+func normalizeScopes(scopes []string) []string {
+	out := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		out = append(out, strings.Fields(scope)...)
+	}
+	return uniqueStrings(out)
+}
+
+// scopesOrDefault always requests openid.
+func scopesOrDefault(scopes []string) []string {
+	if len(scopes) == 0 {
+		return defaultOIDCScopes
+	}
+	for _, scope := range scopes {
+		if scope == "openid" {
+			return scopes
+		}
+	}
+	return append([]string{"openid"}, scopes...)
+}
+
+func attributeOrDefault(name string, fallback string) string {
+	if name != "" {
+		return name
+	}
+	return fallback
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		out = append(out, value)
+	}
+	return out
+}
+
+func isHTTPURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	return err == nil && (parsed.Scheme == "https" || parsed.Scheme == "http") && parsed.Host != ""
+}
+
+func randomToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", errors.InternalServer("RANDOM_FAILED", "generate token failed")
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
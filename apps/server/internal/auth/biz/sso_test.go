@@ -0,0 +1,285 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/oidc/oidctest"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const testTenantID = "tenant-1"
+
+// fakeSSORepo keeps SSO state in memory; methods a test does not reach are
+// left to the nil embedded interface.
+type fakeSSORepo struct {
+	SSORepo
+	mu      sync.Mutex
+	cfg     SSOConfig
+	states  map[string]SSOLoginState
+	links   []SSOIdentity
+	tickets []SSOTicket
+}
+
+func (r *fakeSSORepo) GetSSOConfig(_ context.Context, tenantID string) (SSOConfig, error) {
+	if tenantID != r.cfg.TenantID {
+		return SSOConfig{}, errors.NotFound("SSO_NOT_CONFIGURED", "sso not configured")
+	}
+	return r.cfg, nil
+}
+
+func (r *fakeSSORepo) CreateLoginState(_ context.Context, state SSOLoginState) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.states[state.State] = state
+	return nil
+}
+
+func (r *fakeSSORepo) ConsumeLoginState(_ context.Context, state string) (SSOLoginState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	loginState, ok := r.states[state]
+	if !ok {
+		return SSOLoginState{}, errors.NotFound("SSO_STATE_NOT_FOUND", "login state not found")
+	}
+	delete(r.states, state)
+	return loginState, nil
+}
+
+func (r *fakeSSORepo) FindIdentityUser(context.Context, string, string, string) (string, error) {
+	return "user-1", nil
+}
+
+func (r *fakeSSORepo) LinkIdentity(_ context.Context, _ string, _ string, identity SSOIdentity) error {
+	r.links = append(r.links, identity)
+	return nil
+}
+
+func (r *fakeSSORepo) CreateTicket(_ context.Context, ticket SSOTicket) error {
+	r.tickets = append(r.tickets, ticket)
+	return nil
+}
+
+func (r *fakeSSORepo) ConsumeTicket(_ context.Context, ticketHash string) (SSOTicket, error) {
+	for i, ticket := range r.tickets {
+		if ticket.TicketHash == ticketHash {
+			r.tickets = append(r.tickets[:i], r.tickets[i+1:]...)
+			return ticket, nil
+		}
+	}
+	return SSOTicket{}, errors.NotFound("SSO_TICKET_NOT_FOUND", "ticket not found")
+}
+
+type fakeAuthRepo struct {
+	AuthRepo
+}
+
+func (fakeAuthRepo) GetTenantAccount(_ context.Context, id string) (TenantAccount, error) {
+	return TenantAccount{ID: id, TenantID: testTenantID, Email: "alice@example.com", Status: "active"}, nil
+}
+
+type ssoEnv struct {
+	uc   *SSOUsecase
+	repo *fakeSSORepo
+	idp  *oidctest.Provider
+}
+
+func newSSOEnv(t *testing.T) *ssoEnv {
+	t.Helper()
+	idp, err := oidctest.NewProvider("desk-client", "s3cret")
+	if err != nil {
+		t.Fatalf("stub provider: %v", err)
+	}
+	t.Cleanup(idp.Close)
+	repo := &fakeSSORepo{
+		cfg: SSOConfig{
+			TenantID:         testTenantID,
+			Protocol:         SSOProtocolOIDC,
+			Enabled:          true,
+			OIDCIssuer:       idp.Issuer,
+			OIDCClientID:     "desk-client",
+			OIDCClientSecret: "s3cret",
+		},
+		states: make(map[string]SSOLoginState),
+	}
+	cfg := &conf.Server{Auth: &conf.Server_Auth{Sso: &conf.Server_SSO{PublicUrl: "https://desk.example.com"}}}
+	uc := NewSSOUsecase(repo, &AuthUsecase{repo: fakeAuthRepo{}}, cfg, log.DefaultLogger)
+	return &ssoEnv{uc: uc, repo: repo, idp: idp}
+}
+
+// login starts an OIDC login and returns the state and code the IdP sends
+// back to the callback.
+func (e *ssoEnv) login(t *testing.T) (string, string) {
+	t.Helper()
+	redirectURL, protocol, err := e.uc.StartLogin(context.Background(), testTenantID)
+	if err != nil {
+		t.Fatalf("start login: %v", err)
+	}
+	if protocol != SSOProtocolOIDC {
+		t.Fatalf("protocol = %s", protocol)
+	}
+	back, err := e.idp.Authorize(redirectURL)
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	return back.Get("state"), back.Get("code")
+}
+
+func TestSSOCompleteOIDC(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		complete   func(t *testing.T, e *ssoEnv) error
+		wantReason string
+		// wantTickets counts the login tickets issued in total.
+		wantTickets int
+	}{
+		{
+			name: "valid login",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				state, code := e.login(t)
+				_, err := e.uc.CompleteOIDC(ctx, state, code, "")
+				return err
+			},
+			wantTickets: 1,
+		},
+		{
+			name: "replayed state",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				state, code := e.login(t)
+				if _, err := e.uc.CompleteOIDC(ctx, state, code, ""); err != nil {
+					t.Fatalf("first callback: %v", err)
+				}
+				_, err := e.uc.CompleteOIDC(ctx, state, code, "")
+				return err
+			},
+			wantReason:  "SSO_STATE_INVALID",
+			wantTickets: 1,
+		},
+		{
+			name: "forged state",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				_, code := e.login(t)
+				_, err := e.uc.CompleteOIDC(ctx, "forged-state", code, "")
+				return err
+			},
+			wantReason: "SSO_STATE_INVALID",
+		},
+		{
+			name: "missing state",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				_, code := e.login(t)
+				_, err := e.uc.CompleteOIDC(ctx, "", code, "")
+				return err
+			},
+			wantReason: "SSO_STATE_INVALID",
+		},
+		{
+			name: "expired state",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				state, code := e.login(t)
+				expired := e.repo.states[state]
+				expired.ExpiresAt = time.Now().Add(-time.Second)
+				e.repo.states[state] = expired
+				_, err := e.uc.CompleteOIDC(ctx, state, code, "")
+				return err
+			},
+			wantReason: "SSO_STATE_INVALID",
+		},
+		{
+			name: "state of a saml login",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				_, code := e.login(t)
+				e.repo.states["saml-state"] = SSOLoginState{
+					State:     "saml-state",
+					TenantID:  testTenantID,
+					Protocol:  SSOProtocolSAML,
+					RequestID: "_req-1",
+					ExpiresAt: time.Now().Add(time.Minute),
+				}
+				_, err := e.uc.CompleteOIDC(ctx, "saml-state", code, "")
+				return err
+			},
+			wantReason: "SSO_STATE_INVALID",
+		},
+		{
+			name: "oidc state on the saml acs",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				state, _ := e.login(t)
+				_, err := e.uc.CompleteSAML(ctx, "PHNhbWxwOlJlc3BvbnNlLz4=", state)
+				return err
+			},
+			wantReason: "SSO_STATE_INVALID",
+		},
+		{
+			name: "code of another login",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				state, _ := e.login(t)
+				_, otherCode := e.login(t)
+				// The code was issued for the other login's PKCE challenge.
+				_, err := e.uc.CompleteOIDC(ctx, state, otherCode, "")
+				return err
+			},
+			wantReason: "SSO_EXCHANGE_FAILED",
+		},
+		{
+			name: "nonce mismatch",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				state, code := e.login(t)
+				e.idp.SetClaims(map[string]any{"nonce": "replayed-nonce"})
+				_, err := e.uc.CompleteOIDC(ctx, state, code, "")
+				return err
+			},
+			wantReason: "SSO_ID_TOKEN_INVALID",
+		},
+		{
+			name: "token for another client",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				state, code := e.login(t)
+				e.idp.SetClaims(map[string]any{"aud": "other-client"})
+				_, err := e.uc.CompleteOIDC(ctx, state, code, "")
+				return err
+			},
+			wantReason: "SSO_ID_TOKEN_INVALID",
+		},
+		{
+			name: "idp error consumes the state",
+			complete: func(t *testing.T, e *ssoEnv) error {
+				state, code := e.login(t)
+				_, err := e.uc.CompleteOIDC(ctx, state, "", "access_denied")
+				if errors.Reason(err) != "SSO_IDP_ERROR" {
+					t.Fatalf("err = %v, want SSO_IDP_ERROR", err)
+				}
+				_, err = e.uc.CompleteOIDC(ctx, state, code, "")
+				return err
+			},
+			wantReason: "SSO_STATE_INVALID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newSSOEnv(t)
+			err := tt.complete(t, e)
+			if len(e.repo.tickets) != tt.wantTickets {
+				t.Fatalf("issued %d tickets, want %d", len(e.repo.tickets), tt.wantTickets)
+			}
+			if tt.wantReason != "" {
+				if reason := errors.Reason(err); reason != tt.wantReason {
+					t.Fatalf("err = %v, want %s", err, tt.wantReason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("complete: %v", err)
+			}
+			identity := e.repo.links[0]
+			if identity.Issuer != e.idp.Issuer || identity.Subject != "user-1" || identity.Email != "alice@example.com" {
+				t.Fatalf("identity = %+v", identity)
+			}
+		})
+	}
+}
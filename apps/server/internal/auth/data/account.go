@@ -0,0 +1,133 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"time"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/mail"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type accountTokenRepo struct {
+	log *log.Helper
+	db  *sql.DB
+}
+
+// NewAccountTokenRepo creates a new account token repo.
+func NewAccountTokenRepo(data *internaldata.Data, logger log.Logger) biz.AccountTokenRepo {
+	return &accountTokenRepo{log: log.NewHelper(logger), db: data.DB}
+}
+
+// NewMailSender creates the sender configured in server.mail.
+func NewMailSender(cfg *conf.Server, logger log.Logger) mail.Sender {
+	var driver, dir, from string
+	if cfg != nil && cfg.Mail != nil {
+		driver, dir, from = cfg.Mail.Driver, cfg.Mail.Dir, cfg.Mail.From
+	}
+	return mail.NewSender(driver, dir, from, logger)
+}
+
+func (r *accountTokenRepo) CreateAccountToken(ctx context.Context, token biz.AccountToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if _, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM account_token WHERE user_id = ? AND purpose = ? AND used_at IS NULL",
+		token.UserID,
+		token.Purpose,
+	); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO account_token (token_hash, purpose, tenant_id, user_id, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		token.TokenHash,
+		token.Purpose,
+		token.TenantID,
+		token.UserID,
+		token.ExpiresAt,
+		token.CreatedAt,
+	); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM account_token WHERE expires_at < ? LIMIT 1000", token.CreatedAt); err != nil {
+		r.log.Warnf("purge account tokens failed: %v", err)
+	}
+	return nil
+}
+
+func (r *accountTokenRepo) RedeemAccountToken(ctx context.Context, tokenHash string, purpose string, update biz.AccountUpdate, now time.Time) (biz.AccountToken, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return biz.AccountToken{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	var (
+		out    biz.AccountToken
+		usedAt sql.NullTime
+	)
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT token_hash, purpose, tenant_id, user_id, expires_at, used_at, created_at
+		FROM account_token WHERE token_hash = ? FOR UPDATE`,
+		tokenHash,
+	).Scan(&out.TokenHash, &out.Purpose, &out.TenantID, &out.UserID, &out.ExpiresAt, &usedAt, &out.CreatedAt)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.AccountToken{}, kerrors.BadRequest("ACCOUNT_TOKEN_INVALID", "invalid or expired link")
+		}
+		return biz.AccountToken{}, err
+	}
+	if out.Purpose != purpose {
+		return biz.AccountToken{}, kerrors.BadRequest("ACCOUNT_TOKEN_INVALID", "invalid or expired link")
+	}
+	if usedAt.Valid {
+		return biz.AccountToken{}, kerrors.BadRequest("ACCOUNT_TOKEN_USED", "link already used")
+	}
+	if !now.Before(out.ExpiresAt) {
+		return biz.AccountToken{}, kerrors.BadRequest("ACCOUNT_TOKEN_EXPIRED", "link expired")
+	}
+	status := update.RequireStatus
+	if update.Activate {
+		status = biz.UserStatusActive
+	}
+	res, err := tx.ExecContext(
+		ctx,
+		"UPDATE `user` SET password_hash = ?, status = ?, name = COALESCE(?, name) WHERE id = ? AND tenant_id = ? AND status = ?",
+		update.PasswordHash,
+		status,
+		emptyToNull(update.Name),
+		out.UserID,
+		out.TenantID,
+		update.RequireStatus,
+	)
+	if err != nil {
+		return biz.AccountToken{}, err
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return biz.AccountToken{}, err
+	} else if affected == 0 {
+		return biz.AccountToken{}, kerrors.BadRequest("ACCOUNT_TOKEN_INVALID", "invalid or expired link")
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE account_token SET used_at = ? WHERE token_hash = ?", now, tokenHash); err != nil {
+		return biz.AccountToken{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return biz.AccountToken{}, err
+	}
+	out.UsedAt = now
+	return out, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
 	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-sql-driver/mysql"
@@ -33,14 +34,13 @@ func (r *authRepo) FindTenantAccount(ctx context.Context, account string, tenant
 		return biz.TenantAccount{}, kerrors.BadRequest("ACCOUNT_REQUIRED", "account required")
 	}
 	if strings.TrimSpace(tenantID) != "" {
-		var user biz.TenantAccount
-		err := r.db.QueryRowContext(
+		user, err := scanTenantAccount(r.db.QueryRowContext(
 			ctx,
 			"SELECT id, tenant_id, email, phone, name, status, password_hash FROM `user` WHERE tenant_id = ? AND (email = ? OR phone = ?)",
 			tenantID,
 			account,
 			account,
-		).Scan(&user.ID, &user.TenantID, &user.Email, &user.Phone, &user.Name, &user.Status, &user.PasswordHash)
+		))
 		if err != nil {
 			if stderrors.Is(err, sql.ErrNoRows) {
 				return biz.TenantAccount{}, kerrors.NotFound("ACCOUNT_NOT_FOUND", "account not found")
@@ -61,8 +61,8 @@ func (r *authRepo) FindTenantAccount(ctx context.Context, account string, tenant
 	defer rows.Close()
 	items := make([]biz.TenantAccount, 0, 2)
 	for rows.Next() {
-		var user biz.TenantAccount
-		if err := rows.Scan(&user.ID, &user.TenantID, &user.Email, &user.Phone, &user.Name, &user.Status, &user.PasswordHash); err != nil {
+		user, err := scanTenantAccount(rows)
+		if err != nil {
 			return biz.TenantAccount{}, err
 		}
 		items = append(items, user)
@@ -84,13 +84,12 @@ func (r *authRepo) FindPlatformAccount(ctx context.Context, account string) (biz
 	if account == "" {
 		return biz.PlatformAccount{}, kerrors.BadRequest("ACCOUNT_REQUIRED", "account required")
 	}
-	var admin biz.PlatformAccount
-	err := r.db.QueryRowContext(
+	admin, err := scanPlatformAccount(r.db.QueryRowContext(
 		ctx,
 		"SELECT id, email, phone, name, status, password_hash FROM platform_admin WHERE email = ? OR phone = ?",
 		account,
 		account,
-	).Scan(&admin.ID, &admin.Email, &admin.Phone, &admin.Name, &admin.Status, &admin.PasswordHash)
+	))
 	if err != nil {
 		if stderrors.Is(err, sql.ErrNoRows) {
 			return biz.PlatformAccount{}, kerrors.NotFound("ACCOUNT_NOT_FOUND", "account not found")
@@ -231,6 +230,47 @@ func (r *authRepo) CreateTenantWithAdmin(ctx context.Context, tenant biz.Tenant,
 	return tenant, admin, nil
 }
 
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanTenantAccount scans id, tenant_id, email, phone, name, status and
+// password_hash; SSO users have no password.
+func scanTenantAccount(row rowScanner) (biz.TenantAccount, error) {
+	var (
+		user         biz.TenantAccount
+		email        sql.NullString
+		phone        sql.NullString
+		name         sql.NullString
+		passwordHash sql.NullString
+	)
+	if err := row.Scan(&user.ID, &user.TenantID, &email, &phone, &name, &user.Status, &passwordHash); err != nil {
+		return biz.TenantAccount{}, err
+	}
+	user.Email = email.String
+	user.Phone = phone.String
+	user.Name = name.String
+	user.PasswordHash = passwordHash.String
+	return user, nil
+}
+
+// scanPlatformAccount scans id, email, phone, name, status and password_hash.
+func scanPlatformAccount(row rowScanner) (biz.PlatformAccount, error) {
+	var (
+		admin biz.PlatformAccount
+		email sql.NullString
+		phone sql.NullString
+		name  sql.NullString
+	)
+	if err := row.Scan(&admin.ID, &email, &phone, &name, &admin.Status, &admin.PasswordHash); err != nil {
+		return biz.PlatformAccount{}, err
+	}
+	admin.Email = email.String
+	admin.Phone = phone.String
+	admin.Name = name.String
+	return admin, nil
+}
+
 func emptyToNull(value string) any {
 	if strings.TrimSpace(value) == "" {
 		return nil
@@ -269,4 +309,8 @@ func (r *authRepo) findExistingTenantByAccount(ctx context.Context, email string
 }
 
 // ProviderSet is auth data providers.
-var ProviderSet = wire.NewSet(NewAuthRepo)
+var ProviderSet = wire.NewSet(NewAuthRepo, NewSSORepo, NewMFARepo, NewSigningKeyRepo, NewAccountTokenRepo, NewImpersonationRepo, NewMailSender, NewSessionCache)
+
+func (r *authRepo) GetTenantSubscription(ctx context.Context, tenantID string) (plan.Subscription, error) {
+	return internaldata.LoadSubscription(ctx, r.db, tenantID)
+}
@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"time"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
+	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const impersonationSessionColumns = `id, tenant_id, admin_id, admin_name, reason, read_only, access_jti, expires_at, revoked_at, created_at`
+
+type impersonationRepo struct {
+	log *log.Helper
+	db  *sql.DB
+}
+
+// NewImpersonationRepo creates a new impersonation session repo.
+func NewImpersonationRepo(data *internaldata.Data, logger log.Logger) biz.ImpersonationRepo {
+	return &impersonationRepo{log: log.NewHelper(logger), db: data.DB}
+}
+
+func (r *impersonationRepo) CreateImpersonationSession(ctx context.Context, session biz.ImpersonationSession) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO impersonation_session (id, tenant_id, admin_id, admin_name, reason, read_only, access_jti, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		session.ID,
+		session.TenantID,
+		session.AdminID,
+		session.AdminName,
+		session.Reason,
+		session.ReadOnly,
+		session.AccessJTI,
+		session.ExpiresAt,
+		session.CreatedAt,
+	)
+	return err
+}
+
+func (r *impersonationRepo) GetImpersonationSession(ctx context.Context, id string) (biz.ImpersonationSession, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+impersonationSessionColumns+" FROM impersonation_session WHERE id = ?", id)
+	session, err := scanImpersonationSession(row)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.ImpersonationSession{}, kerrors.NotFound("IMPERSONATION_SESSION_NOT_FOUND", "impersonation session not found")
+		}
+		return biz.ImpersonationSession{}, err
+	}
+	return session, nil
+}
+
+func (r *impersonationRepo) ListImpersonationSessions(ctx context.Context, tenantID string, limit int, offset int) ([]biz.ImpersonationSession, error) {
+	query := "SELECT " + impersonationSessionColumns + " FROM impersonation_session"
+	args := make([]any, 0, 3)
+	if tenantID != "" {
+		query += " WHERE tenant_id = ?"
+		args = append(args, tenantID)
+	}
+	query += " ORDER BY created_at DESC LIMIT ? OFFSET ?"
+	args = append(args, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]biz.ImpersonationSession, 0)
+	for rows.Next() {
+		session, err := scanImpersonationSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, session)
+	}
+	return out, rows.Err()
+}
+
+func (r *impersonationRepo) RevokeImpersonationSession(ctx context.Context, id string, now time.Time) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		"UPDATE impersonation_session SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL",
+		now,
+		id,
+	)
+	return err
+}
+
+func scanImpersonationSession(row rowScanner) (biz.ImpersonationSession, error) {
+	var (
+		session   biz.ImpersonationSession
+		revokedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&session.ID,
+		&session.TenantID,
+		&session.AdminID,
+		&session.AdminName,
+		&session.Reason,
+		&session.ReadOnly,
+		&session.AccessJTI,
+		&session.ExpiresAt,
+		&revokedAt,
+		&session.CreatedAt,
+	); err != nil {
+		return biz.ImpersonationSession{}, err
+	}
+	if revokedAt.Valid {
+		session.RevokedAt = revokedAt.Time
+	}
+	return session, nil
+}
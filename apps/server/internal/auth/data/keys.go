@@ -0,0 +1,89 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
+	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type signingKeyRepo struct {
+	log *log.Helper
+	db  *sql.DB
+}
+
+// NewSigningKeyRepo creates a new signing key repo.
+func NewSigningKeyRepo(data *internaldata.Data, logger log.Logger) biz.SigningKeyRepo {
+	return &signingKeyRepo{log: log.NewHelper(logger), db: data.DB}
+}
+
+func (r *signingKeyRepo) ListSigningKeys(ctx context.Context, now time.Time) ([]biz.SigningKeyRecord, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, algorithm, private_key_pem, activates_at, expires_at, created_at
+		FROM jwt_signing_key WHERE expires_at IS NULL OR expires_at > ?
+		ORDER BY activates_at ASC`,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []biz.SigningKeyRecord
+	for rows.Next() {
+		var (
+			item      biz.SigningKeyRecord
+			expiresAt sql.NullTime
+		)
+		if err := rows.Scan(&item.ID, &item.Algorithm, &item.PrivateKeyPEM, &item.ActivatesAt, &expiresAt, &item.CreatedAt); err != nil {
+			return nil, err
+		}
+		if expiresAt.Valid {
+			item.ExpiresAt = expiresAt.Time
+		}
+		out = append(out, item)
+	}
+	return out, rows.Err()
+}
+
+func (r *signingKeyRepo) CreateSigningKey(ctx context.Context, key biz.SigningKeyRecord, supersededExpiresAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	// Key IDs of scheduled rotations are derived from the activation time, so
+	// replicas rotating at once converge on a single key.
+	res, err := tx.ExecContext(
+		ctx,
+		`INSERT IGNORE INTO jwt_signing_key (id, algorithm, private_key_pem, activates_at, expires_at, created_at)
+		VALUES (?, ?, ?, ?, NULL, ?)`,
+		key.ID,
+		key.Algorithm,
+		key.PrivateKeyPEM,
+		key.ActivatesAt,
+		key.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+		return err
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		"UPDATE jwt_signing_key SET expires_at = ? WHERE id <> ? AND expires_at IS NULL AND activates_at < ?",
+		supersededExpiresAt,
+		key.ID,
+		key.ActivatesAt,
+	); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM jwt_signing_key WHERE expires_at < ?", key.CreatedAt); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,337 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stderrors "errors"
+	"time"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
+	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+type mfaRepo struct {
+	log *log.Helper
+	db  *sql.DB
+}
+
+// NewMFARepo creates a new MFA repo.
+func NewMFARepo(data *internaldata.Data, logger log.Logger) biz.MFARepo {
+	return &mfaRepo{log: log.NewHelper(logger), db: data.DB}
+}
+
+func (r *mfaRepo) GetMFAFactor(ctx context.Context, subjectType string, subjectID string) (biz.MFAFactor, error) {
+	var (
+		out         biz.MFAFactor
+		confirmedAt sql.NullTime
+	)
+	err := r.db.QueryRowContext(
+		ctx,
+		`SELECT subject_type, subject_id, secret, confirmed_at, last_used_step, created_at, updated_at
+		FROM mfa_factor WHERE subject_type = ? AND subject_id = ?`,
+		subjectType,
+		subjectID,
+	).Scan(&out.SubjectType, &out.SubjectID, &out.Secret, &confirmedAt, &out.LastUsedStep, &out.CreatedAt, &out.UpdatedAt)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.MFAFactor{}, kerrors.NotFound("MFA_FACTOR_NOT_FOUND", "mfa factor not found")
+		}
+		return biz.MFAFactor{}, err
+	}
+	if confirmedAt.Valid {
+		out.ConfirmedAt = confirmedAt.Time
+	}
+	return out, nil
+}
+
+func (r *mfaRepo) SaveMFAFactorSecret(ctx context.Context, factor biz.MFAFactor) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO mfa_factor (subject_type, subject_id, secret, confirmed_at, last_used_step, created_at, updated_at)
+		VALUES (?, ?, ?, NULL, 0, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			secret = IF(confirmed_at IS NULL, VALUES(secret), secret),
+			updated_at = IF(confirmed_at IS NULL, VALUES(updated_at), updated_at)`,
+		factor.SubjectType,
+		factor.SubjectID,
+		factor.Secret,
+		factor.CreatedAt,
+		factor.UpdatedAt,
+	)
+	return err
+}
+
+func (r *mfaRepo) ConfirmMFAFactor(ctx context.Context, subjectType string, subjectID string, step int64, codeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	now := time.Now()
+	res, err := tx.ExecContext(
+		ctx,
+		`UPDATE mfa_factor SET confirmed_at = ?, last_used_step = ?, updated_at = ?
+		WHERE subject_type = ? AND subject_id = ? AND confirmed_at IS NULL`,
+		now,
+		step,
+		now,
+		subjectType,
+		subjectID,
+	)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return kerrors.Conflict("MFA_ALREADY_ENABLED", "mfa already enabled")
+	}
+	if err := replaceRecoveryCodes(ctx, tx, subjectType, subjectID, codeHashes, now); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *mfaRepo) UseMFAStep(ctx context.Context, subjectType string, subjectID string, step int64) (bool, error) {
+	res, err := r.db.ExecContext(
+		ctx,
+		"UPDATE mfa_factor SET last_used_step = ? WHERE subject_type = ? AND subject_id = ? AND last_used_step < ?",
+		step,
+		subjectType,
+		subjectID,
+		step,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	return affected > 0, err
+}
+
+func (r *mfaRepo) DeleteMFAFactor(ctx context.Context, subjectType string, subjectID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if _, err := tx.ExecContext(ctx, "DELETE FROM mfa_factor WHERE subject_type = ? AND subject_id = ?", subjectType, subjectID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM mfa_recovery_code WHERE subject_type = ? AND subject_id = ?", subjectType, subjectID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *mfaRepo) ReplaceRecoveryCodes(ctx context.Context, subjectType string, subjectID string, codeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := replaceRecoveryCodes(ctx, tx, subjectType, subjectID, codeHashes, time.Now()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, subjectType string, subjectID string, codeHashes []string, now time.Time) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM mfa_recovery_code WHERE subject_type = ? AND subject_id = ?", subjectType, subjectID); err != nil {
+		return err
+	}
+	for _, hash := range codeHashes {
+		if _, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO mfa_recovery_code (id, subject_type, subject_id, code_hash, created_at) VALUES (?, ?, ?, ?, ?)",
+			uuid.NewString(),
+			subjectType,
+			subjectID,
+			hash,
+			now,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *mfaRepo) UseRecoveryCode(ctx context.Context, subjectType string, subjectID string, codeHash string) (bool, error) {
+	res, err := r.db.ExecContext(
+		ctx,
+		`UPDATE mfa_recovery_code SET used_at = ?
+		WHERE subject_type = ? AND subject_id = ? AND code_hash = ? AND used_at IS NULL`,
+		time.Now(),
+		subjectType,
+		subjectID,
+		codeHash,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	return affected > 0, err
+}
+
+func (r *mfaRepo) CountRecoveryCodes(ctx context.Context, subjectType string, subjectID string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM mfa_recovery_code WHERE subject_type = ? AND subject_id = ? AND used_at IS NULL",
+		subjectType,
+		subjectID,
+	).Scan(&count)
+	return count, err
+}
+
+func (r *mfaRepo) CreateMFAChallenge(ctx context.Context, challenge biz.MFAChallenge) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO mfa_challenge (token_hash, subject_type, subject_id, tenant_id, enrollment, attempts, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, 0, ?, ?)`,
+		challenge.TokenHash,
+		challenge.SubjectType,
+		challenge.SubjectID,
+		challenge.TenantID,
+		challenge.Enrollment,
+		challenge.ExpiresAt,
+		challenge.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	// Logins abandoned after the password step never consume their challenge.
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM mfa_challenge WHERE expires_at < ? LIMIT 1000", challenge.CreatedAt); err != nil {
+		r.log.Warnf("purge mfa challenges failed: %v", err)
+	}
+	return nil
+}
+
+func (r *mfaRepo) GetMFAChallenge(ctx context.Context, tokenHash string) (biz.MFAChallenge, error) {
+	var out biz.MFAChallenge
+	err := r.db.QueryRowContext(
+		ctx,
+		`SELECT token_hash, subject_type, subject_id, tenant_id, enrollment, attempts, expires_at, created_at
+		FROM mfa_challenge WHERE token_hash = ?`,
+		tokenHash,
+	).Scan(&out.TokenHash, &out.SubjectType, &out.SubjectID, &out.TenantID, &out.Enrollment, &out.Attempts, &out.ExpiresAt, &out.CreatedAt)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.MFAChallenge{}, kerrors.NotFound("MFA_CHALLENGE_NOT_FOUND", "mfa challenge not found")
+		}
+		return biz.MFAChallenge{}, err
+	}
+	return out, nil
+}
+
+func (r *mfaRepo) FailMFAChallenge(ctx context.Context, tokenHash string, maxAttempts int) error {
+	if _, err := r.db.ExecContext(ctx, "UPDATE mfa_challenge SET attempts = attempts + 1 WHERE token_hash = ?", tokenHash); err != nil {
+		return err
+	}
+	_, err := r.db.ExecContext(ctx, "DELETE FROM mfa_challenge WHERE token_hash = ? AND attempts >= ?", tokenHash, maxAttempts)
+	return err
+}
+
+func (r *mfaRepo) ConsumeMFAChallenge(ctx context.Context, tokenHash string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM mfa_challenge WHERE token_hash = ?", tokenHash)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	return affected > 0, err
+}
+
+func (r *mfaRepo) GetMFAPolicy(ctx context.Context, subjectType string, tenantID string) (biz.MFAPolicy, error) {
+	var (
+		out     biz.MFAPolicy
+		roleIDs sql.NullString
+	)
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT subject_type, tenant_id, require_all, required_role_ids_json, updated_at FROM mfa_policy WHERE subject_type = ? AND tenant_id = ?",
+		subjectType,
+		tenantID,
+	).Scan(&out.SubjectType, &out.TenantID, &out.RequireAll, &roleIDs, &out.UpdatedAt)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.MFAPolicy{}, kerrors.NotFound("MFA_POLICY_NOT_FOUND", "mfa policy not found")
+		}
+		return biz.MFAPolicy{}, err
+	}
+	if roleIDs.Valid && roleIDs.String != "" {
+		if err := json.Unmarshal([]byte(roleIDs.String), &out.RequiredRoleIDs); err != nil {
+			return biz.MFAPolicy{}, err
+		}
+	}
+	return out, nil
+}
+
+func (r *mfaRepo) SaveMFAPolicy(ctx context.Context, policy biz.MFAPolicy) (biz.MFAPolicy, error) {
+	if policy.RequiredRoleIDs == nil {
+		policy.RequiredRoleIDs = []string{}
+	}
+	roleIDs, err := json.Marshal(policy.RequiredRoleIDs)
+	if err != nil {
+		return biz.MFAPolicy{}, err
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		`INSERT INTO mfa_policy (subject_type, tenant_id, require_all, required_role_ids_json, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			require_all = VALUES(require_all),
+			required_role_ids_json = VALUES(required_role_ids_json),
+			updated_at = VALUES(updated_at)`,
+		policy.SubjectType,
+		policy.TenantID,
+		policy.RequireAll,
+		string(roleIDs),
+		policy.UpdatedAt,
+	)
+	if err != nil {
+		return biz.MFAPolicy{}, err
+	}
+	return policy, nil
+}
+
+func (r *mfaRepo) ListSubjectRoleIDs(ctx context.Context, subjectType string, subjectID string) ([]string, error) {
+	query := "SELECT role_id FROM user_role WHERE user_id = ?"
+	if subjectType == biz.SubjectTypePlatform {
+		query = "SELECT role_id FROM platform_admin_role WHERE admin_id = ?"
+	}
+	rows, err := r.db.QueryContext(ctx, query, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]string, 0)
+	for rows.Next() {
+		var roleID string
+		if err := rows.Scan(&roleID); err != nil {
+			return nil, err
+		}
+		items = append(items, roleID)
+	}
+	return items, rows.Err()
+}
+
+func (r *mfaRepo) CountScopeRoles(ctx context.Context, subjectType string, tenantID string, roleIDs []string) (int, error) {
+	if len(roleIDs) == 0 {
+		return 0, nil
+	}
+	args := make([]any, 0, len(roleIDs)+1)
+	query := "SELECT COUNT(*) FROM platform_role WHERE id IN (" + placeholders(len(roleIDs)) + ")"
+	if subjectType != biz.SubjectTypePlatform {
+		query = "SELECT COUNT(*) FROM `role` WHERE tenant_id = ? AND id IN (" + placeholders(len(roleIDs)) + ")"
+		args = append(args, tenantID)
+	}
+	for _, id := range roleIDs {
+		args = append(args, id)
+	}
+	var count int
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	return count, err
+}
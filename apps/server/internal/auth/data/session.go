@@ -0,0 +1,281 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const revokedTokenKeyPrefix = "ragodesk:auth:jti:"
+
+func (r *authRepo) GetTenantAccount(ctx context.Context, id string) (biz.TenantAccount, error) {
+	user, err := scanTenantAccount(r.db.QueryRowContext(
+		ctx,
+		"SELECT id, tenant_id, email, phone, name, status, password_hash FROM `user` WHERE id = ?",
+		strings.TrimSpace(id),
+	))
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.TenantAccount{}, kerrors.NotFound("ACCOUNT_NOT_FOUND", "account not found")
+		}
+		return biz.TenantAccount{}, err
+	}
+	return user, nil
+}
+
+func (r *authRepo) GetPlatformAccount(ctx context.Context, id string) (biz.PlatformAccount, error) {
+	admin, err := scanPlatformAccount(r.db.QueryRowContext(
+		ctx,
+		"SELECT id, email, phone, name, status, password_hash FROM platform_admin WHERE id = ?",
+		strings.TrimSpace(id),
+	))
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.PlatformAccount{}, kerrors.NotFound("ACCOUNT_NOT_FOUND", "account not found")
+		}
+		return biz.PlatformAccount{}, err
+	}
+	return admin, nil
+}
+
+func (r *authRepo) CreateRefreshToken(ctx context.Context, token biz.RefreshToken) error {
+	return insertRefreshToken(ctx, r.db, token)
+}
+
+func (r *authRepo) GetRefreshToken(ctx context.Context, tokenHash string) (biz.RefreshToken, error) {
+	var (
+		token   biz.RefreshToken
+		used    sql.NullTime
+		revoked sql.NullTime
+	)
+	err := r.db.QueryRowContext(
+		ctx,
+		`SELECT id, session_id, subject_type, subject_id, tenant_id, token_hash, access_jti, access_expires_at,
+			expires_at, used_at, revoked_at, created_at
+		FROM refresh_token WHERE token_hash = ?`,
+		tokenHash,
+	).Scan(
+		&token.ID,
+		&token.SessionID,
+		&token.SubjectType,
+		&token.SubjectID,
+		&token.TenantID,
+		&token.TokenHash,
+		&token.AccessJTI,
+		&token.AccessExpiresAt,
+		&token.ExpiresAt,
+		&used,
+		&revoked,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.RefreshToken{}, kerrors.NotFound("REFRESH_TOKEN_NOT_FOUND", "refresh token not found")
+		}
+		return biz.RefreshToken{}, err
+	}
+	token.UsedAt = used.Time
+	token.RevokedAt = revoked.Time
+	return token, nil
+}
+
+func (r *authRepo) RotateRefreshToken(ctx context.Context, usedID string, next biz.RefreshToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	// Only one of two concurrent refreshes with the same token wins.
+	res, err := tx.ExecContext(
+		ctx,
+		"UPDATE refresh_token SET used_at = ? WHERE id = ? AND used_at IS NULL AND revoked_at IS NULL",
+		next.CreatedAt,
+		usedID,
+	)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return kerrors.Unauthorized("REFRESH_TOKEN_REUSED", "refresh token already used")
+	}
+	if err := insertRefreshToken(ctx, tx, next); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *authRepo) RevokeSession(ctx context.Context, subjectID string, sessionID string, reason string) ([]biz.RevokedToken, error) {
+	return r.revokeRefreshTokens(ctx, "session_id = ? AND subject_id = ?", []any{sessionID, subjectID}, reason)
+}
+
+func (r *authRepo) RevokeSubjectSessions(ctx context.Context, subjectType string, subjectID string, reason string) ([]biz.RevokedToken, error) {
+	return r.revokeRefreshTokens(ctx, "subject_type = ? AND subject_id = ?", []any{subjectType, subjectID}, reason)
+}
+
+// revokeRefreshTokens revokes the matching refresh tokens and every access
+// token issued with them that has not expired yet.
+func (r *authRepo) revokeRefreshTokens(ctx context.Context, where string, args []any, reason string) ([]biz.RevokedToken, error) {
+	now := time.Now()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT access_jti, subject_type, subject_id, access_expires_at FROM refresh_token
+		WHERE `+where+` AND access_expires_at > ? FOR UPDATE`,
+		append(append([]any(nil), args...), now)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	revoked := make([]biz.RevokedToken, 0)
+	for rows.Next() {
+		token := biz.RevokedToken{Reason: reason}
+		if err := rows.Scan(&token.JTI, &token.SubjectType, &token.SubjectID, &token.ExpiresAt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		revoked = append(revoked, token)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return nil, err
+	}
+	rows.Close()
+	if _, err := tx.ExecContext(
+		ctx,
+		"UPDATE refresh_token SET revoked_at = ? WHERE "+where+" AND revoked_at IS NULL",
+		append([]any{now}, args...)...,
+	); err != nil {
+		return nil, err
+	}
+	for _, token := range revoked {
+		if err := insertRevokedToken(ctx, tx, token, now); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return revoked, nil
+}
+
+func (r *authRepo) RevokeToken(ctx context.Context, token biz.RevokedToken) error {
+	now := time.Now()
+	if err := insertRevokedToken(ctx, r.db, token, now); err != nil {
+		return err
+	}
+	// Expired entries can no longer match a valid token.
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM revoked_token WHERE expires_at < ? LIMIT 1000", now); err != nil {
+		r.log.Warnf("purge revoked tokens failed: %v", err)
+	}
+	return nil
+}
+
+func (r *authRepo) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	var found int
+	err := r.db.QueryRowContext(ctx, "SELECT 1 FROM revoked_token WHERE jti = ?", jti).Scan(&found)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func insertRefreshToken(ctx context.Context, db execer, token biz.RefreshToken) error {
+	_, err := db.ExecContext(
+		ctx,
+		`INSERT INTO refresh_token
+			(id, session_id, subject_type, subject_id, tenant_id, token_hash, access_jti, access_expires_at, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		token.ID,
+		token.SessionID,
+		token.SubjectType,
+		token.SubjectID,
+		token.TenantID,
+		token.TokenHash,
+		token.AccessJTI,
+		token.AccessExpiresAt,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	return err
+}
+
+func insertRevokedToken(ctx context.Context, db execer, token biz.RevokedToken, now time.Time) error {
+	_, err := db.ExecContext(
+		ctx,
+		`INSERT IGNORE INTO revoked_token (jti, subject_type, subject_id, reason, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		token.JTI,
+		token.SubjectType,
+		token.SubjectID,
+		token.Reason,
+		token.ExpiresAt,
+		now,
+	)
+	return err
+}
+
+type sessionCache struct {
+	client *redis.Client
+	log    *log.Helper
+}
+
+// NewSessionCache caches token revocation checks in Redis; without Redis
+// every check reads MySQL.
+func NewSessionCache(cfg *conf.Data, logger log.Logger) biz.SessionCache {
+	if cfg == nil || cfg.Redis == nil || cfg.Redis.Addr == "" {
+		return nil
+	}
+	options := &redis.Options{
+		Addr:         cfg.Redis.Addr,
+		ReadTimeout:  cfg.Redis.ReadTimeout.AsDuration(),
+		WriteTimeout: cfg.Redis.WriteTimeout.AsDuration(),
+	}
+	client := redis.NewClient(options)
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		log.NewHelper(logger).Warnf("redis ping failed for session cache: %v", err)
+		return nil
+	}
+	return &sessionCache{client: client, log: log.NewHelper(logger)}
+}
+
+func (c *sessionCache) Get(ctx context.Context, jti string) (bool, bool) {
+	value, err := c.client.Get(ctx, revokedTokenKeyPrefix+jti).Result()
+	if err != nil {
+		if !stderrors.Is(err, redis.Nil) {
+			c.log.Warnf("session cache get failed: %v", err)
+		}
+		return false, false
+	}
+	return value == "1", true
+}
+
+func (c *sessionCache) Set(ctx context.Context, jti string, revoked bool, ttl time.Duration) {
+	value := "0"
+	if revoked {
+		value = "1"
+	}
+	if err := c.client.Set(ctx, revokedTokenKeyPrefix+jti, value, ttl).Err(); err != nil {
+		c.log.Warnf("session cache set failed: %v", err)
+	}
+}
@@ -0,0 +1,388 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
+	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+)
+
+type ssoRepo struct {
+	log *log.Helper
+	db  *sql.DB
+}
+
+// NewSSORepo creates a new SSO repo.
+func NewSSORepo(data *internaldata.Data, logger log.Logger) biz.SSORepo {
+	return &ssoRepo{log: log.NewHelper(logger), db: data.DB}
+}
+
+func (r *authRepo) IsSSOEnforced(ctx context.Context, tenantID string) (bool, error) {
+	var enforced bool
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT enabled = 1 AND enforce_sso = 1 FROM tenant_sso_config WHERE tenant_id = ?",
+		tenantID,
+	).Scan(&enforced)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return enforced, nil
+}
+
+func (r *ssoRepo) GetSSOConfig(ctx context.Context, tenantID string) (biz.SSOConfig, error) {
+	var (
+		cfg           biz.SSOConfig
+		defaultRoleID sql.NullString
+		issuer        sql.NullString
+		clientID      sql.NullString
+		clientSecret  sql.NullString
+		scopes        sql.NullString
+		idpEntityID   sql.NullString
+		idpSSOURL     sql.NullString
+		idpCert       sql.NullString
+		emailAttr     sql.NullString
+		nameAttr      sql.NullString
+		mappings      sql.NullString
+	)
+	err := r.db.QueryRowContext(
+		ctx,
+		`SELECT tenant_id, protocol, enabled, enforce_sso, jit_provisioning, default_role_id,
+			oidc_issuer, oidc_client_id, oidc_client_secret, oidc_scopes,
+			saml_idp_entity_id, saml_idp_sso_url, saml_idp_certificate,
+			email_attribute, name_attribute, role_mappings_json, updated_at
+		FROM tenant_sso_config WHERE tenant_id = ?`,
+		tenantID,
+	).Scan(
+		&cfg.TenantID,
+		&cfg.Protocol,
+		&cfg.Enabled,
+		&cfg.EnforceSSO,
+		&cfg.JITProvisioning,
+		&defaultRoleID,
+		&issuer,
+		&clientID,
+		&clientSecret,
+		&scopes,
+		&idpEntityID,
+		&idpSSOURL,
+		&idpCert,
+		&emailAttr,
+		&nameAttr,
+		&mappings,
+		&cfg.UpdatedAt,
+	)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.SSOConfig{}, kerrors.NotFound("SSO_NOT_CONFIGURED", "sso not configured")
+		}
+		return biz.SSOConfig{}, err
+	}
+	cfg.DefaultRoleID = defaultRoleID.String
+	cfg.OIDCIssuer = issuer.String
+	cfg.OIDCClientID = clientID.String
+	cfg.OIDCClientSecret = clientSecret.String
+	cfg.OIDCScopes = strings.Fields(scopes.String)
+	cfg.SAMLIdPEntityID = idpEntityID.String
+	cfg.SAMLIdPSSOURL = idpSSOURL.String
+	cfg.SAMLIdPCertificate = idpCert.String
+	cfg.EmailAttribute = emailAttr.String
+	cfg.NameAttribute = nameAttr.String
+	if mappings.String != "" {
+		if err := json.Unmarshal([]byte(mappings.String), &cfg.RoleMappings); err != nil {
+			r.log.Warnf("decode sso role mappings failed: tenant=%s err=%v", tenantID, err)
+		}
+	}
+	return cfg, nil
+}
+
+func (r *ssoRepo) SaveSSOConfig(ctx context.Context, cfg biz.SSOConfig) error {
+	mappings, err := json.Marshal(cfg.RoleMappings)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		`INSERT INTO tenant_sso_config
+			(tenant_id, protocol, enabled, enforce_sso, jit_provisioning, default_role_id,
+			oidc_issuer, oidc_client_id, oidc_client_secret, oidc_scopes,
+			saml_idp_entity_id, saml_idp_sso_url, saml_idp_certificate,
+			email_attribute, name_attribute, role_mappings_json, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			protocol = VALUES(protocol),
+			enabled = VALUES(enabled),
+			enforce_sso = VALUES(enforce_sso),
+			jit_provisioning = VALUES(jit_provisioning),
+			default_role_id = VALUES(default_role_id),
+			oidc_issuer = VALUES(oidc_issuer),
+			oidc_client_id = VALUES(oidc_client_id),
+			oidc_client_secret = VALUES(oidc_client_secret),
+			oidc_scopes = VALUES(oidc_scopes),
+			saml_idp_entity_id = VALUES(saml_idp_entity_id),
+			saml_idp_sso_url = VALUES(saml_idp_sso_url),
+			saml_idp_certificate = VALUES(saml_idp_certificate),
+			email_attribute = VALUES(email_attribute),
+			name_attribute = VALUES(name_attribute),
+			role_mappings_json = VALUES(role_mappings_json),
+			updated_at = VALUES(updated_at)`,
+		cfg.TenantID,
+		cfg.Protocol,
+		cfg.Enabled,
+		cfg.EnforceSSO,
+		cfg.JITProvisioning,
+		emptyToNull(cfg.DefaultRoleID),
+		emptyToNull(cfg.OIDCIssuer),
+		emptyToNull(cfg.OIDCClientID),
+		emptyToNull(cfg.OIDCClientSecret),
+		emptyToNull(strings.Join(cfg.OIDCScopes, " ")),
+		emptyToNull(cfg.SAMLIdPEntityID),
+		emptyToNull(cfg.SAMLIdPSSOURL),
+		emptyToNull(cfg.SAMLIdPCertificate),
+		emptyToNull(cfg.EmailAttribute),
+		emptyToNull(cfg.NameAttribute),
+		string(mappings),
+		cfg.UpdatedAt,
+		cfg.UpdatedAt,
+	)
+	return err
+}
+
+func (r *ssoRepo) CountTenantRoles(ctx context.Context, tenantID string, roleIDs []string) (int, error) {
+	if len(roleIDs) == 0 {
+		return 0, nil
+	}
+	args := make([]any, 0, len(roleIDs)+1)
+	args = append(args, tenantID)
+	for _, id := range roleIDs {
+		args = append(args, id)
+	}
+	var count int
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM `role` WHERE tenant_id = ? AND id IN ("+placeholders(len(roleIDs))+")",
+		args...,
+	).Scan(&count)
+	return count, err
+}
+
+func (r *ssoRepo) CreateLoginState(ctx context.Context, state biz.SSOLoginState) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO sso_login_state (state, tenant_id, protocol, nonce, code_verifier, request_id, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		state.State,
+		state.TenantID,
+		state.Protocol,
+		state.Nonce,
+		state.CodeVerifier,
+		state.RequestID,
+		state.ExpiresAt,
+		state.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	// Abandoned logins never reach their callback.
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM sso_login_state WHERE expires_at < ? LIMIT 1000", state.CreatedAt); err != nil {
+		r.log.Warnf("purge sso login states failed: %v", err)
+	}
+	return nil
+}
+
+func (r *ssoRepo) ConsumeLoginState(ctx context.Context, state string) (biz.SSOLoginState, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return biz.SSOLoginState{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	var out biz.SSOLoginState
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT state, tenant_id, protocol, nonce, code_verifier, request_id, expires_at, created_at
+		FROM sso_login_state WHERE state = ? FOR UPDATE`,
+		state,
+	).Scan(&out.State, &out.TenantID, &out.Protocol, &out.Nonce, &out.CodeVerifier, &out.RequestID, &out.ExpiresAt, &out.CreatedAt)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.SSOLoginState{}, kerrors.NotFound("SSO_STATE_NOT_FOUND", "login state not found")
+		}
+		return biz.SSOLoginState{}, err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM sso_login_state WHERE state = ?", state); err != nil {
+		return biz.SSOLoginState{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return biz.SSOLoginState{}, err
+	}
+	return out, nil
+}
+
+func (r *ssoRepo) CreateTicket(ctx context.Context, ticket biz.SSOTicket) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		"INSERT INTO sso_ticket (ticket_hash, tenant_id, user_id, expires_at, created_at) VALUES (?, ?, ?, ?, ?)",
+		ticket.TicketHash,
+		ticket.TenantID,
+		ticket.UserID,
+		ticket.ExpiresAt,
+		ticket.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM sso_ticket WHERE expires_at < ? LIMIT 1000", ticket.CreatedAt); err != nil {
+		r.log.Warnf("purge sso tickets failed: %v", err)
+	}
+	return nil
+}
+
+func (r *ssoRepo) ConsumeTicket(ctx context.Context, ticketHash string) (biz.SSOTicket, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return biz.SSOTicket{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	var out biz.SSOTicket
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT ticket_hash, tenant_id, user_id, expires_at, created_at FROM sso_ticket WHERE ticket_hash = ? FOR UPDATE",
+		ticketHash,
+	).Scan(&out.TicketHash, &out.TenantID, &out.UserID, &out.ExpiresAt, &out.CreatedAt)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.SSOTicket{}, kerrors.NotFound("SSO_TICKET_NOT_FOUND", "ticket not found")
+		}
+		return biz.SSOTicket{}, err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM sso_ticket WHERE ticket_hash = ?", ticketHash); err != nil {
+		return biz.SSOTicket{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return biz.SSOTicket{}, err
+	}
+	return out, nil
+}
+
+func (r *ssoRepo) FindIdentityUser(ctx context.Context, tenantID string, issuer string, subject string) (string, error) {
+	var userID string
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT user_id FROM user_identity WHERE tenant_id = ? AND issuer = ? AND subject = ?",
+		tenantID,
+		issuer,
+		subject,
+	).Scan(&userID)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return "", kerrors.NotFound("SSO_IDENTITY_NOT_FOUND", "identity not linked")
+		}
+		return "", err
+	}
+	return userID, nil
+}
+
+func (r *ssoRepo) LinkIdentity(ctx context.Context, tenantID string, userID string, identity biz.SSOIdentity) error {
+	now := time.Now()
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO user_identity (id, tenant_id, user_id, protocol, issuer, subject, email, last_login_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE email = VALUES(email), last_login_at = VALUES(last_login_at)`,
+		uuid.NewString(),
+		tenantID,
+		userID,
+		identity.Protocol,
+		identity.Issuer,
+		identity.Subject,
+		emptyToNull(identity.Email),
+		now,
+		now,
+	)
+	return err
+}
+
+func (r *ssoRepo) CreateSSOUser(ctx context.Context, user biz.TenantAccount) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		"INSERT INTO `user` (id, tenant_id, email, phone, name, status, password_hash, created_at) VALUES (?, ?, ?, NULL, ?, ?, NULL, ?)",
+		user.ID,
+		user.TenantID,
+		emptyToNull(user.Email),
+		emptyToNull(user.Name),
+		user.Status,
+		time.Now(),
+	)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if stderrors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			// Emails are unique across tenants.
+			return kerrors.Conflict("ACCOUNT_ALREADY_BOUND", "account already bound to another tenant")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *ssoRepo) SyncMappedRoles(ctx context.Context, tenantID string, userID string, managed []string, granted []string) error {
+	keep := make(map[string]bool, len(granted))
+	for _, id := range granted {
+		keep[id] = true
+	}
+	revoke := make([]any, 0, len(managed))
+	for _, id := range managed {
+		if !keep[id] {
+			revoke = append(revoke, id)
+		}
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if len(revoke) > 0 {
+		if _, err := tx.ExecContext(
+			ctx,
+			"DELETE FROM user_role WHERE user_id = ? AND role_id IN ("+placeholders(len(revoke))+")",
+			append([]any{userID}, revoke...)...,
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			"DELETE FROM user_role_scope WHERE user_id = ? AND role_id IN ("+placeholders(len(revoke))+")",
+			append([]any{userID}, revoke...)...,
+		); err != nil {
+			return err
+		}
+	}
+	for _, roleID := range granted {
+		// The role must still belong to the tenant.
+		if _, err := tx.ExecContext(
+			ctx,
+			"INSERT IGNORE INTO user_role (user_id, role_id) SELECT ?, id FROM `role` WHERE id = ? AND tenant_id = ?",
+			userID,
+			roleID,
+			tenantID,
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
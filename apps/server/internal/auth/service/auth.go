@@ -7,6 +7,7 @@ import (
 	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/google/wire"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -14,7 +15,8 @@ import (
 type ConsoleAuthService struct {
 	v1.UnimplementedConsoleAuthServer
 
-	uc *biz.AuthUsecase
+	uc  *biz.AuthUsecase
+	sso *biz.SSOUsecase
 }
 
 // PlatformAuthService handles platform auth endpoints.
@@ -25,8 +27,8 @@ type PlatformAuthService struct {
 }
 
 // NewConsoleAuthService creates a new ConsoleAuthService.
-func NewConsoleAuthService(uc *biz.AuthUsecase) *ConsoleAuthService {
-	return &ConsoleAuthService{uc: uc}
+func NewConsoleAuthService(uc *biz.AuthUsecase, sso *biz.SSOUsecase) *ConsoleAuthService {
+	return &ConsoleAuthService{uc: uc, sso: sso}
 }
 
 // NewPlatformAuthService creates a new PlatformAuthService.
@@ -63,6 +65,82 @@ func (s *ConsoleAuthService) Register(ctx context.Context, req *v1.ConsoleRegist
 	return toAuthResponse(session), nil
 }
 
+func (s *ConsoleAuthService) Refresh(ctx context.Context, req *v1.RefreshTokenRequest) (*v1.AuthResponse, error) {
+	session, err := s.uc.RefreshSession(ctx, biz.SubjectTypeTenant, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+	return toAuthResponse(session), nil
+}
+
+func (s *ConsoleAuthService) Logout(ctx context.Context, _ *v1.LogoutRequest) (*emptypb.Empty, error) {
+	if err := s.uc.Logout(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConsoleAuthService) LogoutAll(ctx context.Context, _ *v1.LogoutRequest) (*emptypb.Empty, error) {
+	if err := s.uc.LogoutAll(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConsoleAuthService) StartSSO(ctx context.Context, req *v1.StartSSORequest) (*v1.StartSSOResponse, error) {
+	redirectURL, protocol, err := s.sso.StartLogin(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.StartSSOResponse{RedirectUrl: redirectURL, Protocol: protocol}, nil
+}
+
+func (s *ConsoleAuthService) ExchangeSSOTicket(ctx context.Context, req *v1.ExchangeSSOTicketRequest) (*v1.AuthResponse, error) {
+	session, err := s.sso.ExchangeTicket(ctx, req.GetTicket())
+	if err != nil {
+		return nil, err
+	}
+	return toAuthResponse(session), nil
+}
+
+func (s *ConsoleAuthService) VerifyMFA(ctx context.Context, req *v1.VerifyMFARequest) (*v1.AuthResponse, error) {
+	session, err := s.uc.VerifyMFA(ctx, biz.SubjectTypeTenant, req.GetMfaToken(), req.GetCode(), req.GetRecoveryCode())
+	if err != nil {
+		return nil, err
+	}
+	return toAuthResponse(session), nil
+}
+
+func (s *ConsoleAuthService) EnrollMFAChallenge(ctx context.Context, req *v1.EnrollMFAChallengeRequest) (*v1.MFAEnrollment, error) {
+	enrollment, err := s.uc.EnrollMFAChallenge(ctx, biz.SubjectTypeTenant, req.GetMfaToken())
+	if err != nil {
+		return nil, err
+	}
+	return toMFAEnrollment(enrollment), nil
+}
+
+func (s *ConsoleAuthService) AcceptInvite(ctx context.Context, req *v1.AcceptInviteRequest) (*v1.AuthResponse, error) {
+	session, err := s.uc.AcceptInvite(ctx, req.GetToken(), req.GetName(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	return toAuthResponse(session), nil
+}
+
+func (s *ConsoleAuthService) RequestPasswordReset(ctx context.Context, req *v1.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if err := s.uc.RequestPasswordReset(ctx, req.GetAccount(), req.GetTenantId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConsoleAuthService) ResetPassword(ctx context.Context, req *v1.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if err := s.uc.ResetPassword(ctx, req.GetToken(), req.GetPassword()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *PlatformAuthService) Login(ctx context.Context, req *v1.PlatformLoginRequest) (*v1.AuthResponse, error) {
 	if req == nil {
 		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
@@ -74,13 +152,61 @@ func (s *PlatformAuthService) Login(ctx context.Context, req *v1.PlatformLoginRe
 	return toAuthResponse(session), nil
 }
 
+func (s *PlatformAuthService) Refresh(ctx context.Context, req *v1.RefreshTokenRequest) (*v1.AuthResponse, error) {
+	session, err := s.uc.RefreshSession(ctx, biz.SubjectTypePlatform, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+	return toAuthResponse(session), nil
+}
+
+func (s *PlatformAuthService) Logout(ctx context.Context, _ *v1.LogoutRequest) (*emptypb.Empty, error) {
+	if err := s.uc.Logout(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PlatformAuthService) LogoutAll(ctx context.Context, _ *v1.LogoutRequest) (*emptypb.Empty, error) {
+	if err := s.uc.LogoutAll(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PlatformAuthService) VerifyMFA(ctx context.Context, req *v1.VerifyMFARequest) (*v1.AuthResponse, error) {
+	session, err := s.uc.VerifyMFA(ctx, biz.SubjectTypePlatform, req.GetMfaToken(), req.GetCode(), req.GetRecoveryCode())
+	if err != nil {
+		return nil, err
+	}
+	return toAuthResponse(session), nil
+}
+
+func (s *PlatformAuthService) EnrollMFAChallenge(ctx context.Context, req *v1.EnrollMFAChallengeRequest) (*v1.MFAEnrollment, error) {
+	enrollment, err := s.uc.EnrollMFAChallenge(ctx, biz.SubjectTypePlatform, req.GetMfaToken())
+	if err != nil {
+		return nil, err
+	}
+	return toMFAEnrollment(enrollment), nil
+}
+
 func toAuthResponse(session biz.AuthSession) *v1.AuthResponse {
+	if session.MFAToken != "" {
+		return &v1.AuthResponse{
+			MfaRequired:           true,
+			MfaToken:              session.MFAToken,
+			MfaExpiresAt:          timestamppb.New(session.MFAExpiresAt),
+			MfaEnrollmentRequired: session.MFAEnrollmentRequired,
+		}
+	}
 	if session.Token == "" {
 		return nil
 	}
 	return &v1.AuthResponse{
-		Token:     session.Token,
-		ExpiresAt: timestamppb.New(session.ExpiresAt),
+		Token:            session.Token,
+		ExpiresAt:        timestamppb.New(session.ExpiresAt),
+		RefreshToken:     session.RefreshToken,
+		RefreshExpiresAt: timestamppb.New(session.RefreshExpiresAt),
 		Profile: &v1.AuthProfile{
 			SubjectId: session.Profile.SubjectID,
 			TenantId:  session.Profile.TenantID,
@@ -88,8 +214,9 @@ func toAuthResponse(session biz.AuthSession) *v1.AuthResponse {
 			Name:      session.Profile.Name,
 			Roles:     session.Profile.Roles,
 		},
+		RecoveryCodes: session.RecoveryCodes,
 	}
 }
 
 // ProviderSet is auth service providers.
-var ProviderSet = wire.NewSet(NewConsoleAuthService, NewPlatformAuthService)
+var ProviderSet = wire.NewSet(NewConsoleAuthService, NewPlatformAuthService, NewSSOService, NewConsoleMFAService, NewPlatformMFAService, NewPlatformImpersonationService, NewConsoleImpersonationService, NewJWKSService)
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/auth/v1"
+	auditbiz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
+	iambiz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PlatformImpersonationService issues tenant console tokens to platform
+// admins.
+type PlatformImpersonationService struct {
+	v1.UnimplementedPlatformImpersonationServer
+
+	uc    *biz.AuthUsecase
+	iamUC *iambiz.IAMUsecase
+}
+
+// ConsoleImpersonationService lists the impersonation sessions of a tenant.
+type ConsoleImpersonationService struct {
+	v1.UnimplementedConsoleImpersonationServer
+
+	uc    *biz.AuthUsecase
+	iamUC *iambiz.IAMUsecase
+}
+
+// NewPlatformImpersonationService creates a new PlatformImpersonationService.
+func NewPlatformImpersonationService(uc *biz.AuthUsecase, iamUC *iambiz.IAMUsecase) *PlatformImpersonationService {
+	return &PlatformImpersonationService{uc: uc, iamUC: iamUC}
+}
+
+// NewConsoleImpersonationService creates a new ConsoleImpersonationService.
+func NewConsoleImpersonationService(uc *biz.AuthUsecase, iamUC *iambiz.IAMUsecase) *ConsoleImpersonationService {
+	return &ConsoleImpersonationService{uc: uc, iamUC: iamUC}
+}
+
+func (s *PlatformImpersonationService) ImpersonateTenant(ctx context.Context, req *v1.ImpersonateTenantRequest) (*v1.ImpersonateTenantResponse, error) {
+	if err := s.iamUC.RequirePermission(ctx, biz.PermissionTenantImpersonate); err != nil {
+		return nil, err
+	}
+	if req.GetWrite() {
+		if err := s.iamUC.RequirePermission(ctx, biz.PermissionTenantImpersonateWrite); err != nil {
+			return nil, err
+		}
+	}
+	out, err := s.uc.Impersonate(ctx, biz.ImpersonationInput{
+		TenantID: req.GetTenantId(),
+		Reason:   req.GetReason(),
+		Write:    req.GetWrite(),
+		TTL:      time.Duration(req.GetTtlSeconds()) * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ImpersonateTenantResponse{
+		Token:     out.Token,
+		ExpiresAt: timestamppb.New(out.ExpiresAt),
+		Session:   toImpersonationSession(out.Session),
+	}, nil
+}
+
+func (s *PlatformImpersonationService) ListImpersonationSessions(ctx context.Context, req *v1.ListImpersonationSessionsRequest) (*v1.ListImpersonationSessionsResponse, error) {
+	if err := s.iamUC.RequirePermission(ctx, iambiz.PermissionTenantRead); err != nil {
+		return nil, err
+	}
+	return listImpersonationSessions(ctx, s.uc, req.GetTenantId(), req)
+}
+
+func (s *PlatformImpersonationService) RevokeImpersonationSession(ctx context.Context, req *v1.RevokeImpersonationSessionRequest) (*v1.ImpersonationSessionResponse, error) {
+	if err := s.iamUC.RequirePermission(ctx, biz.PermissionTenantImpersonate); err != nil {
+		return nil, err
+	}
+	session, err := s.uc.RevokeImpersonationSession(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ImpersonationSessionResponse{Session: toImpersonationSession(session)}, nil
+}
+
+func (s *ConsoleImpersonationService) ListImpersonationSessions(ctx context.Context, req *v1.ListImpersonationSessionsRequest) (*v1.ListImpersonationSessionsResponse, error) {
+	if err := s.iamUC.RequirePermission(ctx, auditbiz.PermissionAuditLogRead); err != nil {
+		return nil, err
+	}
+	return listImpersonationSessions(ctx, s.uc, "", req)
+}
+
+func listImpersonationSessions(ctx context.Context, uc *biz.AuthUsecase, tenantID string, req *v1.ListImpersonationSessionsRequest) (*v1.ListImpersonationSessionsResponse, error) {
+	items, err := uc.ListImpersonationSessions(ctx, tenantID, int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*v1.ImpersonationSession, 0, len(items))
+	for _, item := range items {
+		out = append(out, toImpersonationSession(item))
+	}
+	return &v1.ListImpersonationSessionsResponse{Items: out}, nil
+}
+
+func toImpersonationSession(session biz.ImpersonationSession) *v1.ImpersonationSession {
+	out := &v1.ImpersonationSession{
+		Id:        session.ID,
+		TenantId:  session.TenantID,
+		AdminId:   session.AdminID,
+		AdminName: session.AdminName,
+		Reason:    session.Reason,
+		ReadOnly:  session.ReadOnly,
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+		CreatedAt: timestamppb.New(session.CreatedAt),
+	}
+	if !session.RevokedAt.IsZero() {
+		out.RevokedAt = timestamppb.New(session.RevokedAt)
+	}
+	return out
+}
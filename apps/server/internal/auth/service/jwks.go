@@ -0,0 +1,30 @@
+package service
+
+import (
+	"net/http"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// JWKSService publishes the public keys that verify access tokens.
+type JWKSService struct {
+	uc *biz.AuthUsecase
+}
+
+// NewJWKSService creates a new JWKSService.
+func NewJWKSService(uc *biz.AuthUsecase) *JWKSService {
+	return &JWKSService{uc: uc}
+}
+
+// JWKS serves /.well-known/jwks.json.
+func (s *JWKSService) JWKS(ctx khttp.Context) error {
+	keys, err := s.uc.JWKS(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+	// Verifiers refetch on an unknown kid; the next key is published ahead of
+	// its activation, so a short cache is safe.
+	ctx.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return ctx.JSON(http.StatusOK, keys)
+}
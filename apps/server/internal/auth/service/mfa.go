@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/auth/v1"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
+	iambiz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ConsoleMFAService handles MFA enrollment and the MFA policy of a tenant.
+type ConsoleMFAService struct {
+	v1.UnimplementedConsoleMFAServer
+
+	mfa mfaHandler
+}
+
+// PlatformMFAService handles MFA enrollment and the platform MFA policy.
+type PlatformMFAService struct {
+	v1.UnimplementedPlatformMFAServer
+
+	mfa mfaHandler
+}
+
+// NewConsoleMFAService creates a new ConsoleMFAService.
+func NewConsoleMFAService(uc *biz.AuthUsecase, iamUC *iambiz.IAMUsecase) *ConsoleMFAService {
+	return &ConsoleMFAService{mfa: mfaHandler{
+		uc:              uc,
+		iamUC:           iamUC,
+		subjectType:     biz.SubjectTypeTenant,
+		policyReadPerm:  biz.PermissionTenantMFAPolicyRead,
+		policyWritePerm: biz.PermissionTenantMFAPolicyWrite,
+	}}
+}
+
+// NewPlatformMFAService creates a new PlatformMFAService.
+func NewPlatformMFAService(uc *biz.AuthUsecase, iamUC *iambiz.IAMUsecase) *PlatformMFAService {
+	return &PlatformMFAService{mfa: mfaHandler{
+		uc:              uc,
+		iamUC:           iamUC,
+		subjectType:     biz.SubjectTypePlatform,
+		policyReadPerm:  biz.PermissionPlatformMFAPolicyRead,
+		policyWritePerm: biz.PermissionPlatformMFAPolicyWrite,
+	}}
+}
+
+func (s *ConsoleMFAService) GetMFAStatus(ctx context.Context, _ *v1.GetMFAStatusRequest) (*v1.MFAStatus, error) {
+	return s.mfa.getStatus(ctx)
+}
+
+func (s *ConsoleMFAService) EnrollMFA(ctx context.Context, _ *v1.EnrollMFARequest) (*v1.MFAEnrollment, error) {
+	return s.mfa.enroll(ctx)
+}
+
+func (s *ConsoleMFAService) ConfirmMFA(ctx context.Context, req *v1.ConfirmMFARequest) (*v1.MFARecoveryCodes, error) {
+	return s.mfa.confirm(ctx, req)
+}
+
+func (s *ConsoleMFAService) DisableMFA(ctx context.Context, req *v1.DisableMFARequest) (*emptypb.Empty, error) {
+	return s.mfa.disable(ctx, req)
+}
+
+func (s *ConsoleMFAService) RegenerateRecoveryCodes(ctx context.Context, req *v1.RegenerateRecoveryCodesRequest) (*v1.MFARecoveryCodes, error) {
+	return s.mfa.regenerateRecoveryCodes(ctx, req)
+}
+
+func (s *ConsoleMFAService) GetMFAPolicy(ctx context.Context, _ *v1.GetMFAPolicyRequest) (*v1.MFAPolicyResponse, error) {
+	return s.mfa.getPolicy(ctx)
+}
+
+func (s *ConsoleMFAService) UpdateMFAPolicy(ctx context.Context, req *v1.UpdateMFAPolicyRequest) (*v1.MFAPolicyResponse, error) {
+	return s.mfa.updatePolicy(ctx, req)
+}
+
+func (s *PlatformMFAService) GetMFAStatus(ctx context.Context, _ *v1.GetMFAStatusRequest) (*v1.MFAStatus, error) {
+	return s.mfa.getStatus(ctx)
+}
+
+func (s *PlatformMFAService) EnrollMFA(ctx context.Context, _ *v1.EnrollMFARequest) (*v1.MFAEnrollment, error) {
+	return s.mfa.enroll(ctx)
+}
+
+func (s *PlatformMFAService) ConfirmMFA(ctx context.Context, req *v1.ConfirmMFARequest) (*v1.MFARecoveryCodes, error) {
+	return s.mfa.confirm(ctx, req)
+}
+
+func (s *PlatformMFAService) DisableMFA(ctx context.Context, req *v1.DisableMFARequest) (*emptypb.Empty, error) {
+	return s.mfa.disable(ctx, req)
+}
+
+func (s *PlatformMFAService) RegenerateRecoveryCodes(ctx context.Context, req *v1.RegenerateRecoveryCodesRequest) (*v1.MFARecoveryCodes, error) {
+	return s.mfa.regenerateRecoveryCodes(ctx, req)
+}
+
+func (s *PlatformMFAService) GetMFAPolicy(ctx context.Context, _ *v1.GetMFAPolicyRequest) (*v1.MFAPolicyResponse, error) {
+	return s.mfa.getPolicy(ctx)
+}
+
+func (s *PlatformMFAService) UpdateMFAPolicy(ctx context.Context, req *v1.UpdateMFAPolicyRequest) (*v1.MFAPolicyResponse, error) {
+	return s.mfa.updatePolicy(ctx, req)
+}
+
+// mfaHandler serves the MFA endpoints of one subject type; the console and
+// platform services only differ in it and the policy permissions.
+type mfaHandler struct {
+	uc              *biz.AuthUsecase
+	iamUC           *iambiz.IAMUsecase
+	subjectType     string
+	policyReadPerm  string
+	policyWritePerm string
+}
+
+func (h mfaHandler) getStatus(ctx context.Context) (*v1.MFAStatus, error) {
+	status, err := h.uc.GetMFAStatus(ctx, h.subjectType)
+	if err != nil {
+		return nil, err
+	}
+	out := &v1.MFAStatus{
+		Enabled:                status.Enabled,
+		Pending:                status.Pending,
+		Required:               status.Required,
+		RecoveryCodesRemaining: int32(status.RecoveryCodesRemaining),
+	}
+	if !status.ConfirmedAt.IsZero() {
+		out.ConfirmedAt = timestamppb.New(status.ConfirmedAt)
+	}
+	return out, nil
+}
+
+func (h mfaHandler) enroll(ctx context.Context) (*v1.MFAEnrollment, error) {
+	enrollment, err := h.uc.EnrollMFA(ctx, h.subjectType)
+	if err != nil {
+		return nil, err
+	}
+	return toMFAEnrollment(enrollment), nil
+}
+
+func (h mfaHandler) confirm(ctx context.Context, req *v1.ConfirmMFARequest) (*v1.MFARecoveryCodes, error) {
+	codes, err := h.uc.ConfirmMFA(ctx, h.subjectType, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MFARecoveryCodes{Codes: codes}, nil
+}
+
+func (h mfaHandler) disable(ctx context.Context, req *v1.DisableMFARequest) (*emptypb.Empty, error) {
+	if err := h.uc.DisableMFA(ctx, h.subjectType, req.GetCode(), req.GetRecoveryCode()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h mfaHandler) regenerateRecoveryCodes(ctx context.Context, req *v1.RegenerateRecoveryCodesRequest) (*v1.MFARecoveryCodes, error) {
+	codes, err := h.uc.RegenerateRecoveryCodes(ctx, h.subjectType, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MFARecoveryCodes{Codes: codes}, nil
+}
+
+func (h mfaHandler) getPolicy(ctx context.Context) (*v1.MFAPolicyResponse, error) {
+	if err := h.iamUC.RequirePermission(ctx, h.policyReadPerm); err != nil {
+		return nil, err
+	}
+	policy, err := h.uc.GetMFAPolicy(ctx, h.subjectType)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MFAPolicyResponse{Policy: toMFAPolicy(policy)}, nil
+}
+
+func (h mfaHandler) updatePolicy(ctx context.Context, req *v1.UpdateMFAPolicyRequest) (*v1.MFAPolicyResponse, error) {
+	if err := h.iamUC.RequirePermission(ctx, h.policyWritePerm); err != nil {
+		return nil, err
+	}
+	in := req.GetPolicy()
+	if in == nil {
+		return nil, errors.BadRequest("MFA_POLICY_INVALID", "policy required")
+	}
+	policy, err := h.uc.UpdateMFAPolicy(ctx, h.subjectType, biz.MFAPolicy{
+		RequireAll:      in.GetRequireAll(),
+		RequiredRoleIDs: in.GetRequiredRoleIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MFAPolicyResponse{Policy: toMFAPolicy(policy)}, nil
+}
+
+func toMFAEnrollment(enrollment biz.MFAEnrollment) *v1.MFAEnrollment {
+	return &v1.MFAEnrollment{Secret: enrollment.Secret, ProvisioningUri: enrollment.ProvisioningURI}
+}
+
+func toMFAPolicy(policy biz.MFAPolicy) *v1.MFAPolicy {
+	out := &v1.MFAPolicy{RequireAll: policy.RequireAll, RequiredRoleIds: policy.RequiredRoleIDs}
+	if !policy.UpdatedAt.IsZero() {
+		out.UpdatedAt = timestamppb.New(policy.UpdatedAt)
+	}
+	return out
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/auth/v1"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
+	iambiz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxSAMLResponseBytes bounds the form posted to the ACS.
+const maxSAMLResponseBytes = 1 << 20
+
+// SSOService handles tenant SSO configuration and the IdP callbacks.
+type SSOService struct {
+	v1.UnimplementedConsoleSSOServer
+
+	uc    *biz.SSOUsecase
+	iamUC *iambiz.IAMUsecase
+	log   *log.Helper
+}
+
+// NewSSOService creates a new SSOService.
+func NewSSOService(uc *biz.SSOUsecase, iamUC *iambiz.IAMUsecase, logger log.Logger) *SSOService {
+	return &SSOService{uc: uc, iamUC: iamUC, log: log.NewHelper(logger)}
+}
+
+func (s *SSOService) GetSSOConfig(ctx context.Context, _ *v1.GetSSOConfigRequest) (*v1.SSOConfigResponse, error) {
+	if err := s.iamUC.RequirePermission(ctx, biz.PermissionSSORead); err != nil {
+		return nil, err
+	}
+	cfg, err := s.uc.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SSOConfigResponse{Config: s.toSSOConfig(cfg)}, nil
+}
+
+func (s *SSOService) UpdateSSOConfig(ctx context.Context, req *v1.UpdateSSOConfigRequest) (*v1.SSOConfigResponse, error) {
+	if err := s.iamUC.RequirePermission(ctx, biz.PermissionSSOWrite); err != nil {
+		return nil, err
+	}
+	in := req.GetConfig()
+	if in == nil {
+		return nil, errors.BadRequest("SSO_CONFIG_INVALID", "config required")
+	}
+	mappings := make([]biz.SSORoleMapping, 0, len(in.GetRoleMappings()))
+	for _, item := range in.GetRoleMappings() {
+		mappings = append(mappings, biz.SSORoleMapping{Claim: item.GetClaim(), Value: item.GetValue(), RoleID: item.GetRoleId()})
+	}
+	cfg, err := s.uc.UpdateConfig(ctx, biz.SSOConfig{
+		Protocol:           in.GetProtocol(),
+		Enabled:            in.GetEnabled(),
+		EnforceSSO:         in.GetEnforceSso(),
+		JITProvisioning:    in.GetJitProvisioning(),
+		DefaultRoleID:      in.GetDefaultRoleId(),
+		OIDCIssuer:         in.GetOidcIssuer(),
+		OIDCClientID:       in.GetOidcClientId(),
+		OIDCClientSecret:   in.GetOidcClientSecret(),
+		OIDCScopes:         in.GetOidcScopes(),
+		SAMLIdPEntityID:    in.GetSamlIdpEntityId(),
+		SAMLIdPSSOURL:      in.GetSamlIdpSsoUrl(),
+		SAMLIdPCertificate: in.GetSamlIdpCertificate(),
+		EmailAttribute:     in.GetEmailAttribute(),
+		NameAttribute:      in.GetNameAttribute(),
+		RoleMappings:       mappings,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SSOConfigResponse{Config: s.toSSOConfig(cfg)}, nil
+}
+
+// OIDCCallback receives the authorization response of the IdP and sends the
+// browser on to the dashboard with a login ticket or an error reason.
+func (s *SSOService) OIDCCallback(ctx khttp.Context) error {
+	query := ctx.Request().URL.Query()
+	idpError := strings.TrimSpace(query.Get("error"))
+	if desc := strings.TrimSpace(query.Get("error_description")); idpError != "" && desc != "" {
+		idpError += ": " + desc
+	}
+	ticket, err := s.uc.CompleteOIDC(ctx.Request().Context(), query.Get("state"), query.Get("code"), idpError)
+	return s.redirect(ctx, ticket, err)
+}
+
+// SAMLACS receives the HTTP-POST binding response of the IdP.
+func (s *SSOService) SAMLACS(ctx khttp.Context) error {
+	req := ctx.Request()
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, maxSAMLResponseBytes)
+	if err := req.ParseForm(); err != nil {
+		return s.redirect(ctx, "", errors.BadRequest("SSO_ASSERTION_INVALID", "invalid form"))
+	}
+	ticket, err := s.uc.CompleteSAML(req.Context(), req.PostForm.Get("SAMLResponse"), req.PostForm.Get("RelayState"))
+	return s.redirect(ctx, ticket, err)
+}
+
+// SAMLMetadata serves the SP metadata of a tenant.
+func (s *SSOService) SAMLMetadata(ctx khttp.Context) error {
+	metadata, err := s.uc.SAMLMetadata(ctx.Request().Context(), ctx.Vars().Get("tenant_id"))
+	if err != nil {
+		return err
+	}
+	w := ctx.Response()
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(metadata)
+	return err
+}
+
+func (s *SSOService) redirect(ctx khttp.Context, ticket string, err error) error {
+	if err != nil {
+		s.log.Warnf("sso login failed: %v", err)
+	}
+	http.Redirect(ctx.Response(), ctx.Request(), s.uc.CallbackURL(ticket, err), http.StatusFound)
+	return nil
+}
+
+func (s *SSOService) toSSOConfig(cfg biz.SSOConfig) *v1.SSOConfig {
+	mappings := make([]*v1.SSORoleMapping, 0, len(cfg.RoleMappings))
+	for _, item := range cfg.RoleMappings {
+		mappings = append(mappings, &v1.SSORoleMapping{Claim: item.Claim, Value: item.Value, RoleId: item.RoleID})
+	}
+	endpoints := s.uc.Endpoints(cfg.TenantID)
+	out := &v1.SSOConfig{
+		Protocol:            cfg.Protocol,
+		Enabled:             cfg.Enabled,
+		EnforceSso:          cfg.EnforceSSO,
+		JitProvisioning:     cfg.JITProvisioning,
+		DefaultRoleId:       cfg.DefaultRoleID,
+		OidcIssuer:          cfg.OIDCIssuer,
+		OidcClientId:        cfg.OIDCClientID,
+		OidcClientSecretSet: cfg.OIDCClientSecret != "",
+		OidcScopes:          cfg.OIDCScopes,
+		SamlIdpEntityId:     cfg.SAMLIdPEntityID,
+		SamlIdpSsoUrl:       cfg.SAMLIdPSSOURL,
+		SamlIdpCertificate:  cfg.SAMLIdPCertificate,
+		EmailAttribute:      cfg.EmailAttribute,
+		NameAttribute:       cfg.NameAttribute,
+		RoleMappings:        mappings,
+		OidcRedirectUri:     endpoints.OIDCRedirectURI,
+		SamlSpEntityId:      endpoints.SAMLEntityID,
+		SamlAcsUrl:          endpoints.SAMLACSURL,
+	}
+	if !cfg.UpdatedAt.IsZero() {
+		out.UpdatedAt = timestamppb.New(cfg.UpdatedAt)
+	}
+	return out
+}
@@ -3,9 +3,11 @@ package biz
 import (
 	analyticsbiz "github.com/ZTH7/RagoDesk/apps/server/internal/analytics/biz"
 	apimgmtbiz "github.com/ZTH7/RagoDesk/apps/server/internal/apimgmt/biz"
+	auditbiz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
 	authbiz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
 	botbiz "github.com/ZTH7/RagoDesk/apps/server/internal/bot/biz"
 	conversationbiz "github.com/ZTH7/RagoDesk/apps/server/internal/conversation/biz"
+	credentialbiz "github.com/ZTH7/RagoDesk/apps/server/internal/credential/biz"
 	iambiz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
 	knowledgebiz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
 	ragbiz "github.com/ZTH7/RagoDesk/apps/server/internal/rag/biz"
@@ -17,9 +19,11 @@ import (
 var ProviderSet = wire.NewSet(
 	analyticsbiz.ProviderSet,
 	apimgmtbiz.ProviderSet,
+	auditbiz.ProviderSet,
 	authbiz.ProviderSet,
 	botbiz.ProviderSet,
 	conversationbiz.ProviderSet,
+	credentialbiz.ProviderSet,
 	iambiz.ProviderSet,
 	knowledgebiz.ProviderSet,
 	ragbiz.ProviderSet,
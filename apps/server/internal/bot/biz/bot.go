@@ -30,9 +30,14 @@ const (
 
 // BotRepo defines bot persistence operations.
 type BotRepo interface {
+	// CreateBot checks the bot limit of the plan and inserts in one
+	// transaction; it fails with PLAN_LIMIT_EXCEEDED when the plan has no
+	// room left.
 	CreateBot(ctx context.Context, bot Bot) (Bot, error)
 	GetBot(ctx context.Context, id string) (Bot, error)
-	ListBots(ctx context.Context, limit int, offset int) ([]Bot, error)
+	// ListBots lists the bots of the tenant, only those in botIDs unless it
+	// is nil.
+	ListBots(ctx context.Context, botIDs []string, limit int, offset int) ([]Bot, error)
 	UpdateBot(ctx context.Context, bot Bot) (Bot, error)
 	DeleteBot(ctx context.Context, id string) error
 }
@@ -70,7 +75,7 @@ func (uc *BotUsecase) GetBot(ctx context.Context, id string) (Bot, error) {
 	return uc.repo.GetBot(ctx, id)
 }
 
-func (uc *BotUsecase) ListBots(ctx context.Context, limit int, offset int) ([]Bot, error) {
+func (uc *BotUsecase) ListBots(ctx context.Context, botIDs []string, limit int, offset int) ([]Bot, error) {
 	if limit <= 0 {
 		limit = 50
 	}
@@ -80,7 +85,7 @@ func (uc *BotUsecase) ListBots(ctx context.Context, limit int, offset int) ([]Bo
 	if offset < 0 {
 		offset = 0
 	}
-	return uc.repo.ListBots(ctx, limit, offset)
+	return uc.repo.ListBots(ctx, botIDs, limit, offset)
 }
 
 func (uc *BotUsecase) UpdateBot(ctx context.Context, bot Bot) (Bot, error) {
@@ -9,6 +9,7 @@ import (
 
 	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
 	biz "github.com/ZTH7/RagoDesk/apps/server/internal/bot/biz"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -44,7 +45,15 @@ func (r *botRepo) CreateBot(ctx context.Context, bot biz.Bot) (biz.Bot, error) {
 		bot.CreatedAt = now
 	}
 	bot.UpdatedAt = now
-	_, err = r.db.ExecContext(
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return biz.Bot{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := internaldata.ReserveUsage(ctx, tx, tenantID, plan.ResourceBots, 1); err != nil {
+		return biz.Bot{}, err
+	}
+	_, err = tx.ExecContext(
 		ctx,
 		"INSERT INTO bot (id, tenant_id, name, description, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
 		bot.ID,
@@ -62,6 +71,9 @@ func (r *botRepo) CreateBot(ctx context.Context, bot biz.Bot) (biz.Bot, error) {
 		}
 		return biz.Bot{}, err
 	}
+	if err := tx.Commit(); err != nil {
+		return biz.Bot{}, err
+	}
 	return bot, nil
 }
 
@@ -86,18 +98,25 @@ func (r *botRepo) GetBot(ctx context.Context, id string) (biz.Bot, error) {
 	return bot, nil
 }
 
-func (r *botRepo) ListBots(ctx context.Context, limit int, offset int) ([]biz.Bot, error) {
+func (r *botRepo) ListBots(ctx context.Context, botIDs []string, limit int, offset int) ([]biz.Bot, error) {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
 		return nil, err
 	}
-	rows, err := r.db.QueryContext(
-		ctx,
-		"SELECT id, tenant_id, name, description, status, created_at, updated_at FROM bot WHERE tenant_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?",
-		tenantID,
-		limit,
-		offset,
-	)
+	query := "SELECT id, tenant_id, name, description, status, created_at, updated_at FROM bot WHERE tenant_id = ?"
+	args := []any{tenantID}
+	if botIDs != nil {
+		if len(botIDs) == 0 {
+			return []biz.Bot{}, nil
+		}
+		query += " AND id IN (" + strings.TrimSuffix(strings.Repeat("?, ", len(botIDs)), ", ") + ")"
+		for _, id := range botIDs {
+			args = append(args, id)
+		}
+	}
+	query += " ORDER BY created_at DESC LIMIT ? OFFSET ?"
+	args = append(args, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +51,7 @@ func (s *BotService) GetBot(ctx context.Context, req *v1.GetBotRequest) (*v1.Bot
 		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
 	}
 	if s.iamUC != nil {
-		if err := s.iamUC.RequirePermission(ctx, botbiz.PermissionBotRead); err != nil {
+		if err := s.iamUC.RequirePermission(ctx, botbiz.PermissionBotRead, iambiz.BotRef(req.GetId())); err != nil {
 			return nil, err
 		}
 	}
@@ -63,12 +63,17 @@ func (s *BotService) GetBot(ctx context.Context, req *v1.GetBotRequest) (*v1.Bot
 }
 
 func (s *BotService) ListBots(ctx context.Context, req *v1.ListBotsRequest) (*v1.ListBotsResponse, error) {
+	var botIDs []string
 	if s.iamUC != nil {
-		if err := s.iamUC.RequirePermission(ctx, botbiz.PermissionBotRead); err != nil {
+		visible, err := s.iamUC.VisibleResources(ctx, botbiz.PermissionBotRead, iambiz.ResourceTypeBot)
+		if err != nil {
 			return nil, err
 		}
+		if !visible.All {
+			botIDs = visible.IDs
+		}
 	}
-	bots, err := s.uc.ListBots(ctx, int(req.GetLimit()), int(req.GetOffset()))
+	bots, err := s.uc.ListBots(ctx, botIDs, int(req.GetLimit()), int(req.GetOffset()))
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +89,7 @@ func (s *BotService) UpdateBot(ctx context.Context, req *v1.UpdateBotRequest) (*
 		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
 	}
 	if s.iamUC != nil {
-		if err := s.iamUC.RequirePermission(ctx, botbiz.PermissionBotWrite); err != nil {
+		if err := s.iamUC.RequirePermission(ctx, botbiz.PermissionBotWrite, iambiz.BotRef(req.GetId())); err != nil {
 			return nil, err
 		}
 	}
@@ -105,7 +110,7 @@ func (s *BotService) DeleteBot(ctx context.Context, req *v1.DeleteBotRequest) (*
 		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
 	}
 	if s.iamUC != nil {
-		if err := s.iamUC.RequirePermission(ctx, botbiz.PermissionBotDelete); err != nil {
+		if err := s.iamUC.RequirePermission(ctx, botbiz.PermissionBotDelete, iambiz.BotRef(req.GetId())); err != nil {
 			return nil, err
 		}
 	}
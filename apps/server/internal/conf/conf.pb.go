@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -79,6 +80,7 @@ type Server struct {
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
 	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
 	Auth          *Server_Auth           `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
+	Mail          *Server_Mail           `protobuf:"bytes,4,opt,name=mail,proto3" json:"mail,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -134,6 +136,13 @@ func (x *Server) GetAuth() *Server_Auth {
 	return nil
 }
 
+func (x *Server) GetMail() *Server_Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Proxy         string                 `protobuf:"bytes,10,opt,name=proxy,proto3" json:"proxy,omitempty"`
@@ -146,6 +155,8 @@ type Data struct {
 	Rag           *Data_Rag              `protobuf:"bytes,7,opt,name=rag,proto3" json:"rag,omitempty"`
 	Conversation  *Data_Conversation     `protobuf:"bytes,8,opt,name=conversation,proto3" json:"conversation,omitempty"`
 	Apimgmt       *Data_APIMgmt          `protobuf:"bytes,9,opt,name=apimgmt,proto3" json:"apimgmt,omitempty"`
+	Audit         *Data_Audit            `protobuf:"bytes,11,opt,name=audit,proto3" json:"audit,omitempty"`
+	Credentials   *Data_Credentials      `protobuf:"bytes,12,opt,name=credentials,proto3" json:"credentials,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -250,6 +261,20 @@ func (x *Data) GetApimgmt() *Data_APIMgmt {
 	return nil
 }
 
+func (x *Data) GetAudit() *Data_Audit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *Data) GetCredentials() *Data_Credentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -371,12 +396,34 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 }
 
 type Server_Auth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JwtSecret     string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
-	Issuer        string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	Audience      string                 `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JwtSecret string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
+	Issuer    string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Audience  string                 `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"`
+	// Lifetime of access tokens; defaults to 15m.
+	AccessTokenTtl *durationpb.Duration `protobuf:"bytes,4,opt,name=access_token_ttl,json=accessTokenTtl,proto3" json:"access_token_ttl,omitempty"`
+	// Lifetime of refresh tokens, renewed on every rotation; defaults to 720h.
+	RefreshTokenTtl *durationpb.Duration `protobuf:"bytes,5,opt,name=refresh_token_ttl,json=refreshTokenTtl,proto3" json:"refresh_token_ttl,omitempty"`
+	Sso             *Server_SSO          `protobuf:"bytes,6,opt,name=sso,proto3" json:"sso,omitempty"`
+	// Access token signing algorithm: HS256 (default, signs with jwt_secret),
+	// RS256 or ES256 (signs with rotating keys published as a JWKS).
+	SigningAlgorithm string `protobuf:"bytes,7,opt,name=signing_algorithm,json=signingAlgorithm,proto3" json:"signing_algorithm,omitempty"`
+	// How long a signing key signs tokens before the next one takes over;
+	// defaults to 720h.
+	KeyRotationInterval *durationpb.Duration `protobuf:"bytes,8,opt,name=key_rotation_interval,json=keyRotationInterval,proto3" json:"key_rotation_interval,omitempty"`
+	// How early the next key is published in the JWKS before it signs;
+	// defaults to 24h.
+	KeyPublishAhead *durationpb.Duration `protobuf:"bytes,9,opt,name=key_publish_ahead,json=keyPublishAhead,proto3" json:"key_publish_ahead,omitempty"`
+	// How long an invitation link stays valid; defaults to 72h.
+	InviteTtl *durationpb.Duration `protobuf:"bytes,10,opt,name=invite_ttl,json=inviteTtl,proto3" json:"invite_ttl,omitempty"`
+	// How long a password reset link stays valid; defaults to 1h.
+	PasswordResetTtl *durationpb.Duration `protobuf:"bytes,11,opt,name=password_reset_ttl,json=passwordResetTtl,proto3" json:"password_reset_ttl,omitempty"`
+	// With RS256 or ES256, HS256 tokens signed with jwt_secret are accepted
+	// until this time to let sessions migrate; unset rejects them. Set it
+	// just past the access token lifetime after switching.
+	Hs256AcceptUntil *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=hs256_accept_until,json=hs256AcceptUntil,proto3" json:"hs256_accept_until,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Server_Auth) Reset() {
@@ -430,6 +477,205 @@ func (x *Server_Auth) GetAudience() string {
 	return ""
 }
 
+func (x *Server_Auth) GetAccessTokenTtl() *durationpb.Duration {
+	if x != nil {
+		return x.AccessTokenTtl
+	}
+	return nil
+}
+
+func (x *Server_Auth) GetRefreshTokenTtl() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshTokenTtl
+	}
+	return nil
+}
+
+func (x *Server_Auth) GetSso() *Server_SSO {
+	if x != nil {
+		return x.Sso
+	}
+	return nil
+}
+
+func (x *Server_Auth) GetSigningAlgorithm() string {
+	if x != nil {
+		return x.SigningAlgorithm
+	}
+	return ""
+}
+
+func (x *Server_Auth) GetKeyRotationInterval() *durationpb.Duration {
+	if x != nil {
+		return x.KeyRotationInterval
+	}
+	return nil
+}
+
+func (x *Server_Auth) GetKeyPublishAhead() *durationpb.Duration {
+	if x != nil {
+		return x.KeyPublishAhead
+	}
+	return nil
+}
+
+func (x *Server_Auth) GetInviteTtl() *durationpb.Duration {
+	if x != nil {
+		return x.InviteTtl
+	}
+	return nil
+}
+
+func (x *Server_Auth) GetPasswordResetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.PasswordResetTtl
+	}
+	return nil
+}
+
+func (x *Server_Auth) GetHs256AcceptUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Hs256AcceptUntil
+	}
+	return nil
+}
+
+type Server_SSO struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Externally reachable base URL of this server, used for the OIDC
+	// redirect URI and the SAML ACS and entity IDs.
+	PublicUrl string `protobuf:"bytes,1,opt,name=public_url,json=publicUrl,proto3" json:"public_url,omitempty"`
+	// Base URL of the dashboard; logins end at <dashboard_url>/console/sso/callback.
+	DashboardUrl string `protobuf:"bytes,2,opt,name=dashboard_url,json=dashboardUrl,proto3" json:"dashboard_url,omitempty"`
+	// How long a started login may take; defaults to 10m.
+	StateTtl      *durationpb.Duration `protobuf:"bytes,3,opt,name=state_ttl,json=stateTtl,proto3" json:"state_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_SSO) Reset() {
+	*x = Server_SSO{}
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_SSO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_SSO) ProtoMessage() {}
+
+func (x *Server_SSO) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_SSO.ProtoReflect.Descriptor instead.
+func (*Server_SSO) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *Server_SSO) GetPublicUrl() string {
+	if x != nil {
+		return x.PublicUrl
+	}
+	return ""
+}
+
+func (x *Server_SSO) GetDashboardUrl() string {
+	if x != nil {
+		return x.DashboardUrl
+	}
+	return ""
+}
+
+func (x *Server_SSO) GetStateTtl() *durationpb.Duration {
+	if x != nil {
+		return x.StateTtl
+	}
+	return nil
+}
+
+type Server_Mail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mail sender: "log" (default) logs each message, "file" writes it as
+	// an .eml file to dir.
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Dir    string `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	From   string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Base URL of the dashboard used in invitation and password reset
+	// links; defaults to auth.sso.dashboard_url.
+	DashboardUrl  string `protobuf:"bytes,4,opt,name=dashboard_url,json=dashboardUrl,proto3" json:"dashboard_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Mail) Reset() {
+	*x = Server_Mail{}
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Mail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Mail) ProtoMessage() {}
+
+func (x *Server_Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Mail.ProtoReflect.Descriptor instead.
+func (*Server_Mail) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *Server_Mail) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Server_Mail) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *Server_Mail) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Server_Mail) GetDashboardUrl() string {
+	if x != nil {
+		return x.DashboardUrl
+	}
+	return ""
+}
+
 type Data_Database struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
@@ -440,7 +686,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +698,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -494,7 +740,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -506,7 +752,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +808,7 @@ type Data_VectorDB struct {
 
 func (x *Data_VectorDB) Reset() {
 	*x = Data_VectorDB{}
-	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -574,7 +820,7 @@ func (x *Data_VectorDB) String() string {
 func (*Data_VectorDB) ProtoMessage() {}
 
 func (x *Data_VectorDB) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +876,7 @@ type Data_RabbitMQ struct {
 
 func (x *Data_RabbitMQ) Reset() {
 	*x = Data_RabbitMQ{}
-	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +888,7 @@ func (x *Data_RabbitMQ) String() string {
 func (*Data_RabbitMQ) ProtoMessage() {}
 
 func (x *Data_RabbitMQ) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +946,7 @@ type Data_ObjectStorage struct {
 
 func (x *Data_ObjectStorage) Reset() {
 	*x = Data_ObjectStorage{}
-	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +958,7 @@ func (x *Data_ObjectStorage) String() string {
 func (*Data_ObjectStorage) ProtoMessage() {}
 
 func (x *Data_ObjectStorage) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,13 +1021,14 @@ type Data_Knowledge struct {
 	Chunking      *Data_Knowledge_Chunking  `protobuf:"bytes,1,opt,name=chunking,proto3" json:"chunking,omitempty"`
 	Embedding     *Data_Knowledge_Embedding `protobuf:"bytes,2,opt,name=embedding,proto3" json:"embedding,omitempty"`
 	Ingestion     *Data_Knowledge_Ingestion `protobuf:"bytes,3,opt,name=ingestion,proto3" json:"ingestion,omitempty"`
+	Ocr           *Data_Knowledge_OCR       `protobuf:"bytes,5,opt,name=ocr,proto3" json:"ocr,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Data_Knowledge) Reset() {
 	*x = Data_Knowledge{}
-	mi := &file_internal_conf_conf_proto_msgTypes[11]
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -793,7 +1040,7 @@ func (x *Data_Knowledge) String() string {
 func (*Data_Knowledge) ProtoMessage() {}
 
 func (x *Data_Knowledge) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[11]
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,6 +1077,13 @@ func (x *Data_Knowledge) GetIngestion() *Data_Knowledge_Ingestion {
 	return nil
 }
 
+func (x *Data_Knowledge) GetOcr() *Data_Knowledge_OCR {
+	if x != nil {
+		return x.Ocr
+	}
+	return nil
+}
+
 type Data_Rag struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TimeoutMs     int32                  `protobuf:"varint,1,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
@@ -841,7 +1095,7 @@ type Data_Rag struct {
 
 func (x *Data_Rag) Reset() {
 	*x = Data_Rag{}
-	mi := &file_internal_conf_conf_proto_msgTypes[12]
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -853,7 +1107,7 @@ func (x *Data_Rag) String() string {
 func (*Data_Rag) ProtoMessage() {}
 
 func (x *Data_Rag) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[12]
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +1154,7 @@ type Data_Conversation struct {
 
 func (x *Data_Conversation) Reset() {
 	*x = Data_Conversation{}
-	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -912,7 +1166,7 @@ func (x *Data_Conversation) String() string {
 func (*Data_Conversation) ProtoMessage() {}
 
 func (x *Data_Conversation) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,6 +1196,106 @@ func (x *Data_Conversation) GetPurgeIntervalMinutes() int32 {
 	return 0
 }
 
+type Data_Audit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 keeps audit logs forever.
+	RetentionDays        int32 `protobuf:"varint,1,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+	PurgeIntervalMinutes int32 `protobuf:"varint,2,opt,name=purge_interval_minutes,json=purgeIntervalMinutes,proto3" json:"purge_interval_minutes,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Data_Audit) Reset() {
+	*x = Data_Audit{}
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Audit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Audit) ProtoMessage() {}
+
+func (x *Data_Audit) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Audit.ProtoReflect.Descriptor instead.
+func (*Data_Audit) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 8}
+}
+
+func (x *Data_Audit) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *Data_Audit) GetPurgeIntervalMinutes() int32 {
+	if x != nil {
+		return x.PurgeIntervalMinutes
+	}
+	return 0
+}
+
+type Data_Credentials struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base64-encoded 32-byte key that wraps the data keys of tenant
+	// provider credentials. Falls back to RAGODESK_CREDENTIALS_MASTER_KEY;
+	// tenants cannot store credentials when neither is set.
+	MasterKey     string `protobuf:"bytes,1,opt,name=master_key,json=masterKey,proto3" json:"master_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Credentials) Reset() {
+	*x = Data_Credentials{}
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Credentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Credentials) ProtoMessage() {}
+
+func (x *Data_Credentials) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Credentials.ProtoReflect.Descriptor instead.
+func (*Data_Credentials) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 9}
+}
+
+func (x *Data_Credentials) GetMasterKey() string {
+	if x != nil {
+		return x.MasterKey
+	}
+	return ""
+}
+
 type Data_APIMgmt struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	RotationGraceMinutes int32                  `protobuf:"varint,1,opt,name=rotation_grace_minutes,json=rotationGraceMinutes,proto3" json:"rotation_grace_minutes,omitempty"`
@@ -953,7 +1307,7 @@ type Data_APIMgmt struct {
 
 func (x *Data_APIMgmt) Reset() {
 	*x = Data_APIMgmt{}
-	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	mi := &file_internal_conf_conf_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -965,7 +1319,7 @@ func (x *Data_APIMgmt) String() string {
 func (*Data_APIMgmt) ProtoMessage() {}
 
 func (x *Data_APIMgmt) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	mi := &file_internal_conf_conf_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1332,7 @@ func (x *Data_APIMgmt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_APIMgmt.ProtoReflect.Descriptor instead.
 func (*Data_APIMgmt) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 8}
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 10}
 }
 
 func (x *Data_APIMgmt) GetRotationGraceMinutes() int32 {
@@ -1012,7 +1366,7 @@ type Data_Knowledge_Chunking struct {
 
 func (x *Data_Knowledge_Chunking) Reset() {
 	*x = Data_Knowledge_Chunking{}
-	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1024,7 +1378,7 @@ func (x *Data_Knowledge_Chunking) String() string {
 func (*Data_Knowledge_Chunking) ProtoMessage() {}
 
 func (x *Data_Knowledge_Chunking) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1423,7 @@ type Data_Knowledge_Embedding struct {
 
 func (x *Data_Knowledge_Embedding) Reset() {
 	*x = Data_Knowledge_Embedding{}
-	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1081,7 +1435,7 @@ func (x *Data_Knowledge_Embedding) String() string {
 func (*Data_Knowledge_Embedding) ProtoMessage() {}
 
 func (x *Data_Knowledge_Embedding) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1512,7 @@ type Data_Knowledge_Ingestion struct {
 
 func (x *Data_Knowledge_Ingestion) Reset() {
 	*x = Data_Knowledge_Ingestion{}
-	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	mi := &file_internal_conf_conf_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1170,7 +1524,7 @@ func (x *Data_Knowledge_Ingestion) String() string {
 func (*Data_Knowledge_Ingestion) ProtoMessage() {}
 
 func (x *Data_Knowledge_Ingestion) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	mi := &file_internal_conf_conf_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,6 +1568,90 @@ func (x *Data_Knowledge_Ingestion) GetWorkerConcurrency() int32 {
 	return 0
 }
 
+type Data_Knowledge_OCR struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	ApiKey        string                 `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	TesseractPath string                 `protobuf:"bytes,4,opt,name=tesseract_path,json=tesseractPath,proto3" json:"tesseract_path,omitempty"`
+	Languages     string                 `protobuf:"bytes,5,opt,name=languages,proto3" json:"languages,omitempty"`
+	TimeoutMs     int32                  `protobuf:"varint,6,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Knowledge_OCR) Reset() {
+	*x = Data_Knowledge_OCR{}
+	mi := &file_internal_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Knowledge_OCR) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Knowledge_OCR) ProtoMessage() {}
+
+func (x *Data_Knowledge_OCR) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Knowledge_OCR.ProtoReflect.Descriptor instead.
+func (*Data_Knowledge_OCR) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 5, 3}
+}
+
+func (x *Data_Knowledge_OCR) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Data_Knowledge_OCR) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Data_Knowledge_OCR) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *Data_Knowledge_OCR) GetTesseractPath() string {
+	if x != nil {
+		return x.TesseractPath
+	}
+	return ""
+}
+
+func (x *Data_Knowledge_OCR) GetLanguages() string {
+	if x != nil {
+		return x.Languages
+	}
+	return ""
+}
+
+func (x *Data_Knowledge_OCR) GetTimeoutMs() int32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
 type Data_Rag_Retrieval struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	TopK           int32                  `protobuf:"varint,1,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
@@ -1227,7 +1665,7 @@ type Data_Rag_Retrieval struct {
 
 func (x *Data_Rag_Retrieval) Reset() {
 	*x = Data_Rag_Retrieval{}
-	mi := &file_internal_conf_conf_proto_msgTypes[18]
+	mi := &file_internal_conf_conf_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1239,7 +1677,7 @@ func (x *Data_Rag_Retrieval) String() string {
 func (*Data_Rag_Retrieval) ProtoMessage() {}
 
 func (x *Data_Rag_Retrieval) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[18]
+	mi := &file_internal_conf_conf_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,13 +1739,16 @@ type Data_Rag_LLM struct {
 	MaxTokens      int32                  `protobuf:"varint,7,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
 	SystemPrompt   string                 `protobuf:"bytes,8,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
 	RefusalMessage string                 `protobuf:"bytes,9,opt,name=refusal_message,json=refusalMessage,proto3" json:"refusal_message,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// "" keeps the model's choice, "auto" answers in the detected language of
+	// the question, anything else is a language code (e.g. "en") to always use.
+	ReplyLanguage string `protobuf:"bytes,10,opt,name=reply_language,json=replyLanguage,proto3" json:"reply_language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Data_Rag_LLM) Reset() {
 	*x = Data_Rag_LLM{}
-	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	mi := &file_internal_conf_conf_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1319,7 +1760,7 @@ func (x *Data_Rag_LLM) String() string {
 func (*Data_Rag_LLM) ProtoMessage() {}
 
 func (x *Data_Rag_LLM) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	mi := &file_internal_conf_conf_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,19 +1839,28 @@ func (x *Data_Rag_LLM) GetRefusalMessage() string {
 	return ""
 }
 
+func (x *Data_Rag_LLM) GetReplyLanguage() string {
+	if x != nil {
+		return x.ReplyLanguage
+	}
+	return ""
+}
+
 var File_internal_conf_conf_proto protoreflect.FileDescriptor
 
 const file_internal_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x18internal/conf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"]\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"]\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xc0\x03\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xa3\n" +
+	"\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12+\n" +
-	"\x04auth\x18\x03 \x01(\v2\x17.kratos.api.Server.AuthR\x04auth\x1ai\n" +
+	"\x04auth\x18\x03 \x01(\v2\x17.kratos.api.Server.AuthR\x04auth\x12+\n" +
+	"\x04mail\x18\x04 \x01(\v2\x17.kratos.api.Server.MailR\x04mail\x1ai\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
@@ -1418,12 +1868,33 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1aY\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\x9f\x05\n" +
 	"\x04Auth\x12\x1d\n" +
 	"\n" +
 	"jwt_secret\x18\x01 \x01(\tR\tjwtSecret\x12\x16\n" +
 	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x1a\n" +
-	"\baudience\x18\x03 \x01(\tR\baudience\"\xae\x15\n" +
+	"\baudience\x18\x03 \x01(\tR\baudience\x12C\n" +
+	"\x10access_token_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0eaccessTokenTtl\x12E\n" +
+	"\x11refresh_token_ttl\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshTokenTtl\x12(\n" +
+	"\x03sso\x18\x06 \x01(\v2\x16.kratos.api.Server.SSOR\x03sso\x12+\n" +
+	"\x11signing_algorithm\x18\a \x01(\tR\x10signingAlgorithm\x12M\n" +
+	"\x15key_rotation_interval\x18\b \x01(\v2\x19.google.protobuf.DurationR\x13keyRotationInterval\x12E\n" +
+	"\x11key_publish_ahead\x18\t \x01(\v2\x19.google.protobuf.DurationR\x0fkeyPublishAhead\x128\n" +
+	"\n" +
+	"invite_ttl\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\tinviteTtl\x12G\n" +
+	"\x12password_reset_ttl\x18\v \x01(\v2\x19.google.protobuf.DurationR\x10passwordResetTtl\x12H\n" +
+	"\x12hs256_accept_until\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x10hs256AcceptUntil\x1a\x81\x01\n" +
+	"\x03SSO\x12\x1d\n" +
+	"\n" +
+	"public_url\x18\x01 \x01(\tR\tpublicUrl\x12#\n" +
+	"\rdashboard_url\x18\x02 \x01(\tR\fdashboardUrl\x126\n" +
+	"\tstate_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bstateTtl\x1ai\n" +
+	"\x04Mail\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x10\n" +
+	"\x03dir\x18\x02 \x01(\tR\x03dir\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12#\n" +
+	"\rdashboard_url\x18\x04 \x01(\tR\fdashboardUrl\"\xc6\x19\n" +
 	"\x04Data\x12\x14\n" +
 	"\x05proxy\x18\n" +
 	" \x01(\tR\x05proxy\x125\n" +
@@ -1435,7 +1906,9 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\tknowledge\x18\x06 \x01(\v2\x1a.kratos.api.Data.KnowledgeR\tknowledge\x12&\n" +
 	"\x03rag\x18\a \x01(\v2\x14.kratos.api.Data.RagR\x03rag\x12A\n" +
 	"\fconversation\x18\b \x01(\v2\x1d.kratos.api.Data.ConversationR\fconversation\x122\n" +
-	"\aapimgmt\x18\t \x01(\v2\x18.kratos.api.Data.APIMgmtR\aapimgmt\x1a:\n" +
+	"\aapimgmt\x18\t \x01(\v2\x18.kratos.api.Data.APIMgmtR\aapimgmt\x12,\n" +
+	"\x05audit\x18\v \x01(\v2\x16.kratos.api.Data.AuditR\x05audit\x12>\n" +
+	"\vcredentials\x18\f \x01(\v2\x1c.kratos.api.Data.CredentialsR\vcredentials\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
@@ -1464,11 +1937,12 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"secret_key\x18\x03 \x01(\tR\tsecretKey\x12\x16\n" +
 	"\x06bucket\x18\x04 \x01(\tR\x06bucket\x12\x16\n" +
 	"\x06region\x18\x05 \x01(\tR\x06region\x12\x17\n" +
-	"\ause_ssl\x18\x06 \x01(\bR\x06useSsl\x1a\xa5\x05\n" +
+	"\ause_ssl\x18\x06 \x01(\bR\x06useSsl\x1a\x94\a\n" +
 	"\tKnowledge\x12?\n" +
 	"\bchunking\x18\x01 \x01(\v2#.kratos.api.Data.Knowledge.ChunkingR\bchunking\x12B\n" +
 	"\tembedding\x18\x02 \x01(\v2$.kratos.api.Data.Knowledge.EmbeddingR\tembedding\x12B\n" +
-	"\tingestion\x18\x03 \x01(\v2$.kratos.api.Data.Knowledge.IngestionR\tingestion\x1aP\n" +
+	"\tingestion\x18\x03 \x01(\v2$.kratos.api.Data.Knowledge.IngestionR\tingestion\x120\n" +
+	"\x03ocr\x18\x05 \x01(\v2\x1e.kratos.api.Data.Knowledge.OCRR\x03ocr\x1aP\n" +
 	"\bChunking\x12\x1d\n" +
 	"\n" +
 	"max_tokens\x18\x01 \x01(\x05R\tmaxTokens\x12%\n" +
@@ -1488,7 +1962,15 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"maxRetries\x12&\n" +
 	"\x0fbackoff_base_ms\x18\x02 \x01(\x05R\rbackoffBaseMs\x12#\n" +
 	"\rasync_enabled\x18\x03 \x01(\bR\fasyncEnabled\x12-\n" +
-	"\x12worker_concurrency\x18\x04 \x01(\x05R\x11workerConcurrencyJ\x04\b\x04\x10\x05R\aparsing\x1a\xdf\x04\n" +
+	"\x12worker_concurrency\x18\x04 \x01(\x05R\x11workerConcurrency\x1a\xba\x01\n" +
+	"\x03OCR\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x03 \x01(\tR\x06apiKey\x12%\n" +
+	"\x0etesseract_path\x18\x04 \x01(\tR\rtesseractPath\x12\x1c\n" +
+	"\tlanguages\x18\x05 \x01(\tR\tlanguages\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x06 \x01(\x05R\ttimeoutMsJ\x04\b\x04\x10\x05R\aparsing\x1a\x86\x05\n" +
 	"\x03Rag\x12\x1d\n" +
 	"\n" +
 	"timeout_ms\x18\x01 \x01(\x05R\ttimeoutMs\x12<\n" +
@@ -1500,7 +1982,7 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"timeout_ms\x18\x03 \x01(\x05R\ttimeoutMs\x12#\n" +
 	"\rrerank_weight\x18\x05 \x01(\x02R\frerankWeight\x12'\n" +
-	"\x0fmax_concurrency\x18\x06 \x01(\x05R\x0emaxConcurrencyJ\x04\b\x04\x10\x05\x1a\x9a\x02\n" +
+	"\x0fmax_concurrency\x18\x06 \x01(\x05R\x0emaxConcurrencyJ\x04\b\x04\x10\x05\x1a\xc1\x02\n" +
 	"\x03LLM\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1a\n" +
 	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x17\n" +
@@ -1512,10 +1994,18 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"max_tokens\x18\a \x01(\x05R\tmaxTokens\x12#\n" +
 	"\rsystem_prompt\x18\b \x01(\tR\fsystemPrompt\x12'\n" +
-	"\x0frefusal_message\x18\t \x01(\tR\x0erefusalMessage\x1ak\n" +
+	"\x0frefusal_message\x18\t \x01(\tR\x0erefusalMessage\x12%\n" +
+	"\x0ereply_language\x18\n" +
+	" \x01(\tR\rreplyLanguage\x1ak\n" +
 	"\fConversation\x12%\n" +
 	"\x0eretention_days\x18\x01 \x01(\x05R\rretentionDays\x124\n" +
-	"\x16purge_interval_minutes\x18\x02 \x01(\x05R\x14purgeIntervalMinutes\x1a\x97\x01\n" +
+	"\x16purge_interval_minutes\x18\x02 \x01(\x05R\x14purgeIntervalMinutes\x1ad\n" +
+	"\x05Audit\x12%\n" +
+	"\x0eretention_days\x18\x01 \x01(\x05R\rretentionDays\x124\n" +
+	"\x16purge_interval_minutes\x18\x02 \x01(\x05R\x14purgeIntervalMinutes\x1a,\n" +
+	"\vCredentials\x12\x1d\n" +
+	"\n" +
+	"master_key\x18\x01 \x01(\tR\tmasterKey\x1a\x97\x01\n" +
 	"\aAPIMgmt\x124\n" +
 	"\x16rotation_grace_minutes\x18\x01 \x01(\x05R\x14rotationGraceMinutes\x12(\n" +
 	"\x10tenant_qps_limit\x18\x02 \x01(\x05R\x0etenantQpsLimit\x12,\n" +
@@ -1533,7 +2023,7 @@ func file_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_internal_conf_conf_proto_rawDescData
 }
 
-var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_internal_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),                // 0: kratos.api.Bootstrap
 	(*Server)(nil),                   // 1: kratos.api.Server
@@ -1541,21 +2031,27 @@ var file_internal_conf_conf_proto_goTypes = []any{
 	(*Server_HTTP)(nil),              // 3: kratos.api.Server.HTTP
 	(*Server_GRPC)(nil),              // 4: kratos.api.Server.GRPC
 	(*Server_Auth)(nil),              // 5: kratos.api.Server.Auth
-	(*Data_Database)(nil),            // 6: kratos.api.Data.Database
-	(*Data_Redis)(nil),               // 7: kratos.api.Data.Redis
-	(*Data_VectorDB)(nil),            // 8: kratos.api.Data.VectorDB
-	(*Data_RabbitMQ)(nil),            // 9: kratos.api.Data.RabbitMQ
-	(*Data_ObjectStorage)(nil),       // 10: kratos.api.Data.ObjectStorage
-	(*Data_Knowledge)(nil),           // 11: kratos.api.Data.Knowledge
-	(*Data_Rag)(nil),                 // 12: kratos.api.Data.Rag
-	(*Data_Conversation)(nil),        // 13: kratos.api.Data.Conversation
-	(*Data_APIMgmt)(nil),             // 14: kratos.api.Data.APIMgmt
-	(*Data_Knowledge_Chunking)(nil),  // 15: kratos.api.Data.Knowledge.Chunking
-	(*Data_Knowledge_Embedding)(nil), // 16: kratos.api.Data.Knowledge.Embedding
-	(*Data_Knowledge_Ingestion)(nil), // 17: kratos.api.Data.Knowledge.Ingestion
-	(*Data_Rag_Retrieval)(nil),       // 18: kratos.api.Data.Rag.Retrieval
-	(*Data_Rag_LLM)(nil),             // 19: kratos.api.Data.Rag.LLM
-	(*durationpb.Duration)(nil),      // 20: google.protobuf.Duration
+	(*Server_SSO)(nil),               // 6: kratos.api.Server.SSO
+	(*Server_Mail)(nil),              // 7: kratos.api.Server.Mail
+	(*Data_Database)(nil),            // 8: kratos.api.Data.Database
+	(*Data_Redis)(nil),               // 9: kratos.api.Data.Redis
+	(*Data_VectorDB)(nil),            // 10: kratos.api.Data.VectorDB
+	(*Data_RabbitMQ)(nil),            // 11: kratos.api.Data.RabbitMQ
+	(*Data_ObjectStorage)(nil),       // 12: kratos.api.Data.ObjectStorage
+	(*Data_Knowledge)(nil),           // 13: kratos.api.Data.Knowledge
+	(*Data_Rag)(nil),                 // 14: kratos.api.Data.Rag
+	(*Data_Conversation)(nil),        // 15: kratos.api.Data.Conversation
+	(*Data_Audit)(nil),               // 16: kratos.api.Data.Audit
+	(*Data_Credentials)(nil),         // 17: kratos.api.Data.Credentials
+	(*Data_APIMgmt)(nil),             // 18: kratos.api.Data.APIMgmt
+	(*Data_Knowledge_Chunking)(nil),  // 19: kratos.api.Data.Knowledge.Chunking
+	(*Data_Knowledge_Embedding)(nil), // 20: kratos.api.Data.Knowledge.Embedding
+	(*Data_Knowledge_Ingestion)(nil), // 21: kratos.api.Data.Knowledge.Ingestion
+	(*Data_Knowledge_OCR)(nil),       // 22: kratos.api.Data.Knowledge.OCR
+	(*Data_Rag_Retrieval)(nil),       // 23: kratos.api.Data.Rag.Retrieval
+	(*Data_Rag_LLM)(nil),             // 24: kratos.api.Data.Rag.LLM
+	(*durationpb.Duration)(nil),      // 25: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),    // 26: google.protobuf.Timestamp
 }
 var file_internal_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -1563,29 +2059,42 @@ var file_internal_conf_conf_proto_depIdxs = []int32{
 	3,  // 2: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
 	4,  // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
 	5,  // 4: kratos.api.Server.auth:type_name -> kratos.api.Server.Auth
-	6,  // 5: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	7,  // 6: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	8,  // 7: kratos.api.Data.vectordb:type_name -> kratos.api.Data.VectorDB
-	9,  // 8: kratos.api.Data.rabbitmq:type_name -> kratos.api.Data.RabbitMQ
-	10, // 9: kratos.api.Data.object_storage:type_name -> kratos.api.Data.ObjectStorage
-	11, // 10: kratos.api.Data.knowledge:type_name -> kratos.api.Data.Knowledge
-	12, // 11: kratos.api.Data.rag:type_name -> kratos.api.Data.Rag
-	13, // 12: kratos.api.Data.conversation:type_name -> kratos.api.Data.Conversation
-	14, // 13: kratos.api.Data.apimgmt:type_name -> kratos.api.Data.APIMgmt
-	20, // 14: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	20, // 15: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	20, // 16: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	20, // 17: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	15, // 18: kratos.api.Data.Knowledge.chunking:type_name -> kratos.api.Data.Knowledge.Chunking
-	16, // 19: kratos.api.Data.Knowledge.embedding:type_name -> kratos.api.Data.Knowledge.Embedding
-	17, // 20: kratos.api.Data.Knowledge.ingestion:type_name -> kratos.api.Data.Knowledge.Ingestion
-	18, // 21: kratos.api.Data.Rag.retrieval:type_name -> kratos.api.Data.Rag.Retrieval
-	19, // 22: kratos.api.Data.Rag.llm:type_name -> kratos.api.Data.Rag.LLM
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	7,  // 5: kratos.api.Server.mail:type_name -> kratos.api.Server.Mail
+	8,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	9,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	10, // 8: kratos.api.Data.vectordb:type_name -> kratos.api.Data.VectorDB
+	11, // 9: kratos.api.Data.rabbitmq:type_name -> kratos.api.Data.RabbitMQ
+	12, // 10: kratos.api.Data.object_storage:type_name -> kratos.api.Data.ObjectStorage
+	13, // 11: kratos.api.Data.knowledge:type_name -> kratos.api.Data.Knowledge
+	14, // 12: kratos.api.Data.rag:type_name -> kratos.api.Data.Rag
+	15, // 13: kratos.api.Data.conversation:type_name -> kratos.api.Data.Conversation
+	18, // 14: kratos.api.Data.apimgmt:type_name -> kratos.api.Data.APIMgmt
+	16, // 15: kratos.api.Data.audit:type_name -> kratos.api.Data.Audit
+	17, // 16: kratos.api.Data.credentials:type_name -> kratos.api.Data.Credentials
+	25, // 17: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	25, // 18: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	25, // 19: kratos.api.Server.Auth.access_token_ttl:type_name -> google.protobuf.Duration
+	25, // 20: kratos.api.Server.Auth.refresh_token_ttl:type_name -> google.protobuf.Duration
+	6,  // 21: kratos.api.Server.Auth.sso:type_name -> kratos.api.Server.SSO
+	25, // 22: kratos.api.Server.Auth.key_rotation_interval:type_name -> google.protobuf.Duration
+	25, // 23: kratos.api.Server.Auth.key_publish_ahead:type_name -> google.protobuf.Duration
+	25, // 24: kratos.api.Server.Auth.invite_ttl:type_name -> google.protobuf.Duration
+	25, // 25: kratos.api.Server.Auth.password_reset_ttl:type_name -> google.protobuf.Duration
+	26, // 26: kratos.api.Server.Auth.hs256_accept_until:type_name -> google.protobuf.Timestamp
+	25, // 27: kratos.api.Server.SSO.state_ttl:type_name -> google.protobuf.Duration
+	25, // 28: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	25, // 29: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	19, // 30: kratos.api.Data.Knowledge.chunking:type_name -> kratos.api.Data.Knowledge.Chunking
+	20, // 31: kratos.api.Data.Knowledge.embedding:type_name -> kratos.api.Data.Knowledge.Embedding
+	21, // 32: kratos.api.Data.Knowledge.ingestion:type_name -> kratos.api.Data.Knowledge.Ingestion
+	22, // 33: kratos.api.Data.Knowledge.ocr:type_name -> kratos.api.Data.Knowledge.OCR
+	23, // 34: kratos.api.Data.Rag.retrieval:type_name -> kratos.api.Data.Rag.Retrieval
+	24, // 35: kratos.api.Data.Rag.llm:type_name -> kratos.api.Data.Rag.LLM
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_internal_conf_conf_proto_init() }
@@ -1599,7 +2108,7 @@ func file_internal_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_conf_conf_proto_rawDesc), len(file_internal_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
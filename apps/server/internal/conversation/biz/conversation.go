@@ -28,6 +28,12 @@ const (
 	EventEscalation = "escalation"
 )
 
+// Permission codes (tenant scope).
+const (
+	PermissionSessionRead = "tenant.chat_session.read"
+	PermissionMessageRead = "tenant.chat_message.read"
+)
+
 const (
 	defaultRetentionDays        = 0
 	defaultPurgeIntervalMinutes = 60
@@ -42,6 +48,9 @@ type Session struct {
 	CloseReason  string
 	UserExternal string
 	Metadata     string
+	// Entitlements are the verified access labels of the end user; they
+	// decide which labeled documents answers may draw on.
+	Entitlements []string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	ClosedAt     time.Time
@@ -85,7 +94,9 @@ type MessageFeedback struct {
 type ConversationRepo interface {
 	CreateSession(ctx context.Context, session Session) (Session, error)
 	GetSession(ctx context.Context, sessionID string) (Session, error)
-	ListSessions(ctx context.Context, limit int, offset int) ([]Session, error)
+	// ListSessions lists the sessions of the tenant, only those of botIDs
+	// unless it is nil.
+	ListSessions(ctx context.Context, botIDs []string, limit int, offset int) ([]Session, error)
 	CloseSession(ctx context.Context, sessionID string, closeReason string, closedAt time.Time) error
 	PurgeExpired(ctx context.Context, cutoff time.Time) error
 
@@ -115,7 +126,7 @@ func NewConversationUsecase(repo ConversationRepo, cfg *conf.Data) *Conversation
 	}
 }
 
-func (uc *ConversationUsecase) CreateSession(ctx context.Context, botID string, userExternal string, metadata map[string]any) (Session, error) {
+func (uc *ConversationUsecase) CreateSession(ctx context.Context, botID string, userExternal string, metadata map[string]any, entitlements []string) (Session, error) {
 	botID = strings.TrimSpace(botID)
 	if botID == "" {
 		return Session{}, errors.BadRequest("BOT_ID_MISSING", "bot id missing")
@@ -134,6 +145,7 @@ func (uc *ConversationUsecase) CreateSession(ctx context.Context, botID string,
 		Status:       SessionStatusBot,
 		UserExternal: strings.TrimSpace(userExternal),
 		Metadata:     metaJSON,
+		Entitlements: entitlements,
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}
@@ -210,10 +222,10 @@ func (uc *ConversationUsecase) CloseSession(ctx context.Context, sessionID strin
 	return nil
 }
 
-func (uc *ConversationUsecase) ListSessions(ctx context.Context, limit int, offset int) ([]Session, error) {
+func (uc *ConversationUsecase) ListSessions(ctx context.Context, botIDs []string, limit int, offset int) ([]Session, error) {
 	uc.maybePurge(ctx)
 	limit, offset = paging.Normalize(limit, offset)
-	return uc.repo.ListSessions(ctx, limit, offset)
+	return uc.repo.ListSessions(ctx, botIDs, limit, offset)
 }
 
 func (uc *ConversationUsecase) ListMessages(ctx context.Context, sessionID string, limit int, offset int) ([]Message, error) {
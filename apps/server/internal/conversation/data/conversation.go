@@ -3,6 +3,8 @@ package data
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"strings"
 	"time"
 
 	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
@@ -37,8 +39,8 @@ func (r *conversationRepo) CreateSession(ctx context.Context, session biz.Sessio
 	_, err = r.db.ExecContext(
 		ctx,
 		`INSERT INTO chat_session
-			(id, tenant_id, bot_id, status, close_reason, user_external_id, metadata, created_at, updated_at, closed_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			(id, tenant_id, bot_id, status, close_reason, user_external_id, metadata, entitlements, created_at, updated_at, closed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		session.ID,
 		session.TenantID,
 		session.BotID,
@@ -46,6 +48,7 @@ func (r *conversationRepo) CreateSession(ctx context.Context, session biz.Sessio
 		session.CloseReason,
 		session.UserExternal,
 		session.Metadata,
+		encodeEntitlements(session.Entitlements),
 		session.CreatedAt,
 		session.UpdatedAt,
 		nullTime(session.ClosedAt),
@@ -63,9 +66,10 @@ func (r *conversationRepo) GetSession(ctx context.Context, sessionID string) (bi
 	}
 	var s biz.Session
 	var closedAt sql.NullTime
+	var entitlements sql.NullString
 	err = r.db.QueryRowContext(
 		ctx,
-		`SELECT id, tenant_id, bot_id, status, close_reason, user_external_id, metadata, created_at, updated_at, closed_at
+		`SELECT id, tenant_id, bot_id, status, close_reason, user_external_id, metadata, entitlements, created_at, updated_at, closed_at
 		FROM chat_session WHERE tenant_id = ? AND id = ?`,
 		tenantID,
 		sessionID,
@@ -77,6 +81,7 @@ func (r *conversationRepo) GetSession(ctx context.Context, sessionID string) (bi
 		&s.CloseReason,
 		&s.UserExternal,
 		&s.Metadata,
+		&entitlements,
 		&s.CreatedAt,
 		&s.UpdatedAt,
 		&closedAt,
@@ -90,6 +95,7 @@ func (r *conversationRepo) GetSession(ctx context.Context, sessionID string) (bi
 	if closedAt.Valid {
 		s.ClosedAt = closedAt.Time
 	}
+	s.Entitlements = decodeEntitlements(entitlements)
 	return s, nil
 }
 
@@ -170,14 +176,25 @@ func (r *conversationRepo) PurgeExpired(ctx context.Context, cutoff time.Time) e
 	return err
 }
 
-func (r *conversationRepo) ListSessions(ctx context.Context, limit int, offset int) ([]biz.Session, error) {
+func (r *conversationRepo) ListSessions(ctx context.Context, botIDs []string, limit int, offset int) ([]biz.Session, error) {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
 		return nil, err
 	}
-	query := `SELECT id, tenant_id, bot_id, status, close_reason, user_external_id, metadata, created_at, updated_at, closed_at
-		FROM chat_session WHERE tenant_id = ? ORDER BY created_at DESC LIMIT ? OFFSET ?`
-	args := []any{tenantID, limit, offset}
+	query := `SELECT id, tenant_id, bot_id, status, close_reason, user_external_id, metadata, entitlements, created_at, updated_at, closed_at
+		FROM chat_session WHERE tenant_id = ?`
+	args := []any{tenantID}
+	if botIDs != nil {
+		if len(botIDs) == 0 {
+			return []biz.Session{}, nil
+		}
+		query += " AND bot_id IN (?" + strings.Repeat(", ?", len(botIDs)-1) + ")"
+		for _, id := range botIDs {
+			args = append(args, id)
+		}
+	}
+	query += " ORDER BY created_at DESC LIMIT ? OFFSET ?"
+	args = append(args, limit, offset)
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -188,6 +205,7 @@ func (r *conversationRepo) ListSessions(ctx context.Context, limit int, offset i
 	for rows.Next() {
 		var s biz.Session
 		var closedAt sql.NullTime
+		var entitlements sql.NullString
 		if err := rows.Scan(
 			&s.ID,
 			&s.TenantID,
@@ -196,6 +214,7 @@ func (r *conversationRepo) ListSessions(ctx context.Context, limit int, offset i
 			&s.CloseReason,
 			&s.UserExternal,
 			&s.Metadata,
+			&entitlements,
 			&s.CreatedAt,
 			&s.UpdatedAt,
 			&closedAt,
@@ -205,6 +224,7 @@ func (r *conversationRepo) ListSessions(ctx context.Context, limit int, offset i
 		if closedAt.Valid {
 			s.ClosedAt = closedAt.Time
 		}
+		s.Entitlements = decodeEntitlements(entitlements)
 		sessions = append(sessions, s)
 	}
 	return sessions, rows.Err()
@@ -342,3 +362,25 @@ func nullTime(t time.Time) any {
 	}
 	return t
 }
+
+func encodeEntitlements(entitlements []string) sql.NullString {
+	if len(entitlements) == 0 {
+		return sql.NullString{}
+	}
+	raw, err := json.Marshal(entitlements)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(raw), Valid: true}
+}
+
+func decodeEntitlements(raw sql.NullString) []string {
+	if !raw.Valid || raw.String == "" {
+		return nil
+	}
+	var entitlements []string
+	if err := json.Unmarshal([]byte(raw.String), &entitlements); err != nil {
+		return nil
+	}
+	return entitlements
+}
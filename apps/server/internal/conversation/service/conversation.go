@@ -11,6 +11,7 @@ import (
 	analyticsbiz "github.com/ZTH7/RagoDesk/apps/server/internal/analytics/biz"
 	apimgmtbiz "github.com/ZTH7/RagoDesk/apps/server/internal/apimgmt/biz"
 	biz "github.com/ZTH7/RagoDesk/apps/server/internal/conversation/biz"
+	iambiz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/transport"
@@ -28,11 +29,12 @@ type ConversationService struct {
 	uc  *biz.ConversationUsecase
 	api *apimgmtbiz.APIMgmtUsecase
 	ana *analyticsbiz.AnalyticsUsecase
+	iam *iambiz.IAMUsecase
 }
 
 // NewConversationService creates a new ConversationService
-func NewConversationService(uc *biz.ConversationUsecase, api *apimgmtbiz.APIMgmtUsecase, ana *analyticsbiz.AnalyticsUsecase) *ConversationService {
-	return &ConversationService{uc: uc, api: api, ana: ana}
+func NewConversationService(uc *biz.ConversationUsecase, api *apimgmtbiz.APIMgmtUsecase, ana *analyticsbiz.AnalyticsUsecase, iam *iambiz.IAMUsecase) *ConversationService {
+	return &ConversationService{uc: uc, api: api, ana: ana, iam: iam}
 }
 
 func (s *ConversationService) CreateSession(ctx context.Context, req *v1.CreateSessionRequest) (*v1.CreateSessionResponse, error) {
@@ -52,8 +54,20 @@ func (s *ConversationService) CreateSession(ctx context.Context, req *v1.CreateS
 	defer func() {
 		s.recordUsage(ctx, key, operation, apiVersion, provider.LLMUsage{}, callErr, start, clientIP, userAgent)
 	}()
+	endUser, callErr := s.api.VerifyEntitlementToken(key, req.GetEntitlementToken())
+	if callErr != nil {
+		return nil, callErr
+	}
+	userExternal := strings.TrimSpace(req.GetUserExternalId())
+	if endUser.ExternalID != "" {
+		if userExternal != "" && userExternal != endUser.ExternalID {
+			callErr = errors.BadRequest("ENTITLEMENT_SUBJECT_MISMATCH", "entitlement token subject does not match user_external_id")
+			return nil, callErr
+		}
+		userExternal = endUser.ExternalID
+	}
 	meta := structToMap(req.Metadata)
-	session, callErr := s.uc.CreateSession(ctx, key.BotID, req.UserExternalId, meta)
+	session, callErr := s.uc.CreateSession(ctx, key.BotID, userExternal, meta, endUser.Entitlements)
 	if callErr != nil {
 		return nil, callErr
 	}
@@ -184,7 +198,18 @@ func (s *ConversationService) ListSessions(ctx context.Context, req *v1.ListSess
 	if req == nil {
 		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
 	}
-	sessions, err := s.uc.ListSessions(ctx, int(req.Limit), int(req.Offset))
+	if _, err := tenant.RequireTenantID(ctx); err != nil {
+		return nil, errors.Forbidden("TENANT_MISSING", "tenant missing")
+	}
+	visible, err := s.iam.VisibleResources(ctx, biz.PermissionSessionRead, iambiz.ResourceTypeBot)
+	if err != nil {
+		return nil, err
+	}
+	var botIDs []string
+	if !visible.All {
+		botIDs = visible.IDs
+	}
+	sessions, err := s.uc.ListSessions(ctx, botIDs, int(req.Limit), int(req.Offset))
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +224,16 @@ func (s *ConversationService) ListMessages(ctx context.Context, req *v1.ListMess
 	if req == nil {
 		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
 	}
+	if _, err := tenant.RequireTenantID(ctx); err != nil {
+		return nil, errors.Forbidden("TENANT_MISSING", "tenant missing")
+	}
+	session, _, err := s.uc.GetSession(ctx, req.SessionId, false, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.iam.RequirePermission(ctx, biz.PermissionMessageRead, iambiz.BotRef(session.BotID)); err != nil {
+		return nil, err
+	}
 	messages, err := s.uc.ListMessages(ctx, req.SessionId, int(req.Limit), int(req.Offset))
 	if err != nil {
 		return nil, err
@@ -224,6 +259,7 @@ func toAPISession(session biz.Session) *v1.Session {
 		CreatedAt:      timestamppb.New(session.CreatedAt),
 		UpdatedAt:      timestamppb.New(session.UpdatedAt),
 		ClosedAt:       timeOrNil(session.ClosedAt),
+		Entitlements:   session.Entitlements,
 	}
 }
 
@@ -0,0 +1,262 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/google/wire"
+)
+
+const (
+	PermissionLLMCredentialRead  = "tenant.llm_credential.read"
+	PermissionLLMCredentialWrite = "tenant.llm_credential.write"
+)
+
+// Test statuses of a credential.
+const (
+	TestStatusOK     = "ok"
+	TestStatusFailed = "failed"
+)
+
+const (
+	maxNameLength     = 255
+	maxAPIKeyLength   = 1024
+	maxTestErrorRunes = 500
+	testTimeout       = 20 * time.Second
+)
+
+// supportedProviders are the providers tenants can bring keys for.
+var supportedProviders = map[string]bool{"openai": true, "deepseek": true, "http": true}
+
+// ProviderSet is credential biz providers.
+var ProviderSet = wire.NewSet(NewCredentialUsecase)
+
+// Credential is a tenant's own API key for a provider. The key is only
+// held encrypted; Credential carries a hint of it.
+type Credential struct {
+	ID             string
+	TenantID       string
+	Provider       string
+	Name           string
+	KeyHint        string
+	LastTestedAt   time.Time
+	LastTestStatus string
+	LastTestError  string
+	CreatedBy      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// CredentialInput adds a credential.
+type CredentialInput struct {
+	Provider string
+	Name     string
+	APIKey   string
+}
+
+// TestResult is the outcome of a test call made with a credential.
+type TestResult struct {
+	OK         bool
+	Error      string
+	Latency    time.Duration
+	Credential Credential
+}
+
+// DeploymentProviders are the deployment's LLM and default embedding
+// configs a credential is tested against.
+type DeploymentProviders struct {
+	LLM       provider.LLMConfig
+	Embedding provider.Config
+}
+
+// CredentialRepo stores credentials of the tenant in context, sealing the
+// keys with the deployment master key.
+type CredentialRepo interface {
+	// Enabled reports whether a master key is configured.
+	Enabled() bool
+	ListCredentials(ctx context.Context) ([]Credential, error)
+	GetCredential(ctx context.Context, id string) (Credential, error)
+	CreateCredential(ctx context.Context, cred Credential, apiKey string) error
+	// GetCredentialSecret returns the decrypted key of a credential.
+	GetCredentialSecret(ctx context.Context, id string) (string, error)
+	UpdateCredentialTest(ctx context.Context, id string, testedAt time.Time, status string, message string) error
+	DeleteCredential(ctx context.Context, id string) error
+}
+
+// CredentialUsecase manages tenant provider credentials.
+type CredentialUsecase struct {
+	repo CredentialRepo
+	log  *log.Helper
+}
+
+// NewCredentialUsecase creates a new CredentialUsecase.
+func NewCredentialUsecase(repo CredentialRepo, logger log.Logger) *CredentialUsecase {
+	return &CredentialUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// Enabled reports whether tenants can store credentials.
+func (uc *CredentialUsecase) Enabled() bool {
+	return uc.repo.Enabled()
+}
+
+// ListCredentials lists the credentials of the tenant.
+func (uc *CredentialUsecase) ListCredentials(ctx context.Context) ([]Credential, error) {
+	return uc.repo.ListCredentials(ctx)
+}
+
+// CreateCredential stores a new credential; a tenant has at most one per
+// provider.
+func (uc *CredentialUsecase) CreateCredential(ctx context.Context, input CredentialInput) (Credential, error) {
+	if !uc.repo.Enabled() {
+		return Credential{}, errors.BadRequest("CREDENTIAL_STORE_DISABLED", "provider credentials are not enabled on this deployment")
+	}
+	input.Provider = strings.ToLower(strings.TrimSpace(input.Provider))
+	input.Name = strings.TrimSpace(input.Name)
+	input.APIKey = strings.TrimSpace(input.APIKey)
+	if !supportedProviders[input.Provider] {
+		return Credential{}, errors.BadRequest("CREDENTIAL_PROVIDER_INVALID", "unsupported provider")
+	}
+	if input.APIKey == "" {
+		return Credential{}, errors.BadRequest("CREDENTIAL_KEY_MISSING", "api_key missing")
+	}
+	if len(input.APIKey) > maxAPIKeyLength || strings.ContainsAny(input.APIKey, " \t\r\n") {
+		return Credential{}, errors.BadRequest("CREDENTIAL_KEY_INVALID", "api_key invalid")
+	}
+	if len(input.Name) > maxNameLength {
+		return Credential{}, errors.BadRequest("CREDENTIAL_NAME_TOO_LONG", "name too long")
+	}
+	if input.Name == "" {
+		input.Name = input.Provider
+	}
+	createdBy := ""
+	if claims, ok := jwt.ClaimsFromContext(ctx); ok {
+		createdBy = claims.Subject
+	}
+	now := time.Now()
+	cred := Credential{
+		ID:        uuid.NewString(),
+		Provider:  input.Provider,
+		Name:      input.Name,
+		KeyHint:   keyHint(input.APIKey),
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := uc.repo.CreateCredential(ctx, cred, input.APIKey); err != nil {
+		return Credential{}, err
+	}
+	uc.log.WithContext(ctx).Infof("provider credential added: id=%s provider=%s", cred.ID, cred.Provider)
+	return uc.repo.GetCredential(ctx, cred.ID)
+}
+
+// TestCredential makes a minimal call with a credential against the model
+// the deployment uses with its provider, and records the outcome. Failed
+// calls are reported in the result rather than as an error.
+func (uc *CredentialUsecase) TestCredential(ctx context.Context, id string, deployment DeploymentProviders) (TestResult, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return TestResult{}, errors.BadRequest("CREDENTIAL_ID_MISSING", "id missing")
+	}
+	cred, err := uc.repo.GetCredential(ctx, id)
+	if err != nil {
+		return TestResult{}, err
+	}
+	apiKey, err := uc.repo.GetCredentialSecret(ctx, id)
+	if err != nil {
+		return TestResult{}, err
+	}
+
+	testCtx, cancel := context.WithTimeout(ctx, testTimeout)
+	defer cancel()
+	start := time.Now()
+	callErr := callProvider(testCtx, cred, apiKey, deployment)
+	result := TestResult{OK: callErr == nil, Latency: time.Since(start)}
+	status := TestStatusOK
+	if callErr != nil {
+		status = TestStatusFailed
+		result.Error = testErrorMessage(provider.RedactSecret(callErr, apiKey))
+	}
+	if err := uc.repo.UpdateCredentialTest(ctx, id, time.Now(), status, result.Error); err != nil {
+		return TestResult{}, err
+	}
+	result.Credential, err = uc.repo.GetCredential(ctx, id)
+	if err != nil {
+		return TestResult{}, err
+	}
+	uc.log.WithContext(ctx).Infof("provider credential tested: id=%s provider=%s status=%s", cred.ID, cred.Provider, status)
+	return result, nil
+}
+
+// DeleteCredential removes a credential; the tenant falls back to the
+// deployment keys within a minute.
+func (uc *CredentialUsecase) DeleteCredential(ctx context.Context, id string) error {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return errors.BadRequest("CREDENTIAL_ID_MISSING", "id missing")
+	}
+	if err := uc.repo.DeleteCredential(ctx, id); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("provider credential removed: id=%s", id)
+	return nil
+}
+
+// callProvider prefers the LLM, which every answer needs, and tests the
+// embedding model when only that uses the provider.
+func callProvider(ctx context.Context, cred Credential, apiKey string, deployment DeploymentProviders) error {
+	switch cred.Provider {
+	case providerName(deployment.LLM.Provider):
+		cfg := deployment.LLM
+		cfg.APIKey = apiKey
+		if strings.TrimSpace(cfg.Endpoint) == "" {
+			return errors.BadRequest("CREDENTIAL_ENDPOINT_MISSING", "no endpoint configured for this provider")
+		}
+		_, err := provider.NewLLMProvider(cfg).Generate(ctx, provider.LLMRequest{Prompt: "ping", MaxTokens: 1})
+		return err
+	case providerName(deployment.Embedding.Provider):
+		cfg := deployment.Embedding
+		cfg.APIKey = apiKey
+		if strings.TrimSpace(cfg.Endpoint) == "" {
+			return errors.BadRequest("CREDENTIAL_ENDPOINT_MISSING", "no endpoint configured for this provider")
+		}
+		_, err := provider.NewProvider(cfg).Embed(ctx, []string{"ping"})
+		return err
+	default:
+		return errors.BadRequest("CREDENTIAL_PROVIDER_UNUSED", "this deployment does not use the provider for its LLM or default embedding model")
+	}
+}
+
+func providerName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "openai"
+	}
+	return name
+}
+
+// keyHint keeps the last four characters of long keys only, so short keys
+// are not mostly revealed.
+func keyHint(apiKey string) string {
+	if len(apiKey) < 12 {
+		return "****"
+	}
+	return "****" + apiKey[len(apiKey)-4:]
+}
+
+func testErrorMessage(err error) string {
+	msg := err.Error()
+	if se := errors.FromError(err); se != nil && se.Message != "" {
+		msg = se.Message
+	}
+	if utf8.RuneCountInString(msg) > maxTestErrorRunes {
+		msg = string([]rune(msg)[:maxTestErrorRunes])
+	}
+	return msg
+}
@@ -0,0 +1,193 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"time"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/credential/biz"
+	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/secretbox"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/wire"
+)
+
+// ProviderSet is credential data providers.
+var ProviderSet = wire.NewSet(NewCredentialRepo)
+
+const credentialColumns = `id, tenant_id, provider, name, key_hint, last_tested_at, last_test_status, last_test_error, created_by, created_at, updated_at`
+
+type credentialRepo struct {
+	log     *log.Helper
+	db      *sql.DB
+	secrets *secretbox.Box
+}
+
+// NewCredentialRepo creates a new credential repo.
+func NewCredentialRepo(data *internaldata.Data, logger log.Logger) biz.CredentialRepo {
+	return &credentialRepo{log: log.NewHelper(logger), db: data.DB, secrets: data.Secrets}
+}
+
+func (r *credentialRepo) Enabled() bool {
+	return r.secrets != nil
+}
+
+func (r *credentialRepo) ListCredentials(ctx context.Context) ([]biz.Credential, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(ctx, "SELECT "+credentialColumns+" FROM provider_credential WHERE tenant_id = ? ORDER BY created_at", tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]biz.Credential, 0)
+	for rows.Next() {
+		cred, err := scanCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, cred)
+	}
+	return out, rows.Err()
+}
+
+func (r *credentialRepo) GetCredential(ctx context.Context, id string) (biz.Credential, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.Credential{}, err
+	}
+	row := r.db.QueryRowContext(ctx, "SELECT "+credentialColumns+" FROM provider_credential WHERE tenant_id = ? AND id = ?", tenantID, id)
+	cred, err := scanCredential(row)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.Credential{}, kerrors.NotFound("CREDENTIAL_NOT_FOUND", "credential not found")
+		}
+		return biz.Credential{}, err
+	}
+	return cred, nil
+}
+
+func (r *credentialRepo) CreateCredential(ctx context.Context, cred biz.Credential, apiKey string) error {
+	if r.secrets == nil {
+		return kerrors.BadRequest("CREDENTIAL_STORE_DISABLED", "provider credentials are not enabled on this deployment")
+	}
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	sealed, err := r.secrets.Seal([]byte(apiKey), internaldata.ProviderCredentialAAD(tenantID, cred.ID))
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		`INSERT INTO provider_credential (id, tenant_id, provider, name, key_hint, master_key_id, wrapped_key, ciphertext, created_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		cred.ID,
+		tenantID,
+		cred.Provider,
+		cred.Name,
+		cred.KeyHint,
+		sealed.KeyID,
+		sealed.WrappedKey,
+		sealed.Ciphertext,
+		cred.CreatedBy,
+		cred.CreatedAt,
+		cred.UpdatedAt,
+	)
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+		return kerrors.Conflict("CREDENTIAL_EXISTS", "a credential for this provider already exists")
+	}
+	return err
+}
+
+func (r *credentialRepo) GetCredentialSecret(ctx context.Context, id string) (string, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return "", err
+	}
+	var providerName string
+	if err := r.db.QueryRowContext(ctx, "SELECT provider FROM provider_credential WHERE tenant_id = ? AND id = ?", tenantID, id).Scan(&providerName); err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return "", kerrors.NotFound("CREDENTIAL_NOT_FOUND", "credential not found")
+		}
+		return "", err
+	}
+	cred, ok, err := internaldata.LoadProviderCredential(ctx, r.db, r.secrets, tenantID, providerName)
+	if err != nil {
+		return "", err
+	}
+	if !ok || cred.ID != id {
+		return "", kerrors.NotFound("CREDENTIAL_NOT_FOUND", "credential not found")
+	}
+	return cred.APIKey, nil
+}
+
+func (r *credentialRepo) UpdateCredentialTest(ctx context.Context, id string, testedAt time.Time, status string, message string) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		"UPDATE provider_credential SET last_tested_at = ?, last_test_status = ?, last_test_error = ? WHERE tenant_id = ? AND id = ?",
+		testedAt,
+		status,
+		message,
+		tenantID,
+		id,
+	)
+	return err
+}
+
+func (r *credentialRepo) DeleteCredential(ctx context.Context, id string) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, "DELETE FROM provider_credential WHERE tenant_id = ? AND id = ?", tenantID, id)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return kerrors.NotFound("CREDENTIAL_NOT_FOUND", "credential not found")
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCredential(row rowScanner) (biz.Credential, error) {
+	var (
+		cred     biz.Credential
+		testedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&cred.ID,
+		&cred.TenantID,
+		&cred.Provider,
+		&cred.Name,
+		&cred.KeyHint,
+		&testedAt,
+		&cred.LastTestStatus,
+		&cred.LastTestError,
+		&cred.CreatedBy,
+		&cred.CreatedAt,
+		&cred.UpdatedAt,
+	); err != nil {
+		return biz.Credential{}, err
+	}
+	if testedAt.Valid {
+		cred.LastTestedAt = testedAt.Time
+	}
+	return cred, nil
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/credential/v1"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/credential/biz"
+	iambiz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	ragbiz "github.com/ZTH7/RagoDesk/apps/server/internal/rag/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/wire"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ConsoleCredentialService lets tenants manage their own LLM provider keys.
+type ConsoleCredentialService struct {
+	v1.UnimplementedConsoleCredentialServer
+
+	uc  *biz.CredentialUsecase
+	rag *ragbiz.RAGUsecase
+	iam *iambiz.IAMUsecase
+}
+
+// NewConsoleCredentialService creates a new ConsoleCredentialService.
+func NewConsoleCredentialService(uc *biz.CredentialUsecase, rag *ragbiz.RAGUsecase, iam *iambiz.IAMUsecase) *ConsoleCredentialService {
+	return &ConsoleCredentialService{uc: uc, rag: rag, iam: iam}
+}
+
+func (s *ConsoleCredentialService) ListCredentials(ctx context.Context, _ *v1.ListCredentialsRequest) (*v1.ListCredentialsResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.iam.RequirePermission(ctx, biz.PermissionLLMCredentialRead); err != nil {
+		return nil, err
+	}
+	items, err := s.uc.ListCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*v1.Credential, 0, len(items))
+	for _, item := range items {
+		out = append(out, toCredential(item))
+	}
+	return &v1.ListCredentialsResponse{Items: out, Enabled: s.uc.Enabled()}, nil
+}
+
+func (s *ConsoleCredentialService) CreateCredential(ctx context.Context, req *v1.CreateCredentialRequest) (*v1.CredentialResponse, error) {
+	if req == nil {
+		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
+	}
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.iam.RequirePermission(ctx, biz.PermissionLLMCredentialWrite); err != nil {
+		return nil, err
+	}
+	cred, err := s.uc.CreateCredential(ctx, biz.CredentialInput{
+		Provider: req.GetProvider(),
+		Name:     req.GetName(),
+		APIKey:   req.GetApiKey(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CredentialResponse{Credential: toCredential(cred)}, nil
+}
+
+func (s *ConsoleCredentialService) TestCredential(ctx context.Context, req *v1.TestCredentialRequest) (*v1.TestCredentialResponse, error) {
+	if req == nil {
+		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
+	}
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.iam.RequirePermission(ctx, biz.PermissionLLMCredentialWrite); err != nil {
+		return nil, err
+	}
+	llm, embedding := s.rag.ProviderConfigs()
+	result, err := s.uc.TestCredential(ctx, req.GetId(), biz.DeploymentProviders{LLM: llm, Embedding: embedding})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TestCredentialResponse{
+		Ok:           result.OK,
+		ErrorMessage: result.Error,
+		LatencyMs:    int32(result.Latency.Milliseconds()),
+		Credential:   toCredential(result.Credential),
+	}, nil
+}
+
+func (s *ConsoleCredentialService) DeleteCredential(ctx context.Context, req *v1.DeleteCredentialRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, errors.BadRequest("REQUEST_EMPTY", "request empty")
+	}
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.iam.RequirePermission(ctx, biz.PermissionLLMCredentialWrite); err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteCredential(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func requireTenantContext(ctx context.Context) error {
+	if _, err := tenant.RequireTenantID(ctx); err != nil {
+		return errors.Forbidden("TENANT_MISSING", "tenant missing")
+	}
+	return nil
+}
+
+func toCredential(cred biz.Credential) *v1.Credential {
+	out := &v1.Credential{
+		Id:             cred.ID,
+		Provider:       cred.Provider,
+		Name:           cred.Name,
+		KeyHint:        cred.KeyHint,
+		LastTestStatus: cred.LastTestStatus,
+		LastTestError:  cred.LastTestError,
+		CreatedBy:      cred.CreatedBy,
+		CreatedAt:      timestamppb.New(cred.CreatedAt),
+		UpdatedAt:      timestamppb.New(cred.UpdatedAt),
+	}
+	if !cred.LastTestedAt.IsZero() {
+		out.LastTestedAt = timestamppb.New(cred.LastTestedAt)
+	}
+	return out
+}
+
+// ProviderSet is credential service providers.
+var ProviderSet = wire.NewSet(NewConsoleCredentialService)
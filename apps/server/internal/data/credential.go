@@ -0,0 +1,75 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"os"
+	"strings"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/secretbox"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// masterKeyEnv holds the credential master key when it is kept out of the
+// config file.
+const masterKeyEnv = "RAGODESK_CREDENTIALS_MASTER_KEY"
+
+func newSecretBox(c *conf.Data) (*secretbox.Box, error) {
+	encoded := strings.TrimSpace(c.GetCredentials().GetMasterKey())
+	if encoded == "" {
+		encoded = strings.TrimSpace(os.Getenv(masterKeyEnv))
+	}
+	if encoded == "" {
+		return nil, nil
+	}
+	key, err := secretbox.ParseKey(encoded)
+	if err != nil {
+		return nil, errors.InternalServer("CREDENTIAL_MASTER_KEY_INVALID", "credentials master key must be 32 bytes in base64 or hex")
+	}
+	return secretbox.New(key)
+}
+
+// ProviderCredentialAAD binds a sealed provider credential to its row, so
+// ciphertext copied to another tenant or credential does not open.
+func ProviderCredentialAAD(tenantID string, id string) []byte {
+	return []byte("provider_credential:" + tenantID + ":" + id)
+}
+
+// LoadProviderCredential loads and decrypts the credential a tenant stored
+// for a provider and reports false when there is none. A stored credential
+// that cannot be decrypted is an error, never a silent fallback to the
+// deployment key.
+func LoadProviderCredential(ctx context.Context, db *sql.DB, box *secretbox.Box, tenantID string, providerName string) (provider.Credential, bool, error) {
+	if db == nil {
+		return provider.Credential{}, false, nil
+	}
+	var (
+		cred   provider.Credential
+		sealed secretbox.Sealed
+	)
+	err := db.QueryRowContext(
+		ctx,
+		`SELECT id, provider, master_key_id, wrapped_key, ciphertext, updated_at
+		FROM provider_credential WHERE tenant_id = ? AND provider = ?`,
+		tenantID,
+		providerName,
+	).Scan(&cred.ID, &cred.Provider, &sealed.KeyID, &sealed.WrappedKey, &sealed.Ciphertext, &cred.UpdatedAt)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return provider.Credential{}, false, nil
+		}
+		return provider.Credential{}, false, err
+	}
+	if box == nil {
+		return provider.Credential{}, false, errors.InternalServer("CREDENTIAL_STORE_DISABLED", "provider credentials need a master key")
+	}
+	secret, err := box.Open(sealed, ProviderCredentialAAD(tenantID, cred.ID))
+	if err != nil {
+		return provider.Credential{}, false, errors.InternalServer("CREDENTIAL_DECRYPT_FAILED", "provider credential cannot be decrypted with the configured master key")
+	}
+	cred.APIKey = string(secret)
+	return cred, true, nil
+}
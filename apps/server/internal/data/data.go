@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/secretbox"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 
@@ -18,6 +19,9 @@ import (
 // Data .
 type Data struct {
 	DB *sql.DB
+	// Secrets seals tenant provider credentials; nil when no master key is
+	// configured.
+	Secrets *secretbox.Box
 }
 
 // NewData .
@@ -25,6 +29,10 @@ func NewData(c *conf.Data) (*Data, func(), error) {
 	if c == nil || c.Database == nil || c.Database.Driver == "" || c.Database.Source == "" {
 		return nil, nil, errors.InternalServer("DB_CONFIG_MISSING", "database config missing")
 	}
+	secrets, err := newSecretBox(c)
+	if err != nil {
+		return nil, nil, err
+	}
 	db, err := sql.Open(c.Database.Driver, c.Database.Source)
 	if err != nil {
 		return nil, nil, err
@@ -51,7 +59,7 @@ func NewData(c *conf.Data) (*Data, func(), error) {
 			log.Errorf("close database error: %v", err)
 		}
 	}
-	return &Data{DB: db}, cleanup, nil
+	return &Data{DB: db, Secrets: secrets}, cleanup, nil
 }
 
 func ensureSchemaAndSeed(ctx context.Context, db *sql.DB) error {
@@ -73,12 +81,51 @@ func ensureSchemaAndSeed(ctx context.Context, db *sql.DB) error {
 	if err := ensureAnalyticsSchema(ctx, db); err != nil {
 		return err
 	}
+	if err := ensureAuditSchema(ctx, db); err != nil {
+		return err
+	}
 	if err := seedIAMPermissions(ctx, db); err != nil {
 		return err
 	}
+	if err := seedTenantPlans(ctx, db); err != nil {
+		return err
+	}
 	return nil
 }
 
+func ensureAuditSchema(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS audit_log (
+		id VARCHAR(36) NOT NULL,
+		tenant_id VARCHAR(36) NOT NULL DEFAULT '',
+		actor_id VARCHAR(36) NOT NULL,
+		actor_type VARCHAR(16) NOT NULL,
+		operation VARCHAR(128) NOT NULL,
+		resource_type VARCHAR(64) NOT NULL,
+		resource_id VARCHAR(128) NULL,
+		request_summary TEXT NULL,
+		result VARCHAR(16) NOT NULL,
+		error_reason VARCHAR(128) NULL,
+		status_code INT NOT NULL,
+		client_ip VARCHAR(64) NULL,
+		user_agent VARCHAR(255) NULL,
+		impersonation_id VARCHAR(36) NULL,
+		created_at DATETIME NOT NULL,
+		PRIMARY KEY (id),
+		KEY idx_audit_log_tenant_created (tenant_id, created_at),
+		KEY idx_audit_log_actor_created (actor_id, created_at),
+		KEY idx_audit_log_resource (tenant_id, resource_type, resource_id),
+		KEY idx_audit_log_impersonation (impersonation_id, created_at),
+		KEY idx_audit_log_created_at (created_at)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`)
+	if err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "audit_log", "impersonation_id", "VARCHAR(36) NULL"); err != nil {
+		return err
+	}
+	return ensureIndex(ctx, db, "audit_log", "idx_audit_log_impersonation", "`impersonation_id`, `created_at`")
+}
+
 func ensureAnalyticsSchema(ctx context.Context, db *sql.DB) error {
 	statements := []string{
 		`CREATE TABLE IF NOT EXISTS analytics_event (
@@ -184,6 +231,7 @@ func ensureAPIMgmtSchema(ctx context.Context, db *sql.DB) error {
 			status VARCHAR(32) NOT NULL DEFAULT 'active',
 			quota_daily INT NOT NULL DEFAULT 0,
 			qps_limit INT NOT NULL DEFAULT 0,
+			entitlement_secret VARCHAR(128) NULL,
 			created_at DATETIME NOT NULL,
 			last_used_at DATETIME NULL,
 			PRIMARY KEY (id),
@@ -256,6 +304,9 @@ func ensureAPIMgmtSchema(ctx context.Context, db *sql.DB) error {
 	if err := ensureColumn(ctx, db, "api_key", "last_used_at", "DATETIME NULL"); err != nil {
 		return err
 	}
+	if err := ensureColumn(ctx, db, "api_key", "entitlement_secret", "VARCHAR(128) NULL"); err != nil {
+		return err
+	}
 	if _, err := db.ExecContext(ctx, "UPDATE api_key SET public_chat_id = CONCAT('chat_', REPLACE(UUID(), '-', '')) WHERE public_chat_id = '' OR public_chat_id IS NULL"); err != nil {
 		return err
 	}
@@ -340,6 +391,30 @@ func ensureIAMSchema(ctx context.Context, db *sql.DB) error {
 			KEY idx_user_role_user (user_id),
 			KEY idx_user_role_role (role_id)
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS user_role_scope (
+			user_id VARCHAR(36) NOT NULL,
+			role_id VARCHAR(36) NOT NULL,
+			resource_type VARCHAR(32) NOT NULL,
+			resource_id VARCHAR(36) NOT NULL,
+			PRIMARY KEY (user_id, role_id, resource_type, resource_id),
+			KEY idx_user_role_scope_resource (resource_type, resource_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS resource_group (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			name VARCHAR(128) NOT NULL,
+			description VARCHAR(512) NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			UNIQUE KEY uniq_resource_group_tenant_name (tenant_id, name)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS resource_group_member (
+			group_id VARCHAR(36) NOT NULL,
+			resource_type VARCHAR(32) NOT NULL,
+			resource_id VARCHAR(36) NOT NULL,
+			PRIMARY KEY (group_id, resource_type, resource_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
 		`CREATE TABLE IF NOT EXISTS role_permission (
 			role_id VARCHAR(36) NOT NULL,
 			permission_id VARCHAR(36) NOT NULL,
@@ -378,6 +453,214 @@ func ensureIAMSchema(ctx context.Context, db *sql.DB) error {
 			KEY idx_platform_role_permission_role (role_id),
 			KEY idx_platform_role_permission_permission (permission_id)
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS refresh_token (
+			id VARCHAR(36) NOT NULL,
+			session_id VARCHAR(36) NOT NULL,
+			subject_type VARCHAR(16) NOT NULL,
+			subject_id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL DEFAULT '',
+			token_hash VARCHAR(64) NOT NULL,
+			access_jti VARCHAR(36) NOT NULL,
+			access_expires_at DATETIME NOT NULL,
+			expires_at DATETIME NOT NULL,
+			used_at DATETIME NULL,
+			revoked_at DATETIME NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			UNIQUE KEY uniq_refresh_token_hash (token_hash),
+			KEY idx_refresh_token_session (session_id),
+			KEY idx_refresh_token_subject (subject_type, subject_id, revoked_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS revoked_token (
+			jti VARCHAR(36) NOT NULL,
+			subject_type VARCHAR(16) NOT NULL,
+			subject_id VARCHAR(36) NOT NULL,
+			reason VARCHAR(32) NOT NULL DEFAULT '',
+			expires_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (jti),
+			KEY idx_revoked_token_expires (expires_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS tenant_sso_config (
+			tenant_id VARCHAR(36) NOT NULL,
+			protocol VARCHAR(16) NOT NULL DEFAULT 'oidc',
+			enabled TINYINT(1) NOT NULL DEFAULT 0,
+			enforce_sso TINYINT(1) NOT NULL DEFAULT 0,
+			jit_provisioning TINYINT(1) NOT NULL DEFAULT 0,
+			default_role_id VARCHAR(36) NULL,
+			oidc_issuer VARCHAR(512) NULL,
+			oidc_client_id VARCHAR(255) NULL,
+			oidc_client_secret TEXT NULL,
+			oidc_scopes VARCHAR(512) NULL,
+			saml_idp_entity_id VARCHAR(512) NULL,
+			saml_idp_sso_url VARCHAR(1024) NULL,
+			saml_idp_certificate TEXT NULL,
+			email_attribute VARCHAR(255) NULL,
+			name_attribute VARCHAR(255) NULL,
+			role_mappings_json TEXT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (tenant_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS sso_login_state (
+			state VARCHAR(64) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			protocol VARCHAR(16) NOT NULL,
+			nonce VARCHAR(64) NOT NULL DEFAULT '',
+			code_verifier VARCHAR(128) NOT NULL DEFAULT '',
+			request_id VARCHAR(64) NOT NULL DEFAULT '',
+			expires_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (state),
+			KEY idx_sso_login_state_expires (expires_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS sso_ticket (
+			ticket_hash VARCHAR(64) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			user_id VARCHAR(36) NOT NULL,
+			expires_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (ticket_hash),
+			KEY idx_sso_ticket_expires (expires_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS user_identity (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			user_id VARCHAR(36) NOT NULL,
+			protocol VARCHAR(16) NOT NULL,
+			issuer VARCHAR(255) NOT NULL,
+			subject VARCHAR(255) NOT NULL,
+			email VARCHAR(255) NULL,
+			last_login_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			UNIQUE KEY uniq_user_identity_subject (tenant_id, issuer, subject),
+			KEY idx_user_identity_user (user_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS mfa_factor (
+			subject_type VARCHAR(16) NOT NULL,
+			subject_id VARCHAR(36) NOT NULL,
+			secret VARCHAR(64) NOT NULL,
+			confirmed_at DATETIME NULL,
+			last_used_step BIGINT NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (subject_type, subject_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS mfa_recovery_code (
+			id VARCHAR(36) NOT NULL,
+			subject_type VARCHAR(16) NOT NULL,
+			subject_id VARCHAR(36) NOT NULL,
+			code_hash VARCHAR(64) NOT NULL,
+			used_at DATETIME NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			UNIQUE KEY uniq_mfa_recovery_code (subject_type, subject_id, code_hash)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS mfa_challenge (
+			token_hash VARCHAR(64) NOT NULL,
+			subject_type VARCHAR(16) NOT NULL,
+			subject_id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL DEFAULT '',
+			enrollment TINYINT(1) NOT NULL DEFAULT 0,
+			attempts INT NOT NULL DEFAULT 0,
+			expires_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (token_hash),
+			KEY idx_mfa_challenge_expires (expires_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS mfa_policy (
+			subject_type VARCHAR(16) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL DEFAULT '',
+			require_all TINYINT(1) NOT NULL DEFAULT 0,
+			required_role_ids_json TEXT NULL,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (subject_type, tenant_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS jwt_signing_key (
+			id VARCHAR(64) NOT NULL,
+			algorithm VARCHAR(16) NOT NULL,
+			private_key_pem TEXT NOT NULL,
+			activates_at DATETIME NOT NULL,
+			expires_at DATETIME NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			KEY idx_jwt_signing_key_activates (activates_at),
+			KEY idx_jwt_signing_key_expires (expires_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS account_token (
+			token_hash VARCHAR(64) NOT NULL,
+			purpose VARCHAR(32) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			user_id VARCHAR(36) NOT NULL,
+			expires_at DATETIME NOT NULL,
+			used_at DATETIME NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (token_hash),
+			KEY idx_account_token_user (user_id, purpose),
+			KEY idx_account_token_expires (expires_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS impersonation_session (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			admin_id VARCHAR(36) NOT NULL,
+			admin_name VARCHAR(255) NOT NULL DEFAULT '',
+			reason VARCHAR(512) NOT NULL,
+			read_only TINYINT(1) NOT NULL DEFAULT 1,
+			access_jti VARCHAR(36) NOT NULL,
+			expires_at DATETIME NOT NULL,
+			revoked_at DATETIME NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			KEY idx_impersonation_session_tenant (tenant_id, created_at),
+			KEY idx_impersonation_session_admin (admin_id, created_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS provider_credential (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			provider VARCHAR(32) NOT NULL,
+			name VARCHAR(255) NOT NULL DEFAULT '',
+			key_hint VARCHAR(16) NOT NULL DEFAULT '',
+			master_key_id VARCHAR(16) NOT NULL,
+			wrapped_key VARBINARY(128) NOT NULL,
+			ciphertext VARBINARY(2048) NOT NULL,
+			last_tested_at DATETIME NULL,
+			last_test_status VARCHAR(16) NOT NULL DEFAULT '',
+			last_test_error VARCHAR(512) NOT NULL DEFAULT '',
+			created_by VARCHAR(36) NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			UNIQUE KEY uk_provider_credential_tenant_provider (tenant_id, provider)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS tenant_plan (
+			code VARCHAR(32) NOT NULL,
+			name VARCHAR(255) NOT NULL,
+			max_bots BIGINT NOT NULL DEFAULT 0,
+			max_knowledge_bases BIGINT NOT NULL DEFAULT 0,
+			max_documents BIGINT NOT NULL DEFAULT 0,
+			max_storage_bytes BIGINT NOT NULL DEFAULT 0,
+			monthly_queries BIGINT NOT NULL DEFAULT 0,
+			monthly_tokens BIGINT NOT NULL DEFAULT 0,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (code)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS tenant_purge_job (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			tenant_name VARCHAR(255) NOT NULL DEFAULT '',
+			status VARCHAR(32) NOT NULL,
+			requested_by VARCHAR(36) NOT NULL DEFAULT '',
+			report_json TEXT NULL,
+			error_message TEXT NULL,
+			heartbeat_at DATETIME NULL,
+			created_at DATETIME NOT NULL,
+			started_at DATETIME NULL,
+			finished_at DATETIME NULL,
+			PRIMARY KEY (id),
+			KEY idx_tenant_purge_job_tenant (tenant_id, created_at),
+			KEY idx_tenant_purge_job_status (status, heartbeat_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
 	}
 
 	for _, stmt := range statements {
@@ -395,6 +678,12 @@ func ensureIAMSchema(ctx context.Context, db *sql.DB) error {
 	if _, err := db.ExecContext(ctx, "UPDATE tenant SET `type` = 'enterprise' WHERE `type` IS NULL OR `type` = ''"); err != nil {
 		return err
 	}
+	if _, err := db.ExecContext(ctx, "UPDATE tenant SET status = 'active' WHERE status IS NULL OR status = ''"); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, "UPDATE tenant SET plan = 'free' WHERE plan IS NULL OR plan = ''"); err != nil {
+		return err
+	}
 
 	if err := ensureColumn(ctx, db, "permission", "scope", "VARCHAR(32) NOT NULL DEFAULT 'platform'"); err != nil {
 		return err
@@ -449,6 +738,10 @@ func ensureKnowledgeSchema(ctx context.Context, db *sql.DB) error {
 			tenant_id VARCHAR(36) NOT NULL,
 			name VARCHAR(255) NOT NULL,
 			description TEXT NULL,
+			chunking_config TEXT NULL,
+			embedding_config TEXT NULL,
+			dedup_config TEXT NULL,
+			index_generation INT NOT NULL DEFAULT 0,
 			created_at DATETIME NOT NULL,
 			updated_at DATETIME NOT NULL,
 			PRIMARY KEY (id),
@@ -477,6 +770,11 @@ func ensureKnowledgeSchema(ctx context.Context, db *sql.DB) error {
 			version INT NOT NULL,
 			raw_uri VARCHAR(1024) NULL,
 			index_config_hash VARCHAR(64) NOT NULL DEFAULT '',
+			vector_collection VARCHAR(255) NOT NULL DEFAULT '',
+			reused_chunks INT NOT NULL DEFAULT 0,
+			embedded_chunks INT NOT NULL DEFAULT 0,
+			skipped_chunks INT NOT NULL DEFAULT 0,
+			size_bytes BIGINT NOT NULL DEFAULT 0,
 			status VARCHAR(32) NOT NULL,
 			error_message TEXT NULL,
 			created_at DATETIME NOT NULL,
@@ -499,21 +797,91 @@ func ensureKnowledgeSchema(ctx context.Context, db *sql.DB) error {
 			section VARCHAR(255) NULL,
 			page_no INT NULL,
 			source_uri VARCHAR(1024) NULL,
+			confidence DOUBLE NOT NULL DEFAULT 1,
+			simhash BIGINT UNSIGNED NOT NULL DEFAULT 0,
+			simhash_band0 SMALLINT UNSIGNED NOT NULL DEFAULT 0,
+			simhash_band1 SMALLINT UNSIGNED NOT NULL DEFAULT 0,
+			simhash_band2 SMALLINT UNSIGNED NOT NULL DEFAULT 0,
+			simhash_band3 SMALLINT UNSIGNED NOT NULL DEFAULT 0,
+			origin_hash VARCHAR(64) NOT NULL DEFAULT '',
+			excluded TINYINT(1) NOT NULL DEFAULT 0,
+			keywords TEXT NULL,
 			created_at DATETIME NOT NULL,
 			PRIMARY KEY (id),
 			UNIQUE KEY uniq_chunk_version_index (document_version_id, chunk_index),
 			KEY idx_chunk_tenant_kb (tenant_id, kb_id),
-			KEY idx_chunk_version (tenant_id, document_version_id)
+			KEY idx_chunk_version (tenant_id, document_version_id),
+			KEY idx_chunk_simhash_band0 (tenant_id, kb_id, simhash_band0),
+			KEY idx_chunk_simhash_band1 (tenant_id, kb_id, simhash_band1),
+			KEY idx_chunk_simhash_band2 (tenant_id, kb_id, simhash_band2),
+			KEY idx_chunk_simhash_band3 (tenant_id, kb_id, simhash_band3),
+			KEY idx_chunk_content_hash (tenant_id, kb_id, content_hash),
+			FULLTEXT KEY ft_chunk_content (content, keywords) WITH PARSER ngram
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS chunk_override (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			kb_id VARCHAR(36) NOT NULL,
+			document_id VARCHAR(36) NOT NULL,
+			origin_hash VARCHAR(64) NOT NULL,
+			content LONGTEXT NULL,
+			excluded TINYINT(1) NOT NULL DEFAULT 0,
+			keywords TEXT NULL,
+			updated_by VARCHAR(36) NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			UNIQUE KEY uniq_chunk_override (tenant_id, document_id, origin_hash)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS chunk_edit (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			kb_id VARCHAR(36) NOT NULL,
+			document_id VARCHAR(36) NOT NULL,
+			document_version_id VARCHAR(36) NOT NULL,
+			chunk_id VARCHAR(36) NOT NULL,
+			origin_hash VARCHAR(64) NOT NULL,
+			actor_id VARCHAR(36) NOT NULL DEFAULT '',
+			before_json TEXT NULL,
+			after_json TEXT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			KEY idx_chunk_edit_document (tenant_id, document_id, created_at),
+			KEY idx_chunk_edit_chunk (tenant_id, chunk_id, created_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS chunk_duplicate (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			kb_id VARCHAR(36) NOT NULL,
+			document_id VARCHAR(36) NOT NULL,
+			document_version_id VARCHAR(36) NOT NULL,
+			chunk_id VARCHAR(36) NOT NULL,
+			chunk_index INT NOT NULL,
+			content_hash VARCHAR(64) NOT NULL,
+			content_preview TEXT NULL,
+			duplicate_chunk_id VARCHAR(36) NOT NULL,
+			duplicate_document_id VARCHAR(36) NOT NULL,
+			method VARCHAR(16) NOT NULL,
+			distance INT NOT NULL DEFAULT 0,
+			similarity DOUBLE NOT NULL DEFAULT 0,
+			skipped TINYINT(1) NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			KEY idx_chunk_duplicate_kb (tenant_id, kb_id),
+			KEY idx_chunk_duplicate_version (tenant_id, document_version_id),
+			KEY idx_chunk_duplicate_document (tenant_id, document_id)
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
 		`CREATE TABLE IF NOT EXISTS embedding (
 			id VARCHAR(36) NOT NULL,
 			tenant_id VARCHAR(36) NOT NULL,
 			chunk_id VARCHAR(36) NOT NULL,
 			model VARCHAR(128) NOT NULL,
+			content_hash VARCHAR(64) NOT NULL DEFAULT '',
 			created_at DATETIME NOT NULL,
 			PRIMARY KEY (id),
 			UNIQUE KEY uniq_embedding_chunk_model (chunk_id, model),
-			KEY idx_embedding_tenant_chunk (tenant_id, chunk_id)
+			KEY idx_embedding_tenant_chunk (tenant_id, chunk_id),
+			KEY idx_embedding_content_hash (tenant_id, model, content_hash)
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
 		`CREATE TABLE IF NOT EXISTS bot_kb (
 			id VARCHAR(36) NOT NULL,
@@ -527,6 +895,102 @@ func ensureKnowledgeSchema(ctx context.Context, db *sql.DB) error {
 			KEY idx_bot_kb_tenant_bot (tenant_id, bot_id),
 			KEY idx_bot_kb_tenant_kb (tenant_id, kb_id)
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS kb_migration (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			kb_id VARCHAR(36) NOT NULL,
+			status VARCHAR(32) NOT NULL,
+			generation INT NOT NULL,
+			chunking_config TEXT NULL,
+			embedding_config TEXT NULL,
+			index_config_hash VARCHAR(64) NOT NULL DEFAULT '',
+			total_documents INT NOT NULL DEFAULT 0,
+			processed_documents INT NOT NULL DEFAULT 0,
+			failed_documents INT NOT NULL DEFAULT 0,
+			error_message TEXT NULL,
+			heartbeat_at DATETIME NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			finished_at DATETIME NULL,
+			PRIMARY KEY (id),
+			KEY idx_kb_migration_tenant_kb (tenant_id, kb_id, created_at),
+			KEY idx_kb_migration_status (status, heartbeat_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS kb_migration_item (
+			migration_id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			document_id VARCHAR(36) NOT NULL,
+			source_version INT NOT NULL,
+			target_version_id VARCHAR(36) NOT NULL DEFAULT '',
+			target_version INT NOT NULL DEFAULT 0,
+			status VARCHAR(32) NOT NULL,
+			error_message TEXT NULL,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (migration_id, document_id),
+			KEY idx_kb_migration_item_tenant (tenant_id, migration_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS ingestion_job (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			kb_id VARCHAR(36) NOT NULL DEFAULT '',
+			document_id VARCHAR(36) NOT NULL,
+			document_version_id VARCHAR(36) NOT NULL,
+			fallback_version INT NOT NULL DEFAULT 0,
+			status VARCHAR(32) NOT NULL,
+			current_step VARCHAR(32) NOT NULL DEFAULT '',
+			attempts INT NOT NULL DEFAULT 0,
+			chunk_count INT NOT NULL DEFAULT 0,
+			reused_chunks INT NOT NULL DEFAULT 0,
+			embedded_chunks INT NOT NULL DEFAULT 0,
+			step_durations TEXT NULL,
+			error_message TEXT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			started_at DATETIME NULL,
+			finished_at DATETIME NULL,
+			PRIMARY KEY (id),
+			KEY idx_ingestion_job_kb (tenant_id, kb_id, created_at),
+			KEY idx_ingestion_job_document (tenant_id, document_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS import_batch (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			kb_id VARCHAR(36) NOT NULL,
+			folder_mode VARCHAR(16) NOT NULL,
+			source VARCHAR(16) NOT NULL,
+			total_files INT NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			KEY idx_import_batch_kb (tenant_id, kb_id, created_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS import_batch_item (
+			id VARCHAR(36) NOT NULL,
+			tenant_id VARCHAR(36) NOT NULL,
+			batch_id VARCHAR(36) NOT NULL,
+			path VARCHAR(1024) NOT NULL,
+			source_type VARCHAR(32) NOT NULL DEFAULT '',
+			document_id VARCHAR(36) NULL,
+			status VARCHAR(16) NOT NULL,
+			error_message TEXT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			KEY idx_import_batch_item_batch (tenant_id, batch_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
+		`CREATE TABLE IF NOT EXISTS vector_outbox (
+			id BIGINT NOT NULL AUTO_INCREMENT,
+			tenant_id VARCHAR(36) NOT NULL,
+			document_id VARCHAR(36) NOT NULL,
+			op VARCHAR(16) NOT NULL,
+			collection VARCHAR(255) NOT NULL,
+			dim INT NOT NULL DEFAULT 0,
+			payload LONGBLOB NOT NULL,
+			attempts INT NOT NULL DEFAULT 0,
+			last_error TEXT NULL,
+			next_attempt_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (id),
+			KEY idx_vector_outbox_document (tenant_id, document_id, id)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
 	}
 
 	for _, stmt := range statements {
@@ -540,12 +1004,54 @@ func ensureKnowledgeSchema(ctx context.Context, db *sql.DB) error {
 	if err := ensureDropColumn(ctx, db, "bot_kb", "priority"); err != nil {
 		return err
 	}
+	if err := ensureColumn(ctx, db, "document", "tags", "TEXT NULL"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "document", "section_prefix", "VARCHAR(255) NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "document", "access_labels", "TEXT NULL"); err != nil {
+		return err
+	}
 	if err := ensureColumn(ctx, db, "document_version", "raw_uri", "VARCHAR(1024) NULL"); err != nil {
 		return err
 	}
 	if err := ensureColumn(ctx, db, "document_version", "index_config_hash", "VARCHAR(64) NOT NULL DEFAULT ''"); err != nil {
 		return err
 	}
+	if err := ensureColumn(ctx, db, "document_version", "vector_collection", "VARCHAR(255) NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "document_version", "reused_chunks", "INT NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "document_version", "embedded_chunks", "INT NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "document_version", "skipped_chunks", "INT NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "document_version", "size_bytes", "BIGINT NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "embedding", "content_hash", "VARCHAR(64) NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+	if err := ensureIndex(ctx, db, "embedding", "idx_embedding_content_hash", "`tenant_id`, `model`, `content_hash`"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "knowledge_base", "chunking_config", "TEXT NULL"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "knowledge_base", "embedding_config", "TEXT NULL"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "knowledge_base", "dedup_config", "TEXT NULL"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "knowledge_base", "index_generation", "INT NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
 	if err := ensureColumn(ctx, db, "doc_chunk", "section", "VARCHAR(255) NULL"); err != nil {
 		return err
 	}
@@ -555,6 +1061,36 @@ func ensureKnowledgeSchema(ctx context.Context, db *sql.DB) error {
 	if err := ensureColumn(ctx, db, "doc_chunk", "source_uri", "VARCHAR(1024) NULL"); err != nil {
 		return err
 	}
+	if err := ensureColumn(ctx, db, "doc_chunk", "confidence", "DOUBLE NOT NULL DEFAULT 1"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "doc_chunk", "simhash", "BIGINT UNSIGNED NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+	for i := 0; i < 4; i++ {
+		band := fmt.Sprintf("simhash_band%d", i)
+		if err := ensureColumn(ctx, db, "doc_chunk", band, "SMALLINT UNSIGNED NOT NULL DEFAULT 0"); err != nil {
+			return err
+		}
+		if err := ensureIndex(ctx, db, "doc_chunk", "idx_chunk_"+band, "`tenant_id`, `kb_id`, `"+band+"`"); err != nil {
+			return err
+		}
+	}
+	if err := ensureIndex(ctx, db, "doc_chunk", "idx_chunk_content_hash", "`tenant_id`, `kb_id`, `content_hash`"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "doc_chunk", "origin_hash", "VARCHAR(64) NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "doc_chunk", "excluded", "TINYINT(1) NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+	if err := ensureColumn(ctx, db, "doc_chunk", "keywords", "TEXT NULL"); err != nil {
+		return err
+	}
+	if err := ensureFulltextIndex(ctx, db, "doc_chunk", "ft_chunk_content", "`content`, `keywords`"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -568,6 +1104,7 @@ func ensureConversationSchema(ctx context.Context, db *sql.DB) error {
 			close_reason VARCHAR(64) NULL,
 			user_external_id VARCHAR(128) NULL,
 			metadata TEXT NULL,
+			entitlements TEXT NULL,
 			created_at DATETIME NOT NULL,
 			updated_at DATETIME NOT NULL,
 			closed_at DATETIME NULL,
@@ -587,7 +1124,8 @@ func ensureConversationSchema(ctx context.Context, db *sql.DB) error {
 			references_json TEXT NULL,
 			created_at DATETIME NOT NULL,
 			PRIMARY KEY (id),
-			KEY idx_chat_message_session (tenant_id, session_id, created_at)
+			KEY idx_chat_message_session (tenant_id, session_id, created_at),
+			KEY idx_chat_message_created_at (tenant_id, created_at)
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`,
 		`CREATE TABLE IF NOT EXISTS session_event (
 			id VARCHAR(36) NOT NULL,
@@ -618,6 +1156,12 @@ func ensureConversationSchema(ctx context.Context, db *sql.DB) error {
 			return err
 		}
 	}
+	if err := ensureColumn(ctx, db, "chat_session", "entitlements", "TEXT NULL"); err != nil {
+		return err
+	}
+	if err := ensureIndex(ctx, db, "chat_message", "idx_chat_message_created_at", "`tenant_id`, `created_at`"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -711,6 +1255,29 @@ func ensureUniqueIndex(ctx context.Context, db *sql.DB, table string, indexName
 	return err
 }
 
+// ensureFulltextIndex creates an InnoDB full-text index with the ngram
+// parser, which also tokenizes Chinese and Japanese.
+func ensureFulltextIndex(ctx context.Context, db *sql.DB, table string, indexName string, definition string) error {
+	var count int
+	err := db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*)
+		FROM INFORMATION_SCHEMA.STATISTICS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND INDEX_NAME = ?`,
+		table,
+		indexName,
+	).Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	query := fmt.Sprintf("CREATE FULLTEXT INDEX `%s` ON `%s` (%s) WITH PARSER ngram", indexName, table, definition)
+	_, err = db.ExecContext(ctx, query)
+	return err
+}
+
 func ensureUniqueIndexSafe(ctx context.Context, db *sql.DB, table string, indexName string, definition string) error {
 	err := ensureUniqueIndex(ctx, db, table, indexName, definition)
 	if err == nil {
@@ -735,6 +1302,10 @@ func seedIAMPermissions(ctx context.Context, db *sql.DB) error {
 		{code: "platform.tenant.create", description: "Create tenant", scope: "platform"},
 		{code: "platform.tenant.read", description: "Read tenant", scope: "platform"},
 		{code: "platform.tenant.write", description: "Update tenant plan/status/quota", scope: "platform"},
+		{code: "platform.tenant.delete", description: "Delete tenants and purge their data", scope: "platform"},
+		{code: "platform.tenant.impersonate", description: "Open read-only tenant consoles as a platform admin", scope: "platform"},
+		{code: "platform.tenant.impersonate_write", description: "Open writable tenant consoles as a platform admin", scope: "platform"},
+		{code: "platform.plan.write", description: "Update plan limits", scope: "platform"},
 		{code: "platform.admin.create", description: "Create platform admin", scope: "platform"},
 		{code: "platform.admin.read", description: "Read platform admin", scope: "platform"},
 		{code: "platform.role.write", description: "Create/update platform role", scope: "platform"},
@@ -745,13 +1316,26 @@ func seedIAMPermissions(ctx context.Context, db *sql.DB) error {
 		{code: "platform.permission.write", description: "Create permission", scope: "platform"},
 		{code: "platform.config.read", description: "Read platform configuration", scope: "platform"},
 		{code: "platform.config.write", description: "Update platform configuration", scope: "platform"},
+		{code: "platform.knowledge_base.clone", description: "Clone knowledge bases across tenants", scope: "platform"},
+		{code: "platform.mfa_policy.read", description: "Read platform MFA policy", scope: "platform"},
+		{code: "platform.mfa_policy.write", description: "Update platform MFA policy", scope: "platform"},
+		{code: "platform.audit_log.read", description: "Read audit logs of all tenants", scope: "platform"},
 		{code: "tenant.user.read", description: "Read tenant users", scope: "tenant"},
 		{code: "tenant.user.write", description: "Create/update tenant users", scope: "tenant"},
+		{code: "tenant.user.delete", description: "Delete tenant users", scope: "tenant"},
 		{code: "tenant.role.read", description: "Read tenant roles", scope: "tenant"},
 		{code: "tenant.role.write", description: "Create/update tenant roles", scope: "tenant"},
+		{code: "tenant.role.delete", description: "Delete tenant roles", scope: "tenant"},
 		{code: "tenant.role.assign", description: "Assign role to user", scope: "tenant"},
 		{code: "tenant.role.permission.assign", description: "Assign permissions to role", scope: "tenant"},
 		{code: "tenant.permission.read", description: "Read tenant permission catalog", scope: "tenant"},
+		{code: "tenant.resource_group.read", description: "Read resource groups", scope: "tenant"},
+		{code: "tenant.resource_group.write", description: "Create/update/delete resource groups", scope: "tenant"},
+		{code: "tenant.sso.read", description: "Read single sign-on configuration", scope: "tenant"},
+		{code: "tenant.sso.write", description: "Update single sign-on configuration", scope: "tenant"},
+		{code: "tenant.mfa_policy.read", description: "Read MFA policy", scope: "tenant"},
+		{code: "tenant.mfa_policy.write", description: "Update MFA policy", scope: "tenant"},
+		{code: "tenant.audit_log.read", description: "Read and export audit logs", scope: "tenant"},
 		{code: "tenant.bot.read", description: "Read bots", scope: "tenant"},
 		{code: "tenant.bot.write", description: "Create/update bots", scope: "tenant"},
 		{code: "tenant.bot.delete", description: "Delete bots", scope: "tenant"},
@@ -765,11 +1349,14 @@ func seedIAMPermissions(ctx context.Context, db *sql.DB) error {
 		{code: "tenant.document.delete", description: "Delete documents", scope: "tenant"},
 		{code: "tenant.document.reindex", description: "Reindex documents", scope: "tenant"},
 		{code: "tenant.document.rollback", description: "Rollback document versions", scope: "tenant"},
+		{code: "tenant.chunk.write", description: "Edit document chunks", scope: "tenant"},
 		{code: "tenant.api_key.read", description: "Read API keys", scope: "tenant"},
 		{code: "tenant.api_key.write", description: "Create/update API keys", scope: "tenant"},
 		{code: "tenant.api_key.delete", description: "Delete API keys", scope: "tenant"},
 		{code: "tenant.api_key.rotate", description: "Rotate API keys", scope: "tenant"},
 		{code: "tenant.api_usage.read", description: "Read API usage logs", scope: "tenant"},
+		{code: "tenant.llm_credential.read", description: "Read LLM provider credentials", scope: "tenant"},
+		{code: "tenant.llm_credential.write", description: "Add/test/remove LLM provider credentials", scope: "tenant"},
 		{code: "tenant.analytics.read", description: "Read analytics dashboard", scope: "tenant"},
 		{code: "tenant.chat_session.read", description: "Read chat sessions", scope: "tenant"},
 		{code: "tenant.chat_message.read", description: "Read chat messages", scope: "tenant"},
@@ -0,0 +1,149 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+type planSeed struct {
+	code   string
+	name   string
+	limits plan.Limits
+}
+
+// seedTenantPlans creates the built-in plans; limits changed by platform
+// admins are kept.
+func seedTenantPlans(ctx context.Context, db *sql.DB) error {
+	seeds := []planSeed{
+		{code: plan.Free, name: "Free", limits: plan.Limits{
+			MaxBots:           2,
+			MaxKnowledgeBases: 2,
+			MaxDocuments:      200,
+			MaxStorageBytes:   100 << 20,
+			MonthlyQueries:    1000,
+			MonthlyTokens:     1000000,
+		}},
+		{code: plan.Pro, name: "Pro", limits: plan.Limits{
+			MaxBots:           20,
+			MaxKnowledgeBases: 20,
+			MaxDocuments:      5000,
+			MaxStorageBytes:   5 << 30,
+			MonthlyQueries:    50000,
+			MonthlyTokens:     50000000,
+		}},
+		{code: plan.Enterprise, name: "Enterprise"},
+	}
+	now := time.Now()
+	for _, item := range seeds {
+		if _, err := db.ExecContext(
+			ctx,
+			`INSERT IGNORE INTO tenant_plan
+				(code, name, max_bots, max_knowledge_bases, max_documents, max_storage_bytes, monthly_queries, monthly_tokens, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			item.code,
+			item.name,
+			item.limits.MaxBots,
+			item.limits.MaxKnowledgeBases,
+			item.limits.MaxDocuments,
+			item.limits.MaxStorageBytes,
+			item.limits.MonthlyQueries,
+			item.limits.MonthlyTokens,
+			now,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Queryer runs single-row queries; *sql.DB and *sql.Tx implement it.
+type Queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// LoadSubscription loads the status and plan limits of a tenant. A plan code
+// without a tenant_plan row has no limits.
+func LoadSubscription(ctx context.Context, db Queryer, tenantID string) (plan.Subscription, error) {
+	var sub plan.Subscription
+	var maxBots, maxKBs, maxDocs, maxStorage, queries, tokens sql.NullInt64
+	err := db.QueryRowContext(
+		ctx,
+		`SELECT t.id, t.status, t.plan, p.max_bots, p.max_knowledge_bases, p.max_documents, p.max_storage_bytes, p.monthly_queries, p.monthly_tokens
+		FROM tenant t LEFT JOIN tenant_plan p ON p.code = t.plan
+		WHERE t.id = ?`,
+		tenantID,
+	).Scan(&sub.TenantID, &sub.Status, &sub.Plan, &maxBots, &maxKBs, &maxDocs, &maxStorage, &queries, &tokens)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return plan.Subscription{}, errors.NotFound("TENANT_NOT_FOUND", "tenant not found")
+		}
+		return plan.Subscription{}, err
+	}
+	sub.Limits = plan.Limits{
+		MaxBots:           maxBots.Int64,
+		MaxKnowledgeBases: maxKBs.Int64,
+		MaxDocuments:      maxDocs.Int64,
+		MaxStorageBytes:   maxStorage.Int64,
+		MonthlyQueries:    queries.Int64,
+		MonthlyTokens:     tokens.Int64,
+	}
+	return sub, nil
+}
+
+// usageQueries count the use of each resource limited by a plan; the monthly
+// quotas are counted in Redis instead.
+var usageQueries = map[string]string{
+	plan.ResourceBots:           "SELECT COUNT(*) FROM bot WHERE tenant_id = ?",
+	plan.ResourceKnowledgeBases: "SELECT COUNT(*) FROM knowledge_base WHERE tenant_id = ?",
+	plan.ResourceDocuments:      "SELECT COUNT(*) FROM document WHERE tenant_id = ?",
+	plan.ResourceStorageBytes:   "SELECT COALESCE(SUM(size_bytes), 0) FROM document_version WHERE tenant_id = ?",
+}
+
+// LoadUsage loads the plan limit of a resource and how much of it the tenant
+// uses. Usage is not counted when the plan does not limit the resource.
+func LoadUsage(ctx context.Context, db Queryer, tenantID string, resource string) (plan.Usage, error) {
+	query, ok := usageQueries[resource]
+	if !ok {
+		return plan.Usage{}, fmt.Errorf("unknown plan resource %q", resource)
+	}
+	sub, err := LoadSubscription(ctx, db, tenantID)
+	if err != nil {
+		return plan.Usage{}, err
+	}
+	usage := plan.Usage{Resource: resource, Limit: sub.Limits.For(resource)}
+	if usage.Limit <= 0 {
+		return usage, nil
+	}
+	if err := db.QueryRowContext(ctx, query, tenantID).Scan(&usage.Used); err != nil {
+		return plan.Usage{}, err
+	}
+	return usage, nil
+}
+
+// ReserveUsage checks within tx that adding to a resource stays within the
+// plan limit of a tenant. It locks the tenant row until tx ends, so creates
+// of the same tenant count and insert one at a time instead of all passing
+// the check at once.
+func ReserveUsage(ctx context.Context, tx *sql.Tx, tenantID string, resource string, adding int64) error {
+	var id string
+	if err := tx.QueryRowContext(ctx, "SELECT id FROM tenant WHERE id = ? FOR UPDATE", tenantID).Scan(&id); err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return errors.NotFound("TENANT_NOT_FOUND", "tenant not found")
+		}
+		return err
+	}
+	usage, err := LoadUsage(ctx, tx, tenantID, resource)
+	if err != nil {
+		return err
+	}
+	if !usage.Allows(adding) {
+		return plan.LimitExceeded(usage)
+	}
+	return nil
+}
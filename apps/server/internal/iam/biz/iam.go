@@ -6,6 +6,7 @@ import (
 	"time"
 
 	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -86,6 +87,9 @@ const (
 const (
 	PermissionTenantCreate            = "platform.tenant.create"
 	PermissionTenantRead              = "platform.tenant.read"
+	PermissionTenantWrite             = "platform.tenant.write"
+	PermissionTenantDelete            = "platform.tenant.delete"
+	PermissionPlanWrite               = "platform.plan.write"
 	PermissionPlatformAdminCreate     = "platform.admin.create"
 	PermissionPlatformAdminRead       = "platform.admin.read"
 	PermissionPlatformRoleWrite       = "platform.role.write"
@@ -96,8 +100,10 @@ const (
 	PermissionPlatformPermissionWrite = "platform.permission.write"
 	PermissionUserWrite               = "tenant.user.write"
 	PermissionUserRead                = "tenant.user.read"
+	PermissionUserDelete              = "tenant.user.delete"
 	PermissionRoleWrite               = "tenant.role.write"
 	PermissionRoleRead                = "tenant.role.read"
+	PermissionRoleDelete              = "tenant.role.delete"
 	PermissionRoleAssign              = "tenant.role.assign"
 	PermissionTenantPermissionRead    = "tenant.permission.read"
 	PermissionRolePermAssign          = "tenant.role.permission.assign"
@@ -109,6 +115,35 @@ type IAMRepo interface {
 	CreateTenant(ctx context.Context, tenant Tenant) (Tenant, error)
 	GetTenant(ctx context.Context, id string) (Tenant, error)
 	ListTenants(ctx context.Context) ([]Tenant, error)
+	UpdateTenant(ctx context.Context, tenant Tenant) (Tenant, error)
+	// TransitionTenantStatus sets the status of a tenant whose status is one
+	// of from and reports whether it changed.
+	TransitionTenantStatus(ctx context.Context, id string, from []string, to string) (bool, error)
+	// DeleteTenant deletes the tenant row once its data was purged.
+	DeleteTenant(ctx context.Context, id string) error
+
+	GetPlan(ctx context.Context, code string) (Plan, error)
+	ListPlans(ctx context.Context) ([]Plan, error)
+	SavePlan(ctx context.Context, item Plan) (Plan, error)
+
+	// StartTenantDeletion marks the tenant as deleting and creates its purge
+	// job, failing with TENANT_PURGE_RUNNING while another job is active.
+	StartTenantDeletion(ctx context.Context, job TenantPurgeJob) (TenantPurgeJob, error)
+	GetTenantPurgeJob(ctx context.Context, id string) (TenantPurgeJob, error)
+	ListTenantPurgeJobs(ctx context.Context, tenantID string) ([]TenantPurgeJob, error)
+	ListStaleTenantPurgeJobs(ctx context.Context, staleBefore time.Time) ([]TenantPurgeJob, error)
+	ClaimTenantPurgeJob(ctx context.Context, id string, staleBefore time.Time) (bool, error)
+	TouchTenantPurgeJob(ctx context.Context, id string) error
+	SaveTenantPurgeReport(ctx context.Context, id string, report TenantPurgeReport) error
+	FinishTenantPurgeJob(ctx context.Context, job TenantPurgeJob) error
+	// ListTenantVectorCollections lists the vector collections that may hold
+	// points of the tenant.
+	ListTenantVectorCollections(ctx context.Context, tenantID string) ([]string, error)
+	PurgeTenantObjects(ctx context.Context, tenantID string) (int64, error)
+	// PurgeTenantRows deletes every row of the tenant except the tenant row
+	// and returns the count per table.
+	PurgeTenantRows(ctx context.Context, tenantID string) (map[string]int64, error)
+	PurgeTenantVectors(ctx context.Context, tenantID string, collections []string) (map[string]int64, error)
 
 	CreatePlatformAdmin(ctx context.Context, admin PlatformAdmin) (PlatformAdmin, error)
 	ListPlatformAdmins(ctx context.Context) ([]PlatformAdmin, error)
@@ -125,13 +160,36 @@ type IAMRepo interface {
 
 	CreateUser(ctx context.Context, user User) (User, error)
 	ListUsers(ctx context.Context) ([]User, error)
+	GetUser(ctx context.Context, id string) (User, error)
+	UpdateUser(ctx context.Context, user User) (User, error)
+	DeleteUser(ctx context.Context, id string) error
 
 	CreateRole(ctx context.Context, role Role) (Role, error)
 	ListRoles(ctx context.Context) ([]Role, error)
+	GetRole(ctx context.Context, id string) (Role, error)
+	UpdateRole(ctx context.Context, role Role) (Role, error)
+	DeleteRole(ctx context.Context, id string) error
 
-	AssignRole(ctx context.Context, userID string, roleID string) error
+	// AssignRole binds a role to a user and replaces the scopes of the
+	// binding.
+	AssignRole(ctx context.Context, userID string, roleID string, scopes []ResourceRef) error
 	ListUserRoles(ctx context.Context, userID string) ([]Role, error)
+	ListUserRoleBindings(ctx context.Context, userID string) ([]RoleBinding, error)
+	RemoveRole(ctx context.Context, userID string, roleID string) error
+	CountActiveRoleMembers(ctx context.Context, roleName string, excludeUserID string) (int, error)
 	ListUserPermissions(ctx context.Context, userID string) ([]Permission, error)
+	// ListUserGrants returns the tenant permissions of a user per role
+	// binding, with the resource groups of scoped bindings expanded.
+	ListUserGrants(ctx context.Context, userID string) ([]Grant, error)
+
+	CreateResourceGroup(ctx context.Context, group ResourceGroup) (ResourceGroup, error)
+	GetResourceGroup(ctx context.Context, id string) (ResourceGroup, error)
+	ListResourceGroups(ctx context.Context) ([]ResourceGroup, error)
+	UpdateResourceGroup(ctx context.Context, group ResourceGroup) (ResourceGroup, error)
+	SetResourceGroupMembers(ctx context.Context, id string, members []ResourceRef) (ResourceGroup, error)
+	// DeleteResourceGroup fails with RESOURCE_GROUP_IN_USE while role
+	// bindings are scoped to the group.
+	DeleteResourceGroup(ctx context.Context, id string) error
 
 	CreatePermission(ctx context.Context, permission Permission) (Permission, error)
 	ListPermissions(ctx context.Context) ([]Permission, error)
@@ -142,17 +200,33 @@ type IAMRepo interface {
 
 // IAMUsecase handles iam business logic (placeholder)
 type IAMUsecase struct {
-	repo IAMRepo
-	log  *log.Helper
+	repo   IAMRepo
+	grants *grantCache
+	log    *log.Helper
 }
 
 // NewIAMUsecase creates a new IAMUsecase
 func NewIAMUsecase(repo IAMRepo, logger log.Logger) *IAMUsecase {
-	return &IAMUsecase{repo: repo, log: log.NewHelper(logger)}
+	return &IAMUsecase{repo: repo, grants: newGrantCache(), log: log.NewHelper(logger)}
 }
 
-// CreateTenant creates a tenant (platform admin).
+// CreateTenant creates a tenant (platform admin) on an existing plan,
+// active unless it is created suspended.
 func (uc *IAMUsecase) CreateTenant(ctx context.Context, tenant Tenant) (Tenant, error) {
+	tenant.Plan = strings.TrimSpace(tenant.Plan)
+	if tenant.Plan == "" {
+		tenant.Plan = plan.Free
+	}
+	if _, err := uc.repo.GetPlan(ctx, tenant.Plan); err != nil {
+		return Tenant{}, err
+	}
+	tenant.Status = strings.ToLower(strings.TrimSpace(tenant.Status))
+	if tenant.Status == "" {
+		tenant.Status = plan.TenantActive
+	}
+	if tenant.Status != plan.TenantActive && tenant.Status != plan.TenantSuspended {
+		return Tenant{}, errors.BadRequest("TENANT_STATUS_INVALID", "status must be active or suspended")
+	}
 	return uc.repo.CreateTenant(ctx, tenant)
 }
 
@@ -231,6 +305,11 @@ func (uc *IAMUsecase) ListUsers(ctx context.Context) ([]User, error) {
 	return uc.repo.ListUsers(ctx)
 }
 
+// GetUser gets a user in current tenant.
+func (uc *IAMUsecase) GetUser(ctx context.Context, id string) (User, error) {
+	return uc.repo.GetUser(ctx, id)
+}
+
 // CreateRole creates a tenant role.
 func (uc *IAMUsecase) CreateRole(ctx context.Context, role Role) (Role, error) {
 	return uc.repo.CreateRole(ctx, role)
@@ -241,11 +320,6 @@ func (uc *IAMUsecase) ListRoles(ctx context.Context) ([]Role, error) {
 	return uc.repo.ListRoles(ctx)
 }
 
-// AssignRole assigns role to user within tenant.
-func (uc *IAMUsecase) AssignRole(ctx context.Context, userID string, roleID string) error {
-	return uc.repo.AssignRole(ctx, userID, roleID)
-}
-
 // ListUserRoles lists roles for a user.
 func (uc *IAMUsecase) ListUserRoles(ctx context.Context, userID string) ([]Role, error) {
 	return uc.repo.ListUserRoles(ctx, userID)
@@ -268,7 +342,11 @@ func (uc *IAMUsecase) ListTenantPermissions(ctx context.Context) ([]Permission,
 
 // AssignRolePermissions assigns permissions to a role.
 func (uc *IAMUsecase) AssignRolePermissions(ctx context.Context, roleID string, permissionCodes []string) error {
-	return uc.repo.AssignRolePermissions(ctx, roleID, permissionCodes)
+	if err := uc.repo.AssignRolePermissions(ctx, roleID, permissionCodes); err != nil {
+		return err
+	}
+	uc.invalidateGrants(ctx)
+	return nil
 }
 
 // ListRolePermissions lists permissions for a role.
@@ -276,8 +354,12 @@ func (uc *IAMUsecase) ListRolePermissions(ctx context.Context, roleID string) ([
 	return uc.repo.ListRolePermissions(ctx, roleID)
 }
 
-// RequirePermission enforces RBAC based on JWT subject.
-func (uc *IAMUsecase) RequirePermission(ctx context.Context, permission string) error {
+// RequirePermission enforces RBAC based on JWT subject. Tenant permissions
+// checked without refs need a tenant-wide grant; with refs, every ref must be
+// covered by a tenant-wide grant or a binding scoped to it. Impersonation
+// tokens hold no platform permissions, and read-only ones only hold the
+// read permissions of the tenant.
+func (uc *IAMUsecase) RequirePermission(ctx context.Context, permission string, refs ...ResourceRef) error {
 	if permission == "" {
 		return nil
 	}
@@ -285,6 +367,14 @@ func (uc *IAMUsecase) RequirePermission(ctx context.Context, permission string)
 	if !ok || claims.Subject == "" {
 		return errors.Forbidden("RBAC_FORBIDDEN", "missing subject")
 	}
+	if claims.Impersonated() {
+		if !strings.HasPrefix(permission, "tenant.") {
+			return errors.Forbidden("IMPERSONATION_FORBIDDEN", "not allowed while impersonating")
+		}
+		if claims.ReadOnly && !strings.HasSuffix(permission, ".read") {
+			return errors.Forbidden("IMPERSONATION_READ_ONLY", "impersonation session is read-only")
+		}
+	}
 	if strings.HasPrefix(permission, "platform.") {
 		perms, err := uc.repo.ListPlatformAdminPermissions(ctx, claims.Subject)
 		if err != nil {
@@ -298,17 +388,15 @@ func (uc *IAMUsecase) RequirePermission(ctx context.Context, permission string)
 		return errors.Forbidden("RBAC_FORBIDDEN", "permission denied")
 	}
 	if strings.HasPrefix(permission, "tenant.") {
-		if hasRole(claims.Roles, "tenant_admin") {
+		if hasRole(claims.Roles, RoleTenantAdmin) {
 			return nil
 		}
-		perms, err := uc.repo.ListUserPermissions(ctx, claims.Subject)
+		grants, err := uc.userGrants(ctx, claims.Subject)
 		if err != nil {
 			return err
 		}
-		for _, item := range perms {
-			if permissionMatch(item.Code, permission) {
-				return nil
-			}
+		if grantsAllow(grants, permission, refs) {
+			return nil
 		}
 		return errors.Forbidden("RBAC_FORBIDDEN", "permission denied")
 	}
@@ -0,0 +1,162 @@
+package biz
+
+import (
+	"context"
+	"strings"
+
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// RoleTenantAdmin is the built-in role of tenant owners. It passes every
+// tenant permission check and cannot be renamed or deleted.
+const RoleTenantAdmin = "tenant_admin"
+
+// Tenant user statuses.
+const (
+	UserStatusActive   = "active"
+	UserStatusInvited  = "invited"
+	UserStatusDisabled = "disabled"
+)
+
+// UserUpdate changes a tenant user; empty fields are left unchanged.
+type UserUpdate struct {
+	Email  string
+	Phone  string
+	Name   string
+	Status string
+}
+
+// UpdateUser updates the profile or status of a user in the current tenant.
+// Invited users only become active by accepting their invitation.
+func (uc *IAMUsecase) UpdateUser(ctx context.Context, id string, update UserUpdate) (User, error) {
+	user, err := uc.repo.GetUser(ctx, strings.TrimSpace(id))
+	if err != nil {
+		return User{}, err
+	}
+	if email := strings.TrimSpace(update.Email); email != "" {
+		user.Email = email
+	}
+	if phone := strings.TrimSpace(update.Phone); phone != "" {
+		user.Phone = phone
+	}
+	if name := strings.TrimSpace(update.Name); name != "" {
+		user.Name = name
+	}
+	if status := strings.ToLower(strings.TrimSpace(update.Status)); status != "" && status != user.Status {
+		switch {
+		case status != UserStatusActive && status != UserStatusDisabled:
+			return User{}, errors.BadRequest("USER_STATUS_INVALID", "status must be active or disabled")
+		case user.Status == UserStatusInvited:
+			return User{}, errors.Conflict("USER_INVITE_PENDING", "user has not accepted the invitation")
+		case status == UserStatusDisabled:
+			if err := uc.guardUserRemoval(ctx, user); err != nil {
+				return User{}, err
+			}
+		}
+		user.Status = status
+	}
+	return uc.repo.UpdateUser(ctx, user)
+}
+
+// DeleteUser deletes a user of the current tenant with their role
+// assignments, identities and MFA factors.
+func (uc *IAMUsecase) DeleteUser(ctx context.Context, id string) error {
+	user, err := uc.repo.GetUser(ctx, strings.TrimSpace(id))
+	if err != nil {
+		return err
+	}
+	if err := uc.guardUserRemoval(ctx, user); err != nil {
+		return err
+	}
+	if err := uc.repo.DeleteUser(ctx, user.ID); err != nil {
+		return err
+	}
+	uc.invalidateGrants(ctx)
+	return nil
+}
+
+// RemoveRole unassigns a role from a user. It reports whether the role was
+// tenant_admin, which access tokens carry until they are refreshed.
+func (uc *IAMUsecase) RemoveRole(ctx context.Context, userID string, roleID string) (bool, error) {
+	role, err := uc.repo.GetRole(ctx, strings.TrimSpace(roleID))
+	if err != nil {
+		return false, err
+	}
+	user, err := uc.repo.GetUser(ctx, strings.TrimSpace(userID))
+	if err != nil {
+		return false, err
+	}
+	admin := role.Name == RoleTenantAdmin
+	if admin && user.Status == UserStatusActive {
+		if err := uc.requireOtherTenantAdmin(ctx, user.ID); err != nil {
+			return false, err
+		}
+	}
+	if err := uc.repo.RemoveRole(ctx, user.ID, role.ID); err != nil {
+		return false, err
+	}
+	uc.invalidateGrants(ctx)
+	return admin, nil
+}
+
+// UpdateRole renames a tenant role.
+func (uc *IAMUsecase) UpdateRole(ctx context.Context, id string, name string) (Role, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Role{}, errors.BadRequest("ROLE_NAME_MISSING", "role name missing")
+	}
+	role, err := uc.repo.GetRole(ctx, strings.TrimSpace(id))
+	if err != nil {
+		return Role{}, err
+	}
+	if role.Name == RoleTenantAdmin || name == RoleTenantAdmin {
+		return Role{}, errors.Forbidden("ROLE_PROTECTED", "tenant_admin role cannot be renamed")
+	}
+	role.Name = name
+	return uc.repo.UpdateRole(ctx, role)
+}
+
+// DeleteRole deletes a tenant role that is no longer assigned to any user.
+func (uc *IAMUsecase) DeleteRole(ctx context.Context, id string) error {
+	role, err := uc.repo.GetRole(ctx, strings.TrimSpace(id))
+	if err != nil {
+		return err
+	}
+	if role.Name == RoleTenantAdmin {
+		return errors.Forbidden("ROLE_PROTECTED", "tenant_admin role cannot be deleted")
+	}
+	return uc.repo.DeleteRole(ctx, role.ID)
+}
+
+// guardUserRemoval keeps callers from disabling or deleting themselves and
+// the tenant from losing its last active admin.
+func (uc *IAMUsecase) guardUserRemoval(ctx context.Context, user User) error {
+	if claims, ok := jwt.ClaimsFromContext(ctx); ok && claims.Subject == user.ID {
+		return errors.Forbidden("USER_SELF_FORBIDDEN", "cannot disable or delete yourself")
+	}
+	if user.Status != UserStatusActive {
+		return nil
+	}
+	roles, err := uc.repo.ListUserRoles(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role.Name == RoleTenantAdmin {
+			return uc.requireOtherTenantAdmin(ctx, user.ID)
+		}
+	}
+	return nil
+}
+
+func (uc *IAMUsecase) requireOtherTenantAdmin(ctx context.Context, userID string) error {
+	others, err := uc.repo.CountActiveRoleMembers(ctx, RoleTenantAdmin, userID)
+	if err != nil {
+		return err
+	}
+	if others == 0 {
+		return errors.Conflict("LAST_TENANT_ADMIN", "tenant needs at least one active admin")
+	}
+	return nil
+}
@@ -0,0 +1,376 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Resource types a role binding can be scoped to.
+const (
+	ResourceTypeBot           = "bot"
+	ResourceTypeKnowledgeBase = "knowledge_base"
+	ResourceTypeGroup         = "resource_group"
+)
+
+// Permission codes for resource groups.
+const (
+	PermissionResourceGroupRead  = "tenant.resource_group.read"
+	PermissionResourceGroupWrite = "tenant.resource_group.write"
+)
+
+const (
+	// maxScopeRefs caps the scopes of a role binding and the members of a
+	// resource group.
+	maxScopeRefs = 200
+	// grantCacheTTL bounds how long an instance serves grants changed through
+	// another instance; changes made through this one invalidate at once.
+	grantCacheTTL = 30 * time.Second
+)
+
+// ResourceRef identifies a bot, knowledge base or resource group.
+type ResourceRef struct {
+	Type string
+	ID   string
+}
+
+// BotRef refers to a bot.
+func BotRef(id string) ResourceRef {
+	return ResourceRef{Type: ResourceTypeBot, ID: strings.TrimSpace(id)}
+}
+
+// KnowledgeBaseRef refers to a knowledge base.
+func KnowledgeBaseRef(id string) ResourceRef {
+	return ResourceRef{Type: ResourceTypeKnowledgeBase, ID: strings.TrimSpace(id)}
+}
+
+// RoleBinding is a role held by a user. A binding without scopes applies to
+// the whole tenant; otherwise only to the listed bots, knowledge bases and
+// resource groups.
+type RoleBinding struct {
+	Role   Role
+	Scopes []ResourceRef
+}
+
+// ResourceGroup names a set of bots and knowledge bases that role bindings
+// can be scoped to.
+type ResourceGroup struct {
+	ID          string
+	TenantID    string
+	Name        string
+	Description string
+	Members     []ResourceRef
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Grant is a permission held through one role binding. Resources lists the
+// bots and knowledge bases of a scoped binding with its groups expanded.
+type Grant struct {
+	Permission string
+	Scoped     bool
+	Resources  []ResourceRef
+}
+
+// ResourceScope is the set of resources of one type a caller may access.
+type ResourceScope struct {
+	All bool
+	IDs []string
+}
+
+// Allows reports whether id is in the scope.
+func (s ResourceScope) Allows(id string) bool {
+	if s.All {
+		return true
+	}
+	for _, item := range s.IDs {
+		if item == id {
+			return true
+		}
+	}
+	return false
+}
+
+// AssignRole assigns a role to a user within the tenant. Scopes limit the
+// binding to bots, knowledge bases and resource groups and replace those of
+// an existing binding; no scopes make it tenant-wide.
+func (uc *IAMUsecase) AssignRole(ctx context.Context, userID string, roleID string, scopes []ResourceRef) error {
+	scopes, err := normalizeRefs(scopes, true)
+	if err != nil {
+		return err
+	}
+	role, err := uc.repo.GetRole(ctx, strings.TrimSpace(roleID))
+	if err != nil {
+		return err
+	}
+	if role.Name == RoleTenantAdmin && len(scopes) > 0 {
+		return errors.BadRequest("ROLE_SCOPE_INVALID", "tenant_admin cannot be scoped")
+	}
+	if err := uc.repo.AssignRole(ctx, strings.TrimSpace(userID), role.ID, scopes); err != nil {
+		return err
+	}
+	uc.invalidateGrants(ctx)
+	return nil
+}
+
+// ListUserRoleBindings lists the roles of a user with their scopes.
+func (uc *IAMUsecase) ListUserRoleBindings(ctx context.Context, userID string) ([]RoleBinding, error) {
+	return uc.repo.ListUserRoleBindings(ctx, strings.TrimSpace(userID))
+}
+
+// CreateResourceGroup creates a resource group.
+func (uc *IAMUsecase) CreateResourceGroup(ctx context.Context, group ResourceGroup) (ResourceGroup, error) {
+	group.Name = strings.TrimSpace(group.Name)
+	group.Description = strings.TrimSpace(group.Description)
+	if group.Name == "" {
+		return ResourceGroup{}, errors.BadRequest("RESOURCE_GROUP_NAME_MISSING", "resource group name missing")
+	}
+	members, err := normalizeRefs(group.Members, false)
+	if err != nil {
+		return ResourceGroup{}, err
+	}
+	group.Members = members
+	return uc.repo.CreateResourceGroup(ctx, group)
+}
+
+// GetResourceGroup returns a resource group with its members.
+func (uc *IAMUsecase) GetResourceGroup(ctx context.Context, id string) (ResourceGroup, error) {
+	return uc.repo.GetResourceGroup(ctx, strings.TrimSpace(id))
+}
+
+// ListResourceGroups lists the resource groups of the tenant.
+func (uc *IAMUsecase) ListResourceGroups(ctx context.Context) ([]ResourceGroup, error) {
+	return uc.repo.ListResourceGroups(ctx)
+}
+
+// UpdateResourceGroup renames a resource group; empty fields are kept.
+func (uc *IAMUsecase) UpdateResourceGroup(ctx context.Context, id string, name string, description string) (ResourceGroup, error) {
+	group, err := uc.repo.GetResourceGroup(ctx, strings.TrimSpace(id))
+	if err != nil {
+		return ResourceGroup{}, err
+	}
+	if name = strings.TrimSpace(name); name != "" {
+		group.Name = name
+	}
+	if description = strings.TrimSpace(description); description != "" {
+		group.Description = description
+	}
+	return uc.repo.UpdateResourceGroup(ctx, group)
+}
+
+// SetResourceGroupMembers replaces the members of a resource group.
+func (uc *IAMUsecase) SetResourceGroupMembers(ctx context.Context, id string, members []ResourceRef) (ResourceGroup, error) {
+	members, err := normalizeRefs(members, false)
+	if err != nil {
+		return ResourceGroup{}, err
+	}
+	group, err := uc.repo.SetResourceGroupMembers(ctx, strings.TrimSpace(id), members)
+	if err != nil {
+		return ResourceGroup{}, err
+	}
+	uc.invalidateGrants(ctx)
+	return group, nil
+}
+
+// DeleteResourceGroup deletes a resource group no role binding is scoped to.
+func (uc *IAMUsecase) DeleteResourceGroup(ctx context.Context, id string) error {
+	if err := uc.repo.DeleteResourceGroup(ctx, strings.TrimSpace(id)); err != nil {
+		return err
+	}
+	uc.invalidateGrants(ctx)
+	return nil
+}
+
+// VisibleResources returns the resources of resourceType the caller may
+// access with a tenant permission, for filtering lists. Callers without any
+// grant of the permission are forbidden.
+func (uc *IAMUsecase) VisibleResources(ctx context.Context, permission string, resourceType string) (ResourceScope, error) {
+	claims, ok := jwt.ClaimsFromContext(ctx)
+	if !ok || claims.Subject == "" {
+		return ResourceScope{}, errors.Forbidden("RBAC_FORBIDDEN", "missing subject")
+	}
+	if !strings.HasPrefix(permission, "tenant.") {
+		return ResourceScope{}, errors.Forbidden("RBAC_FORBIDDEN", "invalid permission namespace")
+	}
+	if hasRole(claims.Roles, RoleTenantAdmin) {
+		return ResourceScope{All: true}, nil
+	}
+	grants, err := uc.userGrants(ctx, claims.Subject)
+	if err != nil {
+		return ResourceScope{}, err
+	}
+	found := false
+	seen := make(map[string]struct{})
+	scope := ResourceScope{IDs: make([]string, 0)}
+	for _, grant := range grants {
+		if !permissionMatch(grant.Permission, permission) {
+			continue
+		}
+		if !grant.Scoped {
+			return ResourceScope{All: true}, nil
+		}
+		found = true
+		for _, ref := range grant.Resources {
+			if ref.Type != resourceType {
+				continue
+			}
+			if _, ok := seen[ref.ID]; ok {
+				continue
+			}
+			seen[ref.ID] = struct{}{}
+			scope.IDs = append(scope.IDs, ref.ID)
+		}
+	}
+	if !found {
+		return ResourceScope{}, errors.Forbidden("RBAC_FORBIDDEN", "permission denied")
+	}
+	return scope, nil
+}
+
+func grantsAllow(grants []Grant, permission string, refs []ResourceRef) bool {
+	for _, grant := range grants {
+		if !grant.Scoped && permissionMatch(grant.Permission, permission) {
+			return true
+		}
+	}
+	if len(refs) == 0 {
+		return false
+	}
+	for _, ref := range refs {
+		covered := false
+		for _, grant := range grants {
+			if grant.Scoped && permissionMatch(grant.Permission, permission) && containsRef(grant.Resources, ref) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func containsRef(refs []ResourceRef, target ResourceRef) bool {
+	for _, ref := range refs {
+		if ref == target {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeRefs trims and dedupes refs; groups are only accepted as binding
+// scopes, not as group members.
+func normalizeRefs(refs []ResourceRef, allowGroups bool) ([]ResourceRef, error) {
+	out := make([]ResourceRef, 0, len(refs))
+	seen := make(map[ResourceRef]struct{}, len(refs))
+	for _, ref := range refs {
+		ref.Type = strings.ToLower(strings.TrimSpace(ref.Type))
+		ref.ID = strings.TrimSpace(ref.ID)
+		switch {
+		case ref.ID == "":
+			return nil, errors.BadRequest("RESOURCE_REF_INVALID", "resource id missing")
+		case ref.Type == ResourceTypeBot, ref.Type == ResourceTypeKnowledgeBase:
+		case ref.Type == ResourceTypeGroup && allowGroups:
+		default:
+			return nil, errors.BadRequest("RESOURCE_REF_INVALID", "unsupported resource type "+ref.Type)
+		}
+		if _, ok := seen[ref]; ok {
+			continue
+		}
+		seen[ref] = struct{}{}
+		out = append(out, ref)
+	}
+	if len(out) > maxScopeRefs {
+		return nil, errors.BadRequest("RESOURCE_REF_TOO_MANY", "too many resources")
+	}
+	return out, nil
+}
+
+// grantCache keeps the grants of tenant users for grantCacheTTL so that
+// scoped checks on hot paths do not query role bindings every time.
+type grantCache struct {
+	mu        sync.Mutex
+	tenants   map[string]map[string]grantCacheEntry
+	lastSweep time.Time
+}
+
+type grantCacheEntry struct {
+	grants    []Grant
+	expiresAt time.Time
+}
+
+func newGrantCache() *grantCache {
+	return &grantCache{tenants: make(map[string]map[string]grantCacheEntry)}
+}
+
+func (c *grantCache) get(tenantID string, userID string, now time.Time) ([]Grant, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.tenants[tenantID][userID]
+	if !ok || now.After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.grants, true
+}
+
+func (c *grantCache) put(tenantID string, userID string, grants []Grant, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now.Sub(c.lastSweep) > grantCacheTTL {
+		for id, users := range c.tenants {
+			for user, entry := range users {
+				if now.After(entry.expiresAt) {
+					delete(users, user)
+				}
+			}
+			if len(users) == 0 {
+				delete(c.tenants, id)
+			}
+		}
+		c.lastSweep = now
+	}
+	users := c.tenants[tenantID]
+	if users == nil {
+		users = make(map[string]grantCacheEntry)
+		c.tenants[tenantID] = users
+	}
+	users[userID] = grantCacheEntry{grants: grants, expiresAt: now.Add(grantCacheTTL)}
+}
+
+func (c *grantCache) invalidate(tenantID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tenants, tenantID)
+}
+
+func (uc *IAMUsecase) userGrants(ctx context.Context, userID string) ([]Grant, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if grants, ok := uc.grants.get(tenantID, userID, now); ok {
+		return grants, nil
+	}
+	grants, err := uc.repo.ListUserGrants(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	uc.grants.put(tenantID, userID, grants, now)
+	return grants, nil
+}
+
+// invalidateGrants drops the cached grants of the tenant in ctx after its
+// role bindings, role permissions or resource groups changed.
+func (uc *IAMUsecase) invalidateGrants(ctx context.Context) {
+	if tenantID, err := tenant.RequireTenantID(ctx); err == nil {
+		uc.grants.invalidate(tenantID)
+	}
+}
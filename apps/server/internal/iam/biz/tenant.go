@@ -0,0 +1,334 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Tenant purge job statuses.
+const (
+	TenantPurgePending   = "pending"
+	TenantPurgeRunning   = "running"
+	TenantPurgeSucceeded = "succeeded"
+	TenantPurgeFailed    = "failed"
+)
+
+const (
+	// purgeLeaseTimeout is how long a purge job may go without heartbeat
+	// before another worker resumes it.
+	purgeLeaseTimeout = 2 * time.Minute
+	purgeHeartbeat    = 30 * time.Second
+)
+
+// Plan is a tenant plan with its limits.
+type Plan struct {
+	Code      string
+	Name      string
+	Limits    plan.Limits
+	UpdatedAt time.Time
+}
+
+// TenantUpdate changes a tenant; empty fields are left unchanged.
+type TenantUpdate struct {
+	Name string
+	Type string
+	Plan string
+}
+
+// TenantPurgeJob deletes every row, object and vector of a tenant.
+type TenantPurgeJob struct {
+	ID           string
+	TenantID     string
+	TenantName   string
+	Status       string
+	RequestedBy  string
+	Report       TenantPurgeReport
+	ErrorMessage string
+	CreatedAt    time.Time
+	StartedAt    *time.Time
+	FinishedAt   *time.Time
+}
+
+// TenantPurgeReport records what a purge job removed.
+type TenantPurgeReport struct {
+	// Rows counts the deleted rows per table.
+	Rows    map[string]int64 `json:"rows,omitempty"`
+	Objects int64            `json:"objects"`
+	// Collections are the vector collections the tenant had points in; they
+	// are saved before any data is deleted so a resumed job still knows them.
+	Collections []string `json:"collections,omitempty"`
+	// VectorPoints counts the deleted points per collection.
+	VectorPoints map[string]int64 `json:"vector_points,omitempty"`
+}
+
+// UpdateTenant changes the name, type or plan of a tenant.
+func (uc *IAMUsecase) UpdateTenant(ctx context.Context, id string, update TenantUpdate) (Tenant, error) {
+	tenantModel, err := uc.repo.GetTenant(ctx, strings.TrimSpace(id))
+	if err != nil {
+		return Tenant{}, err
+	}
+	if tenantModel.Status == plan.TenantDeleting {
+		return Tenant{}, errors.Conflict("TENANT_DELETING", "tenant is being deleted")
+	}
+	if name := strings.TrimSpace(update.Name); name != "" {
+		tenantModel.Name = name
+	}
+	if tenantType := strings.TrimSpace(update.Type); tenantType != "" {
+		if tenantType != "enterprise" && tenantType != "personal" {
+			return Tenant{}, errors.BadRequest("TENANT_TYPE_INVALID", "invalid tenant type")
+		}
+		tenantModel.Type = tenantType
+	}
+	if code := strings.TrimSpace(update.Plan); code != "" {
+		if _, err := uc.repo.GetPlan(ctx, code); err != nil {
+			return Tenant{}, err
+		}
+		tenantModel.Plan = code
+	}
+	return uc.repo.UpdateTenant(ctx, tenantModel)
+}
+
+// SuspendTenant blocks console sign-in, console requests and API keys of an
+// active tenant without deleting anything.
+func (uc *IAMUsecase) SuspendTenant(ctx context.Context, id string) (Tenant, error) {
+	return uc.transitionTenant(ctx, id, plan.TenantActive, plan.TenantSuspended)
+}
+
+// ResumeTenant reactivates a suspended tenant.
+func (uc *IAMUsecase) ResumeTenant(ctx context.Context, id string) (Tenant, error) {
+	return uc.transitionTenant(ctx, id, plan.TenantSuspended, plan.TenantActive)
+}
+
+func (uc *IAMUsecase) transitionTenant(ctx context.Context, id string, from string, to string) (Tenant, error) {
+	id = strings.TrimSpace(id)
+	ok, err := uc.repo.TransitionTenantStatus(ctx, id, []string{from}, to)
+	if err != nil {
+		return Tenant{}, err
+	}
+	tenantModel, err := uc.repo.GetTenant(ctx, id)
+	if err != nil {
+		return Tenant{}, err
+	}
+	if !ok && tenantModel.Status != to {
+		return Tenant{}, errors.Conflict("TENANT_STATUS_CONFLICT", "tenant is "+tenantModel.Status)
+	}
+	return tenantModel, nil
+}
+
+// DeleteTenant marks a tenant as deleting, which blocks it like a suspension,
+// and starts a purge job that removes its data in the background.
+func (uc *IAMUsecase) DeleteTenant(ctx context.Context, id string) (TenantPurgeJob, error) {
+	tenantModel, err := uc.repo.GetTenant(ctx, strings.TrimSpace(id))
+	if err != nil {
+		return TenantPurgeJob{}, err
+	}
+	job := TenantPurgeJob{
+		TenantID:   tenantModel.ID,
+		TenantName: tenantModel.Name,
+		Status:     TenantPurgePending,
+		CreatedAt:  time.Now(),
+	}
+	if claims, ok := jwt.ClaimsFromContext(ctx); ok {
+		job.RequestedBy = claims.Subject
+	}
+	// A retry after a failed job keeps the collections the earlier job found,
+	// since the rows it deleted no longer name them.
+	previous, err := uc.repo.ListTenantPurgeJobs(ctx, tenantModel.ID)
+	if err != nil {
+		return TenantPurgeJob{}, err
+	}
+	for _, item := range previous {
+		job.Report.Collections = mergeStrings(job.Report.Collections, item.Report.Collections)
+	}
+	job, err = uc.repo.StartTenantDeletion(ctx, job)
+	if err != nil {
+		return TenantPurgeJob{}, err
+	}
+	uc.launchTenantPurge(job.ID)
+	return uc.repo.GetTenantPurgeJob(ctx, job.ID)
+}
+
+// GetTenantPurgeJob returns a purge job with its report.
+func (uc *IAMUsecase) GetTenantPurgeJob(ctx context.Context, id string) (TenantPurgeJob, error) {
+	return uc.repo.GetTenantPurgeJob(ctx, strings.TrimSpace(id))
+}
+
+// ListTenantPurgeJobs lists purge jobs, optionally of one tenant.
+func (uc *IAMUsecase) ListTenantPurgeJobs(ctx context.Context, tenantID string) ([]TenantPurgeJob, error) {
+	return uc.repo.ListTenantPurgeJobs(ctx, strings.TrimSpace(tenantID))
+}
+
+// ListPlans lists the tenant plans.
+func (uc *IAMUsecase) ListPlans(ctx context.Context) ([]Plan, error) {
+	return uc.repo.ListPlans(ctx)
+}
+
+// UpdatePlan sets the name and limits of a plan, creating it when missing.
+func (uc *IAMUsecase) UpdatePlan(ctx context.Context, item Plan) (Plan, error) {
+	item.Code = strings.ToLower(strings.TrimSpace(item.Code))
+	item.Name = strings.TrimSpace(item.Name)
+	if item.Code == "" {
+		return Plan{}, errors.BadRequest("PLAN_CODE_MISSING", "plan code missing")
+	}
+	if item.Name == "" {
+		item.Name = item.Code
+	}
+	limits := item.Limits
+	if limits.MaxBots < 0 || limits.MaxKnowledgeBases < 0 || limits.MaxDocuments < 0 ||
+		limits.MaxStorageBytes < 0 || limits.MonthlyQueries < 0 || limits.MonthlyTokens < 0 {
+		return Plan{}, errors.BadRequest("PLAN_LIMIT_INVALID", "plan limits must not be negative")
+	}
+	item.UpdatedAt = time.Now()
+	return uc.repo.SavePlan(ctx, item)
+}
+
+// StartTenantPurgeWorker resumes purge jobs whose worker stopped (crash or
+// restart) and keeps checking until ctx is done.
+func (uc *IAMUsecase) StartTenantPurgeWorker(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(purgeLeaseTimeout / 2)
+		defer ticker.Stop()
+		for {
+			uc.resumeTenantPurges(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (uc *IAMUsecase) resumeTenantPurges(ctx context.Context) {
+	items, err := uc.repo.ListStaleTenantPurgeJobs(ctx, time.Now().Add(-purgeLeaseTimeout))
+	if err != nil {
+		uc.log.Warnf("list stale tenant purge jobs failed: %v", err)
+		return
+	}
+	for _, job := range items {
+		uc.log.Infof("resuming tenant purge: tenant=%s job=%s status=%s", job.TenantID, job.ID, job.Status)
+		uc.launchTenantPurge(job.ID)
+	}
+}
+
+// launchTenantPurge claims the job lease and runs the purge in the
+// background.
+func (uc *IAMUsecase) launchTenantPurge(id string) {
+	ctx := context.Background()
+	claimed, err := uc.repo.ClaimTenantPurgeJob(ctx, id, time.Now().Add(-purgeLeaseTimeout))
+	if err != nil || !claimed {
+		if err != nil {
+			uc.log.Warnf("claim tenant purge job failed: job=%s err=%v", id, err)
+		}
+		return
+	}
+	go uc.runTenantPurge(ctx, id)
+}
+
+func (uc *IAMUsecase) runTenantPurge(ctx context.Context, id string) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		ticker := time.NewTicker(purgeHeartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = uc.repo.TouchTenantPurgeJob(ctx, id)
+			}
+		}
+	}()
+	job, err := uc.repo.GetTenantPurgeJob(ctx, id)
+	if err != nil {
+		uc.log.Warnf("load tenant purge job failed: job=%s err=%v", id, err)
+		return
+	}
+	job.Status = TenantPurgeSucceeded
+	job.ErrorMessage = ""
+	if err := uc.purgeTenant(ctx, &job); err != nil {
+		job.Status = TenantPurgeFailed
+		job.ErrorMessage = err.Error()
+		uc.log.Warnf("tenant purge failed: tenant=%s job=%s err=%v", job.TenantID, job.ID, err)
+	} else {
+		uc.log.Infof("tenant purged: tenant=%s job=%s objects=%d", job.TenantID, job.ID, job.Report.Objects)
+	}
+	if err := uc.repo.FinishTenantPurgeJob(ctx, job); err != nil {
+		uc.log.Warnf("finish tenant purge job failed: job=%s err=%v", job.ID, err)
+	}
+}
+
+// purgeTenant removes the objects, rows and vector points of a tenant, then
+// the tenant itself. Every step is idempotent and the report is saved after
+// each one, so a failed or interrupted job can run again and keep counting.
+func (uc *IAMUsecase) purgeTenant(ctx context.Context, job *TenantPurgeJob) error {
+	report := &job.Report
+	collections, err := uc.repo.ListTenantVectorCollections(ctx, job.TenantID)
+	if err != nil {
+		return err
+	}
+	report.Collections = mergeStrings(report.Collections, collections)
+	if err := uc.repo.SaveTenantPurgeReport(ctx, job.ID, *report); err != nil {
+		return err
+	}
+	objects, err := uc.repo.PurgeTenantObjects(ctx, job.TenantID)
+	report.Objects += objects
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.SaveTenantPurgeReport(ctx, job.ID, *report); err != nil {
+		return err
+	}
+	// Rows go before vector points so the vector outbox cannot re-add points
+	// that were already deleted.
+	rows, err := uc.repo.PurgeTenantRows(ctx, job.TenantID)
+	report.Rows = addCounts(report.Rows, rows)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.SaveTenantPurgeReport(ctx, job.ID, *report); err != nil {
+		return err
+	}
+	points, err := uc.repo.PurgeTenantVectors(ctx, job.TenantID, report.Collections)
+	report.VectorPoints = addCounts(report.VectorPoints, points)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.DeleteTenant(ctx, job.TenantID); err != nil {
+		return err
+	}
+	uc.grants.invalidate(job.TenantID)
+	return nil
+}
+
+func addCounts(total map[string]int64, more map[string]int64) map[string]int64 {
+	if len(more) == 0 {
+		return total
+	}
+	if total == nil {
+		total = make(map[string]int64, len(more))
+	}
+	for key, n := range more {
+		total[key] += n
+	}
+	return total
+}
+
+func mergeStrings(values []string, more []string) []string {
+	seen := make(map[string]struct{}, len(values)+len(more))
+	out := make([]string, 0, len(values)+len(more))
+	for _, value := range append(append([]string{}, values...), more...) {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		out = append(out, value)
+	}
+	return out
+}
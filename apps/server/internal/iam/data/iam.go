@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
 	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
 	biz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
@@ -21,11 +22,21 @@ import (
 type iamRepo struct {
 	log *log.Helper
 	db  *sql.DB
+
+	// storage and vectors are used by tenant purges; nil when not configured.
+	storage *purgeStorage
+	vectors *purgeVectors
 }
 
 // NewIAMRepo creates a new iam repo.
-func NewIAMRepo(data *internaldata.Data, logger log.Logger) biz.IAMRepo {
-	return &iamRepo{log: log.NewHelper(logger), db: data.DB}
+func NewIAMRepo(data *internaldata.Data, cfg *conf.Data, logger log.Logger) biz.IAMRepo {
+	helper := log.NewHelper(logger)
+	return &iamRepo{
+		log:     helper,
+		db:      data.DB,
+		storage: newPurgeStorage(cfg, helper),
+		vectors: newPurgeVectors(cfg),
+	}
 }
 
 func (r *iamRepo) Ping(ctx context.Context) error {
@@ -468,10 +479,18 @@ func (r *iamRepo) ListUsers(ctx context.Context) ([]biz.User, error) {
 
 	items := make([]biz.User, 0)
 	for rows.Next() {
-		var user biz.User
-		if err := rows.Scan(&user.ID, &user.TenantID, &user.Email, &user.Phone, &user.Name, &user.Status, &user.CreatedAt); err != nil {
+		var (
+			user  biz.User
+			email sql.NullString
+			phone sql.NullString
+			name  sql.NullString
+		)
+		if err := rows.Scan(&user.ID, &user.TenantID, &email, &phone, &name, &user.Status, &user.CreatedAt); err != nil {
 			return nil, err
 		}
+		user.Email = email.String
+		user.Phone = phone.String
+		user.Name = name.String
 		items = append(items, user)
 	}
 	return items, rows.Err()
@@ -525,55 +544,6 @@ func (r *iamRepo) ListRoles(ctx context.Context) ([]biz.Role, error) {
 	return items, rows.Err()
 }
 
-func (r *iamRepo) AssignRole(ctx context.Context, userID string, roleID string) error {
-	tenantID, err := tenant.RequireTenantID(ctx)
-	if err != nil {
-		return err
-	}
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err != nil {
-			_ = tx.Rollback()
-		}
-	}()
-
-	var userTenant string
-	if err = tx.QueryRowContext(ctx, "SELECT tenant_id FROM `user` WHERE id = ?", userID).Scan(&userTenant); err != nil {
-		if stderrors.Is(err, sql.ErrNoRows) {
-			return kerrors.NotFound("USER_NOT_FOUND", "user not found")
-		}
-		return err
-	}
-	if userTenant != tenantID {
-		return kerrors.Forbidden("TENANT_MISMATCH", "tenant mismatch")
-	}
-
-	var roleTenant string
-	if err = tx.QueryRowContext(ctx, "SELECT tenant_id FROM `role` WHERE id = ?", roleID).Scan(&roleTenant); err != nil {
-		if stderrors.Is(err, sql.ErrNoRows) {
-			return kerrors.NotFound("ROLE_NOT_FOUND", "role not found")
-		}
-		return err
-	}
-	if roleTenant != tenantID {
-		return kerrors.Forbidden("TENANT_MISMATCH", "tenant mismatch")
-	}
-
-	_, err = tx.ExecContext(
-		ctx,
-		"INSERT INTO user_role (user_id, role_id) VALUES (?, ?) ON DUPLICATE KEY UPDATE role_id = role_id",
-		userID,
-		roleID,
-	)
-	if err != nil {
-		return err
-	}
-	return tx.Commit()
-}
-
 func (r *iamRepo) ListUserRoles(ctx context.Context, userID string) ([]biz.Role, error) {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
@@ -0,0 +1,269 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"strconv"
+	"strings"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-sql-driver/mysql"
+)
+
+func (r *iamRepo) GetUser(ctx context.Context, id string) (biz.User, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.User{}, err
+	}
+	var (
+		user  biz.User
+		email sql.NullString
+		phone sql.NullString
+		name  sql.NullString
+	)
+	err = r.db.QueryRowContext(
+		ctx,
+		"SELECT id, tenant_id, email, phone, name, status, created_at FROM `user` WHERE id = ? AND tenant_id = ?",
+		id,
+		tenantID,
+	).Scan(&user.ID, &user.TenantID, &email, &phone, &name, &user.Status, &user.CreatedAt)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.User{}, kerrors.NotFound("USER_NOT_FOUND", "user not found")
+		}
+		return biz.User{}, err
+	}
+	user.Email = email.String
+	user.Phone = phone.String
+	user.Name = name.String
+	return user, nil
+}
+
+func (r *iamRepo) UpdateUser(ctx context.Context, user biz.User) (biz.User, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.User{}, err
+	}
+	if existingTenantID, err := r.findOtherTenantByAccount(ctx, user.ID, user.Email, user.Phone); err != nil {
+		return biz.User{}, err
+	} else if existingTenantID != "" && existingTenantID != tenantID {
+		return biz.User{}, kerrors.Conflict("ACCOUNT_ALREADY_BOUND", "account already bound to another tenant")
+	} else if existingTenantID == tenantID {
+		return biz.User{}, kerrors.Conflict("ACCOUNT_EXISTS", "account already exists")
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		"UPDATE `user` SET email = ?, phone = ?, name = ?, status = ? WHERE id = ? AND tenant_id = ?",
+		emptyToNull(user.Email),
+		emptyToNull(user.Phone),
+		emptyToNull(user.Name),
+		user.Status,
+		user.ID,
+		tenantID,
+	)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if stderrors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return biz.User{}, kerrors.Conflict("ACCOUNT_EXISTS", "account already exists")
+		}
+		return biz.User{}, err
+	}
+	return r.GetUser(ctx, user.ID)
+}
+
+func (r *iamRepo) DeleteUser(ctx context.Context, id string) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	res, err := tx.ExecContext(ctx, "DELETE FROM `user` WHERE id = ? AND tenant_id = ?", id, tenantID)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return kerrors.NotFound("USER_NOT_FOUND", "user not found")
+	}
+	for _, stmt := range []string{
+		"DELETE FROM user_role WHERE user_id = ?",
+		"DELETE FROM user_role_scope WHERE user_id = ?",
+		"DELETE FROM user_identity WHERE user_id = ?",
+		"DELETE FROM account_token WHERE user_id = ?",
+		"DELETE FROM mfa_factor WHERE subject_type = 'tenant' AND subject_id = ?",
+		"DELETE FROM mfa_recovery_code WHERE subject_type = 'tenant' AND subject_id = ?",
+	} {
+		if _, err := tx.ExecContext(ctx, stmt, id); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *iamRepo) RemoveRole(ctx context.Context, userID string, roleID string) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	res, err := tx.ExecContext(
+		ctx,
+		`DELETE ur FROM user_role ur
+		JOIN `+"`user`"+` u ON ur.user_id = u.id
+		WHERE ur.user_id = ? AND ur.role_id = ? AND u.tenant_id = ?`,
+		userID,
+		roleID,
+		tenantID,
+	)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return kerrors.NotFound("USER_ROLE_NOT_FOUND", "user role not found")
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_role_scope WHERE user_id = ? AND role_id = ?", userID, roleID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *iamRepo) GetRole(ctx context.Context, id string) (biz.Role, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.Role{}, err
+	}
+	var role biz.Role
+	err = r.db.QueryRowContext(
+		ctx,
+		"SELECT id, tenant_id, name FROM `role` WHERE id = ? AND tenant_id = ?",
+		id,
+		tenantID,
+	).Scan(&role.ID, &role.TenantID, &role.Name)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.Role{}, kerrors.NotFound("ROLE_NOT_FOUND", "role not found")
+		}
+		return biz.Role{}, err
+	}
+	return role, nil
+}
+
+func (r *iamRepo) UpdateRole(ctx context.Context, role biz.Role) (biz.Role, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.Role{}, err
+	}
+	_, err = r.db.ExecContext(ctx, "UPDATE `role` SET name = ? WHERE id = ? AND tenant_id = ?", role.Name, role.ID, tenantID)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if stderrors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return biz.Role{}, kerrors.Conflict("ROLE_EXISTS", "role already exists")
+		}
+		return biz.Role{}, err
+	}
+	return r.GetRole(ctx, role.ID)
+}
+
+func (r *iamRepo) DeleteRole(ctx context.Context, id string) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	var roleTenant string
+	if err := tx.QueryRowContext(ctx, "SELECT tenant_id FROM `role` WHERE id = ? FOR UPDATE", id).Scan(&roleTenant); err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return kerrors.NotFound("ROLE_NOT_FOUND", "role not found")
+		}
+		return err
+	}
+	if roleTenant != tenantID {
+		return kerrors.NotFound("ROLE_NOT_FOUND", "role not found")
+	}
+	// Locking the role row keeps AssignRole, which reads it, from racing in
+	// a new member.
+	var members int
+	if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM user_role WHERE role_id = ?", id).Scan(&members); err != nil {
+		return err
+	}
+	if members > 0 {
+		return kerrors.Conflict("ROLE_IN_USE", "role is assigned to users").WithMetadata(map[string]string{"users": strconv.Itoa(members)})
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM role_permission WHERE role_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `role` WHERE id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *iamRepo) CountActiveRoleMembers(ctx context.Context, roleName string, excludeUserID string) (int, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	err = r.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(DISTINCT u.id)
+		FROM `+"`user`"+` u
+		JOIN user_role ur ON ur.user_id = u.id
+		JOIN `+"`role`"+` r ON r.id = ur.role_id
+		WHERE u.tenant_id = ? AND r.tenant_id = u.tenant_id AND r.name = ? AND u.status = 'active' AND u.id <> ?`,
+		tenantID,
+		roleName,
+		excludeUserID,
+	).Scan(&count)
+	return count, err
+}
+
+// findOtherTenantByAccount returns the tenant of another user holding the
+// email or phone.
+func (r *iamRepo) findOtherTenantByAccount(ctx context.Context, userID string, email string, phone string) (string, error) {
+	conds := make([]string, 0, 2)
+	args := make([]any, 0, 3)
+	if email = strings.TrimSpace(email); email != "" {
+		conds = append(conds, "email = ?")
+		args = append(args, email)
+	}
+	if phone = strings.TrimSpace(phone); phone != "" {
+		conds = append(conds, "phone = ?")
+		args = append(args, phone)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	args = append(args, userID)
+	var tenantID string
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT tenant_id FROM `user` WHERE ("+strings.Join(conds, " OR ")+") AND id <> ? LIMIT 1",
+		args...,
+	).Scan(&tenantID)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return tenantID, nil
+}
@@ -0,0 +1,453 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// purgeBatchSize bounds each DELETE so a large tenant does not hold long
+// locks.
+const purgeBatchSize = 5000
+
+// tenantLinkTables are keyed by users, roles or resource groups of a tenant
+// and must be purged before those.
+var tenantLinkTables = []struct {
+	table string
+	where string
+}{
+	{"user_role_scope", "user_id IN (SELECT id FROM `user` WHERE tenant_id = ?)"},
+	{"user_role", "user_id IN (SELECT id FROM `user` WHERE tenant_id = ?)"},
+	{"role_permission", "role_id IN (SELECT id FROM `role` WHERE tenant_id = ?)"},
+	{"resource_group_member", "group_id IN (SELECT id FROM resource_group WHERE tenant_id = ?)"},
+	{"mfa_recovery_code", "subject_type = 'tenant' AND subject_id IN (SELECT id FROM `user` WHERE tenant_id = ?)"},
+	{"mfa_factor", "subject_type = 'tenant' AND subject_id IN (SELECT id FROM `user` WHERE tenant_id = ?)"},
+	{"revoked_token", "subject_type = 'tenant' AND subject_id IN (SELECT id FROM `user` WHERE tenant_id = ?)"},
+}
+
+// tenantTables are the tables with a tenant_id column. New tenant tables
+// must be added here so tenant deletion removes them.
+var tenantTables = []string{
+	"message_feedback",
+	"session_event",
+	"chat_message",
+	"chat_session",
+	"analytics_event",
+	"analytics_daily",
+	"api_usage_log",
+	"api_key",
+	"vector_outbox",
+	"import_batch_item",
+	"import_batch",
+	"ingestion_job",
+	"kb_migration_item",
+	"kb_migration",
+	"chunk_duplicate",
+	"chunk_edit",
+	"chunk_override",
+	"embedding",
+	"doc_chunk",
+	"document_version",
+	"document",
+	"bot_kb",
+	"knowledge_base",
+	"bot",
+	"account_token",
+	"mfa_challenge",
+	"mfa_policy",
+	"refresh_token",
+	"sso_ticket",
+	"sso_login_state",
+	"user_identity",
+	"tenant_sso_config",
+	"resource_group",
+	"role",
+	"user",
+	"provider_credential",
+	"impersonation_session",
+	"audit_log",
+}
+
+// tenantObjectPrefixes are the object storage prefixes holding tenant files:
+// knowledge documents and usage exports.
+var tenantObjectPrefixes = []string{"tenants/%s/", "api-usage/%s/"}
+
+type purgeStorage struct {
+	client *minio.Client
+	bucket string
+}
+
+type purgeVectors struct {
+	endpoint   string
+	apiKey     string
+	collection string
+	httpClient *http.Client
+}
+
+func newPurgeStorage(cfg *conf.Data, logger *log.Helper) *purgeStorage {
+	if cfg == nil || cfg.ObjectStorage == nil {
+		return nil
+	}
+	endpoint := strings.TrimSpace(cfg.ObjectStorage.Endpoint)
+	accessKey := strings.TrimSpace(cfg.ObjectStorage.AccessKey)
+	secretKey := strings.TrimSpace(cfg.ObjectStorage.SecretKey)
+	bucket := strings.TrimSpace(cfg.ObjectStorage.Bucket)
+	if endpoint == "" || accessKey == "" || secretKey == "" || bucket == "" {
+		return nil
+	}
+	secure := cfg.ObjectStorage.UseSsl
+	if parsed, err := url.Parse(endpoint); err == nil && parsed.Host != "" {
+		if parsed.Scheme == "https" {
+			secure = true
+		} else if parsed.Scheme == "http" {
+			secure = false
+		}
+		endpoint = parsed.Host
+	}
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: secure,
+		Region: strings.TrimSpace(cfg.ObjectStorage.Region),
+	})
+	if err != nil {
+		logger.Warnf("object storage init failed for tenant purge: %v", err)
+		return nil
+	}
+	return &purgeStorage{client: client, bucket: bucket}
+}
+
+func newPurgeVectors(cfg *conf.Data) *purgeVectors {
+	if cfg == nil || cfg.Vectordb == nil || cfg.Vectordb.Driver != "qdrant" || strings.TrimSpace(cfg.Vectordb.Endpoint) == "" {
+		return nil
+	}
+	collection := strings.TrimSpace(cfg.Vectordb.Collection)
+	if collection == "" {
+		collection = "ragodesk_chunks"
+	}
+	return &purgeVectors{
+		endpoint:   strings.TrimRight(strings.TrimSpace(cfg.Vectordb.Endpoint), "/"),
+		apiKey:     strings.TrimSpace(cfg.Vectordb.ApiKey),
+		collection: collection,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// ListTenantVectorCollections lists the Qdrant collections holding points of
+// the tenant: those recorded on its document versions, which must exist, plus
+// the default collection and the target collections of its KB migrations when
+// they exist.
+func (r *iamRepo) ListTenantVectorCollections(ctx context.Context, tenantID string) ([]string, error) {
+	if r.vectors == nil {
+		return nil, nil
+	}
+	existing, err := r.vectors.listCollections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	items := make([]string, 0)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			items = append(items, name)
+		}
+	}
+	if existing[r.vectors.collection] {
+		add(r.vectors.collection)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT DISTINCT vector_collection FROM document_version WHERE tenant_id = ? AND vector_collection <> ''",
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		add(r.vectors.collectionFor(key))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Versions of a cancelled or failed migration are collected, but their
+	// points may outlive them in the migration's target collection.
+	migrations, err := r.db.QueryContext(ctx, "SELECT embedding_config, generation FROM kb_migration WHERE tenant_id = ?", tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer migrations.Close()
+	for migrations.Next() {
+		var (
+			raw        sql.NullString
+			generation int32
+		)
+		if err := migrations.Scan(&raw, &generation); err != nil {
+			return nil, err
+		}
+		for _, name := range r.vectors.migrationCollections(decodeMigrationEmbedding(raw), generation, existing) {
+			add(name)
+		}
+	}
+	return items, migrations.Err()
+}
+
+func (r *iamRepo) PurgeTenantObjects(ctx context.Context, tenantID string) (int64, error) {
+	if strings.TrimSpace(tenantID) == "" {
+		return 0, kerrors.BadRequest("TENANT_ID_MISSING", "tenant id missing")
+	}
+	if r.storage == nil {
+		return 0, nil
+	}
+	var removed int64
+	for _, format := range tenantObjectPrefixes {
+		objects := make(chan minio.ObjectInfo)
+		var listed int64
+		var listErr error
+		go func() {
+			defer close(objects)
+			for obj := range r.storage.client.ListObjects(ctx, r.storage.bucket, minio.ListObjectsOptions{
+				Prefix:    fmt.Sprintf(format, tenantID),
+				Recursive: true,
+			}) {
+				if obj.Err != nil {
+					listErr = obj.Err
+					return
+				}
+				objects <- obj
+				listed++
+			}
+		}()
+		var failed int64
+		var removeErr error
+		for result := range r.storage.client.RemoveObjects(ctx, r.storage.bucket, objects, minio.RemoveObjectsOptions{}) {
+			if result.Err != nil {
+				failed++
+				if removeErr == nil {
+					removeErr = fmt.Errorf("remove object %s: %w", result.ObjectName, result.Err)
+				}
+			}
+		}
+		// The result channel closes only after the lister closed objects.
+		removed += listed - failed
+		if listErr != nil {
+			return removed, listErr
+		}
+		if removeErr != nil {
+			return removed, removeErr
+		}
+	}
+	return removed, nil
+}
+
+func (r *iamRepo) PurgeTenantRows(ctx context.Context, tenantID string) (map[string]int64, error) {
+	if strings.TrimSpace(tenantID) == "" {
+		return nil, kerrors.BadRequest("TENANT_ID_MISSING", "tenant id missing")
+	}
+	counts := make(map[string]int64)
+	for _, link := range tenantLinkTables {
+		n, err := r.deleteInBatches(ctx, "DELETE FROM "+link.table+" WHERE "+link.where, tenantID)
+		if err != nil {
+			return counts, fmt.Errorf("purge %s: %w", link.table, err)
+		}
+		if n > 0 {
+			counts[link.table] = n
+		}
+	}
+	for _, table := range tenantTables {
+		n, err := r.deleteInBatches(ctx, "DELETE FROM `"+table+"` WHERE tenant_id = ?", tenantID)
+		if err != nil {
+			return counts, fmt.Errorf("purge %s: %w", table, err)
+		}
+		if n > 0 {
+			counts[table] = n
+		}
+	}
+	return counts, nil
+}
+
+func (r *iamRepo) deleteInBatches(ctx context.Context, stmt string, tenantID string) (int64, error) {
+	var total int64
+	for {
+		res, err := r.db.ExecContext(ctx, fmt.Sprintf("%s LIMIT %d", stmt, purgeBatchSize), tenantID)
+		if err != nil {
+			return total, err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += affected
+		if affected < purgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (r *iamRepo) PurgeTenantVectors(ctx context.Context, tenantID string, collections []string) (map[string]int64, error) {
+	if strings.TrimSpace(tenantID) == "" {
+		return nil, kerrors.BadRequest("TENANT_ID_MISSING", "tenant id missing")
+	}
+	counts := make(map[string]int64)
+	if r.vectors == nil {
+		return counts, nil
+	}
+	filter := map[string]any{
+		"must": []map[string]any{{"key": "tenant_id", "match": map[string]any{"value": tenantID}}},
+	}
+	for _, collection := range collections {
+		var counted struct {
+			Result struct {
+				Count int64 `json:"count"`
+			} `json:"result"`
+		}
+		if err := r.vectors.post(ctx, collection, "/points/count", map[string]any{"filter": filter, "exact": true}, &counted); err != nil {
+			return counts, err
+		}
+		if err := r.vectors.post(ctx, collection, "/points/delete?wait=true", map[string]any{"filter": filter}, nil); err != nil {
+			return counts, err
+		}
+		counts[collection] = counted.Result.Count
+	}
+	return counts, nil
+}
+
+// collectionFor maps a collection key to the Qdrant collection name the same
+// way the knowledge repo does; the empty key is the default collection.
+func (v *purgeVectors) collectionFor(key string) string {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return v.collection
+	}
+	return v.collection + "_" + key
+}
+
+// migrationCollections returns the existing collections a KB migration to cfg
+// at generation may have written. When the dimension was left to the provider
+// it is only known from the collection name, so any dimension matches.
+func (v *purgeVectors) migrationCollections(cfg migrationEmbedding, generation int32, existing map[string]bool) []string {
+	model := strings.TrimSpace(cfg.Model)
+	if model == "" || cfg.Dim > 0 {
+		key := ""
+		if model != "" {
+			key = provider.CollectionKey(model, int(cfg.Dim))
+		}
+		name := v.collectionFor(provider.GenerationCollectionKey(key, generation))
+		if existing[name] {
+			return []string{name}
+		}
+		return nil
+	}
+	prefix := v.collectionFor(strings.TrimSuffix(provider.CollectionKey(model, 0), "0"))
+	suffix := strings.TrimPrefix(provider.GenerationCollectionKey("x", generation), "x")
+	out := make([]string, 0)
+	for name := range existing {
+		if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) || len(name) < len(prefix)+len(suffix) {
+			continue
+		}
+		dim := name[len(prefix) : len(name)-len(suffix)]
+		if _, err := strconv.Atoi(dim); err == nil && !strings.HasPrefix(dim, "-") {
+			out = append(out, name)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// migrationEmbedding is the embedding config stored on a KB migration.
+type migrationEmbedding struct {
+	Model string `json:"model,omitempty"`
+	Dim   int32  `json:"dim,omitempty"`
+}
+
+func decodeMigrationEmbedding(raw sql.NullString) migrationEmbedding {
+	var cfg migrationEmbedding
+	if !raw.Valid || strings.TrimSpace(raw.String) == "" {
+		return cfg
+	}
+	if err := json.Unmarshal([]byte(raw.String), &cfg); err != nil {
+		return migrationEmbedding{}
+	}
+	return cfg
+}
+
+// listCollections returns the names of all Qdrant collections.
+func (v *purgeVectors) listCollections(ctx context.Context) (map[string]bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.endpoint+"/collections", nil)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Result struct {
+			Collections []struct {
+				Name string `json:"name"`
+			} `json:"collections"`
+		} `json:"result"`
+	}
+	if err := v.do(req, "/collections", &out); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(out.Result.Collections))
+	for _, item := range out.Result.Collections {
+		names[item.Name] = true
+	}
+	return names, nil
+}
+
+// post calls a collection endpoint. Collections are only listed for a purge
+// when they exist and are never dropped, so a missing one is an error.
+func (v *purgeVectors) post(ctx context.Context, collection string, path string, body any, out any) error {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.endpoint+"/collections/"+url.PathEscape(collection)+path, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return v.do(req, "/collections/"+collection+path, out)
+}
+
+func (v *purgeVectors) do(req *http.Request, path string, out any) error {
+	if v.apiKey != "" {
+		req.Header.Set("api-key", v.apiKey)
+	}
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 16<<10))
+		return kerrors.InternalServer("QDRANT_PURGE_FAILED", fmt.Sprintf("qdrant %s failed: %d %s", path, resp.StatusCode, msg))
+	}
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,488 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"strconv"
+	"strings"
+	"time"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+)
+
+// scopeTables maps the resource types of scopes to their tenant tables.
+var scopeTables = map[string]string{
+	biz.ResourceTypeBot:           "bot",
+	biz.ResourceTypeKnowledgeBase: "knowledge_base",
+	biz.ResourceTypeGroup:         "resource_group",
+}
+
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func (r *iamRepo) AssignRole(ctx context.Context, userID string, roleID string, scopes []biz.ResourceRef) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var userTenant string
+	if err := tx.QueryRowContext(ctx, "SELECT tenant_id FROM `user` WHERE id = ?", userID).Scan(&userTenant); err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return kerrors.NotFound("USER_NOT_FOUND", "user not found")
+		}
+		return err
+	}
+	if userTenant != tenantID {
+		return kerrors.Forbidden("TENANT_MISMATCH", "tenant mismatch")
+	}
+
+	var roleTenant string
+	if err := tx.QueryRowContext(ctx, "SELECT tenant_id FROM `role` WHERE id = ?", roleID).Scan(&roleTenant); err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return kerrors.NotFound("ROLE_NOT_FOUND", "role not found")
+		}
+		return err
+	}
+	if roleTenant != tenantID {
+		return kerrors.Forbidden("TENANT_MISMATCH", "tenant mismatch")
+	}
+	if err := requireTenantResources(ctx, tx, tenantID, scopes); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO user_role (user_id, role_id) VALUES (?, ?) ON DUPLICATE KEY UPDATE role_id = role_id",
+		userID,
+		roleID,
+	); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_role_scope WHERE user_id = ? AND role_id = ?", userID, roleID); err != nil {
+		return err
+	}
+	for _, ref := range scopes {
+		if _, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO user_role_scope (user_id, role_id, resource_type, resource_id) VALUES (?, ?, ?, ?)",
+			userID,
+			roleID,
+			ref.Type,
+			ref.ID,
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *iamRepo) ListUserRoleBindings(ctx context.Context, userID string) ([]biz.RoleBinding, error) {
+	roles, err := r.ListUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT role_id, resource_type, resource_id FROM user_role_scope WHERE user_id = ? ORDER BY resource_type, resource_id",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	scopes := make(map[string][]biz.ResourceRef)
+	for rows.Next() {
+		var roleID string
+		var ref biz.ResourceRef
+		if err := rows.Scan(&roleID, &ref.Type, &ref.ID); err != nil {
+			return nil, err
+		}
+		scopes[roleID] = append(scopes[roleID], ref)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	items := make([]biz.RoleBinding, 0, len(roles))
+	for _, role := range roles {
+		items = append(items, biz.RoleBinding{Role: role, Scopes: scopes[role.ID]})
+	}
+	return items, nil
+}
+
+func (r *iamRepo) ListUserGrants(ctx context.Context, userID string) ([]biz.Grant, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT DISTINCT ur.role_id, p.code
+		FROM `+"`user`"+` u
+		JOIN user_role ur ON u.id = ur.user_id
+		JOIN role_permission rp ON ur.role_id = rp.role_id
+		JOIN permission p ON rp.permission_id = p.id
+		WHERE u.id = ? AND u.tenant_id = ? AND p.scope = ?`,
+		userID,
+		tenantID,
+		biz.PermissionScopeTenant,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	codes := make(map[string][]string)
+	for rows.Next() {
+		var roleID, code string
+		if err := rows.Scan(&roleID, &code); err != nil {
+			return nil, err
+		}
+		codes[roleID] = append(codes[roleID], code)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	// Direct scopes and the members of scoped groups; a binding scoped only
+	// to empty groups stays scoped and grants nothing.
+	scopeRows, err := r.db.QueryContext(
+		ctx,
+		`SELECT s.role_id, s.resource_type, s.resource_id
+		FROM user_role_scope s
+		WHERE s.user_id = ? AND s.resource_type <> ?
+		UNION
+		SELECT s.role_id, m.resource_type, m.resource_id
+		FROM user_role_scope s
+		JOIN resource_group g ON g.id = s.resource_id AND g.tenant_id = ?
+		JOIN resource_group_member m ON m.group_id = g.id
+		WHERE s.user_id = ? AND s.resource_type = ?
+		UNION
+		SELECT s.role_id, '', ''
+		FROM user_role_scope s
+		WHERE s.user_id = ?`,
+		userID,
+		biz.ResourceTypeGroup,
+		tenantID,
+		userID,
+		biz.ResourceTypeGroup,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer scopeRows.Close()
+	scoped := make(map[string]bool)
+	resources := make(map[string][]biz.ResourceRef)
+	for scopeRows.Next() {
+		var roleID string
+		var ref biz.ResourceRef
+		if err := scopeRows.Scan(&roleID, &ref.Type, &ref.ID); err != nil {
+			return nil, err
+		}
+		scoped[roleID] = true
+		if ref.Type != "" {
+			resources[roleID] = append(resources[roleID], ref)
+		}
+	}
+	if err := scopeRows.Err(); err != nil {
+		return nil, err
+	}
+
+	grants := make([]biz.Grant, 0)
+	for roleID, items := range codes {
+		for _, code := range items {
+			grants = append(grants, biz.Grant{Permission: code, Scoped: scoped[roleID], Resources: resources[roleID]})
+		}
+	}
+	return grants, nil
+}
+
+func (r *iamRepo) CreateResourceGroup(ctx context.Context, group biz.ResourceGroup) (biz.ResourceGroup, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	now := time.Now()
+	group.ID = uuid.NewString()
+	group.TenantID = tenantID
+	group.CreatedAt = now
+	group.UpdatedAt = now
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := requireTenantResources(ctx, tx, tenantID, group.Members); err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO resource_group (id, tenant_id, name, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		group.ID,
+		group.TenantID,
+		group.Name,
+		group.Description,
+		group.CreatedAt,
+		group.UpdatedAt,
+	); err != nil {
+		return biz.ResourceGroup{}, resourceGroupWriteError(err)
+	}
+	if err := insertGroupMembers(ctx, tx, group.ID, group.Members); err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	return group, nil
+}
+
+func (r *iamRepo) GetResourceGroup(ctx context.Context, id string) (biz.ResourceGroup, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	var group biz.ResourceGroup
+	err = r.db.QueryRowContext(
+		ctx,
+		"SELECT id, tenant_id, name, description, created_at, updated_at FROM resource_group WHERE id = ? AND tenant_id = ?",
+		id,
+		tenantID,
+	).Scan(&group.ID, &group.TenantID, &group.Name, &group.Description, &group.CreatedAt, &group.UpdatedAt)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.ResourceGroup{}, kerrors.NotFound("RESOURCE_GROUP_NOT_FOUND", "resource group not found")
+		}
+		return biz.ResourceGroup{}, err
+	}
+	members, err := r.listGroupMembers(ctx, []string{group.ID})
+	if err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	group.Members = members[group.ID]
+	return group, nil
+}
+
+func (r *iamRepo) ListResourceGroups(ctx context.Context) ([]biz.ResourceGroup, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT id, tenant_id, name, description, created_at, updated_at FROM resource_group WHERE tenant_id = ? ORDER BY name ASC",
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.ResourceGroup, 0)
+	ids := make([]string, 0)
+	for rows.Next() {
+		var group biz.ResourceGroup
+		if err := rows.Scan(&group.ID, &group.TenantID, &group.Name, &group.Description, &group.CreatedAt, &group.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, group)
+		ids = append(ids, group.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	members, err := r.listGroupMembers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].Members = members[items[i].ID]
+	}
+	return items, nil
+}
+
+func (r *iamRepo) UpdateResourceGroup(ctx context.Context, group biz.ResourceGroup) (biz.ResourceGroup, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	if _, err := r.db.ExecContext(
+		ctx,
+		"UPDATE resource_group SET name = ?, description = ?, updated_at = ? WHERE id = ? AND tenant_id = ?",
+		group.Name,
+		group.Description,
+		time.Now(),
+		group.ID,
+		tenantID,
+	); err != nil {
+		return biz.ResourceGroup{}, resourceGroupWriteError(err)
+	}
+	return r.GetResourceGroup(ctx, group.ID)
+}
+
+func (r *iamRepo) SetResourceGroupMembers(ctx context.Context, id string, members []biz.ResourceRef) (biz.ResourceGroup, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	res, err := tx.ExecContext(ctx, "UPDATE resource_group SET updated_at = ? WHERE id = ? AND tenant_id = ?", time.Now(), id, tenantID)
+	if err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	if affected, err := res.RowsAffected(); err != nil {
+		return biz.ResourceGroup{}, err
+	} else if affected == 0 {
+		return biz.ResourceGroup{}, kerrors.NotFound("RESOURCE_GROUP_NOT_FOUND", "resource group not found")
+	}
+	if err := requireTenantResources(ctx, tx, tenantID, members); err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM resource_group_member WHERE group_id = ?", id); err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	if err := insertGroupMembers(ctx, tx, id, members); err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return biz.ResourceGroup{}, err
+	}
+	return r.GetResourceGroup(ctx, id)
+}
+
+func (r *iamRepo) DeleteResourceGroup(ctx context.Context, id string) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	var groupID string
+	if err := tx.QueryRowContext(ctx, "SELECT id FROM resource_group WHERE id = ? AND tenant_id = ? FOR UPDATE", id, tenantID).Scan(&groupID); err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return kerrors.NotFound("RESOURCE_GROUP_NOT_FOUND", "resource group not found")
+		}
+		return err
+	}
+	var bindings int
+	if err := tx.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM user_role_scope WHERE resource_type = ? AND resource_id = ?",
+		biz.ResourceTypeGroup,
+		id,
+	).Scan(&bindings); err != nil {
+		return err
+	}
+	if bindings > 0 {
+		return kerrors.Conflict("RESOURCE_GROUP_IN_USE", "role bindings are scoped to the resource group").WithMetadata(map[string]string{"bindings": strconv.Itoa(bindings)})
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM resource_group_member WHERE group_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM resource_group WHERE id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *iamRepo) listGroupMembers(ctx context.Context, groupIDs []string) (map[string][]biz.ResourceRef, error) {
+	out := make(map[string][]biz.ResourceRef, len(groupIDs))
+	if len(groupIDs) == 0 {
+		return out, nil
+	}
+	args := make([]any, 0, len(groupIDs))
+	for _, id := range groupIDs {
+		args = append(args, id)
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT group_id, resource_type, resource_id FROM resource_group_member WHERE group_id IN ("+placeholders(len(args))+") ORDER BY resource_type, resource_id",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var groupID string
+		var ref biz.ResourceRef
+		if err := rows.Scan(&groupID, &ref.Type, &ref.ID); err != nil {
+			return nil, err
+		}
+		out[groupID] = append(out[groupID], ref)
+	}
+	return out, rows.Err()
+}
+
+func insertGroupMembers(ctx context.Context, tx *sql.Tx, groupID string, members []biz.ResourceRef) error {
+	for _, ref := range members {
+		if _, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO resource_group_member (group_id, resource_type, resource_id) VALUES (?, ?, ?)",
+			groupID,
+			ref.Type,
+			ref.ID,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requireTenantResources checks that every ref names a resource of the
+// tenant.
+func requireTenantResources(ctx context.Context, q queryer, tenantID string, refs []biz.ResourceRef) error {
+	ids := make(map[string][]any)
+	for _, ref := range refs {
+		ids[ref.Type] = append(ids[ref.Type], ref.ID)
+	}
+	for resourceType, list := range ids {
+		table, ok := scopeTables[resourceType]
+		if !ok {
+			return kerrors.BadRequest("RESOURCE_REF_INVALID", "unsupported resource type "+resourceType)
+		}
+		var count int
+		if err := q.QueryRowContext(
+			ctx,
+			"SELECT COUNT(*) FROM "+table+" WHERE tenant_id = ? AND id IN ("+placeholders(len(list))+")",
+			append([]any{tenantID}, list...)...,
+		).Scan(&count); err != nil {
+			return err
+		}
+		if count != len(list) {
+			return kerrors.NotFound("RESOURCE_NOT_FOUND", resourceType+" not found")
+		}
+	}
+	return nil
+}
+
+func resourceGroupWriteError(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+		return kerrors.Conflict("RESOURCE_GROUP_EXISTS", "resource group already exists")
+	}
+	return err
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
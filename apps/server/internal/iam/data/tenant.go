@@ -0,0 +1,354 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+const tenantPurgeJobColumns = "id, tenant_id, tenant_name, status, requested_by, report_json, error_message, created_at, started_at, finished_at"
+
+var activeTenantPurgeStatuses = []string{biz.TenantPurgePending, biz.TenantPurgeRunning}
+
+func (r *iamRepo) UpdateTenant(ctx context.Context, tenantModel biz.Tenant) (biz.Tenant, error) {
+	_, err := r.db.ExecContext(
+		ctx,
+		"UPDATE tenant SET name = ?, type = ?, plan = ? WHERE id = ?",
+		tenantModel.Name,
+		tenantModel.Type,
+		tenantModel.Plan,
+		tenantModel.ID,
+	)
+	if err != nil {
+		return biz.Tenant{}, err
+	}
+	return r.GetTenant(ctx, tenantModel.ID)
+}
+
+func (r *iamRepo) TransitionTenantStatus(ctx context.Context, id string, from []string, to string) (bool, error) {
+	if len(from) == 0 {
+		return false, nil
+	}
+	args := []any{to, id}
+	for _, status := range from {
+		args = append(args, status)
+	}
+	res, err := r.db.ExecContext(
+		ctx,
+		"UPDATE tenant SET status = ? WHERE id = ? AND status IN ("+placeholders(len(from))+")",
+		args...,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (r *iamRepo) DeleteTenant(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM tenant WHERE id = ?", id)
+	return err
+}
+
+func (r *iamRepo) GetPlan(ctx context.Context, code string) (biz.Plan, error) {
+	item, err := scanPlan(r.db.QueryRowContext(
+		ctx,
+		"SELECT code, name, max_bots, max_knowledge_bases, max_documents, max_storage_bytes, monthly_queries, monthly_tokens, updated_at FROM tenant_plan WHERE code = ?",
+		code,
+	))
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.Plan{}, kerrors.NotFound("PLAN_NOT_FOUND", "plan not found")
+		}
+		return biz.Plan{}, err
+	}
+	return item, nil
+}
+
+func (r *iamRepo) ListPlans(ctx context.Context) ([]biz.Plan, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT code, name, max_bots, max_knowledge_bases, max_documents, max_storage_bytes, monthly_queries, monthly_tokens, updated_at FROM tenant_plan ORDER BY code ASC",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]biz.Plan, 0)
+	for rows.Next() {
+		item, err := scanPlan(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func (r *iamRepo) SavePlan(ctx context.Context, item biz.Plan) (biz.Plan, error) {
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO tenant_plan
+			(code, name, max_bots, max_knowledge_bases, max_documents, max_storage_bytes, monthly_queries, monthly_tokens, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			name = VALUES(name),
+			max_bots = VALUES(max_bots),
+			max_knowledge_bases = VALUES(max_knowledge_bases),
+			max_documents = VALUES(max_documents),
+			max_storage_bytes = VALUES(max_storage_bytes),
+			monthly_queries = VALUES(monthly_queries),
+			monthly_tokens = VALUES(monthly_tokens),
+			updated_at = VALUES(updated_at)`,
+		item.Code,
+		item.Name,
+		item.Limits.MaxBots,
+		item.Limits.MaxKnowledgeBases,
+		item.Limits.MaxDocuments,
+		item.Limits.MaxStorageBytes,
+		item.Limits.MonthlyQueries,
+		item.Limits.MonthlyTokens,
+		item.UpdatedAt,
+	)
+	if err != nil {
+		return biz.Plan{}, err
+	}
+	return r.GetPlan(ctx, item.Code)
+}
+
+func (r *iamRepo) StartTenantDeletion(ctx context.Context, job biz.TenantPurgeJob) (biz.TenantPurgeJob, error) {
+	if job.ID == "" {
+		job.ID = uuid.NewString()
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return biz.TenantPurgeJob{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var status string
+	if err := tx.QueryRowContext(ctx, "SELECT status FROM tenant WHERE id = ? FOR UPDATE", job.TenantID).Scan(&status); err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.TenantPurgeJob{}, kerrors.NotFound("TENANT_NOT_FOUND", "tenant not found")
+		}
+		return biz.TenantPurgeJob{}, err
+	}
+	args := []any{job.TenantID}
+	for _, s := range activeTenantPurgeStatuses {
+		args = append(args, s)
+	}
+	var running int
+	if err := tx.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM tenant_purge_job WHERE tenant_id = ? AND status IN ("+placeholders(len(activeTenantPurgeStatuses))+")",
+		args...,
+	).Scan(&running); err != nil {
+		return biz.TenantPurgeJob{}, err
+	}
+	if running > 0 {
+		return biz.TenantPurgeJob{}, kerrors.Conflict("TENANT_PURGE_RUNNING", "tenant purge already in progress")
+	}
+	if status != plan.TenantDeleting {
+		if _, err := tx.ExecContext(ctx, "UPDATE tenant SET status = ? WHERE id = ?", plan.TenantDeleting, job.TenantID); err != nil {
+			return biz.TenantPurgeJob{}, err
+		}
+	}
+	report, err := json.Marshal(job.Report)
+	if err != nil {
+		return biz.TenantPurgeJob{}, err
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO tenant_purge_job (id, tenant_id, tenant_name, status, requested_by, report_json, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		job.ID,
+		job.TenantID,
+		job.TenantName,
+		job.Status,
+		job.RequestedBy,
+		string(report),
+		job.CreatedAt,
+	); err != nil {
+		return biz.TenantPurgeJob{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return biz.TenantPurgeJob{}, err
+	}
+	return job, nil
+}
+
+func (r *iamRepo) GetTenantPurgeJob(ctx context.Context, id string) (biz.TenantPurgeJob, error) {
+	job, err := scanTenantPurgeJob(r.db.QueryRowContext(
+		ctx,
+		"SELECT "+tenantPurgeJobColumns+" FROM tenant_purge_job WHERE id = ?",
+		id,
+	))
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.TenantPurgeJob{}, kerrors.NotFound("TENANT_PURGE_JOB_NOT_FOUND", "tenant purge job not found")
+		}
+		return biz.TenantPurgeJob{}, err
+	}
+	return job, nil
+}
+
+func (r *iamRepo) ListTenantPurgeJobs(ctx context.Context, tenantID string) ([]biz.TenantPurgeJob, error) {
+	query := "SELECT " + tenantPurgeJobColumns + " FROM tenant_purge_job"
+	args := make([]any, 0, 1)
+	if tenantID != "" {
+		query += " WHERE tenant_id = ?"
+		args = append(args, tenantID)
+	}
+	query += " ORDER BY created_at DESC LIMIT 200"
+	return r.queryTenantPurgeJobs(ctx, query, args...)
+}
+
+func (r *iamRepo) ListStaleTenantPurgeJobs(ctx context.Context, staleBefore time.Time) ([]biz.TenantPurgeJob, error) {
+	args := make([]any, 0, len(activeTenantPurgeStatuses)+1)
+	for _, s := range activeTenantPurgeStatuses {
+		args = append(args, s)
+	}
+	args = append(args, staleBefore)
+	return r.queryTenantPurgeJobs(
+		ctx,
+		"SELECT "+tenantPurgeJobColumns+" FROM tenant_purge_job WHERE status IN ("+placeholders(len(activeTenantPurgeStatuses))+") AND (heartbeat_at IS NULL OR heartbeat_at < ?) ORDER BY created_at",
+		args...,
+	)
+}
+
+func (r *iamRepo) ClaimTenantPurgeJob(ctx context.Context, id string, staleBefore time.Time) (bool, error) {
+	now := time.Now()
+	args := []any{biz.TenantPurgeRunning, now, now, id}
+	for _, s := range activeTenantPurgeStatuses {
+		args = append(args, s)
+	}
+	args = append(args, staleBefore)
+	res, err := r.db.ExecContext(
+		ctx,
+		"UPDATE tenant_purge_job SET status = ?, heartbeat_at = ?, started_at = COALESCE(started_at, ?) WHERE id = ? AND status IN ("+placeholders(len(activeTenantPurgeStatuses))+") AND (heartbeat_at IS NULL OR heartbeat_at < ?)",
+		args...,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (r *iamRepo) TouchTenantPurgeJob(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE tenant_purge_job SET heartbeat_at = ? WHERE id = ?", time.Now(), id)
+	return err
+}
+
+func (r *iamRepo) SaveTenantPurgeReport(ctx context.Context, id string, report biz.TenantPurgeReport) error {
+	raw, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, "UPDATE tenant_purge_job SET report_json = ? WHERE id = ?", string(raw), id)
+	return err
+}
+
+func (r *iamRepo) FinishTenantPurgeJob(ctx context.Context, job biz.TenantPurgeJob) error {
+	raw, err := json.Marshal(job.Report)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		"UPDATE tenant_purge_job SET status = ?, report_json = ?, error_message = ?, heartbeat_at = NULL, finished_at = ? WHERE id = ?",
+		job.Status,
+		string(raw),
+		emptyToNull(job.ErrorMessage),
+		time.Now(),
+		job.ID,
+	)
+	return err
+}
+
+func (r *iamRepo) queryTenantPurgeJobs(ctx context.Context, query string, args ...any) ([]biz.TenantPurgeJob, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]biz.TenantPurgeJob, 0)
+	for rows.Next() {
+		job, err := scanTenantPurgeJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, job)
+	}
+	return items, rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPlan(row rowScanner) (biz.Plan, error) {
+	var item biz.Plan
+	err := row.Scan(
+		&item.Code,
+		&item.Name,
+		&item.Limits.MaxBots,
+		&item.Limits.MaxKnowledgeBases,
+		&item.Limits.MaxDocuments,
+		&item.Limits.MaxStorageBytes,
+		&item.Limits.MonthlyQueries,
+		&item.Limits.MonthlyTokens,
+		&item.UpdatedAt,
+	)
+	return item, err
+}
+
+func scanTenantPurgeJob(row rowScanner) (biz.TenantPurgeJob, error) {
+	var (
+		job        biz.TenantPurgeJob
+		report     sql.NullString
+		errMessage sql.NullString
+		startedAt  sql.NullTime
+		finishedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&job.ID,
+		&job.TenantID,
+		&job.TenantName,
+		&job.Status,
+		&job.RequestedBy,
+		&report,
+		&errMessage,
+		&job.CreatedAt,
+		&startedAt,
+		&finishedAt,
+	); err != nil {
+		return biz.TenantPurgeJob{}, err
+	}
+	if report.Valid && strings.TrimSpace(report.String) != "" {
+		_ = json.Unmarshal([]byte(report.String), &job.Report)
+	}
+	job.ErrorMessage = errMessage.String
+	if startedAt.Valid {
+		job.StartedAt = &startedAt.Time
+	}
+	if finishedAt.Valid {
+		job.FinishedAt = &finishedAt.Time
+	}
+	return job, nil
+}
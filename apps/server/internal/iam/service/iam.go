@@ -10,6 +10,7 @@ import (
 	"time"
 
 	v1 "github.com/ZTH7/RagoDesk/apps/server/api/iam/v1"
+	authbiz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
 	biz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
 	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
@@ -26,13 +27,14 @@ import (
 type IAMService struct {
 	v1.UnimplementedPlatformIAMServer
 	v1.UnimplementedConsoleIAMServer
-	uc  *biz.IAMUsecase
-	log *log.Helper
+	uc     *biz.IAMUsecase
+	authUC *authbiz.AuthUsecase
+	log    *log.Helper
 }
 
 // NewIAMService creates a new IAMService
-func NewIAMService(uc *biz.IAMUsecase, logger log.Logger) *IAMService {
-	return &IAMService{uc: uc, log: log.NewHelper(logger)}
+func NewIAMService(uc *biz.IAMUsecase, authUC *authbiz.AuthUsecase, logger log.Logger) *IAMService {
+	return &IAMService{uc: uc, authUC: authUC, log: log.NewHelper(logger)}
 }
 
 func (s *IAMService) CreateTenant(ctx context.Context, req *v1.CreateTenantRequest) (*v1.TenantResponse, error) {
@@ -278,32 +280,35 @@ func (s *IAMService) CreateUser(ctx context.Context, req *v1.CreateUserRequest)
 	if email == "" && phone == "" {
 		return nil, errors.BadRequest("USER_ACCOUNT_REQUIRED", "email or phone required")
 	}
-	password := strings.TrimSpace(req.GetPassword())
 	sendInvite := req.GetSendInvite()
-	if sendInvite && password == "" {
-		password = generateTempPassword()
-	}
-	if !sendInvite && password == "" {
-		return nil, errors.BadRequest("USER_PASSWORD_REQUIRED", "password required when invite disabled")
-	}
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return nil, errors.InternalServer("PASSWORD_HASH_FAILED", "password hash failed")
+	userModel := biz.User{
+		Email: email,
+		Phone: phone,
+		Name:  req.GetName(),
 	}
-	status := strings.TrimSpace(req.GetStatus())
-	if status == "" {
-		if sendInvite {
-			status = "invited"
-		} else {
-			status = "active"
+	if sendInvite {
+		// Invited users choose their password when accepting the invitation.
+		if email == "" {
+			return nil, errors.BadRequest("USER_EMAIL_REQUIRED", "invitations need an email")
+		}
+		userModel.Status = biz.UserStatusInvited
+	} else {
+		password := strings.TrimSpace(req.GetPassword())
+		if password == "" {
+			return nil, errors.BadRequest("USER_PASSWORD_REQUIRED", "password required when invite disabled")
+		}
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, errors.InternalServer("PASSWORD_HASH_FAILED", "password hash failed")
+		}
+		userModel.PasswordHash = string(passwordHash)
+		userModel.Status = strings.ToLower(strings.TrimSpace(req.GetStatus()))
+		if userModel.Status == "" {
+			userModel.Status = biz.UserStatusActive
+		}
+		if userModel.Status != biz.UserStatusActive && userModel.Status != biz.UserStatusDisabled {
+			return nil, errors.BadRequest("USER_STATUS_INVALID", "status must be active or disabled")
 		}
-	}
-	userModel := biz.User{
-		Email:        email,
-		Phone:        phone,
-		Name:         req.GetName(),
-		Status:       status,
-		PasswordHash: string(passwordHash),
 	}
 	created, err := s.uc.CreateUser(ctx, userModel)
 	if err != nil {
@@ -311,21 +316,10 @@ func (s *IAMService) CreateUser(ctx context.Context, req *v1.CreateUserRequest)
 	}
 	inviteLink := ""
 	if sendInvite {
-		base := strings.TrimSpace(req.GetInviteBaseUrl())
-		if base == "" {
-			base = "http://localhost:5173"
-		}
-		account := email
-		if account == "" {
-			account = phone
+		inviteLink, err = s.authUC.InviteUser(ctx, created.ID, req.GetInviteBaseUrl())
+		if err != nil {
+			return nil, err
 		}
-		inviteLink = fmt.Sprintf(
-			"%s/console/login?account=%s&tenant_id=%s&temp_password=%s",
-			strings.TrimRight(base, "/"),
-			url.QueryEscape(account),
-			url.QueryEscape(created.TenantID),
-			url.QueryEscape(password),
-		)
 	}
 	return &v1.UserResponse{User: toUser(created), InviteLink: inviteLink}, nil
 }
@@ -349,6 +343,77 @@ func (s *IAMService) ListUsers(ctx context.Context, req *v1.ListUsersRequest) (*
 	return resp, nil
 }
 
+func (s *IAMService) UpdateUser(ctx context.Context, req *v1.UpdateUserRequest) (*v1.UserResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionUserWrite); err != nil {
+		return nil, err
+	}
+	updated, err := s.uc.UpdateUser(ctx, req.GetUserId(), biz.UserUpdate{
+		Email:  req.GetEmail(),
+		Phone:  req.GetPhone(),
+		Name:   req.GetName(),
+		Status: req.GetStatus(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated.Status == biz.UserStatusDisabled {
+		if err := s.authUC.RevokeSubjectSessions(ctx, authbiz.SubjectTypeTenant, updated.ID, authbiz.RevokeReasonAccountDisabled); err != nil {
+			return nil, err
+		}
+	}
+	return &v1.UserResponse{User: toUser(updated)}, nil
+}
+
+func (s *IAMService) DeleteUser(ctx context.Context, req *v1.DeleteUserRequest) (*emptypb.Empty, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionUserDelete); err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteUser(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+	if err := s.authUC.RevokeSubjectSessions(ctx, authbiz.SubjectTypeTenant, req.GetUserId(), authbiz.RevokeReasonAccountDeleted); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *IAMService) ResendInvite(ctx context.Context, req *v1.ResendInviteRequest) (*v1.UserResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionUserWrite); err != nil {
+		return nil, err
+	}
+	inviteLink, err := s.authUC.InviteUser(ctx, req.GetUserId(), req.GetInviteBaseUrl())
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.uc.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UserResponse{User: toUser(user), InviteLink: inviteLink}, nil
+}
+
+func (s *IAMService) ResetUserPassword(ctx context.Context, req *v1.ResetUserPasswordRequest) (*emptypb.Empty, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionUserWrite); err != nil {
+		return nil, err
+	}
+	if err := s.authUC.SendPasswordReset(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *IAMService) CreateRole(ctx context.Context, req *v1.CreateRoleRequest) (*v1.RoleResponse, error) {
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
@@ -384,6 +449,33 @@ func (s *IAMService) ListRoles(ctx context.Context, req *v1.ListRolesRequest) (*
 	return resp, nil
 }
 
+func (s *IAMService) UpdateRole(ctx context.Context, req *v1.UpdateRoleRequest) (*v1.RoleResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionRoleWrite); err != nil {
+		return nil, err
+	}
+	updated, err := s.uc.UpdateRole(ctx, req.GetRoleId(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RoleResponse{Role: toRole(updated)}, nil
+}
+
+func (s *IAMService) DeleteRole(ctx context.Context, req *v1.DeleteRoleRequest) (*emptypb.Empty, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionRoleDelete); err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteRole(ctx, req.GetRoleId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *IAMService) AssignRole(ctx context.Context, req *v1.AssignRoleRequest) (*emptypb.Empty, error) {
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
@@ -391,9 +483,47 @@ func (s *IAMService) AssignRole(ctx context.Context, req *v1.AssignRoleRequest)
 	if err := s.uc.RequirePermission(ctx, biz.PermissionRoleAssign); err != nil {
 		return nil, err
 	}
-	if err := s.uc.AssignRole(ctx, req.GetUserId(), req.GetRoleId()); err != nil {
+	if err := s.uc.AssignRole(ctx, req.GetUserId(), req.GetRoleId(), fromResourceRefs(req.GetScopes())); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *IAMService) ListUserRoles(ctx context.Context, req *v1.ListUserRolesRequest) (*v1.ListRolesResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionRoleRead); err != nil {
 		return nil, err
 	}
+	roles, err := s.uc.ListUserRoles(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListRolesResponse{Items: make([]*v1.Role, 0, len(roles))}
+	for _, item := range roles {
+		resp.Items = append(resp.Items, toRole(item))
+	}
+	return resp, nil
+}
+
+func (s *IAMService) RemoveRole(ctx context.Context, req *v1.RemoveRoleRequest) (*emptypb.Empty, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionRoleAssign); err != nil {
+		return nil, err
+	}
+	admin, err := s.uc.RemoveRole(ctx, req.GetUserId(), req.GetRoleId())
+	if err != nil {
+		return nil, err
+	}
+	if admin {
+		// Access tokens carry tenant_admin; signing the user out drops it.
+		if err := s.authUC.RevokeSubjectSessions(ctx, authbiz.SubjectTypeTenant, req.GetUserId(), authbiz.RevokeReasonRoleRemoved); err != nil {
+			return nil, err
+		}
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/iam/v1"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *IAMService) ListUserRoleBindings(ctx context.Context, req *v1.ListUserRolesRequest) (*v1.ListUserRoleBindingsResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionRoleRead); err != nil {
+		return nil, err
+	}
+	bindings, err := s.uc.ListUserRoleBindings(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListUserRoleBindingsResponse{Items: make([]*v1.RoleBinding, 0, len(bindings))}
+	for _, item := range bindings {
+		resp.Items = append(resp.Items, &v1.RoleBinding{Role: toRole(item.Role), Scopes: toResourceRefs(item.Scopes)})
+	}
+	return resp, nil
+}
+
+func (s *IAMService) CreateResourceGroup(ctx context.Context, req *v1.CreateResourceGroupRequest) (*v1.ResourceGroupResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionResourceGroupWrite); err != nil {
+		return nil, err
+	}
+	group, err := s.uc.CreateResourceGroup(ctx, biz.ResourceGroup{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Members:     fromResourceRefs(req.GetMembers()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ResourceGroupResponse{Group: toResourceGroup(group)}, nil
+}
+
+func (s *IAMService) ListResourceGroups(ctx context.Context, _ *v1.ListResourceGroupsRequest) (*v1.ListResourceGroupsResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionResourceGroupRead); err != nil {
+		return nil, err
+	}
+	groups, err := s.uc.ListResourceGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListResourceGroupsResponse{Items: make([]*v1.ResourceGroup, 0, len(groups))}
+	for _, item := range groups {
+		resp.Items = append(resp.Items, toResourceGroup(item))
+	}
+	return resp, nil
+}
+
+func (s *IAMService) GetResourceGroup(ctx context.Context, req *v1.GetResourceGroupRequest) (*v1.ResourceGroupResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionResourceGroupRead); err != nil {
+		return nil, err
+	}
+	group, err := s.uc.GetResourceGroup(ctx, req.GetGroupId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ResourceGroupResponse{Group: toResourceGroup(group)}, nil
+}
+
+func (s *IAMService) UpdateResourceGroup(ctx context.Context, req *v1.UpdateResourceGroupRequest) (*v1.ResourceGroupResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionResourceGroupWrite); err != nil {
+		return nil, err
+	}
+	group, err := s.uc.UpdateResourceGroup(ctx, req.GetGroupId(), req.GetName(), req.GetDescription())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ResourceGroupResponse{Group: toResourceGroup(group)}, nil
+}
+
+func (s *IAMService) SetResourceGroupMembers(ctx context.Context, req *v1.SetResourceGroupMembersRequest) (*v1.ResourceGroupResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionResourceGroupWrite); err != nil {
+		return nil, err
+	}
+	group, err := s.uc.SetResourceGroupMembers(ctx, req.GetGroupId(), fromResourceRefs(req.GetMembers()))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ResourceGroupResponse{Group: toResourceGroup(group)}, nil
+}
+
+func (s *IAMService) DeleteResourceGroup(ctx context.Context, req *v1.DeleteResourceGroupRequest) (*emptypb.Empty, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.uc.RequirePermission(ctx, biz.PermissionResourceGroupWrite); err != nil {
+		return nil, err
+	}
+	if err := s.uc.DeleteResourceGroup(ctx, req.GetGroupId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func toResourceGroup(value biz.ResourceGroup) *v1.ResourceGroup {
+	return &v1.ResourceGroup{
+		Id:          value.ID,
+		TenantId:    value.TenantID,
+		Name:        value.Name,
+		Description: value.Description,
+		Members:     toResourceRefs(value.Members),
+		CreatedAt:   toTimestamp(value.CreatedAt),
+		UpdatedAt:   toTimestamp(value.UpdatedAt),
+	}
+}
+
+func toResourceRefs(refs []biz.ResourceRef) []*v1.ResourceRef {
+	out := make([]*v1.ResourceRef, 0, len(refs))
+	for _, ref := range refs {
+		out = append(out, &v1.ResourceRef{Type: ref.Type, Id: ref.ID})
+	}
+	return out
+}
+
+func fromResourceRefs(refs []*v1.ResourceRef) []biz.ResourceRef {
+	out := make([]biz.ResourceRef, 0, len(refs))
+	for _, ref := range refs {
+		out = append(out, biz.ResourceRef{Type: ref.GetType(), ID: ref.GetId()})
+	}
+	return out
+}
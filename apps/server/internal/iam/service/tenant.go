@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/iam/v1"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
+)
+
+func (s *IAMService) UpdateTenant(ctx context.Context, req *v1.UpdateTenantRequest) (*v1.TenantResponse, error) {
+	if err := s.uc.RequirePermission(ctx, biz.PermissionTenantWrite); err != nil {
+		return nil, err
+	}
+	tenantModel, err := s.uc.UpdateTenant(ctx, req.GetId(), biz.TenantUpdate{
+		Name: req.GetName(),
+		Type: req.GetType(),
+		Plan: req.GetPlan(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TenantResponse{Tenant: toTenant(tenantModel)}, nil
+}
+
+func (s *IAMService) SuspendTenant(ctx context.Context, req *v1.SuspendTenantRequest) (*v1.TenantResponse, error) {
+	if err := s.uc.RequirePermission(ctx, biz.PermissionTenantWrite); err != nil {
+		return nil, err
+	}
+	tenantModel, err := s.uc.SuspendTenant(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TenantResponse{Tenant: toTenant(tenantModel)}, nil
+}
+
+func (s *IAMService) ResumeTenant(ctx context.Context, req *v1.ResumeTenantRequest) (*v1.TenantResponse, error) {
+	if err := s.uc.RequirePermission(ctx, biz.PermissionTenantWrite); err != nil {
+		return nil, err
+	}
+	tenantModel, err := s.uc.ResumeTenant(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TenantResponse{Tenant: toTenant(tenantModel)}, nil
+}
+
+func (s *IAMService) DeleteTenant(ctx context.Context, req *v1.DeleteTenantRequest) (*v1.TenantPurgeJobResponse, error) {
+	if err := s.uc.RequirePermission(ctx, biz.PermissionTenantDelete); err != nil {
+		return nil, err
+	}
+	job, err := s.uc.DeleteTenant(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TenantPurgeJobResponse{Job: toTenantPurgeJob(job)}, nil
+}
+
+func (s *IAMService) GetTenantPurgeJob(ctx context.Context, req *v1.GetTenantPurgeJobRequest) (*v1.TenantPurgeJobResponse, error) {
+	if err := s.uc.RequirePermission(ctx, biz.PermissionTenantRead); err != nil {
+		return nil, err
+	}
+	job, err := s.uc.GetTenantPurgeJob(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TenantPurgeJobResponse{Job: toTenantPurgeJob(job)}, nil
+}
+
+func (s *IAMService) ListTenantPurgeJobs(ctx context.Context, req *v1.ListTenantPurgeJobsRequest) (*v1.ListTenantPurgeJobsResponse, error) {
+	if err := s.uc.RequirePermission(ctx, biz.PermissionTenantRead); err != nil {
+		return nil, err
+	}
+	jobs, err := s.uc.ListTenantPurgeJobs(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListTenantPurgeJobsResponse{Items: make([]*v1.TenantPurgeJob, 0, len(jobs))}
+	for _, item := range jobs {
+		resp.Items = append(resp.Items, toTenantPurgeJob(item))
+	}
+	return resp, nil
+}
+
+func (s *IAMService) ListPlans(ctx context.Context, _ *v1.ListPlansRequest) (*v1.ListPlansResponse, error) {
+	if err := s.uc.RequirePermission(ctx, biz.PermissionTenantRead); err != nil {
+		return nil, err
+	}
+	plans, err := s.uc.ListPlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListPlansResponse{Items: make([]*v1.Plan, 0, len(plans))}
+	for _, item := range plans {
+		resp.Items = append(resp.Items, toPlan(item))
+	}
+	return resp, nil
+}
+
+func (s *IAMService) UpdatePlan(ctx context.Context, req *v1.UpdatePlanRequest) (*v1.PlanResponse, error) {
+	if err := s.uc.RequirePermission(ctx, biz.PermissionPlanWrite); err != nil {
+		return nil, err
+	}
+	limits := req.GetLimits()
+	item, err := s.uc.UpdatePlan(ctx, biz.Plan{
+		Code: req.GetCode(),
+		Name: req.GetName(),
+		Limits: plan.Limits{
+			MaxBots:           limits.GetMaxBots(),
+			MaxKnowledgeBases: limits.GetMaxKnowledgeBases(),
+			MaxDocuments:      limits.GetMaxDocuments(),
+			MaxStorageBytes:   limits.GetMaxStorageBytes(),
+			MonthlyQueries:    limits.GetMonthlyQueries(),
+			MonthlyTokens:     limits.GetMonthlyTokens(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PlanResponse{Plan: toPlan(item)}, nil
+}
+
+func toPlan(value biz.Plan) *v1.Plan {
+	return &v1.Plan{
+		Code: value.Code,
+		Name: value.Name,
+		Limits: &v1.PlanLimits{
+			MaxBots:           value.Limits.MaxBots,
+			MaxKnowledgeBases: value.Limits.MaxKnowledgeBases,
+			MaxDocuments:      value.Limits.MaxDocuments,
+			MaxStorageBytes:   value.Limits.MaxStorageBytes,
+			MonthlyQueries:    value.Limits.MonthlyQueries,
+			MonthlyTokens:     value.Limits.MonthlyTokens,
+		},
+		UpdatedAt: toTimestamp(value.UpdatedAt),
+	}
+}
+
+func toTenantPurgeJob(value biz.TenantPurgeJob) *v1.TenantPurgeJob {
+	job := &v1.TenantPurgeJob{
+		Id:           value.ID,
+		TenantId:     value.TenantID,
+		TenantName:   value.TenantName,
+		Status:       value.Status,
+		RequestedBy:  value.RequestedBy,
+		ErrorMessage: value.ErrorMessage,
+		CreatedAt:    toTimestamp(value.CreatedAt),
+		Report: &v1.TenantPurgeReport{
+			Rows:         value.Report.Rows,
+			Objects:      value.Report.Objects,
+			Collections:  value.Report.Collections,
+			VectorPoints: value.Report.VectorPoints,
+		},
+	}
+	if value.StartedAt != nil {
+		job.StartedAt = toTimestamp(*value.StartedAt)
+	}
+	if value.FinishedAt != nil {
+		job.FinishedAt = toTimestamp(*value.FinishedAt)
+	}
+	return job
+}
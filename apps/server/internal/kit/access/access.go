@@ -0,0 +1,86 @@
+// Package access matches document access labels against the entitlements of
+// an end user.
+package access
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxLabels caps the labels of a document or the entitlements of a session.
+	MaxLabels = 32
+	// MaxLabelRunes caps the length of one label.
+	MaxLabelRunes = 64
+)
+
+var (
+	ErrTooManyLabels = errors.New("too many access labels")
+	ErrLabelTooLong  = errors.New("access label too long")
+)
+
+// Normalize trims, lower-cases and dedupes labels, dropping empty ones.
+func Normalize(labels []string) ([]string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	seen := make(map[string]struct{}, len(labels))
+	out := make([]string, 0, len(labels))
+	for _, label := range labels {
+		label = strings.ToLower(strings.TrimSpace(label))
+		if label == "" {
+			continue
+		}
+		if utf8.RuneCountInString(label) > MaxLabelRunes {
+			return nil, ErrLabelTooLong
+		}
+		if _, ok := seen[label]; ok {
+			continue
+		}
+		seen[label] = struct{}{}
+		out = append(out, label)
+	}
+	if len(out) > MaxLabels {
+		return nil, ErrTooManyLabels
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+// Allowed reports whether an end user with entitlements may see a document
+// with labels: unlabeled documents are public, labeled ones need at least one
+// shared label.
+func Allowed(labels []string, entitlements []string) bool {
+	if len(labels) == 0 {
+		return true
+	}
+	for _, label := range labels {
+		for _, e := range entitlements {
+			if label == e {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SameLabels reports whether two label lists restrict to the same end users,
+// that is whether they hold the same labels in any order.
+func SameLabels(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]struct{}, len(a))
+	for _, label := range a {
+		set[label] = struct{}{}
+	}
+	for _, label := range b {
+		if _, ok := set[label]; !ok {
+			return false
+		}
+	}
+	return true
+}
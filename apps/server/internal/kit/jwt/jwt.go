@@ -1,11 +1,15 @@
 package jwt
 
 import (
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/hmac"
+	"crypto/rsa"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"math/big"
 	"strings"
 	"time"
 )
@@ -15,6 +19,14 @@ var (
 	ErrExpiredToken = errors.New("token expired")
 	ErrIssuer       = errors.New("invalid issuer")
 	ErrAudience     = errors.New("invalid audience")
+	ErrUnknownKey   = errors.New("unknown signing key")
+)
+
+// Signing algorithms.
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
 )
 
 // Claims carries JWT claim fields used by the platform.
@@ -27,18 +39,57 @@ type Claims struct {
 	NotBefore int64    `json:"nbf,omitempty"`
 	IssuedAt  int64    `json:"iat,omitempty"`
 	Roles     []string `json:"roles,omitempty"`
+	// ID is the token identifier used for revocation.
+	ID string `json:"jti,omitempty"`
+	// SessionID ties an access token to the refresh token family it was
+	// issued with.
+	SessionID string `json:"sid,omitempty"`
+	// Entitlements are the access labels of an end user, asserted by the
+	// tenant's backend.
+	Entitlements []string `json:"entitlements,omitempty"`
+	// Actor is the party acting for the subject (RFC 8693 "act"); it is set
+	// on console tokens a platform admin uses to impersonate a tenant.
+	Actor *Actor `json:"act,omitempty"`
+	// ReadOnly limits the token to calls that do not change state.
+	ReadOnly bool `json:"read_only,omitempty"`
+}
+
+// Actor identifies who acts on behalf of the subject of a token.
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+// Impersonated reports whether the token was issued for impersonation.
+func (c *Claims) Impersonated() bool {
+	return c != nil && c.Actor != nil && c.Actor.Subject != ""
 }
 
 type header struct {
 	Alg string `json:"alg"`
 	Typ string `json:"typ"`
+	Kid string `json:"kid,omitempty"`
 }
 
+// KeyFunc returns the verification key for the alg and kid of a token
+// header: a []byte secret for HS256, *rsa.PublicKey for RS256 and
+// *ecdsa.PublicKey for ES256.
+type KeyFunc func(alg string, kid string) (any, error)
+
 // ParseHS256 verifies a HS256 JWT and returns claims.
 func ParseHS256(token string, secret string, issuer string, audience string, now time.Time) (*Claims, error) {
 	if secret == "" {
 		return nil, ErrInvalidToken
 	}
+	return Parse(token, func(alg string, _ string) (any, error) {
+		if alg != AlgHS256 {
+			return nil, ErrInvalidToken
+		}
+		return []byte(secret), nil
+	}, issuer, audience, now)
+}
+
+// Parse verifies a JWT with the key keyFunc selects and returns claims.
+func Parse(token string, keyFunc KeyFunc, issuer string, audience string, now time.Time) (*Claims, error) {
 	parts := strings.Split(token, ".")
 	if len(parts) != 3 {
 		return nil, ErrInvalidToken
@@ -51,8 +102,9 @@ func ParseHS256(token string, secret string, issuer string, audience string, now
 	if err := json.Unmarshal(headerBytes, &hdr); err != nil {
 		return nil, ErrInvalidToken
 	}
-	if hdr.Alg != "HS256" {
-		return nil, ErrInvalidToken
+	key, err := keyFunc(hdr.Alg, hdr.Kid)
+	if err != nil {
+		return nil, err
 	}
 	payloadBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
 	if err != nil {
@@ -62,7 +114,7 @@ func ParseHS256(token string, secret string, issuer string, audience string, now
 	if err := json.Unmarshal(payloadBytes, &claims); err != nil {
 		return nil, ErrInvalidToken
 	}
-	if !verifySignature(secret, parts[0], parts[1], parts[2]) {
+	if !verifySignature(hdr.Alg, key, parts[0]+"."+parts[1], parts[2]) {
 		return nil, ErrInvalidToken
 	}
 	if claims.Expiry != 0 && now.Unix() >= claims.Expiry {
@@ -80,17 +132,39 @@ func ParseHS256(token string, secret string, issuer string, audience string, now
 	return &claims, nil
 }
 
-func verifySignature(secret string, header string, payload string, signature string) bool {
+func verifySignature(alg string, key any, signingInput string, signature string) bool {
 	signatureBytes, err := base64.RawURLEncoding.DecodeString(signature)
 	if err != nil {
 		return false
 	}
-	mac := hmac.New(sha256.New, []byte(secret))
-	_, _ = mac.Write([]byte(header))
-	_, _ = mac.Write([]byte("."))
-	_, _ = mac.Write([]byte(payload))
-	expected := mac.Sum(nil)
-	return hmac.Equal(signatureBytes, expected)
+	switch alg {
+	case AlgHS256:
+		secret, ok := key.([]byte)
+		if !ok || len(secret) == 0 {
+			return false
+		}
+		mac := hmac.New(sha256.New, secret)
+		_, _ = mac.Write([]byte(signingInput))
+		return hmac.Equal(signatureBytes, mac.Sum(nil))
+	case AlgRS256:
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return false
+		}
+		digest := sha256.Sum256([]byte(signingInput))
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signatureBytes) == nil
+	case AlgES256:
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok || len(signatureBytes) != 64 {
+			return false
+		}
+		digest := sha256.Sum256([]byte(signingInput))
+		r := new(big.Int).SetBytes(signatureBytes[:32])
+		sig := new(big.Int).SetBytes(signatureBytes[32:])
+		return ecdsa.Verify(pub, digest[:], r, sig)
+	default:
+		return false
+	}
 }
 
 // SignHS256 signs claims into a HS256 JWT string.
@@ -98,7 +172,7 @@ func SignHS256(claims Claims, secret string) (string, error) {
 	if secret == "" {
 		return "", ErrInvalidToken
 	}
-	headerBytes, err := json.Marshal(header{Alg: "HS256", Typ: "JWT"})
+	headerBytes, err := json.Marshal(header{Alg: AlgHS256, Typ: "JWT"})
 	if err != nil {
 		return "", ErrInvalidToken
 	}
@@ -0,0 +1,172 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const rsaKeyBits = 2048
+
+var ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+
+// SigningKey is an asymmetric key that signs tokens under a key ID.
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+}
+
+// GenerateSigningKey creates a new RS256 or ES256 private key.
+func GenerateSigningKey(alg string) (crypto.Signer, error) {
+	switch alg {
+	case AlgRS256:
+		return rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case AlgES256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// MarshalPrivateKeyPEM encodes a private key as PKCS#8 PEM.
+func MarshalPrivateKeyPEM(key crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// MarshalPublicKeyPEM encodes the public half of a key as PKIX PEM.
+func MarshalPublicKeyPEM(key crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+// ParsePrivateKeyPEM decodes a PKCS#8 PEM private key.
+func ParsePrivateKeyPEM(data string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("invalid private key pem")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, ErrUnsupportedAlgorithm
+	}
+	return signer, nil
+}
+
+// Sign creates a JWT signed with key, naming it in the kid header.
+func Sign(claims Claims, key SigningKey) (string, error) {
+	if key.Private == nil || key.ID == "" {
+		return "", ErrUnknownKey
+	}
+	headerBytes, err := json.Marshal(header{Alg: key.Algorithm, Typ: "JWT", Kid: key.ID})
+	if err != nil {
+		return "", err
+	}
+	payloadBytes, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(headerBytes) + "." + base64.RawURLEncoding.EncodeToString(payloadBytes)
+	digest := sha256.Sum256([]byte(signingInput))
+	var signature []byte
+	switch key.Algorithm {
+	case AlgRS256:
+		priv, ok := key.Private.(*rsa.PrivateKey)
+		if !ok {
+			return "", ErrUnsupportedAlgorithm
+		}
+		signature, err = rsa.SignPKCS1v15(rand.Reader, priv, crypto.SHA256, digest[:])
+		if err != nil {
+			return "", err
+		}
+	case AlgES256:
+		priv, ok := key.Private.(*ecdsa.PrivateKey)
+		if !ok {
+			return "", ErrUnsupportedAlgorithm
+		}
+		r, s, err := ecdsa.Sign(rand.Reader, priv, digest[:])
+		if err != nil {
+			return "", err
+		}
+		// JWS uses the fixed-size R||S encoding, not ASN.1.
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	default:
+		return "", ErrUnsupportedAlgorithm
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// JWK is a public key in JSON Web Key form.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// PublicJWK returns the public half of the key as a JWK.
+func (k SigningKey) PublicJWK() (JWK, error) {
+	out := JWK{Kid: k.ID, Use: "sig", Alg: k.Algorithm}
+	switch pub := k.Private.Public().(type) {
+	case *rsa.PublicKey:
+		out.Kty = "RSA"
+		out.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		out.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		out.Kty = "EC"
+		out.Crv = "P-256"
+		out.X = base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, 32)))
+		out.Y = base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, 32)))
+	default:
+		return JWK{}, ErrUnsupportedAlgorithm
+	}
+	return out, nil
+}
+
+// PublicKey returns the verification key of the signing key.
+func (k SigningKey) PublicKey() any {
+	return k.Private.Public()
+}
+
+// NormalizeAlgorithm upper-cases a configured algorithm name, defaulting to
+// HS256.
+func NormalizeAlgorithm(alg string) string {
+	alg = strings.ToUpper(strings.TrimSpace(alg))
+	if alg == "" {
+		return AlgHS256
+	}
+	return alg
+}
@@ -0,0 +1,298 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var (
+	testKeysOnce sync.Once
+	testRSAKey   SigningKey
+	testECKey    SigningKey
+)
+
+// testKeys generates one RS256 and one ES256 key for the package tests.
+func testKeys(t *testing.T) (SigningKey, SigningKey) {
+	t.Helper()
+	testKeysOnce.Do(func() {
+		rsaKey, err := GenerateSigningKey(AlgRS256)
+		if err != nil {
+			panic(err)
+		}
+		ecKey, err := GenerateSigningKey(AlgES256)
+		if err != nil {
+			panic(err)
+		}
+		testRSAKey = SigningKey{ID: "rs256-1", Algorithm: AlgRS256, Private: rsaKey}
+		testECKey = SigningKey{ID: "es256-1", Algorithm: AlgES256, Private: ecKey}
+	})
+	return testRSAKey, testECKey
+}
+
+func testClaims(now time.Time) Claims {
+	return Claims{
+		TenantID: "tenant-1",
+		Subject:  "user-1",
+		Issuer:   "ragodesk",
+		Audience: "console",
+		IssuedAt: now.Unix(),
+		Expiry:   now.Add(time.Hour).Unix(),
+		ID:       "jti-1",
+	}
+}
+
+// keyFor verifies with key when the header names its kid and algorithm.
+func keyFor(key SigningKey) KeyFunc {
+	return func(alg string, kid string) (any, error) {
+		if kid != key.ID || alg != key.Algorithm {
+			return nil, ErrUnknownKey
+		}
+		return key.PublicKey(), nil
+	}
+}
+
+func TestSignParse(t *testing.T) {
+	rsaKey, ecKey := testKeys(t)
+	now := time.Now()
+	for _, key := range []SigningKey{rsaKey, ecKey} {
+		t.Run(key.Algorithm, func(t *testing.T) {
+			token, err := Sign(testClaims(now), key)
+			if err != nil {
+				t.Fatalf("sign: %v", err)
+			}
+			hdr := decodeHeader(t, token)
+			if hdr.Alg != key.Algorithm || hdr.Kid != key.ID || hdr.Typ != "JWT" {
+				t.Fatalf("header = %+v", hdr)
+			}
+			claims, err := Parse(token, keyFor(key), "ragodesk", "console", now)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if claims.Subject != "user-1" || claims.TenantID != "tenant-1" || claims.ID != "jti-1" {
+				t.Fatalf("claims = %+v", claims)
+			}
+		})
+	}
+}
+
+func TestSignRejectsKey(t *testing.T) {
+	rsaKey, ecKey := testKeys(t)
+	tests := []struct {
+		name string
+		key  SigningKey
+		want error
+	}{
+		{name: "no kid", key: SigningKey{Algorithm: AlgRS256, Private: rsaKey.Private}, want: ErrUnknownKey},
+		{name: "no private key", key: SigningKey{ID: "k", Algorithm: AlgRS256}, want: ErrUnknownKey},
+		{name: "rs256 with ec key", key: SigningKey{ID: "k", Algorithm: AlgRS256, Private: ecKey.Private}, want: ErrUnsupportedAlgorithm},
+		{name: "es256 with rsa key", key: SigningKey{ID: "k", Algorithm: AlgES256, Private: rsaKey.Private}, want: ErrUnsupportedAlgorithm},
+		{name: "hs256", key: SigningKey{ID: "k", Algorithm: AlgHS256, Private: rsaKey.Private}, want: ErrUnsupportedAlgorithm},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Sign(testClaims(time.Now()), tt.key); !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// The verification key must match the algorithm of the header, whatever the
+// key function returns.
+func TestParseRejectsKeyMismatch(t *testing.T) {
+	rsaKey, ecKey := testKeys(t)
+	now := time.Now()
+	rsToken, err := Sign(testClaims(now), rsaKey)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	esToken, err := Sign(testClaims(now), ecKey)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	otherRSA, err := GenerateSigningKey(AlgRS256)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	// An HS256 token keyed with the bytes of the public key, as in the
+	// classic RS256 to HS256 confusion.
+	publicPEM, err := MarshalPublicKeyPEM(rsaKey.Private)
+	if err != nil {
+		t.Fatalf("public pem: %v", err)
+	}
+	confused := signHS256WithKid(t, testClaims(now), publicPEM, rsaKey.ID)
+	tests := []struct {
+		name  string
+		token string
+		key   any
+	}{
+		{name: "rs256 token, ec key", token: rsToken, key: ecKey.PublicKey()},
+		{name: "es256 token, rsa key", token: esToken, key: rsaKey.PublicKey()},
+		{name: "rs256 token, other rsa key", token: rsToken, key: otherRSA.Public()},
+		{name: "rs256 token, secret", token: rsToken, key: []byte("secret")},
+		{name: "hs256 token, rsa public key", token: confused, key: rsaKey.PublicKey()},
+		{name: "hs256 token, no secret", token: confused, key: []byte{}},
+		{name: "es256 token, no key", token: esToken, key: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.token, func(string, string) (any, error) { return tt.key, nil }, "", "", now)
+			if !errors.Is(err, ErrInvalidToken) {
+				t.Fatalf("err = %v, want ErrInvalidToken", err)
+			}
+		})
+	}
+}
+
+func TestParseRejectsTampering(t *testing.T) {
+	rsaKey, ecKey := testKeys(t)
+	now := time.Now()
+	for _, key := range []SigningKey{rsaKey, ecKey} {
+		t.Run(key.Algorithm, func(t *testing.T) {
+			token, err := Sign(testClaims(now), key)
+			if err != nil {
+				t.Fatalf("sign: %v", err)
+			}
+			parts := strings.Split(token, ".")
+			forged := testClaims(now)
+			forged.Subject = "admin"
+			payload, err := Sign(forged, key)
+			if err != nil {
+				t.Fatalf("sign: %v", err)
+			}
+			sig := []byte(parts[2])
+			sig[len(sig)/2] ^= 0x01
+			tampered := []string{
+				parts[0] + "." + strings.Split(payload, ".")[1] + "." + parts[2],
+				parts[0] + "." + parts[1] + "." + string(sig),
+				parts[0] + "." + parts[1] + ".",
+				parts[0] + "." + parts[1],
+			}
+			for _, token := range tampered {
+				if _, err := Parse(token, keyFor(key), "", "", now); !errors.Is(err, ErrInvalidToken) {
+					t.Fatalf("parse %q: err = %v, want ErrInvalidToken", token, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPublicJWK(t *testing.T) {
+	rsaKey, ecKey := testKeys(t)
+	decode := func(s string) *big.Int {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil {
+			t.Fatalf("decode %q: %v", s, err)
+		}
+		return new(big.Int).SetBytes(b)
+	}
+
+	jwk, err := rsaKey.PublicJWK()
+	if err != nil {
+		t.Fatalf("rsa jwk: %v", err)
+	}
+	if jwk.Kty != "RSA" || jwk.Kid != rsaKey.ID || jwk.Alg != AlgRS256 || jwk.Use != "sig" || jwk.Crv != "" {
+		t.Fatalf("rsa jwk = %+v", jwk)
+	}
+	rsaPub := &rsa.PublicKey{N: decode(jwk.N), E: int(decode(jwk.E).Int64())}
+	if !rsaPub.Equal(rsaKey.PublicKey()) {
+		t.Fatal("rsa jwk does not match the key")
+	}
+
+	jwk, err = ecKey.PublicJWK()
+	if err != nil {
+		t.Fatalf("ec jwk: %v", err)
+	}
+	if jwk.Kty != "EC" || jwk.Crv != "P-256" || jwk.Kid != ecKey.ID || jwk.Alg != AlgES256 || jwk.N != "" {
+		t.Fatalf("ec jwk = %+v", jwk)
+	}
+	for _, coord := range []string{jwk.X, jwk.Y} {
+		if b, _ := base64.RawURLEncoding.DecodeString(coord); len(b) != 32 {
+			t.Fatalf("coordinate %q is %d bytes, want 32", coord, len(b))
+		}
+	}
+	ecPub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: decode(jwk.X), Y: decode(jwk.Y)}
+	if !ecPub.Equal(ecKey.PublicKey()) {
+		t.Fatal("ec jwk does not match the key")
+	}
+
+	// A token verifies with the key published in the JWKS.
+	now := time.Now()
+	token, err := Sign(testClaims(now), rsaKey)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	if _, err := Parse(token, func(string, string) (any, error) { return rsaPub, nil }, "", "", now); err != nil {
+		t.Fatalf("parse with jwk: %v", err)
+	}
+}
+
+func TestPrivateKeyPEM(t *testing.T) {
+	rsaKey, ecKey := testKeys(t)
+	for _, key := range []SigningKey{rsaKey, ecKey} {
+		t.Run(key.Algorithm, func(t *testing.T) {
+			data, err := MarshalPrivateKeyPEM(key.Private)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			parsed, err := ParsePrivateKeyPEM(data)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			type equaler interface{ Equal(crypto.PrivateKey) bool }
+			if !parsed.(equaler).Equal(key.Private) {
+				t.Fatal("parsed key differs")
+			}
+		})
+	}
+	if _, err := ParsePrivateKeyPEM("not pem"); err == nil {
+		t.Fatal("parsed invalid pem")
+	}
+}
+
+func TestGenerateSigningKeyRejectsHS256(t *testing.T) {
+	if _, err := GenerateSigningKey(AlgHS256); !errors.Is(err, ErrUnsupportedAlgorithm) {
+		t.Fatalf("err = %v, want ErrUnsupportedAlgorithm", err)
+	}
+}
+
+func decodeHeader(t *testing.T, token string) header {
+	t.Helper()
+	raw, err := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[0])
+	if err != nil {
+		t.Fatalf("decode header: %v", err)
+	}
+	var hdr header
+	if err := json.Unmarshal(raw, &hdr); err != nil {
+		t.Fatalf("header json: %v", err)
+	}
+	return hdr
+}
+
+func signHS256WithKid(t *testing.T, claims Claims, secret string, kid string) string {
+	t.Helper()
+	headerJSON, err := json.Marshal(header{Alg: AlgHS256, Typ: "JWT", Kid: kid})
+	if err != nil {
+		t.Fatalf("header: %v", err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("payload: %v", err)
+	}
+	input := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(input))
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,203 @@
+// Package lang detects the language of text and splits it into lexical
+// tokens, treating scripts written without spaces (Chinese, Japanese, Thai)
+// as character bigrams.
+package lang
+
+import (
+	"strings"
+	"unicode"
+)
+
+// minDetectLetters is the least letters needed to report a language.
+const minDetectLetters = 2
+
+// Latin-script stopwords of the languages told apart by Detect.
+var latinStopwords = map[string][]string{
+	"en": {"the", "and", "is", "are", "of", "to", "in", "for", "with", "what", "how", "you", "this", "that", "it", "can", "do", "my", "on", "be"},
+	"es": {"el", "la", "los", "las", "de", "que", "y", "en", "es", "por", "para", "con", "una", "como", "qué", "cómo", "mi", "del", "se", "no"},
+	"fr": {"le", "la", "les", "de", "des", "et", "est", "que", "pour", "dans", "une", "un", "qui", "pas", "comment", "je", "vous", "du", "sur", "avec"},
+	"de": {"der", "die", "das", "und", "ist", "nicht", "ich", "wie", "mit", "für", "ein", "eine", "zu", "auf", "den", "von", "was", "sie", "es", "kann"},
+	"pt": {"o", "a", "os", "as", "de", "que", "e", "é", "do", "da", "em", "para", "com", "uma", "não", "como", "meu", "por", "se", "um"},
+	"it": {"il", "la", "di", "che", "e", "è", "per", "non", "un", "una", "con", "come", "del", "della", "sono", "mi", "gli", "nel", "si", "ho"},
+	"nl": {"de", "het", "een", "en", "van", "is", "niet", "dat", "ik", "voor", "met", "hoe", "wat", "op", "zijn", "je", "te", "er", "kan", "mijn"},
+}
+
+// latinOrder breaks stopword ties, most common language first.
+var latinOrder = []string{"en", "es", "fr", "de", "pt", "it", "nl"}
+
+var stopwordIndex = buildStopwordIndex()
+
+func buildStopwordIndex() map[string][]string {
+	index := make(map[string][]string)
+	for code, words := range latinStopwords {
+		for _, w := range words {
+			index[w] = append(index[w], code)
+		}
+	}
+	return index
+}
+
+var names = map[string]string{
+	"zh": "Chinese",
+	"ja": "Japanese",
+	"ko": "Korean",
+	"en": "English",
+	"es": "Spanish",
+	"fr": "French",
+	"de": "German",
+	"pt": "Portuguese",
+	"it": "Italian",
+	"nl": "Dutch",
+	"ru": "Russian",
+	"ar": "Arabic",
+	"he": "Hebrew",
+	"el": "Greek",
+	"th": "Thai",
+	"hi": "Hindi",
+}
+
+// Name returns the English name of a language code, or the code itself.
+func Name(code string) string {
+	if name, ok := names[code]; ok {
+		return name
+	}
+	return code
+}
+
+// Detect returns the ISO 639-1 code of the dominant language of text, or ""
+// when it cannot tell. Scripts decide most languages; Latin text is told
+// apart by stopwords, so short keyword queries may stay undetected.
+func Detect(text string) string {
+	var han, kana, hangul, latin, total int
+	others := make(map[string]int)
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			han++
+		case unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r):
+			kana++
+		case unicode.Is(unicode.Hangul, r):
+			hangul++
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		case unicode.Is(unicode.Cyrillic, r):
+			others["ru"]++
+		case unicode.Is(unicode.Arabic, r):
+			others["ar"]++
+		case unicode.Is(unicode.Hebrew, r):
+			others["he"]++
+		case unicode.Is(unicode.Greek, r):
+			others["el"]++
+		case unicode.Is(unicode.Thai, r):
+			others["th"]++
+		case unicode.Is(unicode.Devanagari, r):
+			others["hi"]++
+		default:
+			continue
+		}
+		total++
+	}
+	if total < minDetectLetters {
+		return ""
+	}
+	// One CJK character carries about as much as a short Latin word.
+	best, bestCount := "", 0
+	pick := func(code string, count int) {
+		if count > bestCount {
+			best, bestCount = code, count
+		}
+	}
+	if kana > 0 {
+		pick("ja", (kana+han)*3)
+	} else {
+		pick("zh", han*3)
+	}
+	pick("ko", hangul*2)
+	for code, count := range others {
+		pick(code, count)
+	}
+	if latin > bestCount {
+		return detectLatin(text)
+	}
+	return best
+}
+
+// detectLatin picks the Latin-script language with the most stopword hits,
+// or "" without any.
+func detectLatin(text string) string {
+	hits := make(map[string]int)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}) {
+		for _, code := range stopwordIndex[word] {
+			hits[code]++
+		}
+	}
+	best, bestHits := "", 0
+	for _, code := range latinOrder {
+		if hits[code] > bestHits {
+			best, bestHits = code, hits[code]
+		}
+	}
+	return best
+}
+
+// Tokens splits text into lower-cased lexical tokens, at most limit of them
+// (no limit when limit <= 0). Words of spaced scripts are kept whole when
+// they have two or more characters; runs of Han, kana and Thai characters
+// become overlapping character bigrams, or a single token for a one-character
+// run.
+func Tokens(text string, limit int) []string {
+	out := make([]string, 0)
+	full := func() bool { return limit > 0 && len(out) >= limit }
+	var word []rune
+	var run []rune
+	flushWord := func() {
+		if len(word) >= 2 {
+			out = append(out, string(word))
+		}
+		word = word[:0]
+	}
+	flushRun := func() {
+		switch {
+		case len(run) == 1:
+			out = append(out, string(run))
+		case len(run) > 1:
+			for i := 1; i < len(run) && !full(); i++ {
+				out = append(out, string(run[i-1:i+1]))
+			}
+		}
+		run = run[:0]
+	}
+	for _, r := range strings.ToLower(text) {
+		if full() {
+			break
+		}
+		switch {
+		case isUnspaced(r):
+			flushWord()
+			run = append(run, r)
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.Is(unicode.Mn, r):
+			flushRun()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushRun()
+		}
+	}
+	if !full() {
+		flushWord()
+		flushRun()
+	}
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// isUnspaced reports characters of scripts written without word spaces,
+// including the katakana prolonged sound mark "ー".
+func isUnspaced(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Thai, r) || r == 'ー'
+}
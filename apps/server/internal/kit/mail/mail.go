@@ -0,0 +1,98 @@
+// Package mail sends account mail such as invitations and password resets.
+// Delivery is pluggable; the built-in senders only log or store messages
+// locally.
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// Sender drivers.
+const (
+	DriverLog  = "log"
+	DriverFile = "file"
+)
+
+const defaultFrom = "RagoDesk <no-reply@ragodesk.local>"
+
+// Message is a plain text mail.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers mail.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewSender returns the sender of a driver; unknown drivers fall back to
+// logging.
+func NewSender(driver string, dir string, from string, logger log.Logger) Sender {
+	if strings.TrimSpace(from) == "" {
+		from = defaultFrom
+	}
+	helper := log.NewHelper(logger)
+	switch strings.ToLower(strings.TrimSpace(driver)) {
+	case DriverFile:
+		if strings.TrimSpace(dir) == "" {
+			dir = "mail"
+		}
+		return &fileSender{dir: dir, from: from}
+	case "", DriverLog:
+	default:
+		helper.Warnf("unknown mail driver %q, logging mail instead", driver)
+	}
+	return &logSender{log: helper, from: from}
+}
+
+type logSender struct {
+	log  *log.Helper
+	from string
+}
+
+func (s *logSender) Send(_ context.Context, msg Message) error {
+	s.log.Infof("mail from=%q to=%q subject=%q\n%s", s.from, msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+type fileSender struct {
+	dir  string
+	from string
+}
+
+func (s *fileSender) Send(_ context.Context, msg Message) error {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+	now := time.Now()
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102T150405Z"), uuid.NewString()[:8])
+	return os.WriteFile(filepath.Join(s.dir, name), []byte(format(s.from, msg, now)), 0o600)
+}
+
+// format renders msg as an RFC 5322 message.
+func format(from string, msg Message, now time.Time) string {
+	var b strings.Builder
+	b.WriteString("From: " + headerValue(from) + "\r\n")
+	b.WriteString("To: " + headerValue(msg.To) + "\r\n")
+	b.WriteString("Subject: " + headerValue(msg.Subject) + "\r\n")
+	b.WriteString("Date: " + now.Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return b.String()
+}
+
+// headerValue keeps a value on a single header line.
+func headerValue(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
@@ -0,0 +1,398 @@
+// Package oidc implements the relying party side of the OpenID Connect
+// authorization code flow with PKCE.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxResponseBytes bounds discovery, token, JWKS and userinfo responses.
+const maxResponseBytes = 1 << 20
+
+// clockSkew is the tolerance applied to ID token expiry.
+const clockSkew = 2 * time.Minute
+
+var (
+	ErrDiscovery    = errors.New("oidc discovery failed")
+	ErrExchange     = errors.New("oidc code exchange failed")
+	ErrInvalidToken = errors.New("invalid id token")
+)
+
+// Provider is the discovered metadata of an OpenID provider.
+type Provider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Token is the token endpoint response.
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+}
+
+// Claims are the claims of an ID token or userinfo response.
+type Claims map[string]any
+
+// Discover loads the provider metadata of issuer.
+func Discover(ctx context.Context, client *http.Client, issuer string) (Provider, error) {
+	issuer = strings.TrimRight(strings.TrimSpace(issuer), "/")
+	var provider Provider
+	if err := getJSON(ctx, client, issuer+"/.well-known/openid-configuration", "", &provider); err != nil {
+		return Provider{}, fmt.Errorf("%w: %v", ErrDiscovery, err)
+	}
+	if strings.TrimRight(provider.Issuer, "/") != issuer {
+		return Provider{}, fmt.Errorf("%w: issuer mismatch %s", ErrDiscovery, provider.Issuer)
+	}
+	if provider.AuthorizationEndpoint == "" || provider.TokenEndpoint == "" || provider.JWKSURI == "" {
+		return Provider{}, fmt.Errorf("%w: incomplete provider metadata", ErrDiscovery)
+	}
+	return provider, nil
+}
+
+// NewPKCE returns a code verifier and its S256 challenge.
+func NewPKCE() (string, string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	verifier := base64.RawURLEncoding.EncodeToString(raw)
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// AuthCodeURL returns the authorization request URL.
+func AuthCodeURL(p Provider, clientID string, redirectURI string, scopes []string, state string, nonce string, challenge string) (string, error) {
+	target, err := url.Parse(p.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+	query := target.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", clientID)
+	query.Set("redirect_uri", redirectURI)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", challenge)
+	query.Set("code_challenge_method", "S256")
+	target.RawQuery = query.Encode()
+	return target.String(), nil
+}
+
+// Exchange redeems an authorization code. Confidential clients authenticate
+// with client_secret_basic.
+func Exchange(ctx context.Context, client *http.Client, p Provider, clientID string, clientSecret string, code string, redirectURI string, verifier string) (Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURI)
+	form.Set("code_verifier", verifier)
+	if clientSecret == "" {
+		form.Set("client_id", clientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Token{}, fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Token{}, fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return Token{}, fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var failure struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		_ = json.Unmarshal(body, &failure)
+		return Token{}, fmt.Errorf("%w: status %d %s %s", ErrExchange, resp.StatusCode, failure.Error, failure.ErrorDescription)
+	}
+	var token Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return Token{}, fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	if token.IDToken == "" {
+		return Token{}, fmt.Errorf("%w: id_token missing", ErrExchange)
+	}
+	return token, nil
+}
+
+// VerifyIDToken checks the signature of an ID token against the provider
+// keys and validates issuer, audience, expiry and nonce.
+func VerifyIDToken(ctx context.Context, client *http.Client, p Provider, raw string, clientID string, nonce string, now time.Time) (Claims, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := json.Unmarshal(headerBytes, &header); err != nil {
+		return nil, ErrInvalidToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var keys struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := getJSON(ctx, client, p.JWKSURI, "", &keys); err != nil {
+		return nil, fmt.Errorf("%w: load jwks: %v", ErrInvalidToken, err)
+	}
+	if err := verifyJWS(header.Alg, header.Kid, keys.Keys, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if claims.String("iss") != p.Issuer {
+		return nil, fmt.Errorf("%w: issuer mismatch", ErrInvalidToken)
+	}
+	audiences := claims.Strings("aud")
+	if !contains(audiences, clientID) {
+		return nil, fmt.Errorf("%w: audience mismatch", ErrInvalidToken)
+	}
+	if len(audiences) > 1 && claims.String("azp") != "" && claims.String("azp") != clientID {
+		return nil, fmt.Errorf("%w: authorized party mismatch", ErrInvalidToken)
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok || !now.Add(-clockSkew).Before(time.Unix(int64(exp), 0)) {
+		return nil, fmt.Errorf("%w: token expired", ErrInvalidToken)
+	}
+	if claims.String("nonce") != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidToken)
+	}
+	if claims.String("sub") == "" {
+		return nil, fmt.Errorf("%w: subject missing", ErrInvalidToken)
+	}
+	return claims, nil
+}
+
+// UserInfo loads the claims of the userinfo endpoint.
+func UserInfo(ctx context.Context, client *http.Client, p Provider, accessToken string) (Claims, error) {
+	var claims Claims
+	if err := getJSON(ctx, client, p.UserinfoEndpoint, accessToken, &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// String returns a string claim.
+func (c Claims) String(name string) string {
+	value, _ := c[name].(string)
+	return value
+}
+
+// Strings returns a claim as a list; single strings, numbers and booleans
+// become one element.
+func (c Claims) Strings(name string) []string {
+	switch value := c[name].(type) {
+	case string:
+		return []string{value}
+	case []any:
+		out := make([]string, 0, len(value))
+		for _, item := range value {
+			if text := scalarString(item); text != "" {
+				out = append(out, text)
+			}
+		}
+		return out
+	default:
+		if text := scalarString(value); text != "" {
+			return []string{text}
+		}
+		return nil
+	}
+}
+
+func scalarString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func verifyJWS(alg string, kid string, keys []jwk, signingInput string, signature []byte) error {
+	var (
+		hash crypto.Hash
+		kty  string
+	)
+	switch alg {
+	case "RS256":
+		hash, kty = crypto.SHA256, "RSA"
+	case "RS384":
+		hash, kty = crypto.SHA384, "RSA"
+	case "RS512":
+		hash, kty = crypto.SHA512, "RSA"
+	case "ES256":
+		hash, kty = crypto.SHA256, "EC"
+	case "ES384":
+		hash, kty = crypto.SHA384, "EC"
+	default:
+		return fmt.Errorf("%w: unsupported alg %s", ErrInvalidToken, alg)
+	}
+	h := hash.New()
+	h.Write([]byte(signingInput))
+	digest := h.Sum(nil)
+	for _, key := range keys {
+		if key.Kty != kty || (key.Use != "" && key.Use != "sig") || (kid != "" && key.Kid != kid) {
+			continue
+		}
+		switch kty {
+		case "RSA":
+			pub, err := key.rsaKey()
+			if err != nil {
+				continue
+			}
+			if rsa.VerifyPKCS1v15(pub, hash, digest, signature) == nil {
+				return nil
+			}
+		case "EC":
+			pub, err := key.ecdsaKey()
+			if err != nil {
+				continue
+			}
+			size := (pub.Curve.Params().BitSize + 7) / 8
+			if len(signature) != 2*size {
+				continue
+			}
+			r := new(big.Int).SetBytes(signature[:size])
+			s := new(big.Int).SetBytes(signature[size:])
+			if ecdsa.Verify(pub, digest, r, s) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%w: signature verification failed", ErrInvalidToken)
+}
+
+func (k jwk) rsaKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid rsa exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+func (k jwk) ecdsaKey() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	default:
+		return nil, errors.New("unsupported curve")
+	}
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := base64.RawURLEncoding.DecodeString(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, endpoint string, bearer string, out any) error {
+	if endpoint == "" {
+		return errors.New("endpoint missing")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", endpoint, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(out)
+}
+
+func contains(items []string, target string) bool {
+	for _, item := range items {
+		if item == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,332 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/oidc"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/oidc/oidctest"
+)
+
+const (
+	testClientID    = "desk-client"
+	testRedirectURI = "https://desk.example.com/api/v1/sso/oidc/callback"
+)
+
+func newProvider(t *testing.T, secret string) (*oidctest.Provider, oidc.Provider) {
+	t.Helper()
+	stub, err := oidctest.NewProvider(testClientID, secret)
+	if err != nil {
+		t.Fatalf("stub provider: %v", err)
+	}
+	t.Cleanup(stub.Close)
+	provider, err := oidc.Discover(context.Background(), stub.Client(), stub.Issuer+"/")
+	if err != nil {
+		t.Fatalf("discover: %v", err)
+	}
+	return stub, provider
+}
+
+// authorize starts a login and returns the code of the approved request.
+func authorize(t *testing.T, stub *oidctest.Provider, provider oidc.Provider, nonce string, challenge string) string {
+	t.Helper()
+	authURL, err := oidc.AuthCodeURL(provider, testClientID, testRedirectURI, []string{"openid", "email"}, "state-1", nonce, challenge)
+	if err != nil {
+		t.Fatalf("auth code url: %v", err)
+	}
+	back, err := stub.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	if back.Get("state") != "state-1" {
+		t.Fatalf("state = %q, want state-1", back.Get("state"))
+	}
+	return back.Get("code")
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	for _, secret := range []string{"", "s3cret+/="} {
+		name := "public client"
+		if secret != "" {
+			name = "confidential client"
+		}
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			stub, provider := newProvider(t, secret)
+			stub.SetUserInfo(map[string]any{"groups": []string{"support"}})
+			verifier, challenge, err := oidc.NewPKCE()
+			if err != nil {
+				t.Fatalf("pkce: %v", err)
+			}
+			code := authorize(t, stub, provider, "nonce-1", challenge)
+
+			token, err := oidc.Exchange(ctx, stub.Client(), provider, testClientID, secret, code, testRedirectURI, verifier)
+			if err != nil {
+				t.Fatalf("exchange: %v", err)
+			}
+			claims, err := oidc.VerifyIDToken(ctx, stub.Client(), provider, token.IDToken, testClientID, "nonce-1", time.Now())
+			if err != nil {
+				t.Fatalf("verify: %v", err)
+			}
+			if claims.String("sub") != "user-1" || claims.String("email") != "alice@example.com" {
+				t.Fatalf("claims = %v", claims)
+			}
+			info, err := oidc.UserInfo(ctx, stub.Client(), provider, token.AccessToken)
+			if err != nil {
+				t.Fatalf("userinfo: %v", err)
+			}
+			if info.String("sub") != "user-1" || strings.Join(info.Strings("groups"), ",") != "support" {
+				t.Fatalf("userinfo = %v", info)
+			}
+		})
+	}
+}
+
+func TestExchangeFailures(t *testing.T) {
+	ctx := context.Background()
+	stub, provider := newProvider(t, "")
+	verifier, challenge, err := oidc.NewPKCE()
+	if err != nil {
+		t.Fatalf("pkce: %v", err)
+	}
+	otherVerifier, _, err := oidc.NewPKCE()
+	if err != nil {
+		t.Fatalf("pkce: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		clientID    string
+		redirectURI string
+		verifier    string
+		// replay exchanges the code successfully first.
+		replay   bool
+		wantText string
+	}{
+		{name: "pkce verifier mismatch", verifier: otherVerifier, wantText: "PKCE verification failed"},
+		{name: "missing pkce verifier", verifier: "", wantText: "PKCE verification failed"},
+		{name: "redirect uri mismatch", redirectURI: "https://evil.example.com/callback", wantText: "redirect_uri mismatch"},
+		{name: "unknown client", clientID: "other-client", wantText: "invalid_client"},
+		{name: "replayed code", replay: true, wantText: "unknown or used code"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := authorize(t, stub, provider, "nonce-1", challenge)
+			clientID, redirectURI := testClientID, testRedirectURI
+			if tt.clientID != "" {
+				clientID = tt.clientID
+			}
+			if tt.redirectURI != "" {
+				redirectURI = tt.redirectURI
+			}
+			codeVerifier := tt.verifier
+			if tt.replay {
+				if _, err := oidc.Exchange(ctx, stub.Client(), provider, testClientID, "", code, testRedirectURI, verifier); err != nil {
+					t.Fatalf("first exchange: %v", err)
+				}
+				codeVerifier = verifier
+			}
+			_, err := oidc.Exchange(ctx, stub.Client(), provider, clientID, "", code, redirectURI, codeVerifier)
+			if !errors.Is(err, oidc.ErrExchange) {
+				t.Fatalf("err = %v, want %v", err, oidc.ErrExchange)
+			}
+			if !strings.Contains(err.Error(), tt.wantText) {
+				t.Fatalf("err = %v, want it to contain %q", err, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	ctx := context.Background()
+	stub, provider := newProvider(t, "")
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("key: %v", err)
+	}
+	otherECKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("key: %v", err)
+	}
+	now := time.Now()
+	sign := func(alg string, kid string, key crypto.Signer, edit func(map[string]any)) string {
+		claims := stub.IDToken("nonce-1")
+		if edit != nil {
+			edit(claims)
+		}
+		raw, err := oidctest.Sign(alg, kid, key, claims)
+		if err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		return raw
+	}
+	valid := sign("RS256", oidctest.RSAKeyID, stub.RSAKey, nil)
+	parts := strings.Split(valid, ".")
+
+	tests := []struct {
+		name     string
+		token    string
+		nonce    string
+		wantText string
+	}{
+		{name: "rs256", token: valid},
+		{name: "es256", token: sign("ES256", oidctest.ECKeyID, stub.ECKey, nil)},
+		{name: "without kid", token: sign("RS256", "", stub.RSAKey, nil)},
+		{
+			name:  "several audiences with matching azp",
+			token: sign("RS256", oidctest.RSAKeyID, stub.RSAKey, func(c map[string]any) { c["aud"] = []string{testClientID, "api"}; c["azp"] = testClientID }),
+		},
+		{
+			name:  "expired within clock skew",
+			token: sign("RS256", oidctest.RSAKeyID, stub.RSAKey, func(c map[string]any) { c["exp"] = now.Add(-time.Minute).Unix() }),
+		},
+		{name: "nonce mismatch", token: valid, nonce: "nonce-2", wantText: "nonce mismatch"},
+		{
+			name:     "missing nonce",
+			token:    sign("RS256", oidctest.RSAKeyID, stub.RSAKey, func(c map[string]any) { delete(c, "nonce") }),
+			wantText: "nonce mismatch",
+		},
+		{
+			name:     "wrong audience",
+			token:    sign("RS256", oidctest.RSAKeyID, stub.RSAKey, func(c map[string]any) { c["aud"] = "other-client" }),
+			wantText: "audience mismatch",
+		},
+		{
+			name:     "several audiences with other azp",
+			token:    sign("RS256", oidctest.RSAKeyID, stub.RSAKey, func(c map[string]any) { c["aud"] = []string{testClientID, "api"}; c["azp"] = "api" }),
+			wantText: "authorized party mismatch",
+		},
+		{
+			name:     "wrong issuer",
+			token:    sign("RS256", oidctest.RSAKeyID, stub.RSAKey, func(c map[string]any) { c["iss"] = "https://evil.example.com" }),
+			wantText: "issuer mismatch",
+		},
+		{
+			name:     "expired",
+			token:    sign("RS256", oidctest.RSAKeyID, stub.RSAKey, func(c map[string]any) { c["exp"] = now.Add(-5 * time.Minute).Unix() }),
+			wantText: "token expired",
+		},
+		{
+			name:     "missing exp",
+			token:    sign("RS256", oidctest.RSAKeyID, stub.RSAKey, func(c map[string]any) { delete(c, "exp") }),
+			wantText: "token expired",
+		},
+		{
+			name:     "missing subject",
+			token:    sign("RS256", oidctest.RSAKeyID, stub.RSAKey, func(c map[string]any) { delete(c, "sub") }),
+			wantText: "subject missing",
+		},
+		{
+			name:     "unknown rsa key",
+			token:    sign("RS256", oidctest.RSAKeyID, otherKey, nil),
+			wantText: "signature verification failed",
+		},
+		{
+			name:     "unknown ec key",
+			token:    sign("ES256", oidctest.ECKeyID, otherECKey, nil),
+			wantText: "signature verification failed",
+		},
+		{
+			name:     "kid of another key",
+			token:    sign("RS256", oidctest.ECKeyID, stub.RSAKey, nil),
+			wantText: "signature verification failed",
+		},
+		{
+			name:     "tampered payload",
+			token:    parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin"}`)) + "." + parts[2],
+			wantText: "signature verification failed",
+		},
+		{
+			name:     "alg none",
+			token:    base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." + parts[1] + ".",
+			wantText: "unsupported alg none",
+		},
+		{
+			name:     "alg hs256",
+			token:    base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","kid":"rsa-1"}`)) + "." + parts[1] + "." + parts[2],
+			wantText: "unsupported alg HS256",
+		},
+		{name: "malformed", token: parts[0] + "." + parts[1], wantText: "invalid id token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nonce := tt.nonce
+			if nonce == "" {
+				nonce = "nonce-1"
+			}
+			claims, err := oidc.VerifyIDToken(ctx, stub.Client(), provider, tt.token, testClientID, nonce, now)
+			if tt.wantText == "" {
+				if err != nil {
+					t.Fatalf("verify: %v", err)
+				}
+				if claims.String("sub") != "user-1" {
+					t.Fatalf("sub = %q", claims.String("sub"))
+				}
+				return
+			}
+			if !errors.Is(err, oidc.ErrInvalidToken) {
+				t.Fatalf("err = %v, want %v", err, oidc.ErrInvalidToken)
+			}
+			if !strings.Contains(err.Error(), tt.wantText) {
+				t.Fatalf("err = %v, want it to contain %q", err, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata string
+		status   int
+		wantText string
+	}{
+		{
+			name:     "issuer mismatch",
+			metadata: `{"issuer":"https://evil.example.com","authorization_endpoint":"a","token_endpoint":"t","jwks_uri":"j"}`,
+			wantText: "issuer mismatch",
+		},
+		{
+			name:     "incomplete metadata",
+			metadata: `{"issuer":"{{issuer}}","authorization_endpoint":"a"}`,
+			wantText: "incomplete provider metadata",
+		},
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			wantText: "status 404",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var issuer string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				_, _ = w.Write([]byte(strings.ReplaceAll(tt.metadata, "{{issuer}}", issuer)))
+			}))
+			defer server.Close()
+			issuer = server.URL
+			_, err := oidc.Discover(context.Background(), server.Client(), issuer)
+			if !errors.Is(err, oidc.ErrDiscovery) {
+				t.Fatalf("err = %v, want %v", err, oidc.ErrDiscovery)
+			}
+			if !strings.Contains(err.Error(), tt.wantText) {
+				t.Fatalf("err = %v, want it to contain %q", err, tt.wantText)
+			}
+		})
+	}
+}
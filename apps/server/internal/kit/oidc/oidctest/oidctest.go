@@ -0,0 +1,352 @@
+// Package oidctest provides an in-process OpenID provider for tests: it
+// serves discovery, an authorization endpoint that approves every request,
+// a PKCE-checking token endpoint, JWKS and userinfo.
+package oidctest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Key IDs of the provider signing keys published in its JWKS.
+const (
+	RSAKeyID = "rsa-1"
+	ECKeyID  = "ec-1"
+)
+
+// Provider is a running stub OpenID provider. Close it when done.
+type Provider struct {
+	*httptest.Server
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RSAKey       *rsa.PrivateKey
+	ECKey        *ecdsa.PrivateKey
+
+	mu sync.Mutex
+	// claims are merged into every ID token issued by the token endpoint.
+	claims   map[string]any
+	userinfo map[string]any
+	codes    map[string]authorization
+	tokens   map[string]string
+}
+
+type authorization struct {
+	challenge   string
+	nonce       string
+	redirectURI string
+}
+
+// NewProvider starts a provider for one client. Clients without a secret
+// are public and send their client_id in the token request.
+func NewProvider(clientID string, clientSecret string) (*Provider, error) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	p := &Provider{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RSAKey:       rsaKey,
+		ECKey:        ecKey,
+		claims:       map[string]any{"sub": "user-1", "email": "alice@example.com"},
+		userinfo:     map[string]any{},
+		codes:        make(map[string]authorization),
+		tokens:       make(map[string]string),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/authorize", p.authorize)
+	mux.HandleFunc("/token", p.token)
+	mux.HandleFunc("/jwks", p.jwks)
+	mux.HandleFunc("/userinfo", p.userInfo)
+	p.Server = httptest.NewServer(mux)
+	p.Issuer = p.Server.URL
+	return p, nil
+}
+
+// SetClaims sets claims of the ID tokens issued from now on; a nil value
+// removes the claim.
+func (p *Provider) SetClaims(claims map[string]any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key, value := range claims {
+		if value == nil {
+			delete(p.claims, key)
+			continue
+		}
+		p.claims[key] = value
+	}
+}
+
+// SetUserInfo sets the claims served by the userinfo endpoint besides sub.
+func (p *Provider) SetUserInfo(claims map[string]any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.userinfo = claims
+}
+
+// Authorize follows an authorization request URL as a user who approves it
+// and returns the query of the redirect back to the client.
+func (p *Provider) Authorize(authURL string) (url.Values, error) {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		return nil, fmt.Errorf("authorize: status %d", resp.StatusCode)
+	}
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return nil, err
+	}
+	return location.Query(), nil
+}
+
+// Sign returns a JWS of claims. key is an *rsa.PrivateKey for RS256 or an
+// *ecdsa.PrivateKey for ES256; alg and kid are written to the header as given.
+func Sign(alg string, kid string, key crypto.Signer, claims map[string]any) (string, error) {
+	header := map[string]any{"alg": alg, "typ": "JWT"}
+	if kid != "" {
+		header["kid"] = kid
+	}
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	input := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(input))
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, k, digest[:])
+		if err == nil {
+			size := (k.Curve.Params().BitSize + 7) / 8
+			signature = make([]byte, 2*size)
+			r.FillBytes(signature[:size])
+			s.FillBytes(signature[size:])
+		}
+	default:
+		err = fmt.Errorf("unsupported key %T", key)
+	}
+	if err != nil {
+		return "", err
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// IDToken returns the claims of an ID token the provider would issue now.
+func (p *Provider) IDToken(nonce string) map[string]any {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	claims := map[string]any{
+		"iss":   p.Issuer,
+		"aud":   p.ClientID,
+		"iat":   now.Unix(),
+		"exp":   now.Add(5 * time.Minute).Unix(),
+		"nonce": nonce,
+	}
+	for key, value := range p.claims {
+		claims[key] = value
+	}
+	return claims
+}
+
+func (p *Provider) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.Issuer,
+		"authorization_endpoint":                p.Issuer + "/authorize",
+		"token_endpoint":                        p.Issuer + "/token",
+		"userinfo_endpoint":                     p.Issuer + "/userinfo",
+		"jwks_uri":                              p.Issuer + "/jwks",
+		"code_challenge_methods_supported":      []string{"S256"},
+		"id_token_signing_alg_values_supported": []string{"RS256", "ES256"},
+	})
+}
+
+func (p *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectURI := query.Get("redirect_uri")
+	switch {
+	case query.Get("client_id") != p.ClientID:
+		http.Error(w, "unknown client", http.StatusBadRequest)
+		return
+	case query.Get("response_type") != "code":
+		http.Error(w, "unsupported response type", http.StatusBadRequest)
+		return
+	case query.Get("code_challenge") == "" || query.Get("code_challenge_method") != "S256":
+		http.Error(w, "S256 code challenge required", http.StatusBadRequest)
+		return
+	case redirectURI == "":
+		http.Error(w, "redirect_uri required", http.StatusBadRequest)
+		return
+	}
+	code := rand.Text()
+	p.mu.Lock()
+	p.codes[code] = authorization{
+		challenge:   query.Get("code_challenge"),
+		nonce:       query.Get("nonce"),
+		redirectURI: redirectURI,
+	}
+	p.mu.Unlock()
+	target, err := url.Parse(redirectURI)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	back := target.Query()
+	back.Set("code", code)
+	back.Set("state", query.Get("state"))
+	target.RawQuery = back.Encode()
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+	if p.ClientSecret != "" {
+		id, secret, ok := r.BasicAuth()
+		id, _ = url.QueryUnescape(id)
+		secret, _ = url.QueryUnescape(secret)
+		if !ok || id != p.ClientID || secret != p.ClientSecret {
+			tokenError(w, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+			return
+		}
+	} else if r.PostForm.Get("client_id") != p.ClientID {
+		tokenError(w, http.StatusUnauthorized, "invalid_client", "unknown client")
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, http.StatusBadRequest, "unsupported_grant_type", "")
+		return
+	}
+	p.mu.Lock()
+	code := r.PostForm.Get("code")
+	auth, ok := p.codes[code]
+	// Codes are single use, whether or not the exchange succeeds.
+	delete(p.codes, code)
+	p.mu.Unlock()
+	if !ok {
+		tokenError(w, http.StatusBadRequest, "invalid_grant", "unknown or used code")
+		return
+	}
+	if r.PostForm.Get("redirect_uri") != auth.redirectURI {
+		tokenError(w, http.StatusBadRequest, "invalid_grant", "redirect_uri mismatch")
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != auth.challenge {
+		tokenError(w, http.StatusBadRequest, "invalid_grant", "PKCE verification failed")
+		return
+	}
+	idToken, err := Sign("RS256", RSAKeyID, p.RSAKey, p.IDToken(auth.nonce))
+	if err != nil {
+		tokenError(w, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+	accessToken := rand.Text()
+	p.mu.Lock()
+	p.tokens[accessToken], _ = p.claims["sub"].(string)
+	p.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func (p *Provider) jwks(w http.ResponseWriter, _ *http.Request) {
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	size := (p.ECKey.Curve.Params().BitSize + 7) / 8
+	x := make([]byte, size)
+	y := make([]byte, size)
+	p.ECKey.X.FillBytes(x)
+	p.ECKey.Y.FillBytes(y)
+	writeJSON(w, http.StatusOK, map[string]any{"keys": []map[string]any{
+		{
+			"kty": "RSA",
+			"kid": RSAKeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   encode(p.RSAKey.N.Bytes()),
+			"e":   encode(big.NewInt(int64(p.RSAKey.E)).Bytes()),
+		},
+		{
+			"kty": "EC",
+			"kid": ECKeyID,
+			"use": "sig",
+			"alg": "ES256",
+			"crv": "P-256",
+			"x":   encode(x),
+			"y":   encode(y),
+		},
+	}})
+}
+
+func (p *Provider) userInfo(w http.ResponseWriter, r *http.Request) {
+	const prefix = "Bearer "
+	header := r.Header.Get("Authorization")
+	if len(header) <= len(prefix) || header[:len(prefix)] != prefix {
+		http.Error(w, "bearer token required", http.StatusUnauthorized)
+		return
+	}
+	p.mu.Lock()
+	sub, ok := p.tokens[header[len(prefix):]]
+	claims := map[string]any{"sub": sub}
+	for key, value := range p.userinfo {
+		claims[key] = value
+	}
+	p.mu.Unlock()
+	if !ok {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+	writeJSON(w, http.StatusOK, claims)
+}
+
+func tokenError(w http.ResponseWriter, status int, code string, description string) {
+	writeJSON(w, status, map[string]string{"error": code, "error_description": description})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
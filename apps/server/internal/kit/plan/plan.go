@@ -0,0 +1,101 @@
+// Package plan describes the limits a tenant plan puts on resources and
+// usage.
+package plan
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Built-in plan codes.
+const (
+	Free       = "free"
+	Pro        = "pro"
+	Enterprise = "enterprise"
+)
+
+// Tenant statuses.
+const (
+	TenantActive    = "active"
+	TenantSuspended = "suspended"
+	TenantDeleting  = "deleting"
+)
+
+// Resources limited by a plan.
+const (
+	ResourceBots           = "bots"
+	ResourceKnowledgeBases = "knowledge_bases"
+	ResourceDocuments      = "documents"
+	ResourceStorageBytes   = "storage_bytes"
+	ResourceMonthlyQueries = "monthly_queries"
+	ResourceMonthlyTokens  = "monthly_tokens"
+)
+
+// Limits are the caps of a plan; 0 means unlimited.
+type Limits struct {
+	MaxBots           int64
+	MaxKnowledgeBases int64
+	MaxDocuments      int64
+	MaxStorageBytes   int64
+	MonthlyQueries    int64
+	MonthlyTokens     int64
+}
+
+// Subscription is the status and plan of a tenant.
+type Subscription struct {
+	TenantID string
+	Status   string
+	Plan     string
+	Limits   Limits
+}
+
+// Active reports whether the tenant may sign in and use its API keys.
+func (s Subscription) Active() bool {
+	return s.Status == TenantActive
+}
+
+// For returns the limit of a resource.
+func (l Limits) For(resource string) int64 {
+	switch resource {
+	case ResourceBots:
+		return l.MaxBots
+	case ResourceKnowledgeBases:
+		return l.MaxKnowledgeBases
+	case ResourceDocuments:
+		return l.MaxDocuments
+	case ResourceStorageBytes:
+		return l.MaxStorageBytes
+	case ResourceMonthlyQueries:
+		return l.MonthlyQueries
+	case ResourceMonthlyTokens:
+		return l.MonthlyTokens
+	default:
+		return 0
+	}
+}
+
+// Usage is the current use of a limited resource by a tenant.
+type Usage struct {
+	Resource string
+	Limit    int64
+	Used     int64
+}
+
+// Allows reports whether adding to the current use stays within the limit.
+func (u Usage) Allows(adding int64) bool {
+	return u.Limit <= 0 || u.Used+adding <= u.Limit
+}
+
+// LimitExceeded returns the error for adding to a resource beyond the limit
+// of usage.
+func LimitExceeded(usage Usage) error {
+	return errors.Forbidden("PLAN_LIMIT_EXCEEDED", "plan limit reached: "+usage.Resource).
+		WithMetadata(map[string]string{"resource": usage.Resource, "limit": strconv.FormatInt(usage.Limit, 10)})
+}
+
+// Month returns the quota period of t, e.g. "202610".
+func Month(t time.Time) string {
+	return t.UTC().Format("200601")
+}
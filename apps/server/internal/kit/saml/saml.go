@@ -0,0 +1,444 @@
+// Package saml implements the service provider side of SAML 2.0
+// SP-initiated login: HTTP-Redirect AuthnRequests and signed HTTP-POST
+// responses.
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	nsProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	nsAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	nsDSig      = "http://www.w3.org/2000/09/xmldsig#"
+	nsExcC14N   = "http://www.w3.org/2001/10/xml-exc-c14n#"
+
+	statusSuccess = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	bindingPOST   = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	bearerMethod  = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+
+	algEnveloped = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	algRSASHA256 = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algRSASHA512 = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	algSHA256    = "http://www.w3.org/2001/04/xmlenc#sha256"
+	algSHA512    = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+// clockSkew is the tolerance applied to assertion validity windows.
+const clockSkew = 3 * time.Minute
+
+var (
+	ErrInvalidResponse = errors.New("invalid saml response")
+	ErrSignature       = errors.New("invalid saml signature")
+	ErrExpired         = errors.New("saml assertion expired")
+)
+
+// ServiceProvider describes this side of the federation.
+type ServiceProvider struct {
+	EntityID string
+	ACSURL   string
+}
+
+// IdentityProvider describes the IdP a tenant trusts.
+type IdentityProvider struct {
+	EntityID    string
+	SSOURL      string
+	Certificate *x509.Certificate
+}
+
+// Assertion is the verified content of a SAML assertion.
+type Assertion struct {
+	Issuer       string
+	NameID       string
+	SessionIndex string
+	// Attributes are keyed by Name and, when present, FriendlyName.
+	Attributes map[string][]string
+}
+
+// ParseCertificate accepts a PEM certificate or the bare base64 DER form
+// found in IdP metadata.
+func ParseCertificate(raw string) (*x509.Certificate, error) {
+	raw = strings.TrimSpace(raw)
+	if block, _ := pem.Decode([]byte(raw)); block != nil {
+		return x509.ParseCertificate(block.Bytes)
+	}
+	der, err := base64.StdEncoding.DecodeString(stripSpace(raw))
+	if err != nil {
+		return nil, fmt.Errorf("certificate is neither PEM nor base64: %w", err)
+	}
+	return x509.ParseCertificate(der)
+}
+
+// AuthnRequestURL returns the IdP URL that starts a login with the
+// HTTP-Redirect binding. The request is not signed.
+func AuthnRequestURL(sp ServiceProvider, idp IdentityProvider, requestID string, relayState string, now time.Time) (string, error) {
+	var doc bytes.Buffer
+	doc.WriteString(`<samlp:AuthnRequest xmlns:samlp="` + nsProtocol + `" xmlns:saml="` + nsAssertion + `"`)
+	writeXMLAttr(&doc, "ID", requestID)
+	writeXMLAttr(&doc, "Version", "2.0")
+	writeXMLAttr(&doc, "IssueInstant", now.UTC().Format(time.RFC3339))
+	writeXMLAttr(&doc, "Destination", idp.SSOURL)
+	writeXMLAttr(&doc, "AssertionConsumerServiceURL", sp.ACSURL)
+	writeXMLAttr(&doc, "ProtocolBinding", bindingPOST)
+	doc.WriteString(`><saml:Issuer>`)
+	if err := xml.EscapeText(&doc, []byte(sp.EntityID)); err != nil {
+		return "", err
+	}
+	doc.WriteString(`</saml:Issuer><samlp:NameIDPolicy AllowCreate="true"/></samlp:AuthnRequest>`)
+
+	var deflated bytes.Buffer
+	w, err := flate.NewWriter(&deflated, flate.DefaultCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(doc.Bytes()); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	target, err := url.Parse(idp.SSOURL)
+	if err != nil {
+		return "", err
+	}
+	query := target.Query()
+	query.Set("SAMLRequest", base64.StdEncoding.EncodeToString(deflated.Bytes()))
+	if relayState != "" {
+		query.Set("RelayState", relayState)
+	}
+	target.RawQuery = query.Encode()
+	return target.String(), nil
+}
+
+// Metadata returns the SP metadata document to register with the IdP.
+func Metadata(sp ServiceProvider) []byte {
+	var doc bytes.Buffer
+	doc.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	doc.WriteString(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata"`)
+	writeXMLAttr(&doc, "entityID", sp.EntityID)
+	doc.WriteString(`><md:SPSSODescriptor AuthnRequestsSigned="false" WantAssertionsSigned="true" protocolSupportEnumeration="` + nsProtocol + `">`)
+	doc.WriteString(`<md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</md:NameIDFormat>`)
+	doc.WriteString(`<md:AssertionConsumerService Binding="` + bindingPOST + `"`)
+	writeXMLAttr(&doc, "Location", sp.ACSURL)
+	doc.WriteString(` index="0" isDefault="true"/></md:SPSSODescriptor></md:EntityDescriptor>`)
+	return doc.Bytes()
+}
+
+// ParseResponse decodes and verifies a base64 SAMLResponse posted to the
+// ACS. Either the response or its single assertion must carry a valid
+// enveloped signature of the IdP certificate; only the verified elements are
+// read.
+func ParseResponse(encoded string, sp ServiceProvider, idp IdentityProvider, requestID string, now time.Time) (Assertion, error) {
+	if idp.Certificate == nil {
+		return Assertion{}, fmt.Errorf("%w: idp certificate missing", ErrSignature)
+	}
+	raw, err := base64.StdEncoding.DecodeString(stripSpace(encoded))
+	if err != nil {
+		return Assertion{}, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	root, err := parseXML(raw)
+	if err != nil {
+		return Assertion{}, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	if !root.is(nsProtocol, "Response") {
+		return Assertion{}, fmt.Errorf("%w: not a samlp:Response", ErrInvalidResponse)
+	}
+	if err := checkUniqueIDs(root); err != nil {
+		return Assertion{}, err
+	}
+	if dest := root.attr("Destination"); dest != "" && dest != sp.ACSURL {
+		return Assertion{}, fmt.Errorf("%w: unexpected destination", ErrInvalidResponse)
+	}
+	if root.attr("InResponseTo") != requestID {
+		return Assertion{}, fmt.Errorf("%w: response does not answer the login request", ErrInvalidResponse)
+	}
+	status := root.child(nsProtocol, "Status").child(nsProtocol, "StatusCode")
+	if code := status.attr("Value"); code != statusSuccess {
+		return Assertion{}, fmt.Errorf("%w: idp status %s", ErrInvalidResponse, code)
+	}
+
+	responseSigned := len(root.childrenNamed(nsDSig, "Signature")) > 0
+	if responseSigned {
+		if err := verifySignature(root, idp.Certificate); err != nil {
+			return Assertion{}, err
+		}
+	}
+	if len(root.childrenNamed(nsAssertion, "EncryptedAssertion")) > 0 {
+		return Assertion{}, fmt.Errorf("%w: encrypted assertions not supported", ErrInvalidResponse)
+	}
+	assertions := root.childrenNamed(nsAssertion, "Assertion")
+	if len(assertions) != 1 {
+		return Assertion{}, fmt.Errorf("%w: expected one assertion", ErrInvalidResponse)
+	}
+	assertion := assertions[0]
+	if len(assertion.childrenNamed(nsDSig, "Signature")) > 0 {
+		if err := verifySignature(assertion, idp.Certificate); err != nil {
+			return Assertion{}, err
+		}
+	} else if !responseSigned {
+		return Assertion{}, fmt.Errorf("%w: response not signed", ErrSignature)
+	}
+
+	out := Assertion{
+		Issuer:     assertion.child(nsAssertion, "Issuer").text(),
+		Attributes: make(map[string][]string),
+	}
+	if idp.EntityID != "" && out.Issuer != idp.EntityID {
+		return Assertion{}, fmt.Errorf("%w: unexpected issuer %s", ErrInvalidResponse, out.Issuer)
+	}
+	if err := checkConditions(assertion.child(nsAssertion, "Conditions"), sp, now); err != nil {
+		return Assertion{}, err
+	}
+	subject := assertion.child(nsAssertion, "Subject")
+	out.NameID = subject.child(nsAssertion, "NameID").text()
+	if out.NameID == "" {
+		return Assertion{}, fmt.Errorf("%w: subject NameID missing", ErrInvalidResponse)
+	}
+	if err := checkSubjectConfirmation(subject, sp, requestID, now); err != nil {
+		return Assertion{}, err
+	}
+	if authn := assertion.child(nsAssertion, "AuthnStatement"); authn != nil {
+		out.SessionIndex = authn.attr("SessionIndex")
+	}
+	for _, statement := range assertion.childrenNamed(nsAssertion, "AttributeStatement") {
+		for _, attribute := range statement.childrenNamed(nsAssertion, "Attribute") {
+			values := make([]string, 0)
+			for _, value := range attribute.childrenNamed(nsAssertion, "AttributeValue") {
+				if text := value.text(); text != "" {
+					values = append(values, text)
+				}
+			}
+			for _, key := range []string{attribute.attr("Name"), attribute.attr("FriendlyName")} {
+				if key != "" {
+					out.Attributes[key] = append(out.Attributes[key], values...)
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+func checkConditions(conditions *node, sp ServiceProvider, now time.Time) error {
+	if conditions == nil {
+		return nil
+	}
+	if err := checkWindow(conditions.attr("NotBefore"), conditions.attr("NotOnOrAfter"), now); err != nil {
+		return err
+	}
+	for _, restriction := range conditions.childrenNamed(nsAssertion, "AudienceRestriction") {
+		matched := false
+		for _, audience := range restriction.childrenNamed(nsAssertion, "Audience") {
+			if audience.text() == sp.EntityID {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%w: audience mismatch", ErrInvalidResponse)
+		}
+	}
+	return nil
+}
+
+func checkSubjectConfirmation(subject *node, sp ServiceProvider, requestID string, now time.Time) error {
+	for _, confirmation := range subject.childrenNamed(nsAssertion, "SubjectConfirmation") {
+		if confirmation.attr("Method") != bearerMethod {
+			continue
+		}
+		data := confirmation.child(nsAssertion, "SubjectConfirmationData")
+		if data == nil {
+			continue
+		}
+		if recipient := data.attr("Recipient"); recipient != "" && recipient != sp.ACSURL {
+			continue
+		}
+		if inResponseTo := data.attr("InResponseTo"); inResponseTo != "" && inResponseTo != requestID {
+			continue
+		}
+		if data.attr("NotOnOrAfter") == "" {
+			continue
+		}
+		if err := checkWindow(data.attr("NotBefore"), data.attr("NotOnOrAfter"), now); err != nil {
+			return err
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: no valid bearer subject confirmation", ErrInvalidResponse)
+}
+
+func checkWindow(notBefore string, notOnOrAfter string, now time.Time) error {
+	if notBefore != "" {
+		start, err := time.Parse(time.RFC3339Nano, notBefore)
+		if err != nil {
+			return fmt.Errorf("%w: invalid NotBefore", ErrInvalidResponse)
+		}
+		if now.Add(clockSkew).Before(start) {
+			return fmt.Errorf("%w: assertion not yet valid", ErrInvalidResponse)
+		}
+	}
+	if notOnOrAfter != "" {
+		end, err := time.Parse(time.RFC3339Nano, notOnOrAfter)
+		if err != nil {
+			return fmt.Errorf("%w: invalid NotOnOrAfter", ErrInvalidResponse)
+		}
+		if !now.Add(-clockSkew).Before(end) {
+			return ErrExpired
+		}
+	}
+	return nil
+}
+
+// checkUniqueIDs rejects documents with repeated ID attributes, which
+// signature wrapping attacks rely on.
+func checkUniqueIDs(root *node) error {
+	seen := make(map[string]bool)
+	var dup string
+	root.walk(func(n *node) {
+		id := n.attr("ID")
+		if id == "" {
+			return
+		}
+		if seen[id] {
+			dup = id
+		}
+		seen[id] = true
+	})
+	if dup != "" {
+		return fmt.Errorf("%w: duplicate ID %s", ErrInvalidResponse, dup)
+	}
+	return nil
+}
+
+// verifySignature checks the enveloped signature of el. The signature must
+// reference el itself and is verified against the configured certificate
+// only; embedded KeyInfo is ignored.
+func verifySignature(el *node, cert *x509.Certificate) error {
+	signatures := el.childrenNamed(nsDSig, "Signature")
+	if len(signatures) != 1 {
+		return fmt.Errorf("%w: expected one signature", ErrSignature)
+	}
+	signature := signatures[0]
+	signedInfo := signature.child(nsDSig, "SignedInfo")
+	if signedInfo == nil {
+		return fmt.Errorf("%w: SignedInfo missing", ErrSignature)
+	}
+	canonMethod := signedInfo.child(nsDSig, "CanonicalizationMethod")
+	if canonMethod.attr("Algorithm") != nsExcC14N {
+		return fmt.Errorf("%w: unsupported canonicalization %s", ErrSignature, canonMethod.attr("Algorithm"))
+	}
+	var hash crypto.Hash
+	switch alg := signedInfo.child(nsDSig, "SignatureMethod").attr("Algorithm"); alg {
+	case algRSASHA256:
+		hash = crypto.SHA256
+	case algRSASHA512:
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("%w: unsupported signature method %s", ErrSignature, alg)
+	}
+	references := signedInfo.childrenNamed(nsDSig, "Reference")
+	if len(references) != 1 {
+		return fmt.Errorf("%w: expected one reference", ErrSignature)
+	}
+	reference := references[0]
+	if id := el.attr("ID"); id == "" || reference.attr("URI") != "#"+id {
+		return fmt.Errorf("%w: signature does not reference the signed element", ErrSignature)
+	}
+	var (
+		inclusive []string
+		excC14N   bool
+	)
+	if transforms := reference.child(nsDSig, "Transforms"); transforms != nil {
+		for _, transform := range transforms.childrenNamed(nsDSig, "Transform") {
+			switch alg := transform.attr("Algorithm"); alg {
+			case algEnveloped:
+			case nsExcC14N:
+				excC14N = true
+				inclusive = inclusivePrefixes(transform)
+			default:
+				return fmt.Errorf("%w: unsupported transform %s", ErrSignature, alg)
+			}
+		}
+	}
+	if !excC14N {
+		return fmt.Errorf("%w: exclusive canonicalization required", ErrSignature)
+	}
+	var digestHash crypto.Hash
+	switch alg := reference.child(nsDSig, "DigestMethod").attr("Algorithm"); alg {
+	case algSHA256:
+		digestHash = crypto.SHA256
+	case algSHA512:
+		digestHash = crypto.SHA512
+	default:
+		return fmt.Errorf("%w: unsupported digest method %s", ErrSignature, alg)
+	}
+	expectedDigest, err := base64.StdEncoding.DecodeString(stripSpace(reference.child(nsDSig, "DigestValue").text()))
+	if err != nil {
+		return fmt.Errorf("%w: invalid digest value", ErrSignature)
+	}
+	canonical, err := canonicalize(el, signature, inclusive)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSignature, err)
+	}
+	digest := digestHash.New()
+	digest.Write(canonical)
+	if !bytes.Equal(digest.Sum(nil), expectedDigest) {
+		return fmt.Errorf("%w: digest mismatch", ErrSignature)
+	}
+
+	signatureValue, err := base64.StdEncoding.DecodeString(stripSpace(signature.child(nsDSig, "SignatureValue").text()))
+	if err != nil {
+		return fmt.Errorf("%w: invalid signature value", ErrSignature)
+	}
+	canonicalSignedInfo, err := canonicalize(signedInfo, nil, inclusivePrefixes(canonMethod))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSignature, err)
+	}
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("%w: idp certificate is not RSA", ErrSignature)
+	}
+	signed := hash.New()
+	signed.Write(canonicalSignedInfo)
+	if err := rsa.VerifyPKCS1v15(publicKey, hash, signed.Sum(nil), signatureValue); err != nil {
+		return fmt.Errorf("%w: %v", ErrSignature, err)
+	}
+	return nil
+}
+
+func inclusivePrefixes(method *node) []string {
+	list := method.child(nsExcC14N, "InclusiveNamespaces")
+	if list == nil {
+		return nil
+	}
+	return strings.Fields(list.attr("PrefixList"))
+}
+
+func writeXMLAttr(buf *bytes.Buffer, name string, value string) {
+	buf.WriteString(" " + name + `="`)
+	buf.WriteString(escapeAttr(value))
+	buf.WriteByte('"')
+}
+
+func stripSpace(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\n', '\r':
+			return -1
+		}
+		return r
+	}, value)
+}
@@ -0,0 +1,676 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testIdPEntityID = "https://idp.example.com/metadata"
+	testRequestID   = "_req-0b6a"
+
+	nsXS  = "http://www.w3.org/2001/XMLSchema"
+	nsXSI = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+var (
+	testNow = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	testSP  = ServiceProvider{
+		EntityID: "https://desk.example.com/api/v1/sso/saml/metadata",
+		ACSURL:   "https://desk.example.com/api/v1/sso/saml/acs",
+	}
+)
+
+// stubIdP issues signed responses the way an IdP does: the signed element is
+// serialized in its exclusive canonical form by hand, so the digest and the
+// signature do not depend on the canonicalization under test.
+type stubIdP struct {
+	key  *rsa.PrivateKey
+	cert *x509.Certificate
+}
+
+var (
+	stubOnce sync.Once
+	stubIdPs [2]*stubIdP
+	stubErr  error
+)
+
+// testIdPs returns the trusted IdP and an unrelated one.
+func testIdPs(t *testing.T) (*stubIdP, *stubIdP) {
+	t.Helper()
+	stubOnce.Do(func() {
+		for i := range stubIdPs {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			if err != nil {
+				stubErr = err
+				return
+			}
+			template := &x509.Certificate{
+				SerialNumber: big.NewInt(int64(i + 1)),
+				Subject:      pkix.Name{CommonName: "stub idp"},
+				NotBefore:    testNow.Add(-time.Hour),
+				NotAfter:     testNow.Add(24 * time.Hour),
+			}
+			der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+			if err != nil {
+				stubErr = err
+				return
+			}
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				stubErr = err
+				return
+			}
+			stubIdPs[i] = &stubIdP{key: key, cert: cert}
+		}
+	})
+	if stubErr != nil {
+		t.Fatalf("stub idp: %v", stubErr)
+	}
+	return stubIdPs[0], stubIdPs[1]
+}
+
+func (idp *stubIdP) provider() IdentityProvider {
+	return IdentityProvider{EntityID: testIdPEntityID, SSOURL: "https://idp.example.com/sso", Certificate: idp.cert}
+}
+
+type assertionSpec struct {
+	ID           string
+	Issuer       string
+	NameID       string
+	Audience     string
+	Recipient    string
+	InResponseTo string
+	NotBefore    time.Time
+	NotOnOrAfter time.Time
+	ConfirmUntil time.Time
+	// InclusiveXS signs with an InclusiveNamespaces PrefixList of "xs".
+	InclusiveXS bool
+}
+
+type responseSpec struct {
+	ID            string
+	Destination   string
+	InResponseTo  string
+	Status        string
+	Assertion     assertionSpec
+	SignAssertion bool
+	SignResponse  bool
+	// Signer signs instead of the IdP when set.
+	Signer *stubIdP
+}
+
+func validResponse() responseSpec {
+	return responseSpec{
+		ID:           "_resp-1",
+		Destination:  testSP.ACSURL,
+		InResponseTo: testRequestID,
+		Status:       statusSuccess,
+		Assertion: assertionSpec{
+			ID:           "_assert-1",
+			Issuer:       testIdPEntityID,
+			NameID:       "alice@example.com",
+			Audience:     testSP.EntityID,
+			Recipient:    testSP.ACSURL,
+			InResponseTo: testRequestID,
+			NotBefore:    testNow.Add(-time.Minute),
+			NotOnOrAfter: testNow.Add(5 * time.Minute),
+			ConfirmUntil: testNow.Add(5 * time.Minute),
+		},
+		SignAssertion: true,
+	}
+}
+
+func stamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// el writes an element; documents use empty-element tags, canonical forms
+// never do.
+func el(canonical bool, name string, attrs string, content string) string {
+	if content == "" && !canonical {
+		return "<" + name + attrs + "/>"
+	}
+	return "<" + name + attrs + ">" + content + "</" + name + ">"
+}
+
+// assertionXML serializes the assertion with signature inserted after its
+// issuer. The canonical form declares its namespaces itself; the document form
+// inherits them from the response.
+func assertionXML(a assertionSpec, canonical bool, signature string) string {
+	var b strings.Builder
+	if canonical {
+		b.WriteString(`<saml:Assertion xmlns:saml="` + nsAssertion + `"`)
+		if a.InclusiveXS {
+			b.WriteString(` xmlns:xs="` + nsXS + `"`)
+		}
+		b.WriteString(` ID="` + a.ID + `" IssueInstant="` + stamp(testNow) + `" Version="2.0">`)
+	} else {
+		b.WriteString(`<saml:Assertion Version="2.0" IssueInstant='` + stamp(testNow) + `' ID="` + a.ID + `">`)
+	}
+	b.WriteString(el(canonical, "saml:Issuer", "", a.Issuer))
+	b.WriteString(signature)
+	if !canonical {
+		b.WriteString("<!-- issued by the stub idp -->")
+	}
+
+	confirmation := ` Recipient="` + a.Recipient + `" NotOnOrAfter="` + stamp(a.ConfirmUntil) + `" InResponseTo="` + a.InResponseTo + `"`
+	if canonical {
+		confirmation = ` InResponseTo="` + a.InResponseTo + `" NotOnOrAfter="` + stamp(a.ConfirmUntil) + `" Recipient="` + a.Recipient + `"`
+	}
+	b.WriteString(el(canonical, "saml:Subject", "",
+		el(canonical, "saml:NameID", ` Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"`, a.NameID)+
+			el(canonical, "saml:SubjectConfirmation", ` Method="`+bearerMethod+`"`,
+				el(canonical, "saml:SubjectConfirmationData", confirmation, ""))))
+	b.WriteString(el(canonical, "saml:Conditions", ` NotBefore="`+stamp(a.NotBefore)+`" NotOnOrAfter="`+stamp(a.NotOnOrAfter)+`"`,
+		el(canonical, "saml:AudienceRestriction", "", el(canonical, "saml:Audience", "", a.Audience))))
+	b.WriteString(el(canonical, "saml:AuthnStatement", ` AuthnInstant="`+stamp(testNow)+`" SessionIndex="_session-1"`,
+		el(canonical, "saml:AuthnContext", "",
+			el(canonical, "saml:AuthnContextClassRef", "", "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"))))
+
+	value := func(v string) string {
+		if canonical {
+			return el(true, "saml:AttributeValue", ` xmlns:xsi="`+nsXSI+`" xsi:type="xs:string"`, strings.ReplaceAll(v, "&", "&amp;"))
+		}
+		return el(false, "saml:AttributeValue", ` xsi:type="xs:string"`, strings.ReplaceAll(v, "&", "&#x26;"))
+	}
+	b.WriteString(el(canonical, "saml:AttributeStatement", "",
+		el(canonical, "saml:Attribute", ` FriendlyName="mail" Name="email"`, value(a.NameID))+
+			el(canonical, "saml:Attribute", ` Name="groups"`, value("support")+value("R&D"))))
+	b.WriteString("</saml:Assertion>")
+	return b.String()
+}
+
+// responseXML serializes the response with signature inserted after its
+// issuer and assertion as its only assertion.
+func responseXML(r responseSpec, canonical bool, signature string, assertion string) string {
+	var b strings.Builder
+	if canonical {
+		b.WriteString(`<samlp:Response xmlns:samlp="` + nsProtocol + `" Destination="` + r.Destination + `" ID="` + r.ID +
+			`" InResponseTo="` + r.InResponseTo + `" IssueInstant="` + stamp(testNow) + `" Version="2.0">`)
+		b.WriteString(el(true, "saml:Issuer", ` xmlns:saml="`+nsAssertion+`"`, testIdPEntityID))
+	} else {
+		b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+		b.WriteString(`<samlp:Response xmlns:samlp="` + nsProtocol + `" xmlns:saml="` + nsAssertion + `" xmlns:xs="` + nsXS + `" xmlns:xsi="` + nsXSI +
+			`" ID="` + r.ID + `" Version="2.0" IssueInstant="` + stamp(testNow) + `" Destination="` + r.Destination + `" InResponseTo="` + r.InResponseTo + `">`)
+		b.WriteString(el(false, "saml:Issuer", "", testIdPEntityID))
+	}
+	b.WriteString(signature)
+	b.WriteString(el(canonical, "samlp:Status", "", el(canonical, "samlp:StatusCode", ` Value="`+r.Status+`"`, "")))
+	b.WriteString(assertion)
+	b.WriteString("</samlp:Response>")
+	return b.String()
+}
+
+// signatureXML signs the canonical form of the element id. With canonical set
+// it returns the signature as it appears inside the canonical form of an
+// enclosing signed element.
+func (idp *stubIdP) signatureXML(t *testing.T, id string, signed string, inclusiveXS bool, canonical bool) (string, string) {
+	t.Helper()
+	digest := sha256.Sum256([]byte(signed))
+	signedInfo := func(canonical bool, declare bool) string {
+		attrs := ""
+		if declare {
+			attrs = ` xmlns:ds="` + nsDSig + `"`
+		}
+		exc := ""
+		if inclusiveXS {
+			exc = el(canonical, "ec:InclusiveNamespaces", ` xmlns:ec="`+nsExcC14N+`" PrefixList="xs"`, "")
+		}
+		return el(canonical, "ds:SignedInfo", attrs,
+			el(canonical, "ds:CanonicalizationMethod", ` Algorithm="`+nsExcC14N+`"`, "")+
+				el(canonical, "ds:SignatureMethod", ` Algorithm="`+algRSASHA256+`"`, "")+
+				el(canonical, "ds:Reference", ` URI="#`+id+`"`,
+					el(canonical, "ds:Transforms", "",
+						el(canonical, "ds:Transform", ` Algorithm="`+algEnveloped+`"`, "")+
+							el(canonical, "ds:Transform", ` Algorithm="`+nsExcC14N+`"`, exc))+
+						el(canonical, "ds:DigestMethod", ` Algorithm="`+algSHA256+`"`, "")+
+						el(canonical, "ds:DigestValue", "", base64.StdEncoding.EncodeToString(digest[:]))))
+	}
+	hashed := sha256.Sum256([]byte(signedInfo(true, true)))
+	value, err := rsa.SignPKCS1v15(rand.Reader, idp.key, crypto.SHA256, hashed[:])
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	signature := func(canonical bool) string {
+		return el(canonical, "ds:Signature", ` xmlns:ds="`+nsDSig+`"`,
+			signedInfo(canonical, false)+
+				el(canonical, "ds:SignatureValue", "", base64.StdEncoding.EncodeToString(value))+
+				el(canonical, "ds:KeyInfo", "", el(canonical, "ds:X509Data", "",
+					el(canonical, "ds:X509Certificate", "", base64.StdEncoding.EncodeToString(idp.cert.Raw)))))
+	}
+	return signature(false), signature(true)
+}
+
+// response returns the XML document of r.
+func (idp *stubIdP) response(t *testing.T, r responseSpec) string {
+	t.Helper()
+	signer := idp
+	if r.Signer != nil {
+		signer = r.Signer
+	}
+	assertionDoc := assertionXML(r.Assertion, false, "")
+	assertionCanonical := assertionXML(r.Assertion, true, "")
+	if r.SignAssertion {
+		doc, canonical := signer.signatureXML(t, r.Assertion.ID, assertionCanonical, r.Assertion.InclusiveXS, false)
+		assertionDoc = assertionXML(r.Assertion, false, doc)
+		assertionCanonical = assertionXML(r.Assertion, true, canonical)
+	}
+	signature := ""
+	if r.SignResponse {
+		signature, _ = signer.signatureXML(t, r.ID, responseXML(r, true, "", assertionCanonical), false, false)
+	}
+	return responseXML(r, false, signature, assertionDoc)
+}
+
+func encodeResponse(doc string) string {
+	return base64.StdEncoding.EncodeToString([]byte(doc))
+}
+
+func TestParseResponse(t *testing.T) {
+	idp, other := testIdPs(t)
+	want := Assertion{
+		Issuer:       testIdPEntityID,
+		NameID:       "alice@example.com",
+		SessionIndex: "_session-1",
+		Attributes: map[string][]string{
+			"email":  {"alice@example.com"},
+			"mail":   {"alice@example.com"},
+			"groups": {"support", "R&D"},
+		},
+	}
+	// evilAssertion is an unsigned assertion for mallory.
+	evilAssertion := func(id string, signature string) string {
+		a := validResponse().Assertion
+		a.ID = id
+		a.NameID = "mallory@example.com"
+		return assertionXML(a, false, signature)
+	}
+	signedAssertion := func(doc string) string {
+		start := strings.Index(doc, "<saml:Assertion ")
+		end := strings.LastIndex(doc, "</saml:Assertion>") + len("</saml:Assertion>")
+		return doc[start:end]
+	}
+
+	tests := []struct {
+		name   string
+		spec   func(*responseSpec)
+		tamper func(doc string) string
+		// trust overrides the certificate of the configured IdP.
+		trust    *stubIdP
+		want     *Assertion
+		wantErr  error
+		wantText string
+	}{
+		{
+			name: "signed assertion",
+			want: &want,
+		},
+		{
+			name: "signed response",
+			spec: func(r *responseSpec) { r.SignAssertion, r.SignResponse = false, true },
+			want: &want,
+		},
+		{
+			name: "signed response and assertion",
+			spec: func(r *responseSpec) { r.SignResponse = true },
+			want: &want,
+		},
+		{
+			name: "inclusive namespaces",
+			spec: func(r *responseSpec) { r.Assertion.InclusiveXS = true },
+			want: &want,
+		},
+		{
+			name: "within clock skew",
+			spec: func(r *responseSpec) {
+				r.Assertion.NotBefore = testNow.Add(2 * time.Minute)
+				r.Assertion.NotOnOrAfter = testNow.Add(-2 * time.Minute)
+				r.Assertion.ConfirmUntil = testNow.Add(-2 * time.Minute)
+			},
+			want: &want,
+		},
+		{
+			name: "comment inside name id",
+			spec: func(r *responseSpec) { r.Assertion.NameID = "alice@example.com.evil.test" },
+			tamper: func(doc string) string {
+				return strings.Replace(doc, ">alice@example.com.evil.test</saml:NameID>", ">alice@example.com<!---->.evil.test</saml:NameID>", 1)
+			},
+			want: &Assertion{
+				Issuer:       testIdPEntityID,
+				NameID:       "alice@example.com.evil.test",
+				SessionIndex: "_session-1",
+				Attributes: map[string][]string{
+					"email":  {"alice@example.com.evil.test"},
+					"mail":   {"alice@example.com.evil.test"},
+					"groups": {"support", "R&D"},
+				},
+			},
+		},
+		{
+			name:     "unsigned",
+			spec:     func(r *responseSpec) { r.SignAssertion = false },
+			wantErr:  ErrSignature,
+			wantText: "response not signed",
+		},
+		{
+			name:     "signed by another key",
+			spec:     func(r *responseSpec) { r.Signer = other },
+			wantErr:  ErrSignature,
+			wantText: "verification error",
+		},
+		{
+			name:     "response signed by another key",
+			spec:     func(r *responseSpec) { r.SignAssertion, r.SignResponse, r.Signer = false, true, other },
+			wantErr:  ErrSignature,
+			wantText: "verification error",
+		},
+		{
+			name:     "wrong trusted certificate",
+			trust:    other,
+			wantErr:  ErrSignature,
+			wantText: "verification error",
+		},
+		{
+			name: "tampered name id",
+			tamper: func(doc string) string {
+				return strings.Replace(doc, ">alice@example.com</saml:NameID>", ">mallory@example.com</saml:NameID>", 1)
+			},
+			wantErr:  ErrSignature,
+			wantText: "digest mismatch",
+		},
+		{
+			name: "assertion replaced under a response signature",
+			spec: func(r *responseSpec) { r.SignAssertion, r.SignResponse = false, true },
+			tamper: func(doc string) string {
+				return strings.Replace(doc, signedAssertion(doc), evilAssertion("_assert-1", ""), 1)
+			},
+			wantErr:  ErrSignature,
+			wantText: "digest mismatch",
+		},
+		{
+			name: "duplicate id",
+			tamper: func(doc string) string {
+				return strings.Replace(doc, "<saml:Assertion ", evilAssertion("_assert-1", "")+"<saml:Assertion ", 1)
+			},
+			wantErr:  ErrInvalidResponse,
+			wantText: "duplicate ID _assert-1",
+		},
+		{
+			name: "signed assertion wrapped in extensions",
+			tamper: func(doc string) string {
+				signed := signedAssertion(doc)
+				wrapped := "<samlp:Extensions>" + signed + "</samlp:Extensions>" + evilAssertion("_evil", "")
+				return strings.Replace(doc, signed, wrapped, 1)
+			},
+			wantErr:  ErrSignature,
+			wantText: "response not signed",
+		},
+		{
+			name: "signature copied into another assertion",
+			tamper: func(doc string) string {
+				signed := signedAssertion(doc)
+				start := strings.Index(signed, "<ds:Signature ")
+				end := strings.Index(signed, "</ds:Signature>") + len("</ds:Signature>")
+				wrapped := "<samlp:Extensions>" + signed + "</samlp:Extensions>" + evilAssertion("_evil", signed[start:end])
+				return strings.Replace(doc, signed, wrapped, 1)
+			},
+			wantErr:  ErrSignature,
+			wantText: "does not reference the signed element",
+		},
+		{
+			name: "second assertion",
+			tamper: func(doc string) string {
+				return strings.Replace(doc, "</samlp:Response>", evilAssertion("_evil", "")+"</samlp:Response>", 1)
+			},
+			wantErr:  ErrInvalidResponse,
+			wantText: "expected one assertion",
+		},
+		{
+			name: "encrypted assertion",
+			tamper: func(doc string) string {
+				return strings.Replace(doc, "</samlp:Response>", "<saml:EncryptedAssertion></saml:EncryptedAssertion></samlp:Response>", 1)
+			},
+			wantErr:  ErrInvalidResponse,
+			wantText: "encrypted assertions not supported",
+		},
+		{
+			name: "doctype",
+			tamper: func(doc string) string {
+				return strings.Replace(doc, "\n", "\n"+`<!DOCTYPE r [<!ENTITY x SYSTEM "file:///etc/passwd">]>`, 1)
+			},
+			wantErr:  ErrInvalidResponse,
+			wantText: "DTDs not supported",
+		},
+		{
+			name: "expired",
+			spec: func(r *responseSpec) {
+				r.Assertion.NotOnOrAfter = testNow.Add(-5 * time.Minute)
+				r.Assertion.ConfirmUntil = testNow.Add(-5 * time.Minute)
+			},
+			wantErr: ErrExpired,
+		},
+		{
+			name:    "subject confirmation expired",
+			spec:    func(r *responseSpec) { r.Assertion.ConfirmUntil = testNow.Add(-5 * time.Minute) },
+			wantErr: ErrExpired,
+		},
+		{
+			name:     "not yet valid",
+			spec:     func(r *responseSpec) { r.Assertion.NotBefore = testNow.Add(10 * time.Minute) },
+			wantErr:  ErrInvalidResponse,
+			wantText: "not yet valid",
+		},
+		{
+			name:     "wrong audience",
+			spec:     func(r *responseSpec) { r.Assertion.Audience = "https://other-sp.example.com" },
+			wantErr:  ErrInvalidResponse,
+			wantText: "audience mismatch",
+		},
+		{
+			name:     "response answers another request",
+			spec:     func(r *responseSpec) { r.InResponseTo = "_req-other" },
+			wantErr:  ErrInvalidResponse,
+			wantText: "does not answer the login request",
+		},
+		{
+			name:     "unsolicited response",
+			spec:     func(r *responseSpec) { r.InResponseTo = "" },
+			wantErr:  ErrInvalidResponse,
+			wantText: "does not answer the login request",
+		},
+		{
+			name:     "subject confirmation answers another request",
+			spec:     func(r *responseSpec) { r.Assertion.InResponseTo = "_req-other" },
+			wantErr:  ErrInvalidResponse,
+			wantText: "no valid bearer subject confirmation",
+		},
+		{
+			name:     "wrong recipient",
+			spec:     func(r *responseSpec) { r.Assertion.Recipient = "https://other-sp.example.com/acs" },
+			wantErr:  ErrInvalidResponse,
+			wantText: "no valid bearer subject confirmation",
+		},
+		{
+			name:     "wrong destination",
+			spec:     func(r *responseSpec) { r.Destination = "https://other-sp.example.com/acs" },
+			wantErr:  ErrInvalidResponse,
+			wantText: "unexpected destination",
+		},
+		{
+			name:     "wrong issuer",
+			spec:     func(r *responseSpec) { r.Assertion.Issuer = "https://other-idp.example.com" },
+			wantErr:  ErrInvalidResponse,
+			wantText: "unexpected issuer",
+		},
+		{
+			name:     "idp error status",
+			spec:     func(r *responseSpec) { r.Status = "urn:oasis:names:tc:SAML:2.0:status:Responder" },
+			wantErr:  ErrInvalidResponse,
+			wantText: "idp status",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := validResponse()
+			if tt.spec != nil {
+				tt.spec(&spec)
+			}
+			doc := idp.response(t, spec)
+			if tt.tamper != nil {
+				tampered := tt.tamper(doc)
+				if tampered == doc {
+					t.Fatalf("tamper left the response unchanged")
+				}
+				doc = tampered
+			}
+			trusted := idp.provider()
+			if tt.trust != nil {
+				trusted.Certificate = tt.trust.cert
+			}
+			got, err := ParseResponse(encodeResponse(doc), testSP, trusted, testRequestID, testNow)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				if !strings.Contains(err.Error(), tt.wantText) {
+					t.Fatalf("err = %v, want it to contain %q", err, tt.wantText)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse: %v\n%s", err, doc)
+			}
+			if !reflect.DeepEqual(got, *tt.want) {
+				t.Fatalf("assertion = %+v, want %+v", got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestParseResponseRequiresCertificate(t *testing.T) {
+	idp, _ := testIdPs(t)
+	trusted := idp.provider()
+	trusted.Certificate = nil
+	_, err := ParseResponse(encodeResponse(idp.response(t, validResponse())), testSP, trusted, testRequestID, testNow)
+	if !errors.Is(err, ErrSignature) {
+		t.Fatalf("err = %v, want %v", err, ErrSignature)
+	}
+}
+
+// TestLoginWithStubIdP runs an SP-initiated login against an in-process IdP
+// that reads the redirect-bound AuthnRequest and answers it.
+func TestLoginWithStubIdP(t *testing.T) {
+	idp, _ := testIdPs(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, err := base64.StdEncoding.DecodeString(r.URL.Query().Get("SAMLRequest"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		inflated, err := io.ReadAll(flate.NewReader(bytes.NewReader(raw)))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var request struct {
+			ID     string `xml:"ID,attr"`
+			ACSURL string `xml:"AssertionConsumerServiceURL,attr"`
+			Issuer string `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+		}
+		if err := xml.Unmarshal(inflated, &request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if request.Issuer != testSP.EntityID || request.ACSURL != testSP.ACSURL {
+			http.Error(w, "unknown service provider", http.StatusForbidden)
+			return
+		}
+		spec := validResponse()
+		spec.InResponseTo = request.ID
+		spec.Assertion.InResponseTo = request.ID
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"SAMLResponse": encodeResponse(idp.response(t, spec)),
+			"RelayState":   r.URL.Query().Get("RelayState"),
+		})
+	}))
+	defer server.Close()
+
+	trusted := idp.provider()
+	trusted.SSOURL = server.URL + "/sso?tenant=acme"
+	requestID := "_req-" + strings.Repeat("7", 32)
+	target, err := AuthnRequestURL(testSP, trusted, requestID, "state-1", testNow)
+	if err != nil {
+		t.Fatalf("authn request: %v", err)
+	}
+	if parsed, _ := url.Parse(target); parsed.Query().Get("tenant") != "acme" {
+		t.Fatalf("authn request dropped the IdP query: %s", target)
+	}
+	resp, err := http.Get(target)
+	if err != nil {
+		t.Fatalf("idp: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		t.Fatalf("idp status %d: %s", resp.StatusCode, body)
+	}
+	var posted map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&posted); err != nil {
+		t.Fatalf("decode idp response: %v", err)
+	}
+	if posted["RelayState"] != "state-1" {
+		t.Fatalf("relay state = %q", posted["RelayState"])
+	}
+	got, err := ParseResponse(posted["SAMLResponse"], testSP, trusted, requestID, testNow)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got.NameID != "alice@example.com" {
+		t.Fatalf("name id = %q", got.NameID)
+	}
+	if _, err := ParseResponse(posted["SAMLResponse"], testSP, trusted, "_req-other", testNow); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("response accepted for another request: %v", err)
+	}
+}
+
+func TestParseCertificate(t *testing.T) {
+	idp, _ := testIdPs(t)
+	pemCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: idp.cert.Raw}))
+	bare := base64.StdEncoding.EncodeToString(idp.cert.Raw)
+	// Metadata wraps the base64 DER form across lines.
+	wrapped := bare[:40] + "\n  " + bare[40:]
+	for _, raw := range []string{pemCert, bare, wrapped} {
+		cert, err := ParseCertificate(raw)
+		if err != nil {
+			t.Fatalf("parse %q: %v", raw[:20], err)
+		}
+		if !cert.Equal(idp.cert) {
+			t.Fatalf("parsed another certificate")
+		}
+	}
+	if _, err := ParseCertificate("not a certificate"); err == nil {
+		t.Fatalf("want error for garbage")
+	}
+}
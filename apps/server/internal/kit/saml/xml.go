@@ -0,0 +1,333 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const nsXML = "http://www.w3.org/XML/1998/namespace"
+
+// node is an XML element parsed without namespace resolution, so prefixes
+// and declarations survive for canonicalization.
+type node struct {
+	prefix   string
+	local    string
+	attrs    []xml.Attr
+	ns       map[string]string
+	children []any
+	parent   *node
+}
+
+// parseXML builds the element tree of a document. DTDs and processing
+// instructions inside the document are rejected.
+func parseXML(data []byte) (*node, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.Strict = true
+	var root, cur *node
+	for {
+		tok, err := dec.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &node{prefix: t.Name.Space, local: t.Name.Local, parent: cur}
+			for _, attr := range t.Attr {
+				switch {
+				case attr.Name.Space == "xmlns":
+					n.declare(attr.Name.Local, attr.Value)
+				case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+					n.declare("", attr.Value)
+				default:
+					n.attrs = append(n.attrs, attr)
+				}
+			}
+			if cur == nil {
+				if root != nil {
+					return nil, fmt.Errorf("multiple root elements")
+				}
+				root = n
+			} else {
+				cur.children = append(cur.children, n)
+			}
+			cur = n
+		case xml.EndElement:
+			if cur == nil || t.Name.Space != cur.prefix || t.Name.Local != cur.local {
+				return nil, fmt.Errorf("unexpected end element %s", t.Name.Local)
+			}
+			cur = cur.parent
+		case xml.CharData:
+			if cur == nil {
+				continue
+			}
+			if last := len(cur.children) - 1; last >= 0 {
+				if text, ok := cur.children[last].(string); ok {
+					cur.children[last] = text + string(t)
+					continue
+				}
+			}
+			cur.children = append(cur.children, string(t))
+		case xml.Comment:
+			// Dropped, as by canonicalization without comments.
+		case xml.ProcInst:
+			if cur != nil {
+				return nil, fmt.Errorf("processing instructions not supported")
+			}
+		case xml.Directive:
+			return nil, fmt.Errorf("DTDs not supported")
+		}
+	}
+	if root == nil || cur != nil {
+		return nil, fmt.Errorf("incomplete document")
+	}
+	return root, nil
+}
+
+func (n *node) declare(prefix string, uri string) {
+	if n.ns == nil {
+		n.ns = make(map[string]string)
+	}
+	n.ns[prefix] = uri
+}
+
+// lookupNS resolves a prefix in the scope of n.
+func (n *node) lookupNS(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return nsXML, true
+	}
+	for e := n; e != nil; e = e.parent {
+		if uri, ok := e.ns[prefix]; ok {
+			return uri, true
+		}
+	}
+	if prefix == "" {
+		return "", true
+	}
+	return "", false
+}
+
+func (n *node) space() string {
+	uri, _ := n.lookupNS(n.prefix)
+	return uri
+}
+
+func (n *node) is(space string, local string) bool {
+	return n.local == local && n.space() == space
+}
+
+func (n *node) elements() []*node {
+	if n == nil {
+		return nil
+	}
+	out := make([]*node, 0, len(n.children))
+	for _, child := range n.children {
+		if el, ok := child.(*node); ok {
+			out = append(out, el)
+		}
+	}
+	return out
+}
+
+func (n *node) childrenNamed(space string, local string) []*node {
+	out := make([]*node, 0)
+	for _, el := range n.elements() {
+		if el.is(space, local) {
+			out = append(out, el)
+		}
+	}
+	return out
+}
+
+func (n *node) child(space string, local string) *node {
+	if n == nil {
+		return nil
+	}
+	for _, el := range n.elements() {
+		if el.is(space, local) {
+			return el
+		}
+	}
+	return nil
+}
+
+// attr returns an unqualified attribute.
+func (n *node) attr(local string) string {
+	if n == nil {
+		return ""
+	}
+	for _, attr := range n.attrs {
+		if attr.Name.Space == "" && attr.Name.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func (n *node) text() string {
+	if n == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, child := range n.children {
+		if text, ok := child.(string); ok {
+			b.WriteString(text)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// walk visits n and all its descendant elements.
+func (n *node) walk(fn func(*node)) {
+	fn(n)
+	for _, el := range n.elements() {
+		el.walk(fn)
+	}
+}
+
+// canonicalize returns the exclusive XML canonicalization (without
+// comments) of n, leaving out the subtree skip. inclusive lists the
+// InclusiveNamespaces prefixes of the transform ("#default" for the default
+// namespace).
+func canonicalize(n *node, skip *node, inclusive []string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeCanonical(&buf, n, skip, map[string]string{}, inclusive); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeCanonical(buf *bytes.Buffer, n *node, skip *node, rendered map[string]string, inclusive []string) error {
+	used := []string{n.prefix}
+	for _, attr := range n.attrs {
+		if attr.Name.Space != "" {
+			used = append(used, attr.Name.Space)
+		}
+	}
+	for _, prefix := range inclusive {
+		if prefix == "#default" {
+			prefix = ""
+		}
+		if _, ok := n.lookupNS(prefix); ok {
+			used = append(used, prefix)
+		}
+	}
+
+	type nsDecl struct{ prefix, uri string }
+	decls := make([]nsDecl, 0)
+	seen := make(map[string]bool)
+	for _, prefix := range used {
+		if seen[prefix] || prefix == "xml" {
+			continue
+		}
+		seen[prefix] = true
+		uri, ok := n.lookupNS(prefix)
+		if !ok {
+			return fmt.Errorf("undeclared namespace prefix %q", prefix)
+		}
+		prev, had := rendered[prefix]
+		if had && prev == uri {
+			continue
+		}
+		// An empty default namespace only needs undeclaring when a non-empty
+		// one is in effect in the output.
+		if prefix == "" && uri == "" && prev == "" {
+			continue
+		}
+		decls = append(decls, nsDecl{prefix: prefix, uri: uri})
+	}
+	if len(decls) > 0 {
+		next := make(map[string]string, len(rendered)+len(decls))
+		for prefix, uri := range rendered {
+			next[prefix] = uri
+		}
+		for _, decl := range decls {
+			next[decl.prefix] = decl.uri
+		}
+		rendered = next
+	}
+	sort.Slice(decls, func(i, j int) bool { return decls[i].prefix < decls[j].prefix })
+
+	type canonAttr struct{ space, name, value string }
+	attrs := make([]canonAttr, 0, len(n.attrs))
+	for _, attr := range n.attrs {
+		space, _ := n.lookupNS(attr.Name.Space)
+		if attr.Name.Space == "" {
+			space = ""
+		}
+		attrs = append(attrs, canonAttr{space: space, name: qualifiedName(attr.Name.Space, attr.Name.Local), value: attr.Value})
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i].space != attrs[j].space {
+			return attrs[i].space < attrs[j].space
+		}
+		return localPart(attrs[i].name) < localPart(attrs[j].name)
+	})
+
+	name := qualifiedName(n.prefix, n.local)
+	buf.WriteByte('<')
+	buf.WriteString(name)
+	for _, decl := range decls {
+		if decl.prefix == "" {
+			buf.WriteString(` xmlns="`)
+		} else {
+			buf.WriteString(` xmlns:` + decl.prefix + `="`)
+		}
+		buf.WriteString(escapeAttr(decl.uri))
+		buf.WriteByte('"')
+	}
+	for _, attr := range attrs {
+		buf.WriteString(" " + attr.name + `="`)
+		buf.WriteString(escapeAttr(attr.value))
+		buf.WriteByte('"')
+	}
+	buf.WriteByte('>')
+	for _, child := range n.children {
+		switch c := child.(type) {
+		case string:
+			buf.WriteString(escapeText(c))
+		case *node:
+			if c == skip {
+				continue
+			}
+			if err := writeCanonical(buf, c, skip, rendered, inclusive); err != nil {
+				return err
+			}
+		}
+	}
+	buf.WriteString("</" + name + ">")
+	return nil
+}
+
+func qualifiedName(prefix string, local string) string {
+	if prefix == "" {
+		return local
+	}
+	return prefix + ":" + local
+}
+
+func localPart(name string) string {
+	if idx := strings.IndexByte(name, ':'); idx >= 0 {
+		return name[idx+1:]
+	}
+	return name
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+)
+
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+func escapeAttr(value string) string {
+	return attrEscaper.Replace(value)
+}
@@ -0,0 +1,131 @@
+package saml
+
+import (
+	"strings"
+	"testing"
+)
+
+// The expected forms below were produced with xmllint --exc-c14n.
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		// path selects the canonicalized element by child positions from the root.
+		path      []int
+		inclusive []string
+		want      string
+	}{
+		{
+			name: "inherited namespaces are declared where used",
+			doc: `<saml:Assertion Version="2.0" xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" IssueInstant='2026-01-02T03:04:05Z' ID="_a1">` +
+				`<saml:Issuer>https://idp.example.com</saml:Issuer><!-- c --><saml:Subject><saml:NameID Format="f">alice@example.com</saml:NameID>` +
+				`<saml:SubjectConfirmation Method="m"><saml:SubjectConfirmationData Recipient="r" NotOnOrAfter="t" InResponseTo="_r"/></saml:SubjectConfirmation></saml:Subject>` +
+				`<saml:AttributeStatement><saml:Attribute Name="email" FriendlyName="mail"><saml:AttributeValue xsi:type="xs:string">a &amp; b &lt; "c" &gt;</saml:AttributeValue></saml:Attribute></saml:AttributeStatement></saml:Assertion>`,
+			want: `<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_a1" IssueInstant="2026-01-02T03:04:05Z" Version="2.0">` +
+				`<saml:Issuer>https://idp.example.com</saml:Issuer><saml:Subject><saml:NameID Format="f">alice@example.com</saml:NameID>` +
+				`<saml:SubjectConfirmation Method="m"><saml:SubjectConfirmationData InResponseTo="_r" NotOnOrAfter="t" Recipient="r"></saml:SubjectConfirmationData></saml:SubjectConfirmation></saml:Subject>` +
+				`<saml:AttributeStatement><saml:Attribute FriendlyName="mail" Name="email"><saml:AttributeValue xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="xs:string">a &amp; b &lt; "c" &gt;</saml:AttributeValue></saml:Attribute></saml:AttributeStatement></saml:Assertion>`,
+		},
+		{
+			name: "siblings redeclare unused ancestor namespaces",
+			doc:  `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_r1" Version="2.0"><saml:Issuer>i</saml:Issuer><samlp:Status><samlp:StatusCode Value="s"/></samlp:Status><saml:Assertion ID="_a1"><saml:Issuer>i</saml:Issuer></saml:Assertion></samlp:Response>`,
+			want: `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="_r1" Version="2.0"><saml:Issuer xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion">i</saml:Issuer><samlp:Status><samlp:StatusCode Value="s"></samlp:StatusCode></samlp:Status><saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_a1"><saml:Issuer>i</saml:Issuer></saml:Assertion></samlp:Response>`,
+		},
+		{
+			name: "subtree of a response",
+			doc:  `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_r1" Version="2.0"><saml:Issuer>i</saml:Issuer><samlp:Status><samlp:StatusCode Value="s"/></samlp:Status><saml:Assertion ID="_a1"><saml:Issuer>i</saml:Issuer></saml:Assertion></samlp:Response>`,
+			path: []int{2},
+			want: `<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_a1"><saml:Issuer>i</saml:Issuer></saml:Assertion>`,
+		},
+		{
+			name: "default namespace, attribute order and escaping",
+			doc:  `<a xmlns="urn:a" xmlns:p="urn:p" xmlns:q="urn:q"><b xmlns="" p:z="2" a="1" q:y="3" xml:lang="en"><c/>x &#x3E; y &#x26; "z"</b><p:d attr="&lt;&quot;&#9;&#10;"/></a>`,
+			want: `<a xmlns="urn:a"><b xmlns="" xmlns:p="urn:p" xmlns:q="urn:q" a="1" xml:lang="en" p:z="2" q:y="3"><c></c>x &gt; y &amp; "z"</b><p:d xmlns:p="urn:p" attr="&lt;&quot;&#x9;&#xA;"></p:d></a>`,
+		},
+		{
+			name: "inherited default namespace of a subtree",
+			doc:  `<r xmlns="urn:d"><x:e xmlns:x="urn:x"><f/></x:e></r>`,
+			path: []int{0},
+			want: `<x:e xmlns:x="urn:x"><f xmlns="urn:d"></f></x:e>`,
+		},
+		{
+			name:      "inclusive namespaces are rendered on the apex",
+			doc:       `<r xmlns:saml="urn:s" xmlns:xs="urn:xs" xmlns:xsi="urn:xsi"><saml:A ID="_a"><saml:V xsi:type="xs:string">v</saml:V><saml:V xsi:type="xs:string">w</saml:V></saml:A></r>`,
+			path:      []int{0},
+			inclusive: []string{"xs"},
+			want:      `<saml:A xmlns:saml="urn:s" xmlns:xs="urn:xs" ID="_a"><saml:V xmlns:xsi="urn:xsi" xsi:type="xs:string">v</saml:V><saml:V xmlns:xsi="urn:xsi" xsi:type="xs:string">w</saml:V></saml:A>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := parseXML([]byte(tt.doc))
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			n := root
+			for _, i := range tt.path {
+				n = n.elements()[i]
+			}
+			got, err := canonicalize(n, nil, tt.inclusive)
+			if err != nil {
+				t.Fatalf("canonicalize: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Fatalf("canonical form =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeSkipsSignature(t *testing.T) {
+	doc := `<saml:Assertion xmlns:saml="urn:s" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" ID="_a"><saml:Issuer>i</saml:Issuer><ds:Signature><ds:SignedInfo/></ds:Signature><saml:Subject/></saml:Assertion>`
+	root, err := parseXML([]byte(doc))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	got, err := canonicalize(root, root.child(nsDSig, "Signature"), nil)
+	if err != nil {
+		t.Fatalf("canonicalize: %v", err)
+	}
+	want := `<saml:Assertion xmlns:saml="urn:s" ID="_a"><saml:Issuer>i</saml:Issuer><saml:Subject></saml:Subject></saml:Assertion>`
+	if string(got) != want {
+		t.Fatalf("canonical form =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestParseXMLRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{
+			name: "doctype",
+			doc:  `<?xml version="1.0"?><!DOCTYPE r [<!ENTITY x SYSTEM "file:///etc/passwd">]><r>&x;</r>`,
+			want: "DTDs not supported",
+		},
+		{
+			name: "processing instruction",
+			doc:  `<r><?evil data?></r>`,
+			want: "processing instructions not supported",
+		},
+		{
+			name: "multiple roots",
+			doc:  `<r></r><r></r>`,
+			want: "multiple root elements",
+		},
+		{
+			name: "truncated",
+			doc:  `<r><a></a>`,
+			want: "incomplete document",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseXML([]byte(tt.doc))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Package secretbox seals secrets with envelope encryption: every secret is
+// encrypted under its own random data key with AES-256-GCM, and the data key
+// is in turn encrypted (wrapped) under a long-lived master key. Only the
+// wrapped key and the ciphertext are stored.
+package secretbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// KeySize is the size of master and data keys (AES-256).
+const KeySize = 32
+
+var (
+	ErrInvalidKey  = errors.New("secretbox: master key must be 32 bytes")
+	ErrKeyMismatch = errors.New("secretbox: sealed with another master key")
+	ErrDecrypt     = errors.New("secretbox: decryption failed")
+)
+
+// Sealed is an encrypted secret as stored.
+type Sealed struct {
+	// KeyID identifies the master key that wrapped the data key.
+	KeyID string
+	// WrappedKey is the data key encrypted under the master key, prefixed
+	// with its nonce.
+	WrappedKey []byte
+	// Ciphertext is the secret encrypted under the data key, prefixed with
+	// its nonce.
+	Ciphertext []byte
+}
+
+// Box seals and opens secrets under one master key.
+type Box struct {
+	master cipher.AEAD
+	keyID  string
+}
+
+// ParseKey decodes a master key given in base64 (standard or URL alphabet)
+// or hex.
+func ParseKey(encoded string) ([]byte, error) {
+	encoded = strings.TrimSpace(encoded)
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		if key, err := enc.DecodeString(encoded); err == nil && len(key) == KeySize {
+			return key, nil
+		}
+	}
+	if key, err := hex.DecodeString(encoded); err == nil && len(key) == KeySize {
+		return key, nil
+	}
+	return nil, ErrInvalidKey
+}
+
+// New creates a Box for a 32-byte master key.
+func New(masterKey []byte) (*Box, error) {
+	if len(masterKey) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(masterKey)
+	return &Box{master: aead, keyID: hex.EncodeToString(sum[:8])}, nil
+}
+
+// KeyID identifies the master key without revealing it.
+func (b *Box) KeyID() string {
+	return b.keyID
+}
+
+// Seal encrypts plaintext under a fresh data key. aad binds the result to
+// its owner: Open fails unless given the same aad.
+func (b *Box) Seal(plaintext []byte, aad []byte) (Sealed, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return Sealed{}, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return Sealed{}, err
+	}
+	ciphertext, err := seal(aead, plaintext, aad)
+	if err != nil {
+		return Sealed{}, err
+	}
+	wrapped, err := seal(b.master, dataKey, aad)
+	if err != nil {
+		return Sealed{}, err
+	}
+	clear(dataKey)
+	return Sealed{KeyID: b.keyID, WrappedKey: wrapped, Ciphertext: ciphertext}, nil
+}
+
+// Open decrypts a secret sealed by Seal.
+func (b *Box) Open(sealed Sealed, aad []byte) ([]byte, error) {
+	if sealed.KeyID != "" && sealed.KeyID != b.keyID {
+		return nil, ErrKeyMismatch
+	}
+	dataKey, err := open(b.master, sealed.WrappedKey, aad)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(dataKey)
+	if len(dataKey) != KeySize {
+		return nil, ErrDecrypt
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return open(aead, sealed.Ciphertext, aad)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, data []byte, aad []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,185 @@
+package secretbox
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func testKey(fill byte) []byte {
+	return bytes.Repeat([]byte{fill}, KeySize)
+}
+
+func newTestBox(t *testing.T, fill byte) *Box {
+	t.Helper()
+	box, err := New(testKey(fill))
+	if err != nil {
+		t.Fatalf("new box: %v", err)
+	}
+	return box
+}
+
+func TestSealOpen(t *testing.T) {
+	box := newTestBox(t, 1)
+	aad := []byte("provider_credential:tenant-1:cred-1")
+	for _, plaintext := range [][]byte{[]byte("sk-live-1234567890"), {}, bytes.Repeat([]byte("k"), 4096)} {
+		sealed, err := box.Seal(plaintext, aad)
+		if err != nil {
+			t.Fatalf("seal: %v", err)
+		}
+		if sealed.KeyID != box.KeyID() {
+			t.Fatalf("key id = %q, want %q", sealed.KeyID, box.KeyID())
+		}
+		if len(plaintext) > 0 && bytes.Contains(sealed.Ciphertext, plaintext) {
+			t.Fatal("ciphertext contains the plaintext")
+		}
+		got, err := box.Open(sealed, aad)
+		if err != nil {
+			t.Fatalf("open: %v", err)
+		}
+		if !bytes.Equal(got, plaintext) {
+			t.Fatalf("opened %q, want %q", got, plaintext)
+		}
+	}
+}
+
+func TestSealUsesFreshKeys(t *testing.T) {
+	box := newTestBox(t, 1)
+	a, err := box.Seal([]byte("secret"), nil)
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	b, err := box.Seal([]byte("secret"), nil)
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	if bytes.Equal(a.WrappedKey, b.WrappedKey) || bytes.Equal(a.Ciphertext, b.Ciphertext) {
+		t.Fatal("sealing twice produced the same data key or ciphertext")
+	}
+}
+
+func TestOpenRejects(t *testing.T) {
+	box := newTestBox(t, 1)
+	aad := []byte("provider_credential:tenant-1:cred-1")
+	sealed, err := box.Seal([]byte("sk-live-1234567890"), aad)
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	flip := func(b []byte, i int) []byte {
+		out := bytes.Clone(b)
+		out[i] ^= 0x01
+		return out
+	}
+	tests := []struct {
+		name   string
+		box    *Box
+		sealed Sealed
+		aad    []byte
+		want   error
+	}{
+		{name: "other tenant", sealed: sealed, aad: []byte("provider_credential:tenant-2:cred-1"), want: ErrDecrypt},
+		{name: "other credential", sealed: sealed, aad: []byte("provider_credential:tenant-1:cred-2"), want: ErrDecrypt},
+		{name: "no aad", sealed: sealed, want: ErrDecrypt},
+		{name: "other master key", box: newTestBox(t, 2), sealed: sealed, aad: aad, want: ErrKeyMismatch},
+		{
+			name:   "other master key without key id",
+			box:    newTestBox(t, 2),
+			sealed: Sealed{WrappedKey: sealed.WrappedKey, Ciphertext: sealed.Ciphertext},
+			aad:    aad,
+			want:   ErrDecrypt,
+		},
+		{name: "tampered ciphertext", sealed: Sealed{KeyID: sealed.KeyID, WrappedKey: sealed.WrappedKey, Ciphertext: flip(sealed.Ciphertext, len(sealed.Ciphertext)-1)}, aad: aad, want: ErrDecrypt},
+		{name: "tampered ciphertext nonce", sealed: Sealed{KeyID: sealed.KeyID, WrappedKey: sealed.WrappedKey, Ciphertext: flip(sealed.Ciphertext, 0)}, aad: aad, want: ErrDecrypt},
+		{name: "tampered wrapped key", sealed: Sealed{KeyID: sealed.KeyID, WrappedKey: flip(sealed.WrappedKey, 20), Ciphertext: sealed.Ciphertext}, aad: aad, want: ErrDecrypt},
+		{name: "truncated ciphertext", sealed: Sealed{KeyID: sealed.KeyID, WrappedKey: sealed.WrappedKey, Ciphertext: sealed.Ciphertext[:5]}, aad: aad, want: ErrDecrypt},
+		{name: "truncated wrapped key", sealed: Sealed{KeyID: sealed.KeyID, WrappedKey: sealed.WrappedKey[:5], Ciphertext: sealed.Ciphertext}, aad: aad, want: ErrDecrypt},
+		{name: "empty", sealed: Sealed{KeyID: sealed.KeyID}, aad: aad, want: ErrDecrypt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opener := tt.box
+			if opener == nil {
+				opener = box
+			}
+			got, err := opener.Open(tt.sealed, tt.aad)
+			if !errors.Is(err, tt.want) || got != nil {
+				t.Fatalf("open = %q, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// Rows written without a master key ID still open under the key that sealed
+// them.
+func TestOpenWithoutKeyID(t *testing.T) {
+	box := newTestBox(t, 1)
+	sealed, err := box.Seal([]byte("sk-legacy"), []byte("aad"))
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	sealed.KeyID = ""
+	got, err := box.Open(sealed, []byte("aad"))
+	if err != nil || string(got) != "sk-legacy" {
+		t.Fatalf("open = %q, %v", got, err)
+	}
+}
+
+func TestKeyID(t *testing.T) {
+	a, b := newTestBox(t, 1), newTestBox(t, 2)
+	if a.KeyID() == b.KeyID() {
+		t.Fatal("different master keys share a key id")
+	}
+	if a.KeyID() != newTestBox(t, 1).KeyID() {
+		t.Fatal("key id is not stable")
+	}
+	if len(a.KeyID()) != 16 || bytes.Contains([]byte(hex.EncodeToString(testKey(1))), []byte(a.KeyID())) {
+		t.Fatalf("key id = %q", a.KeyID())
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	key := make([]byte, KeySize)
+	for i := range key {
+		key[i] = byte(i*7 + 250)
+	}
+	tests := []struct {
+		name    string
+		encoded string
+		wantErr bool
+	}{
+		{name: "std base64", encoded: base64.StdEncoding.EncodeToString(key)},
+		{name: "url base64", encoded: base64.URLEncoding.EncodeToString(key)},
+		{name: "raw std base64", encoded: base64.RawStdEncoding.EncodeToString(key)},
+		{name: "raw url base64", encoded: base64.RawURLEncoding.EncodeToString(key)},
+		{name: "hex", encoded: hex.EncodeToString(key)},
+		{name: "surrounding space", encoded: "  " + hex.EncodeToString(key) + "\n"},
+		{name: "short", encoded: base64.StdEncoding.EncodeToString(key[:16]), wantErr: true},
+		{name: "long", encoded: hex.EncodeToString(append(bytes.Clone(key), 0)), wantErr: true},
+		{name: "garbage", encoded: "not a key", wantErr: true},
+		{name: "empty", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseKey(tt.encoded)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidKey) {
+					t.Fatalf("err = %v, want ErrInvalidKey", err)
+				}
+				return
+			}
+			if err != nil || !bytes.Equal(got, key) {
+				t.Fatalf("ParseKey = %x, %v", got, err)
+			}
+		})
+	}
+}
+
+func TestNewRejectsKeySize(t *testing.T) {
+	for _, size := range []int{0, 16, 24, 31, 33} {
+		if _, err := New(make([]byte, size)); !errors.Is(err, ErrInvalidKey) {
+			t.Fatalf("New(%d bytes) err = %v", size, err)
+		}
+	}
+}
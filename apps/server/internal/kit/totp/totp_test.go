@@ -0,0 +1,174 @@
+package totp
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 key of the RFC 4226 and RFC 6238 test vectors.
+var rfcSecret = encoding.EncodeToString([]byte("12345678901234567890"))
+
+// The RFC 6238 SHA1 vectors are 8 digits; a 6 digit code is their tail.
+func TestCodeRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("code at %d: %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Fatalf("code at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestCodeRFC4226(t *testing.T) {
+	want := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+	for counter, code := range want {
+		got, err := Code(rfcSecret, int64(counter))
+		if err != nil || got != code {
+			t.Fatalf("code at counter %d = %s, %v, want %s", counter, got, err, code)
+		}
+	}
+}
+
+func TestCodeSecret(t *testing.T) {
+	want, err := Code(rfcSecret, 1)
+	if err != nil {
+		t.Fatalf("code: %v", err)
+	}
+	if got, err := Code(" "+strings.ToLower(rfcSecret)+" ", 1); err != nil || got != want {
+		t.Fatalf("lower case secret = %s, %v, want %s", got, err, want)
+	}
+	for _, secret := range []string{"", "   ", "not base32!", "GEZDGNBVGY3TQOJQ1"} {
+		if _, err := Code(secret, 1); !errors.Is(err, ErrInvalidSecret) {
+			t.Fatalf("Code(%q) err = %v, want ErrInvalidSecret", secret, err)
+		}
+	}
+}
+
+func TestValidateSkew(t *testing.T) {
+	const step = 37037037
+	first := time.Unix(step*Period, 0)
+	last := time.Unix(step*Period+Period-1, 0)
+	tests := []struct {
+		name     string
+		now      time.Time
+		codeStep int64
+		skew     int
+		wantOK   bool
+	}{
+		{name: "current step", now: first, codeStep: step, skew: 1, wantOK: true},
+		{name: "current step at its last second", now: last, codeStep: step, skew: 1, wantOK: true},
+		{name: "previous step", now: first, codeStep: step - 1, skew: 1, wantOK: true},
+		{name: "previous step at the last second", now: last, codeStep: step - 1, skew: 1, wantOK: true},
+		{name: "next step", now: last, codeStep: step + 1, skew: 1, wantOK: true},
+		{name: "next step at the first second", now: first, codeStep: step + 1, skew: 1, wantOK: true},
+		{name: "two steps back", now: last, codeStep: step - 2, skew: 1},
+		{name: "two steps ahead", now: first, codeStep: step + 2, skew: 1},
+		{name: "two steps back with skew 2", now: first, codeStep: step - 2, skew: 2, wantOK: true},
+		{name: "no skew, current step", now: last, codeStep: step, wantOK: true},
+		{name: "no skew, previous step", now: first, codeStep: step - 1},
+		{name: "no skew, next step", now: last, codeStep: step + 1},
+		// One second past the window the previous step falls out of it.
+		{name: "previous step after the window", now: last.Add(time.Second), codeStep: step - 1, skew: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Code(rfcSecret, tt.codeStep)
+			if err != nil {
+				t.Fatalf("code: %v", err)
+			}
+			got, ok := Validate(rfcSecret, code, tt.now, tt.skew)
+			if ok != tt.wantOK {
+				t.Fatalf("Validate = %d, %v, want ok %v", got, ok, tt.wantOK)
+			}
+			if ok && got != tt.codeStep {
+				t.Fatalf("step = %d, want %d", got, tt.codeStep)
+			}
+		})
+	}
+}
+
+func TestValidateCode(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+		wantOK bool
+	}{
+		{name: "valid", secret: rfcSecret, code: "050471", wantOK: true},
+		{name: "grouped", secret: rfcSecret, code: " 050 471 ", wantOK: true},
+		{name: "wrong code", secret: rfcSecret, code: "050472"},
+		{name: "short", secret: rfcSecret, code: "50471"},
+		{name: "long", secret: rfcSecret, code: "0050471"},
+		{name: "eight digit rfc code", secret: rfcSecret, code: "14050471"},
+		{name: "letters", secret: rfcSecret, code: "05047a"},
+		{name: "empty", secret: rfcSecret},
+		{name: "invalid secret", secret: "not base32!", code: "050471"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := Validate(tt.secret, tt.code, now, 1); ok != tt.wantOK {
+				t.Fatalf("Validate(%q) = %v, want %v", tt.code, ok, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	b, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if a == b {
+		t.Fatal("generated the same secret twice")
+	}
+	raw, err := encoding.DecodeString(a)
+	if err != nil || len(raw) != secretSize || strings.Contains(a, "=") {
+		t.Fatalf("secret %q decodes to %d bytes, %v", a, len(raw), err)
+	}
+	now := time.Now()
+	code, err := Code(a, Step(now))
+	if err != nil {
+		t.Fatalf("code: %v", err)
+	}
+	if step, ok := Validate(a, code, now, 0); !ok || step != Step(now) {
+		t.Fatalf("Validate = %d, %v", step, ok)
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	u, err := url.Parse(ProvisioningURI("RagoDesk", "alice@example.com", rfcSecret))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/RagoDesk:alice@example.com" {
+		t.Fatalf("uri = %s", u)
+	}
+	query := u.Query()
+	want := map[string]string{"secret": rfcSecret, "issuer": "RagoDesk", "algorithm": "SHA1", "digits": "6", "period": "30"}
+	for key, value := range want {
+		if query.Get(key) != value {
+			t.Fatalf("%s = %q, want %q", key, query.Get(key), value)
+		}
+	}
+}
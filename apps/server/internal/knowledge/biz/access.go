@@ -0,0 +1,63 @@
+package biz
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/access"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// SetDocumentAccessLabels replaces the access labels of a document. Indexed
+// chunks pick them up through the vector outbox; retrieval also checks the
+// labels stored with the document, so a change applies to the next answer.
+// Documents that skipped chunks as duplicates across the change are
+// reindexed, since skips only hold between documents with the same labels.
+func (uc *KnowledgeUsecase) SetDocumentAccessLabels(ctx context.Context, documentID string, labels []string) (Document, error) {
+	documentID = strings.TrimSpace(documentID)
+	if documentID == "" {
+		return Document{}, errors.BadRequest("DOC_ID_MISSING", "document id missing")
+	}
+	labels, err := normalizeAccessLabels(labels)
+	if err != nil {
+		return Document{}, err
+	}
+	before, err := uc.repo.GetDocument(ctx, documentID)
+	if err != nil {
+		return Document{}, err
+	}
+	doc, err := uc.repo.UpdateDocumentAccessLabels(ctx, documentID, labels)
+	if err != nil {
+		return Document{}, err
+	}
+	if !access.SameLabels(before.AccessLabels, doc.AccessLabels) {
+		uc.reindexSkippedDuplicates(ctx, documentID)
+	}
+	return doc, nil
+}
+
+// reindexSkippedDuplicates reindexes the documents whose skipped duplicates
+// involve documentID so the skips are re-evaluated against the new labels.
+// Failures are logged; the reindex can be started again by hand.
+func (uc *KnowledgeUsecase) reindexSkippedDuplicates(ctx context.Context, documentID string) {
+	ids, err := uc.repo.ListSkippedDuplicateDocuments(ctx, documentID)
+	if err != nil {
+		if uc.log != nil {
+			uc.log.Warnf("list skipped duplicates failed: document=%s err=%v", documentID, err)
+		}
+		return
+	}
+	for _, id := range ids {
+		if _, err := uc.ReindexDocument(ctx, id); err != nil && uc.log != nil {
+			uc.log.Warnf("reindex after access label change failed: document=%s err=%v", id, err)
+		}
+	}
+}
+
+func normalizeAccessLabels(labels []string) ([]string, error) {
+	out, err := access.Normalize(labels)
+	if err != nil {
+		return nil, errors.BadRequest("DOC_ACCESS_LABEL_INVALID", err.Error())
+	}
+	return out, nil
+}
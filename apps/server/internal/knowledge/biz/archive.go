@@ -0,0 +1,789 @@
+package biz
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+// PermissionKnowledgeBaseClone lets platform admins clone knowledge bases
+// across tenants.
+const PermissionKnowledgeBaseClone = "platform.knowledge_base.clone"
+
+// ImportSourceKBArchive is the source of an import batch created from a
+// knowledge base archive.
+const ImportSourceKBArchive = "kb_archive"
+
+// A knowledge base archive is a ZIP file with manifest.json, the raw object of
+// every document version under objects/ and, when vectors are included, the
+// chunks and vectors of the current version of each document as JSON lines
+// under chunks/.
+const (
+	kbArchiveFormat        = "ragodesk.kb_archive"
+	kbArchiveFormatVersion = 1
+	kbArchiveManifestName  = "manifest.json"
+
+	maxArchiveManifestBytes = 64 << 20
+	// maxArchiveObjectBytes matches the document upload limit.
+	maxArchiveObjectBytes = 10 << 20
+	maxArchiveChunkBytes  = 8 << 20
+	archivePageSize       = 200
+)
+
+type kbArchiveManifest struct {
+	Format         string               `json:"format"`
+	FormatVersion  int                  `json:"format_version"`
+	ExportedAt     time.Time            `json:"exported_at"`
+	SourceTenantID string               `json:"source_tenant_id"`
+	SourceKBID     string               `json:"source_kb_id"`
+	KnowledgeBase  archiveKnowledgeBase `json:"knowledge_base"`
+	IncludeVectors bool                 `json:"include_vectors"`
+	Documents      []archiveDocument    `json:"documents"`
+}
+
+type archiveKnowledgeBase struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Chunking    ChunkingConfig  `json:"chunking"`
+	Embedding   EmbeddingConfig `json:"embedding"`
+	Dedup       DedupConfig     `json:"dedup"`
+}
+
+type archiveDocument struct {
+	ID             string           `json:"id"`
+	Title          string           `json:"title"`
+	SourceType     string           `json:"source_type"`
+	Status         string           `json:"status"`
+	CurrentVersion int32            `json:"current_version"`
+	Tags           []string         `json:"tags,omitempty"`
+	SectionPrefix  string           `json:"section_prefix,omitempty"`
+	AccessLabels   []string         `json:"access_labels,omitempty"`
+	Versions       []archiveVersion `json:"versions"`
+	// Vectors is set when the chunks of the current version are archived.
+	Vectors        *archiveVectors        `json:"vectors,omitempty"`
+	ChunkOverrides []archiveChunkOverride `json:"chunk_overrides,omitempty"`
+}
+
+type archiveChunkOverride struct {
+	OriginHash string   `json:"origin_hash"`
+	Content    string   `json:"content,omitempty"`
+	Excluded   bool     `json:"excluded,omitempty"`
+	Keywords   []string `json:"keywords,omitempty"`
+}
+
+type archiveVersion struct {
+	ID              string `json:"id"`
+	Version         int32  `json:"version"`
+	Status          string `json:"status"`
+	IndexConfigHash string `json:"index_config_hash,omitempty"`
+	// Object is the archive path of the raw object; url documents keep their
+	// RawURI instead.
+	Object    string    `json:"object,omitempty"`
+	SHA256    string    `json:"sha256,omitempty"`
+	RawURI    string    `json:"raw_uri,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type archiveVectors struct {
+	Version int32  `json:"version"`
+	Model   string `json:"model"`
+	Dim     int    `json:"dim"`
+	Chunks  int    `json:"chunks"`
+	File    string `json:"file"`
+}
+
+type archiveChunk struct {
+	ChunkIndex  int32     `json:"chunk_index"`
+	Content     string    `json:"content"`
+	TokenCount  int32     `json:"token_count"`
+	ContentHash string    `json:"content_hash"`
+	Language    string    `json:"language,omitempty"`
+	Section     string    `json:"section,omitempty"`
+	PageNo      int32     `json:"page_no,omitempty"`
+	SourceURI   string    `json:"source_uri,omitempty"`
+	Confidence  float32   `json:"confidence,omitempty"`
+	OriginHash  string    `json:"origin_hash,omitempty"`
+	Excluded    bool      `json:"excluded,omitempty"`
+	Keywords    []string  `json:"keywords,omitempty"`
+	Vector      []float32 `json:"vector"`
+}
+
+// KBImportOptions control how a knowledge base archive is imported.
+type KBImportOptions struct {
+	// Name overrides the archived knowledge base name.
+	Name string
+	// Reembed ignores archived vectors and runs every document through
+	// ingestion.
+	Reembed bool
+}
+
+// KBArchiveImport is the outcome of importing a knowledge base archive.
+type KBArchiveImport struct {
+	KnowledgeBase KnowledgeBase
+	// Batch has one item per archived document.
+	Batch               ImportBatch
+	RestoredDocuments   int32
+	ReembeddedDocuments int32
+}
+
+// KBCloneRequest copies a knowledge base of one tenant into another.
+type KBCloneRequest struct {
+	SourceTenantID string
+	SourceKBID     string
+	TargetTenantID string
+	KBImportOptions
+}
+
+// ExportKnowledgeBase writes an archive of the knowledge base to w. Vectors
+// are only archived for documents whose current version has all of them.
+func (uc *KnowledgeUsecase) ExportKnowledgeBase(ctx context.Context, kbID string, includeVectors bool, w io.Writer) error {
+	kbID = strings.TrimSpace(kbID)
+	if kbID == "" {
+		return errors.BadRequest("KB_ID_MISSING", "knowledge base id missing")
+	}
+	kb, err := uc.repo.GetKnowledgeBase(ctx, kbID)
+	if err != nil {
+		return err
+	}
+	docs, err := uc.listAllDocuments(ctx, kbID)
+	if err != nil {
+		return err
+	}
+	refsByVersion := make(map[string][]ChunkVectorRef)
+	if includeVectors {
+		refs, err := uc.repo.ListChunkVectorRefs(ctx, kbID)
+		if err != nil {
+			return err
+		}
+		for _, ref := range refs {
+			refsByVersion[ref.DocumentVersionID] = append(refsByVersion[ref.DocumentVersionID], ref)
+		}
+	}
+
+	manifest := kbArchiveManifest{
+		Format:         kbArchiveFormat,
+		FormatVersion:  kbArchiveFormatVersion,
+		ExportedAt:     time.Now().UTC(),
+		SourceTenantID: kb.TenantID,
+		SourceKBID:     kb.ID,
+		KnowledgeBase: archiveKnowledgeBase{
+			Name:        kb.Name,
+			Description: kb.Description,
+			Chunking:    kb.Chunking,
+			Embedding:   kb.Embedding,
+			Dedup:       kb.Dedup,
+		},
+		IncludeVectors: includeVectors,
+		Documents:      make([]archiveDocument, 0, len(docs)),
+	}
+	zw := zip.NewWriter(w)
+	for _, doc := range docs {
+		entry, err := uc.exportDocument(ctx, zw, doc, refsByVersion)
+		if err != nil {
+			return err
+		}
+		manifest.Documents = append(manifest.Documents, entry)
+	}
+	// The manifest goes last: it records hashes and counts of the entries.
+	mw, err := zw.Create(kbArchiveManifestName)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(mw)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(manifest); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	if uc.log != nil {
+		uc.log.Infof("knowledge base exported: kb=%s documents=%d vectors=%t", kb.ID, len(docs), includeVectors)
+	}
+	return nil
+}
+
+func (uc *KnowledgeUsecase) listAllDocuments(ctx context.Context, kbID string) ([]Document, error) {
+	out := make([]Document, 0)
+	for offset := 0; ; offset += archivePageSize {
+		page, err := uc.repo.ListDocuments(ctx, []string{kbID}, archivePageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, page...)
+		if len(page) < archivePageSize {
+			return out, nil
+		}
+	}
+}
+
+func (uc *KnowledgeUsecase) exportDocument(ctx context.Context, zw *zip.Writer, doc Document, refsByVersion map[string][]ChunkVectorRef) (archiveDocument, error) {
+	entry := archiveDocument{
+		ID:             doc.ID,
+		Title:          doc.Title,
+		SourceType:     doc.SourceType,
+		Status:         doc.Status,
+		CurrentVersion: doc.CurrentVersion,
+		Tags:           doc.Tags,
+		SectionPrefix:  doc.SectionPrefix,
+		AccessLabels:   doc.AccessLabels,
+	}
+	overrides, err := uc.repo.ListChunkOverrides(ctx, doc.ID)
+	if err != nil {
+		return entry, err
+	}
+	for _, o := range overrides {
+		entry.ChunkOverrides = append(entry.ChunkOverrides, archiveChunkOverride{
+			OriginHash: o.OriginHash,
+			Content:    o.Content,
+			Excluded:   o.Excluded,
+			Keywords:   o.Keywords,
+		})
+	}
+	versions, err := uc.repo.ListDocumentVersions(ctx, doc.ID)
+	if err != nil {
+		return entry, err
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	for _, item := range versions {
+		v, err := uc.repo.GetDocumentVersion(ctx, item.ID)
+		if err != nil {
+			return entry, err
+		}
+		av := archiveVersion{
+			ID:              v.ID,
+			Version:         v.Version,
+			Status:          v.Status,
+			IndexConfigHash: v.IndexConfigHash,
+			CreatedAt:       v.CreatedAt,
+		}
+		if normalizeSourceType(doc.SourceType) == "url" {
+			av.RawURI = v.RawURI
+		} else {
+			payload, err := uc.repo.LoadDocumentContent(ctx, v)
+			if err != nil {
+				return entry, err
+			}
+			av.Object = fmt.Sprintf("objects/%s/v%d/%s", doc.ID, v.Version, archiveObjectName(v.RawURI))
+			if err := writeArchiveEntry(zw, av.Object, payload); err != nil {
+				return entry, err
+			}
+			sum := sha256.Sum256(payload)
+			av.SHA256 = hex.EncodeToString(sum[:])
+		}
+		entry.Versions = append(entry.Versions, av)
+		if v.Version == doc.CurrentVersion && v.Status == DocumentVersionStatusReady {
+			if refs := refsByVersion[v.ID]; len(refs) > 0 {
+				vectors, err := uc.exportVectors(ctx, zw, doc, v, refs)
+				if err != nil {
+					return entry, err
+				}
+				entry.Vectors = vectors
+			}
+		}
+	}
+	return entry, nil
+}
+
+// exportVectors archives the chunks and vectors of an indexed version, or
+// returns nil when a vector is missing so the import re-embeds the document.
+func (uc *KnowledgeUsecase) exportVectors(ctx context.Context, zw *zip.Writer, doc Document, version DocumentVersion, refs []ChunkVectorRef) (*archiveVectors, error) {
+	collection := refs[0].VectorCollection
+	model := refs[0].EmbeddingModel
+	chunks := make([]archiveChunk, 0, len(refs))
+	dim := 0
+	for start := 0; start < len(refs); start += archivePageSize {
+		end := start + archivePageSize
+		if end > len(refs) {
+			end = len(refs)
+		}
+		ids := make([]string, 0, end-start)
+		for _, ref := range refs[start:end] {
+			ids = append(ids, ref.ChunkID)
+		}
+		items, err := uc.repo.ListVersionChunks(ctx, version.ID, ids)
+		if err != nil {
+			return nil, err
+		}
+		vectors, err := uc.repo.GetChunkVectors(ctx, collection, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, ch := range items {
+			vec, ok := vectors[ch.ID]
+			if !ok || len(vec) == 0 || (dim > 0 && len(vec) != dim) {
+				if uc.log != nil {
+					uc.log.Warnf("export vectors skipped: document=%s version=%s chunk=%s vector missing", doc.ID, version.ID, ch.ID)
+				}
+				return nil, nil
+			}
+			dim = len(vec)
+			chunks = append(chunks, archiveChunk{
+				ChunkIndex:  ch.ChunkIndex,
+				Content:     ch.Content,
+				TokenCount:  ch.TokenCount,
+				ContentHash: ch.ContentHash,
+				Language:    ch.Language,
+				Section:     ch.Section,
+				PageNo:      ch.PageNo,
+				SourceURI:   ch.SourceURI,
+				Confidence:  ch.Confidence,
+				OriginHash:  ch.OriginHash,
+				Excluded:    ch.Excluded,
+				Keywords:    ch.Keywords,
+				Vector:      vec,
+			})
+		}
+	}
+	if len(chunks) == 0 || model == "" {
+		return nil, nil
+	}
+	sort.Slice(chunks, func(i, j int) bool { return chunks[i].ChunkIndex < chunks[j].ChunkIndex })
+	name := "chunks/" + doc.ID + ".jsonl"
+	cw, err := zw.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	enc := json.NewEncoder(cw)
+	for _, ch := range chunks {
+		if err := enc.Encode(ch); err != nil {
+			return nil, err
+		}
+	}
+	return &archiveVectors{Version: version.Version, Model: model, Dim: dim, Chunks: len(chunks), File: name}, nil
+}
+
+// ImportKnowledgeBase creates a knowledge base from an archive with new IDs
+// for every entity. Documents with archived vectors for the current embedding
+// model are indexed directly; the others go through ingestion.
+func (uc *KnowledgeUsecase) ImportKnowledgeBase(ctx context.Context, archive io.ReaderAt, size int64, opts KBImportOptions) (KBArchiveImport, error) {
+	zr, err := zip.NewReader(archive, size)
+	if err != nil {
+		return KBArchiveImport{}, errors.BadRequest("KB_ARCHIVE_INVALID", "invalid knowledge base archive")
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	manifest, err := readArchiveManifest(files)
+	if err != nil {
+		return KBArchiveImport{}, err
+	}
+	name := strings.TrimSpace(opts.Name)
+	if name == "" {
+		name = manifest.KnowledgeBase.Name
+	}
+	kb, err := uc.CreateKnowledgeBase(ctx, KnowledgeBase{
+		Name:        name,
+		Description: manifest.KnowledgeBase.Description,
+		Chunking:    manifest.KnowledgeBase.Chunking,
+		Embedding:   manifest.KnowledgeBase.Embedding,
+		Dedup:       manifest.KnowledgeBase.Dedup,
+	})
+	if err != nil {
+		return KBArchiveImport{}, err
+	}
+	batch, err := uc.repo.CreateImportBatch(ctx, ImportBatch{
+		KBID:       kb.ID,
+		FolderMode: ImportFolderModeNone,
+		Source:     ImportSourceKBArchive,
+		TotalFiles: int32(len(manifest.Documents)),
+	})
+	if err != nil {
+		return KBArchiveImport{}, err
+	}
+	result := KBArchiveImport{KnowledgeBase: kb}
+	embedder, err := uc.tenantEmbedderFor(ctx, kb.Embedding)
+	if err != nil {
+		return KBArchiveImport{}, err
+	}
+	for _, src := range manifest.Documents {
+		item := ImportBatchItem{
+			BatchID:    batch.ID,
+			Path:       src.Title,
+			SourceType: normalizeSourceType(src.SourceType),
+		}
+		docID, restored, err := uc.importArchiveDocument(ctx, files, kb, embedder, src, opts.Reembed)
+		item.DocumentID = docID
+		if err != nil {
+			item.Status = ImportItemStatusFailed
+			item.ErrorReason = err.Error()
+		} else {
+			item.Status = ImportItemStatusCreated
+			if restored {
+				result.RestoredDocuments++
+			} else {
+				result.ReembeddedDocuments++
+			}
+		}
+		if err := uc.repo.CreateImportBatchItem(ctx, item); err != nil {
+			return KBArchiveImport{}, err
+		}
+	}
+	if uc.log != nil {
+		uc.log.Infof("knowledge base imported: kb=%s source_tenant=%s source_kb=%s documents=%d restored=%d reembedded=%d",
+			kb.ID, manifest.SourceTenantID, manifest.SourceKBID, len(manifest.Documents), result.RestoredDocuments, result.ReembeddedDocuments)
+	}
+	result.Batch, err = uc.GetImportBatch(ctx, batch.ID)
+	if err != nil {
+		return KBArchiveImport{}, err
+	}
+	return result, nil
+}
+
+// importArchiveDocument recreates a document with all its versions and
+// indexes the current one. It returns the new document ID, set even when
+// indexing failed, and whether the archived vectors were used.
+func (uc *KnowledgeUsecase) importArchiveDocument(ctx context.Context, files map[string]*zip.File, kb KnowledgeBase, embedder provider.Provider, src archiveDocument, reembed bool) (string, bool, error) {
+	if len(src.Versions) == 0 {
+		return "", false, errors.BadRequest("KB_ARCHIVE_INVALID", "document has no versions")
+	}
+	versions := append([]archiveVersion(nil), src.Versions...)
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	// A document that never became ready is indexed from its latest version.
+	target := src.CurrentVersion
+	if target <= 0 {
+		target = versions[len(versions)-1].Version
+	}
+	accessLabels, err := normalizeAccessLabels(src.AccessLabels)
+	if err != nil {
+		return "", false, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	if err := uc.requirePlanCapacity(ctx, plan.ResourceDocuments, 1); err != nil {
+		return "", false, err
+	}
+	doc, err := uc.repo.CreateDocument(ctx, Document{
+		KBID:       kb.ID,
+		Title:      strings.TrimSpace(src.Title),
+		SourceType: normalizeSourceType(src.SourceType),
+		Status:     DocumentStatusProcessing,
+		DocumentLabels: DocumentLabels{
+			Tags:          src.Tags,
+			SectionPrefix: src.SectionPrefix,
+			AccessLabels:  accessLabels,
+		},
+	})
+	if err != nil {
+		return "", false, err
+	}
+	// Overrides are keyed by content hash and apply to the re-embedded
+	// chunks of the new document as well.
+	for _, o := range src.ChunkOverrides {
+		keywords, err := normalizeChunkKeywords(o.Keywords)
+		if err != nil {
+			return doc.ID, false, err
+		}
+		override := ChunkOverride{
+			KBID:       kb.ID,
+			DocumentID: doc.ID,
+			OriginHash: strings.TrimSpace(o.OriginHash),
+			Content:    strings.TrimSpace(o.Content),
+			Excluded:   o.Excluded,
+			Keywords:   keywords,
+		}
+		if override.OriginHash == "" || override.IsZero() {
+			continue
+		}
+		if err := uc.repo.SaveChunkOverride(ctx, override); err != nil {
+			return doc.ID, false, err
+		}
+	}
+	var current DocumentVersion
+	for _, v := range versions {
+		rawURI := strings.TrimSpace(v.RawURI)
+		var sizeBytes int64
+		if v.Object != "" {
+			payload, err := readArchiveObject(files, v)
+			if err != nil {
+				return doc.ID, false, err
+			}
+			sizeBytes = int64(len(payload))
+			if err := uc.requirePlanCapacity(ctx, plan.ResourceStorageBytes, sizeBytes); err != nil {
+				return doc.ID, false, err
+			}
+			rawURI, err = uc.repo.PutDocumentObject(ctx, kb.ID, path.Base(v.Object), payload, mime.TypeByExtension(path.Ext(v.Object)))
+			if err != nil {
+				return doc.ID, false, err
+			}
+		}
+		ver := DocumentVersion{
+			DocumentID:      doc.ID,
+			Version:         v.Version,
+			RawURI:          rawURI,
+			IndexConfigHash: v.IndexConfigHash,
+			SizeBytes:       sizeBytes,
+			Status:          v.Status,
+			CreatedAt:       v.CreatedAt,
+		}
+		if v.Version == target {
+			ver.IndexConfigHash = uc.indexConfigHashFor(kb)
+			ver.Status = DocumentVersionStatusProcessing
+			ver.CreatedAt = time.Time{}
+		} else if ver.Status != DocumentVersionStatusReady {
+			ver.Status = DocumentVersionStatusFailed
+		}
+		created, err := uc.repo.CreateDocumentVersion(ctx, ver)
+		if err != nil {
+			return doc.ID, false, err
+		}
+		if v.Version == target {
+			current = created
+		}
+	}
+	if current.ID == "" {
+		return doc.ID, false, errors.BadRequest("KB_ARCHIVE_INVALID", "current document version missing")
+	}
+
+	job := IngestionJob{
+		TenantID:          tenantID,
+		KBID:              kb.ID,
+		DocumentID:        doc.ID,
+		DocumentVersionID: current.ID,
+	}
+	if !reembed && archiveVectorsUsable(src.Vectors, target, embedder) {
+		if err := uc.restoreArchiveVectors(ctx, files, kb, doc, current, src.Vectors); err != nil {
+			uc.markIngestionFailed(ctx, job, current.ID, err)
+			return doc.ID, false, err
+		}
+		return doc.ID, true, nil
+	}
+	job, err = uc.trackIngestionJob(ctx, job)
+	if err != nil {
+		return doc.ID, false, err
+	}
+	if uc.enqueueIngestion(ctx, job) {
+		return doc.ID, false, nil
+	}
+	return doc.ID, false, uc.processIngestion(ctx, job)
+}
+
+// archiveVectorsUsable reports whether archived vectors fit the embedding
+// model the knowledge base uses in this deployment.
+func archiveVectorsUsable(v *archiveVectors, version int32, embedder provider.Provider) bool {
+	if v == nil || v.Version != version || v.Chunks == 0 || embedder == nil {
+		return false
+	}
+	if v.Model != embedder.Model() {
+		return false
+	}
+	return embedder.Dim() <= 0 || embedder.Dim() == v.Dim
+}
+
+func (uc *KnowledgeUsecase) restoreArchiveVectors(ctx context.Context, files map[string]*zip.File, kb KnowledgeBase, doc Document, version DocumentVersion, v *archiveVectors) error {
+	f, ok := files[v.File]
+	if !ok {
+		return errors.BadRequest("KB_ARCHIVE_INVALID", "archive entry missing: "+v.File)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	now := time.Now()
+	embedded := make([]EmbeddedChunk, 0, v.Chunks)
+	scanner := bufio.NewScanner(rc)
+	scanner.Buffer(make([]byte, 64<<10), maxArchiveChunkBytes)
+	for scanner.Scan() {
+		var ch archiveChunk
+		if err := json.Unmarshal(scanner.Bytes(), &ch); err != nil {
+			return errors.BadRequest("KB_ARCHIVE_INVALID", "invalid chunk in "+v.File)
+		}
+		if len(embedded) >= v.Chunks {
+			return errors.BadRequest("KB_ARCHIVE_INVALID", "more chunks than declared in "+v.File)
+		}
+		if len(ch.Vector) != v.Dim {
+			return errors.BadRequest("KB_ARCHIVE_INVALID", "vector dimension mismatch in "+v.File)
+		}
+		// Chunks of object documents point at the new raw object.
+		sourceURI := ch.SourceURI
+		if normalizeSourceType(doc.SourceType) != "url" {
+			sourceURI = version.RawURI
+		}
+		embedded = append(embedded, EmbeddedChunk{
+			Chunk: DocChunk{
+				ID:          uuid.NewString(),
+				ChunkIndex:  ch.ChunkIndex,
+				Content:     ch.Content,
+				TokenCount:  ch.TokenCount,
+				ContentHash: ch.ContentHash,
+				Language:    ch.Language,
+				Section:     ch.Section,
+				PageNo:      ch.PageNo,
+				SourceURI:   sourceURI,
+				Confidence:  ch.Confidence,
+				OriginHash:  ch.OriginHash,
+				Excluded:    ch.Excluded,
+				Keywords:    ch.Keywords,
+				SimHash:     simHash(ch.Content),
+				CreatedAt:   now,
+			},
+			Vector: ch.Vector,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.BadRequest("KB_ARCHIVE_INVALID", "read "+v.File+": "+err.Error())
+	}
+	if len(embedded) == 0 {
+		return errors.BadRequest("KB_ARCHIVE_INVALID", "no chunks in "+v.File)
+	}
+	if err := uc.repo.IndexDocumentVersion(ctx, IndexDocumentVersionRequest{
+		KBID:              kb.ID,
+		DocumentID:        doc.ID,
+		DocumentVersionID: version.ID,
+		DocumentTitle:     doc.Title,
+		SourceType:        normalizeSourceType(doc.SourceType),
+		EmbeddingModel:    v.Model,
+		EmbeddingDim:      v.Dim,
+		VectorCollection:  vectorCollectionKey(kb.Embedding, v.Model, v.Dim, kb.IndexGeneration),
+		Chunks:            embedded,
+		Stats:             EmbeddingStats{Reused: len(embedded)},
+	}); err != nil {
+		return err
+	}
+	if err := uc.repo.UpdateDocumentVersionStatus(ctx, version.ID, DocumentVersionStatusReady, ""); err != nil {
+		return err
+	}
+	return uc.repo.UpdateDocumentIndexState(ctx, doc.ID, DocumentStatusReady, version.Version)
+}
+
+// CloneKnowledgeBase exports a knowledge base of the source tenant and
+// imports it into the target tenant.
+func (uc *KnowledgeUsecase) CloneKnowledgeBase(ctx context.Context, req KBCloneRequest) (KBArchiveImport, error) {
+	req.SourceTenantID = strings.TrimSpace(req.SourceTenantID)
+	req.SourceKBID = strings.TrimSpace(req.SourceKBID)
+	req.TargetTenantID = strings.TrimSpace(req.TargetTenantID)
+	if req.SourceTenantID == "" || req.TargetTenantID == "" {
+		return KBArchiveImport{}, errors.BadRequest("TENANT_ID_MISSING", "source and target tenant ids required")
+	}
+	if req.SourceKBID == "" {
+		return KBArchiveImport{}, errors.BadRequest("KB_ID_MISSING", "knowledge base id missing")
+	}
+	// The archive is spooled to disk: it holds every raw object of the
+	// knowledge base.
+	tmp, err := os.CreateTemp("", "ragodesk-kb-clone-*.zip")
+	if err != nil {
+		return KBArchiveImport{}, err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	if err := uc.ExportKnowledgeBase(withTenantID(ctx, req.SourceTenantID), req.SourceKBID, !req.Reembed, tmp); err != nil {
+		return KBArchiveImport{}, err
+	}
+	info, err := tmp.Stat()
+	if err != nil {
+		return KBArchiveImport{}, err
+	}
+	result, err := uc.ImportKnowledgeBase(withTenantID(ctx, req.TargetTenantID), tmp, info.Size(), req.KBImportOptions)
+	if err != nil {
+		return KBArchiveImport{}, err
+	}
+	if uc.log != nil {
+		uc.log.Infof("knowledge base cloned: source_tenant=%s source_kb=%s target_tenant=%s kb=%s",
+			req.SourceTenantID, req.SourceKBID, req.TargetTenantID, result.KnowledgeBase.ID)
+	}
+	return result, nil
+}
+
+func readArchiveManifest(files map[string]*zip.File) (kbArchiveManifest, error) {
+	f, ok := files[kbArchiveManifestName]
+	if !ok {
+		return kbArchiveManifest{}, errors.BadRequest("KB_ARCHIVE_INVALID", "archive manifest missing")
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return kbArchiveManifest{}, errors.BadRequest("KB_ARCHIVE_INVALID", "archive manifest unreadable")
+	}
+	defer rc.Close()
+	var manifest kbArchiveManifest
+	if err := json.NewDecoder(io.LimitReader(rc, maxArchiveManifestBytes)).Decode(&manifest); err != nil {
+		return kbArchiveManifest{}, errors.BadRequest("KB_ARCHIVE_INVALID", "invalid archive manifest")
+	}
+	if manifest.Format != kbArchiveFormat || manifest.FormatVersion != kbArchiveFormatVersion {
+		return kbArchiveManifest{}, errors.BadRequest("KB_ARCHIVE_UNSUPPORTED", fmt.Sprintf("unsupported archive format %s v%d", manifest.Format, manifest.FormatVersion))
+	}
+	// Check every referenced entry up front so a truncated archive fails
+	// before anything is created.
+	for _, doc := range manifest.Documents {
+		for _, v := range doc.Versions {
+			if v.Object == "" && strings.TrimSpace(v.RawURI) == "" {
+				return kbArchiveManifest{}, errors.BadRequest("KB_ARCHIVE_INVALID", "document version without content: "+doc.ID)
+			}
+			if _, ok := files[v.Object]; v.Object != "" && !ok {
+				return kbArchiveManifest{}, errors.BadRequest("KB_ARCHIVE_INVALID", "archive entry missing: "+v.Object)
+			}
+		}
+	}
+	return manifest, nil
+}
+
+// readArchiveObject reads a raw object, trusting neither the declared size
+// nor the compression header, and checks its hash.
+func readArchiveObject(files map[string]*zip.File, v archiveVersion) ([]byte, error) {
+	f, ok := files[v.Object]
+	if !ok {
+		return nil, errors.BadRequest("KB_ARCHIVE_INVALID", "archive entry missing: "+v.Object)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	payload, err := io.ReadAll(io.LimitReader(rc, maxArchiveObjectBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) > maxArchiveObjectBytes {
+		return nil, errors.BadRequest("KB_ARCHIVE_INVALID", "archive object too large: "+v.Object)
+	}
+	if v.SHA256 != "" {
+		sum := sha256.Sum256(payload)
+		if hex.EncodeToString(sum[:]) != v.SHA256 {
+			return nil, errors.BadRequest("KB_ARCHIVE_INVALID", "archive object corrupted: "+v.Object)
+		}
+	}
+	return payload, nil
+}
+
+func writeArchiveEntry(zw *zip.Writer, name string, payload []byte) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(payload)
+	return err
+}
+
+// archiveObjectName returns the file name of a raw object URI without the
+// upload timestamp prefix added by object storage keys.
+func archiveObjectName(rawURI string) string {
+	name := path.Base(strings.TrimSpace(rawURI))
+	if idx := strings.Index(name, "_"); idx > 0 && strings.Trim(name[:idx], "0123456789") == "" {
+		name = name[idx+1:]
+	}
+	if name == "" || name == "." || name == "/" {
+		return "document"
+	}
+	return name
+}
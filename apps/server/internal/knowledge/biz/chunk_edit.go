@@ -0,0 +1,402 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/lang"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// PermissionChunkWrite allows correcting, excluding and tagging chunks.
+const PermissionChunkWrite = "tenant.chunk.write"
+
+const (
+	maxChunkKeywords     = 20
+	maxChunkKeywordRunes = 64
+	maxChunkSearchRunes  = 256
+)
+
+// ChunkRecord is a stored chunk with the document and index it belongs to.
+type ChunkRecord struct {
+	DocChunk
+	KBID              string
+	DocumentID        string
+	DocumentVersionID string
+	VectorCollection  string
+	// EmbeddingModel is the model the chunk vector was built with.
+	EmbeddingModel string
+}
+
+// ChunkView is a chunk as shown in the chunk browser.
+type ChunkView struct {
+	DocChunk
+	DocumentID        string
+	DocumentVersionID string
+	DocumentTitle     string
+	// Citations counts the answers that cited the chunk.
+	Citations int32
+}
+
+// ChunkEdit changes a chunk; nil fields are left unchanged.
+type ChunkEdit struct {
+	Content  *string
+	Excluded *bool
+	// Keywords replaces the pinned keywords; an empty list clears them.
+	Keywords *[]string
+	ActorID  string
+}
+
+// ChunkOverride is an editor change kept per document and original content
+// hash, so it is applied again when reindexing produces the same chunk.
+type ChunkOverride struct {
+	KBID       string
+	DocumentID string
+	OriginHash string
+	// Content replaces the chunked content; empty keeps it.
+	Content   string
+	Excluded  bool
+	Keywords  []string
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// IsZero reports whether the override changes nothing.
+func (o ChunkOverride) IsZero() bool {
+	return o.Content == "" && !o.Excluded && len(o.Keywords) == 0
+}
+
+// ChunkState is the editable state of a chunk recorded in the edit trail.
+type ChunkState struct {
+	Content     string   `json:"content"`
+	ContentHash string   `json:"content_hash"`
+	Excluded    bool     `json:"excluded"`
+	Keywords    []string `json:"keywords,omitempty"`
+}
+
+// ChunkEditRecord is one entry of the chunk edit trail.
+type ChunkEditRecord struct {
+	ID                string
+	KBID              string
+	DocumentID        string
+	DocumentVersionID string
+	ChunkID           string
+	OriginHash        string
+	ActorID           string
+	Before            ChunkState
+	After             ChunkState
+	CreatedAt         time.Time
+}
+
+// ChunkUpdate persists an edited chunk. Vector is set when the embedded text
+// changed; otherwise only the payload of the point is updated.
+type ChunkUpdate struct {
+	Record         ChunkRecord
+	Chunk          DocChunk
+	DocumentTitle  string
+	SourceType     string
+	Vector         []float32
+	EmbeddingModel string
+	Override       ChunkOverride
+	Edit           ChunkEditRecord
+}
+
+// ListDocumentChunks lists the chunks of a document version (the current one
+// when version is 0) with their citation counts.
+func (uc *KnowledgeUsecase) ListDocumentChunks(ctx context.Context, documentID string, version int32, limit int32, offset int32) ([]ChunkView, int32, error) {
+	documentID = strings.TrimSpace(documentID)
+	if documentID == "" {
+		return nil, 0, errors.BadRequest("DOC_ID_MISSING", "document id missing")
+	}
+	doc, err := uc.repo.GetDocument(ctx, documentID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if version <= 0 {
+		version = doc.CurrentVersion
+	}
+	if version <= 0 {
+		return nil, 0, errors.New(412, "DOC_VERSION_MISSING", "document has no version")
+	}
+	v, err := uc.repo.GetDocumentVersionByNumber(ctx, documentID, version)
+	if err != nil {
+		return nil, 0, err
+	}
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	chunks, total, err := uc.repo.ListChunks(ctx, v.ID, int(limit), int(offset))
+	if err != nil {
+		return nil, 0, err
+	}
+	citations, err := uc.repo.ListVersionCitations(ctx, documentID, []string{v.ID}, v.CreatedAt)
+	if err != nil {
+		return nil, 0, err
+	}
+	counts := make(map[string]int32, len(citations))
+	for _, c := range citations {
+		counts[c.ChunkID] += c.Citations
+	}
+	out := make([]ChunkView, 0, len(chunks))
+	for _, ch := range chunks {
+		out = append(out, ChunkView{
+			DocChunk:          ch,
+			DocumentID:        documentID,
+			DocumentVersionID: v.ID,
+			DocumentTitle:     doc.Title,
+			Citations:         counts[ch.ID],
+		})
+	}
+	return out, int32(total), nil
+}
+
+// SearchChunks runs a full-text search over the chunks of the current
+// versions of a knowledge base, best matches first.
+func (uc *KnowledgeUsecase) SearchChunks(ctx context.Context, kbID string, query string, limit int32, offset int32) ([]ChunkView, error) {
+	kbID = strings.TrimSpace(kbID)
+	if kbID == "" {
+		return nil, errors.BadRequest("KB_ID_MISSING", "kb id missing")
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.BadRequest("CHUNK_QUERY_MISSING", "query missing")
+	}
+	if utf8.RuneCountInString(query) > maxChunkSearchRunes {
+		return nil, errors.BadRequest("CHUNK_QUERY_INVALID", "query too long")
+	}
+	if _, err := uc.repo.GetKnowledgeBase(ctx, kbID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return uc.repo.SearchChunks(ctx, kbID, query, int(limit), int(offset))
+}
+
+// ChunkKnowledgeBaseID returns the knowledge base a chunk belongs to.
+func (uc *KnowledgeUsecase) ChunkKnowledgeBaseID(ctx context.Context, chunkID string) (string, error) {
+	chunkID = strings.TrimSpace(chunkID)
+	if chunkID == "" {
+		return "", errors.BadRequest("CHUNK_ID_MISSING", "chunk id missing")
+	}
+	rec, err := uc.repo.GetChunk(ctx, chunkID)
+	if err != nil {
+		return "", err
+	}
+	return rec.KBID, nil
+}
+
+// UpdateChunk corrects the content, exclusion or keywords of a chunk of a
+// current document version. A changed content or keyword list is embedded
+// again; the change is kept as an override for reindexing and recorded in the
+// edit trail.
+func (uc *KnowledgeUsecase) UpdateChunk(ctx context.Context, chunkID string, edit ChunkEdit) (ChunkView, error) {
+	chunkID = strings.TrimSpace(chunkID)
+	if chunkID == "" {
+		return ChunkView{}, errors.BadRequest("CHUNK_ID_MISSING", "chunk id missing")
+	}
+	rec, err := uc.repo.GetChunk(ctx, chunkID)
+	if err != nil {
+		return ChunkView{}, err
+	}
+	doc, err := uc.repo.GetDocument(ctx, rec.DocumentID)
+	if err != nil {
+		return ChunkView{}, err
+	}
+	version, err := uc.repo.GetDocumentVersion(ctx, rec.DocumentVersionID)
+	if err != nil {
+		return ChunkView{}, err
+	}
+	if version.Version != doc.CurrentVersion || version.Status != DocumentVersionStatusReady {
+		return ChunkView{}, errors.New(412, "CHUNK_VERSION_NOT_CURRENT", "only chunks of the current ready version can be edited")
+	}
+	var kb KnowledgeBase
+	if doc.KBID != "" {
+		// A migration rebuilds every document from its overrides; an edit
+		// made meanwhile would miss the documents already rebuilt.
+		if err := uc.requireNoMigration(ctx, doc.KBID, false); err != nil {
+			return ChunkView{}, err
+		}
+		if kb, err = uc.repo.GetKnowledgeBase(ctx, doc.KBID); err != nil {
+			return ChunkView{}, err
+		}
+	}
+
+	originHash := rec.OriginHash
+	if originHash == "" {
+		originHash = rec.ContentHash
+	}
+	next := rec.DocChunk
+	if edit.Content != nil {
+		content := strings.TrimSpace(*edit.Content)
+		if content == "" {
+			return ChunkView{}, errors.BadRequest("CHUNK_CONTENT_EMPTY", "chunk content empty")
+		}
+		if content != next.Content {
+			setChunkContent(&next, content)
+		}
+	}
+	if edit.Keywords != nil {
+		keywords, err := normalizeChunkKeywords(*edit.Keywords)
+		if err != nil {
+			return ChunkView{}, err
+		}
+		next.Keywords = keywords
+	}
+	if edit.Excluded != nil {
+		next.Excluded = *edit.Excluded
+	}
+	next.OriginHash = ""
+	if next.ContentHash != originHash {
+		next.OriginHash = originHash
+	}
+	view := ChunkView{
+		DocChunk:          next,
+		DocumentID:        doc.ID,
+		DocumentVersionID: version.ID,
+		DocumentTitle:     doc.Title,
+	}
+	if next.ContentHash == rec.ContentHash && next.Excluded == rec.Excluded && slices.Equal(next.Keywords, rec.Keywords) {
+		return view, nil
+	}
+
+	update := ChunkUpdate{
+		Record:        rec,
+		Chunk:         next,
+		DocumentTitle: doc.Title,
+		SourceType:    normalizeSourceType(doc.SourceType),
+		Override: ChunkOverride{
+			KBID:       doc.KBID,
+			DocumentID: doc.ID,
+			OriginHash: originHash,
+			Excluded:   next.Excluded,
+			Keywords:   next.Keywords,
+			UpdatedBy:  edit.ActorID,
+		},
+		Edit: ChunkEditRecord{
+			KBID:              doc.KBID,
+			DocumentID:        doc.ID,
+			DocumentVersionID: version.ID,
+			ChunkID:           rec.ID,
+			OriginHash:        originHash,
+			ActorID:           edit.ActorID,
+			Before:            toChunkState(rec.DocChunk),
+			After:             toChunkState(next),
+		},
+	}
+	if next.OriginHash != "" {
+		update.Override.Content = next.Content
+	}
+	if next.EmbeddingHash() != rec.EmbeddingHash() {
+		embedder, err := uc.tenantEmbedderFor(ctx, kb.Embedding)
+		if err != nil {
+			return ChunkView{}, err
+		}
+		if rec.EmbeddingModel != "" && rec.EmbeddingModel != embedder.Model() {
+			return ChunkView{}, errors.Conflict("EMBEDDING_MODEL_MISMATCH", "chunk was embedded with "+rec.EmbeddingModel)
+		}
+		embedded, _, err := uc.embedChunks(ctx, embedder, []DocChunk{next})
+		if err != nil {
+			return ChunkView{}, err
+		}
+		update.Vector = embedded[0].Vector
+		update.EmbeddingModel = embedder.Model()
+	}
+	if err := uc.repo.UpdateChunk(ctx, update); err != nil {
+		return ChunkView{}, err
+	}
+	return view, nil
+}
+
+// ListChunkEdits returns the edit trail of a document, newest first.
+func (uc *KnowledgeUsecase) ListChunkEdits(ctx context.Context, documentID string, limit int32, offset int32) ([]ChunkEditRecord, error) {
+	documentID = strings.TrimSpace(documentID)
+	if documentID == "" {
+		return nil, errors.BadRequest("DOC_ID_MISSING", "document id missing")
+	}
+	if _, err := uc.repo.GetDocument(ctx, documentID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return uc.repo.ListChunkEdits(ctx, documentID, int(limit), int(offset))
+}
+
+// applyChunkOverrides applies the editor overrides of a document to freshly
+// built chunks whose content hash matches the override.
+func (uc *KnowledgeUsecase) applyChunkOverrides(ctx context.Context, documentID string, chunks []DocChunk) ([]DocChunk, error) {
+	overrides, err := uc.repo.ListChunkOverrides(ctx, documentID)
+	if err != nil || len(overrides) == 0 {
+		return chunks, err
+	}
+	byHash := make(map[string]ChunkOverride, len(overrides))
+	for _, o := range overrides {
+		byHash[o.OriginHash] = o
+	}
+	for i := range chunks {
+		o, ok := byHash[chunks[i].ContentHash]
+		if !ok {
+			continue
+		}
+		if o.Content != "" && o.Content != chunks[i].Content {
+			chunks[i].OriginHash = chunks[i].ContentHash
+			setChunkContent(&chunks[i], o.Content)
+		}
+		chunks[i].Excluded = o.Excluded
+		chunks[i].Keywords = o.Keywords
+	}
+	return chunks, nil
+}
+
+// setChunkContent replaces the content of a chunk and the fields derived
+// from it.
+func setChunkContent(ch *DocChunk, content string) {
+	ch.Content = content
+	ch.ContentHash = sha256Hex(content)
+	ch.TokenCount = int32(estimateTokenCount(content))
+	ch.Language = lang.Detect(content)
+	ch.SimHash = simHash(content)
+}
+
+func normalizeChunkKeywords(keywords []string) ([]string, error) {
+	out := make([]string, 0, len(keywords))
+	for _, kw := range keywords {
+		kw = strings.Join(strings.Fields(kw), " ")
+		if kw == "" || slices.Contains(out, kw) {
+			continue
+		}
+		if utf8.RuneCountInString(kw) > maxChunkKeywordRunes {
+			return nil, errors.BadRequest("CHUNK_KEYWORD_INVALID", "keyword too long: "+kw)
+		}
+		out = append(out, kw)
+	}
+	if len(out) > maxChunkKeywords {
+		return nil, errors.BadRequest("CHUNK_KEYWORD_INVALID", "too many keywords")
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+func toChunkState(ch DocChunk) ChunkState {
+	return ChunkState{
+		Content:     ch.Content,
+		ContentHash: ch.ContentHash,
+		Excluded:    ch.Excluded,
+		Keywords:    ch.Keywords,
+	}
+}
@@ -1,6 +1,7 @@
 package biz
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -8,12 +9,13 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/lang"
 	"github.com/google/uuid"
 )
 
 // ChunkingStrategy defines how to split parsed blocks into chunks.
 type ChunkingStrategy interface {
-	BuildChunks(blocks []DocumentBlock, meta DocumentMeta, docVersionID string) []DocChunk
+	BuildChunks(ctx context.Context, blocks []DocumentBlock, meta DocumentMeta, docVersionID string) ([]DocChunk, error)
 }
 
 // TokenChunker is the default chunking strategy (structure-first + token window).
@@ -22,8 +24,8 @@ type TokenChunker struct {
 	OverlapTokens int
 }
 
-func (c TokenChunker) BuildChunks(blocks []DocumentBlock, meta DocumentMeta, docVersionID string) []DocChunk {
-	return buildChunksFromBlocks(blocks, meta, docVersionID, c.MaxTokens, c.OverlapTokens)
+func (c TokenChunker) BuildChunks(_ context.Context, blocks []DocumentBlock, meta DocumentMeta, docVersionID string) ([]DocChunk, error) {
+	return buildChunksFromBlocks(blocks, meta, docVersionID, c.MaxTokens, c.OverlapTokens), nil
 }
 
 func buildChunksFromBlocks(blocks []DocumentBlock, meta DocumentMeta, docVersionID string, chunkSize, overlap int) []DocChunk {
@@ -67,6 +69,7 @@ func buildChunksFromBlocks(blocks []DocumentBlock, meta DocumentMeta, docVersion
 				state.pageNo = block.PageNo
 			}
 			state.addPart(segment)
+			state.addConfidence(block.Confidence)
 		}
 	}
 	if state.tokens > 0 {
@@ -236,25 +239,6 @@ func tailByTokens(s string, tokens int) string {
 	return strings.TrimSpace(string(runes[start:]))
 }
 
-func detectLanguage(s string) string {
-	var cjk, latin int
-	for _, r := range s {
-		switch {
-		case isCJK(r):
-			cjk++
-		case r <= unicode.MaxLatin1 && (unicode.IsLetter(r) || unicode.IsDigit(r)):
-			latin++
-		}
-	}
-	if cjk >= 10 && cjk >= latin {
-		return "zh"
-	}
-	if latin >= 10 && latin > cjk {
-		return "en"
-	}
-	return ""
-}
-
 func isCJK(r rune) bool {
 	// Common CJK Unified Ideographs blocks.
 	return (r >= 0x4E00 && r <= 0x9FFF) || (r >= 0x3400 && r <= 0x4DBF) || (r >= 0x20000 && r <= 0x2A6DF)
@@ -286,6 +270,8 @@ type chunkState struct {
 	tokens  int
 	section string
 	pageNo  int32
+	// confidence is the lowest OCR confidence of the parts; 0 means native text only.
+	confidence float32
 }
 
 func (s *chunkState) addPart(text string) {
@@ -305,6 +291,23 @@ func (s *chunkState) reset() {
 	s.tokens = 0
 	s.section = ""
 	s.pageNo = 0
+	s.confidence = 0
+}
+
+func (s *chunkState) addConfidence(confidence float32) {
+	if confidence <= 0 {
+		return
+	}
+	if s.confidence == 0 || confidence < s.confidence {
+		s.confidence = confidence
+	}
+}
+
+func (s chunkState) chunkConfidence() float32 {
+	if s.confidence <= 0 {
+		return 1
+	}
+	return s.confidence
 }
 
 func isStructureBoundary(state chunkState, block DocumentBlock) bool {
@@ -345,10 +348,11 @@ func flushChunkWithContent(out *[]DocChunk, state *chunkState, meta DocumentMeta
 		Content:     content,
 		TokenCount:  int32(estimateTokenCount(content)),
 		ContentHash: sha256Hex(content),
-		Language:    detectLanguage(content),
+		Language:    lang.Detect(content),
 		Section:     state.section,
 		PageNo:      state.pageNo,
 		SourceURI:   meta.SourceURI,
+		Confidence:  state.chunkConfidence(),
 		CreatedAt:   createdAt,
 	}
 	*out = append(*out, chunk)
@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	ChunkingStrategyToken          = "token"
+	ChunkingStrategyMarkdown       = "markdown"
+	ChunkingStrategySentenceWindow = "sentence_window"
+	ChunkingStrategySemantic       = "semantic"
+)
+
+const (
+	defaultWindowSentences     = 5
+	defaultOverlapSentences    = 1
+	defaultSimilarityThreshold = 0.75
+)
+
+// ChunkingConfig is the per knowledge base chunking strategy and its parameters.
+// Zero values fall back to the global defaults.
+type ChunkingConfig struct {
+	Strategy            string  `json:"strategy,omitempty"`
+	MaxTokens           int32   `json:"max_tokens,omitempty"`
+	OverlapTokens       int32   `json:"overlap_tokens,omitempty"`
+	WindowSentences     int32   `json:"window_sentences,omitempty"`
+	OverlapSentences    int32   `json:"overlap_sentences,omitempty"`
+	SimilarityThreshold float64 `json:"similarity_threshold,omitempty"`
+}
+
+// IsZero reports whether no chunking option is set.
+func (c ChunkingConfig) IsZero() bool {
+	return c == ChunkingConfig{}
+}
+
+func normalizeChunkingStrategy(strategy string) string {
+	value := strings.ToLower(strings.TrimSpace(strategy))
+	switch value {
+	case "", "token", "default":
+		return ChunkingStrategyToken
+	case "markdown", "md", "heading":
+		return ChunkingStrategyMarkdown
+	case "sentence_window", "sentence", "sentence-window":
+		return ChunkingStrategySentenceWindow
+	case "semantic", "embedding":
+		return ChunkingStrategySemantic
+	default:
+		return value
+	}
+}
+
+// validateChunkingConfig normalizes the strategy name and rejects invalid parameters.
+func validateChunkingConfig(cfg ChunkingConfig) (ChunkingConfig, error) {
+	if cfg.IsZero() {
+		return cfg, nil
+	}
+	cfg.Strategy = normalizeChunkingStrategy(cfg.Strategy)
+	switch cfg.Strategy {
+	case ChunkingStrategyToken, ChunkingStrategyMarkdown, ChunkingStrategySentenceWindow, ChunkingStrategySemantic:
+	default:
+		return ChunkingConfig{}, errors.BadRequest("KB_CHUNKING_STRATEGY_INVALID", fmt.Sprintf("unknown chunking strategy %q", cfg.Strategy))
+	}
+	if cfg.MaxTokens < 0 || cfg.OverlapTokens < 0 || cfg.WindowSentences < 0 || cfg.OverlapSentences < 0 {
+		return ChunkingConfig{}, errors.BadRequest("KB_CHUNKING_INVALID", "chunking parameters must not be negative")
+	}
+	if cfg.MaxTokens > 0 && cfg.OverlapTokens >= cfg.MaxTokens {
+		return ChunkingConfig{}, errors.BadRequest("KB_CHUNKING_INVALID", "overlap_tokens must be less than max_tokens")
+	}
+	if cfg.WindowSentences > 0 && cfg.OverlapSentences >= cfg.WindowSentences {
+		return ChunkingConfig{}, errors.BadRequest("KB_CHUNKING_INVALID", "overlap_sentences must be less than window_sentences")
+	}
+	if cfg.SimilarityThreshold < 0 || cfg.SimilarityThreshold >= 1 {
+		return ChunkingConfig{}, errors.BadRequest("KB_CHUNKING_INVALID", "similarity_threshold must be in [0,1)")
+	}
+	return cfg, nil
+}
+
+// resolveChunkingConfig fills unset parameters from the global ingestion options.
+func resolveChunkingConfig(cfg ChunkingConfig, opts ingestionOptions) ChunkingConfig {
+	cfg.Strategy = normalizeChunkingStrategy(cfg.Strategy)
+	if cfg.MaxTokens <= 0 {
+		cfg.MaxTokens = int32(opts.chunkSizeTokens)
+	}
+	if cfg.OverlapTokens <= 0 {
+		cfg.OverlapTokens = int32(opts.chunkOverlapTokens)
+	}
+	if cfg.OverlapTokens >= cfg.MaxTokens {
+		cfg.OverlapTokens = 0
+	}
+	switch cfg.Strategy {
+	case ChunkingStrategySentenceWindow:
+		if cfg.WindowSentences <= 0 {
+			cfg.WindowSentences = defaultWindowSentences
+		}
+		if cfg.OverlapSentences <= 0 {
+			cfg.OverlapSentences = defaultOverlapSentences
+		}
+		if cfg.OverlapSentences >= cfg.WindowSentences {
+			cfg.OverlapSentences = 0
+		}
+		cfg.SimilarityThreshold = 0
+	case ChunkingStrategySemantic:
+		if cfg.SimilarityThreshold <= 0 {
+			cfg.SimilarityThreshold = defaultSimilarityThreshold
+		}
+		cfg.WindowSentences = 0
+		cfg.OverlapSentences = 0
+	default:
+		cfg.WindowSentences = 0
+		cfg.OverlapSentences = 0
+		cfg.SimilarityThreshold = 0
+	}
+	return cfg
+}
+
+// newChunkingStrategy builds the chunker for a resolved config.
+func newChunkingStrategy(cfg ChunkingConfig, embedder provider.Provider, batchSize int) ChunkingStrategy {
+	maxTokens := int(cfg.MaxTokens)
+	overlap := int(cfg.OverlapTokens)
+	switch cfg.Strategy {
+	case ChunkingStrategyMarkdown:
+		return MarkdownChunker{MaxTokens: maxTokens, OverlapTokens: overlap}
+	case ChunkingStrategySentenceWindow:
+		return SentenceWindowChunker{
+			WindowSentences:  int(cfg.WindowSentences),
+			OverlapSentences: int(cfg.OverlapSentences),
+			MaxTokens:        maxTokens,
+		}
+	case ChunkingStrategySemantic:
+		return SemanticChunker{
+			Embedder:  embedder,
+			Threshold: cfg.SimilarityThreshold,
+			MaxTokens: maxTokens,
+			BatchSize: batchSize,
+		}
+	default:
+		return TokenChunker{MaxTokens: maxTokens, OverlapTokens: overlap}
+	}
+}
@@ -0,0 +1,240 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// MarkdownChunker keeps the heading hierarchy (H1 > H2 > H3) in Section and
+// never mixes content of different heading sections in one chunk.
+type MarkdownChunker struct {
+	MaxTokens     int
+	OverlapTokens int
+}
+
+func (c MarkdownChunker) BuildChunks(_ context.Context, blocks []DocumentBlock, meta DocumentMeta, docVersionID string) ([]DocChunk, error) {
+	withPath := make([]DocumentBlock, 0, len(blocks))
+	for _, block := range blocks {
+		if len(block.HeadingPath) > 0 {
+			block.Section = strings.Join(block.HeadingPath, headingPathSeparator)
+		}
+		withPath = append(withPath, block)
+	}
+	return buildChunksFromBlocks(withPath, meta, docVersionID, c.MaxTokens, c.OverlapTokens), nil
+}
+
+// SentenceWindowChunker emits fixed windows of sentences; neighbouring windows
+// share OverlapSentences sentences. Windows never cross section or page boundaries.
+type SentenceWindowChunker struct {
+	WindowSentences  int
+	OverlapSentences int
+	MaxTokens        int
+}
+
+func (c SentenceWindowChunker) BuildChunks(_ context.Context, blocks []DocumentBlock, meta DocumentMeta, docVersionID string) ([]DocChunk, error) {
+	maxTokens := clampInt(c.MaxTokens, 64, 8192)
+	window := c.WindowSentences
+	if window <= 0 {
+		window = defaultWindowSentences
+	}
+	overlap := c.OverlapSentences
+	if overlap < 0 || overlap >= window {
+		overlap = 0
+	}
+	out := make([]DocChunk, 0)
+	var index int32
+	for _, group := range groupSentenceUnits(blockSentenceUnits(blocks, maxTokens)) {
+		for start := 0; start < len(group); {
+			end := start + window
+			if end > len(group) {
+				end = len(group)
+			}
+			// Shrink the window until it fits the token budget.
+			for end-start > 1 && sentenceUnitTokens(group[start:end]) > maxTokens {
+				end--
+			}
+			index = emitSentenceChunk(&out, group[start:end], meta, docVersionID, index)
+			if end == len(group) {
+				break
+			}
+			next := end - overlap
+			if next <= start {
+				next = start + 1
+			}
+			start = next
+		}
+	}
+	return out, nil
+}
+
+// SemanticChunker embeds sentences and starts a new chunk where the cosine
+// similarity of adjacent sentences drops below Threshold (or MaxTokens is reached).
+type SemanticChunker struct {
+	Embedder  provider.Provider
+	Threshold float64
+	MaxTokens int
+	BatchSize int
+}
+
+func (c SemanticChunker) BuildChunks(ctx context.Context, blocks []DocumentBlock, meta DocumentMeta, docVersionID string) ([]DocChunk, error) {
+	if c.Embedder == nil {
+		return nil, errors.InternalServer("EMBEDDING_PROVIDER_MISSING", "semantic chunking needs an embedding provider")
+	}
+	maxTokens := clampInt(c.MaxTokens, 64, 8192)
+	threshold := c.Threshold
+	if threshold <= 0 {
+		threshold = defaultSimilarityThreshold
+	}
+	units := blockSentenceUnits(blocks, maxTokens)
+	texts := make([]string, 0, len(units))
+	for _, unit := range units {
+		texts = append(texts, unit.text)
+	}
+	vectors, err := embedTexts(ctx, c.Embedder, texts, c.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]DocChunk, 0)
+	var index int32
+	offset := 0
+	for _, group := range groupSentenceUnits(units) {
+		groupVectors := vectors[offset : offset+len(group)]
+		offset += len(group)
+		start := 0
+		tokens := 0
+		for i, unit := range group {
+			if i > start {
+				split := tokens+unit.tokens > maxTokens ||
+					cosineSimilarity(groupVectors[i-1], groupVectors[i]) < threshold
+				if split {
+					index = emitSentenceChunk(&out, group[start:i], meta, docVersionID, index)
+					start = i
+					tokens = 0
+				}
+			}
+			tokens += unit.tokens
+		}
+		index = emitSentenceChunk(&out, group[start:], meta, docVersionID, index)
+	}
+	return out, nil
+}
+
+// sentenceUnit is a sentence with the structure of the block it came from.
+type sentenceUnit struct {
+	text       string
+	tokens     int
+	section    string
+	pageNo     int32
+	confidence float32
+}
+
+func blockSentenceUnits(blocks []DocumentBlock, maxTokens int) []sentenceUnit {
+	units := make([]sentenceUnit, 0)
+	for _, block := range blocks {
+		section := block.Section
+		if len(block.HeadingPath) > 0 {
+			section = strings.Join(block.HeadingPath, headingPathSeparator)
+		}
+		for _, sentence := range splitBySentences(block.Text) {
+			parts := []string{sentence}
+			if estimateTokenCount(sentence) > maxTokens {
+				parts = splitByTokens(sentence, maxTokens, 0)
+			}
+			for _, part := range parts {
+				part = strings.TrimSpace(part)
+				tokens := estimateTokenCount(part)
+				if tokens == 0 {
+					continue
+				}
+				units = append(units, sentenceUnit{
+					text:       part,
+					tokens:     tokens,
+					section:    section,
+					pageNo:     block.PageNo,
+					confidence: block.Confidence,
+				})
+			}
+		}
+	}
+	return units
+}
+
+// groupSentenceUnits splits units into runs sharing section and page.
+func groupSentenceUnits(units []sentenceUnit) [][]sentenceUnit {
+	groups := make([][]sentenceUnit, 0)
+	start := 0
+	for i := 1; i <= len(units); i++ {
+		if i < len(units) && units[i].section == units[start].section && units[i].pageNo == units[start].pageNo {
+			continue
+		}
+		if i > start {
+			groups = append(groups, units[start:i])
+		}
+		start = i
+	}
+	return groups
+}
+
+func sentenceUnitTokens(units []sentenceUnit) int {
+	total := 0
+	for _, unit := range units {
+		total += unit.tokens
+	}
+	return total
+}
+
+func emitSentenceChunk(out *[]DocChunk, units []sentenceUnit, meta DocumentMeta, docVersionID string, index int32) int32 {
+	if len(units) == 0 {
+		return index
+	}
+	texts := make([]string, 0, len(units))
+	state := chunkState{section: units[0].section, pageNo: units[0].pageNo}
+	for _, unit := range units {
+		texts = append(texts, unit.text)
+		state.addConfidence(unit.confidence)
+	}
+	state.addPart(strings.Join(texts, " "))
+	return flushChunk(out, &state, meta, docVersionID, index)
+}
+
+func embedTexts(ctx context.Context, embedder provider.Provider, texts []string, batchSize int) ([][]float32, error) {
+	if batchSize <= 0 {
+		batchSize = 64
+	}
+	out := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += batchSize {
+		end := start + batchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+		vectors, err := embedder.Embed(ctx, texts[start:end])
+		if err != nil {
+			return nil, err
+		}
+		if len(vectors) != end-start {
+			return nil, errors.InternalServer("EMBEDDING_COUNT_MISMATCH", "embedding count mismatch")
+		}
+		out = append(out, vectors...)
+	}
+	return out, nil
+}
+
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
@@ -0,0 +1,524 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/access"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Duplicate detection modes of a knowledge base.
+const (
+	// DedupModeFlag records near-duplicate chunks but indexes them (default).
+	DedupModeFlag = "flag"
+	// DedupModeSkip does not index chunks that duplicate an existing ready
+	// chunk of another document.
+	DedupModeSkip = "skip"
+	DedupModeOff  = "off"
+)
+
+// Duplicate match methods, strongest first.
+const (
+	DuplicateMethodExact     = "exact"
+	DuplicateMethodSimHash   = "simhash"
+	DuplicateMethodEmbedding = "embedding"
+)
+
+const (
+	defaultDedupMaxDistance         = 3
+	defaultDedupSimilarityThreshold = 0.95
+	// embeddingCheckDistance bounds the SimHash distance of candidates whose
+	// embeddings are compared.
+	embeddingCheckDistance = 18
+	// documentDuplicateRatio is the share of a document's chunks that must
+	// duplicate one other document for the documents to be reported together.
+	documentDuplicateRatio = 0.8
+	duplicatePreviewRunes  = 200
+	// simhashBands splits the 64-bit SimHash into 16-bit bands; chunks within
+	// distance 3 share at least one band exactly.
+	simhashBands = 4
+)
+
+// DedupConfig is the near-duplicate policy of a knowledge base. Zero values
+// fall back to the defaults.
+type DedupConfig struct {
+	Mode string `json:"mode,omitempty"`
+	// MaxDistance is the largest SimHash Hamming distance of a near-duplicate.
+	MaxDistance int32 `json:"max_distance,omitempty"`
+	// SimilarityThreshold is the embedding cosine similarity from which two
+	// chunks are duplicates.
+	SimilarityThreshold float64 `json:"similarity_threshold,omitempty"`
+}
+
+// IsZero reports whether no dedup option is set.
+func (c DedupConfig) IsZero() bool {
+	return c == DedupConfig{}
+}
+
+func validateDedupConfig(cfg DedupConfig) (DedupConfig, error) {
+	if cfg.IsZero() {
+		return cfg, nil
+	}
+	cfg.Mode = strings.ToLower(strings.TrimSpace(cfg.Mode))
+	switch cfg.Mode {
+	case "":
+		cfg.Mode = DedupModeFlag
+	case DedupModeFlag, DedupModeSkip, DedupModeOff:
+	default:
+		return DedupConfig{}, errors.BadRequest("KB_DEDUP_MODE_INVALID", fmt.Sprintf("unknown dedup mode %q", cfg.Mode))
+	}
+	if cfg.MaxDistance < 0 || cfg.MaxDistance > 16 {
+		return DedupConfig{}, errors.BadRequest("KB_DEDUP_INVALID", "max_distance must be in [0,16]")
+	}
+	if cfg.SimilarityThreshold < 0 || cfg.SimilarityThreshold > 1 {
+		return DedupConfig{}, errors.BadRequest("KB_DEDUP_INVALID", "similarity_threshold must be in [0,1]")
+	}
+	return cfg, nil
+}
+
+func resolveDedupConfig(cfg DedupConfig) DedupConfig {
+	if cfg.Mode == "" {
+		cfg.Mode = DedupModeFlag
+	}
+	if cfg.MaxDistance <= 0 {
+		cfg.MaxDistance = defaultDedupMaxDistance
+	}
+	if cfg.SimilarityThreshold <= 0 {
+		cfg.SimilarityThreshold = defaultDedupSimilarityThreshold
+	}
+	return cfg
+}
+
+// ChunkDuplicate links a chunk to the ready chunk of another document it
+// duplicates.
+type ChunkDuplicate struct {
+	ID                  string
+	TenantID            string
+	KBID                string
+	DocumentID          string
+	DocumentVersionID   string
+	ChunkID             string
+	ChunkIndex          int32
+	ContentHash         string
+	ContentPreview      string
+	DuplicateChunkID    string
+	DuplicateDocumentID string
+	Method              string
+	Distance            int32
+	Similarity          float64
+	// Skipped chunks were not indexed.
+	Skipped   bool
+	CreatedAt time.Time
+
+	// Set by ListChunkDuplicates.
+	DocumentTitle           string
+	DuplicateDocumentTitle  string
+	DuplicateContentPreview string
+	// OriginalMissing means the duplicated chunk no longer exists, e.g. its
+	// document was reindexed or deleted.
+	OriginalMissing bool
+}
+
+// DuplicateCandidate is an indexed chunk that may duplicate a new chunk.
+type DuplicateCandidate struct {
+	ChunkID     string
+	DocumentID  string
+	ContentHash string
+	SimHash     uint64
+	// AccessLabels are the labels of the candidate's document.
+	AccessLabels []string
+}
+
+// DocumentDuplicatePair counts the chunks of a document that duplicate one
+// other document.
+type DocumentDuplicatePair struct {
+	DocumentID             string
+	DocumentTitle          string
+	DuplicateDocumentID    string
+	DuplicateDocumentTitle string
+	DuplicateChunks        int32
+	TotalChunks            int32
+}
+
+// DuplicateCluster is a group of chunks linked by duplicate detection.
+type DuplicateCluster struct {
+	Chunks []ChunkDuplicateMember
+}
+
+// ChunkDuplicateMember is one chunk of a duplicate cluster.
+type ChunkDuplicateMember struct {
+	ChunkID        string
+	DocumentID     string
+	DocumentTitle  string
+	ContentPreview string
+	Method         string
+	Skipped        bool
+	// OriginalMissing is set on skipped chunks whose original is gone;
+	// reindex their document to index them again.
+	OriginalMissing bool
+}
+
+// DocumentDuplicateCluster is a group of documents that mostly duplicate each
+// other.
+type DocumentDuplicateCluster struct {
+	Pairs []DocumentDuplicatePair
+}
+
+// DuplicateReport lists the duplicate clusters of a knowledge base.
+type DuplicateReport struct {
+	KBID             string
+	Links            int32
+	SkippedChunks    int32
+	ChunkClusters    []DuplicateCluster
+	DocumentClusters []DocumentDuplicateCluster
+}
+
+// GetDuplicateReport groups the recorded duplicate links of a knowledge base
+// into chunk and document clusters, largest first.
+func (uc *KnowledgeUsecase) GetDuplicateReport(ctx context.Context, kbID string, limit int) (DuplicateReport, error) {
+	kbID = strings.TrimSpace(kbID)
+	if kbID == "" {
+		return DuplicateReport{}, errors.BadRequest("KB_ID_MISSING", "knowledge base id missing")
+	}
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if _, err := uc.repo.GetKnowledgeBase(ctx, kbID); err != nil {
+		return DuplicateReport{}, err
+	}
+	links, err := uc.repo.ListChunkDuplicates(ctx, kbID)
+	if err != nil {
+		return DuplicateReport{}, err
+	}
+	pairs, err := uc.repo.ListDocumentDuplicatePairs(ctx, kbID)
+	if err != nil {
+		return DuplicateReport{}, err
+	}
+	report := DuplicateReport{KBID: kbID, Links: int32(len(links))}
+
+	members := make(map[string]ChunkDuplicateMember, len(links)*2)
+	uf := newUnionFind()
+	for _, link := range links {
+		if link.Skipped {
+			report.SkippedChunks++
+		}
+		key := link.ChunkID
+		members[key] = ChunkDuplicateMember{
+			ChunkID:         link.ChunkID,
+			DocumentID:      link.DocumentID,
+			DocumentTitle:   link.DocumentTitle,
+			ContentPreview:  link.ContentPreview,
+			Method:          link.Method,
+			Skipped:         link.Skipped,
+			OriginalMissing: link.Skipped && link.OriginalMissing,
+		}
+		if _, ok := members[link.DuplicateChunkID]; !ok {
+			members[link.DuplicateChunkID] = ChunkDuplicateMember{
+				ChunkID:        link.DuplicateChunkID,
+				DocumentID:     link.DuplicateDocumentID,
+				DocumentTitle:  link.DuplicateDocumentTitle,
+				ContentPreview: link.DuplicateContentPreview,
+			}
+		}
+		uf.union(key, link.DuplicateChunkID)
+	}
+	for _, group := range uf.groups(limit) {
+		cluster := DuplicateCluster{Chunks: make([]ChunkDuplicateMember, 0, len(group))}
+		for _, id := range group {
+			cluster.Chunks = append(cluster.Chunks, members[id])
+		}
+		report.ChunkClusters = append(report.ChunkClusters, cluster)
+	}
+
+	docUF := newUnionFind()
+	byDocument := make(map[string][]DocumentDuplicatePair)
+	for _, pair := range pairs {
+		if pair.TotalChunks <= 0 || float64(pair.DuplicateChunks)/float64(pair.TotalChunks) < documentDuplicateRatio {
+			continue
+		}
+		docUF.union(pair.DocumentID, pair.DuplicateDocumentID)
+		byDocument[pair.DocumentID] = append(byDocument[pair.DocumentID], pair)
+	}
+	for _, group := range docUF.groups(limit) {
+		cluster := DocumentDuplicateCluster{}
+		for _, id := range group {
+			cluster.Pairs = append(cluster.Pairs, byDocument[id]...)
+		}
+		report.DocumentClusters = append(report.DocumentClusters, cluster)
+	}
+	return report, nil
+}
+
+// detectDuplicates compares the chunks of a new version with the ready chunks
+// of the other documents of the knowledge base in the same collection. It
+// returns the chunks to index and the duplicate links; detection failures are
+// logged and index every chunk. A chunk is only skipped as a duplicate of a
+// document with the same access labels, so skipping never hides content from
+// end users entitled to the new document but not to the other one.
+func (uc *KnowledgeUsecase) detectDuplicates(ctx context.Context, kb KnowledgeBase, job IngestionJob, collectionKey string, embedded []EmbeddedChunk) ([]EmbeddedChunk, []ChunkDuplicate) {
+	for i := range embedded {
+		embedded[i].Chunk.SimHash = simHash(embedded[i].Chunk.Content)
+	}
+	cfg := resolveDedupConfig(kb.Dedup)
+	if cfg.Mode == DedupModeOff || kb.ID == "" || len(embedded) == 0 {
+		return embedded, nil
+	}
+	hashes := make([]string, 0, len(embedded))
+	simhashes := make([]uint64, 0, len(embedded))
+	for _, item := range embedded {
+		hashes = append(hashes, item.Chunk.ContentHash)
+		simhashes = append(simhashes, item.Chunk.SimHash)
+	}
+	candidates, err := uc.repo.FindDuplicateCandidates(ctx, kb.ID, job.DocumentID, collectionKey, hashes, simhashes)
+	if err != nil {
+		if uc.log != nil {
+			uc.log.Warnf("duplicate lookup failed: document=%s err=%v", job.DocumentID, err)
+		}
+		return embedded, nil
+	}
+	if len(candidates) == 0 {
+		return embedded, nil
+	}
+	doc, err := uc.repo.GetDocument(ctx, job.DocumentID)
+	if err != nil {
+		if uc.log != nil {
+			uc.log.Warnf("duplicate lookup failed: document=%s err=%v", job.DocumentID, err)
+		}
+		return embedded, nil
+	}
+	// Candidates with the same labels go first so they win ties.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return access.SameLabels(doc.AccessLabels, candidates[i].AccessLabels) && !access.SameLabels(doc.AccessLabels, candidates[j].AccessLabels)
+	})
+
+	type match struct {
+		candidate DuplicateCandidate
+		method    string
+		distance  int
+		sim       float64
+	}
+	matches := make([]*match, len(embedded))
+	pending := make(map[int][]DuplicateCandidate)
+	vectorIDs := make([]string, 0)
+	for i, item := range embedded {
+		ch := item.Chunk
+		var best *match
+		var near []DuplicateCandidate
+		for _, cand := range candidates {
+			distance := bits.OnesCount64(ch.SimHash ^ cand.SimHash)
+			switch {
+			case ch.ContentHash != "" && cand.ContentHash == ch.ContentHash:
+				best = &match{candidate: cand, method: DuplicateMethodExact, sim: 1}
+			case distance <= int(cfg.MaxDistance):
+				if best == nil || (best.method == DuplicateMethodSimHash && distance < best.distance) {
+					best = &match{candidate: cand, method: DuplicateMethodSimHash, distance: distance}
+				}
+			case distance <= embeddingCheckDistance:
+				near = append(near, cand)
+			}
+			if best != nil && best.method == DuplicateMethodExact {
+				break
+			}
+		}
+		if best != nil {
+			matches[i] = best
+			continue
+		}
+		if len(near) > 0 && len(item.Vector) > 0 {
+			pending[i] = near
+			for _, cand := range near {
+				vectorIDs = append(vectorIDs, cand.ChunkID)
+			}
+		}
+	}
+	if len(pending) > 0 {
+		vectors, err := uc.repo.GetChunkVectors(ctx, collectionKey, vectorIDs)
+		if err != nil && uc.log != nil {
+			uc.log.Warnf("duplicate vector lookup failed: document=%s err=%v", job.DocumentID, err)
+		}
+		for i, near := range pending {
+			for _, cand := range near {
+				sim := cosineSimilarity(embedded[i].Vector, vectors[cand.ChunkID])
+				if sim < cfg.SimilarityThreshold || (matches[i] != nil && sim <= matches[i].sim) {
+					continue
+				}
+				matches[i] = &match{
+					candidate: cand,
+					method:    DuplicateMethodEmbedding,
+					distance:  bits.OnesCount64(embedded[i].Chunk.SimHash ^ cand.SimHash),
+					sim:       sim,
+				}
+			}
+		}
+	}
+
+	kept := make([]EmbeddedChunk, 0, len(embedded))
+	links := make([]ChunkDuplicate, 0)
+	for i, item := range embedded {
+		m := matches[i]
+		if m == nil {
+			kept = append(kept, item)
+			continue
+		}
+		skip := cfg.Mode == DedupModeSkip && access.SameLabels(doc.AccessLabels, m.candidate.AccessLabels)
+		links = append(links, ChunkDuplicate{
+			KBID:                kb.ID,
+			DocumentID:          job.DocumentID,
+			DocumentVersionID:   job.DocumentVersionID,
+			ChunkID:             item.Chunk.ID,
+			ChunkIndex:          item.Chunk.ChunkIndex,
+			ContentHash:         item.Chunk.ContentHash,
+			ContentPreview:      contentPreview(item.Chunk.Content),
+			DuplicateChunkID:    m.candidate.ChunkID,
+			DuplicateDocumentID: m.candidate.DocumentID,
+			Method:              m.method,
+			Distance:            int32(m.distance),
+			Similarity:          m.sim,
+			Skipped:             skip,
+		})
+		if !skip {
+			kept = append(kept, item)
+		}
+	}
+	if uc.log != nil && len(links) > 0 {
+		uc.log.Infof("ingestion duplicates tenant=%s document=%s version=%s duplicates=%d skipped=%d",
+			job.TenantID, job.DocumentID, job.DocumentVersionID, len(links), len(embedded)-len(kept))
+	}
+	return kept, links
+}
+
+// simHash is the 64-bit SimHash of the words and word bigrams of text. CJK
+// characters count as words.
+func simHash(text string) uint64 {
+	tokens := simHashTokens(text)
+	if len(tokens) == 0 {
+		return 0
+	}
+	var weights [64]int
+	add := func(feature string) {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(feature))
+		sum := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<uint(bit)) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+	for i, token := range tokens {
+		add(token)
+		if i > 0 {
+			add(tokens[i-1] + " " + token)
+		}
+	}
+	var out uint64
+	for bit := 0; bit < 64; bit++ {
+		if weights[bit] > 0 {
+			out |= 1 << uint(bit)
+		}
+	}
+	return out
+}
+
+func simHashTokens(text string) []string {
+	tokens := make([]string, 0)
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// SimHashBands splits h into the bands used to find near-duplicate candidates.
+func SimHashBands(h uint64) [simhashBands]uint16 {
+	var out [simhashBands]uint16
+	for i := range out {
+		out[i] = uint16(h >> (16 * uint(i)))
+	}
+	return out
+}
+
+func contentPreview(content string) string {
+	runes := []rune(strings.Join(strings.Fields(content), " "))
+	if len(runes) > duplicatePreviewRunes {
+		runes = runes[:duplicatePreviewRunes]
+	}
+	return string(runes)
+}
+
+// unionFind groups string keys, remembering insertion order.
+type unionFind struct {
+	parent map[string]string
+	order  []string
+}
+
+func newUnionFind() *unionFind {
+	return &unionFind{parent: make(map[string]string)}
+}
+
+func (u *unionFind) find(key string) string {
+	if _, ok := u.parent[key]; !ok {
+		u.parent[key] = key
+		u.order = append(u.order, key)
+	}
+	for u.parent[key] != key {
+		u.parent[key] = u.parent[u.parent[key]]
+		key = u.parent[key]
+	}
+	return key
+}
+
+func (u *unionFind) union(a, b string) {
+	ra, rb := u.find(a), u.find(b)
+	if ra != rb {
+		u.parent[rb] = ra
+	}
+}
+
+// groups returns up to limit groups of two or more keys, largest first.
+func (u *unionFind) groups(limit int) [][]string {
+	byRoot := make(map[string][]string)
+	roots := make([]string, 0)
+	for _, key := range u.order {
+		root := u.find(key)
+		if _, ok := byRoot[root]; !ok {
+			roots = append(roots, root)
+		}
+		byRoot[root] = append(byRoot[root], key)
+	}
+	out := make([][]string, 0, len(roots))
+	for _, root := range roots {
+		if len(byRoot[root]) > 1 {
+			out = append(out, byRoot[root])
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return len(out[i]) > len(out[j]) })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
@@ -2,13 +2,62 @@ package biz
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
 	"github.com/go-kratos/kratos/v2/errors"
 )
 
+// EmbeddingConfig is the embedding model of a knowledge base. The zero value
+// means the deployment default (data.knowledge.embedding); endpoint and API key
+// are always taken from the deployment config.
+type EmbeddingConfig struct {
+	Provider string `json:"provider,omitempty"`
+	Model    string `json:"model,omitempty"`
+	Dim      int32  `json:"dim,omitempty"`
+}
+
+// IsZero reports whether the knowledge base uses the default embedding model.
+func (c EmbeddingConfig) IsZero() bool {
+	return strings.TrimSpace(c.Provider) == "" && strings.TrimSpace(c.Model) == "" && c.Dim == 0
+}
+
+func validateEmbeddingConfig(cfg EmbeddingConfig) (EmbeddingConfig, error) {
+	cfg.Provider = strings.ToLower(strings.TrimSpace(cfg.Provider))
+	cfg.Model = strings.TrimSpace(cfg.Model)
+	if cfg.IsZero() {
+		return EmbeddingConfig{}, nil
+	}
+	if cfg.Model == "" {
+		return EmbeddingConfig{}, errors.BadRequest("KB_EMBEDDING_MODEL_MISSING", "embedding model missing")
+	}
+	if cfg.Dim < 0 {
+		return EmbeddingConfig{}, errors.BadRequest("KB_EMBEDDING_DIM_INVALID", "embedding dim must not be negative")
+	}
+	return cfg, nil
+}
+
+// withEmbedding returns the ingestion options with the knowledge base embedding applied.
+func (opts ingestionOptions) withEmbedding(cfg EmbeddingConfig) ingestionOptions {
+	if cfg.IsZero() {
+		return opts
+	}
+	if cfg.Provider != "" {
+		opts.embeddingProvider = cfg.Provider
+	}
+	opts.embeddingModel = cfg.Model
+	opts.embeddingDim = int(cfg.Dim)
+	return opts
+}
+
 func newEmbeddingProvider(opts ingestionOptions) provider.Provider {
-	cfg := provider.Config{
+	return provider.NewProvider(embeddingProviderConfig(opts))
+}
+
+func embeddingProviderConfig(opts ingestionOptions) provider.Config {
+	return provider.Config{
 		Provider:  opts.embeddingProvider,
 		Endpoint:  opts.embeddingEndpoint,
 		APIKey:    opts.embeddingAPIKey,
@@ -17,47 +66,167 @@ func newEmbeddingProvider(opts ingestionOptions) provider.Provider {
 		TimeoutMs: opts.embeddingTimeoutMs,
 		Proxy:     opts.proxy,
 	}
-	return provider.NewProvider(cfg)
 }
 
-func (uc *KnowledgeUsecase) embedChunks(ctx context.Context, chunks []DocChunk) ([]EmbeddedChunk, error) {
+func embeddingCacheKey(opts ingestionOptions) string {
+	return fmt.Sprintf("%s|%s|%d", strings.ToLower(opts.embeddingProvider), opts.embeddingModel, opts.embeddingDim)
+}
+
+// embedderFor returns the embedding provider of a knowledge base. Providers are
+// cached per (provider, model, dim) because they learn their dimension lazily.
+func (uc *KnowledgeUsecase) embedderFor(cfg EmbeddingConfig) provider.Provider {
+	if cfg.IsZero() && uc.embedder != nil {
+		return uc.embedder
+	}
+	opts := uc.options.withEmbedding(cfg)
+	key := embeddingCacheKey(opts)
+	uc.embeddersMu.Lock()
+	defer uc.embeddersMu.Unlock()
+	if uc.embedders == nil {
+		uc.embedders = make(map[string]provider.Provider)
+	}
+	if cached, ok := uc.embedders[key]; ok {
+		return cached
+	}
+	embedder := newEmbeddingProvider(opts)
+	uc.embedders[key] = embedder
+	return embedder
+}
+
+// tenantEmbedderFor is embedderFor using the API key of the tenant in
+// context when it brought one for the provider. It names the same model as
+// embedderFor, so collection keys do not depend on whose key embedded.
+func (uc *KnowledgeUsecase) tenantEmbedderFor(ctx context.Context, cfg EmbeddingConfig) (provider.Provider, error) {
+	tenantID, _ := tenant.TenantID(ctx)
+	embedder, ok, err := uc.tenantClients.Embedder(ctx, tenantID, embeddingProviderConfig(uc.options.withEmbedding(cfg)))
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return embedder, nil
+	}
+	return uc.embedderFor(cfg), nil
+}
+
+// vectorCollectionKey selects the vector collection of a knowledge base: empty
+// for the deployment default, otherwise one collection per (model, dim). Index
+// generations (see KB migrations) get their own collection.
+func vectorCollectionKey(cfg EmbeddingConfig, model string, dim int, generation int32) string {
+	key := ""
+	if !cfg.IsZero() {
+		key = provider.CollectionKey(model, dim)
+	}
+	return provider.GenerationCollectionKey(key, generation)
+}
+
+// EmbeddingText is the text embedded for the chunk: its content followed by
+// its pinned keywords.
+func (ch DocChunk) EmbeddingText() string {
+	if len(ch.Keywords) == 0 {
+		return ch.Content
+	}
+	return ch.Content + "\n\n" + strings.Join(ch.Keywords, ", ")
+}
+
+// EmbeddingHash identifies the embedded text; it is the content hash unless
+// keywords are pinned, so keyword vectors are never reused for bare content.
+func (ch DocChunk) EmbeddingHash() string {
+	if len(ch.Keywords) == 0 {
+		return ch.ContentHash
+	}
+	return sha256Hex(ch.EmbeddingText())
+}
+
+// EmbeddingStats counts the chunks of a version whose vector was reused from
+// an already indexed chunk with the same content hash and the chunks sent to
+// the embedding provider.
+type EmbeddingStats struct {
+	Reused   int
+	Embedded int
+}
+
+// embedChunks embeds the chunks of a version. Chunks whose content hash was
+// already embedded with the same model in the tenant reuse that vector, so
+// re-ingesting an edited document only embeds new or changed chunks.
+func (uc *KnowledgeUsecase) embedChunks(ctx context.Context, embedder provider.Provider, chunks []DocChunk) ([]EmbeddedChunk, EmbeddingStats, error) {
+	var stats EmbeddingStats
 	if len(chunks) == 0 {
-		return nil, nil
+		return nil, stats, nil
 	}
-	if uc.embedder == nil {
-		uc.embedder = provider.NewProvider(provider.Config{
+	if embedder == nil {
+		embedder = provider.NewProvider(provider.Config{
 			Provider: defaultEmbeddingProvider,
 			Model:    defaultEmbeddingModel,
 			Dim:      defaultEmbeddingDim,
 		})
 	}
-	batchSize := uc.embeddingBatchSize
-	if batchSize <= 0 {
-		batchSize = 64
-	}
-	out := make([]EmbeddedChunk, 0, len(chunks))
-	for start := 0; start < len(chunks); start += batchSize {
-		end := start + batchSize
-		if end > len(chunks) {
-			end = len(chunks)
+	reusable := uc.reusableVectors(ctx, embedder, chunks)
+	vectors := make([][]float32, len(chunks))
+	pending := make([]int, 0, len(chunks))
+	for i, ch := range chunks {
+		if vec, ok := reusable[ch.EmbeddingHash()]; ok {
+			vectors[i] = vec
+			stats.Reused++
+			continue
 		}
-		texts := make([]string, 0, end-start)
-		for _, ch := range chunks[start:end] {
-			texts = append(texts, ch.Content)
+		pending = append(pending, i)
+	}
+	if len(pending) > 0 {
+		texts := make([]string, 0, len(pending))
+		for _, i := range pending {
+			texts = append(texts, chunks[i].EmbeddingText())
 		}
-		vectors, err := uc.embedder.Embed(ctx, texts)
+		fresh, err := embedTexts(ctx, embedder, texts, uc.embeddingBatchSize)
 		if err != nil {
-			return nil, err
+			return nil, stats, err
 		}
-		if len(vectors) != len(texts) {
-			return nil, errors.InternalServer("EMBEDDING_COUNT_MISMATCH", "embedding count mismatch")
+		for j, i := range pending {
+			vectors[i] = fresh[j]
 		}
-		for i, ch := range chunks[start:end] {
-			out = append(out, EmbeddedChunk{
-				Chunk:  ch,
-				Vector: vectors[i],
-			})
+		stats.Embedded = len(pending)
+	}
+	out := make([]EmbeddedChunk, 0, len(chunks))
+	for i, ch := range chunks {
+		out = append(out, EmbeddedChunk{
+			Chunk:  ch,
+			Vector: vectors[i],
+		})
+	}
+	return out, stats, nil
+}
+
+// reusableVectors looks up vectors already stored for the embedding hashes
+// of chunks. Lookup failures only cost a fresh embedding, so they are logged and
+// an empty result is returned.
+func (uc *KnowledgeUsecase) reusableVectors(ctx context.Context, embedder provider.Provider, chunks []DocChunk) map[string][]float32 {
+	hashes := make([]string, 0, len(chunks))
+	seen := make(map[string]struct{}, len(chunks))
+	for _, ch := range chunks {
+		hash := ch.EmbeddingHash()
+		if hash == "" {
+			continue
+		}
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+		hashes = append(hashes, hash)
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+	found, err := uc.repo.FindReusableEmbeddings(ctx, embedder.Model(), hashes)
+	if err != nil {
+		if uc.log != nil {
+			uc.log.Warnf("embedding reuse lookup failed: model=%s err=%v", embedder.Model(), err)
+		}
+		return nil
+	}
+	dim := embedder.Dim()
+	for hash, vec := range found {
+		if len(vec) == 0 || (dim > 0 && len(vec) != dim) {
+			delete(found, hash)
 		}
 	}
-	return out, nil
+	return found
 }
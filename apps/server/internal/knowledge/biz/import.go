@@ -0,0 +1,251 @@
+package biz
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Folder modes of a bulk import: how the folder path of an archive entry is
+// kept on its document.
+const (
+	ImportFolderModeNone = "none"
+	// ImportFolderModeTags turns every folder of the path into a document tag.
+	ImportFolderModeTags = "tags"
+	// ImportFolderModeSection prefixes the section of every chunk with the
+	// folder path ("a > b").
+	ImportFolderModeSection = "section"
+)
+
+// Import batch item statuses.
+const (
+	ImportItemStatusCreated = "created"
+	ImportItemStatusFailed  = "failed"
+	ImportItemStatusSkipped = "skipped"
+)
+
+// Import batch statuses, derived from the items and their documents.
+const (
+	ImportBatchStatusProcessing = "processing"
+	ImportBatchStatusCompleted  = "completed"
+	// ImportBatchStatusPartial means some files were skipped or failed.
+	ImportBatchStatusPartial = "partial"
+	// ImportBatchStatusFailed means no document of the batch was indexed.
+	ImportBatchStatusFailed = "failed"
+)
+
+// ImportFile is one file of a bulk import.
+type ImportFile struct {
+	// Path is the slash-separated path inside the archive, or the file name.
+	Path        string
+	SourceType  string
+	ContentType string
+	Payload     []byte
+	// SkipReason is set when the entry was rejected while reading the upload.
+	SkipReason string
+}
+
+// ImportBatch is a bulk import of files into a knowledge base.
+type ImportBatch struct {
+	ID         string
+	TenantID   string
+	KBID       string
+	FolderMode string
+	// Source is "zip", "files" or "kb_archive".
+	Source     string
+	TotalFiles int32
+	CreatedAt  time.Time
+
+	// Aggregates derived from Items.
+	Status              string
+	SkippedFiles        int32
+	FailedFiles         int32
+	DocumentsProcessing int32
+	DocumentsReady      int32
+	DocumentsFailed     int32
+	Items               []ImportBatchItem
+}
+
+// ImportBatchItem is the outcome of one file of an import batch.
+type ImportBatchItem struct {
+	ID          string
+	TenantID    string
+	BatchID     string
+	Path        string
+	SourceType  string
+	DocumentID  string
+	Status      string
+	ErrorReason string
+	CreatedAt   time.Time
+	// DocumentStatus is the current status of the created document; empty
+	// once the document is deleted.
+	DocumentStatus string
+}
+
+// ImportDocuments creates one document per file through UploadDocumentFile
+// and records the outcome of every file in a batch. A failing file does not
+// stop the batch.
+func (uc *KnowledgeUsecase) ImportDocuments(ctx context.Context, kbID string, folderMode string, source string, files []ImportFile, accessLabels []string) (ImportBatch, error) {
+	kbID = strings.TrimSpace(kbID)
+	if kbID == "" {
+		return ImportBatch{}, errors.BadRequest("KB_ID_MISSING", "kb_id missing")
+	}
+	folderMode = strings.ToLower(strings.TrimSpace(folderMode))
+	switch folderMode {
+	case "":
+		folderMode = ImportFolderModeNone
+	case ImportFolderModeNone, ImportFolderModeTags, ImportFolderModeSection:
+	default:
+		return ImportBatch{}, errors.BadRequest("IMPORT_FOLDER_MODE_INVALID", "folder_mode must be none, tags or section")
+	}
+	if len(files) == 0 {
+		return ImportBatch{}, errors.BadRequest("DOC_UPLOAD_EMPTY", "no files provided")
+	}
+	accessLabels, err := normalizeAccessLabels(accessLabels)
+	if err != nil {
+		return ImportBatch{}, err
+	}
+	if _, err := uc.repo.GetKnowledgeBase(ctx, kbID); err != nil {
+		return ImportBatch{}, err
+	}
+	if err := uc.requireNoMigration(ctx, kbID, true); err != nil {
+		return ImportBatch{}, err
+	}
+	batch, err := uc.repo.CreateImportBatch(ctx, ImportBatch{
+		KBID:       kbID,
+		FolderMode: folderMode,
+		Source:     source,
+		TotalFiles: int32(len(files)),
+	})
+	if err != nil {
+		return ImportBatch{}, err
+	}
+	for _, f := range files {
+		item := ImportBatchItem{
+			BatchID:    batch.ID,
+			Path:       f.Path,
+			SourceType: f.SourceType,
+		}
+		if f.SkipReason != "" {
+			item.Status = ImportItemStatusSkipped
+			item.ErrorReason = f.SkipReason
+		} else {
+			labels := folderLabels(folderMode, f.Path)
+			labels.AccessLabels = accessLabels
+			doc, _, err := uc.UploadDocumentFile(ctx, kbID, "", f.SourceType, path.Base(f.Path), f.Payload, f.ContentType, labels)
+			if err != nil {
+				item.Status = ImportItemStatusFailed
+				item.ErrorReason = err.Error()
+			} else {
+				item.Status = ImportItemStatusCreated
+				item.DocumentID = doc.ID
+			}
+		}
+		if err := uc.repo.CreateImportBatchItem(ctx, item); err != nil {
+			return ImportBatch{}, err
+		}
+	}
+	if uc.log != nil {
+		uc.log.Infof("import batch created: batch=%s kb=%s files=%d", batch.ID, kbID, len(files))
+	}
+	return uc.GetImportBatch(ctx, batch.ID)
+}
+
+// ImportBatchKnowledgeBaseID returns the knowledge base an import batch
+// writes to.
+func (uc *KnowledgeUsecase) ImportBatchKnowledgeBaseID(ctx context.Context, id string) (string, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return "", errors.BadRequest("IMPORT_BATCH_ID_MISSING", "import batch id missing")
+	}
+	batch, err := uc.repo.GetImportBatch(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return batch.KBID, nil
+}
+
+// GetImportBatch returns a batch with its items and the aggregate indexing
+// progress of its documents.
+func (uc *KnowledgeUsecase) GetImportBatch(ctx context.Context, id string) (ImportBatch, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ImportBatch{}, errors.BadRequest("IMPORT_BATCH_ID_MISSING", "import batch id missing")
+	}
+	batch, err := uc.repo.GetImportBatch(ctx, id)
+	if err != nil {
+		return ImportBatch{}, err
+	}
+	items, err := uc.repo.ListImportBatchItems(ctx, id)
+	if err != nil {
+		return ImportBatch{}, err
+	}
+	batch.Items = items
+	for _, item := range items {
+		switch item.Status {
+		case ImportItemStatusSkipped:
+			batch.SkippedFiles++
+		case ImportItemStatusFailed:
+			batch.FailedFiles++
+		case ImportItemStatusCreated:
+			switch item.DocumentStatus {
+			case DocumentStatusReady:
+				batch.DocumentsReady++
+			case DocumentStatusFailed:
+				batch.DocumentsFailed++
+			case "":
+			default:
+				batch.DocumentsProcessing++
+			}
+		}
+	}
+	switch {
+	case batch.DocumentsProcessing > 0 || int32(len(items)) < batch.TotalFiles:
+		batch.Status = ImportBatchStatusProcessing
+	case batch.DocumentsReady == 0:
+		batch.Status = ImportBatchStatusFailed
+	case batch.SkippedFiles+batch.FailedFiles+batch.DocumentsFailed > 0:
+		batch.Status = ImportBatchStatusPartial
+	default:
+		batch.Status = ImportBatchStatusCompleted
+	}
+	return batch, nil
+}
+
+// folderLabels maps the folder path of an import entry to document labels.
+func folderLabels(mode string, filePath string) DocumentLabels {
+	dir := path.Dir(filePath)
+	if dir == "." || dir == "/" {
+		return DocumentLabels{}
+	}
+	folders := make([]string, 0)
+	for _, folder := range strings.Split(dir, "/") {
+		if folder = strings.TrimSpace(folder); folder != "" {
+			folders = append(folders, folder)
+		}
+	}
+	switch mode {
+	case ImportFolderModeTags:
+		seen := make(map[string]struct{}, len(folders))
+		tags := make([]string, 0, len(folders))
+		for _, folder := range folders {
+			if _, ok := seen[folder]; ok {
+				continue
+			}
+			seen[folder] = struct{}{}
+			tags = append(tags, folder)
+		}
+		return DocumentLabels{Tags: tags}
+	case ImportFolderModeSection:
+		prefix := []rune(strings.Join(folders, headingPathSeparator))
+		if len(prefix) > maxSectionRunes {
+			prefix = prefix[:maxSectionRunes]
+		}
+		return DocumentLabels{SectionPrefix: string(prefix)}
+	default:
+		return DocumentLabels{}
+	}
+}
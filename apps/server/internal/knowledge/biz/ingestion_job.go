@@ -0,0 +1,278 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Ingestion job statuses.
+const (
+	IngestionJobStatusQueued    = "queued"
+	IngestionJobStatusRunning   = "running"
+	IngestionJobStatusRetrying  = "retrying"
+	IngestionJobStatusSucceeded = "succeeded"
+	IngestionJobStatusFailed    = "failed"
+	// IngestionJobStatusDead means the queue gave up and moved the job to its
+	// dead-letter queue.
+	IngestionJobStatusDead      = "dead"
+	IngestionJobStatusCancelled = "cancelled"
+)
+
+// Ingestion steps, in execution order.
+const (
+	IngestionStepLoad  = "load"
+	IngestionStepChunk = "chunk"
+	IngestionStepEmbed = "embed"
+	IngestionStepIndex = "index"
+)
+
+var errIngestionCancelled = errors.Conflict("INGESTION_JOB_CANCELLED", "ingestion job cancelled")
+
+// IngestionJobRecord is the persisted state of an ingestion job.
+type IngestionJobRecord struct {
+	ID                string
+	TenantID          string
+	KBID              string
+	DocumentID        string
+	DocumentVersionID string
+	FallbackVersion   int32
+	Status            string
+	CurrentStep       string
+	Attempts          int32
+	ChunkCount        int32
+	ReusedChunks      int32
+	EmbeddedChunks    int32
+	// StepDurations holds the duration in ms of each finished step of the
+	// latest attempt.
+	StepDurations map[string]int64
+	ErrorReason   string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	StartedAt     *time.Time
+	FinishedAt    *time.Time
+}
+
+// Job rebuilds the queue message of the record.
+func (r IngestionJobRecord) Job() IngestionJob {
+	return IngestionJob{
+		ID:                r.ID,
+		TenantID:          r.TenantID,
+		KBID:              r.KBID,
+		DocumentID:        r.DocumentID,
+		DocumentVersionID: r.DocumentVersionID,
+		FallbackVersion:   r.FallbackVersion,
+	}
+}
+
+// IngestionJobProgress is written after every step of a running attempt.
+type IngestionJobProgress struct {
+	CurrentStep    string
+	StepDurations  map[string]int64
+	ChunkCount     int32
+	ReusedChunks   int32
+	EmbeddedChunks int32
+}
+
+func (uc *KnowledgeUsecase) ListIngestionJobs(ctx context.Context, kbID string, status string, limit int, offset int) ([]IngestionJobRecord, error) {
+	kbID = strings.TrimSpace(kbID)
+	if kbID == "" {
+		return nil, errors.BadRequest("KB_ID_MISSING", "knowledge base id missing")
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return uc.repo.ListIngestionJobs(ctx, kbID, strings.TrimSpace(status), limit, offset)
+}
+
+func (uc *KnowledgeUsecase) GetIngestionJob(ctx context.Context, id string) (IngestionJobRecord, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return IngestionJobRecord{}, errors.BadRequest("INGESTION_JOB_ID_MISSING", "ingestion job id missing")
+	}
+	return uc.repo.GetIngestionJob(ctx, id)
+}
+
+// RetryIngestionJob queues a failed or dead-lettered job again under the same
+// record.
+func (uc *KnowledgeUsecase) RetryIngestionJob(ctx context.Context, id string) (IngestionJobRecord, error) {
+	rec, err := uc.GetIngestionJob(ctx, id)
+	if err != nil {
+		return IngestionJobRecord{}, err
+	}
+	if rec.Status != IngestionJobStatusFailed && rec.Status != IngestionJobStatusDead {
+		return IngestionJobRecord{}, errors.Conflict("INGESTION_JOB_NOT_RETRYABLE", "only failed or dead-lettered jobs can be retried")
+	}
+	if rec.KBID != "" {
+		if err := uc.requireNoMigration(ctx, rec.KBID, true); err != nil {
+			return IngestionJobRecord{}, err
+		}
+	}
+	if _, err := uc.repo.GetDocumentVersion(ctx, rec.DocumentVersionID); err != nil {
+		return IngestionJobRecord{}, err
+	}
+	ok, err := uc.repo.TransitionIngestionJob(ctx, rec.ID, []string{rec.Status}, IngestionJobStatusQueued)
+	if err != nil {
+		return IngestionJobRecord{}, err
+	}
+	if !ok {
+		return IngestionJobRecord{}, errors.Conflict("INGESTION_JOB_CHANGED", "ingestion job changed, reload and retry")
+	}
+	if rec.Status == IngestionJobStatusDead && uc.queue != nil {
+		if _, err := uc.queue.RemoveDeadLetter(ctx, rec.ID); err != nil && uc.log != nil {
+			uc.log.Warnf("remove dead letter failed: job=%s err=%v", rec.ID, err)
+		}
+	}
+	_ = uc.repo.UpdateDocumentVersionStatus(ctx, rec.DocumentVersionID, DocumentVersionStatusProcessing, "")
+	_ = uc.repo.UpdateDocumentIndexState(ctx, rec.DocumentID, DocumentStatusProcessing, rec.FallbackVersion)
+
+	job := rec.Job()
+	if !uc.enqueueIngestion(ctx, job) {
+		// Without a queue the retry runs inline like the original upload.
+		_ = uc.processIngestion(ctx, job)
+	}
+	return uc.repo.GetIngestionJob(ctx, rec.ID)
+}
+
+// CancelIngestionJob cancels a job that has not started or is waiting for a
+// retry. The document falls back to its previous version.
+func (uc *KnowledgeUsecase) CancelIngestionJob(ctx context.Context, id string) (IngestionJobRecord, error) {
+	rec, err := uc.GetIngestionJob(ctx, id)
+	if err != nil {
+		return IngestionJobRecord{}, err
+	}
+	ok, err := uc.repo.TransitionIngestionJob(ctx, rec.ID, []string{IngestionJobStatusQueued, IngestionJobStatusRetrying}, IngestionJobStatusCancelled)
+	if err != nil {
+		return IngestionJobRecord{}, err
+	}
+	if !ok {
+		return IngestionJobRecord{}, errors.Conflict("INGESTION_JOB_NOT_CANCELLABLE", "only queued or retrying jobs can be cancelled")
+	}
+	if uc.queue != nil {
+		if err := uc.queue.Cancel(ctx, rec.ID); err != nil && uc.log != nil {
+			uc.log.Warnf("cancel queued ingestion failed: job=%s err=%v", rec.ID, err)
+		}
+	}
+	uc.markIngestionFailed(ctx, rec.Job(), rec.DocumentVersionID, errIngestionCancelled)
+	return uc.repo.GetIngestionJob(ctx, rec.ID)
+}
+
+// trackIngestionJob persists a queued record for job and returns job with its
+// ID set.
+func (uc *KnowledgeUsecase) trackIngestionJob(ctx context.Context, job IngestionJob) (IngestionJob, error) {
+	rec, err := uc.repo.CreateIngestionJob(ctx, IngestionJobRecord{
+		KBID:              job.KBID,
+		DocumentID:        job.DocumentID,
+		DocumentVersionID: job.DocumentVersionID,
+		FallbackVersion:   job.FallbackVersion,
+		Status:            IngestionJobStatusQueued,
+	})
+	if err != nil {
+		return job, err
+	}
+	job.ID = rec.ID
+	return job, nil
+}
+
+// beginIngestionAttempt marks a tracked job running and reports whether it
+// should be processed; cancelled and finished jobs are skipped.
+func (uc *KnowledgeUsecase) beginIngestionAttempt(ctx context.Context, job IngestionJob) bool {
+	if job.ID == "" {
+		return true
+	}
+	ok, err := uc.repo.StartIngestionJobAttempt(ctx, job.ID)
+	if err != nil {
+		// Tracking must not block ingestion.
+		if uc.log != nil {
+			uc.log.Warnf("start ingestion job failed: job=%s err=%v", job.ID, err)
+		}
+		return true
+	}
+	if !ok && uc.log != nil {
+		uc.log.Infof("ingestion job skipped: job=%s document=%s", job.ID, job.DocumentID)
+	}
+	return ok
+}
+
+// finishIngestionAttempt records the outcome of an attempt. A failure is
+// "retrying" while the queue has attempts left, "dead" once it dead-letters
+// the job and "failed" without a queue.
+func (uc *KnowledgeUsecase) finishIngestionAttempt(ctx context.Context, job IngestionJob, cause error) {
+	if job.ID == "" {
+		return
+	}
+	status := IngestionJobStatusSucceeded
+	reason := ""
+	if cause != nil {
+		reason = cause.Error()
+		switch {
+		case job.MaxAttempts <= 0:
+			status = IngestionJobStatusFailed
+		case job.Attempt < job.MaxAttempts:
+			status = IngestionJobStatusRetrying
+		default:
+			status = IngestionJobStatusDead
+		}
+	}
+	if err := uc.repo.FinishIngestionJobAttempt(ctx, job.ID, status, reason); err != nil && uc.log != nil {
+		uc.log.Warnf("finish ingestion job failed: job=%s err=%v", job.ID, err)
+	}
+}
+
+// ingestionTracker records the step progress of one attempt of a tracked job.
+// A nil tracker only logs.
+type ingestionTracker struct {
+	uc       *KnowledgeUsecase
+	job      IngestionJob
+	progress IngestionJobProgress
+}
+
+func (uc *KnowledgeUsecase) newIngestionTracker(job IngestionJob) *ingestionTracker {
+	if job.ID == "" {
+		return nil
+	}
+	return &ingestionTracker{
+		uc:       uc,
+		job:      job,
+		progress: IngestionJobProgress{StepDurations: make(map[string]int64, 4)},
+	}
+}
+
+// enter marks step as the current step.
+func (t *ingestionTracker) enter(ctx context.Context, step string) {
+	if t == nil {
+		return
+	}
+	t.progress.CurrentStep = step
+	t.save(ctx)
+}
+
+// done records the duration of step.
+func (t *ingestionTracker) done(ctx context.Context, step string, start time.Time) {
+	if t == nil {
+		return
+	}
+	t.progress.StepDurations[step] = time.Since(start).Milliseconds()
+	t.save(ctx)
+}
+
+// chunks records the chunk counts of the embed step.
+func (t *ingestionTracker) chunks(total int, stats EmbeddingStats) {
+	if t == nil {
+		return
+	}
+	t.progress.ChunkCount = int32(total)
+	t.progress.ReusedChunks = int32(stats.Reused)
+	t.progress.EmbeddedChunks = int32(stats.Embedded)
+}
+
+func (t *ingestionTracker) save(ctx context.Context) {
+	if err := t.uc.repo.UpdateIngestionJobProgress(ctx, t.job.ID, t.progress); err != nil && t.uc.log != nil {
+		t.uc.log.Warnf("update ingestion job progress failed: job=%s err=%v", t.job.ID, err)
+	}
+}
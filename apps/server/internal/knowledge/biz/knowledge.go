@@ -3,10 +3,12 @@ package biz
 import (
 	"context"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -44,8 +46,14 @@ type KnowledgeBase struct {
 	TenantID    string
 	Name        string
 	Description string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	Chunking    ChunkingConfig
+	Embedding   EmbeddingConfig
+	Dedup       DedupConfig
+	// IndexGeneration is bumped by every completed KB migration; each generation
+	// has its own vector collection.
+	IndexGeneration int32
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 // BotKnowledgeBase links a bot to a knowledge base.
@@ -67,8 +75,20 @@ type Document struct {
 	SourceType     string
 	Status         string
 	CurrentVersion int32
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	DocumentLabels
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DocumentLabels are set on upload, e.g. from the folder path of a bulk
+// import entry.
+type DocumentLabels struct {
+	Tags []string
+	// SectionPrefix is prepended to the section of every chunk.
+	SectionPrefix string
+	// AccessLabels restrict the document to end users entitled to one of
+	// them; a document without labels is visible to every end user.
+	AccessLabels []string
 }
 
 // DocumentVersion represents a versioned document content.
@@ -79,24 +99,47 @@ type DocumentVersion struct {
 	Version         int32
 	RawURI          string
 	IndexConfigHash string
-	Status          string
-	ErrorReason     string
-	CreatedAt       time.Time
+	// SizeBytes is the size of the object uploaded for the version; versions
+	// reusing the object of an earlier version count 0.
+	SizeBytes int64
+	// ReusedChunks and EmbeddedChunks split the chunks of the version into
+	// vectors reused by content hash and freshly embedded ones.
+	ReusedChunks   int32
+	EmbeddedChunks int32
+	// SkippedChunks were not indexed because they duplicate ready chunks of
+	// other documents.
+	SkippedChunks int32
+	Status        string
+	ErrorReason   string
+	CreatedAt     time.Time
 }
 
 // IngestionJob describes a document ingestion task.
 type IngestionJob struct {
+	// ID is the tracked ingestion_job record; empty for untracked builds such
+	// as KB migrations.
+	ID                string
 	TenantID          string
 	KBID              string
 	DocumentID        string
 	DocumentVersionID string
 	FallbackVersion   int32
+	// Attempt (1-based) and MaxAttempts are set by the queue on delivery; zero
+	// MaxAttempts means the job is not retried.
+	Attempt     int32
+	MaxAttempts int32
 }
 
 // IngestionQueue enqueues ingestion jobs and consumes them.
 type IngestionQueue interface {
 	Enqueue(ctx context.Context, job IngestionJob) error
 	Start(ctx context.Context, handler func(context.Context, IngestionJob) error) error
+	// RemoveDeadLetter drops the job from the dead-letter queue and reports
+	// whether it was there.
+	RemoveDeadLetter(ctx context.Context, jobID string) (bool, error)
+	// Cancel drops a queued job where the queue supports removal; consumers
+	// skip cancelled jobs on delivery either way.
+	Cancel(ctx context.Context, jobID string) error
 	Close() error
 }
 
@@ -111,7 +154,19 @@ type DocChunk struct {
 	Section     string
 	PageNo      int32
 	SourceURI   string
-	CreatedAt   time.Time
+	// Confidence is 1 for native text and the lowest OCR block confidence otherwise.
+	Confidence float32
+	// SimHash fingerprints the content for near-duplicate detection.
+	SimHash uint64
+	// OriginHash is the content hash produced by chunking when an editor
+	// corrected the content; empty otherwise.
+	OriginHash string
+	// Excluded chunks stay indexed but are never retrieved.
+	Excluded bool
+	// Keywords are pinned by editors and embedded and matched with the
+	// content.
+	Keywords  []string
+	CreatedAt time.Time
 }
 
 // EmbeddedChunk is a chunk plus its embedding vector.
@@ -129,16 +184,30 @@ type IndexDocumentVersionRequest struct {
 	SourceType        string
 	EmbeddingModel    string
 	EmbeddingDim      int
-	Chunks            []EmbeddedChunk
+	// VectorCollection is the collection key of the embedding model; empty
+	// means the default collection.
+	VectorCollection string
+	Chunks           []EmbeddedChunk
+	Stats            EmbeddingStats
+	// Duplicates links chunks of the version to chunks of other documents;
+	// SkippedChunks of them are not in Chunks.
+	Duplicates    []ChunkDuplicate
+	SkippedChunks int
 }
 
 // KnowledgeRepo persists knowledge entities and writes to vector store.
 type KnowledgeRepo interface {
+	// CreateKnowledgeBase checks the knowledge base limit of the plan and
+	// inserts in one transaction; it fails with PLAN_LIMIT_EXCEEDED when the
+	// plan has no room left.
 	CreateKnowledgeBase(ctx context.Context, kb KnowledgeBase) (KnowledgeBase, error)
 	GetKnowledgeBase(ctx context.Context, id string) (KnowledgeBase, error)
 	ListKnowledgeBases(ctx context.Context) ([]KnowledgeBase, error)
 	UpdateKnowledgeBase(ctx context.Context, kb KnowledgeBase) (KnowledgeBase, error)
 	DeleteKnowledgeBase(ctx context.Context, id string) error
+	// GetPlanUsage returns the plan limit of a resource and the use of the
+	// tenant.
+	GetPlanUsage(ctx context.Context, resource string) (plan.Usage, error)
 
 	CreateDocument(ctx context.Context, doc Document) (Document, error)
 	GetDocument(ctx context.Context, id string) (Document, error)
@@ -153,16 +222,103 @@ type KnowledgeRepo interface {
 	PutDocumentObject(ctx context.Context, kbID string, filename string, payload []byte, contentType string) (string, error)
 
 	IndexDocumentVersion(ctx context.Context, req IndexDocumentVersionRequest) error
+	// FindReusableEmbeddings returns vectors already indexed in the tenant with
+	// model, keyed by chunk content hash; unknown hashes are omitted.
+	FindReusableEmbeddings(ctx context.Context, model string, hashes []string) (map[string][]float32, error)
 	DeleteDocumentVersionIndex(ctx context.Context, documentID string, versionID string) error
+	// FindDuplicateCandidates returns the chunks of the current ready versions
+	// of the other documents of kbID in collection that share a content hash
+	// or a SimHash band with the given chunks.
+	FindDuplicateCandidates(ctx context.Context, kbID string, excludeDocumentID string, collection string, hashes []string, simhashes []uint64) ([]DuplicateCandidate, error)
+	// GetChunkVectors returns the vectors of chunks in collection; missing
+	// chunks are omitted.
+	GetChunkVectors(ctx context.Context, collection string, chunkIDs []string) (map[string][]float32, error)
+	// ListSkippedDuplicateDocuments returns the documents whose current
+	// version skipped chunks as duplicates of documentID, and documentID
+	// itself when its current version skipped any.
+	ListSkippedDuplicateDocuments(ctx context.Context, documentID string) ([]string, error)
+	ListChunkDuplicates(ctx context.Context, kbID string) ([]ChunkDuplicate, error)
+	ListDocumentDuplicatePairs(ctx context.Context, kbID string) ([]DocumentDuplicatePair, error)
 	RollbackDocument(ctx context.Context, documentID string, version int32) error
 
-	ListDocuments(ctx context.Context, kbID string, limit int, offset int) ([]Document, error)
+	// ListDocuments lists the documents of the tenant, only those of kbIDs
+	// unless it is nil.
+	ListDocuments(ctx context.Context, kbIDs []string, limit int, offset int) ([]Document, error)
 	UpdateDocumentKB(ctx context.Context, documentID string, kbID string) (Document, error)
+	UpdateDocumentAccessLabels(ctx context.Context, documentID string, labels []string) (Document, error)
 	DeleteDocument(ctx context.Context, documentID string) error
 
 	ListBotKnowledgeBases(ctx context.Context, botID string) ([]BotKnowledgeBase, error)
 	BindBotKnowledgeBase(ctx context.Context, link BotKnowledgeBase) (BotKnowledgeBase, error)
 	UnbindBotKnowledgeBase(ctx context.Context, botID string, kbID string) error
+
+	CreateKnowledgeBaseMigration(ctx context.Context, m KBMigration) (KBMigration, error)
+	GetKnowledgeBaseMigration(ctx context.Context, id string) (KBMigration, error)
+	GetActiveKnowledgeBaseMigration(ctx context.Context, kbID string) (KBMigration, error)
+	ListKnowledgeBaseMigrations(ctx context.Context, kbID string) ([]KBMigration, error)
+	// ListStaleKnowledgeBaseMigrations lists active migrations of all tenants
+	// without heartbeat since staleBefore.
+	ListStaleKnowledgeBaseMigrations(ctx context.Context, staleBefore time.Time) ([]KBMigration, error)
+	ClaimKnowledgeBaseMigration(ctx context.Context, id string, staleBefore time.Time) (bool, error)
+	TouchKnowledgeBaseMigration(ctx context.Context, id string) error
+	TransitionKnowledgeBaseMigration(ctx context.Context, id string, from []string, to string) (bool, error)
+	UpdateKnowledgeBaseMigrationProgress(ctx context.Context, m KBMigration) error
+	FinishKnowledgeBaseMigration(ctx context.Context, id string, status string, errorReason string) error
+	ListKnowledgeBaseMigrationItems(ctx context.Context, migrationID string) ([]KBMigrationItem, error)
+	SaveKnowledgeBaseMigrationItem(ctx context.Context, item KBMigrationItem) error
+	SwitchKnowledgeBaseIndex(ctx context.Context, req KBIndexSwitch) error
+
+	CreateImportBatch(ctx context.Context, batch ImportBatch) (ImportBatch, error)
+	CreateImportBatchItem(ctx context.Context, item ImportBatchItem) error
+	GetImportBatch(ctx context.Context, id string) (ImportBatch, error)
+	// ListImportBatchItems returns the items of a batch with the current status
+	// of their documents.
+	ListImportBatchItems(ctx context.Context, batchID string) ([]ImportBatchItem, error)
+
+	CreateIngestionJob(ctx context.Context, rec IngestionJobRecord) (IngestionJobRecord, error)
+	GetIngestionJob(ctx context.Context, id string) (IngestionJobRecord, error)
+	ListIngestionJobs(ctx context.Context, kbID string, status string, limit int, offset int) ([]IngestionJobRecord, error)
+	// StartIngestionJobAttempt moves a queued, retrying or interrupted running
+	// job to running and reports false for any other status.
+	StartIngestionJobAttempt(ctx context.Context, id string) (bool, error)
+	UpdateIngestionJobProgress(ctx context.Context, id string, p IngestionJobProgress) error
+	// FinishIngestionJobAttempt ends the running attempt; a job cancelled
+	// meanwhile keeps its status.
+	FinishIngestionJobAttempt(ctx context.Context, id string, status string, errorReason string) error
+	TransitionIngestionJob(ctx context.Context, id string, from []string, to string) (bool, error)
+
+	// ApplyVectorOutbox applies due vector outbox rows of all tenants.
+	ApplyVectorOutbox(ctx context.Context) (int, error)
+	ListPendingVectorDocuments(ctx context.Context) ([]string, error)
+	ListChunkVectorRefs(ctx context.Context, kbID string) ([]ChunkVectorRef, error)
+	// ListVectorPointRefs scans the tenant's points (of kbID when set) in every
+	// collection used by its versions plus extraCollections.
+	ListVectorPointRefs(ctx context.Context, kbID string, extraCollections []string) ([]VectorPointRef, error)
+	ListVersionChunks(ctx context.Context, versionID string, chunkIDs []string) ([]DocChunk, error)
+	// ListDocumentVersionChunks returns all stored chunks of a version in
+	// chunk order.
+	ListDocumentVersionChunks(ctx context.Context, versionID string) ([]DocChunk, error)
+	ListChunks(ctx context.Context, versionID string, limit int, offset int) ([]DocChunk, int, error)
+	// SearchChunks matches the content and keywords of the chunks of the
+	// current versions of kbID.
+	SearchChunks(ctx context.Context, kbID string, query string, limit int, offset int) ([]ChunkView, error)
+	GetChunk(ctx context.Context, id string) (ChunkRecord, error)
+	// UpdateChunk stores an edited chunk, its override and edit record, and
+	// queues the vector upsert or payload update.
+	UpdateChunk(ctx context.Context, update ChunkUpdate) error
+	ListChunkOverrides(ctx context.Context, documentID string) ([]ChunkOverride, error)
+	SaveChunkOverride(ctx context.Context, o ChunkOverride) error
+	ListChunkEdits(ctx context.Context, documentID string, limit int, offset int) ([]ChunkEditRecord, error)
+	// ListVersionCitations aggregates the references of assistant messages
+	// since the given time to chunks of the versions of documentID.
+	ListVersionCitations(ctx context.Context, documentID string, versionIDs []string, since time.Time) ([]ChunkCitation, error)
+	RestoreChunkVectors(ctx context.Context, req IndexDocumentVersionRequest) error
+	DeleteVectorPoints(ctx context.Context, refs []VectorPointRef) error
+}
+
+// CredentialRepo loads the provider credentials tenants brought.
+type CredentialRepo interface {
+	GetProviderCredential(ctx context.Context, tenantID string, providerName string) (provider.Credential, bool, error)
 }
 
 // KnowledgeUsecase handles knowledge business logic.
@@ -173,30 +329,32 @@ type KnowledgeUsecase struct {
 	queue        IngestionQueue
 	asyncEnabled bool
 
+	options            ingestionOptions
 	embedder           provider.Provider
-	chunkSizeTokens    int
-	chunkOverlapTokens int
 	embeddingBatchSize int
+	embedders          map[string]provider.Provider
+	embeddersMu        sync.Mutex
+	tenantClients      *provider.TenantClients
 	indexConfigHash    string
 	cleaner            CleaningStrategy
-	chunker            ChunkingStrategy
+	ocr                OCREngine
 }
 
 // NewKnowledgeUsecase creates a new KnowledgeUsecase
-func NewKnowledgeUsecase(repo KnowledgeRepo, queue IngestionQueue, cfg *conf.Data, logger log.Logger) *KnowledgeUsecase {
+func NewKnowledgeUsecase(repo KnowledgeRepo, queue IngestionQueue, ocr OCREngine, credRepo CredentialRepo, cfg *conf.Data, logger log.Logger) *KnowledgeUsecase {
 	opts := loadIngestionOptions(cfg)
 	embedder := newEmbeddingProvider(opts)
 	uc := &KnowledgeUsecase{
 		repo:               repo,
 		queue:              queue,
 		log:                log.NewHelper(logger),
+		options:            opts,
 		embedder:           embedder,
-		chunkSizeTokens:    opts.chunkSizeTokens,
-		chunkOverlapTokens: opts.chunkOverlapTokens,
 		embeddingBatchSize: opts.embeddingBatchSize,
+		tenantClients:      provider.NewTenantClients(credRepo),
 		indexConfigHash:    opts.indexConfigHash,
 		cleaner:            DefaultCleaningStrategy{},
-		chunker:            TokenChunker{MaxTokens: opts.chunkSizeTokens, OverlapTokens: opts.chunkOverlapTokens},
+		ocr:                ocr,
 	}
 	uc.asyncEnabled = opts.asyncEnabled && queue != nil
 	return uc
@@ -231,9 +389,37 @@ func (uc *KnowledgeUsecase) CreateKnowledgeBase(ctx context.Context, kb Knowledg
 	if kb.Name == "" {
 		return KnowledgeBase{}, errors.BadRequest("KB_NAME_MISSING", "knowledge base name missing")
 	}
+	chunking, err := validateChunkingConfig(kb.Chunking)
+	if err != nil {
+		return KnowledgeBase{}, err
+	}
+	kb.Chunking = chunking
+	embedding, err := validateEmbeddingConfig(kb.Embedding)
+	if err != nil {
+		return KnowledgeBase{}, err
+	}
+	kb.Embedding = embedding
+	dedup, err := validateDedupConfig(kb.Dedup)
+	if err != nil {
+		return KnowledgeBase{}, err
+	}
+	kb.Dedup = dedup
 	return uc.repo.CreateKnowledgeBase(ctx, kb)
 }
 
+// requirePlanCapacity rejects adding to a resource beyond the plan limit of
+// the tenant.
+func (uc *KnowledgeUsecase) requirePlanCapacity(ctx context.Context, resource string, adding int64) error {
+	usage, err := uc.repo.GetPlanUsage(ctx, resource)
+	if err != nil {
+		return err
+	}
+	if !usage.Allows(adding) {
+		return plan.LimitExceeded(usage)
+	}
+	return nil
+}
+
 func (uc *KnowledgeUsecase) GetKnowledgeBase(ctx context.Context, id string) (KnowledgeBase, error) {
 	id = strings.TrimSpace(id)
 	if id == "" {
@@ -246,15 +432,15 @@ func (uc *KnowledgeUsecase) ListKnowledgeBases(ctx context.Context) ([]Knowledge
 	return uc.repo.ListKnowledgeBases(ctx)
 }
 
-func (uc *KnowledgeUsecase) ListDocuments(ctx context.Context, kbID string, limit int32, offset int32) ([]Document, error) {
-	kbID = strings.TrimSpace(kbID)
+// ListDocuments lists documents, only those of kbIDs unless it is nil.
+func (uc *KnowledgeUsecase) ListDocuments(ctx context.Context, kbIDs []string, limit int32, offset int32) ([]Document, error) {
 	if limit <= 0 || limit > 200 {
 		limit = 50
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	return uc.repo.ListDocuments(ctx, kbID, int(limit), int(offset))
+	return uc.repo.ListDocuments(ctx, kbIDs, int(limit), int(offset))
 }
 
 func (uc *KnowledgeUsecase) UpdateDocumentKB(ctx context.Context, documentID string, kbID string) (Document, error) {
@@ -285,9 +471,29 @@ func (uc *KnowledgeUsecase) UpdateKnowledgeBase(ctx context.Context, kb Knowledg
 	if kb.ID == "" {
 		return KnowledgeBase{}, errors.BadRequest("KB_ID_MISSING", "knowledge base id missing")
 	}
-	if kb.Name == "" && strings.TrimSpace(kb.Description) == "" {
+	if kb.Name == "" && strings.TrimSpace(kb.Description) == "" && kb.Chunking.IsZero() && kb.Embedding.IsZero() && kb.Dedup.IsZero() {
 		return KnowledgeBase{}, errors.BadRequest("KB_UPDATE_EMPTY", "knowledge base update empty")
 	}
+	chunking, err := validateChunkingConfig(kb.Chunking)
+	if err != nil {
+		return KnowledgeBase{}, err
+	}
+	kb.Chunking = chunking
+	embedding, err := validateEmbeddingConfig(kb.Embedding)
+	if err != nil {
+		return KnowledgeBase{}, err
+	}
+	kb.Embedding = embedding
+	dedup, err := validateDedupConfig(kb.Dedup)
+	if err != nil {
+		return KnowledgeBase{}, err
+	}
+	kb.Dedup = dedup
+	if !kb.Chunking.IsZero() || !kb.Embedding.IsZero() {
+		if err := uc.requireNoMigration(ctx, kb.ID, false); err != nil {
+			return KnowledgeBase{}, err
+		}
+	}
 	return uc.repo.UpdateKnowledgeBase(ctx, kb)
 }
 
@@ -296,6 +502,9 @@ func (uc *KnowledgeUsecase) DeleteKnowledgeBase(ctx context.Context, id string)
 	if id == "" {
 		return errors.BadRequest("KB_ID_MISSING", "knowledge base id missing")
 	}
+	if err := uc.requireNoMigration(ctx, id, false); err != nil {
+		return err
+	}
 	return uc.repo.DeleteKnowledgeBase(ctx, id)
 }
 
@@ -341,7 +550,16 @@ func (uc *KnowledgeUsecase) UnbindBotKnowledgeBase(ctx context.Context, botID, k
 	return uc.repo.UnbindBotKnowledgeBase(ctx, botID, kbID)
 }
 
-func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID, title, sourceType, rawURI string) (Document, DocumentVersion, error) {
+func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID, title, sourceType, rawURI string, labels DocumentLabels) (Document, DocumentVersion, error) {
+	if err := uc.requirePlanCapacity(ctx, plan.ResourceDocuments, 1); err != nil {
+		return Document{}, DocumentVersion{}, err
+	}
+	return uc.uploadDocument(ctx, kbID, title, sourceType, rawURI, 0, labels)
+}
+
+// uploadDocument creates a document whose first version is at rawURI; callers
+// check the plan limits.
+func (uc *KnowledgeUsecase) uploadDocument(ctx context.Context, kbID, title, sourceType, rawURI string, sizeBytes int64, labels DocumentLabels) (Document, DocumentVersion, error) {
 	kbID = strings.TrimSpace(kbID)
 	title = strings.TrimSpace(title)
 	sourceType = normalizeSourceType(sourceType)
@@ -355,8 +573,17 @@ func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID, title, sou
 	if rawURI == "" {
 		return Document{}, DocumentVersion{}, errors.BadRequest("DOC_RAW_URI_MISSING", "raw_uri missing")
 	}
+	accessLabels, err := normalizeAccessLabels(labels.AccessLabels)
+	if err != nil {
+		return Document{}, DocumentVersion{}, err
+	}
+	labels.AccessLabels = accessLabels
 	// Ensure KB exists (tenant scoped).
-	if _, err := uc.repo.GetKnowledgeBase(ctx, kbID); err != nil {
+	kb, err := uc.repo.GetKnowledgeBase(ctx, kbID)
+	if err != nil {
+		return Document{}, DocumentVersion{}, err
+	}
+	if err := uc.requireNoMigration(ctx, kbID, true); err != nil {
 		return Document{}, DocumentVersion{}, err
 	}
 	tenantID, err := tenantIDFromContext(ctx)
@@ -370,6 +597,7 @@ func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID, title, sou
 		SourceType:     sourceType,
 		Status:         DocumentStatusProcessing,
 		CurrentVersion: 0,
+		DocumentLabels: labels,
 	})
 	if err != nil {
 		return Document{}, DocumentVersion{}, err
@@ -378,7 +606,8 @@ func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID, title, sou
 		DocumentID:      doc.ID,
 		Version:         1,
 		RawURI:          rawURI,
-		IndexConfigHash: uc.indexConfigHash,
+		IndexConfigHash: uc.indexConfigHashFor(kb),
+		SizeBytes:       sizeBytes,
 		Status:          DocumentVersionStatusProcessing,
 	})
 	if err != nil {
@@ -392,6 +621,10 @@ func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID, title, sou
 		DocumentVersionID: ver.ID,
 		FallbackVersion:   0,
 	}
+	job, err = uc.trackIngestionJob(ctx, job)
+	if err != nil {
+		return Document{}, DocumentVersion{}, err
+	}
 	if uc.enqueueIngestion(ctx, job) {
 		return doc, ver, nil
 	}
@@ -404,7 +637,7 @@ func (uc *KnowledgeUsecase) UploadDocument(ctx context.Context, kbID, title, sou
 	return doc, ver, nil
 }
 
-func (uc *KnowledgeUsecase) UploadDocumentFile(ctx context.Context, kbID, title, sourceType, filename string, payload []byte, contentType string) (Document, DocumentVersion, error) {
+func (uc *KnowledgeUsecase) UploadDocumentFile(ctx context.Context, kbID, title, sourceType, filename string, payload []byte, contentType string, labels DocumentLabels) (Document, DocumentVersion, error) {
 	if uc == nil || uc.repo == nil {
 		return Document{}, DocumentVersion{}, errors.InternalServer("KB_REPO_MISSING", "knowledge repo missing")
 	}
@@ -415,6 +648,12 @@ func (uc *KnowledgeUsecase) UploadDocumentFile(ctx context.Context, kbID, title,
 	if len(payload) == 0 {
 		return Document{}, DocumentVersion{}, errors.BadRequest("DOC_CONTENT_MISSING", "document content missing")
 	}
+	if err := uc.requirePlanCapacity(ctx, plan.ResourceDocuments, 1); err != nil {
+		return Document{}, DocumentVersion{}, err
+	}
+	if err := uc.requirePlanCapacity(ctx, plan.ResourceStorageBytes, int64(len(payload))); err != nil {
+		return Document{}, DocumentVersion{}, err
+	}
 	rawURI, err := uc.repo.PutDocumentObject(ctx, kbID, filename, payload, contentType)
 	if err != nil {
 		return Document{}, DocumentVersion{}, err
@@ -422,7 +661,7 @@ func (uc *KnowledgeUsecase) UploadDocumentFile(ctx context.Context, kbID, title,
 	if strings.TrimSpace(title) == "" {
 		title = inferTitleFromFilename(filename)
 	}
-	return uc.UploadDocument(ctx, kbID, title, sourceType, rawURI)
+	return uc.uploadDocument(ctx, kbID, title, sourceType, rawURI, int64(len(payload)), labels)
 }
 
 func inferTitleFromFilename(filename string) string {
@@ -456,6 +695,19 @@ func (uc *KnowledgeUsecase) GetDocument(ctx context.Context, id string) (Documen
 	return doc, versions, nil
 }
 
+// DocumentKnowledgeBaseID returns the knowledge base a document belongs to.
+func (uc *KnowledgeUsecase) DocumentKnowledgeBaseID(ctx context.Context, id string) (string, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return "", errors.BadRequest("DOC_ID_MISSING", "document id missing")
+	}
+	doc, err := uc.repo.GetDocument(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return doc.KBID, nil
+}
+
 func (uc *KnowledgeUsecase) ReindexDocument(ctx context.Context, id string) (DocumentVersion, error) {
 	id = strings.TrimSpace(id)
 	if id == "" {
@@ -472,18 +724,34 @@ func (uc *KnowledgeUsecase) ReindexDocument(ctx context.Context, id string) (Doc
 	if err != nil {
 		return DocumentVersion{}, err
 	}
-	if strings.TrimSpace(current.IndexConfigHash) == strings.TrimSpace(uc.indexConfigHash) {
+	indexConfigHash := uc.indexConfigHash
+	if doc.KBID != "" {
+		kb, err := uc.repo.GetKnowledgeBase(ctx, doc.KBID)
+		if err != nil {
+			return DocumentVersion{}, err
+		}
+		if err := uc.requireNoMigration(ctx, doc.KBID, true); err != nil {
+			return DocumentVersion{}, err
+		}
+		indexConfigHash = uc.indexConfigHashFor(kb)
+	}
+	// Versions with skipped duplicates are reindexed so the skips are
+	// re-evaluated against the current chunks of the knowledge base.
+	if strings.TrimSpace(current.IndexConfigHash) == strings.TrimSpace(indexConfigHash) && current.SkippedChunks == 0 {
 		return DocumentVersion{}, errors.New(412, "DOC_REINDEX_NOT_NEEDED", "index config unchanged")
 	}
 	if strings.TrimSpace(current.RawURI) == "" {
 		return DocumentVersion{}, errors.BadRequest("DOC_RAW_URI_MISSING", "document raw_uri missing")
 	}
-	nextVersion := doc.CurrentVersion + 1
+	nextVersion, err := uc.nextVersionNumber(ctx, id)
+	if err != nil {
+		return DocumentVersion{}, err
+	}
 	ver, err := uc.repo.CreateDocumentVersion(ctx, DocumentVersion{
 		DocumentID:      id,
 		Version:         nextVersion,
 		RawURI:          current.RawURI,
-		IndexConfigHash: uc.indexConfigHash,
+		IndexConfigHash: indexConfigHash,
 		Status:          DocumentVersionStatusProcessing,
 	})
 	if err != nil {
@@ -502,6 +770,10 @@ func (uc *KnowledgeUsecase) ReindexDocument(ctx context.Context, id string) (Doc
 		DocumentVersionID: ver.ID,
 		FallbackVersion:   doc.CurrentVersion,
 	}
+	job, err = uc.trackIngestionJob(ctx, job)
+	if err != nil {
+		return DocumentVersion{}, err
+	}
 	if uc.enqueueIngestion(ctx, job) {
 		return ver, nil
 	}
@@ -512,6 +784,22 @@ func (uc *KnowledgeUsecase) ReindexDocument(ctx context.Context, id string) (Doc
 	return ver, nil
 }
 
+// nextVersionNumber returns the number after the latest version, which may be
+// above current_version after a rollback or while a KB migration is running.
+func (uc *KnowledgeUsecase) nextVersionNumber(ctx context.Context, documentID string) (int32, error) {
+	versions, err := uc.repo.ListDocumentVersions(ctx, documentID)
+	if err != nil {
+		return 0, err
+	}
+	var latest int32
+	for _, v := range versions {
+		if v.Version > latest {
+			latest = v.Version
+		}
+	}
+	return latest + 1, nil
+}
+
 func (uc *KnowledgeUsecase) RollbackDocument(ctx context.Context, id string, version int32) error {
 	id = strings.TrimSpace(id)
 	if id == "" {
@@ -546,38 +834,86 @@ func (uc *KnowledgeUsecase) processIngestion(ctx context.Context, job IngestionJ
 		return errors.Forbidden("TENANT_MISSING", "tenant missing")
 	}
 	ctx = withTenantID(ctx, job.TenantID)
+	if !uc.beginIngestionAttempt(ctx, job) {
+		return nil
+	}
 	startTotal := time.Now()
+	var kb KnowledgeBase
+	if job.KBID != "" {
+		var err error
+		kb, err = uc.repo.GetKnowledgeBase(ctx, job.KBID)
+		if err != nil {
+			uc.markIngestionFailed(ctx, job, job.DocumentVersionID, err)
+			uc.finishIngestionAttempt(ctx, job, err)
+			return err
+		}
+	}
+	version, err := uc.buildVersionIndex(ctx, job, kb, uc.newIngestionTracker(job))
+	if err != nil {
+		uc.markIngestionFailed(ctx, job, job.DocumentVersionID, err)
+		uc.finishIngestionAttempt(ctx, job, err)
+		return err
+	}
+	uc.markIngestionReady(ctx, job, version)
+	uc.finishIngestionAttempt(ctx, job, nil)
+	uc.logIngestionStep(job, "complete", startTotal, nil)
+	return nil
+}
+
+// buildVersionIndex parses, chunks, embeds and indexes a document version with
+// the chunking, embedding and index generation of kb. tracker may be nil.
+func (uc *KnowledgeUsecase) buildVersionIndex(ctx context.Context, job IngestionJob, kb KnowledgeBase, tracker *ingestionTracker) (DocumentVersion, error) {
+	tracker.enter(ctx, IngestionStepLoad)
 	stepStart := time.Now()
 	version, doc, sourceType, rawInput, meta, err := uc.loadIngestionInput(ctx, job)
-	uc.logIngestionStep(job, "load", stepStart, err)
+	uc.endIngestionStep(ctx, tracker, job, IngestionStepLoad, stepStart, err)
 	if err != nil {
-		uc.markIngestionFailed(ctx, job, version.ID, err)
-		return err
+		return version, err
 	}
-	stepStart = time.Now()
-	chunks, err := uc.parseAndChunk(ctx, sourceType, rawInput, meta, version.ID)
-	uc.logIngestionStep(job, "chunk", stepStart, err)
+	embedder, err := uc.tenantEmbedderFor(ctx, kb.Embedding)
 	if err != nil {
-		uc.markIngestionFailed(ctx, job, version.ID, err)
-		return err
+		return version, err
 	}
+	tracker.enter(ctx, IngestionStepChunk)
 	stepStart = time.Now()
-	embedded, err := uc.embedChunks(ctx, chunks)
-	uc.logIngestionStep(job, "embed", stepStart, err)
+	chunks, err := uc.parseAndChunk(ctx, kb, embedder, sourceType, rawInput, meta, version.ID)
+	if err == nil {
+		chunks, err = uc.applyChunkOverrides(ctx, job.DocumentID, chunks)
+	}
+	uc.endIngestionStep(ctx, tracker, job, IngestionStepChunk, stepStart, err)
 	if err != nil {
-		uc.markIngestionFailed(ctx, job, version.ID, err)
-		return err
+		return version, err
 	}
+	tracker.enter(ctx, IngestionStepEmbed)
 	stepStart = time.Now()
-	if err := uc.indexEmbeddedChunks(ctx, job, doc, sourceType, version, embedded); err != nil {
-		uc.logIngestionStep(job, "index", stepStart, err)
-		uc.markIngestionFailed(ctx, job, version.ID, err)
-		return err
+	embedded, stats, err := uc.embedChunks(ctx, embedder, chunks)
+	tracker.chunks(len(chunks), stats)
+	uc.endIngestionStep(ctx, tracker, job, IngestionStepEmbed, stepStart, err)
+	if err != nil {
+		return version, err
 	}
-	uc.logIngestionStep(job, "index", stepStart, nil)
-	uc.markIngestionReady(ctx, job, version)
-	uc.logIngestionStep(job, "complete", startTotal, nil)
-	return nil
+	if uc.log != nil {
+		uc.log.Infof("ingestion embeddings tenant=%s document=%s version=%s reused=%d embedded=%d",
+			job.TenantID,
+			job.DocumentID,
+			job.DocumentVersionID,
+			stats.Reused,
+			stats.Embedded,
+		)
+	}
+	version.ReusedChunks = int32(stats.Reused)
+	version.EmbeddedChunks = int32(stats.Embedded)
+	tracker.enter(ctx, IngestionStepIndex)
+	stepStart = time.Now()
+	err = uc.indexEmbeddedChunks(ctx, job, kb, embedder, doc, sourceType, version, embedded, stats)
+	uc.endIngestionStep(ctx, tracker, job, IngestionStepIndex, stepStart, err)
+	return version, err
+}
+
+// endIngestionStep logs a finished step and records its duration on the job.
+func (uc *KnowledgeUsecase) endIngestionStep(ctx context.Context, tracker *ingestionTracker, job IngestionJob, step string, start time.Time, err error) {
+	uc.logIngestionStep(job, step, start, err)
+	tracker.done(ctx, step, start)
 }
 
 func (uc *KnowledgeUsecase) loadIngestionInput(ctx context.Context, job IngestionJob) (DocumentVersion, Document, string, []byte, DocumentMeta, error) {
@@ -603,18 +939,28 @@ func (uc *KnowledgeUsecase) loadIngestionInput(ctx context.Context, job Ingestio
 		return version, doc, sourceType, nil, DocumentMeta{}, errors.BadRequest("DOC_CONTENT_MISSING", "document content missing")
 	}
 	meta := DocumentMeta{
-		Title:      doc.Title,
-		SourceURI:  strings.TrimSpace(version.RawURI),
-		SourceType: sourceType,
+		Title:         doc.Title,
+		SourceURI:     strings.TrimSpace(version.RawURI),
+		SourceType:    sourceType,
+		SectionPrefix: doc.SectionPrefix,
 	}
 	return version, doc, sourceType, rawInput, meta, nil
 }
 
-func (uc *KnowledgeUsecase) parseAndChunk(ctx context.Context, sourceType string, rawInput []byte, meta DocumentMeta, versionID string) ([]DocChunk, error) {
-	parsed, err := parseDocument(ctx, sourceType, rawInput, meta)
+func (uc *KnowledgeUsecase) parseAndChunk(ctx context.Context, kb KnowledgeBase, embedder provider.Provider, sourceType string, rawInput []byte, meta DocumentMeta, versionID string) ([]DocChunk, error) {
+	parsed, err := uc.parseNormalized(ctx, sourceType, rawInput, meta)
 	if err != nil {
 		return nil, err
 	}
+	return uc.chunkParsed(ctx, kb, embedder, parsed, meta, versionID)
+}
+
+// parseNormalized parses rawInput and applies the cleaning strategy.
+func (uc *KnowledgeUsecase) parseNormalized(ctx context.Context, sourceType string, rawInput []byte, meta DocumentMeta) (ParsedDocument, error) {
+	parsed, err := parseDocument(ctx, sourceType, rawInput, meta, uc.ocr)
+	if err != nil {
+		return ParsedDocument{}, err
+	}
 	cleaner := uc.cleaner
 	if cleaner == nil {
 		cleaner = DefaultCleaningStrategy{}
@@ -622,29 +968,56 @@ func (uc *KnowledgeUsecase) parseAndChunk(ctx context.Context, sourceType string
 	parsed = cleaner.Normalize(sourceType, parsed)
 	parsed = enrichParsedDocument(parsed)
 	if len(parsed.Blocks) == 0 {
-		return nil, errors.BadRequest("DOC_CONTENT_MISSING", "document content missing")
+		return ParsedDocument{}, errors.BadRequest("DOC_CONTENT_MISSING", "document content missing")
 	}
-	chunker := uc.chunker
-	if chunker == nil {
-		chunker = TokenChunker{MaxTokens: uc.chunkSizeTokens, OverlapTokens: uc.chunkOverlapTokens}
+	return parsed, nil
+}
+
+func (uc *KnowledgeUsecase) chunkParsed(ctx context.Context, kb KnowledgeBase, embedder provider.Provider, parsed ParsedDocument, meta DocumentMeta, versionID string) ([]DocChunk, error) {
+	chunker := uc.chunkerFor(kb, embedder)
+	chunks, err := chunker.BuildChunks(ctx, parsed.Blocks, parsed.Meta, versionID)
+	if err != nil {
+		return nil, err
 	}
-	chunks := chunker.BuildChunks(parsed.Blocks, parsed.Meta, versionID)
 	if len(chunks) == 0 {
 		return nil, errors.BadRequest("DOC_CHUNKS_EMPTY", "document chunks empty")
 	}
+	applySectionPrefix(chunks, meta.SectionPrefix)
 	return chunks, nil
 }
 
-func (uc *KnowledgeUsecase) indexEmbeddedChunks(ctx context.Context, job IngestionJob, doc Document, sourceType string, version DocumentVersion, embedded []EmbeddedChunk) error {
+// chunkerFor returns the chunking strategy configured on the knowledge base.
+func (uc *KnowledgeUsecase) chunkerFor(kb KnowledgeBase, embedder provider.Provider) ChunkingStrategy {
+	opts := uc.options.withEmbedding(kb.Embedding)
+	resolved := resolveChunkingConfig(kb.Chunking, opts)
+	return newChunkingStrategy(resolved, embedder, uc.embeddingBatchSize)
+}
+
+func (uc *KnowledgeUsecase) indexConfigHashFor(kb KnowledgeBase) string {
+	opts := uc.options.withEmbedding(kb.Embedding)
+	return buildIndexConfigHash(opts, resolveChunkingConfig(kb.Chunking, opts))
+}
+
+func (uc *KnowledgeUsecase) indexEmbeddedChunks(ctx context.Context, job IngestionJob, kb KnowledgeBase, embedder provider.Provider, doc Document, sourceType string, version DocumentVersion, embedded []EmbeddedChunk, stats EmbeddingStats) error {
+	dim := embedder.Dim()
+	if len(embedded) > 0 && len(embedded[0].Vector) > 0 {
+		dim = len(embedded[0].Vector)
+	}
+	collection := vectorCollectionKey(kb.Embedding, embedder.Model(), dim, kb.IndexGeneration)
+	kept, duplicates := uc.detectDuplicates(ctx, kb, job, collection, embedded)
 	indexReq := IndexDocumentVersionRequest{
 		KBID:              job.KBID,
 		DocumentID:        job.DocumentID,
 		DocumentVersionID: version.ID,
 		DocumentTitle:     doc.Title,
 		SourceType:        sourceType,
-		EmbeddingModel:    uc.embedder.Model(),
-		EmbeddingDim:      uc.embedder.Dim(),
-		Chunks:            embedded,
+		EmbeddingModel:    embedder.Model(),
+		EmbeddingDim:      dim,
+		VectorCollection:  collection,
+		Chunks:            kept,
+		Stats:             stats,
+		Duplicates:        duplicates,
+		SkippedChunks:     len(embedded) - len(kept),
 	}
 	if err := uc.repo.IndexDocumentVersion(ctx, indexReq); err != nil {
 		return err
@@ -1,10 +1,16 @@
 package biz
 
+import "strings"
+
+const headingPathSeparator = " > "
+
 // DocumentMeta describes document-level metadata extracted during parsing.
 type DocumentMeta struct {
 	Title      string
 	SourceURI  string
 	SourceType string
+	// SectionPrefix is the document's DocumentLabels.SectionPrefix.
+	SectionPrefix string
 }
 
 // DocumentBlock is a structured block of document content.
@@ -12,6 +18,10 @@ type DocumentBlock struct {
 	Text    string
 	Section string
 	PageNo  int32
+	// Confidence is the OCR confidence in [0,1]; 0 means native text.
+	Confidence float32
+	// HeadingPath is the enclosing heading hierarchy (H1, H2, ...) when the source has one.
+	HeadingPath []string
 }
 
 // ParsedDocument represents parsed document content and metadata.
@@ -19,3 +29,24 @@ type ParsedDocument struct {
 	Meta   DocumentMeta
 	Blocks []DocumentBlock
 }
+
+// maxSectionRunes matches doc_chunk.section VARCHAR(255).
+const maxSectionRunes = 255
+
+// applySectionPrefix prepends prefix to the section path of every chunk.
+func applySectionPrefix(chunks []DocChunk, prefix string) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return
+	}
+	for i := range chunks {
+		section := prefix
+		if chunks[i].Section != "" {
+			section = prefix + headingPathSeparator + chunks[i].Section
+		}
+		if runes := []rune(section); len(runes) > maxSectionRunes {
+			section = string(runes[:maxSectionRunes])
+		}
+		chunks[i].Section = section
+	}
+}
@@ -0,0 +1,577 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// KB migration statuses.
+const (
+	KBMigrationStatusPending    = "pending"
+	KBMigrationStatusRunning    = "running"
+	KBMigrationStatusSwitching  = "switching"
+	KBMigrationStatusCollecting = "collecting"
+	KBMigrationStatusCancelling = "cancelling"
+	KBMigrationStatusCompleted  = "completed"
+	KBMigrationStatusFailed     = "failed"
+	KBMigrationStatusCancelled  = "cancelled"
+)
+
+// KB migration item statuses. Items become switched in the switch transaction
+// and collected once the index of their source version is deleted.
+const (
+	KBMigrationItemPending   = "pending"
+	KBMigrationItemReady     = "ready"
+	KBMigrationItemFailed    = "failed"
+	KBMigrationItemSwitched  = "switched"
+	KBMigrationItemCollected = "collected"
+)
+
+const (
+	// migrationLeaseTimeout is how long a migration may go without heartbeat
+	// before another worker resumes it.
+	migrationLeaseTimeout = 2 * time.Minute
+	migrationHeartbeat    = 30 * time.Second
+	migrationWaitInterval = 2 * time.Second
+	migrationPageSize     = 200
+)
+
+// KBMigration rebuilds every document of a knowledge base into a new index
+// generation and switches the knowledge base over once all documents are ready.
+type KBMigration struct {
+	ID                 string
+	TenantID           string
+	KBID               string
+	Status             string
+	Generation         int32
+	Chunking           ChunkingConfig
+	Embedding          EmbeddingConfig
+	IndexConfigHash    string
+	TotalDocuments     int32
+	ProcessedDocuments int32
+	FailedDocuments    int32
+	ErrorReason        string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	FinishedAt         *time.Time
+}
+
+// Active reports whether the migration has not reached a final status.
+func (m KBMigration) Active() bool {
+	switch m.Status {
+	case KBMigrationStatusPending, KBMigrationStatusRunning, KBMigrationStatusSwitching, KBMigrationStatusCollecting, KBMigrationStatusCancelling:
+		return true
+	default:
+		return false
+	}
+}
+
+// KBMigrationItem is the shadow version built for one document.
+type KBMigrationItem struct {
+	MigrationID     string
+	DocumentID      string
+	SourceVersion   int32
+	TargetVersionID string
+	TargetVersion   int32
+	Status          string
+	ErrorReason     string
+	UpdatedAt       time.Time
+}
+
+// KBIndexSwitch atomically moves a knowledge base and its documents to the
+// index generation built by a migration and leaves the migration collecting
+// the old versions.
+type KBIndexSwitch struct {
+	MigrationID string
+	KBID        string
+	Chunking    ChunkingConfig
+	Embedding   EmbeddingConfig
+	Generation  int32
+	Items       []KBMigrationItem
+}
+
+var errMigrationCancelled = errors.Conflict("KB_MIGRATION_CANCELLED", "knowledge base migration cancelled")
+
+// StartKnowledgeBaseMigration rebuilds every document of the knowledge base with
+// the given chunking/embedding (zero values keep the current ones) while
+// retrieval keeps serving the current index.
+func (uc *KnowledgeUsecase) StartKnowledgeBaseMigration(ctx context.Context, kbID string, chunking ChunkingConfig, embedding EmbeddingConfig) (KBMigration, error) {
+	kbID = strings.TrimSpace(kbID)
+	if kbID == "" {
+		return KBMigration{}, errors.BadRequest("KB_ID_MISSING", "knowledge base id missing")
+	}
+	kb, err := uc.repo.GetKnowledgeBase(ctx, kbID)
+	if err != nil {
+		return KBMigration{}, err
+	}
+	if err := uc.requireNoMigration(ctx, kbID, false); err != nil {
+		return KBMigration{}, err
+	}
+	chunking, err = validateChunkingConfig(chunking)
+	if err != nil {
+		return KBMigration{}, err
+	}
+	embedding, err = validateEmbeddingConfig(embedding)
+	if err != nil {
+		return KBMigration{}, err
+	}
+	target := kb
+	if !chunking.IsZero() {
+		target.Chunking = chunking
+	}
+	if !embedding.IsZero() {
+		target.Embedding = embedding
+	}
+	target.IndexGeneration = kb.IndexGeneration + 1
+	m, err := uc.repo.CreateKnowledgeBaseMigration(ctx, KBMigration{
+		KBID:            kbID,
+		Status:          KBMigrationStatusPending,
+		Generation:      target.IndexGeneration,
+		Chunking:        target.Chunking,
+		Embedding:       target.Embedding,
+		IndexConfigHash: uc.indexConfigHashFor(target),
+	})
+	if err != nil {
+		return KBMigration{}, err
+	}
+	uc.launchMigration(m)
+	return m, nil
+}
+
+func (uc *KnowledgeUsecase) GetKnowledgeBaseMigration(ctx context.Context, kbID string, id string) (KBMigration, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return KBMigration{}, errors.BadRequest("KB_MIGRATION_ID_MISSING", "migration id missing")
+	}
+	m, err := uc.repo.GetKnowledgeBaseMigration(ctx, id)
+	if err != nil {
+		return KBMigration{}, err
+	}
+	if kbID = strings.TrimSpace(kbID); kbID != "" && m.KBID != kbID {
+		return KBMigration{}, errors.NotFound("KB_MIGRATION_NOT_FOUND", "migration not found")
+	}
+	return m, nil
+}
+
+func (uc *KnowledgeUsecase) ListKnowledgeBaseMigrations(ctx context.Context, kbID string) ([]KBMigration, error) {
+	kbID = strings.TrimSpace(kbID)
+	if kbID == "" {
+		return nil, errors.BadRequest("KB_ID_MISSING", "knowledge base id missing")
+	}
+	return uc.repo.ListKnowledgeBaseMigrations(ctx, kbID)
+}
+
+// CancelKnowledgeBaseMigration stops a migration before it switches; the worker
+// then drops the shadow versions it built.
+func (uc *KnowledgeUsecase) CancelKnowledgeBaseMigration(ctx context.Context, kbID string, id string) (KBMigration, error) {
+	m, err := uc.GetKnowledgeBaseMigration(ctx, kbID, id)
+	if err != nil {
+		return KBMigration{}, err
+	}
+	switch m.Status {
+	case KBMigrationStatusPending, KBMigrationStatusRunning:
+	case KBMigrationStatusCancelling:
+		return m, nil
+	case KBMigrationStatusSwitching:
+		return KBMigration{}, errors.Conflict("KB_MIGRATION_SWITCHING", "migration is switching and can no longer be cancelled")
+	case KBMigrationStatusCollecting:
+		return KBMigration{}, errors.Conflict("KB_MIGRATION_SWITCHED", "migration already switched the knowledge base")
+	default:
+		return KBMigration{}, errors.Conflict("KB_MIGRATION_FINISHED", "migration already finished")
+	}
+	ok, err := uc.repo.TransitionKnowledgeBaseMigration(ctx, m.ID, []string{KBMigrationStatusPending, KBMigrationStatusRunning}, KBMigrationStatusCancelling)
+	if err != nil {
+		return KBMigration{}, err
+	}
+	if !ok {
+		return KBMigration{}, errors.Conflict("KB_MIGRATION_SWITCHING", "migration is switching and can no longer be cancelled")
+	}
+	return uc.repo.GetKnowledgeBaseMigration(ctx, m.ID)
+}
+
+// StartMigrationWorker resumes migrations whose worker stopped (crash or
+// restart) and keeps checking until ctx is done.
+func (uc *KnowledgeUsecase) StartMigrationWorker(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(migrationLeaseTimeout / 2)
+		defer ticker.Stop()
+		for {
+			uc.resumeMigrations(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (uc *KnowledgeUsecase) resumeMigrations(ctx context.Context) {
+	items, err := uc.repo.ListStaleKnowledgeBaseMigrations(ctx, time.Now().Add(-migrationLeaseTimeout))
+	if err != nil {
+		if uc.log != nil {
+			uc.log.Warnf("list stale kb migrations failed: %v", err)
+		}
+		return
+	}
+	for _, m := range items {
+		if uc.log != nil {
+			uc.log.Infof("resuming kb migration: tenant=%s kb=%s migration=%s status=%s", m.TenantID, m.KBID, m.ID, m.Status)
+		}
+		uc.launchMigration(m)
+	}
+}
+
+// requireNoMigration rejects changes that would race with a running migration.
+// With switchingOnly, only the short switch phase blocks (document writes are
+// picked up by the migration until then).
+func (uc *KnowledgeUsecase) requireNoMigration(ctx context.Context, kbID string, switchingOnly bool) error {
+	m, err := uc.repo.GetActiveKnowledgeBaseMigration(ctx, kbID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if m.Status == KBMigrationStatusSwitching {
+		return errors.Conflict("KB_MIGRATION_SWITCHING", "knowledge base is switching to a new index, retry shortly")
+	}
+	if !switchingOnly {
+		return errors.Conflict("KB_MIGRATION_RUNNING", "knowledge base migration in progress")
+	}
+	return nil
+}
+
+// launchMigration claims the migration lease and runs it in the background.
+func (uc *KnowledgeUsecase) launchMigration(m KBMigration) {
+	ctx := withTenantID(context.Background(), m.TenantID)
+	claimed, err := uc.repo.ClaimKnowledgeBaseMigration(ctx, m.ID, time.Now().Add(-migrationLeaseTimeout))
+	if err != nil || !claimed {
+		if err != nil && uc.log != nil {
+			uc.log.Warnf("claim kb migration failed: migration=%s err=%v", m.ID, err)
+		}
+		return
+	}
+	go uc.runMigration(ctx, m.ID)
+}
+
+func (uc *KnowledgeUsecase) runMigration(ctx context.Context, id string) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		ticker := time.NewTicker(migrationHeartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = uc.repo.TouchKnowledgeBaseMigration(ctx, id)
+			}
+		}
+	}()
+	if err := uc.migrate(ctx, id); err != nil && uc.log != nil {
+		uc.log.Warnf("kb migration stopped: migration=%s err=%v", id, err)
+	}
+}
+
+func (uc *KnowledgeUsecase) migrate(ctx context.Context, id string) error {
+	m, err := uc.repo.GetKnowledgeBaseMigration(ctx, id)
+	if err != nil {
+		return err
+	}
+	if m.Status == KBMigrationStatusCancelling {
+		return uc.abortMigration(ctx, m, KBMigrationStatusCancelled, "")
+	}
+	if m.Status == KBMigrationStatusCollecting {
+		return uc.collectOldVersions(ctx, m)
+	}
+	if m.Status == KBMigrationStatusPending {
+		if _, err := uc.repo.TransitionKnowledgeBaseMigration(ctx, m.ID, []string{KBMigrationStatusPending}, KBMigrationStatusRunning); err != nil {
+			return err
+		}
+		m.Status = KBMigrationStatusRunning
+	}
+	kb, err := uc.repo.GetKnowledgeBase(ctx, m.KBID)
+	if err != nil {
+		return uc.abortMigration(ctx, m, KBMigrationStatusFailed, err.Error())
+	}
+	target := kb
+	target.Chunking = m.Chunking
+	target.Embedding = m.Embedding
+	target.IndexGeneration = m.Generation
+
+	for {
+		ready, waiting, err := uc.migrateDocuments(ctx, &m, target)
+		if err != nil {
+			if err == errMigrationCancelled {
+				return uc.abortMigration(ctx, m, KBMigrationStatusCancelled, "")
+			}
+			return uc.abortMigration(ctx, m, KBMigrationStatusFailed, err.Error())
+		}
+		if waiting > 0 {
+			// Documents still ingesting; their current version is not final yet.
+			if err := sleepContext(ctx, migrationWaitInterval); err != nil {
+				return err
+			}
+			continue
+		}
+		if m.FailedDocuments > 0 {
+			reason := fmt.Sprintf("%d document(s) failed to rebuild", m.FailedDocuments)
+			return uc.abortMigration(ctx, m, KBMigrationStatusFailed, reason)
+		}
+		if m.Status != KBMigrationStatusSwitching {
+			// Block document writes, then take one more pass to catch changes
+			// made since the last one.
+			ok, err := uc.repo.TransitionKnowledgeBaseMigration(ctx, m.ID, []string{KBMigrationStatusRunning}, KBMigrationStatusSwitching)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return uc.abortMigration(ctx, m, KBMigrationStatusCancelled, "")
+			}
+			m.Status = KBMigrationStatusSwitching
+			continue
+		}
+		err = uc.repo.SwitchKnowledgeBaseIndex(ctx, KBIndexSwitch{
+			MigrationID: m.ID,
+			KBID:        m.KBID,
+			Chunking:    m.Chunking,
+			Embedding:   m.Embedding,
+			Generation:  m.Generation,
+			Items:       ready,
+		})
+		if errors.IsConflict(err) {
+			// A document changed between the last pass and the switch.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		m.Status = KBMigrationStatusCollecting
+		return uc.collectOldVersions(ctx, m)
+	}
+}
+
+// migrateDocuments builds shadow versions for documents that do not have one for
+// their current version yet. It returns the ready items of live documents and
+// the number of documents still ingesting.
+func (uc *KnowledgeUsecase) migrateDocuments(ctx context.Context, m *KBMigration, target KnowledgeBase) ([]KBMigrationItem, int, error) {
+	existing, err := uc.repo.ListKnowledgeBaseMigrationItems(ctx, m.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	items := make(map[string]KBMigrationItem, len(existing))
+	for _, item := range existing {
+		items[item.DocumentID] = item
+	}
+	ready := make([]KBMigrationItem, 0, len(existing))
+	waiting := 0
+	var total, processed, failed int32
+	for offset := 0; ; offset += migrationPageSize {
+		docs, err := uc.repo.ListDocuments(ctx, []string{m.KBID}, migrationPageSize, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, doc := range docs {
+			if doc.CurrentVersion <= 0 {
+				if doc.Status == DocumentStatusProcessing {
+					waiting++
+				}
+				continue
+			}
+			total++
+			if doc.Status == DocumentStatusProcessing {
+				waiting++
+				continue
+			}
+			item, ok := items[doc.ID]
+			if !ok || item.SourceVersion != doc.CurrentVersion || item.Status == KBMigrationItemPending {
+				if err := uc.checkMigrationCancelled(ctx, m.ID); err != nil {
+					return nil, 0, err
+				}
+				item, err = uc.migrateDocument(ctx, *m, target, doc, item)
+				if err != nil {
+					return nil, 0, err
+				}
+				items[doc.ID] = item
+			}
+			switch item.Status {
+			case KBMigrationItemReady:
+				processed++
+				ready = append(ready, item)
+			case KBMigrationItemFailed:
+				processed++
+				failed++
+			}
+			m.TotalDocuments, m.ProcessedDocuments, m.FailedDocuments = total, processed, failed
+			_ = uc.repo.UpdateKnowledgeBaseMigrationProgress(ctx, *m)
+		}
+		if len(docs) < migrationPageSize {
+			break
+		}
+	}
+	m.TotalDocuments, m.ProcessedDocuments, m.FailedDocuments = total, processed, failed
+	if err := uc.repo.UpdateKnowledgeBaseMigrationProgress(ctx, *m); err != nil {
+		return nil, 0, err
+	}
+	return ready, waiting, nil
+}
+
+// migrateDocument builds the shadow version of the current version of doc. The
+// item is saved before indexing so a resumed migration reuses the version.
+func (uc *KnowledgeUsecase) migrateDocument(ctx context.Context, m KBMigration, target KnowledgeBase, doc Document, item KBMigrationItem) (KBMigrationItem, error) {
+	if item.TargetVersionID != "" {
+		// Partial index of an interrupted run, or a shadow of an outdated version.
+		_ = uc.repo.DeleteDocumentVersionIndex(ctx, doc.ID, item.TargetVersionID)
+		if item.SourceVersion != doc.CurrentVersion {
+			_ = uc.repo.UpdateDocumentVersionStatus(ctx, item.TargetVersionID, DocumentVersionStatusFailed, "superseded during knowledge base migration")
+			item.TargetVersionID = ""
+		}
+	}
+	if item.TargetVersionID == "" {
+		source, err := uc.repo.GetDocumentVersionByNumber(ctx, doc.ID, doc.CurrentVersion)
+		if err != nil {
+			return item, err
+		}
+		next, err := uc.nextVersionNumber(ctx, doc.ID)
+		if err != nil {
+			return item, err
+		}
+		ver, err := uc.repo.CreateDocumentVersion(ctx, DocumentVersion{
+			DocumentID:      doc.ID,
+			Version:         next,
+			RawURI:          source.RawURI,
+			IndexConfigHash: m.IndexConfigHash,
+			Status:          DocumentVersionStatusProcessing,
+		})
+		if err != nil {
+			return item, err
+		}
+		item = KBMigrationItem{
+			MigrationID:     m.ID,
+			DocumentID:      doc.ID,
+			SourceVersion:   doc.CurrentVersion,
+			TargetVersionID: ver.ID,
+			TargetVersion:   ver.Version,
+		}
+	}
+	item.Status = KBMigrationItemPending
+	item.ErrorReason = ""
+	if err := uc.repo.SaveKnowledgeBaseMigrationItem(ctx, item); err != nil {
+		return item, err
+	}
+	job := IngestionJob{
+		TenantID:          m.TenantID,
+		KBID:              m.KBID,
+		DocumentID:        doc.ID,
+		DocumentVersionID: item.TargetVersionID,
+	}
+	if _, err := uc.buildVersionIndex(ctx, job, target, nil); err != nil {
+		if ctx.Err() != nil {
+			return item, ctx.Err()
+		}
+		_ = uc.repo.DeleteDocumentVersionIndex(ctx, doc.ID, item.TargetVersionID)
+		_ = uc.repo.UpdateDocumentVersionStatus(ctx, item.TargetVersionID, DocumentVersionStatusFailed, err.Error())
+		item.Status = KBMigrationItemFailed
+		item.ErrorReason = err.Error()
+	} else {
+		_ = uc.repo.UpdateDocumentVersionStatus(ctx, item.TargetVersionID, DocumentVersionStatusReady, "")
+		item.Status = KBMigrationItemReady
+	}
+	if err := uc.repo.SaveKnowledgeBaseMigrationItem(ctx, item); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+func (uc *KnowledgeUsecase) checkMigrationCancelled(ctx context.Context, id string) error {
+	m, err := uc.repo.GetKnowledgeBaseMigration(ctx, id)
+	if err != nil {
+		return err
+	}
+	if m.Status == KBMigrationStatusCancelling || m.Status == KBMigrationStatusCancelled {
+		return errMigrationCancelled
+	}
+	return nil
+}
+
+// abortMigration drops every shadow version and records the final status; the
+// knowledge base keeps serving its current index.
+func (uc *KnowledgeUsecase) abortMigration(ctx context.Context, m KBMigration, status string, reason string) error {
+	items, err := uc.repo.ListKnowledgeBaseMigrationItems(ctx, m.ID)
+	if err != nil {
+		return err
+	}
+	message := "knowledge base migration " + status
+	for _, item := range items {
+		if item.TargetVersionID == "" {
+			continue
+		}
+		if err := uc.repo.DeleteDocumentVersionIndex(ctx, item.DocumentID, item.TargetVersionID); err != nil && uc.log != nil {
+			uc.log.Warnf("drop shadow version failed: migration=%s version=%s err=%v", m.ID, item.TargetVersionID, err)
+		}
+		if item.Status != KBMigrationItemFailed {
+			_ = uc.repo.UpdateDocumentVersionStatus(ctx, item.TargetVersionID, DocumentVersionStatusFailed, message)
+		}
+	}
+	if uc.log != nil {
+		uc.log.Infof("kb migration %s: tenant=%s kb=%s migration=%s reason=%s", status, m.TenantID, m.KBID, m.ID, reason)
+	}
+	return uc.repo.FinishKnowledgeBaseMigration(ctx, m.ID, status, reason)
+}
+
+// collectOldVersions removes chunks, embeddings and vectors of the versions the
+// knowledge base switched away from and completes the migration once every
+// switched item is collected. On error the migration stays collecting and is
+// resumed with the remaining items.
+func (uc *KnowledgeUsecase) collectOldVersions(ctx context.Context, m KBMigration) error {
+	items, err := uc.repo.ListKnowledgeBaseMigrationItems(ctx, m.ID)
+	if err != nil {
+		return err
+	}
+	collected := 0
+	for _, item := range items {
+		if item.Status != KBMigrationItemSwitched {
+			continue
+		}
+		old, err := uc.repo.GetDocumentVersionByNumber(ctx, item.DocumentID, item.SourceVersion)
+		switch {
+		case err == nil:
+			err = uc.repo.DeleteDocumentVersionIndex(ctx, item.DocumentID, old.ID)
+		case errors.IsNotFound(err):
+			// Deleted with its document since the switch.
+			err = nil
+		}
+		if err != nil {
+			return fmt.Errorf("collect old index: document=%s version=%d: %w", item.DocumentID, item.SourceVersion, err)
+		}
+		item.Status = KBMigrationItemCollected
+		if err := uc.repo.SaveKnowledgeBaseMigrationItem(ctx, item); err != nil {
+			return err
+		}
+		collected++
+	}
+	if err := uc.repo.FinishKnowledgeBaseMigration(ctx, m.ID, KBMigrationStatusCompleted, ""); err != nil {
+		return err
+	}
+	if uc.log != nil {
+		uc.log.Infof("kb migration completed: tenant=%s kb=%s migration=%s generation=%d collected=%d", m.TenantID, m.KBID, m.ID, m.Generation, collected)
+	}
+	return nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
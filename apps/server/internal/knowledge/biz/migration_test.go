@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// fakeMigrationRepo keeps the items and old versions of one migration in
+// memory; methods a test does not reach are left to the nil embedded interface.
+type fakeMigrationRepo struct {
+	KnowledgeRepo
+	items    []KBMigrationItem
+	versions map[string]DocumentVersion
+	// failDelete fails index deletes of these documents.
+	failDelete map[string]bool
+	deleted    []string
+	finished   string
+}
+
+func (r *fakeMigrationRepo) ListKnowledgeBaseMigrationItems(context.Context, string) ([]KBMigrationItem, error) {
+	return append([]KBMigrationItem(nil), r.items...), nil
+}
+
+func (r *fakeMigrationRepo) GetDocumentVersionByNumber(_ context.Context, documentID string, version int32) (DocumentVersion, error) {
+	v, ok := r.versions[fmt.Sprintf("%s/%d", documentID, version)]
+	if !ok {
+		return DocumentVersion{}, errors.NotFound("DOC_VERSION_NOT_FOUND", "document version not found")
+	}
+	return v, nil
+}
+
+func (r *fakeMigrationRepo) DeleteDocumentVersionIndex(_ context.Context, documentID string, versionID string) error {
+	if r.failDelete[documentID] {
+		return fmt.Errorf("vector store unavailable")
+	}
+	r.deleted = append(r.deleted, versionID)
+	return nil
+}
+
+func (r *fakeMigrationRepo) SaveKnowledgeBaseMigrationItem(_ context.Context, item KBMigrationItem) error {
+	for i := range r.items {
+		if r.items[i].DocumentID == item.DocumentID {
+			r.items[i] = item
+		}
+	}
+	return nil
+}
+
+func (r *fakeMigrationRepo) FinishKnowledgeBaseMigration(_ context.Context, _ string, status string, _ string) error {
+	r.finished = status
+	return nil
+}
+
+func TestCollectOldVersionsResumes(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeMigrationRepo{
+		items: []KBMigrationItem{
+			{DocumentID: "doc-1", SourceVersion: 1, Status: KBMigrationItemSwitched},
+			{DocumentID: "doc-2", SourceVersion: 3, Status: KBMigrationItemSwitched},
+			// Deleted with its document after the switch.
+			{DocumentID: "doc-3", SourceVersion: 2, Status: KBMigrationItemSwitched},
+			{DocumentID: "doc-4", SourceVersion: 1, Status: KBMigrationItemFailed},
+		},
+		versions: map[string]DocumentVersion{
+			"doc-1/1": {ID: "v-1"},
+			"doc-2/3": {ID: "v-2"},
+			"doc-4/1": {ID: "v-4"},
+		},
+		failDelete: map[string]bool{"doc-2": true},
+	}
+	uc := &KnowledgeUsecase{repo: repo}
+	m := KBMigration{ID: "mig-1", Status: KBMigrationStatusCollecting}
+
+	if err := uc.collectOldVersions(ctx, m); err == nil {
+		t.Fatal("collect with a failing delete succeeded")
+	}
+	if repo.finished != "" {
+		t.Fatalf("migration finished as %s with old versions left", repo.finished)
+	}
+	if repo.items[0].Status != KBMigrationItemCollected || repo.items[1].Status != KBMigrationItemSwitched {
+		t.Fatalf("items after failure = %+v", repo.items)
+	}
+
+	// A resumed run only deletes what is left.
+	repo.failDelete = nil
+	if err := uc.collectOldVersions(ctx, m); err != nil {
+		t.Fatalf("resumed collect: %v", err)
+	}
+	if repo.finished != KBMigrationStatusCompleted {
+		t.Fatalf("finished = %q, want completed", repo.finished)
+	}
+	if want := []string{"v-1", "v-2"}; fmt.Sprint(repo.deleted) != fmt.Sprint(want) {
+		t.Fatalf("deleted = %v, want %v", repo.deleted, want)
+	}
+	for _, item := range repo.items[:3] {
+		if item.Status != KBMigrationItemCollected {
+			t.Fatalf("item %s = %s, want collected", item.DocumentID, item.Status)
+		}
+	}
+	if repo.items[3].Status != KBMigrationItemFailed {
+		t.Fatalf("failed item = %s", repo.items[3].Status)
+	}
+}
@@ -0,0 +1,257 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	ldpdf "github.com/ledongthuc/pdf"
+)
+
+const minOCRConfidence = 0.01
+
+// OCRImage is an image handed to an OCR engine.
+type OCRImage struct {
+	Data        []byte
+	ContentType string
+}
+
+// OCRBlock is a text region recognized by an OCR engine.
+type OCRBlock struct {
+	Text string
+	// Confidence is in [0,1].
+	Confidence float32
+}
+
+// OCREngine recognizes text in images (scanned PDF pages, screenshots).
+type OCREngine interface {
+	Recognize(ctx context.Context, img OCRImage) ([]OCRBlock, error)
+}
+
+func parseImageBlocks(ctx context.Context, raw []byte, ocr OCREngine) ([]DocumentBlock, error) {
+	if len(raw) == 0 {
+		return nil, errors.BadRequest("IMAGE_CONTENT_EMPTY", "image content missing")
+	}
+	if ocr == nil {
+		return nil, errors.BadRequest("OCR_NOT_CONFIGURED", "ocr engine not configured; image documents need data.knowledge.ocr")
+	}
+	blocks, err := recognizeBlocks(ctx, ocr, OCRImage{Data: raw, ContentType: http.DetectContentType(raw)}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 {
+		return nil, errors.BadRequest("OCR_NO_TEXT", "ocr found no text in image")
+	}
+	return blocks, nil
+}
+
+func recognizeBlocks(ctx context.Context, ocr OCREngine, img OCRImage, pageNo int32) ([]DocumentBlock, error) {
+	recognized, err := ocr.Recognize(ctx, img)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]DocumentBlock, 0, len(recognized))
+	for _, item := range recognized {
+		text := strings.TrimSpace(item.Text)
+		if text == "" {
+			continue
+		}
+		conf := item.Confidence
+		if conf < minOCRConfidence {
+			conf = minOCRConfidence
+		}
+		if conf > 1 {
+			conf = 1
+		}
+		blocks = append(blocks, DocumentBlock{
+			Text:       text,
+			PageNo:     pageNo,
+			Confidence: conf,
+		})
+	}
+	return blocks, nil
+}
+
+// recognizePDFPages runs OCR on pages that have no text layer but carry images.
+func recognizePDFPages(ctx context.Context, ocr OCREngine, pages []pdfPageLayout) error {
+	for i := range pages {
+		if len(pages[i].lines) > 0 || len(pages[i].images) == 0 {
+			continue
+		}
+		for _, img := range pages[i].images {
+			blocks, err := recognizeBlocks(ctx, ocr, img, pages[i].pageNo)
+			if err != nil {
+				return err
+			}
+			pages[i].ocrBlocks = append(pages[i].ocrBlocks, blocks...)
+		}
+	}
+	return nil
+}
+
+// pdfJPEG is a DCT-encoded image stream found in the raw PDF bytes.
+type pdfJPEG struct {
+	data   []byte
+	width  int
+	height int
+	used   bool
+}
+
+// scanPDFJPEGs collects JPEG streams directly from the file bytes, since the
+// pdf reader cannot hand out DCTDecode streams undecoded.
+func scanPDFJPEGs(payload []byte) []*pdfJPEG {
+	out := make([]*pdfJPEG, 0)
+	marker := []byte("stream")
+	soi := []byte{0xFF, 0xD8, 0xFF}
+	for idx := 0; idx < len(payload); {
+		i := bytes.Index(payload[idx:], marker)
+		if i < 0 {
+			break
+		}
+		start := idx + i + len(marker)
+		if start < len(payload) && payload[start] == '\r' {
+			start++
+		}
+		if start < len(payload) && payload[start] == '\n' {
+			start++
+		}
+		idx = start
+		if !bytes.HasPrefix(payload[start:], soi) {
+			continue
+		}
+		end := bytes.Index(payload[start:], []byte("endstream"))
+		if end < 0 {
+			break
+		}
+		data := bytes.TrimRight(payload[start:start+end], "\r\n")
+		idx = start + end
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			continue
+		}
+		out = append(out, &pdfJPEG{data: data, width: cfg.Width, height: cfg.Height})
+	}
+	return out
+}
+
+// pdfPageImages returns OCR inputs for the image XObjects of a page. JPEGs are
+// matched to XObjects by size in file order; 8-bit and 1-bit gray/RGB rasters
+// are re-encoded as PNG.
+func pdfPageImages(page ldpdf.Page, jpegs []*pdfJPEG) []OCRImage {
+	xobjects := page.Resources().Key("XObject")
+	if xobjects.Kind() != ldpdf.Dict {
+		return nil
+	}
+	out := make([]OCRImage, 0, 1)
+	for _, name := range xobjects.Keys() {
+		obj := xobjects.Key(name)
+		if obj.Key("Subtype").Name() != "Image" {
+			continue
+		}
+		width := int(obj.Key("Width").Int64())
+		height := int(obj.Key("Height").Int64())
+		switch pdfImageFilter(obj) {
+		case "DCTDecode":
+			for _, candidate := range jpegs {
+				if candidate.used || candidate.width != width || candidate.height != height {
+					continue
+				}
+				candidate.used = true
+				out = append(out, OCRImage{Data: candidate.data, ContentType: "image/jpeg"})
+				break
+			}
+		case "", "FlateDecode":
+			if data := pdfRasterPNG(obj, width, height); len(data) > 0 {
+				out = append(out, OCRImage{Data: data, ContentType: "image/png"})
+			}
+		}
+	}
+	return out
+}
+
+func pdfImageFilter(obj ldpdf.Value) string {
+	filter := obj.Key("Filter")
+	switch filter.Kind() {
+	case ldpdf.Name:
+		return filter.Name()
+	case ldpdf.Array:
+		if filter.Len() == 1 {
+			return filter.Index(0).Name()
+		}
+		return "unsupported"
+	default:
+		return ""
+	}
+}
+
+func pdfRasterPNG(obj ldpdf.Value, width, height int) (out []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			out = nil
+		}
+	}()
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	components := 0
+	switch obj.Key("ColorSpace").Name() {
+	case "DeviceGray":
+		components = 1
+	case "DeviceRGB":
+		components = 3
+	default:
+		return nil
+	}
+	bpc := int(obj.Key("BitsPerComponent").Int64())
+	if bpc != 8 && !(bpc == 1 && components == 1) {
+		return nil
+	}
+	rc := obj.Reader()
+	defer rc.Close()
+	rowBytes := (width*components*bpc + 7) / 8
+	raw, err := io.ReadAll(io.LimitReader(rc, int64(rowBytes*height)))
+	if err != nil || len(raw) < rowBytes*height {
+		return nil
+	}
+	var img image.Image
+	switch {
+	case bpc == 1:
+		gray := image.NewGray(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			row := raw[y*rowBytes : (y+1)*rowBytes]
+			for x := 0; x < width; x++ {
+				if row[x/8]&(0x80>>uint(x%8)) != 0 {
+					gray.Pix[y*gray.Stride+x] = 0xFF
+				}
+			}
+		}
+		img = gray
+	case components == 1:
+		gray := image.NewGray(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			copy(gray.Pix[y*gray.Stride:y*gray.Stride+width], raw[y*rowBytes:])
+		}
+		img = gray
+	default:
+		rgba := image.NewRGBA(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				src := raw[y*rowBytes+x*3:]
+				dst := rgba.Pix[y*rgba.Stride+x*4:]
+				dst[0], dst[1], dst[2], dst[3] = src[0], src[1], src[2], 0xFF
+			}
+		}
+		img = rgba
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
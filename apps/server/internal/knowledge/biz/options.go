@@ -141,20 +141,32 @@ func loadIngestionOptions(cfg *conf.Data) ingestionOptions {
 	if opts.embeddingBatchSize <= 0 {
 		opts.embeddingBatchSize = 64
 	}
-	opts.indexConfigHash = buildIndexConfigHash(opts)
+	opts.indexConfigHash = buildIndexConfigHash(opts, resolveChunkingConfig(ChunkingConfig{}, opts))
 	return opts
 }
 
-func buildIndexConfigHash(opts ingestionOptions) string {
+// buildIndexConfigHash fingerprints everything that shapes the index of a
+// document: chunking (resolved per knowledge base) and the embedding model.
+func buildIndexConfigHash(opts ingestionOptions, chunking ChunkingConfig) string {
 	payload := fmt.Sprintf(
 		"chunk=%d|overlap=%d|provider=%s|model=%s|dim=%d|endpoint=%s",
-		opts.chunkSizeTokens,
-		opts.chunkOverlapTokens,
+		chunking.MaxTokens,
+		chunking.OverlapTokens,
 		strings.ToLower(strings.TrimSpace(opts.embeddingProvider)),
 		strings.TrimSpace(opts.embeddingModel),
 		opts.embeddingDim,
 		strings.TrimSpace(opts.embeddingEndpoint),
 	)
+	// The token strategy keeps the original payload so existing versions stay valid.
+	if chunking.Strategy != ChunkingStrategyToken {
+		payload += fmt.Sprintf(
+			"|strategy=%s|window=%d|window_overlap=%d|similarity=%.4f",
+			chunking.Strategy,
+			chunking.WindowSentences,
+			chunking.OverlapSentences,
+			chunking.SimilarityThreshold,
+		)
+	}
 	sum := sha256.Sum256([]byte(payload))
 	return hex.EncodeToString(sum[:])
 }
@@ -50,6 +50,8 @@ func normalizeSourceType(sourceType string) string {
 		return "doc"
 	case "pdf":
 		return "pdf"
+	case "image", "png", "jpg", "jpeg", "webp", "tif", "tiff", "bmp", "gif":
+		return "image"
 	case "url", "link":
 		return "url"
 	default:
@@ -69,7 +71,7 @@ func prepareContentNormalized(sourceType, content string) string {
 	return cleanContent(content)
 }
 
-func parseDocument(ctx context.Context, sourceType string, raw []byte, meta DocumentMeta) (ParsedDocument, error) {
+func parseDocument(ctx context.Context, sourceType string, raw []byte, meta DocumentMeta, ocr OCREngine) (ParsedDocument, error) {
 	doc := ParsedDocument{Meta: meta}
 	switch sourceType {
 	case "url":
@@ -106,7 +108,14 @@ func parseDocument(ctx context.Context, sourceType string, raw []byte, meta Docu
 		doc.Blocks = blocks
 		return doc, nil
 	case "pdf":
-		blocks, err := parsePDFBlocks(raw)
+		blocks, err := parsePDFBlocks(ctx, raw, ocr)
+		if err != nil {
+			return ParsedDocument{}, err
+		}
+		doc.Blocks = blocks
+		return doc, nil
+	case "image":
+		blocks, err := parseImageBlocks(ctx, raw, ocr)
 		if err != nil {
 			return ParsedDocument{}, err
 		}
@@ -274,8 +283,16 @@ func parseDocxBytes(payload []byte) (string, error) {
 	return builder.String(), nil
 }
 
-func parsePDFBlocks(payload []byte) ([]DocumentBlock, error) {
-	blocks, err := parsePDFBlocksPlain(payload)
+func parsePDFBlocks(ctx context.Context, payload []byte, ocr OCREngine) ([]DocumentBlock, error) {
+	blocks, err := parsePDFLayoutBlocks(ctx, payload, ocr)
+	if err == nil && len(blocks) > 0 {
+		return blocks, nil
+	}
+	if errors.Reason(err) == "PDF_NO_TEXT" {
+		// The text layer is missing; byte scraping would only produce garbage.
+		return nil, err
+	}
+	blocks, err = parsePDFBlocksPlain(payload)
 	if err == nil && len(blocks) > 0 {
 		return blocks, nil
 	}
@@ -447,27 +464,39 @@ func parseMarkdownBlocks(raw string) ([]DocumentBlock, string) {
 	blocks := make([]DocumentBlock, 0)
 	section := ""
 	title := ""
+	// path[i] is the current heading of level i+1.
+	path := make([]string, 0, 6)
+	inFence := false
 	buf := make([]string, 0, 16)
 	flush := func() {
 		if len(buf) == 0 {
 			return
 		}
 		blocks = append(blocks, DocumentBlock{
-			Text:    strings.Join(buf, "\n"),
-			Section: section,
+			Text:        strings.Join(buf, "\n"),
+			Section:     section,
+			HeadingPath: compactHeadingPath(path),
 		})
 		buf = buf[:0]
 	}
 	for _, line := range lines {
 		trimmed := strings.TrimSpace(line)
-		if strings.HasPrefix(trimmed, "#") {
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+		}
+		if !inFence && strings.HasPrefix(trimmed, "#") {
+			level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
 			heading := strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
-			if heading != "" {
+			if heading != "" && level <= 6 {
 				if title == "" {
 					title = heading
 				}
 				flush()
 				section = heading
+				for len(path) < level {
+					path = append(path, "")
+				}
+				path = append(path[:level-1], heading)
 				continue
 			}
 		}
@@ -480,6 +509,19 @@ func parseMarkdownBlocks(raw string) ([]DocumentBlock, string) {
 	return blocks, title
 }
 
+func compactHeadingPath(path []string) []string {
+	out := make([]string, 0, len(path))
+	for _, heading := range path {
+		if heading != "" {
+			out = append(out, heading)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
 func splitTextBlocks(raw string) ([]DocumentBlock, string) {
 	normalized := strings.ReplaceAll(raw, "\r\n", "\n")
 	normalized = strings.ReplaceAll(normalized, "\r", "\n")
@@ -0,0 +1,638 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	ldpdf "github.com/ledongthuc/pdf"
+)
+
+const (
+	pdfDefaultFontSize     = 10.0
+	pdfRowToleranceRatio   = 0.5
+	pdfWordGapRatio        = 0.2
+	pdfCellGapRatio        = 1.5
+	pdfParagraphGapRatio   = 1.8
+	pdfHeadingSizeRatio    = 1.15
+	pdfColumnMinWidthRatio = 0.25
+	pdfColumnGutterRatio   = 0.02
+	pdfColumnMinLines      = 3
+	pdfRepeatedEdgeLines   = 2
+	pdfEdgeBandRatio       = 0.1
+	pdfMaxHeadingRunes     = 120
+	pdfMaxTableCellRunes   = 60
+)
+
+var pdfPageNumberRe = regexp.MustCompile(`^[\s\-–—]*(?:page\s*)?#+(?:\s*(?:/|of)\s*#+)?[\s\-–—]*$`)
+
+// pdfFragment is a run of glyphs on one baseline without large horizontal gaps.
+type pdfFragment struct {
+	text     string
+	x0       float64
+	x1       float64
+	y        float64
+	fontSize float64
+}
+
+// pdfLine is a line in reading order. Lines with several cells are table row candidates.
+type pdfLine struct {
+	cells    []string
+	x0       float64
+	x1       float64
+	y        float64
+	fontSize float64
+}
+
+func (l pdfLine) text() string {
+	return strings.TrimSpace(strings.Join(l.cells, " "))
+}
+
+// pdfPageLayout is the layout-ordered content of one page.
+type pdfPageLayout struct {
+	pageNo    int32
+	width     float64
+	height    float64
+	lines     []pdfLine
+	hasImages bool
+	// images and ocrBlocks are only filled for pages without a text layer.
+	images    []OCRImage
+	ocrBlocks []DocumentBlock
+}
+
+// parsePDFLayoutBlocks runs the layout-aware pass: reading order for columns,
+// header/footer removal, heading detection by font size and markdown tables.
+// Pages without a text layer are sent through OCR when an engine is configured.
+func parsePDFLayoutBlocks(ctx context.Context, payload []byte, ocr OCREngine) ([]DocumentBlock, error) {
+	pages, err := readPDFLayout(payload, ocr != nil)
+	if err != nil {
+		return nil, err
+	}
+	if ocr != nil {
+		if err := recognizePDFPages(ctx, ocr, pages); err != nil {
+			return nil, err
+		}
+	}
+	if !pdfLayoutHasText(pages) {
+		return nil, pdfNoTextError(pages, ocr != nil)
+	}
+	bodySize := pdfBodyFontSize(pages)
+	removePDFRepeatedEdges(pages, bodySize)
+	return buildPDFLayoutBlocks(pages, bodySize), nil
+}
+
+func readPDFLayout(payload []byte, withImages bool) (pages []pdfPageLayout, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			pages = nil
+			err = errors.BadRequest("PDF_PARSE_FAILED", "pdf content parse failed")
+		}
+	}()
+	if len(payload) == 0 {
+		return nil, errors.BadRequest("PDF_CONTENT_EMPTY", "pdf content missing")
+	}
+	reader, err := ldpdf.NewReader(bytes.NewReader(payload), int64(len(payload)))
+	if err != nil {
+		return nil, err
+	}
+	pages = make([]pdfPageLayout, 0, reader.NumPage())
+	var jpegs []*pdfJPEG
+	for i := 1; i <= reader.NumPage(); i++ {
+		page := reader.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+		width, height := pdfPageSize(page)
+		layout := pdfPageLayout{
+			pageNo:    int32(i),
+			width:     width,
+			height:    height,
+			hasImages: pdfPageHasImages(page),
+		}
+		if page.V.Key("Contents").Kind() != ldpdf.Null {
+			rows := groupPDFRows(page.Content().Text)
+			if layout.width <= 0 {
+				layout.width = pdfRowsWidth(rows)
+			}
+			layout.lines = orderPDFLines(rows, layout.width)
+		}
+		if withImages && layout.hasImages && len(layout.lines) == 0 {
+			if jpegs == nil {
+				jpegs = scanPDFJPEGs(payload)
+			}
+			layout.images = pdfPageImages(page, jpegs)
+		}
+		pages = append(pages, layout)
+	}
+	return pages, nil
+}
+
+func pdfPageSize(page ldpdf.Page) (float64, float64) {
+	var box ldpdf.Value
+	for v := page.V; !v.IsNull(); v = v.Key("Parent") {
+		if box = v.Key("MediaBox"); !box.IsNull() {
+			break
+		}
+	}
+	if box.Kind() != ldpdf.Array || box.Len() < 4 {
+		return 0, 0
+	}
+	width := math.Abs(box.Index(2).Float64() - box.Index(0).Float64())
+	height := math.Abs(box.Index(3).Float64() - box.Index(1).Float64())
+	return width, height
+}
+
+func pdfPageHasImages(page ldpdf.Page) bool {
+	xobjects := page.Resources().Key("XObject")
+	if xobjects.Kind() != ldpdf.Dict {
+		return false
+	}
+	for _, name := range xobjects.Keys() {
+		if xobjects.Key(name).Key("Subtype").Name() == "Image" {
+			return true
+		}
+	}
+	return false
+}
+
+func pdfLayoutHasText(pages []pdfPageLayout) bool {
+	for _, page := range pages {
+		if len(page.ocrBlocks) > 0 {
+			return true
+		}
+		for _, line := range page.lines {
+			if hasLetterOrDigit(line.text()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func pdfNoTextError(pages []pdfPageLayout, ocrEnabled bool) error {
+	for _, page := range pages {
+		if !page.hasImages {
+			continue
+		}
+		if ocrEnabled {
+			return errors.BadRequest("PDF_NO_TEXT", "pdf has no extractable text layer and ocr found no text")
+		}
+		return errors.BadRequest("PDF_NO_TEXT", "pdf has no extractable text layer; pages look scanned (image only), configure data.knowledge.ocr")
+	}
+	return errors.BadRequest("PDF_NO_TEXT", "pdf has no extractable text")
+}
+
+func pdfFontSize(size float64) float64 {
+	size = math.Abs(size)
+	if size < 1 {
+		return pdfDefaultFontSize
+	}
+	return size
+}
+
+// groupPDFRows clusters glyphs by baseline and splits each row into fragments at wide gaps.
+func groupPDFRows(glyphs []ldpdf.Text) [][]pdfFragment {
+	if len(glyphs) == 0 {
+		return nil
+	}
+	sorted := make([]ldpdf.Text, 0, len(glyphs))
+	for _, g := range glyphs {
+		if g.S == "" {
+			continue
+		}
+		sorted = append(sorted, g)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Y != sorted[j].Y {
+			return sorted[i].Y > sorted[j].Y
+		}
+		return sorted[i].X < sorted[j].X
+	})
+
+	rows := make([][]pdfFragment, 0)
+	var current []ldpdf.Text
+	rowY := 0.0
+	flush := func() {
+		if len(current) == 0 {
+			return
+		}
+		if fragments := splitPDFRow(current); len(fragments) > 0 {
+			rows = append(rows, fragments)
+		}
+		current = nil
+	}
+	for _, g := range sorted {
+		tolerance := pdfFontSize(g.FontSize) * pdfRowToleranceRatio
+		if len(current) > 0 && math.Abs(g.Y-rowY) > tolerance {
+			flush()
+		}
+		if len(current) == 0 {
+			rowY = g.Y
+		}
+		current = append(current, g)
+	}
+	flush()
+	return rows
+}
+
+func splitPDFRow(glyphs []ldpdf.Text) []pdfFragment {
+	sort.SliceStable(glyphs, func(i, j int) bool { return glyphs[i].X < glyphs[j].X })
+	fragments := make([]pdfFragment, 0, 1)
+	var b strings.Builder
+	frag := pdfFragment{}
+	prevEnd := 0.0
+	pendingSpace := false
+	flush := func() {
+		text := strings.TrimSpace(b.String())
+		if text != "" {
+			frag.text = text
+			fragments = append(fragments, frag)
+		}
+		b.Reset()
+		frag = pdfFragment{}
+		pendingSpace = false
+	}
+	for _, g := range glyphs {
+		size := pdfFontSize(g.FontSize)
+		width := g.W
+		if width <= 0 {
+			width = size * 0.5
+		}
+		if strings.TrimSpace(g.S) == "" {
+			if b.Len() > 0 {
+				pendingSpace = true
+				prevEnd = g.X + width
+			}
+			continue
+		}
+		if b.Len() > 0 {
+			gap := g.X - prevEnd
+			if gap > size*pdfCellGapRatio {
+				flush()
+			} else if pendingSpace || gap > size*pdfWordGapRatio {
+				b.WriteByte(' ')
+			}
+		}
+		if b.Len() == 0 {
+			frag.x0 = g.X
+			frag.y = g.Y
+		}
+		pendingSpace = false
+		b.WriteString(g.S)
+		frag.x1 = g.X + width
+		if size > frag.fontSize {
+			frag.fontSize = size
+		}
+		prevEnd = g.X + width
+	}
+	flush()
+	return fragments
+}
+
+func pdfRowsWidth(rows [][]pdfFragment) float64 {
+	maxX := 0.0
+	for _, row := range rows {
+		for _, frag := range row {
+			maxX = math.Max(maxX, frag.x1)
+		}
+	}
+	return maxX
+}
+
+// orderPDFLines reconstructs reading order. On two-column pages the left column
+// is emitted before the right one, with full-width rows acting as region breaks.
+func orderPDFLines(rows [][]pdfFragment, width float64) []pdfLine {
+	if len(rows) == 0 {
+		return nil
+	}
+	if width <= 0 || !isTwoColumnPDFPage(rows, width) {
+		lines := make([]pdfLine, 0, len(rows))
+		for _, row := range rows {
+			lines = append(lines, pdfLineFromFragments(row))
+		}
+		return lines
+	}
+	mid := width / 2
+	gutter := width * pdfColumnGutterRatio
+	lines := make([]pdfLine, 0, len(rows))
+	left := make([]pdfLine, 0)
+	right := make([]pdfLine, 0)
+	flushRegion := func() {
+		lines = append(lines, left...)
+		lines = append(lines, right...)
+		left = left[:0]
+		right = right[:0]
+	}
+	for _, row := range rows {
+		// Rows with three or more cells are table rows that run across the gutter.
+		spanning := len(row) > 2
+		for _, frag := range row {
+			if frag.x1 > mid+gutter && frag.x0 < mid-gutter {
+				spanning = true
+				break
+			}
+		}
+		if spanning {
+			flushRegion()
+			lines = append(lines, pdfLineFromFragments(row))
+			continue
+		}
+		var leftFrags, rightFrags []pdfFragment
+		for _, frag := range row {
+			if frag.x1 <= mid+gutter {
+				leftFrags = append(leftFrags, frag)
+			} else {
+				rightFrags = append(rightFrags, frag)
+			}
+		}
+		if len(leftFrags) > 0 {
+			left = append(left, pdfLineFromFragments(leftFrags))
+		}
+		if len(rightFrags) > 0 {
+			right = append(right, pdfLineFromFragments(rightFrags))
+		}
+	}
+	flushRegion()
+	return lines
+}
+
+func isTwoColumnPDFPage(rows [][]pdfFragment, width float64) bool {
+	mid := width / 2
+	gutter := width * pdfColumnGutterRatio
+	minWidth := width * pdfColumnMinWidthRatio
+	wideLeft, wideRight, spanning := 0, 0, 0
+	for _, row := range rows {
+		for _, frag := range row {
+			fragWidth := frag.x1 - frag.x0
+			switch {
+			case frag.x1 <= mid+gutter:
+				if fragWidth >= minWidth {
+					wideLeft++
+				}
+			case frag.x0 >= mid-gutter:
+				if fragWidth >= minWidth {
+					wideRight++
+				}
+			default:
+				spanning++
+			}
+		}
+	}
+	if wideLeft < pdfColumnMinLines || wideRight < pdfColumnMinLines {
+		return false
+	}
+	return spanning < (wideLeft+wideRight)/2
+}
+
+func pdfLineFromFragments(frags []pdfFragment) pdfLine {
+	line := pdfLine{cells: make([]string, 0, len(frags))}
+	for i, frag := range frags {
+		line.cells = append(line.cells, frag.text)
+		if i == 0 || frag.x0 < line.x0 {
+			line.x0 = frag.x0
+		}
+		if frag.x1 > line.x1 {
+			line.x1 = frag.x1
+		}
+		if frag.y > line.y {
+			line.y = frag.y
+		}
+		if frag.fontSize > line.fontSize {
+			line.fontSize = frag.fontSize
+		}
+	}
+	return line
+}
+
+// removePDFRepeatedEdges drops running headers/footers and page numbers that
+// repeat in the top or bottom margin of pages.
+func removePDFRepeatedEdges(pages []pdfPageLayout, bodySize float64) {
+	counts := make(map[string]int)
+	for _, page := range pages {
+		seen := make(map[string]bool)
+		for _, idx := range pdfEdgeCandidates(page, bodySize) {
+			key := pdfEdgeKey(page.lines[idx].text())
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			counts[key]++
+		}
+	}
+	minRepeats := len(pages) / 2
+	if minRepeats < 2 {
+		minRepeats = 2
+	}
+	for p := range pages {
+		drop := make(map[int]bool)
+		for _, idx := range pdfEdgeCandidates(pages[p], bodySize) {
+			key := pdfEdgeKey(pages[p].lines[idx].text())
+			if key == "" {
+				continue
+			}
+			if pdfPageNumberRe.MatchString(key) || (len(pages) >= 3 && counts[key] >= minRepeats) {
+				drop[idx] = true
+			}
+		}
+		if len(drop) == 0 {
+			continue
+		}
+		kept := make([]pdfLine, 0, len(pages[p].lines)-len(drop))
+		for i, line := range pages[p].lines {
+			if !drop[i] {
+				kept = append(kept, line)
+			}
+		}
+		pages[p].lines = kept
+	}
+}
+
+// pdfEdgeCandidates returns the indexes of the first and last lines of a page
+// that sit in the margin band and are not set larger than body text.
+func pdfEdgeCandidates(page pdfPageLayout, bodySize float64) []int {
+	n := len(page.lines)
+	out := make([]int, 0, pdfRepeatedEdgeLines*2)
+	for i := 0; i < n; i++ {
+		if i >= pdfRepeatedEdgeLines && i < n-pdfRepeatedEdgeLines {
+			continue
+		}
+		line := page.lines[i]
+		if line.fontSize > bodySize*1.05 {
+			continue
+		}
+		if page.height > 0 {
+			band := page.height * pdfEdgeBandRatio
+			if line.y < page.height-band && line.y > band {
+				continue
+			}
+		}
+		out = append(out, i)
+	}
+	return out
+}
+
+// pdfEdgeKey normalizes a header/footer candidate so that page numbers do not
+// prevent matching ("Page 3 of 9" and "Page 4 of 9" share a key).
+func pdfEdgeKey(text string) string {
+	var b strings.Builder
+	lastDigit := false
+	for _, r := range strings.ToLower(strings.Join(strings.Fields(text), " ")) {
+		if unicode.IsDigit(r) {
+			if !lastDigit {
+				b.WriteRune('#')
+			}
+			lastDigit = true
+			continue
+		}
+		lastDigit = false
+		b.WriteRune(r)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// pdfBodyFontSize returns the most common font size weighted by text length.
+func pdfBodyFontSize(pages []pdfPageLayout) float64 {
+	weights := make(map[float64]int)
+	for _, page := range pages {
+		for _, line := range page.lines {
+			size := math.Round(line.fontSize*2) / 2
+			weights[size] += len([]rune(line.text()))
+		}
+	}
+	best, bestWeight := pdfDefaultFontSize, 0
+	for size, weight := range weights {
+		if weight > bestWeight || (weight == bestWeight && size < best) {
+			best, bestWeight = size, weight
+		}
+	}
+	return best
+}
+
+func buildPDFLayoutBlocks(pages []pdfPageLayout, bodySize float64) []DocumentBlock {
+	blocks := make([]DocumentBlock, 0)
+	section := ""
+	for _, page := range pages {
+		buf := make([]string, 0, 8)
+		flush := func() {
+			if len(buf) == 0 {
+				return
+			}
+			blocks = append(blocks, DocumentBlock{
+				Text:    strings.Join(buf, "\n"),
+				Section: section,
+				PageNo:  page.pageNo,
+			})
+			buf = buf[:0]
+		}
+		for _, block := range page.ocrBlocks {
+			block.Section = section
+			blocks = append(blocks, block)
+		}
+		lines := page.lines
+		for i := 0; i < len(lines); i++ {
+			line := lines[i]
+			if end := pdfTableRunEnd(lines, i); end > i+1 {
+				flush()
+				blocks = append(blocks, DocumentBlock{
+					Text:    renderPDFMarkdownTable(lines[i:end]),
+					Section: section,
+					PageNo:  page.pageNo,
+				})
+				i = end - 1
+				continue
+			}
+			text := line.text()
+			if text == "" {
+				continue
+			}
+			if isPDFHeading(line, bodySize) {
+				flush()
+				section = text
+				continue
+			}
+			if len(buf) > 0 && i > 0 {
+				prev := lines[i-1]
+				gap := prev.y - line.y
+				if gap < 0 || gap > pdfFontSize(prev.fontSize)*pdfParagraphGapRatio {
+					flush()
+				}
+			}
+			if n := len(buf); n > 0 && strings.HasSuffix(buf[n-1], "-") && startsLower(text) {
+				buf[n-1] = strings.TrimSuffix(buf[n-1], "-") + text
+				continue
+			}
+			buf = append(buf, text)
+		}
+		flush()
+	}
+	return blocks
+}
+
+func isPDFHeading(line pdfLine, bodySize float64) bool {
+	if len(line.cells) != 1 || bodySize <= 0 {
+		return false
+	}
+	text := line.text()
+	if len([]rune(text)) > pdfMaxHeadingRunes || !hasLetterOrDigit(text) {
+		return false
+	}
+	return line.fontSize >= bodySize*pdfHeadingSizeRatio
+}
+
+// pdfTableRunEnd returns the end (exclusive) of a run of table rows starting at
+// start, i.e. consecutive lines with the same number (>= 2) of short cells.
+func pdfTableRunEnd(lines []pdfLine, start int) int {
+	cols := len(lines[start].cells)
+	if cols < 2 || !isPDFTableRow(lines[start]) {
+		return start
+	}
+	end := start + 1
+	for end < len(lines) && len(lines[end].cells) == cols && isPDFTableRow(lines[end]) {
+		end++
+	}
+	return end
+}
+
+func isPDFTableRow(line pdfLine) bool {
+	total := 0
+	for _, cell := range line.cells {
+		total += len([]rune(cell))
+	}
+	return total <= pdfMaxTableCellRunes*len(line.cells)
+}
+
+func renderPDFMarkdownTable(rows []pdfLine) string {
+	var b strings.Builder
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+		for _, cell := range cells {
+			b.WriteString(" ")
+			b.WriteString(strings.ReplaceAll(strings.TrimSpace(cell), "|", `\|`))
+			b.WriteString(" |")
+		}
+		b.WriteString("\n")
+	}
+	for i, row := range rows {
+		writeRow(row.cells)
+		if i == 0 {
+			sep := make([]string, len(row.cells))
+			for j := range sep {
+				sep[j] = "---"
+			}
+			writeRow(sep)
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func startsLower(s string) bool {
+	for _, r := range s {
+		return unicode.IsLower(r)
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+package biz
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// fakeOCR returns blocks for every image and records what it was given.
+type fakeOCR struct {
+	blocks []OCRBlock
+	images []OCRImage
+}
+
+func (f *fakeOCR) Recognize(_ context.Context, img OCRImage) ([]OCRBlock, error) {
+	f.images = append(f.images, img)
+	return f.blocks, nil
+}
+
+func readPDFFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	raw, err := os.ReadFile(filepath.Join("testdata", "pdf", name))
+	if err != nil {
+		t.Fatalf("read fixture %s: %v", name, err)
+	}
+	return raw
+}
+
+func TestParsePDFLayoutBlocks(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		ocr     *fakeOCR
+		want    []DocumentBlock
+		// wantReason and wantMessage describe the expected error.
+		wantReason  string
+		wantMessage string
+		wantImages  int
+	}{
+		{
+			name:    "text layer",
+			fixture: "text_layer.pdf",
+			want: []DocumentBlock{
+				{Text: "RagoDesk answers customer questions from your documents.\nUpload manuals and policies to a knowledge base.", Section: "Getting Started", PageNo: 1},
+				{Text: "Each bot searches the knowledge bases bound to it.", Section: "Getting Started", PageNo: 1},
+				{Text: "| Plan | Seats | Price |\n| --- | --- | --- |\n| Basic | 5 | 10 |\n| Pro | 50 | 80 |", Section: "Plans", PageNo: 2},
+				{Text: "Unresolved sessions are handed to a human agent.", Section: "Escalation", PageNo: 3},
+			},
+		},
+		{
+			name:    "text layer is not sent to ocr",
+			fixture: "text_layer.pdf",
+			ocr:     &fakeOCR{blocks: []OCRBlock{{Text: "unexpected", Confidence: 0.9}}},
+			want: []DocumentBlock{
+				{Text: "RagoDesk answers customer questions from your documents.\nUpload manuals and policies to a knowledge base.", Section: "Getting Started", PageNo: 1},
+				{Text: "Each bot searches the knowledge bases bound to it.", Section: "Getting Started", PageNo: 1},
+				{Text: "| Plan | Seats | Price |\n| --- | --- | --- |\n| Basic | 5 | 10 |\n| Pro | 50 | 80 |", Section: "Plans", PageNo: 2},
+				{Text: "Unresolved sessions are handed to a human agent.", Section: "Escalation", PageNo: 3},
+			},
+		},
+		{
+			name:        "scanned without ocr",
+			fixture:     "scanned.pdf",
+			wantReason:  "PDF_NO_TEXT",
+			wantMessage: "configure data.knowledge.ocr",
+		},
+		{
+			name:       "scanned with ocr",
+			fixture:    "scanned.pdf",
+			ocr:        &fakeOCR{blocks: []OCRBlock{{Text: "Refunds take five days.", Confidence: 0.9}}},
+			want:       []DocumentBlock{{Text: "Refunds take five days.", PageNo: 1, Confidence: 0.9}},
+			wantImages: 1,
+		},
+		{
+			name:        "scanned and ocr finds nothing",
+			fixture:     "scanned.pdf",
+			ocr:         &fakeOCR{},
+			wantReason:  "PDF_NO_TEXT",
+			wantMessage: "ocr found no text",
+			wantImages:  1,
+		},
+		{
+			name:        "blank",
+			fixture:     "blank.pdf",
+			wantReason:  "PDF_NO_TEXT",
+			wantMessage: "pdf has no extractable text",
+		},
+		{
+			name:        "blank with ocr",
+			fixture:     "blank.pdf",
+			ocr:         &fakeOCR{blocks: []OCRBlock{{Text: "unexpected", Confidence: 0.9}}},
+			wantReason:  "PDF_NO_TEXT",
+			wantMessage: "pdf has no extractable text",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ocr OCREngine
+			if tt.ocr != nil {
+				ocr = tt.ocr
+			}
+			blocks, err := parsePDFLayoutBlocks(context.Background(), readPDFFixture(t, tt.fixture), ocr)
+			if tt.wantReason != "" {
+				if err == nil {
+					t.Fatalf("want %s error, got blocks %+v", tt.wantReason, blocks)
+				}
+				if reason := errors.Reason(err); reason != tt.wantReason {
+					t.Fatalf("reason = %q, want %q (err %v)", reason, tt.wantReason, err)
+				}
+				if !strings.Contains(errors.FromError(err).Message, tt.wantMessage) {
+					t.Fatalf("message = %q, want it to contain %q", errors.FromError(err).Message, tt.wantMessage)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("parse: %v", err)
+				}
+				if !reflect.DeepEqual(blocks, tt.want) {
+					t.Fatalf("blocks =\n%+v\nwant\n%+v", blocks, tt.want)
+				}
+			}
+			if tt.ocr != nil {
+				if len(tt.ocr.images) != tt.wantImages {
+					t.Fatalf("ocr got %d images, want %d", len(tt.ocr.images), tt.wantImages)
+				}
+				for _, img := range tt.ocr.images {
+					if img.ContentType != "image/png" || len(img.Data) == 0 {
+						t.Fatalf("ocr image = %s (%d bytes), want a png", img.ContentType, len(img.Data))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPDFNoTextError(t *testing.T) {
+	tests := []struct {
+		name        string
+		pages       []pdfPageLayout
+		ocrEnabled  bool
+		wantMessage string
+	}{
+		{
+			name:        "no pages",
+			wantMessage: "pdf has no extractable text",
+		},
+		{
+			name:        "pages without images",
+			pages:       []pdfPageLayout{{pageNo: 1}, {pageNo: 2}},
+			wantMessage: "pdf has no extractable text",
+		},
+		{
+			name:        "image pages without ocr",
+			pages:       []pdfPageLayout{{pageNo: 1}, {pageNo: 2, hasImages: true}},
+			wantMessage: "pdf has no extractable text layer; pages look scanned (image only), configure data.knowledge.ocr",
+		},
+		{
+			name:        "image pages with ocr",
+			pages:       []pdfPageLayout{{pageNo: 1, hasImages: true}},
+			ocrEnabled:  true,
+			wantMessage: "pdf has no extractable text layer and ocr found no text",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := errors.FromError(pdfNoTextError(tt.pages, tt.ocrEnabled))
+			if err.Reason != "PDF_NO_TEXT" || err.Code != 400 {
+				t.Fatalf("error = %d %s, want 400 PDF_NO_TEXT", err.Code, err.Reason)
+			}
+			if err.Message != tt.wantMessage {
+				t.Fatalf("message = %q, want %q", err.Message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestPDFLayoutHasText(t *testing.T) {
+	tests := []struct {
+		name  string
+		pages []pdfPageLayout
+		want  bool
+	}{
+		{name: "empty", want: false},
+		{name: "punctuation only", pages: []pdfPageLayout{{lines: []pdfLine{{cells: []string{"- . -"}}}}}, want: false},
+		{name: "text line", pages: []pdfPageLayout{{lines: []pdfLine{{cells: []string{"Refunds"}}}}}, want: true},
+		{name: "ocr block", pages: []pdfPageLayout{{ocrBlocks: []DocumentBlock{{Text: "Refunds"}}}}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pdfLayoutHasText(tt.pages); got != tt.want {
+				t.Fatalf("pdfLayoutHasText = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
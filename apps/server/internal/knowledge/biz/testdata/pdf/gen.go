@@ -0,0 +1,144 @@
+//go:build ignore
+
+// gen writes the PDF fixtures of the layout parser tests:
+//
+//	go run ./testdata/pdf/gen.go
+//
+// run from internal/knowledge/biz.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type textItem struct {
+	size int
+	x, y int
+	text string
+}
+
+func main() {
+	dir := filepath.Join("testdata", "pdf")
+	files := map[string][]byte{
+		"text_layer.pdf": textLayer(),
+		"scanned.pdf":    scanned(),
+		"blank.pdf":      blank(),
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// textLayer has three pages with a running header and page numbers, headings
+// set larger than the body, two paragraphs and a table.
+func textLayer() []byte {
+	header := textItem{9, 72, 760, "ACME Support Handbook"}
+	pages := [][]textItem{
+		{
+			header,
+			{18, 72, 700, "Getting Started"},
+			{11, 72, 670, "RagoDesk answers customer questions from your documents."},
+			{11, 72, 656, "Upload manuals and policies to a knowledge base."},
+			{11, 72, 620, "Each bot searches the knowledge bases bound to it."},
+			{9, 300, 30, "1"},
+		},
+		{
+			header,
+			{18, 72, 700, "Plans"},
+			{11, 72, 670, "Plan"}, {11, 250, 670, "Seats"}, {11, 400, 670, "Price"},
+			{11, 72, 656, "Basic"}, {11, 250, 656, "5"}, {11, 400, 656, "10"},
+			{11, 72, 642, "Pro"}, {11, 250, 642, "50"}, {11, 400, 642, "80"},
+			{9, 300, 30, "2"},
+		},
+		{
+			header,
+			{18, 72, 700, "Escalation"},
+			{11, 72, 670, "Unresolved sessions are handed to a human agent."},
+			{9, 300, 30, "3"},
+		},
+	}
+	contents := make([][]byte, 0, len(pages))
+	for _, items := range pages {
+		contents = append(contents, textOps(items))
+	}
+	return build(pageObjects(contents, "<< /Font << /F1 3 0 R >> >>"))
+}
+
+// scanned has one page that only draws an uncompressed grayscale image.
+func scanned() []byte {
+	const size = 16
+	pixels := make([]byte, size*size)
+	for i := range pixels {
+		pixels[i] = byte(i * 16)
+	}
+	objs := pageObjects([][]byte{[]byte("q 200 0 0 200 72 500 cm /Im1 Do Q")}, "<< /XObject << /Im1 6 0 R >> >>")
+	objs = append(objs, stream(pixels, fmt.Sprintf(" /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8", size, size)))
+	return build(objs)
+}
+
+// blank has one page with neither text nor images.
+func blank() []byte {
+	return build(pageObjects([][]byte{[]byte("q Q")}, "<< >>"))
+}
+
+// pageObjects returns the catalog, page tree, a Helvetica font and one page
+// and content stream per page, numbered from 1.
+func pageObjects(contents [][]byte, resources string) [][]byte {
+	widths := strings.TrimSpace(strings.Repeat("500 ", 126-32+1))
+	objs := [][]byte{
+		[]byte("<< /Type /Catalog /Pages 2 0 R >>"),
+		nil,
+		[]byte("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding /FirstChar 32 /LastChar 126 /Widths [" + widths + "] >>"),
+	}
+	kids := make([]string, 0, len(contents))
+	for _, content := range contents {
+		pageNo := len(objs) + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageNo))
+		objs = append(objs, []byte(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources %s /Contents %d 0 R >>", resources, pageNo+1)))
+		objs = append(objs, stream(content, ""))
+	}
+	objs[1] = []byte(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(contents)))
+	return objs
+}
+
+func textOps(items []textItem) []byte {
+	escape := strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`)
+	ops := make([]string, 0, len(items))
+	for _, item := range items {
+		ops = append(ops, fmt.Sprintf("BT /F1 %d Tf %d %d Td (%s) Tj ET", item.size, item.x, item.y, escape.Replace(item.text)))
+	}
+	return []byte(strings.Join(ops, "\n"))
+}
+
+func stream(data []byte, extra string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "<< /Length %d%s >>\nstream\n", len(data), extra)
+	b.Write(data)
+	b.WriteString("\nendstream")
+	return b.Bytes()
+}
+
+func build(objs [][]byte) []byte {
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+	offsets := make([]int, 0, len(objs))
+	for i, body := range objs {
+		offsets = append(offsets, b.Len())
+		fmt.Fprintf(&b, "%d 0 obj\n", i+1)
+		b.Write(body)
+		b.WriteString("\nendobj\n")
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objs)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objs)+1, xref)
+	return b.Bytes()
+}
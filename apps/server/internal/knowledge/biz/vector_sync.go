@@ -0,0 +1,244 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// vectorOutboxInterval is how often the outbox worker looks for vector writes
+// that were not applied right after their MySQL transaction.
+const vectorOutboxInterval = 5 * time.Second
+
+// ChunkVectorRef locates the vector an indexed chunk should have.
+type ChunkVectorRef struct {
+	ChunkID           string
+	KBID              string
+	DocumentID        string
+	DocumentVersionID string
+	// VectorCollection is the collection key of the version.
+	VectorCollection string
+	EmbeddingModel   string
+}
+
+// VectorPointRef is a point found in the vector store.
+type VectorPointRef struct {
+	VectorCollection string
+	PointID          string
+	DocumentID       string
+}
+
+// VectorReconcileReport is the drift between doc_chunk and the vector store of
+// a tenant or knowledge base.
+type VectorReconcileReport struct {
+	KBID   string
+	Chunks int
+	Points int
+	// MissingVectors are chunks without a point in their collection.
+	MissingVectors []ChunkVectorRef
+	// OrphanPoints are points without a chunk.
+	OrphanPoints []VectorPointRef
+	// SkippedDocuments have pending outbox rows and are left out of the diff.
+	SkippedDocuments int
+	RestoredVectors  int
+	DeletedPoints    int
+	// Unrepaired counts missing vectors that could not be restored, e.g. chunks
+	// embedded with a model the knowledge base no longer uses.
+	Unrepaired int
+}
+
+// Drift reports whether the scan found missing vectors or orphan points.
+func (r VectorReconcileReport) Drift() bool {
+	return len(r.MissingVectors) > 0 || len(r.OrphanPoints) > 0
+}
+
+// StartVectorOutboxWorker applies pending vector outbox rows in the background
+// until ctx is done.
+func (uc *KnowledgeUsecase) StartVectorOutboxWorker(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(vectorOutboxInterval)
+		defer ticker.Stop()
+		for {
+			applied, err := uc.repo.ApplyVectorOutbox(ctx)
+			if uc.log != nil {
+				if err != nil {
+					uc.log.Warnf("vector outbox apply failed: %v", err)
+				} else if applied > 0 {
+					uc.log.Infof("vector outbox applied=%d", applied)
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// ReconcileVectors diffs the chunks of the tenant in ctx (of kbID when set)
+// against the points in the vector store. With repair, orphan points are
+// deleted and missing vectors are re-embedded.
+func (uc *KnowledgeUsecase) ReconcileVectors(ctx context.Context, kbID string, repair bool) (VectorReconcileReport, error) {
+	kbID = strings.TrimSpace(kbID)
+	report := VectorReconcileReport{KBID: kbID}
+	var kbs []KnowledgeBase
+	if kbID != "" {
+		kb, err := uc.repo.GetKnowledgeBase(ctx, kbID)
+		if err != nil {
+			return report, err
+		}
+		kbs = []KnowledgeBase{kb}
+	} else {
+		items, err := uc.repo.ListKnowledgeBases(ctx)
+		if err != nil {
+			return report, err
+		}
+		kbs = items
+	}
+	kbByID := make(map[string]KnowledgeBase, len(kbs))
+	extra := make([]string, 0, len(kbs))
+	for _, kb := range kbs {
+		kbByID[kb.ID] = kb
+		embedder := uc.embedderFor(kb.Embedding)
+		extra = append(extra, vectorCollectionKey(kb.Embedding, embedder.Model(), embedder.Dim(), kb.IndexGeneration))
+	}
+
+	pendingDocs, err := uc.repo.ListPendingVectorDocuments(ctx)
+	if err != nil {
+		return report, err
+	}
+	pending := make(map[string]struct{}, len(pendingDocs))
+	for _, id := range pendingDocs {
+		pending[id] = struct{}{}
+	}
+	chunks, err := uc.repo.ListChunkVectorRefs(ctx, kbID)
+	if err != nil {
+		return report, err
+	}
+	points, err := uc.repo.ListVectorPointRefs(ctx, kbID, extra)
+	if err != nil {
+		return report, err
+	}
+	report.Chunks = len(chunks)
+	report.Points = len(points)
+
+	skipped := make(map[string]struct{})
+	expected := make(map[string]struct{}, len(chunks))
+	for _, ch := range chunks {
+		expected[ch.VectorCollection+"|"+ch.ChunkID] = struct{}{}
+	}
+	found := make(map[string]struct{}, len(points))
+	for _, p := range points {
+		key := p.VectorCollection + "|" + p.PointID
+		found[key] = struct{}{}
+		if _, ok := expected[key]; ok {
+			continue
+		}
+		if _, ok := pending[p.DocumentID]; ok {
+			skipped[p.DocumentID] = struct{}{}
+			continue
+		}
+		report.OrphanPoints = append(report.OrphanPoints, p)
+	}
+	for _, ch := range chunks {
+		if _, ok := found[ch.VectorCollection+"|"+ch.ChunkID]; ok {
+			continue
+		}
+		if _, ok := pending[ch.DocumentID]; ok {
+			skipped[ch.DocumentID] = struct{}{}
+			continue
+		}
+		report.MissingVectors = append(report.MissingVectors, ch)
+	}
+	report.SkippedDocuments = len(skipped)
+	if !repair || !report.Drift() {
+		return report, nil
+	}
+
+	if len(report.OrphanPoints) > 0 {
+		if err := uc.repo.DeleteVectorPoints(ctx, report.OrphanPoints); err != nil {
+			return report, err
+		}
+		report.DeletedPoints = len(report.OrphanPoints)
+	}
+	byVersion := make(map[string][]ChunkVectorRef)
+	order := make([]string, 0)
+	for _, ch := range report.MissingVectors {
+		if _, ok := byVersion[ch.DocumentVersionID]; !ok {
+			order = append(order, ch.DocumentVersionID)
+		}
+		byVersion[ch.DocumentVersionID] = append(byVersion[ch.DocumentVersionID], ch)
+	}
+	for _, versionID := range order {
+		refs := byVersion[versionID]
+		restored, err := uc.restoreVersionVectors(ctx, kbByID, refs)
+		if err != nil {
+			if uc.log != nil {
+				uc.log.Warnf("restore vectors failed: version=%s err=%v", versionID, err)
+			}
+			report.Unrepaired += len(refs)
+			continue
+		}
+		report.RestoredVectors += restored
+		report.Unrepaired += len(refs) - restored
+	}
+	return report, nil
+}
+
+// restoreVersionVectors re-embeds the chunks of one version that lost their
+// points and writes them back to the version's collection.
+func (uc *KnowledgeUsecase) restoreVersionVectors(ctx context.Context, kbByID map[string]KnowledgeBase, refs []ChunkVectorRef) (int, error) {
+	first := refs[0]
+	kb, ok := kbByID[first.KBID]
+	if !ok {
+		var err error
+		kb, err = uc.repo.GetKnowledgeBase(ctx, first.KBID)
+		if err != nil {
+			return 0, err
+		}
+	}
+	embedder, err := uc.tenantEmbedderFor(ctx, kb.Embedding)
+	if err != nil {
+		return 0, err
+	}
+	if first.EmbeddingModel != "" && first.EmbeddingModel != embedder.Model() {
+		return 0, errors.Conflict("EMBEDDING_MODEL_MISMATCH", "chunks were embedded with "+first.EmbeddingModel)
+	}
+	doc, err := uc.repo.GetDocument(ctx, first.DocumentID)
+	if err != nil {
+		return 0, err
+	}
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.ChunkID)
+	}
+	chunks, err := uc.repo.ListVersionChunks(ctx, first.DocumentVersionID, ids)
+	if err != nil {
+		return 0, err
+	}
+	if len(chunks) == 0 {
+		return 0, nil
+	}
+	embedded, _, err := uc.embedChunks(ctx, embedder, chunks)
+	if err != nil {
+		return 0, err
+	}
+	err = uc.repo.RestoreChunkVectors(ctx, IndexDocumentVersionRequest{
+		KBID:              doc.KBID,
+		DocumentID:        doc.ID,
+		DocumentVersionID: first.DocumentVersionID,
+		DocumentTitle:     doc.Title,
+		SourceType:        normalizeSourceType(doc.SourceType),
+		EmbeddingModel:    embedder.Model(),
+		EmbeddingDim:      len(embedded[0].Vector),
+		VectorCollection:  first.VectorCollection,
+		Chunks:            embedded,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(embedded), nil
+}
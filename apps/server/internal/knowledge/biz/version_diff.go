@@ -0,0 +1,300 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+const (
+	// maxVersionDiffLines bounds the parsed lines of each version fed to the
+	// text diff.
+	maxVersionDiffLines = 20000
+	// maxVersionTextDiffBytes bounds the returned unified diff.
+	maxVersionTextDiffBytes = 1 << 20
+	versionTextDiffContext  = 3
+)
+
+// ChunkCitation counts the answers citing a chunk of a document version.
+type ChunkCitation struct {
+	DocumentVersionID string
+	ChunkID           string
+	// ChunkIndex is -1 and ContentHash empty when the chunk is not among the
+	// chunks of the version diff.
+	ChunkIndex  int32
+	ContentHash string
+	Citations   int32
+	LastCitedAt time.Time
+	// Snippet is the snippet of the latest citing answer.
+	Snippet string
+}
+
+// DocumentVersionDiffSide is one compared version.
+type DocumentVersionDiffSide struct {
+	Version DocumentVersion
+	// ChunksRebuilt is set when the chunks of the version were no longer
+	// stored and were rebuilt from its raw object with the current chunking
+	// settings of the knowledge base.
+	ChunksRebuilt bool
+	Citations     []ChunkCitation
+}
+
+// DiffChunk is a chunk of a compared version with the answers citing it.
+type DiffChunk struct {
+	DocChunk
+	Citations int32
+}
+
+// ChunkChange pairs a chunk with its edited counterpart at the same position.
+type ChunkChange struct {
+	From DiffChunk
+	To   DiffChunk
+}
+
+// DocumentVersionDiff compares two versions of a document.
+type DocumentVersionDiff struct {
+	DocumentID string
+	From       DocumentVersionDiffSide
+	To         DocumentVersionDiffSide
+	Added      []DiffChunk
+	Removed    []DiffChunk
+	Modified   []ChunkChange
+	// UnchangedChunks have the same content hash in both versions, possibly
+	// at another position.
+	UnchangedChunks int32
+	// TextDiff is a unified diff of the parsed content of the versions.
+	TextDiff          string
+	TextDiffTruncated bool
+}
+
+type versionSnapshot struct {
+	version DocumentVersion
+	chunks  []DocChunk
+	rebuilt bool
+	text    string
+}
+
+// DiffDocumentVersions compares version from with version to of a document;
+// to defaults to the current version.
+func (uc *KnowledgeUsecase) DiffDocumentVersions(ctx context.Context, documentID string, from int32, to int32) (DocumentVersionDiff, error) {
+	documentID = strings.TrimSpace(documentID)
+	if documentID == "" {
+		return DocumentVersionDiff{}, errors.BadRequest("DOC_ID_MISSING", "document id missing")
+	}
+	doc, err := uc.repo.GetDocument(ctx, documentID)
+	if err != nil {
+		return DocumentVersionDiff{}, err
+	}
+	if to <= 0 {
+		to = doc.CurrentVersion
+	}
+	if from <= 0 || to <= 0 {
+		return DocumentVersionDiff{}, errors.BadRequest("DOC_VERSION_INVALID", "invalid version")
+	}
+	if from == to {
+		return DocumentVersionDiff{}, errors.BadRequest("DOC_VERSION_INVALID", "versions must differ")
+	}
+	var kb KnowledgeBase
+	if doc.KBID != "" {
+		if kb, err = uc.repo.GetKnowledgeBase(ctx, doc.KBID); err != nil {
+			return DocumentVersionDiff{}, err
+		}
+	}
+	fromSnap, err := uc.loadVersionSnapshot(ctx, kb, doc, from)
+	if err != nil {
+		return DocumentVersionDiff{}, err
+	}
+	toSnap, err := uc.loadVersionSnapshot(ctx, kb, doc, to)
+	if err != nil {
+		return DocumentVersionDiff{}, err
+	}
+
+	since := fromSnap.version.CreatedAt
+	if toSnap.version.CreatedAt.Before(since) {
+		since = toSnap.version.CreatedAt
+	}
+	citations, err := uc.repo.ListVersionCitations(ctx, documentID, []string{fromSnap.version.ID, toSnap.version.ID}, since)
+	if err != nil {
+		return DocumentVersionDiff{}, err
+	}
+	configHash := uc.indexConfigHashFor(kb)
+	fromSide, fromCounts := versionDiffSide(fromSnap, citations, configHash)
+	toSide, toCounts := versionDiffSide(toSnap, citations, configHash)
+
+	out := DocumentVersionDiff{
+		DocumentID: documentID,
+		From:       fromSide,
+		To:         toSide,
+	}
+	added, removed, modified, unchanged := diffVersionChunks(fromSnap.chunks, toSnap.chunks)
+	for _, ch := range added {
+		out.Added = append(out.Added, DiffChunk{DocChunk: ch, Citations: toCounts[ch.ID]})
+	}
+	for _, ch := range removed {
+		out.Removed = append(out.Removed, DiffChunk{DocChunk: ch, Citations: fromCounts[ch.ID]})
+	}
+	for _, pair := range modified {
+		out.Modified = append(out.Modified, ChunkChange{
+			From: DiffChunk{DocChunk: pair[0], Citations: fromCounts[pair[0].ID]},
+			To:   DiffChunk{DocChunk: pair[1], Citations: toCounts[pair[1].ID]},
+		})
+	}
+	out.UnchangedChunks = int32(unchanged)
+	out.TextDiff, out.TextDiffTruncated = versionTextDiff(fromSnap, toSnap)
+	return out, nil
+}
+
+// loadVersionSnapshot loads the chunks and parsed text of a version. Chunks
+// of versions whose index was released after a newer version took over are
+// rebuilt from the raw object.
+func (uc *KnowledgeUsecase) loadVersionSnapshot(ctx context.Context, kb KnowledgeBase, doc Document, number int32) (versionSnapshot, error) {
+	version, err := uc.repo.GetDocumentVersionByNumber(ctx, doc.ID, number)
+	if err != nil {
+		return versionSnapshot{}, err
+	}
+	snap := versionSnapshot{version: version}
+	if snap.chunks, err = uc.repo.ListDocumentVersionChunks(ctx, version.ID); err != nil {
+		return snap, err
+	}
+	if normalizeSourceType(doc.SourceType) == "url" {
+		// The page is fetched again on every ingestion; only the chunks keep
+		// the content of a version.
+		if len(snap.chunks) == 0 {
+			return snap, errors.New(412, "DOC_VERSION_CONTENT_MISSING", "url version content no longer stored")
+		}
+		parts := make([]string, 0, len(snap.chunks))
+		for _, ch := range snap.chunks {
+			parts = append(parts, ch.Content)
+		}
+		snap.text = strings.Join(parts, "\n\n")
+		return snap, nil
+	}
+	if strings.TrimSpace(version.RawURI) == "" {
+		return snap, errors.New(412, "DOC_RAW_URI_MISSING", "document raw_uri missing")
+	}
+	_, _, sourceType, rawInput, meta, err := uc.loadIngestionInput(ctx, IngestionJob{DocumentID: doc.ID, DocumentVersionID: version.ID})
+	if err != nil {
+		return snap, err
+	}
+	parsed, err := uc.parseNormalized(ctx, sourceType, rawInput, meta)
+	if err != nil {
+		return snap, err
+	}
+	texts := make([]string, 0, len(parsed.Blocks))
+	for _, block := range parsed.Blocks {
+		texts = append(texts, block.Text)
+	}
+	snap.text = strings.Join(texts, "\n\n")
+	if len(snap.chunks) == 0 {
+		embedder, err := uc.tenantEmbedderFor(ctx, kb.Embedding)
+		if err != nil {
+			return snap, err
+		}
+		if snap.chunks, err = uc.chunkParsed(ctx, kb, embedder, parsed, meta, version.ID); err != nil {
+			return snap, err
+		}
+		snap.rebuilt = true
+	}
+	return snap, nil
+}
+
+// versionDiffSide collects the citations of a version and counts them per
+// chunk. Rebuilt chunks keep their IDs (derived from version and position)
+// only while the chunking settings are those the version was indexed with.
+func versionDiffSide(snap versionSnapshot, citations []ChunkCitation, configHash string) (DocumentVersionDiffSide, map[string]int32) {
+	side := DocumentVersionDiffSide{Version: snap.version, ChunksRebuilt: snap.rebuilt}
+	chunks := make(map[string]DocChunk, len(snap.chunks))
+	if !snap.rebuilt || strings.TrimSpace(snap.version.IndexConfigHash) == strings.TrimSpace(configHash) {
+		for _, ch := range snap.chunks {
+			chunks[ch.ID] = ch
+		}
+	}
+	counts := make(map[string]int32)
+	for _, c := range citations {
+		if c.DocumentVersionID != snap.version.ID {
+			continue
+		}
+		c.ChunkIndex = -1
+		if ch, ok := chunks[c.ChunkID]; ok {
+			c.ChunkIndex = ch.ChunkIndex
+			c.ContentHash = ch.ContentHash
+			counts[c.ChunkID] += c.Citations
+		}
+		side.Citations = append(side.Citations, c)
+	}
+	sort.SliceStable(side.Citations, func(i, j int) bool {
+		return side.Citations[i].Citations > side.Citations[j].Citations
+	})
+	return side, counts
+}
+
+// diffVersionChunks matches chunks by content hash first, in order, then pairs
+// the remaining chunks at the same position as modified.
+func diffVersionChunks(from []DocChunk, to []DocChunk) (added []DocChunk, removed []DocChunk, modified [][2]DocChunk, unchanged int) {
+	byHash := make(map[string][]int)
+	for i, ch := range to {
+		byHash[ch.ContentHash] = append(byHash[ch.ContentHash], i)
+	}
+	matched := make([]bool, len(to))
+	var unmatched []DocChunk
+	for _, ch := range from {
+		if queue := byHash[ch.ContentHash]; len(queue) > 0 {
+			matched[queue[0]] = true
+			byHash[ch.ContentHash] = queue[1:]
+			unchanged++
+			continue
+		}
+		unmatched = append(unmatched, ch)
+	}
+	byIndex := make(map[int32]int)
+	for i, ch := range to {
+		if !matched[i] {
+			byIndex[ch.ChunkIndex] = i
+		}
+	}
+	for _, ch := range unmatched {
+		if i, ok := byIndex[ch.ChunkIndex]; ok {
+			matched[i] = true
+			delete(byIndex, ch.ChunkIndex)
+			modified = append(modified, [2]DocChunk{ch, to[i]})
+			continue
+		}
+		removed = append(removed, ch)
+	}
+	for i, ch := range to {
+		if !matched[i] {
+			added = append(added, ch)
+		}
+	}
+	return added, removed, modified, unchanged
+}
+
+// versionTextDiff returns a unified diff of the parsed content of two
+// versions, truncated past maxVersionTextDiffBytes. Content above
+// maxVersionDiffLines is not diffed.
+func versionTextDiff(from versionSnapshot, to versionSnapshot) (string, bool) {
+	a := difflib.SplitLines(from.text)
+	b := difflib.SplitLines(to.text)
+	if len(a) > maxVersionDiffLines || len(b) > maxVersionDiffLines {
+		return "", true
+	}
+	text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        a,
+		B:        b,
+		FromFile: "v" + strconv.Itoa(int(from.version.Version)),
+		ToFile:   "v" + strconv.Itoa(int(to.version.Version)),
+		Context:  versionTextDiffContext,
+	})
+	if err != nil {
+		return "", true
+	}
+	if len(text) > maxVersionTextDiffBytes {
+		return text[:strings.LastIndexByte(text[:maxVersionTextDiffBytes], '\n')+1], true
+	}
+	return text, false
+}
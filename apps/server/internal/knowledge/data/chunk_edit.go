@@ -0,0 +1,385 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+func (r *knowledgeRepo) ListChunks(ctx context.Context, versionID string, limit int, offset int) ([]biz.DocChunk, int, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	var total int
+	if err := r.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM doc_chunk WHERE tenant_id = ? AND document_version_id = ?",
+		tenantID,
+		versionID,
+	).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT `+docChunkColumns+`
+		FROM doc_chunk WHERE tenant_id = ? AND document_version_id = ?
+		ORDER BY chunk_index LIMIT ? OFFSET ?`,
+		tenantID,
+		versionID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	items, err := scanDocChunks(rows, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+func (r *knowledgeRepo) SearchChunks(ctx context.Context, kbID string, query string, limit int, offset int) ([]biz.ChunkView, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT `+docChunkColumns+`, document_id, document_version_id, title FROM (
+			SELECT c.*, d.title, MATCH(c.content, c.keywords) AGAINST (? IN NATURAL LANGUAGE MODE) AS score
+			FROM doc_chunk c
+			JOIN document d ON d.tenant_id = c.tenant_id AND d.id = c.document_id
+			JOIN document_version v ON v.id = c.document_version_id AND v.version = d.current_version
+			WHERE c.tenant_id = ? AND c.kb_id = ? AND MATCH(c.content, c.keywords) AGAINST (? IN NATURAL LANGUAGE MODE)
+		) matched
+		ORDER BY score DESC, document_id, chunk_index LIMIT ? OFFSET ?`,
+		query,
+		tenantID,
+		kbID,
+		query,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.ChunkView, 0, limit)
+	for rows.Next() {
+		var item biz.ChunkView
+		item.DocChunk, err = scanDocChunk(rows.Scan, &item.DocumentID, &item.DocumentVersionID, &item.DocumentTitle)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func (r *knowledgeRepo) GetChunk(ctx context.Context, id string) (biz.ChunkRecord, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.ChunkRecord{}, err
+	}
+	row := r.db.QueryRowContext(
+		ctx,
+		`SELECT `+docChunkColumns+`, kb_id, document_id, document_version_id, vector_collection, embedding_model FROM (
+			SELECT c.*, v.vector_collection,
+				COALESCE((SELECT e.model FROM embedding e WHERE e.tenant_id = c.tenant_id AND e.chunk_id = c.id
+					ORDER BY e.created_at DESC LIMIT 1), '') AS embedding_model
+			FROM doc_chunk c
+			JOIN document_version v ON v.id = c.document_version_id
+			WHERE c.tenant_id = ? AND c.id = ?
+		) chunk`,
+		tenantID,
+		id,
+	)
+	var rec biz.ChunkRecord
+	rec.DocChunk, err = scanDocChunk(row.Scan, &rec.KBID, &rec.DocumentID, &rec.DocumentVersionID, &rec.VectorCollection, &rec.EmbeddingModel)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.ChunkRecord{}, kerrors.NotFound("CHUNK_NOT_FOUND", "chunk not found")
+		}
+		return biz.ChunkRecord{}, err
+	}
+	return rec, nil
+}
+
+func (r *knowledgeRepo) UpdateChunk(ctx context.Context, u biz.ChunkUpdate) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	if r.vector == nil {
+		return kerrors.InternalServer("VECTORDB_MISSING", "vectordb not configured")
+	}
+	rec := u.Record
+	ch := u.Chunk
+	collection := r.collectionFor(rec.VectorCollection)
+	now := time.Now()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	accessLabels, err := lockDocumentAccessLabels(ctx, tx, tenantID, rec.DocumentID)
+	if err != nil {
+		return err
+	}
+	bands := biz.SimHashBands(ch.SimHash)
+	if _, err := tx.ExecContext(
+		ctx,
+		`UPDATE doc_chunk SET content = ?, token_count = ?, content_hash = ?, language = ?,
+			simhash = ?, simhash_band0 = ?, simhash_band1 = ?, simhash_band2 = ?, simhash_band3 = ?,
+			origin_hash = ?, excluded = ?, keywords = ?
+		WHERE tenant_id = ? AND id = ?`,
+		ch.Content,
+		ch.TokenCount,
+		ch.ContentHash,
+		ch.Language,
+		ch.SimHash,
+		bands[0],
+		bands[1],
+		bands[2],
+		bands[3],
+		ch.OriginHash,
+		ch.Excluded,
+		encodeChunkKeywords(ch.Keywords),
+		tenantID,
+		rec.ID,
+	); err != nil {
+		return err
+	}
+
+	if len(u.Vector) > 0 {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO embedding (id, tenant_id, chunk_id, model, content_hash, created_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE content_hash = VALUES(content_hash), created_at = VALUES(created_at)`,
+			deterministicEmbeddingID(rec.ID, u.EmbeddingModel),
+			tenantID,
+			rec.ID,
+			u.EmbeddingModel,
+			ch.EmbeddingHash(),
+			now,
+		); err != nil {
+			return err
+		}
+		points := buildVectorPoints(tenantID, biz.IndexDocumentVersionRequest{
+			KBID:              rec.KBID,
+			DocumentID:        rec.DocumentID,
+			DocumentVersionID: rec.DocumentVersionID,
+			DocumentTitle:     u.DocumentTitle,
+			SourceType:        u.SourceType,
+			Chunks:            []biz.EmbeddedChunk{{Chunk: ch, Vector: u.Vector}},
+		}, accessLabels)
+		if err := enqueueVectorUpserts(ctx, tx, tenantID, rec.DocumentID, collection, len(u.Vector), points); err != nil {
+			return err
+		}
+	} else if ch.Excluded != rec.Excluded {
+		filter := VectorFilter{
+			Must: []VectorCondition{
+				VectorMatchCondition("tenant_id", tenantID),
+				VectorMatchCondition("chunk_id", rec.ID),
+			},
+		}
+		if err := enqueueVectorSetPayload(ctx, tx, tenantID, rec.DocumentID, []string{collection}, filter, map[string]any{"excluded": ch.Excluded}); err != nil {
+			return err
+		}
+	}
+
+	if err := saveChunkOverride(ctx, tx, tenantID, u.Override, now); err != nil {
+		return err
+	}
+	before, err := json.Marshal(u.Edit.Before)
+	if err != nil {
+		return err
+	}
+	after, err := json.Marshal(u.Edit.After)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO chunk_edit
+			(id, tenant_id, kb_id, document_id, document_version_id, chunk_id, origin_hash, actor_id, before_json, after_json, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		uuid.NewString(),
+		tenantID,
+		u.Edit.KBID,
+		u.Edit.DocumentID,
+		u.Edit.DocumentVersionID,
+		u.Edit.ChunkID,
+		u.Edit.OriginHash,
+		u.Edit.ActorID,
+		string(before),
+		string(after),
+		now,
+	); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	r.flushOutbox(ctx, tenantID, rec.DocumentID)
+	return nil
+}
+
+func (r *knowledgeRepo) ListChunkOverrides(ctx context.Context, documentID string) ([]biz.ChunkOverride, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT kb_id, document_id, origin_hash, content, excluded, keywords, updated_by, updated_at
+		FROM chunk_override WHERE tenant_id = ? AND document_id = ?`,
+		tenantID,
+		documentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.ChunkOverride, 0)
+	for rows.Next() {
+		var (
+			o        biz.ChunkOverride
+			content  sql.NullString
+			keywords sql.NullString
+		)
+		if err := rows.Scan(&o.KBID, &o.DocumentID, &o.OriginHash, &content, &o.Excluded, &keywords, &o.UpdatedBy, &o.UpdatedAt); err != nil {
+			return nil, err
+		}
+		o.Content = content.String
+		o.Keywords = decodeChunkKeywords(keywords)
+		items = append(items, o)
+	}
+	return items, rows.Err()
+}
+
+func (r *knowledgeRepo) SaveChunkOverride(ctx context.Context, o biz.ChunkOverride) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := saveChunkOverride(ctx, tx, tenantID, o, time.Now()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// saveChunkOverride upserts the override of a document chunk, or deletes it
+// when it no longer changes anything.
+func saveChunkOverride(ctx context.Context, tx *sql.Tx, tenantID string, o biz.ChunkOverride, now time.Time) error {
+	if o.IsZero() {
+		_, err := tx.ExecContext(
+			ctx,
+			"DELETE FROM chunk_override WHERE tenant_id = ? AND document_id = ? AND origin_hash = ?",
+			tenantID,
+			o.DocumentID,
+			o.OriginHash,
+		)
+		return err
+	}
+	content := sql.NullString{String: o.Content, Valid: o.Content != ""}
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO chunk_override
+			(id, tenant_id, kb_id, document_id, origin_hash, content, excluded, keywords, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			kb_id = VALUES(kb_id),
+			content = VALUES(content),
+			excluded = VALUES(excluded),
+			keywords = VALUES(keywords),
+			updated_by = VALUES(updated_by),
+			updated_at = VALUES(updated_at)`,
+		uuid.NewString(),
+		tenantID,
+		o.KBID,
+		o.DocumentID,
+		o.OriginHash,
+		content,
+		o.Excluded,
+		encodeChunkKeywords(o.Keywords),
+		o.UpdatedBy,
+		now,
+		now,
+	)
+	return err
+}
+
+func (r *knowledgeRepo) ListChunkEdits(ctx context.Context, documentID string, limit int, offset int) ([]biz.ChunkEditRecord, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, kb_id, document_id, document_version_id, chunk_id, origin_hash, actor_id, before_json, after_json, created_at
+		FROM chunk_edit WHERE tenant_id = ? AND document_id = ?
+		ORDER BY created_at DESC, id LIMIT ? OFFSET ?`,
+		tenantID,
+		documentID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.ChunkEditRecord, 0, limit)
+	for rows.Next() {
+		var (
+			e      biz.ChunkEditRecord
+			before sql.NullString
+			after  sql.NullString
+		)
+		if err := rows.Scan(&e.ID, &e.KBID, &e.DocumentID, &e.DocumentVersionID, &e.ChunkID, &e.OriginHash, &e.ActorID, &before, &after, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		if before.Valid {
+			_ = json.Unmarshal([]byte(before.String), &e.Before)
+		}
+		if after.Valid {
+			_ = json.Unmarshal([]byte(after.String), &e.After)
+		}
+		items = append(items, e)
+	}
+	return items, rows.Err()
+}
+
+// encodeChunkKeywords stores keywords one per line, which the full-text
+// index tokenizes like the content.
+func encodeChunkKeywords(keywords []string) sql.NullString {
+	if len(keywords) == 0 {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: strings.Join(keywords, "\n"), Valid: true}
+}
+
+func decodeChunkKeywords(raw sql.NullString) []string {
+	if !raw.Valid || strings.TrimSpace(raw.String) == "" {
+		return nil
+	}
+	return strings.Split(raw.String, "\n")
+}
@@ -0,0 +1,25 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
+	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/secretbox"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+)
+
+type credentialRepo struct {
+	db      *sql.DB
+	secrets *secretbox.Box
+}
+
+// NewCredentialRepo creates a new tenant provider credential loader.
+func NewCredentialRepo(data *internaldata.Data) biz.CredentialRepo {
+	return &credentialRepo{db: data.DB, secrets: data.Secrets}
+}
+
+func (r *credentialRepo) GetProviderCredential(ctx context.Context, tenantID string, providerName string) (provider.Credential, bool, error) {
+	return internaldata.LoadProviderCredential(ctx, r.db, r.secrets, tenantID, providerName)
+}
@@ -0,0 +1,286 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	"github.com/google/uuid"
+)
+
+// duplicateLookupBatch bounds the chunks of one candidate query.
+const duplicateLookupBatch = 200
+
+// duplicatePreviewChars bounds the preview of a duplicated chunk in reports.
+const duplicatePreviewChars = 200
+
+// maxDuplicateCandidates caps the candidates of one version so boilerplate
+// shared by many documents does not fan out the comparison.
+const maxDuplicateCandidates = 5000
+
+func (r *knowledgeRepo) FindDuplicateCandidates(ctx context.Context, kbID string, excludeDocumentID string, collection string, hashes []string, simhashes []uint64) ([]biz.DuplicateCandidate, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]biz.DuplicateCandidate, 0)
+	seen := make(map[string]struct{})
+	total := len(hashes)
+	if len(simhashes) > total {
+		total = len(simhashes)
+	}
+	for start := 0; start < total && len(out) < maxDuplicateCandidates; start += duplicateLookupBatch {
+		end := start + duplicateLookupBatch
+		hashBatch := hashes[min(start, len(hashes)):min(end, len(hashes))]
+		simBatch := simhashes[min(start, len(simhashes)):min(end, len(simhashes))]
+
+		conds := make([]string, 0, 5)
+		args := []any{tenantID, kbID, excludeDocumentID, strings.TrimSpace(collection), biz.DocumentVersionStatusReady}
+		if len(hashBatch) > 0 {
+			conds = append(conds, "c.content_hash IN ("+placeholders(len(hashBatch))+")")
+			args = appendStrings(args, hashBatch)
+		}
+		bandValues := make([][]any, 4)
+		for _, h := range simBatch {
+			if h == 0 {
+				continue
+			}
+			for i, band := range biz.SimHashBands(h) {
+				bandValues[i] = append(bandValues[i], band)
+			}
+		}
+		for i, values := range bandValues {
+			if len(values) == 0 {
+				continue
+			}
+			// Chunks indexed before fingerprints existed have simhash 0 and
+			// only match by content hash.
+			conds = append(conds, "(c.simhash <> 0 AND c.simhash_band"+strconv.Itoa(i)+" IN ("+placeholders(len(values))+"))")
+			args = append(args, values...)
+		}
+		if len(conds) == 0 {
+			continue
+		}
+		args = append(args, maxDuplicateCandidates-len(out))
+		rows, err := r.db.QueryContext(
+			ctx,
+			`SELECT c.id, c.document_id, c.content_hash, c.simhash, d.access_labels
+			FROM doc_chunk c
+			JOIN document d ON d.tenant_id = c.tenant_id AND d.id = c.document_id
+			JOIN document_version v ON v.id = c.document_version_id AND v.version = d.current_version
+			WHERE c.tenant_id = ? AND d.kb_id = ? AND c.document_id <> ? AND v.vector_collection = ? AND v.status = ?
+				AND (`+strings.Join(conds, " OR ")+`)
+			LIMIT ?`,
+			args...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var (
+				c      biz.DuplicateCandidate
+				labels sql.NullString
+			)
+			if err := rows.Scan(&c.ChunkID, &c.DocumentID, &c.ContentHash, &c.SimHash, &labels); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			c.AccessLabels = decodeDocumentTags(labels)
+			if _, ok := seen[c.ChunkID]; ok {
+				continue
+			}
+			seen[c.ChunkID] = struct{}{}
+			out = append(out, c)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (r *knowledgeRepo) GetChunkVectors(ctx context.Context, collection string, chunkIDs []string) (map[string][]float32, error) {
+	if r.vector == nil || len(chunkIDs) == 0 {
+		return map[string][]float32{}, nil
+	}
+	return r.vector.RetrievePoints(ctx, r.collectionFor(collection), chunkIDs)
+}
+
+func (r *knowledgeRepo) ListSkippedDuplicateDocuments(ctx context.Context, documentID string) ([]string, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT DISTINCT l.document_id
+		FROM chunk_duplicate l
+		JOIN document d ON d.tenant_id = l.tenant_id AND d.id = l.document_id
+		JOIN document_version v ON v.id = l.document_version_id AND v.version = d.current_version
+		WHERE l.tenant_id = ? AND l.skipped = 1 AND (l.document_id = ? OR l.duplicate_document_id = ?)`,
+		tenantID,
+		documentID,
+		documentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+// insertChunkDuplicates replaces the duplicate links of the indexed version.
+func insertChunkDuplicates(ctx context.Context, tx *sql.Tx, tenantID string, req biz.IndexDocumentVersionRequest) error {
+	if _, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM chunk_duplicate WHERE tenant_id = ? AND document_version_id = ?",
+		tenantID,
+		req.DocumentVersionID,
+	); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, d := range req.Duplicates {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO chunk_duplicate
+				(id, tenant_id, kb_id, document_id, document_version_id, chunk_id, chunk_index, content_hash, content_preview,
+				duplicate_chunk_id, duplicate_document_id, method, distance, similarity, skipped, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			uuid.NewString(),
+			tenantID,
+			req.KBID,
+			req.DocumentID,
+			req.DocumentVersionID,
+			d.ChunkID,
+			d.ChunkIndex,
+			d.ContentHash,
+			nullableString(d.ContentPreview),
+			d.DuplicateChunkID,
+			d.DuplicateDocumentID,
+			d.Method,
+			d.Distance,
+			d.Similarity,
+			d.Skipped,
+			now,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *knowledgeRepo) ListChunkDuplicates(ctx context.Context, kbID string) ([]biz.ChunkDuplicate, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT l.id, l.tenant_id, l.kb_id, l.document_id, l.document_version_id, l.chunk_id, l.chunk_index, l.content_hash,
+			l.content_preview, l.duplicate_chunk_id, l.duplicate_document_id, l.method, l.distance, l.similarity, l.skipped,
+			l.created_at, COALESCE(d.title, ''), COALESCE(dd.title, ''), COALESCE(LEFT(c.content, ?), ''), c.id IS NULL
+		FROM chunk_duplicate l
+		LEFT JOIN document d ON d.tenant_id = l.tenant_id AND d.id = l.document_id
+		LEFT JOIN document dd ON dd.tenant_id = l.tenant_id AND dd.id = l.duplicate_document_id
+		LEFT JOIN doc_chunk c ON c.id = l.duplicate_chunk_id
+		WHERE l.tenant_id = ? AND l.kb_id = ?
+		ORDER BY l.created_at DESC`,
+		duplicatePreviewChars,
+		tenantID,
+		kbID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.ChunkDuplicate, 0)
+	for rows.Next() {
+		var (
+			d       biz.ChunkDuplicate
+			preview sql.NullString
+		)
+		if err := rows.Scan(
+			&d.ID,
+			&d.TenantID,
+			&d.KBID,
+			&d.DocumentID,
+			&d.DocumentVersionID,
+			&d.ChunkID,
+			&d.ChunkIndex,
+			&d.ContentHash,
+			&preview,
+			&d.DuplicateChunkID,
+			&d.DuplicateDocumentID,
+			&d.Method,
+			&d.Distance,
+			&d.Similarity,
+			&d.Skipped,
+			&d.CreatedAt,
+			&d.DocumentTitle,
+			&d.DuplicateDocumentTitle,
+			&d.DuplicateContentPreview,
+			&d.OriginalMissing,
+		); err != nil {
+			return nil, err
+		}
+		d.ContentPreview = preview.String
+		items = append(items, d)
+	}
+	return items, rows.Err()
+}
+
+func (r *knowledgeRepo) ListDocumentDuplicatePairs(ctx context.Context, kbID string) ([]biz.DocumentDuplicatePair, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Totals count the chunks of the linked version, indexed or skipped.
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT l.document_id, COALESCE(d.title, ''), l.duplicate_document_id, COALESCE(dd.title, ''),
+			COUNT(DISTINCT l.chunk_id), COALESCE(MAX(v.reused_chunks + v.embedded_chunks), 0)
+		FROM chunk_duplicate l
+		JOIN document_version v ON v.id = l.document_version_id
+		LEFT JOIN document d ON d.tenant_id = l.tenant_id AND d.id = l.document_id
+		LEFT JOIN document dd ON dd.tenant_id = l.tenant_id AND dd.id = l.duplicate_document_id
+		WHERE l.tenant_id = ? AND l.kb_id = ?
+		GROUP BY l.document_id, d.title, l.duplicate_document_id, dd.title`,
+		tenantID,
+		kbID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.DocumentDuplicatePair, 0)
+	for rows.Next() {
+		var p biz.DocumentDuplicatePair
+		if err := rows.Scan(
+			&p.DocumentID,
+			&p.DocumentTitle,
+			&p.DuplicateDocumentID,
+			&p.DuplicateDocumentTitle,
+			&p.DuplicateChunks,
+			&p.TotalChunks,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, p)
+	}
+	return items, rows.Err()
+}
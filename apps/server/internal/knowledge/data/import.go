@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+func (r *knowledgeRepo) CreateImportBatch(ctx context.Context, batch biz.ImportBatch) (biz.ImportBatch, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.ImportBatch{}, err
+	}
+	if batch.ID == "" {
+		batch.ID = uuid.NewString()
+	}
+	batch.TenantID = tenantID
+	batch.CreatedAt = time.Now()
+	_, err = r.db.ExecContext(
+		ctx,
+		`INSERT INTO import_batch (id, tenant_id, kb_id, folder_mode, source, total_files, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		batch.ID,
+		batch.TenantID,
+		batch.KBID,
+		batch.FolderMode,
+		batch.Source,
+		batch.TotalFiles,
+		batch.CreatedAt,
+	)
+	if err != nil {
+		return biz.ImportBatch{}, err
+	}
+	return batch, nil
+}
+
+func (r *knowledgeRepo) CreateImportBatchItem(ctx context.Context, item biz.ImportBatchItem) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	if item.ID == "" {
+		item.ID = uuid.NewString()
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		`INSERT INTO import_batch_item (id, tenant_id, batch_id, path, source_type, document_id, status, error_message, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		item.ID,
+		tenantID,
+		item.BatchID,
+		item.Path,
+		item.SourceType,
+		nullableString(item.DocumentID),
+		item.Status,
+		nullableString(item.ErrorReason),
+		time.Now(),
+	)
+	return err
+}
+
+func (r *knowledgeRepo) GetImportBatch(ctx context.Context, id string) (biz.ImportBatch, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.ImportBatch{}, err
+	}
+	var batch biz.ImportBatch
+	err = r.db.QueryRowContext(
+		ctx,
+		`SELECT id, tenant_id, kb_id, folder_mode, source, total_files, created_at
+		FROM import_batch WHERE tenant_id = ? AND id = ?`,
+		tenantID,
+		id,
+	).Scan(
+		&batch.ID,
+		&batch.TenantID,
+		&batch.KBID,
+		&batch.FolderMode,
+		&batch.Source,
+		&batch.TotalFiles,
+		&batch.CreatedAt,
+	)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.ImportBatch{}, kerrors.NotFound("IMPORT_BATCH_NOT_FOUND", "import batch not found")
+		}
+		return biz.ImportBatch{}, err
+	}
+	return batch, nil
+}
+
+func (r *knowledgeRepo) ListImportBatchItems(ctx context.Context, batchID string) ([]biz.ImportBatchItem, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT i.id, i.tenant_id, i.batch_id, i.path, i.source_type, i.document_id, i.status, i.error_message, i.created_at, d.status
+		FROM import_batch_item i
+		LEFT JOIN document d ON d.tenant_id = i.tenant_id AND d.id = i.document_id
+		WHERE i.tenant_id = ? AND i.batch_id = ?
+		ORDER BY i.created_at, i.path`,
+		tenantID,
+		batchID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.ImportBatchItem, 0)
+	for rows.Next() {
+		var (
+			item                                biz.ImportBatchItem
+			documentID, errorMessage, docStatus sql.NullString
+		)
+		if err := rows.Scan(
+			&item.ID,
+			&item.TenantID,
+			&item.BatchID,
+			&item.Path,
+			&item.SourceType,
+			&documentID,
+			&item.Status,
+			&errorMessage,
+			&item.CreatedAt,
+			&docStatus,
+		); err != nil {
+			return nil, err
+		}
+		item.DocumentID = documentID.String
+		item.ErrorReason = errorMessage.String
+		item.DocumentStatus = docStatus.String
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
@@ -0,0 +1,280 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+const ingestionJobColumns = `id, tenant_id, kb_id, document_id, document_version_id, fallback_version, status, current_step,
+	attempts, chunk_count, reused_chunks, embedded_chunks, step_durations, error_message, created_at, updated_at, started_at, finished_at`
+
+func (r *knowledgeRepo) CreateIngestionJob(ctx context.Context, rec biz.IngestionJobRecord) (biz.IngestionJobRecord, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.IngestionJobRecord{}, err
+	}
+	if rec.ID == "" {
+		rec.ID = uuid.NewString()
+	}
+	rec.TenantID = tenantID
+	now := time.Now()
+	rec.CreatedAt = now
+	rec.UpdatedAt = now
+	_, err = r.db.ExecContext(
+		ctx,
+		`INSERT INTO ingestion_job
+			(id, tenant_id, kb_id, document_id, document_version_id, fallback_version, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		rec.ID,
+		rec.TenantID,
+		rec.KBID,
+		rec.DocumentID,
+		rec.DocumentVersionID,
+		rec.FallbackVersion,
+		rec.Status,
+		rec.CreatedAt,
+		rec.UpdatedAt,
+	)
+	if err != nil {
+		return biz.IngestionJobRecord{}, err
+	}
+	return rec, nil
+}
+
+func (r *knowledgeRepo) GetIngestionJob(ctx context.Context, id string) (biz.IngestionJobRecord, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.IngestionJobRecord{}, err
+	}
+	row := r.db.QueryRowContext(
+		ctx,
+		"SELECT "+ingestionJobColumns+" FROM ingestion_job WHERE tenant_id = ? AND id = ?",
+		tenantID,
+		id,
+	)
+	rec, err := scanIngestionJob(row)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.IngestionJobRecord{}, kerrors.NotFound("INGESTION_JOB_NOT_FOUND", "ingestion job not found")
+		}
+		return biz.IngestionJobRecord{}, err
+	}
+	return rec, nil
+}
+
+func (r *knowledgeRepo) ListIngestionJobs(ctx context.Context, kbID string, status string, limit int, offset int) ([]biz.IngestionJobRecord, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := "SELECT " + ingestionJobColumns + " FROM ingestion_job WHERE tenant_id = ? AND kb_id = ?"
+	args := []any{tenantID, kbID}
+	if status != "" {
+		query += " AND status = ?"
+		args = append(args, status)
+	}
+	query += " ORDER BY created_at DESC LIMIT ? OFFSET ?"
+	args = append(args, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.IngestionJobRecord, 0)
+	for rows.Next() {
+		rec, err := scanIngestionJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, rec)
+	}
+	return items, rows.Err()
+}
+
+func (r *knowledgeRepo) StartIngestionJobAttempt(ctx context.Context, id string) (bool, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now()
+	res, err := r.db.ExecContext(
+		ctx,
+		`UPDATE ingestion_job
+		SET status = ?, attempts = attempts + 1, current_step = '', step_durations = NULL,
+			started_at = COALESCE(started_at, ?), finished_at = NULL, updated_at = ?
+		WHERE tenant_id = ? AND id = ? AND status IN (?, ?, ?)`,
+		biz.IngestionJobStatusRunning,
+		now,
+		now,
+		tenantID,
+		id,
+		biz.IngestionJobStatusQueued,
+		biz.IngestionJobStatusRetrying,
+		biz.IngestionJobStatusRunning,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		// Jobs enqueued before tracking existed have no record; process them.
+		var exists int
+		err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM ingestion_job WHERE tenant_id = ? AND id = ?", tenantID, id).Scan(&exists)
+		if err != nil {
+			return false, err
+		}
+		return exists == 0, nil
+	}
+	return true, nil
+}
+
+func (r *knowledgeRepo) UpdateIngestionJobProgress(ctx context.Context, id string, p biz.IngestionJobProgress) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		`UPDATE ingestion_job
+		SET current_step = ?, step_durations = ?, chunk_count = ?, reused_chunks = ?, embedded_chunks = ?, updated_at = ?
+		WHERE tenant_id = ? AND id = ? AND status = ?`,
+		p.CurrentStep,
+		encodeStepDurations(p.StepDurations),
+		p.ChunkCount,
+		p.ReusedChunks,
+		p.EmbeddedChunks,
+		time.Now(),
+		tenantID,
+		id,
+		biz.IngestionJobStatusRunning,
+	)
+	return err
+}
+
+func (r *knowledgeRepo) FinishIngestionJobAttempt(ctx context.Context, id string, status string, errorReason string) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var finishedAt any
+	if status != biz.IngestionJobStatusRetrying {
+		finishedAt = now
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		`UPDATE ingestion_job SET status = ?, error_message = ?, finished_at = ?, updated_at = ?
+		WHERE tenant_id = ? AND id = ? AND status = ?`,
+		status,
+		nullableString(errorReason),
+		finishedAt,
+		now,
+		tenantID,
+		id,
+		biz.IngestionJobStatusRunning,
+	)
+	return err
+}
+
+func (r *knowledgeRepo) TransitionIngestionJob(ctx context.Context, id string, from []string, to string) (bool, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(from) == 0 {
+		return false, nil
+	}
+	now := time.Now()
+	query := "UPDATE ingestion_job SET status = ?, updated_at = ?"
+	args := []any{to, now}
+	if to == biz.IngestionJobStatusCancelled {
+		query += ", finished_at = ?"
+		args = append(args, now)
+	} else {
+		query += ", finished_at = NULL"
+	}
+	query += " WHERE tenant_id = ? AND id = ? AND status IN (" + placeholders(len(from)) + ")"
+	args = append(args, tenantID, id)
+	res, err := r.db.ExecContext(ctx, query, appendStrings(args, from)...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func scanIngestionJob(row rowScanner) (biz.IngestionJobRecord, error) {
+	var rec biz.IngestionJobRecord
+	var durations, errorMessage sql.NullString
+	var startedAt, finishedAt sql.NullTime
+	if err := row.Scan(
+		&rec.ID,
+		&rec.TenantID,
+		&rec.KBID,
+		&rec.DocumentID,
+		&rec.DocumentVersionID,
+		&rec.FallbackVersion,
+		&rec.Status,
+		&rec.CurrentStep,
+		&rec.Attempts,
+		&rec.ChunkCount,
+		&rec.ReusedChunks,
+		&rec.EmbeddedChunks,
+		&durations,
+		&errorMessage,
+		&rec.CreatedAt,
+		&rec.UpdatedAt,
+		&startedAt,
+		&finishedAt,
+	); err != nil {
+		return biz.IngestionJobRecord{}, err
+	}
+	rec.StepDurations = decodeStepDurations(durations)
+	rec.ErrorReason = errorMessage.String
+	if startedAt.Valid {
+		t := startedAt.Time
+		rec.StartedAt = &t
+	}
+	if finishedAt.Valid {
+		t := finishedAt.Time
+		rec.FinishedAt = &t
+	}
+	return rec, nil
+}
+
+func encodeStepDurations(durations map[string]int64) sql.NullString {
+	if len(durations) == 0 {
+		return sql.NullString{}
+	}
+	raw, err := json.Marshal(durations)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(raw), Valid: true}
+}
+
+func decodeStepDurations(raw sql.NullString) map[string]int64 {
+	if !raw.Valid || strings.TrimSpace(raw.String) == "" {
+		return nil
+	}
+	var out map[string]int64
+	if err := json.Unmarshal([]byte(raw.String), &out); err != nil {
+		return nil
+	}
+	return out
+}
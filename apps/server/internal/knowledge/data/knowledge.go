@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	stderrors "errors"
 	"strconv"
 	"strings"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
 	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/plan"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
 	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
@@ -64,13 +66,24 @@ func (r *knowledgeRepo) CreateKnowledgeBase(ctx context.Context, kb biz.Knowledg
 	if kb.UpdatedAt.IsZero() {
 		kb.UpdatedAt = kb.CreatedAt
 	}
-	_, err = r.db.ExecContext(
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return biz.KnowledgeBase{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := internaldata.ReserveUsage(ctx, tx, tenantID, plan.ResourceKnowledgeBases, 1); err != nil {
+		return biz.KnowledgeBase{}, err
+	}
+	_, err = tx.ExecContext(
 		ctx,
-		"INSERT INTO knowledge_base (id, tenant_id, name, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		"INSERT INTO knowledge_base (id, tenant_id, name, description, chunking_config, embedding_config, dedup_config, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
 		kb.ID,
 		kb.TenantID,
 		kb.Name,
 		kb.Description,
+		encodeChunkingConfig(kb.Chunking),
+		encodeEmbeddingConfig(kb.Embedding),
+		encodeDedupConfig(kb.Dedup),
 		kb.CreatedAt,
 		kb.UpdatedAt,
 	)
@@ -81,6 +94,9 @@ func (r *knowledgeRepo) CreateKnowledgeBase(ctx context.Context, kb biz.Knowledg
 		}
 		return biz.KnowledgeBase{}, err
 	}
+	if err := tx.Commit(); err != nil {
+		return biz.KnowledgeBase{}, err
+	}
 	return kb, nil
 }
 
@@ -90,18 +106,22 @@ func (r *knowledgeRepo) GetKnowledgeBase(ctx context.Context, id string) (biz.Kn
 		return biz.KnowledgeBase{}, err
 	}
 	var kb biz.KnowledgeBase
+	var chunkingRaw, embeddingRaw, dedupRaw sql.NullString
 	err = r.db.QueryRowContext(
 		ctx,
-		"SELECT id, tenant_id, name, description, created_at, updated_at FROM knowledge_base WHERE tenant_id = ? AND id = ?",
+		"SELECT id, tenant_id, name, description, chunking_config, embedding_config, dedup_config, index_generation, created_at, updated_at FROM knowledge_base WHERE tenant_id = ? AND id = ?",
 		tenantID,
 		id,
-	).Scan(&kb.ID, &kb.TenantID, &kb.Name, &kb.Description, &kb.CreatedAt, &kb.UpdatedAt)
+	).Scan(&kb.ID, &kb.TenantID, &kb.Name, &kb.Description, &chunkingRaw, &embeddingRaw, &dedupRaw, &kb.IndexGeneration, &kb.CreatedAt, &kb.UpdatedAt)
 	if err != nil {
 		if stderrors.Is(err, sql.ErrNoRows) {
 			return biz.KnowledgeBase{}, kerrors.NotFound("KB_NOT_FOUND", "knowledge base not found")
 		}
 		return biz.KnowledgeBase{}, err
 	}
+	kb.Chunking = decodeChunkingConfig(chunkingRaw)
+	kb.Embedding = decodeEmbeddingConfig(embeddingRaw)
+	kb.Dedup = decodeDedupConfig(dedupRaw)
 	return kb, nil
 }
 
@@ -112,7 +132,7 @@ func (r *knowledgeRepo) ListKnowledgeBases(ctx context.Context) ([]biz.Knowledge
 	}
 	rows, err := r.db.QueryContext(
 		ctx,
-		"SELECT id, tenant_id, name, description, created_at, updated_at FROM knowledge_base WHERE tenant_id = ? ORDER BY created_at DESC",
+		"SELECT id, tenant_id, name, description, chunking_config, embedding_config, dedup_config, index_generation, created_at, updated_at FROM knowledge_base WHERE tenant_id = ? ORDER BY created_at DESC",
 		tenantID,
 	)
 	if err != nil {
@@ -123,9 +143,13 @@ func (r *knowledgeRepo) ListKnowledgeBases(ctx context.Context) ([]biz.Knowledge
 	items := make([]biz.KnowledgeBase, 0)
 	for rows.Next() {
 		var kb biz.KnowledgeBase
-		if err := rows.Scan(&kb.ID, &kb.TenantID, &kb.Name, &kb.Description, &kb.CreatedAt, &kb.UpdatedAt); err != nil {
+		var chunkingRaw, embeddingRaw, dedupRaw sql.NullString
+		if err := rows.Scan(&kb.ID, &kb.TenantID, &kb.Name, &kb.Description, &chunkingRaw, &embeddingRaw, &dedupRaw, &kb.IndexGeneration, &kb.CreatedAt, &kb.UpdatedAt); err != nil {
 			return nil, err
 		}
+		kb.Chunking = decodeChunkingConfig(chunkingRaw)
+		kb.Embedding = decodeEmbeddingConfig(embeddingRaw)
+		kb.Dedup = decodeDedupConfig(dedupRaw)
 		items = append(items, kb)
 	}
 	return items, rows.Err()
@@ -146,14 +170,27 @@ func (r *knowledgeRepo) UpdateKnowledgeBase(ctx context.Context, kb biz.Knowledg
 	if strings.TrimSpace(kb.Description) == "" {
 		kb.Description = current.Description
 	}
+	if kb.Chunking.IsZero() {
+		kb.Chunking = current.Chunking
+	}
+	if kb.Embedding.IsZero() {
+		kb.Embedding = current.Embedding
+	}
+	if kb.Dedup.IsZero() {
+		kb.Dedup = current.Dedup
+	}
 	kb.TenantID = tenantID
+	kb.IndexGeneration = current.IndexGeneration
 	kb.CreatedAt = current.CreatedAt
 	kb.UpdatedAt = time.Now()
 	_, err = r.db.ExecContext(
 		ctx,
-		"UPDATE knowledge_base SET name = ?, description = ?, updated_at = ? WHERE tenant_id = ? AND id = ?",
+		"UPDATE knowledge_base SET name = ?, description = ?, chunking_config = ?, embedding_config = ?, dedup_config = ?, updated_at = ? WHERE tenant_id = ? AND id = ?",
 		kb.Name,
 		kb.Description,
+		encodeChunkingConfig(kb.Chunking),
+		encodeEmbeddingConfig(kb.Embedding),
+		encodeDedupConfig(kb.Dedup),
 		kb.UpdatedAt,
 		tenantID,
 		kb.ID,
@@ -187,6 +224,14 @@ func (r *knowledgeRepo) DeleteKnowledgeBase(ctx context.Context, id string) erro
 	); err != nil {
 		return err
 	}
+	if _, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM chunk_duplicate WHERE tenant_id = ? AND kb_id = ?",
+		tenantID,
+		id,
+	); err != nil {
+		return err
+	}
 
 	res, err := tx.ExecContext(
 		ctx,
@@ -207,6 +252,14 @@ func (r *knowledgeRepo) DeleteKnowledgeBase(ctx context.Context, id string) erro
 	return tx.Commit()
 }
 
+func (r *knowledgeRepo) GetPlanUsage(ctx context.Context, resource string) (plan.Usage, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return plan.Usage{}, err
+	}
+	return internaldata.LoadUsage(ctx, r.db, tenantID, resource)
+}
+
 func (r *knowledgeRepo) CreateDocument(ctx context.Context, doc biz.Document) (biz.Document, error) {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
@@ -228,8 +281,8 @@ func (r *knowledgeRepo) CreateDocument(ctx context.Context, doc biz.Document) (b
 	}
 	_, err = r.db.ExecContext(
 		ctx,
-		`INSERT INTO document (id, tenant_id, kb_id, title, source_type, status, current_version, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		`INSERT INTO document (id, tenant_id, kb_id, title, source_type, status, current_version, tags, section_prefix, access_labels, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		doc.ID,
 		doc.TenantID,
 		doc.KBID,
@@ -237,6 +290,9 @@ func (r *knowledgeRepo) CreateDocument(ctx context.Context, doc biz.Document) (b
 		doc.SourceType,
 		doc.Status,
 		doc.CurrentVersion,
+		encodeDocumentTags(doc.Tags),
+		doc.SectionPrefix,
+		encodeDocumentTags(doc.AccessLabels),
 		doc.CreatedAt,
 		doc.UpdatedAt,
 	)
@@ -251,24 +307,13 @@ func (r *knowledgeRepo) GetDocument(ctx context.Context, id string) (biz.Documen
 	if err != nil {
 		return biz.Document{}, err
 	}
-	var doc biz.Document
-	err = r.db.QueryRowContext(
+	row := r.db.QueryRowContext(
 		ctx,
-		`SELECT id, tenant_id, kb_id, title, source_type, status, current_version, created_at, updated_at
-		FROM document WHERE tenant_id = ? AND id = ?`,
+		"SELECT "+documentColumns+" FROM document WHERE tenant_id = ? AND id = ?",
 		tenantID,
 		id,
-	).Scan(
-		&doc.ID,
-		&doc.TenantID,
-		&doc.KBID,
-		&doc.Title,
-		&doc.SourceType,
-		&doc.Status,
-		&doc.CurrentVersion,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
 	)
+	doc, err := scanDocument(row)
 	if err != nil {
 		if stderrors.Is(err, sql.ErrNoRows) {
 			return biz.Document{}, kerrors.NotFound("DOC_NOT_FOUND", "document not found")
@@ -278,17 +323,19 @@ func (r *knowledgeRepo) GetDocument(ctx context.Context, id string) (biz.Documen
 	return doc, nil
 }
 
-func (r *knowledgeRepo) ListDocuments(ctx context.Context, kbID string, limit int, offset int) ([]biz.Document, error) {
+func (r *knowledgeRepo) ListDocuments(ctx context.Context, kbIDs []string, limit int, offset int) ([]biz.Document, error) {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
 		return nil, err
 	}
-	query := `SELECT id, tenant_id, kb_id, title, source_type, status, current_version, created_at, updated_at
-		FROM document WHERE tenant_id = ?`
+	query := "SELECT " + documentColumns + " FROM document WHERE tenant_id = ?"
 	args := []any{tenantID}
-	if strings.TrimSpace(kbID) != "" {
-		query += " AND kb_id = ?"
-		args = append(args, kbID)
+	if kbIDs != nil {
+		if len(kbIDs) == 0 {
+			return []biz.Document{}, nil
+		}
+		query += " AND kb_id IN (" + placeholders(len(kbIDs)) + ")"
+		args = appendStrings(args, kbIDs)
 	}
 	query += " ORDER BY created_at DESC LIMIT ? OFFSET ?"
 	args = append(args, limit, offset)
@@ -301,18 +348,8 @@ func (r *knowledgeRepo) ListDocuments(ctx context.Context, kbID string, limit in
 
 	items := make([]biz.Document, 0)
 	for rows.Next() {
-		var doc biz.Document
-		if err := rows.Scan(
-			&doc.ID,
-			&doc.TenantID,
-			&doc.KBID,
-			&doc.Title,
-			&doc.SourceType,
-			&doc.Status,
-			&doc.CurrentVersion,
-			&doc.CreatedAt,
-			&doc.UpdatedAt,
-		); err != nil {
+		doc, err := scanDocument(rows)
+		if err != nil {
 			return nil, err
 		}
 		items = append(items, doc)
@@ -320,6 +357,54 @@ func (r *knowledgeRepo) ListDocuments(ctx context.Context, kbID string, limit in
 	return items, rows.Err()
 }
 
+const documentColumns = "id, tenant_id, kb_id, title, source_type, status, current_version, tags, section_prefix, access_labels, created_at, updated_at"
+
+func scanDocument(row rowScanner) (biz.Document, error) {
+	var doc biz.Document
+	var tags, accessLabels sql.NullString
+	if err := row.Scan(
+		&doc.ID,
+		&doc.TenantID,
+		&doc.KBID,
+		&doc.Title,
+		&doc.SourceType,
+		&doc.Status,
+		&doc.CurrentVersion,
+		&tags,
+		&doc.SectionPrefix,
+		&accessLabels,
+		&doc.CreatedAt,
+		&doc.UpdatedAt,
+	); err != nil {
+		return biz.Document{}, err
+	}
+	doc.Tags = decodeDocumentTags(tags)
+	doc.AccessLabels = decodeDocumentTags(accessLabels)
+	return doc, nil
+}
+
+func encodeDocumentTags(tags []string) sql.NullString {
+	if len(tags) == 0 {
+		return sql.NullString{}
+	}
+	raw, err := json.Marshal(tags)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(raw), Valid: true}
+}
+
+func decodeDocumentTags(raw sql.NullString) []string {
+	if !raw.Valid || strings.TrimSpace(raw.String) == "" {
+		return nil
+	}
+	var tags []string
+	if err := json.Unmarshal([]byte(raw.String), &tags); err != nil {
+		return nil
+	}
+	return tags
+}
+
 func (r *knowledgeRepo) UpdateDocumentKB(ctx context.Context, documentID string, kbID string) (biz.Document, error) {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
@@ -346,6 +431,82 @@ func (r *knowledgeRepo) UpdateDocumentKB(ctx context.Context, documentID string,
 	return r.GetDocument(ctx, documentID)
 }
 
+func (r *knowledgeRepo) UpdateDocumentAccessLabels(ctx context.Context, documentID string, labels []string) (biz.Document, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.Document{}, err
+	}
+	documentID = strings.TrimSpace(documentID)
+	collections, err := r.versionCollections(ctx, tenantID, "document_id = ?", documentID)
+	if err != nil {
+		return biz.Document{}, err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return biz.Document{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	res, err := tx.ExecContext(
+		ctx,
+		"UPDATE document SET access_labels = ?, updated_at = ? WHERE tenant_id = ? AND id = ?",
+		encodeDocumentTags(labels),
+		time.Now(),
+		tenantID,
+		documentID,
+	)
+	if err != nil {
+		return biz.Document{}, err
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return biz.Document{}, kerrors.NotFound("DOC_NOT_FOUND", "document not found")
+	}
+	if r.vector != nil {
+		filter := VectorFilter{Must: []VectorCondition{
+			VectorMatchCondition("tenant_id", tenantID),
+			VectorMatchCondition("document_id", documentID),
+		}}
+		if err := enqueueVectorSetPayload(ctx, tx, tenantID, documentID, collections, filter, map[string]any{
+			"access_labels": pointAccessLabels(labels),
+		}); err != nil {
+			return biz.Document{}, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return biz.Document{}, err
+	}
+	r.flushOutbox(ctx, tenantID, documentID)
+	return r.GetDocument(ctx, documentID)
+}
+
+// lockDocumentAccessLabels reads the access labels of a document and locks
+// its row in tx, so points enqueued in tx carry the labels a concurrent
+// UpdateDocumentAccessLabels either already wrote or will overwrite after.
+func lockDocumentAccessLabels(ctx context.Context, tx *sql.Tx, tenantID string, documentID string) ([]string, error) {
+	var raw sql.NullString
+	err := tx.QueryRowContext(
+		ctx,
+		"SELECT access_labels FROM document WHERE tenant_id = ? AND id = ? FOR UPDATE",
+		tenantID,
+		documentID,
+	).Scan(&raw)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return nil, kerrors.NotFound("DOC_NOT_FOUND", "document not found")
+		}
+		return nil, err
+	}
+	return decodeDocumentTags(raw), nil
+}
+
+// pointAccessLabels is the access_labels payload of a point; an empty list
+// marks the document public.
+func pointAccessLabels(labels []string) []string {
+	if labels == nil {
+		return []string{}
+	}
+	return labels
+}
+
 func (r *knowledgeRepo) UpdateDocumentIndexState(ctx context.Context, documentID string, status string, currentVersion int32) error {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
@@ -388,14 +549,15 @@ func (r *knowledgeRepo) CreateDocumentVersion(ctx context.Context, v biz.Documen
 	}
 	_, err = r.db.ExecContext(
 		ctx,
-		`INSERT INTO document_version (id, tenant_id, document_id, version, raw_uri, index_config_hash, status, error_message, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		`INSERT INTO document_version (id, tenant_id, document_id, version, raw_uri, index_config_hash, size_bytes, status, error_message, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		v.ID,
 		v.TenantID,
 		v.DocumentID,
 		v.Version,
 		rawURI,
 		v.IndexConfigHash,
+		v.SizeBytes,
 		v.Status,
 		v.ErrorReason,
 		v.CreatedAt,
@@ -419,11 +581,11 @@ func (r *knowledgeRepo) GetDocumentVersion(ctx context.Context, id string) (biz.
 	var v biz.DocumentVersion
 	err = r.db.QueryRowContext(
 		ctx,
-		`SELECT id, tenant_id, document_id, version, raw_uri, index_config_hash, status, error_message, created_at
+		`SELECT id, tenant_id, document_id, version, raw_uri, index_config_hash, reused_chunks, embedded_chunks, skipped_chunks, status, error_message, created_at
 		FROM document_version WHERE tenant_id = ? AND id = ?`,
 		tenantID,
 		id,
-	).Scan(&v.ID, &v.TenantID, &v.DocumentID, &v.Version, &v.RawURI, &v.IndexConfigHash, &v.Status, &v.ErrorReason, &v.CreatedAt)
+	).Scan(&v.ID, &v.TenantID, &v.DocumentID, &v.Version, &v.RawURI, &v.IndexConfigHash, &v.ReusedChunks, &v.EmbeddedChunks, &v.SkippedChunks, &v.Status, &v.ErrorReason, &v.CreatedAt)
 	if err != nil {
 		if stderrors.Is(err, sql.ErrNoRows) {
 			return biz.DocumentVersion{}, kerrors.NotFound("DOC_VERSION_NOT_FOUND", "document version not found")
@@ -441,12 +603,12 @@ func (r *knowledgeRepo) GetDocumentVersionByNumber(ctx context.Context, document
 	var v biz.DocumentVersion
 	err = r.db.QueryRowContext(
 		ctx,
-		`SELECT id, tenant_id, document_id, version, raw_uri, index_config_hash, status, error_message, created_at
+		`SELECT id, tenant_id, document_id, version, raw_uri, index_config_hash, reused_chunks, embedded_chunks, skipped_chunks, status, error_message, created_at
 		FROM document_version WHERE tenant_id = ? AND document_id = ? AND version = ?`,
 		tenantID,
 		documentID,
 		version,
-	).Scan(&v.ID, &v.TenantID, &v.DocumentID, &v.Version, &v.RawURI, &v.IndexConfigHash, &v.Status, &v.ErrorReason, &v.CreatedAt)
+	).Scan(&v.ID, &v.TenantID, &v.DocumentID, &v.Version, &v.RawURI, &v.IndexConfigHash, &v.ReusedChunks, &v.EmbeddedChunks, &v.SkippedChunks, &v.Status, &v.ErrorReason, &v.CreatedAt)
 	if err != nil {
 		if stderrors.Is(err, sql.ErrNoRows) {
 			return biz.DocumentVersion{}, kerrors.NotFound("DOC_VERSION_NOT_FOUND", "document version not found")
@@ -497,7 +659,7 @@ func (r *knowledgeRepo) ListDocumentVersions(ctx context.Context, documentID str
 	}
 	rows, err := r.db.QueryContext(
 		ctx,
-		`SELECT id, tenant_id, document_id, version, index_config_hash, status, created_at
+		`SELECT id, tenant_id, document_id, version, index_config_hash, reused_chunks, embedded_chunks, skipped_chunks, status, created_at
 		FROM document_version WHERE tenant_id = ? AND document_id = ? ORDER BY version DESC`,
 		tenantID,
 		documentID,
@@ -510,7 +672,7 @@ func (r *knowledgeRepo) ListDocumentVersions(ctx context.Context, documentID str
 	items := make([]biz.DocumentVersion, 0)
 	for rows.Next() {
 		var v biz.DocumentVersion
-		if err := rows.Scan(&v.ID, &v.TenantID, &v.DocumentID, &v.Version, &v.IndexConfigHash, &v.Status, &v.CreatedAt); err != nil {
+		if err := rows.Scan(&v.ID, &v.TenantID, &v.DocumentID, &v.Version, &v.IndexConfigHash, &v.ReusedChunks, &v.EmbeddedChunks, &v.SkippedChunks, &v.Status, &v.CreatedAt); err != nil {
 			return nil, err
 		}
 		items = append(items, v)
@@ -548,54 +710,45 @@ func (r *knowledgeRepo) IndexDocumentVersion(ctx context.Context, req biz.IndexD
 	if req.EmbeddingDim <= 0 {
 		return kerrors.InternalServer("EMBEDDING_DIM_INVALID", "embedding dim invalid")
 	}
-	if err := r.vector.EnsureCollection(ctx, r.collection, req.EmbeddingDim); err != nil {
+	collection := r.collectionFor(req.VectorCollection)
+	if err := r.vector.EnsureCollection(ctx, collection, req.EmbeddingDim); err != nil {
 		return err
 	}
 
-	points := make([]VectorPoint, 0, len(req.Chunks))
 	now := time.Now()
-	for _, item := range req.Chunks {
-		ch := item.Chunk
-		payload := map[string]any{
-			"tenant_id":           tenantID,
-			"kb_id":               req.KBID,
-			"document_id":         req.DocumentID,
-			"document_version_id": req.DocumentVersionID,
-			"document_title":      strings.TrimSpace(req.DocumentTitle),
-			"source_type":         strings.TrimSpace(req.SourceType),
-			"chunk_id":            ch.ID,
-			"chunk_index":         ch.ChunkIndex,
-			"token_count":         ch.TokenCount,
-			"content_hash":        ch.ContentHash,
-			"language":            ch.Language,
-			"section":             ch.Section,
-			"page_no":             ch.PageNo,
-			"source_uri":          ch.SourceURI,
-			"created_at":          ch.CreatedAt.UnixMilli(),
-		}
-		points = append(points, VectorPoint{
-			ID:      ch.ID,
-			Vector:  item.Vector,
-			Payload: payload,
-		})
-	}
-	if err := r.vector.UpsertPoints(ctx, r.collection, points); err != nil {
-		return err
-	}
 
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = tx.Rollback() }()
+	accessLabels, err := lockDocumentAccessLabels(ctx, tx, tenantID, req.DocumentID)
+	if err != nil {
+		return err
+	}
+	points := buildVectorPoints(tenantID, req, accessLabels)
 
+	if _, err := tx.ExecContext(
+		ctx,
+		"UPDATE document_version SET vector_collection = ?, reused_chunks = ?, embedded_chunks = ?, skipped_chunks = ? WHERE tenant_id = ? AND id = ?",
+		strings.TrimSpace(req.VectorCollection),
+		req.Stats.Reused,
+		req.Stats.Embedded,
+		req.SkippedChunks,
+		tenantID,
+		req.DocumentVersionID,
+	); err != nil {
+		return err
+	}
 	for _, item := range req.Chunks {
 		ch := item.Chunk
+		bands := biz.SimHashBands(ch.SimHash)
 		_, err = tx.ExecContext(
 			ctx,
 			`INSERT INTO doc_chunk
-				(id, tenant_id, kb_id, document_id, document_version_id, chunk_index, content, token_count, content_hash, language, section, page_no, source_uri, created_at)
-			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+				(id, tenant_id, kb_id, document_id, document_version_id, chunk_index, content, token_count, content_hash, language, section, page_no, source_uri, confidence,
+				simhash, simhash_band0, simhash_band1, simhash_band2, simhash_band3, origin_hash, excluded, keywords, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 			ON DUPLICATE KEY UPDATE
 				content = VALUES(content),
 				token_count = VALUES(token_count),
@@ -603,7 +756,16 @@ func (r *knowledgeRepo) IndexDocumentVersion(ctx context.Context, req biz.IndexD
 				language = VALUES(language),
 				section = VALUES(section),
 				page_no = VALUES(page_no),
-				source_uri = VALUES(source_uri)`,
+				source_uri = VALUES(source_uri),
+				confidence = VALUES(confidence),
+				simhash = VALUES(simhash),
+				simhash_band0 = VALUES(simhash_band0),
+				simhash_band1 = VALUES(simhash_band1),
+				simhash_band2 = VALUES(simhash_band2),
+				simhash_band3 = VALUES(simhash_band3),
+				origin_hash = VALUES(origin_hash),
+				excluded = VALUES(excluded),
+				keywords = VALUES(keywords)`,
 			ch.ID,
 			tenantID,
 			req.KBID,
@@ -617,6 +779,15 @@ func (r *knowledgeRepo) IndexDocumentVersion(ctx context.Context, req biz.IndexD
 			ch.Section,
 			ch.PageNo,
 			ch.SourceURI,
+			chunkConfidence(ch.Confidence),
+			ch.SimHash,
+			bands[0],
+			bands[1],
+			bands[2],
+			bands[3],
+			ch.OriginHash,
+			ch.Excluded,
+			encodeChunkKeywords(ch.Keywords),
 			ch.CreatedAt,
 		)
 		if err != nil {
@@ -626,26 +797,174 @@ func (r *knowledgeRepo) IndexDocumentVersion(ctx context.Context, req biz.IndexD
 		embeddingID := deterministicEmbeddingID(ch.ID, req.EmbeddingModel)
 		_, err = tx.ExecContext(
 			ctx,
-			`INSERT INTO embedding (id, tenant_id, chunk_id, model, created_at)
-			VALUES (?, ?, ?, ?, ?)
-			ON DUPLICATE KEY UPDATE model = model`,
+			`INSERT INTO embedding (id, tenant_id, chunk_id, model, content_hash, created_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE content_hash = VALUES(content_hash)`,
 			embeddingID,
 			tenantID,
 			ch.ID,
 			req.EmbeddingModel,
+			ch.EmbeddingHash(),
 			now,
 		)
 		if err != nil {
 			return err
 		}
 	}
+	if err := insertChunkDuplicates(ctx, tx, tenantID, req); err != nil {
+		return err
+	}
+	// Points are written through the outbox so chunks and vectors cannot
+	// diverge when Qdrant or this process fails.
+	if err := enqueueVectorUpserts(ctx, tx, tenantID, req.DocumentID, collection, req.EmbeddingDim, points); err != nil {
+		return err
+	}
 
 	if err := tx.Commit(); err != nil {
 		return err
 	}
+	r.flushOutbox(ctx, tenantID, req.DocumentID)
 	return nil
 }
 
+// buildVectorPoints builds the Qdrant points of the chunks in req.
+func buildVectorPoints(tenantID string, req biz.IndexDocumentVersionRequest, accessLabels []string) []VectorPoint {
+	points := make([]VectorPoint, 0, len(req.Chunks))
+	for _, item := range req.Chunks {
+		ch := item.Chunk
+		payload := map[string]any{
+			"tenant_id":           tenantID,
+			"kb_id":               req.KBID,
+			"document_id":         req.DocumentID,
+			"document_version_id": req.DocumentVersionID,
+			"document_title":      strings.TrimSpace(req.DocumentTitle),
+			"source_type":         strings.TrimSpace(req.SourceType),
+			"chunk_id":            ch.ID,
+			"chunk_index":         ch.ChunkIndex,
+			"token_count":         ch.TokenCount,
+			"content_hash":        ch.ContentHash,
+			"language":            ch.Language,
+			"section":             ch.Section,
+			"page_no":             ch.PageNo,
+			"source_uri":          ch.SourceURI,
+			"confidence":          ch.Confidence,
+			"access_labels":       pointAccessLabels(accessLabels),
+			"excluded":            ch.Excluded,
+			"created_at":          ch.CreatedAt.UnixMilli(),
+		}
+		if len(ch.Keywords) > 0 {
+			payload["keywords"] = ch.Keywords
+		}
+		points = append(points, VectorPoint{
+			ID:      ch.ID,
+			Vector:  item.Vector,
+			Payload: payload,
+		})
+	}
+	return points
+}
+
+// reuseCandidatesPerHash caps the indexed chunks tried per content hash, so
+// boilerplate shared by many documents does not fan out the lookup.
+const reuseCandidatesPerHash = 3
+
+// reuseLookupBatch bounds the IN list of a reuse lookup query.
+const reuseLookupBatch = 200
+
+type reuseCandidate struct {
+	hash       string
+	chunkID    string
+	collection string
+}
+
+func (r *knowledgeRepo) FindReusableEmbeddings(ctx context.Context, model string, hashes []string) (map[string][]float32, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string][]float32)
+	model = strings.TrimSpace(model)
+	if r.vector == nil || r.collection == "" || model == "" || len(hashes) == 0 {
+		return out, nil
+	}
+	candidates := make([]reuseCandidate, 0, len(hashes))
+	perHash := make(map[string]int, len(hashes))
+	for start := 0; start < len(hashes); start += reuseLookupBatch {
+		end := start + reuseLookupBatch
+		if end > len(hashes) {
+			end = len(hashes)
+		}
+		batch := hashes[start:end]
+		args := []any{tenantID, model}
+		args = appendStrings(args, batch)
+		rows, err := r.db.QueryContext(
+			ctx,
+			`SELECT e.content_hash, e.chunk_id, v.vector_collection
+			FROM embedding e
+			JOIN doc_chunk c ON c.id = e.chunk_id
+			JOIN document_version v ON v.id = c.document_version_id
+			WHERE e.tenant_id = ? AND e.model = ? AND e.content_hash IN (`+placeholders(len(batch))+`)
+			ORDER BY e.created_at DESC`,
+			args...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var c reuseCandidate
+			if err := rows.Scan(&c.hash, &c.chunkID, &c.collection); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			if perHash[c.hash] >= reuseCandidatesPerHash {
+				continue
+			}
+			perHash[c.hash]++
+			c.collection = r.collectionFor(c.collection)
+			candidates = append(candidates, c)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	byCollection := make(map[string][]reuseCandidate)
+	order := make([]string, 0)
+	for _, c := range candidates {
+		if _, ok := byCollection[c.collection]; !ok {
+			order = append(order, c.collection)
+		}
+		byCollection[c.collection] = append(byCollection[c.collection], c)
+	}
+	for _, collection := range order {
+		group := byCollection[collection]
+		ids := make([]string, 0, len(group))
+		for _, c := range group {
+			if _, ok := out[c.hash]; !ok {
+				ids = append(ids, c.chunkID)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		vectors, err := r.vector.RetrievePoints(ctx, collection, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range group {
+			if _, ok := out[c.hash]; ok {
+				continue
+			}
+			if vec, ok := vectors[c.chunkID]; ok {
+				out[c.hash] = vec
+			}
+		}
+	}
+	return out, nil
+}
+
 func (r *knowledgeRepo) DeleteDocumentVersionIndex(ctx context.Context, documentID string, versionID string) error {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
@@ -657,6 +976,10 @@ func (r *knowledgeRepo) DeleteDocumentVersionIndex(ctx context.Context, document
 	if strings.TrimSpace(versionID) == "" {
 		return nil
 	}
+	collections, err := r.versionCollections(ctx, tenantID, "id = ?", versionID)
+	if err != nil {
+		return err
+	}
 
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -682,21 +1005,29 @@ func (r *knowledgeRepo) DeleteDocumentVersionIndex(ctx context.Context, document
 	); err != nil {
 		return err
 	}
-	if err := tx.Commit(); err != nil {
+	if _, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM chunk_duplicate WHERE tenant_id = ? AND document_version_id = ?",
+		tenantID,
+		versionID,
+	); err != nil {
 		return err
 	}
-
-	if r.vector != nil && r.collection != "" {
+	if r.vector != nil {
 		filter := VectorFilter{
 			Must: []VectorCondition{
 				VectorMatchCondition("tenant_id", tenantID),
 				VectorMatchCondition("document_version_id", versionID),
 			},
 		}
-		if err := r.vector.DeletePoints(ctx, r.collection, filter); err != nil {
+		if err := enqueueVectorDelete(ctx, tx, tenantID, documentID, collections, filter); err != nil {
 			return err
 		}
 	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	r.flushOutbox(ctx, tenantID, documentID)
 	return nil
 }
 
@@ -753,6 +1084,10 @@ func (r *knowledgeRepo) DeleteDocument(ctx context.Context, documentID string) e
 		}
 		_ = rows.Close()
 	}
+	collections, err := r.versionCollections(ctx, tenantID, "document_id = ?", documentID)
+	if err != nil {
+		return err
+	}
 
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -778,6 +1113,14 @@ func (r *knowledgeRepo) DeleteDocument(ctx context.Context, documentID string) e
 	); err != nil {
 		return err
 	}
+	if _, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM chunk_duplicate WHERE tenant_id = ? AND document_id = ?",
+		tenantID,
+		documentID,
+	); err != nil {
+		return err
+	}
 	if _, err := tx.ExecContext(
 		ctx,
 		"DELETE FROM document_version WHERE tenant_id = ? AND document_id = ?",
@@ -802,9 +1145,21 @@ func (r *knowledgeRepo) DeleteDocument(ctx context.Context, documentID string) e
 	if err != nil {
 		return err
 	}
+	if r.vector != nil {
+		filter := VectorFilter{
+			Must: []VectorCondition{
+				VectorMatchCondition("tenant_id", tenantID),
+				VectorMatchCondition("document_id", doc.ID),
+			},
+		}
+		if err := enqueueVectorDelete(ctx, tx, tenantID, doc.ID, collections, filter); err != nil {
+			return err
+		}
+	}
 	if err := tx.Commit(); err != nil {
 		return err
 	}
+	r.flushOutbox(ctx, tenantID, doc.ID)
 
 	if r.storage != nil {
 		for _, uri := range rawURIs {
@@ -813,18 +1168,6 @@ func (r *knowledgeRepo) DeleteDocument(ctx context.Context, documentID string) e
 			}
 		}
 	}
-
-	if r.vector != nil && r.collection != "" {
-		filter := VectorFilter{
-			Must: []VectorCondition{
-				VectorMatchCondition("tenant_id", tenantID),
-				VectorMatchCondition("document_id", doc.ID),
-			},
-		}
-		if err := r.vector.DeletePoints(ctx, r.collection, filter); err != nil {
-			return err
-		}
-	}
 	return nil
 }
 
@@ -919,7 +1262,117 @@ func (r *knowledgeRepo) UnbindBotKnowledgeBase(ctx context.Context, botID string
 }
 
 // ProviderSet is knowledge data providers.
-var ProviderSet = wire.NewSet(NewKnowledgeRepo, NewIngestionQueue)
+var ProviderSet = wire.NewSet(NewKnowledgeRepo, NewIngestionQueue, NewOCREngine, NewCredentialRepo)
+
+func encodeChunkingConfig(cfg biz.ChunkingConfig) sql.NullString {
+	if cfg.IsZero() {
+		return sql.NullString{}
+	}
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(raw), Valid: true}
+}
+
+func decodeChunkingConfig(raw sql.NullString) biz.ChunkingConfig {
+	var cfg biz.ChunkingConfig
+	if !raw.Valid || strings.TrimSpace(raw.String) == "" {
+		return cfg
+	}
+	if err := json.Unmarshal([]byte(raw.String), &cfg); err != nil {
+		return biz.ChunkingConfig{}
+	}
+	return cfg
+}
+
+func encodeEmbeddingConfig(cfg biz.EmbeddingConfig) sql.NullString {
+	if cfg.IsZero() {
+		return sql.NullString{}
+	}
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(raw), Valid: true}
+}
+
+func decodeEmbeddingConfig(raw sql.NullString) biz.EmbeddingConfig {
+	var cfg biz.EmbeddingConfig
+	if !raw.Valid || strings.TrimSpace(raw.String) == "" {
+		return cfg
+	}
+	if err := json.Unmarshal([]byte(raw.String), &cfg); err != nil {
+		return biz.EmbeddingConfig{}
+	}
+	return cfg
+}
+
+func encodeDedupConfig(cfg biz.DedupConfig) sql.NullString {
+	if cfg.IsZero() {
+		return sql.NullString{}
+	}
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(raw), Valid: true}
+}
+
+func decodeDedupConfig(raw sql.NullString) biz.DedupConfig {
+	var cfg biz.DedupConfig
+	if !raw.Valid || strings.TrimSpace(raw.String) == "" {
+		return cfg
+	}
+	if err := json.Unmarshal([]byte(raw.String), &cfg); err != nil {
+		return biz.DedupConfig{}
+	}
+	return cfg
+}
+
+// collectionFor maps a collection key to the Qdrant collection name; the empty
+// key is the default collection (data.vectordb.collection).
+func (r *knowledgeRepo) collectionFor(key string) string {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return r.collection
+	}
+	return r.collection + "_" + key
+}
+
+// versionCollections lists the distinct collections holding vectors of the
+// matching document versions. The default collection is always included so
+// versions indexed before per knowledge base embeddings are cleaned up too.
+func (r *knowledgeRepo) versionCollections(ctx context.Context, tenantID string, where string, arg string) ([]string, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT DISTINCT vector_collection FROM document_version WHERE tenant_id = ? AND "+where,
+		tenantID,
+		arg,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	collections := []string{r.collection}
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(key) != "" {
+			collections = append(collections, r.collectionFor(key))
+		}
+	}
+	return collections, rows.Err()
+}
+
+func chunkConfidence(confidence float32) float64 {
+	if confidence <= 0 || confidence > 1 {
+		return 1
+	}
+	return float64(confidence)
+}
 
 func deterministicEmbeddingID(chunkID string, model string) string {
 	// Deterministic IDs make ingestion idempotent.
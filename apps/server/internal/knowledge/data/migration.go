@@ -0,0 +1,455 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+)
+
+const kbMigrationColumns = `id, tenant_id, kb_id, status, generation, chunking_config, embedding_config, index_config_hash,
+	total_documents, processed_documents, failed_documents, error_message, created_at, updated_at, finished_at`
+
+var activeKBMigrationStatuses = []string{
+	biz.KBMigrationStatusPending,
+	biz.KBMigrationStatusRunning,
+	biz.KBMigrationStatusSwitching,
+	biz.KBMigrationStatusCollecting,
+	biz.KBMigrationStatusCancelling,
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func (r *knowledgeRepo) CreateKnowledgeBaseMigration(ctx context.Context, m biz.KBMigration) (biz.KBMigration, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.KBMigration{}, err
+	}
+	if m.ID == "" {
+		m.ID = uuid.NewString()
+	}
+	m.TenantID = tenantID
+	now := time.Now()
+	m.CreatedAt = now
+	m.UpdatedAt = now
+	_, err = r.db.ExecContext(
+		ctx,
+		`INSERT INTO kb_migration
+			(id, tenant_id, kb_id, status, generation, chunking_config, embedding_config, index_config_hash, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		m.ID,
+		m.TenantID,
+		m.KBID,
+		m.Status,
+		m.Generation,
+		encodeChunkingConfig(m.Chunking),
+		encodeEmbeddingConfig(m.Embedding),
+		m.IndexConfigHash,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+	if err != nil {
+		return biz.KBMigration{}, err
+	}
+	return m, nil
+}
+
+func (r *knowledgeRepo) GetKnowledgeBaseMigration(ctx context.Context, id string) (biz.KBMigration, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.KBMigration{}, err
+	}
+	row := r.db.QueryRowContext(
+		ctx,
+		"SELECT "+kbMigrationColumns+" FROM kb_migration WHERE tenant_id = ? AND id = ?",
+		tenantID,
+		id,
+	)
+	m, err := scanKBMigration(row)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.KBMigration{}, kerrors.NotFound("KB_MIGRATION_NOT_FOUND", "migration not found")
+		}
+		return biz.KBMigration{}, err
+	}
+	return m, nil
+}
+
+func (r *knowledgeRepo) GetActiveKnowledgeBaseMigration(ctx context.Context, kbID string) (biz.KBMigration, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return biz.KBMigration{}, err
+	}
+	args := []any{tenantID, kbID}
+	row := r.db.QueryRowContext(
+		ctx,
+		"SELECT "+kbMigrationColumns+" FROM kb_migration WHERE tenant_id = ? AND kb_id = ? AND status IN ("+placeholders(len(activeKBMigrationStatuses))+") ORDER BY created_at DESC LIMIT 1",
+		appendStrings(args, activeKBMigrationStatuses)...,
+	)
+	m, err := scanKBMigration(row)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return biz.KBMigration{}, kerrors.NotFound("KB_MIGRATION_NOT_FOUND", "no active migration")
+		}
+		return biz.KBMigration{}, err
+	}
+	return m, nil
+}
+
+func (r *knowledgeRepo) ListKnowledgeBaseMigrations(ctx context.Context, kbID string) ([]biz.KBMigration, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT "+kbMigrationColumns+" FROM kb_migration WHERE tenant_id = ? AND kb_id = ? ORDER BY created_at DESC LIMIT 50",
+		tenantID,
+		kbID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.KBMigration, 0)
+	for rows.Next() {
+		m, err := scanKBMigration(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, m)
+	}
+	return items, rows.Err()
+}
+
+func (r *knowledgeRepo) ListStaleKnowledgeBaseMigrations(ctx context.Context, staleBefore time.Time) ([]biz.KBMigration, error) {
+	args := appendStrings(nil, activeKBMigrationStatuses)
+	args = append(args, staleBefore)
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT "+kbMigrationColumns+" FROM kb_migration WHERE status IN ("+placeholders(len(activeKBMigrationStatuses))+") AND (heartbeat_at IS NULL OR heartbeat_at < ?) ORDER BY created_at",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.KBMigration, 0)
+	for rows.Next() {
+		m, err := scanKBMigration(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, m)
+	}
+	return items, rows.Err()
+}
+
+func (r *knowledgeRepo) ClaimKnowledgeBaseMigration(ctx context.Context, id string, staleBefore time.Time) (bool, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return false, err
+	}
+	args := []any{time.Now(), tenantID, id}
+	args = appendStrings(args, activeKBMigrationStatuses)
+	args = append(args, staleBefore)
+	res, err := r.db.ExecContext(
+		ctx,
+		"UPDATE kb_migration SET heartbeat_at = ? WHERE tenant_id = ? AND id = ? AND status IN ("+placeholders(len(activeKBMigrationStatuses))+") AND (heartbeat_at IS NULL OR heartbeat_at < ?)",
+		args...,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (r *knowledgeRepo) TouchKnowledgeBaseMigration(ctx context.Context, id string) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		"UPDATE kb_migration SET heartbeat_at = ? WHERE tenant_id = ? AND id = ?",
+		time.Now(),
+		tenantID,
+		id,
+	)
+	return err
+}
+
+func (r *knowledgeRepo) TransitionKnowledgeBaseMigration(ctx context.Context, id string, from []string, to string) (bool, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(from) == 0 {
+		return false, nil
+	}
+	args := []any{to, time.Now(), tenantID, id}
+	args = appendStrings(args, from)
+	res, err := r.db.ExecContext(
+		ctx,
+		"UPDATE kb_migration SET status = ?, updated_at = ? WHERE tenant_id = ? AND id = ? AND status IN ("+placeholders(len(from))+")",
+		args...,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (r *knowledgeRepo) UpdateKnowledgeBaseMigrationProgress(ctx context.Context, m biz.KBMigration) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		`UPDATE kb_migration SET total_documents = ?, processed_documents = ?, failed_documents = ?, updated_at = ?
+		WHERE tenant_id = ? AND id = ?`,
+		m.TotalDocuments,
+		m.ProcessedDocuments,
+		m.FailedDocuments,
+		time.Now(),
+		tenantID,
+		m.ID,
+	)
+	return err
+}
+
+func (r *knowledgeRepo) FinishKnowledgeBaseMigration(ctx context.Context, id string, status string, errorReason string) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	_, err = r.db.ExecContext(
+		ctx,
+		"UPDATE kb_migration SET status = ?, error_message = ?, updated_at = ?, finished_at = ? WHERE tenant_id = ? AND id = ?",
+		status,
+		nullableString(errorReason),
+		now,
+		now,
+		tenantID,
+		id,
+	)
+	return err
+}
+
+func (r *knowledgeRepo) ListKnowledgeBaseMigrationItems(ctx context.Context, migrationID string) ([]biz.KBMigrationItem, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT migration_id, document_id, source_version, target_version_id, target_version, status, error_message, updated_at
+		FROM kb_migration_item WHERE tenant_id = ? AND migration_id = ?`,
+		tenantID,
+		migrationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.KBMigrationItem, 0)
+	for rows.Next() {
+		var item biz.KBMigrationItem
+		var errorMessage sql.NullString
+		if err := rows.Scan(
+			&item.MigrationID,
+			&item.DocumentID,
+			&item.SourceVersion,
+			&item.TargetVersionID,
+			&item.TargetVersion,
+			&item.Status,
+			&errorMessage,
+			&item.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		item.ErrorReason = errorMessage.String
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func (r *knowledgeRepo) SaveKnowledgeBaseMigrationItem(ctx context.Context, item biz.KBMigrationItem) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		`INSERT INTO kb_migration_item
+			(migration_id, tenant_id, document_id, source_version, target_version_id, target_version, status, error_message, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			source_version = VALUES(source_version),
+			target_version_id = VALUES(target_version_id),
+			target_version = VALUES(target_version),
+			status = VALUES(status),
+			error_message = VALUES(error_message),
+			updated_at = VALUES(updated_at)`,
+		item.MigrationID,
+		tenantID,
+		item.DocumentID,
+		item.SourceVersion,
+		item.TargetVersionID,
+		item.TargetVersion,
+		item.Status,
+		nullableString(item.ErrorReason),
+		time.Now(),
+	)
+	return err
+}
+
+// SwitchKnowledgeBaseIndex points every migrated document at its shadow version
+// and the knowledge base at the new generation in one transaction, marking the
+// items switched and the migration collecting. It returns a conflict if a
+// document changed its current version in the meantime.
+func (r *knowledgeRepo) SwitchKnowledgeBaseIndex(ctx context.Context, req biz.KBIndexSwitch) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now()
+	for _, item := range req.Items {
+		res, err := tx.ExecContext(
+			ctx,
+			`UPDATE document SET current_version = ?, status = ?, updated_at = ?
+			WHERE tenant_id = ? AND id = ? AND kb_id = ? AND current_version = ?`,
+			item.TargetVersion,
+			biz.DocumentStatusReady,
+			now,
+			tenantID,
+			item.DocumentID,
+			req.KBID,
+			item.SourceVersion,
+		)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return kerrors.Conflict("KB_MIGRATION_STALE", "document changed during migration switch")
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			"UPDATE kb_migration_item SET status = ?, updated_at = ? WHERE tenant_id = ? AND migration_id = ? AND document_id = ?",
+			biz.KBMigrationItemSwitched,
+			now,
+			tenantID,
+			req.MigrationID,
+			item.DocumentID,
+		); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		`UPDATE knowledge_base SET chunking_config = ?, embedding_config = ?, index_generation = ?, updated_at = ?
+		WHERE tenant_id = ? AND id = ?`,
+		encodeChunkingConfig(req.Chunking),
+		encodeEmbeddingConfig(req.Embedding),
+		req.Generation,
+		now,
+		tenantID,
+		req.KBID,
+	); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		"UPDATE kb_migration SET status = ?, updated_at = ? WHERE tenant_id = ? AND id = ?",
+		biz.KBMigrationStatusCollecting,
+		now,
+		tenantID,
+		req.MigrationID,
+	); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func scanKBMigration(row rowScanner) (biz.KBMigration, error) {
+	var m biz.KBMigration
+	var chunkingRaw, embeddingRaw, errorMessage sql.NullString
+	var finishedAt sql.NullTime
+	if err := row.Scan(
+		&m.ID,
+		&m.TenantID,
+		&m.KBID,
+		&m.Status,
+		&m.Generation,
+		&chunkingRaw,
+		&embeddingRaw,
+		&m.IndexConfigHash,
+		&m.TotalDocuments,
+		&m.ProcessedDocuments,
+		&m.FailedDocuments,
+		&errorMessage,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+		&finishedAt,
+	); err != nil {
+		return biz.KBMigration{}, err
+	}
+	m.Chunking = decodeChunkingConfig(chunkingRaw)
+	m.Embedding = decodeEmbeddingConfig(embeddingRaw)
+	m.ErrorReason = errorMessage.String
+	if finishedAt.Valid {
+		t := finishedAt.Time
+		m.FinishedAt = &t
+	}
+	return m, nil
+}
+
+func placeholders(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
+
+func appendStrings(args []any, values []string) []any {
+	for _, v := range values {
+		args = append(args, v)
+	}
+	return args
+}
+
+func nullableString(value string) sql.NullString {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: value, Valid: true}
+}
@@ -0,0 +1,218 @@
+package data
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultOCRTimeout       = 60 * time.Second
+	defaultOCRLanguages     = "eng"
+	defaultTesseractCommand = "tesseract"
+)
+
+// NewOCREngine builds the OCR adapter selected by data.knowledge.ocr.provider.
+// It returns nil when OCR is disabled.
+func NewOCREngine(cfg *conf.Data, logger log.Logger) biz.OCREngine {
+	if cfg == nil || cfg.Knowledge == nil || cfg.Knowledge.Ocr == nil {
+		return nil
+	}
+	ocrCfg := cfg.Knowledge.Ocr
+	timeout := defaultOCRTimeout
+	if ocrCfg.TimeoutMs > 0 {
+		timeout = time.Duration(ocrCfg.TimeoutMs) * time.Millisecond
+	}
+	languages := strings.TrimSpace(ocrCfg.Languages)
+	if languages == "" {
+		languages = defaultOCRLanguages
+	}
+	helper := log.NewHelper(logger)
+	switch strings.ToLower(strings.TrimSpace(ocrCfg.Provider)) {
+	case "", "none", "disabled":
+		return nil
+	case "tesseract":
+		path := strings.TrimSpace(ocrCfg.TesseractPath)
+		if path == "" {
+			path = defaultTesseractCommand
+		}
+		if _, err := exec.LookPath(path); err != nil {
+			helper.Warnf("tesseract not found, ocr disabled: %v", err)
+			return nil
+		}
+		return &tesseractOCR{path: path, languages: languages, timeout: timeout}
+	case "http":
+		endpoint := strings.TrimSpace(ocrCfg.Endpoint)
+		if endpoint == "" {
+			helper.Warn("ocr endpoint missing, ocr disabled")
+			return nil
+		}
+		return &httpOCR{
+			endpoint:   endpoint,
+			apiKey:     strings.TrimSpace(ocrCfg.ApiKey),
+			languages:  languages,
+			httpClient: &http.Client{Timeout: timeout},
+		}
+	default:
+		helper.Warnf("unknown ocr provider %q, ocr disabled", ocrCfg.Provider)
+		return nil
+	}
+}
+
+// tesseractOCR runs the tesseract CLI and groups its TSV word output into paragraphs.
+type tesseractOCR struct {
+	path      string
+	languages string
+	timeout   time.Duration
+}
+
+var _ biz.OCREngine = (*tesseractOCR)(nil)
+
+func (t *tesseractOCR) Recognize(ctx context.Context, img biz.OCRImage) ([]biz.OCRBlock, error) {
+	if len(img.Data) == 0 {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, t.path, "stdin", "stdout", "-l", t.languages, "tsv")
+	cmd.Stdin = bytes.NewReader(img.Data)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, kerrors.InternalServer("OCR_FAILED", fmt.Sprintf("tesseract failed: %v: %s", err, strings.TrimSpace(stderr.String())))
+	}
+	return parseTesseractTSV(stdout.Bytes()), nil
+}
+
+type tesseractParagraph struct {
+	lines   []string
+	lineNo  string
+	confSum float64
+	words   int
+}
+
+// parseTesseractTSV turns word rows (level 5) into one block per paragraph
+// with the mean word confidence.
+func parseTesseractTSV(raw []byte) []biz.OCRBlock {
+	order := make([]string, 0)
+	paragraphs := make(map[string]*tesseractParagraph)
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	for scanner.Scan() {
+		cols := strings.Split(scanner.Text(), "\t")
+		if len(cols) < 12 || cols[0] != "5" {
+			continue
+		}
+		text := strings.TrimSpace(cols[11])
+		conf, err := strconv.ParseFloat(cols[10], 64)
+		if text == "" || err != nil || conf < 0 {
+			continue
+		}
+		key := cols[1] + "/" + cols[2] + "/" + cols[3]
+		para, ok := paragraphs[key]
+		if !ok {
+			para = &tesseractParagraph{}
+			paragraphs[key] = para
+			order = append(order, key)
+		}
+		if n := len(para.lines); n > 0 && para.lineNo == cols[4] {
+			para.lines[n-1] += " " + text
+		} else {
+			para.lines = append(para.lines, text)
+			para.lineNo = cols[4]
+		}
+		para.confSum += conf
+		para.words++
+	}
+	blocks := make([]biz.OCRBlock, 0, len(order))
+	for _, key := range order {
+		para := paragraphs[key]
+		blocks = append(blocks, biz.OCRBlock{
+			Text:       strings.Join(para.lines, "\n"),
+			Confidence: float32(para.confSum / float64(para.words) / 100),
+		})
+	}
+	return blocks
+}
+
+// httpOCR posts the image to an external OCR service.
+type httpOCR struct {
+	endpoint   string
+	apiKey     string
+	languages  string
+	httpClient *http.Client
+}
+
+var _ biz.OCREngine = (*httpOCR)(nil)
+
+type httpOCRRequest struct {
+	Image       string `json:"image"`
+	ContentType string `json:"content_type,omitempty"`
+	Languages   string `json:"languages,omitempty"`
+}
+
+type httpOCRResponse struct {
+	Blocks []struct {
+		Text       string  `json:"text"`
+		Confidence float64 `json:"confidence"`
+	} `json:"blocks"`
+}
+
+func (h *httpOCR) Recognize(ctx context.Context, img biz.OCRImage) ([]biz.OCRBlock, error) {
+	if len(img.Data) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(httpOCRRequest{
+		Image:       base64.StdEncoding.EncodeToString(img.Data),
+		ContentType: img.ContentType,
+		Languages:   h.languages,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.endpoint, bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+h.apiKey)
+	}
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body := readBodyLimit(resp.Body, 8<<10)
+		return nil, kerrors.InternalServer("OCR_FAILED", fmt.Sprintf("ocr service failed: %s", body))
+	}
+	var out httpOCRResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	blocks := make([]biz.OCRBlock, 0, len(out.Blocks))
+	for _, item := range out.Blocks {
+		conf := item.Confidence
+		if conf > 1 {
+			// Some services report percentages.
+			conf = conf / 100
+		}
+		blocks = append(blocks, biz.OCRBlock{Text: item.Text, Confidence: float32(conf)})
+	}
+	return blocks, nil
+}
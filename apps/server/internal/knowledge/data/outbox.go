@@ -0,0 +1,302 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+)
+
+// Vector outbox operations.
+const (
+	outboxOpUpsert     = "upsert"
+	outboxOpDelete     = "delete"
+	outboxOpDeleteIDs  = "delete_ids"
+	outboxOpSetPayload = "set_payload"
+)
+
+const (
+	// outboxUpsertBatch is the number of points per upsert outbox row.
+	outboxUpsertBatch = 64
+	// outboxLease is how long a claimed row stays invisible to other workers.
+	outboxLease      = time.Minute
+	outboxMaxBackoff = 10 * time.Minute
+	// outboxScanLimit bounds the rows inspected per drain.
+	outboxScanLimit = 500
+)
+
+// outboxEntry is a pending Qdrant write. Rows of one document are applied in
+// id order, so a delete never overtakes the upsert it cleans up.
+type outboxEntry struct {
+	ID            int64
+	TenantID      string
+	DocumentID    string
+	Op            string
+	Collection    string
+	Dim           int
+	Payload       []byte
+	Attempts      int
+	NextAttemptAt time.Time
+}
+
+func insertOutboxEntry(ctx context.Context, tx *sql.Tx, e outboxEntry) error {
+	// DATETIME rounds fractions; truncating keeps the row due immediately.
+	now := time.Now().Truncate(time.Second)
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO vector_outbox (tenant_id, document_id, op, collection, dim, payload, attempts, next_attempt_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, 0, ?, ?)`,
+		e.TenantID,
+		e.DocumentID,
+		e.Op,
+		e.Collection,
+		e.Dim,
+		e.Payload,
+		now,
+		now,
+	)
+	return err
+}
+
+// enqueueVectorUpserts records the upsert of points in tx, in batches of
+// outboxUpsertBatch points.
+func enqueueVectorUpserts(ctx context.Context, tx *sql.Tx, tenantID string, documentID string, collection string, dim int, points []VectorPoint) error {
+	for start := 0; start < len(points); start += outboxUpsertBatch {
+		end := start + outboxUpsertBatch
+		if end > len(points) {
+			end = len(points)
+		}
+		payload, err := json.Marshal(points[start:end])
+		if err != nil {
+			return err
+		}
+		if err := insertOutboxEntry(ctx, tx, outboxEntry{
+			TenantID:   tenantID,
+			DocumentID: documentID,
+			Op:         outboxOpUpsert,
+			Collection: collection,
+			Dim:        dim,
+			Payload:    payload,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueueVectorDelete records the deletion of the points matching filter from
+// every collection in tx.
+func enqueueVectorDelete(ctx context.Context, tx *sql.Tx, tenantID string, documentID string, collections []string, filter VectorFilter) error {
+	payload, err := json.Marshal(filter)
+	if err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		if err := insertOutboxEntry(ctx, tx, outboxEntry{
+			TenantID:   tenantID,
+			DocumentID: documentID,
+			Op:         outboxOpDelete,
+			Collection: collection,
+			Payload:    payload,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// outboxSetPayload is the payload of a set_payload outbox row.
+type outboxSetPayload struct {
+	Filter  VectorFilter   `json:"filter"`
+	Payload map[string]any `json:"payload"`
+}
+
+// enqueueVectorSetPayload records merging payload into the points matching
+// filter in every collection in tx.
+func enqueueVectorSetPayload(ctx context.Context, tx *sql.Tx, tenantID string, documentID string, collections []string, filter VectorFilter, payload map[string]any) error {
+	raw, err := json.Marshal(outboxSetPayload{Filter: filter, Payload: payload})
+	if err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		if err := insertOutboxEntry(ctx, tx, outboxEntry{
+			TenantID:   tenantID,
+			DocumentID: documentID,
+			Op:         outboxOpSetPayload,
+			Collection: collection,
+			Payload:    raw,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyVectorOutbox applies due outbox rows of all tenants and returns the
+// number applied. Failed rows are rescheduled with backoff, not returned.
+func (r *knowledgeRepo) ApplyVectorOutbox(ctx context.Context) (int, error) {
+	return r.drainOutbox(ctx, "", "")
+}
+
+// flushOutbox applies the pending rows of a document right after the MySQL
+// transaction that wrote them. Rows that fail stay in the outbox for the
+// worker.
+func (r *knowledgeRepo) flushOutbox(ctx context.Context, tenantID string, documentID string) {
+	if _, err := r.drainOutbox(ctx, tenantID, documentID); err != nil && r.log != nil {
+		r.log.Warnf("vector outbox flush failed: tenant=%s document=%s err=%v", tenantID, documentID, err)
+	}
+}
+
+func (r *knowledgeRepo) drainOutbox(ctx context.Context, tenantID string, documentID string) (int, error) {
+	if r.vector == nil {
+		return 0, nil
+	}
+	query := `SELECT id, tenant_id, document_id, op, collection, dim, attempts, next_attempt_at FROM vector_outbox`
+	args := make([]any, 0, 3)
+	if tenantID != "" {
+		query += " WHERE tenant_id = ? AND document_id = ?"
+		args = append(args, tenantID, documentID)
+	}
+	query += " ORDER BY id LIMIT ?"
+	args = append(args, outboxScanLimit)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	entries := make([]outboxEntry, 0)
+	for rows.Next() {
+		var e outboxEntry
+		if err := rows.Scan(&e.ID, &e.TenantID, &e.DocumentID, &e.Op, &e.Collection, &e.Dim, &e.Attempts, &e.NextAttemptAt); err != nil {
+			_ = rows.Close()
+			return 0, err
+		}
+		entries = append(entries, e)
+	}
+	err = rows.Err()
+	_ = rows.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	// A document whose earliest row is not applied blocks its later rows.
+	blocked := make(map[string]struct{})
+	for _, e := range entries {
+		key := e.TenantID + "|" + e.DocumentID
+		if _, ok := blocked[key]; ok {
+			continue
+		}
+		now := time.Now().Truncate(time.Second)
+		if e.NextAttemptAt.After(now) {
+			blocked[key] = struct{}{}
+			continue
+		}
+		claimed, err := r.claimOutboxEntry(ctx, e.ID, now)
+		if err != nil {
+			return applied, err
+		}
+		if !claimed {
+			blocked[key] = struct{}{}
+			continue
+		}
+		if err := r.applyOutboxEntry(ctx, e); err != nil {
+			blocked[key] = struct{}{}
+			r.failOutboxEntry(ctx, e, err)
+			continue
+		}
+		if _, err := r.db.ExecContext(ctx, "DELETE FROM vector_outbox WHERE id = ?", e.ID); err != nil {
+			return applied, err
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+func (r *knowledgeRepo) claimOutboxEntry(ctx context.Context, id int64, now time.Time) (bool, error) {
+	res, err := r.db.ExecContext(
+		ctx,
+		"UPDATE vector_outbox SET next_attempt_at = ? WHERE id = ? AND next_attempt_at <= ?",
+		now.Add(outboxLease),
+		id,
+		now,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (r *knowledgeRepo) applyOutboxEntry(ctx context.Context, e outboxEntry) error {
+	if err := r.db.QueryRowContext(ctx, "SELECT payload FROM vector_outbox WHERE id = ?", e.ID).Scan(&e.Payload); err != nil {
+		return err
+	}
+	switch e.Op {
+	case outboxOpUpsert:
+		var points []VectorPoint
+		if err := json.Unmarshal(e.Payload, &points); err != nil {
+			return err
+		}
+		if e.Dim > 0 {
+			if err := r.vector.EnsureCollection(ctx, e.Collection, e.Dim); err != nil {
+				return err
+			}
+		}
+		return r.vector.UpsertPoints(ctx, e.Collection, points)
+	case outboxOpDelete:
+		var filter VectorFilter
+		if err := json.Unmarshal(e.Payload, &filter); err != nil {
+			return err
+		}
+		return r.vector.DeletePoints(ctx, e.Collection, filter)
+	case outboxOpDeleteIDs:
+		var ids []string
+		if err := json.Unmarshal(e.Payload, &ids); err != nil {
+			return err
+		}
+		return r.vector.DeletePointIDs(ctx, e.Collection, ids)
+	case outboxOpSetPayload:
+		var req outboxSetPayload
+		if err := json.Unmarshal(e.Payload, &req); err != nil {
+			return err
+		}
+		return r.vector.SetPayload(ctx, e.Collection, req.Filter, req.Payload)
+	default:
+		return kerrors.InternalServer("VECTOR_OUTBOX_OP_INVALID", fmt.Sprintf("unknown vector outbox op %q", e.Op))
+	}
+}
+
+func (r *knowledgeRepo) failOutboxEntry(ctx context.Context, e outboxEntry, cause error) {
+	backoff := outboxBackoff(e.Attempts + 1)
+	if _, err := r.db.ExecContext(
+		ctx,
+		"UPDATE vector_outbox SET attempts = attempts + 1, last_error = ?, next_attempt_at = ? WHERE id = ?",
+		cause.Error(),
+		time.Now().Add(backoff),
+		e.ID,
+	); err != nil && r.log != nil {
+		r.log.Warnf("vector outbox update failed: id=%d err=%v", e.ID, err)
+	}
+	if r.log != nil {
+		r.log.Warnf("vector outbox apply failed: id=%d tenant=%s document=%s op=%s collection=%s attempt=%d retry_in=%s err=%v",
+			e.ID, e.TenantID, e.DocumentID, e.Op, e.Collection, e.Attempts+1, backoff, cause)
+	}
+}
+
+// outboxBackoff doubles from 5s per attempt up to outboxMaxBackoff.
+func outboxBackoff(attempt int) time.Duration {
+	backoff := 5 * time.Second
+	for i := 1; i < attempt && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > outboxMaxBackoff {
+		backoff = outboxMaxBackoff
+	}
+	return backoff
+}
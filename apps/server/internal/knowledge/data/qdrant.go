@@ -42,6 +42,49 @@ type qdrantDeleteRequest struct {
 	Filter VectorFilter `json:"filter"`
 }
 
+type qdrantSetPayloadRequest struct {
+	Payload map[string]any `json:"payload"`
+	Filter  VectorFilter   `json:"filter"`
+}
+
+type qdrantDeleteIDsRequest struct {
+	Points []string `json:"points"`
+}
+
+type qdrantScrollRequest struct {
+	Filter      VectorFilter `json:"filter"`
+	Limit       int          `json:"limit"`
+	Offset      any          `json:"offset,omitempty"`
+	WithPayload []string     `json:"with_payload"`
+	WithVector  bool         `json:"with_vector"`
+}
+
+type qdrantScrollResponse struct {
+	Result struct {
+		Points []struct {
+			ID      any            `json:"id"`
+			Payload map[string]any `json:"payload"`
+		} `json:"points"`
+		NextPageOffset any `json:"next_page_offset"`
+	} `json:"result"`
+}
+
+// qdrantScrollPage is the page size of ScrollPoints.
+const qdrantScrollPage = 256
+
+type qdrantRetrieveRequest struct {
+	IDs         []string `json:"ids"`
+	WithVector  bool     `json:"with_vector"`
+	WithPayload bool     `json:"with_payload"`
+}
+
+type qdrantRetrieveResponse struct {
+	Result []struct {
+		ID     any       `json:"id"`
+		Vector []float32 `json:"vector"`
+	} `json:"result"`
+}
+
 func (c *qdrantClient) EnsureCollection(ctx context.Context, collection string, dim int) error {
 	collection = strings.TrimSpace(collection)
 	if collection == "" {
@@ -162,6 +205,10 @@ func (c *qdrantClient) DeletePoints(ctx context.Context, collection string, filt
 		return err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		// Missing collection: nothing to delete.
+		return nil
+	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body := readBodyLimit(resp.Body, 16<<10)
 		return kerrors.InternalServer("QDRANT_DELETE_FAILED", fmt.Sprintf("qdrant delete failed: %s", body))
@@ -169,6 +216,178 @@ func (c *qdrantClient) DeletePoints(ctx context.Context, collection string, filt
 	return nil
 }
 
+func (c *qdrantClient) DeletePointIDs(ctx context.Context, collection string, ids []string) error {
+	collection = strings.TrimSpace(collection)
+	if collection == "" {
+		return kerrors.InternalServer("QDRANT_COLLECTION_MISSING", "qdrant collection missing")
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	raw, err := json.Marshal(qdrantDeleteIDsRequest{Points: ids})
+	if err != nil {
+		return err
+	}
+	deleteURL := c.endpoint + "/collections/" + url.PathEscape(collection) + "/points/delete?wait=true"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, deleteURL, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	c.applyAuth(req)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body := readBodyLimit(resp.Body, 16<<10)
+		return kerrors.InternalServer("QDRANT_DELETE_FAILED", fmt.Sprintf("qdrant delete failed: %s", body))
+	}
+	return nil
+}
+
+func (c *qdrantClient) SetPayload(ctx context.Context, collection string, filter VectorFilter, payload map[string]any) error {
+	collection = strings.TrimSpace(collection)
+	if collection == "" {
+		return kerrors.InternalServer("QDRANT_COLLECTION_MISSING", "qdrant collection missing")
+	}
+	if len(filter.Must) == 0 || len(payload) == 0 {
+		return nil
+	}
+	raw, err := json.Marshal(qdrantSetPayloadRequest{Payload: payload, Filter: filter})
+	if err != nil {
+		return err
+	}
+	payloadURL := c.endpoint + "/collections/" + url.PathEscape(collection) + "/points/payload?wait=true"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, payloadURL, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	c.applyAuth(req)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		// Missing collection: no points to update.
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body := readBodyLimit(resp.Body, 16<<10)
+		return kerrors.InternalServer("QDRANT_SET_PAYLOAD_FAILED", fmt.Sprintf("qdrant set payload failed: %s", body))
+	}
+	return nil
+}
+
+func (c *qdrantClient) ScrollPoints(ctx context.Context, collection string, filter VectorFilter, fn func(id string, payload map[string]any) error) error {
+	collection = strings.TrimSpace(collection)
+	if collection == "" {
+		return kerrors.InternalServer("QDRANT_COLLECTION_MISSING", "qdrant collection missing")
+	}
+	scrollURL := c.endpoint + "/collections/" + url.PathEscape(collection) + "/points/scroll"
+	var offset any
+	for {
+		raw, err := json.Marshal(qdrantScrollRequest{
+			Filter:      filter,
+			Limit:       qdrantScrollPage,
+			Offset:      offset,
+			WithPayload: []string{"document_id", "chunk_id"},
+		})
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, scrollURL, bytes.NewReader(raw))
+		if err != nil {
+			return err
+		}
+		c.applyAuth(req)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			resp.Body.Close()
+			return nil
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			body := readBodyLimit(resp.Body, 16<<10)
+			resp.Body.Close()
+			return kerrors.InternalServer("QDRANT_SCROLL_FAILED", fmt.Sprintf("qdrant scroll failed: %s", body))
+		}
+		var decoded qdrantScrollResponse
+		err = json.NewDecoder(resp.Body).Decode(&decoded)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		for _, point := range decoded.Result.Points {
+			if err := fn(fmt.Sprint(point.ID), point.Payload); err != nil {
+				return err
+			}
+		}
+		if decoded.Result.NextPageOffset == nil {
+			return nil
+		}
+		offset = decoded.Result.NextPageOffset
+	}
+}
+
+func (c *qdrantClient) RetrievePoints(ctx context.Context, collection string, ids []string) (map[string][]float32, error) {
+	collection = strings.TrimSpace(collection)
+	if collection == "" {
+		return nil, kerrors.InternalServer("QDRANT_COLLECTION_MISSING", "qdrant collection missing")
+	}
+	out := make(map[string][]float32, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	raw, err := json.Marshal(qdrantRetrieveRequest{IDs: ids, WithVector: true})
+	if err != nil {
+		return nil, err
+	}
+	retrieveURL := c.endpoint + "/collections/" + url.PathEscape(collection) + "/points"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, retrieveURL, bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	c.applyAuth(req)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return out, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body := readBodyLimit(resp.Body, 16<<10)
+		return nil, kerrors.InternalServer("QDRANT_RETRIEVE_FAILED", fmt.Sprintf("qdrant retrieve failed: %s", body))
+	}
+	var decoded qdrantRetrieveResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		return nil, err
+	}
+	for _, point := range decoded.Result {
+		if len(point.Vector) == 0 {
+			continue
+		}
+		out[fmt.Sprint(point.ID)] = point.Vector
+	}
+	return out, nil
+}
+
 func (c *qdrantClient) applyAuth(req *http.Request) {
 	if c.apiKey == "" {
 		return
@@ -176,8 +176,10 @@ func (q *rabbitQueue) consume(ctx context.Context, handler func(context.Context,
 				_ = msg.Nack(false, false)
 				continue
 			}
+			retry := getRetryCount(msg.Headers)
+			job.Attempt = int32(retry + 1)
+			job.MaxAttempts = int32(q.maxRetries + 1)
 			if err := handler(ctx, job); err != nil {
-				retry := getRetryCount(msg.Headers)
 				if retry < q.maxRetries {
 					delay := q.backoffBase
 					if retry > 0 {
@@ -264,6 +266,41 @@ func cloneHeaders(headers amqp.Table) amqp.Table {
 	return out
 }
 
+// RemoveDeadLetter pulls DLQ messages until it finds the job. Messages left
+// unacknowledged return to the DLQ when the channel closes.
+func (q *rabbitQueue) RemoveDeadLetter(ctx context.Context, jobID string) (bool, error) {
+	if q.conn == nil {
+		return false, errors.New("rabbitmq connection missing")
+	}
+	ch, err := q.conn.Channel()
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = ch.Close() }()
+	for {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		msg, ok, err := ch.Get(q.dlq, false)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+		var job biz.IngestionJob
+		if err := json.Unmarshal(msg.Body, &job); err == nil && job.ID == jobID {
+			return true, msg.Ack(false)
+		}
+	}
+}
+
+// Cancel is a no-op: RabbitMQ cannot remove a single queued message, so the
+// consumer drops cancelled jobs on delivery.
+func (q *rabbitQueue) Cancel(ctx context.Context, jobID string) error {
+	return nil
+}
+
 func (q *rabbitQueue) Close() error {
 	if q.ch != nil {
 		_ = q.ch.Close()
@@ -367,6 +404,8 @@ func (q *redisQueue) consume(ctx context.Context, handler func(context.Context,
 			q.log.Warnf("redis payload decode failed: %v", err)
 			continue
 		}
+		job.Attempt = int32(retry + 1)
+		job.MaxAttempts = int32(q.maxRetries + 1)
 		if err := handler(ctx, job); err != nil {
 			if retry < q.maxRetries {
 				delay := q.backoffBase
@@ -425,6 +464,39 @@ func decodeRedisPayload(raw string) (biz.IngestionJob, int, error) {
 	return job, 0, nil
 }
 
+func (q *redisQueue) RemoveDeadLetter(ctx context.Context, jobID string) (bool, error) {
+	return q.removeJob(ctx, q.dlq, jobID)
+}
+
+// Cancel removes the job from the queue. Jobs waiting for a delayed retry are
+// not in the list yet; the consumer drops them on delivery.
+func (q *redisQueue) Cancel(ctx context.Context, jobID string) error {
+	_, err := q.removeJob(ctx, q.queue, jobID)
+	return err
+}
+
+func (q *redisQueue) removeJob(ctx context.Context, list string, jobID string) (bool, error) {
+	if jobID == "" {
+		return false, nil
+	}
+	entries, err := q.client.LRange(ctx, list, 0, -1).Result()
+	if err != nil {
+		return false, err
+	}
+	for _, raw := range entries {
+		job, _, err := decodeRedisPayload(raw)
+		if err != nil || job.ID != jobID {
+			continue
+		}
+		removed, err := q.client.LRem(ctx, list, 1, raw).Result()
+		if err != nil {
+			return false, err
+		}
+		return removed > 0, nil
+	}
+	return false, nil
+}
+
 func (q *redisQueue) Close() error {
 	if q.client == nil {
 		return nil
@@ -7,6 +7,15 @@ type VectorStore interface {
 	EnsureCollection(ctx context.Context, collection string, dim int) error
 	UpsertPoints(ctx context.Context, collection string, points []VectorPoint) error
 	DeletePoints(ctx context.Context, collection string, filter VectorFilter) error
+	// RetrievePoints returns the vectors of the given point IDs; missing points
+	// and a missing collection are skipped.
+	RetrievePoints(ctx context.Context, collection string, ids []string) (map[string][]float32, error)
+	// DeletePointIDs deletes points by ID.
+	DeletePointIDs(ctx context.Context, collection string, ids []string) error
+	// SetPayload merges payload into the points matching filter.
+	SetPayload(ctx context.Context, collection string, filter VectorFilter, payload map[string]any) error
+	// ScrollPoints calls fn for every point matching filter, without vectors.
+	ScrollPoints(ctx context.Context, collection string, filter VectorFilter, fn func(id string, payload map[string]any) error) error
 }
 
 // VectorPoint represents a vector entry.
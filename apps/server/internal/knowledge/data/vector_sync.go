@@ -0,0 +1,296 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+)
+
+// outboxDeleteIDsBatch is the number of point IDs per delete_ids outbox row.
+const outboxDeleteIDsBatch = 256
+
+func (r *knowledgeRepo) ListPendingVectorDocuments(ctx context.Context) ([]string, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT DISTINCT document_id FROM vector_outbox WHERE tenant_id = ?",
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	return items, rows.Err()
+}
+
+func (r *knowledgeRepo) ListChunkVectorRefs(ctx context.Context, kbID string) ([]biz.ChunkVectorRef, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT c.id, c.kb_id, c.document_id, c.document_version_id, v.vector_collection, COALESCE(MAX(e.model), '')
+		FROM doc_chunk c
+		JOIN document_version v ON v.id = c.document_version_id
+		LEFT JOIN embedding e ON e.chunk_id = c.id
+		WHERE c.tenant_id = ?`
+	args := []any{tenantID}
+	if kbID != "" {
+		query += " AND c.kb_id = ?"
+		args = append(args, kbID)
+	}
+	query += " GROUP BY c.id, c.kb_id, c.document_id, c.document_version_id, v.vector_collection"
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make([]biz.ChunkVectorRef, 0)
+	for rows.Next() {
+		var ref biz.ChunkVectorRef
+		if err := rows.Scan(&ref.ChunkID, &ref.KBID, &ref.DocumentID, &ref.DocumentVersionID, &ref.VectorCollection, &ref.EmbeddingModel); err != nil {
+			return nil, err
+		}
+		items = append(items, ref)
+	}
+	return items, rows.Err()
+}
+
+func (r *knowledgeRepo) ListVectorPointRefs(ctx context.Context, kbID string, extraCollections []string) ([]biz.VectorPointRef, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.vector == nil {
+		return nil, kerrors.InternalServer("VECTORDB_MISSING", "vectordb not configured")
+	}
+	query := "SELECT DISTINCT v.vector_collection FROM document_version v"
+	args := []any{tenantID}
+	if kbID != "" {
+		query += " JOIN document d ON d.id = v.document_id WHERE v.tenant_id = ? AND d.kb_id = ?"
+		args = append(args, kbID)
+	} else {
+		query += " WHERE v.tenant_id = ?"
+	}
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{""}
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	err = rows.Err()
+	_ = rows.Close()
+	if err != nil {
+		return nil, err
+	}
+	keys = append(keys, extraCollections...)
+
+	must := []VectorCondition{VectorMatchCondition("tenant_id", tenantID)}
+	if kbID != "" {
+		must = append(must, VectorMatchCondition("kb_id", kbID))
+	}
+	items := make([]biz.VectorPointRef, 0)
+	seen := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		err := r.vector.ScrollPoints(ctx, r.collectionFor(key), VectorFilter{Must: must}, func(id string, payload map[string]any) error {
+			documentID, _ := payload["document_id"].(string)
+			items = append(items, biz.VectorPointRef{
+				VectorCollection: key,
+				PointID:          id,
+				DocumentID:       documentID,
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+func (r *knowledgeRepo) ListVersionChunks(ctx context.Context, versionID string, chunkIDs []string) ([]biz.DocChunk, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(chunkIDs) == 0 {
+		return nil, nil
+	}
+	args := []any{tenantID, versionID}
+	args = appendStrings(args, chunkIDs)
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT `+docChunkColumns+`
+		FROM doc_chunk WHERE tenant_id = ? AND document_version_id = ? AND id IN (`+placeholders(len(chunkIDs))+`)
+		ORDER BY chunk_index`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanDocChunks(rows, len(chunkIDs))
+}
+
+// docChunkColumns are the doc_chunk columns read by scanDocChunk.
+const docChunkColumns = `id, chunk_index, content, token_count, content_hash, language, section, page_no, source_uri, confidence,
+		origin_hash, excluded, keywords, created_at`
+
+func scanDocChunks(rows *sql.Rows, sizeHint int) ([]biz.DocChunk, error) {
+	items := make([]biz.DocChunk, 0, sizeHint)
+	for rows.Next() {
+		ch, err := scanDocChunk(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, ch)
+	}
+	return items, rows.Err()
+}
+
+// scanDocChunk reads docChunkColumns followed by extra destinations.
+func scanDocChunk(scan func(dest ...any) error, extra ...any) (biz.DocChunk, error) {
+	var (
+		ch         biz.DocChunk
+		section    sql.NullString
+		pageNo     sql.NullInt32
+		sourceURI  sql.NullString
+		confidence float64
+		keywords   sql.NullString
+	)
+	dest := []any{&ch.ID, &ch.ChunkIndex, &ch.Content, &ch.TokenCount, &ch.ContentHash, &ch.Language, &section, &pageNo, &sourceURI, &confidence,
+		&ch.OriginHash, &ch.Excluded, &keywords, &ch.CreatedAt}
+	if err := scan(append(dest, extra...)...); err != nil {
+		return ch, err
+	}
+	ch.Section = section.String
+	ch.PageNo = pageNo.Int32
+	ch.SourceURI = sourceURI.String
+	ch.Confidence = float32(confidence)
+	ch.Keywords = decodeChunkKeywords(keywords)
+	return ch, nil
+}
+
+// RestoreChunkVectors writes the points of already indexed chunks again
+// through the outbox, leaving doc_chunk and embedding untouched.
+func (r *knowledgeRepo) RestoreChunkVectors(ctx context.Context, req biz.IndexDocumentVersionRequest) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	if r.vector == nil {
+		return kerrors.InternalServer("VECTORDB_MISSING", "vectordb not configured")
+	}
+	if req.EmbeddingDim <= 0 {
+		return kerrors.InternalServer("EMBEDDING_DIM_INVALID", "embedding dim invalid")
+	}
+	collection := r.collectionFor(req.VectorCollection)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	accessLabels, err := lockDocumentAccessLabels(ctx, tx, tenantID, req.DocumentID)
+	if err != nil {
+		return err
+	}
+	if err := enqueueVectorUpserts(ctx, tx, tenantID, req.DocumentID, collection, req.EmbeddingDim, buildVectorPoints(tenantID, req, accessLabels)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	r.flushOutbox(ctx, tenantID, req.DocumentID)
+	return nil
+}
+
+// DeleteVectorPoints deletes points by ID through the outbox.
+func (r *knowledgeRepo) DeleteVectorPoints(ctx context.Context, refs []biz.VectorPointRef) error {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return err
+	}
+	if r.vector == nil || len(refs) == 0 {
+		return nil
+	}
+	type group struct {
+		collection string
+		documentID string
+	}
+	groups := make(map[group][]string)
+	order := make([]group, 0)
+	for _, ref := range refs {
+		g := group{collection: r.collectionFor(ref.VectorCollection), documentID: ref.DocumentID}
+		if _, ok := groups[g]; !ok {
+			order = append(order, g)
+		}
+		groups[g] = append(groups[g], ref.PointID)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	for _, g := range order {
+		ids := groups[g]
+		for start := 0; start < len(ids); start += outboxDeleteIDsBatch {
+			end := start + outboxDeleteIDsBatch
+			if end > len(ids) {
+				end = len(ids)
+			}
+			payload, err := json.Marshal(ids[start:end])
+			if err != nil {
+				return err
+			}
+			if err := insertOutboxEntry(ctx, tx, outboxEntry{
+				TenantID:   tenantID,
+				DocumentID: g.documentID,
+				Op:         outboxOpDeleteIDs,
+				Collection: g.collection,
+				Payload:    payload,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	flushed := make(map[string]struct{})
+	for _, g := range order {
+		if _, ok := flushed[g.documentID]; ok {
+			continue
+		}
+		flushed[g.documentID] = struct{}{}
+		r.flushOutbox(ctx, tenantID, g.documentID)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+)
+
+// messageReference is the part of a stored chat_message reference used to
+// attribute citations.
+type messageReference struct {
+	DocumentID        string `json:"document_id"`
+	DocumentVersionID string `json:"document_version_id"`
+	ChunkID           string `json:"chunk_id"`
+	Snippet           string `json:"snippet"`
+}
+
+func (r *knowledgeRepo) ListDocumentVersionChunks(ctx context.Context, versionID string) ([]biz.DocChunk, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT `+docChunkColumns+`
+		FROM doc_chunk WHERE tenant_id = ? AND document_version_id = ?
+		ORDER BY chunk_index`,
+		tenantID,
+		versionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanDocChunks(rows, 0)
+}
+
+func (r *knowledgeRepo) ListVersionCitations(ctx context.Context, documentID string, versionIDs []string, since time.Time) ([]biz.ChunkCitation, error) {
+	tenantID, err := tenant.RequireTenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	documentID = strings.TrimSpace(documentID)
+	if documentID == "" || len(versionIDs) == 0 {
+		return nil, nil
+	}
+	wanted := make(map[string]struct{}, len(versionIDs))
+	for _, id := range versionIDs {
+		wanted[id] = struct{}{}
+	}
+	// The LIKE pre-filter keeps the JSON decoding to messages that mention
+	// the document.
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT references_json, created_at FROM chat_message
+		WHERE tenant_id = ? AND role = 'assistant' AND created_at >= ? AND references_json LIKE ?
+		ORDER BY created_at`,
+		tenantID,
+		since,
+		`%"document_id":"`+documentID+`"%`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type citationKey struct {
+		versionID string
+		chunkID   string
+	}
+	byKey := make(map[citationKey]*biz.ChunkCitation)
+	order := make([]citationKey, 0)
+	for rows.Next() {
+		var (
+			raw       sql.NullString
+			createdAt time.Time
+		)
+		if err := rows.Scan(&raw, &createdAt); err != nil {
+			return nil, err
+		}
+		var refs []messageReference
+		if err := json.Unmarshal([]byte(raw.String), &refs); err != nil {
+			continue
+		}
+		// A message counts once per chunk even if it cites the chunk twice.
+		seen := make(map[citationKey]struct{}, len(refs))
+		for _, ref := range refs {
+			if ref.DocumentID != documentID {
+				continue
+			}
+			if _, ok := wanted[ref.DocumentVersionID]; !ok {
+				continue
+			}
+			key := citationKey{versionID: ref.DocumentVersionID, chunkID: ref.ChunkID}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			c, ok := byKey[key]
+			if !ok {
+				c = &biz.ChunkCitation{DocumentVersionID: key.versionID, ChunkID: key.chunkID}
+				byKey[key] = c
+				order = append(order, key)
+			}
+			c.Citations++
+			c.LastCitedAt = createdAt
+			c.Snippet = ref.Snippet
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	out := make([]biz.ChunkCitation, 0, len(order))
+	for _, key := range order {
+		out = append(out, *byKey[key])
+	}
+	return out, nil
+}
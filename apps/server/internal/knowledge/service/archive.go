@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1"
+	auditbiz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// maxKBArchiveRequestBytes caps knowledge base archive uploads.
+const maxKBArchiveRequestBytes = 2 << 30
+
+// ExportKnowledgeBase streams a knowledge base archive. Query parameter
+// include_vectors (default true) adds the chunks and vectors of the current
+// document versions.
+func (s *KnowledgeService) ExportKnowledgeBase(ctx khttp.Context) error {
+	reqCtx, err := s.ensureConsoleTenant(ctx, "ConsoleKnowledge/ExportKnowledgeBase")
+	if err != nil {
+		return err
+	}
+	kbID := strings.TrimSpace(ctx.Vars().Get("kb_id"))
+	if err := s.requireKBPermission(reqCtx, biz.PermissionKnowledgeBaseRead, kbID); err != nil {
+		return err
+	}
+	if err := s.requireKBPermission(reqCtx, biz.PermissionDocumentRead, kbID); err != nil {
+		return err
+	}
+	includeVectors := true
+	if raw := strings.TrimSpace(ctx.Request().URL.Query().Get("include_vectors")); raw != "" {
+		includeVectors, err = strconv.ParseBool(raw)
+		if err != nil {
+			return errors.BadRequest("KB_EXPORT_INVALID", "include_vectors must be a boolean")
+		}
+	}
+	w := &archiveResponseWriter{w: ctx.Response(), filename: "kb-" + kbID + ".zip"}
+	if err := s.uc.ExportKnowledgeBase(reqCtx, kbID, includeVectors, w); err != nil {
+		if !w.started {
+			return err
+		}
+		// The archive is already partly sent; without its central directory
+		// the client sees a corrupt ZIP.
+		s.log.Errorf("knowledge base export aborted: kb=%s err=%v", kbID, err)
+	}
+	return nil
+}
+
+// ImportKnowledgeBase creates a knowledge base from an uploaded archive.
+// Form fields: file, name (overrides the archived name) and reembed.
+func (s *KnowledgeService) ImportKnowledgeBase(ctx khttp.Context) (err error) {
+	reqCtx, err := s.ensureConsoleTenant(ctx, "ConsoleKnowledge/ImportKnowledgeBase")
+	if err != nil {
+		return err
+	}
+	entry := auditbiz.AuditLog{Operation: "ConsoleKnowledge/ImportKnowledgeBase", ResourceType: "knowledge_base"}
+	defer func() { s.audit.RecordError(reqCtx, entry, err) }()
+	if err := s.iamUC.RequirePermission(reqCtx, biz.PermissionKnowledgeBaseWrite); err != nil {
+		return err
+	}
+	if err := s.iamUC.RequirePermission(reqCtx, biz.PermissionDocumentUpload); err != nil {
+		return err
+	}
+
+	req := ctx.Request()
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, maxKBArchiveRequestBytes)
+	if err := req.ParseMultipartForm(maxUploadBytes); err != nil {
+		var tooLarge *http.MaxBytesError
+		if stderrors.As(err, &tooLarge) {
+			return errors.New(http.StatusRequestEntityTooLarge, "KB_ARCHIVE_TOO_LARGE", "knowledge base archive too large")
+		}
+		return errors.BadRequest("KB_ARCHIVE_INVALID", "invalid multipart form")
+	}
+	defer func() { _ = req.MultipartForm.RemoveAll() }()
+
+	headers := req.MultipartForm.File["file"]
+	if len(headers) != 1 {
+		return errors.BadRequest("KB_ARCHIVE_MISSING", "exactly one archive file required")
+	}
+	reembed := false
+	if raw := strings.TrimSpace(req.FormValue("reembed")); raw != "" {
+		reembed, err = strconv.ParseBool(raw)
+		if err != nil {
+			return errors.BadRequest("KB_ARCHIVE_INVALID", "reembed must be a boolean")
+		}
+	}
+	entry.RequestSummary = formAuditSummary(map[string]any{
+		"name":    req.FormValue("name"),
+		"reembed": reembed,
+		"file":    headers[0].Filename,
+	})
+	file, err := headers[0].Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := s.uc.ImportKnowledgeBase(reqCtx, file, headers[0].Size, biz.KBImportOptions{
+		Name:    req.FormValue("name"),
+		Reembed: reembed,
+	})
+	if err != nil {
+		return err
+	}
+	entry.ResourceID = result.KnowledgeBase.ID
+	return ctx.Result(http.StatusOK, toKnowledgeBaseImport(result))
+}
+
+// CloneKnowledgeBase copies a knowledge base into another tenant (platform
+// admin).
+func (s *KnowledgeService) CloneKnowledgeBase(ctx context.Context, req *v1.CloneKnowledgeBaseRequest) (*v1.KnowledgeBaseImportResponse, error) {
+	if err := s.iamUC.RequirePermission(ctx, biz.PermissionKnowledgeBaseClone); err != nil {
+		return nil, err
+	}
+	for _, tenantID := range []string{req.GetSourceTenantId(), req.GetTargetTenantId()} {
+		if strings.TrimSpace(tenantID) == "" {
+			continue
+		}
+		if _, err := s.iamUC.GetTenant(ctx, strings.TrimSpace(tenantID)); err != nil {
+			return nil, err
+		}
+	}
+	result, err := s.uc.CloneKnowledgeBase(ctx, biz.KBCloneRequest{
+		SourceTenantID: req.GetSourceTenantId(),
+		SourceKBID:     req.GetSourceKbId(),
+		TargetTenantID: req.GetTargetTenantId(),
+		KBImportOptions: biz.KBImportOptions{
+			Name:    req.GetName(),
+			Reembed: req.GetReembed(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toKnowledgeBaseImport(result), nil
+}
+
+// archiveResponseWriter sets the download headers on the first write, so an
+// error raised before any output still gets a regular error response.
+type archiveResponseWriter struct {
+	w        http.ResponseWriter
+	filename string
+	started  bool
+}
+
+func (a *archiveResponseWriter) Write(p []byte) (int, error) {
+	if !a.started {
+		a.started = true
+		a.w.Header().Set("Content-Type", "application/zip")
+		a.w.Header().Set("Content-Disposition", `attachment; filename="`+a.filename+`"`)
+		a.w.WriteHeader(http.StatusOK)
+	}
+	return a.w.Write(p)
+}
+
+func toKnowledgeBaseImport(r biz.KBArchiveImport) *v1.KnowledgeBaseImportResponse {
+	return &v1.KnowledgeBaseImportResponse{
+		KnowledgeBase:       toKnowledgeBase(r.KnowledgeBase),
+		Batch:               toImportBatch(r.Batch),
+		RestoredDocuments:   r.RestoredDocuments,
+		ReembeddedDocuments: r.ReembeddedDocuments,
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1"
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+)
+
+func (s *KnowledgeService) ListDocumentChunks(ctx context.Context, req *v1.ListDocumentChunksRequest) (*v1.ListChunksResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireDocumentPermission(ctx, biz.PermissionDocumentRead, req.GetId()); err != nil {
+		return nil, err
+	}
+	items, total, err := s.uc.ListDocumentChunks(ctx, req.GetId(), req.GetVersion(), req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*v1.Chunk, 0, len(items))
+	for _, item := range items {
+		out = append(out, toChunk(item))
+	}
+	return &v1.ListChunksResponse{Items: out, Total: total}, nil
+}
+
+func (s *KnowledgeService) SearchChunks(ctx context.Context, req *v1.SearchChunksRequest) (*v1.ListChunksResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireKBPermission(ctx, biz.PermissionDocumentRead, req.GetKbId()); err != nil {
+		return nil, err
+	}
+	items, err := s.uc.SearchChunks(ctx, req.GetKbId(), req.GetQuery(), req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*v1.Chunk, 0, len(items))
+	for _, item := range items {
+		out = append(out, toChunk(item))
+	}
+	return &v1.ListChunksResponse{Items: out}, nil
+}
+
+func (s *KnowledgeService) UpdateChunk(ctx context.Context, req *v1.UpdateChunkRequest) (*v1.ChunkResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireChunkPermission(ctx, biz.PermissionChunkWrite, req.GetId()); err != nil {
+		return nil, err
+	}
+	var edit biz.ChunkEdit
+	if req.Content != nil {
+		content := req.Content.GetValue()
+		edit.Content = &content
+	}
+	if req.Excluded != nil {
+		excluded := req.Excluded.GetValue()
+		edit.Excluded = &excluded
+	}
+	if req.Keywords != nil {
+		keywords := req.Keywords.GetValues()
+		edit.Keywords = &keywords
+	}
+	if claims, ok := jwt.ClaimsFromContext(ctx); ok {
+		edit.ActorID = claims.Subject
+	}
+	item, err := s.uc.UpdateChunk(ctx, req.GetId(), edit)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ChunkResponse{Chunk: toChunk(item)}, nil
+}
+
+func (s *KnowledgeService) ListChunkEdits(ctx context.Context, req *v1.ListChunkEditsRequest) (*v1.ListChunkEditsResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireDocumentPermission(ctx, biz.PermissionDocumentRead, req.GetId()); err != nil {
+		return nil, err
+	}
+	items, err := s.uc.ListChunkEdits(ctx, req.GetId(), req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*v1.ChunkEdit, 0, len(items))
+	for _, item := range items {
+		out = append(out, &v1.ChunkEdit{
+			Id:                item.ID,
+			DocumentId:        item.DocumentID,
+			DocumentVersionId: item.DocumentVersionID,
+			ChunkId:           item.ChunkID,
+			OriginHash:        item.OriginHash,
+			ActorId:           item.ActorID,
+			Before:            toChunkState(item.Before),
+			After:             toChunkState(item.After),
+			CreatedAt:         toTimestamp(item.CreatedAt),
+		})
+	}
+	return &v1.ListChunkEditsResponse{Items: out}, nil
+}
+
+func toChunk(ch biz.ChunkView) *v1.Chunk {
+	return &v1.Chunk{
+		Id:                ch.ID,
+		DocumentId:        ch.DocumentID,
+		DocumentVersionId: ch.DocumentVersionID,
+		DocumentTitle:     ch.DocumentTitle,
+		ChunkIndex:        ch.ChunkIndex,
+		Content:           ch.Content,
+		ContentHash:       ch.ContentHash,
+		Section:           ch.Section,
+		PageNo:            ch.PageNo,
+		TokenCount:        ch.TokenCount,
+		Language:          ch.Language,
+		Excluded:          ch.Excluded,
+		Keywords:          ch.Keywords,
+		OriginHash:        ch.OriginHash,
+		Citations:         ch.Citations,
+	}
+}
+
+func toChunkState(st biz.ChunkState) *v1.ChunkState {
+	return &v1.ChunkState{
+		Content:     st.Content,
+		ContentHash: st.ContentHash,
+		Excluded:    st.Excluded,
+		Keywords:    st.Keywords,
+	}
+}
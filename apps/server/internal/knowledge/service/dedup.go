@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+)
+
+func (s *KnowledgeService) GetDuplicateReport(ctx context.Context, req *v1.GetDuplicateReportRequest) (*v1.DuplicateReportResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireKBPermission(ctx, biz.PermissionKnowledgeBaseRead, req.GetKbId()); err != nil {
+		return nil, err
+	}
+	report, err := s.uc.GetDuplicateReport(ctx, req.GetKbId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DuplicateReportResponse{Report: toDuplicateReport(report)}, nil
+}
+
+func toDuplicateReport(r biz.DuplicateReport) *v1.DuplicateReport {
+	out := &v1.DuplicateReport{
+		KbId:             r.KBID,
+		Links:            r.Links,
+		SkippedChunks:    r.SkippedChunks,
+		ChunkClusters:    make([]*v1.DuplicateChunkCluster, 0, len(r.ChunkClusters)),
+		DocumentClusters: make([]*v1.DuplicateDocumentCluster, 0, len(r.DocumentClusters)),
+	}
+	for _, cluster := range r.ChunkClusters {
+		item := &v1.DuplicateChunkCluster{Chunks: make([]*v1.DuplicateChunk, 0, len(cluster.Chunks))}
+		for _, ch := range cluster.Chunks {
+			item.Chunks = append(item.Chunks, &v1.DuplicateChunk{
+				ChunkId:         ch.ChunkID,
+				DocumentId:      ch.DocumentID,
+				DocumentTitle:   ch.DocumentTitle,
+				ContentPreview:  ch.ContentPreview,
+				Method:          ch.Method,
+				Skipped:         ch.Skipped,
+				OriginalMissing: ch.OriginalMissing,
+			})
+		}
+		out.ChunkClusters = append(out.ChunkClusters, item)
+	}
+	for _, cluster := range r.DocumentClusters {
+		item := &v1.DuplicateDocumentCluster{Pairs: make([]*v1.DuplicateDocumentPair, 0, len(cluster.Pairs))}
+		for _, p := range cluster.Pairs {
+			item.Pairs = append(item.Pairs, &v1.DuplicateDocumentPair{
+				DocumentId:             p.DocumentID,
+				DocumentTitle:          p.DocumentTitle,
+				DuplicateDocumentId:    p.DuplicateDocumentID,
+				DuplicateDocumentTitle: p.DuplicateDocumentTitle,
+				DuplicateChunks:        p.DuplicateChunks,
+				TotalChunks:            p.TotalChunks,
+			})
+		}
+		out.DocumentClusters = append(out.DocumentClusters, item)
+	}
+	return out
+}
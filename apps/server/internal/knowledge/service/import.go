@@ -0,0 +1,315 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	stderrors "errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1"
+	auditbiz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// Bulk import limits. Entries are held in memory until their documents are
+// created, so the uncompressed total is bounded as well as each entry: one
+// import request can hold up to maxImportTotalBytes of payloads plus the
+// maxUploadBytes of the multipart form kept in memory, and concurrent
+// imports add up. Size server memory for the expected number of concurrent
+// imports accordingly.
+const (
+	maxImportRequestBytes = 200 << 20
+	maxImportTotalBytes   = 500 << 20
+	maxImportFiles        = 1000
+	// maxImportCompressionRatio rejects entries that inflate suspiciously.
+	maxImportCompressionRatio = 100
+)
+
+// ImportDocuments creates one document per file of the uploaded ZIP archives
+// and plain files. Form fields: kb_id, folder_mode (none | tags | section),
+// access_labels (comma-separated, applied to every document) and files.
+func (s *KnowledgeService) ImportDocuments(ctx khttp.Context) (err error) {
+	reqCtx, err := s.ensureConsoleTenant(ctx, "ConsoleKnowledge/ImportDocuments")
+	if err != nil {
+		return err
+	}
+	entry := auditbiz.AuditLog{Operation: "ConsoleKnowledge/ImportDocuments", ResourceType: "documents"}
+	defer func() { s.audit.RecordError(reqCtx, entry, err) }()
+	if err := s.requireAnyKBPermission(reqCtx, biz.PermissionDocumentUpload); err != nil {
+		return err
+	}
+
+	req := ctx.Request()
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, maxImportRequestBytes)
+	if err := req.ParseMultipartForm(maxUploadBytes); err != nil {
+		var tooLarge *http.MaxBytesError
+		if stderrors.As(err, &tooLarge) {
+			return errors.New(http.StatusRequestEntityTooLarge, "IMPORT_TOO_LARGE", "import request too large")
+		}
+		return errors.BadRequest("DOC_UPLOAD_INVALID", "invalid multipart form")
+	}
+	defer func() { _ = req.MultipartForm.RemoveAll() }()
+
+	kbID := strings.TrimSpace(req.FormValue("kb_id"))
+	if err := s.requireKBPermission(reqCtx, biz.PermissionDocumentUpload, kbID); err != nil {
+		return err
+	}
+	folderMode := strings.TrimSpace(req.FormValue("folder_mode"))
+	headers := req.MultipartForm.File["files"]
+	if len(headers) == 0 {
+		headers = req.MultipartForm.File["file"]
+	}
+	if len(headers) == 0 {
+		return errors.BadRequest("DOC_UPLOAD_EMPTY", "no files provided")
+	}
+	names := make([]string, 0, len(headers))
+	for _, fh := range headers {
+		names = append(names, fh.Filename)
+	}
+	entry.ResourceID = kbID
+	entry.RequestSummary = formAuditSummary(map[string]any{
+		"kb_id":         kbID,
+		"folder_mode":   folderMode,
+		"access_labels": splitFormList(req.FormValue("access_labels")),
+		"files":         names,
+	})
+
+	reader := importReader{}
+	source := "zip"
+	for _, fh := range headers {
+		if !isZipUpload(fh) {
+			source = "files"
+			if err := reader.addFile(fh); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := reader.addArchive(fh); err != nil {
+			return err
+		}
+	}
+	if len(reader.files) == 0 {
+		return errors.BadRequest("DOC_UPLOAD_EMPTY", "no files provided")
+	}
+
+	batch, err := s.uc.ImportDocuments(reqCtx, kbID, folderMode, source, reader.files, splitFormList(req.FormValue("access_labels")))
+	if err != nil {
+		return err
+	}
+	return ctx.Result(http.StatusOK, &v1.ImportBatchResponse{Batch: toImportBatch(batch)})
+}
+
+func (s *KnowledgeService) GetImportBatch(ctx context.Context, req *v1.GetImportBatchRequest) (*v1.ImportBatchResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireImportBatchPermission(ctx, biz.PermissionDocumentRead, req.GetId()); err != nil {
+		return nil, err
+	}
+	batch, err := s.uc.GetImportBatch(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ImportBatchResponse{Batch: toImportBatch(batch)}, nil
+}
+
+// importReader collects the files of an import request within the limits.
+type importReader struct {
+	files      []biz.ImportFile
+	totalBytes int64
+}
+
+func (r *importReader) addFile(fh *multipart.FileHeader) error {
+	if err := r.reserve(); err != nil {
+		return err
+	}
+	name := path.Base(strings.ReplaceAll(fh.Filename, "\\", "/"))
+	if skip := skipImportEntry(name, uint64(fh.Size), 0); skip != "" {
+		r.skip(name, skip)
+		return nil
+	}
+	file, err := fh.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return r.read(name, file, strings.TrimSpace(fh.Header.Get("Content-Type")))
+}
+
+func (r *importReader) addArchive(fh *multipart.FileHeader) error {
+	file, err := fh.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	archive, err := zip.NewReader(file, fh.Size)
+	if err != nil {
+		return errors.BadRequest("IMPORT_ARCHIVE_INVALID", "invalid zip archive: "+fh.Filename)
+	}
+	for _, entry := range archive.File {
+		if entry.FileInfo().IsDir() {
+			continue
+		}
+		name, ok := cleanArchivePath(entry.Name)
+		if ok && isArchiveMetadata(name) {
+			continue
+		}
+		if err := r.reserve(); err != nil {
+			return err
+		}
+		if !ok {
+			// Zip-slip: never trust an entry path that leaves the archive root.
+			r.skip(entry.Name, "unsafe path")
+			continue
+		}
+		if skip := skipImportEntry(name, entry.UncompressedSize64, entry.CompressedSize64); skip != "" {
+			r.skip(name, skip)
+			continue
+		}
+		rc, err := entry.Open()
+		if err != nil {
+			r.skip(name, err.Error())
+			continue
+		}
+		err = r.read(name, rc, "")
+		_ = rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// read reads one file, trusting neither the declared size nor the
+// compression header.
+func (r *importReader) read(name string, src io.Reader, contentType string) error {
+	payload, err := io.ReadAll(io.LimitReader(src, maxUploadBytes+1))
+	if err != nil {
+		r.skip(name, err.Error())
+		return nil
+	}
+	if len(payload) > maxUploadBytes {
+		r.skip(name, "file too large")
+		return nil
+	}
+	if len(payload) == 0 {
+		r.skip(name, "file empty")
+		return nil
+	}
+	r.totalBytes += int64(len(payload))
+	if r.totalBytes > maxImportTotalBytes {
+		return errors.New(http.StatusRequestEntityTooLarge, "IMPORT_TOO_LARGE", "import content too large")
+	}
+	if contentType == "" {
+		contentType = detectContentType(name, payload)
+	}
+	r.files = append(r.files, biz.ImportFile{
+		Path:        name,
+		SourceType:  inferSourceTypeFromFilename(name),
+		ContentType: contentType,
+		Payload:     payload,
+	})
+	return nil
+}
+
+func (r *importReader) reserve() error {
+	if len(r.files) >= maxImportFiles {
+		return errors.BadRequest("IMPORT_TOO_MANY_FILES", "too many files in import")
+	}
+	return nil
+}
+
+func (r *importReader) skip(name string, reason string) {
+	r.files = append(r.files, biz.ImportFile{Path: name, SkipReason: reason})
+}
+
+// skipImportEntry returns why an entry is not imported, or "".
+func skipImportEntry(name string, size uint64, compressed uint64) string {
+	if _, ok := sourceTypeByExtension[strings.ToLower(filepath.Ext(name))]; !ok {
+		return "unsupported file type"
+	}
+	if size > maxUploadBytes {
+		return "file too large"
+	}
+	if compressed > 0 && size/compressed > maxImportCompressionRatio {
+		return "compression ratio too high"
+	}
+	return ""
+}
+
+// cleanArchivePath normalizes an entry name to a relative slash path and
+// reports false for absolute paths or paths escaping the archive root.
+func cleanArchivePath(name string) (string, bool) {
+	name = strings.ReplaceAll(name, "\\", "/")
+	// "C:..." is a Windows volume.
+	if name == "" || strings.ContainsRune(name, 0) || strings.HasPrefix(name, "/") || (len(name) > 1 && name[1] == ':') {
+		return "", false
+	}
+	cleaned := path.Clean(name)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", false
+	}
+	return cleaned, true
+}
+
+// isArchiveMetadata reports files added by archivers and file managers, such
+// as __MACOSX/ and .DS_Store.
+func isArchiveMetadata(name string) bool {
+	for _, part := range strings.Split(name, "/") {
+		if part == "__MACOSX" || strings.HasPrefix(part, ".") {
+			return true
+		}
+	}
+	return false
+}
+
+func isZipUpload(fh *multipart.FileHeader) bool {
+	if strings.EqualFold(filepath.Ext(fh.Filename), ".zip") {
+		return true
+	}
+	switch strings.ToLower(strings.TrimSpace(fh.Header.Get("Content-Type"))) {
+	case "application/zip", "application/x-zip-compressed":
+		return true
+	}
+	return false
+}
+
+func toImportBatch(b biz.ImportBatch) *v1.ImportBatch {
+	if b.ID == "" {
+		return nil
+	}
+	out := &v1.ImportBatch{
+		Id:                  b.ID,
+		TenantId:            b.TenantID,
+		KbId:                b.KBID,
+		FolderMode:          b.FolderMode,
+		Source:              b.Source,
+		Status:              b.Status,
+		TotalFiles:          b.TotalFiles,
+		SkippedFiles:        b.SkippedFiles,
+		FailedFiles:         b.FailedFiles,
+		DocumentsProcessing: b.DocumentsProcessing,
+		DocumentsReady:      b.DocumentsReady,
+		DocumentsFailed:     b.DocumentsFailed,
+		Items:               make([]*v1.ImportBatchItem, 0, len(b.Items)),
+		CreatedAt:           toTimestamp(b.CreatedAt),
+	}
+	for _, item := range b.Items {
+		out.Items = append(out.Items, &v1.ImportBatchItem{
+			Path:           item.Path,
+			SourceType:     item.SourceType,
+			DocumentId:     item.DocumentID,
+			Status:         item.Status,
+			DocumentStatus: item.DocumentStatus,
+			ErrorMessage:   item.ErrorReason,
+		})
+	}
+	return out
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestCleanArchivePath(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   string
+		wantOK bool
+	}{
+		{name: "docs/guide.md", want: "docs/guide.md", wantOK: true},
+		{name: "./docs//a/../guide.md", want: "docs/guide.md", wantOK: true},
+		{name: `docs\guide.md`, want: "docs/guide.md", wantOK: true},
+		{name: "docs/..hidden.md", want: "docs/..hidden.md", wantOK: true},
+		{name: "../guide.md"},
+		{name: "docs/../../guide.md"},
+		{name: `..\guide.md`},
+		{name: `docs\..\..\guide.md`},
+		{name: ".."},
+		{name: "docs/.."},
+		{name: "."},
+		{name: ""},
+		{name: "/etc/passwd"},
+		{name: `\windows\win.ini`},
+		{name: "C:/windows/win.ini"},
+		{name: `C:\windows\win.ini`},
+		{name: "c:guide.md"},
+		{name: "docs/guide\x00.md"},
+		{name: "\x00"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%q", tt.name), func(t *testing.T) {
+			got, ok := cleanArchivePath(tt.name)
+			if ok != tt.wantOK || got != tt.want {
+				t.Fatalf("cleanArchivePath(%q) = %q, %v, want %q, %v", tt.name, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSkipImportEntry(t *testing.T) {
+	tests := []struct {
+		name       string
+		entry      string
+		size       uint64
+		compressed uint64
+		want       string
+	}{
+		{name: "supported", entry: "a.md", size: 1 << 10, compressed: 512},
+		{name: "extension case", entry: "A.PDF", size: 1 << 10},
+		{name: "unsupported", entry: "a.exe", size: 1 << 10, want: "unsupported file type"},
+		{name: "no extension", entry: "Makefile", size: 1 << 10, want: "unsupported file type"},
+		{name: "size at limit", entry: "a.txt", size: maxUploadBytes, compressed: maxUploadBytes},
+		{name: "size over limit", entry: "a.txt", size: maxUploadBytes + 1, want: "file too large"},
+		{name: "ratio at limit", entry: "a.txt", size: 100 * 1000, compressed: 1000},
+		{name: "ratio over limit", entry: "a.txt", size: 101 * 1000, compressed: 1000, want: "compression ratio too high"},
+		// Plain files carry no compressed size.
+		{name: "ratio unknown", entry: "a.txt", size: 1 << 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := skipImportEntry(tt.entry, tt.size, tt.compressed); got != tt.want {
+				t.Fatalf("skipImportEntry = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type zipEntry struct {
+	name   string
+	body   []byte
+	method uint16
+}
+
+func zipArchive(t *testing.T, entries []zipEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, entry := range entries {
+		f, err := w.CreateHeader(&zip.FileHeader{Name: entry.name, Method: entry.method})
+		if err != nil {
+			t.Fatalf("zip entry: %v", err)
+		}
+		if _, err := f.Write(entry.body); err != nil {
+			t.Fatalf("zip write: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("zip close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// uploadFile returns the header of a file part as ParseMultipartForm does.
+func uploadFile(t *testing.T, filename string, content []byte) *multipart.FileHeader {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("files", filename)
+	if err != nil {
+		t.Fatalf("form file: %v", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		t.Fatalf("form write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("form close: %v", err)
+	}
+	form, err := multipart.NewReader(&body, w.Boundary()).ReadForm(maxUploadBytes)
+	if err != nil {
+		t.Fatalf("read form: %v", err)
+	}
+	t.Cleanup(func() { _ = form.RemoveAll() })
+	return form.File["files"][0]
+}
+
+func TestImportReaderArchive(t *testing.T) {
+	archive := zipArchive(t, []zipEntry{
+		{name: "docs/", method: zip.Store},
+		{name: "docs/guide.md", body: []byte("# Guide"), method: zip.Deflate},
+		{name: `docs\faq.txt`, body: []byte("faq"), method: zip.Store},
+		{name: "../evil.md", body: []byte("x")},
+		{name: "/etc/evil.md", body: []byte("x")},
+		{name: `C:\evil.md`, body: []byte("x")},
+		{name: "__MACOSX/docs/._guide.md", body: []byte("x")},
+		{name: "docs/.DS_Store", body: []byte("x")},
+		{name: "tool.exe", body: []byte("MZ")},
+		{name: "empty.txt"},
+		{name: "bomb.txt", body: make([]byte, 1<<20), method: zip.Deflate},
+	})
+	reader := importReader{}
+	if err := reader.addArchive(uploadFile(t, "docs.zip", archive)); err != nil {
+		t.Fatalf("addArchive: %v", err)
+	}
+	got := make([]string, 0, len(reader.files))
+	for _, f := range reader.files {
+		got = append(got, f.Path+": "+f.SkipReason)
+	}
+	want := []string{
+		"docs/guide.md: ",
+		"docs/faq.txt: ",
+		"../evil.md: unsafe path",
+		"/etc/evil.md: unsafe path",
+		`C:\evil.md: unsafe path`,
+		"tool.exe: unsupported file type",
+		"empty.txt: file empty",
+		"bomb.txt: compression ratio too high",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("files =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if string(reader.files[0].Payload) != "# Guide" || reader.totalBytes != int64(len("# Guide")+len("faq")) {
+		t.Fatalf("payload = %q, total = %d", reader.files[0].Payload, reader.totalBytes)
+	}
+}
+
+func TestImportReaderLimits(t *testing.T) {
+	manyFiles := func(n int) []zipEntry {
+		entries := make([]zipEntry, 0, n+1)
+		for i := 0; i < n; i++ {
+			entries = append(entries, zipEntry{name: fmt.Sprintf("f%04d.txt", i), body: []byte("x")})
+		}
+		// Archive metadata does not count towards the file limit.
+		return append(entries, zipEntry{name: "__MACOSX/._f0000.txt", body: []byte("x")})
+	}
+	tests := []struct {
+		name string
+		// totalBytes is the content already read from earlier files.
+		totalBytes int64
+		add        func(t *testing.T, r *importReader) error
+		wantReason string
+		wantFiles  int
+	}{
+		{
+			name: "file count at limit",
+			add: func(t *testing.T, r *importReader) error {
+				return r.addArchive(uploadFile(t, "many.zip", zipArchive(t, manyFiles(maxImportFiles))))
+			},
+			wantFiles: maxImportFiles,
+		},
+		{
+			name: "file count over limit",
+			add: func(t *testing.T, r *importReader) error {
+				return r.addArchive(uploadFile(t, "many.zip", zipArchive(t, manyFiles(maxImportFiles+1))))
+			},
+			wantReason: "IMPORT_TOO_MANY_FILES",
+		},
+		{
+			name: "file count across uploads",
+			add: func(t *testing.T, r *importReader) error {
+				if err := r.addArchive(uploadFile(t, "many.zip", zipArchive(t, manyFiles(maxImportFiles)))); err != nil {
+					t.Fatalf("archive: %v", err)
+				}
+				return r.addFile(uploadFile(t, "one-more.txt", []byte("x")))
+			},
+			wantReason: "IMPORT_TOO_MANY_FILES",
+		},
+		{
+			name:       "total bytes at limit",
+			totalBytes: maxImportTotalBytes - 4,
+			add: func(t *testing.T, r *importReader) error {
+				return r.addFile(uploadFile(t, "a.txt", []byte("abcd")))
+			},
+			wantFiles: 1,
+		},
+		{
+			name:       "total bytes over limit",
+			totalBytes: maxImportTotalBytes - 4,
+			add: func(t *testing.T, r *importReader) error {
+				return r.addFile(uploadFile(t, "a.txt", []byte("abcde")))
+			},
+			wantReason: "IMPORT_TOO_LARGE",
+		},
+		{
+			name:       "total bytes over limit in an archive",
+			totalBytes: maxImportTotalBytes - 4,
+			add: func(t *testing.T, r *importReader) error {
+				return r.addArchive(uploadFile(t, "a.zip", zipArchive(t, []zipEntry{{name: "a.txt", body: []byte("abcde")}})))
+			},
+			wantReason: "IMPORT_TOO_LARGE",
+		},
+		{
+			name: "file over size limit",
+			add: func(t *testing.T, r *importReader) error {
+				return r.addFile(uploadFile(t, "big.txt", make([]byte, maxUploadBytes+1)))
+			},
+			wantFiles: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := &importReader{totalBytes: tt.totalBytes}
+			err := tt.add(t, reader)
+			if tt.wantReason != "" {
+				if reason := errors.Reason(err); reason != tt.wantReason {
+					t.Fatalf("err = %v, want %s", err, tt.wantReason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("add: %v", err)
+			}
+			if len(reader.files) != tt.wantFiles {
+				t.Fatalf("files = %d, want %d", len(reader.files), tt.wantFiles)
+			}
+		})
+	}
+}
+
+// read must not trust the sizes an archive declares.
+func TestImportReaderReadCapsContent(t *testing.T) {
+	reader := importReader{}
+	if err := reader.read("big.txt", bytes.NewReader(make([]byte, maxUploadBytes+1)), ""); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if len(reader.files) != 1 || reader.files[0].SkipReason != "file too large" || reader.totalBytes != 0 {
+		t.Fatalf("files = %+v, total = %d", reader.files, reader.totalBytes)
+	}
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+)
+
+func (s *KnowledgeService) ListIngestionJobs(ctx context.Context, req *v1.ListIngestionJobsRequest) (*v1.ListIngestionJobsResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireKBPermission(ctx, biz.PermissionDocumentRead, req.GetKbId()); err != nil {
+		return nil, err
+	}
+	items, err := s.uc.ListIngestionJobs(ctx, req.GetKbId(), req.GetStatus(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListIngestionJobsResponse{Items: make([]*v1.IngestionJob, 0, len(items))}
+	for _, item := range items {
+		resp.Items = append(resp.Items, toIngestionJob(item))
+	}
+	return resp, nil
+}
+
+func (s *KnowledgeService) GetIngestionJob(ctx context.Context, req *v1.GetIngestionJobRequest) (*v1.IngestionJobResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireIngestionJobPermission(ctx, biz.PermissionDocumentRead, req.GetId()); err != nil {
+		return nil, err
+	}
+	job, err := s.uc.GetIngestionJob(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.IngestionJobResponse{Job: toIngestionJob(job)}, nil
+}
+
+func (s *KnowledgeService) RetryIngestionJob(ctx context.Context, req *v1.RetryIngestionJobRequest) (*v1.IngestionJobResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireIngestionJobPermission(ctx, biz.PermissionDocumentReindex, req.GetId()); err != nil {
+		return nil, err
+	}
+	job, err := s.uc.RetryIngestionJob(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.IngestionJobResponse{Job: toIngestionJob(job)}, nil
+}
+
+func (s *KnowledgeService) CancelIngestionJob(ctx context.Context, req *v1.CancelIngestionJobRequest) (*v1.IngestionJobResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireIngestionJobPermission(ctx, biz.PermissionDocumentReindex, req.GetId()); err != nil {
+		return nil, err
+	}
+	job, err := s.uc.CancelIngestionJob(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.IngestionJobResponse{Job: toIngestionJob(job)}, nil
+}
+
+func toIngestionJob(j biz.IngestionJobRecord) *v1.IngestionJob {
+	if j.ID == "" {
+		return nil
+	}
+	out := &v1.IngestionJob{
+		Id:                j.ID,
+		TenantId:          j.TenantID,
+		KbId:              j.KBID,
+		DocumentId:        j.DocumentID,
+		DocumentVersionId: j.DocumentVersionID,
+		Status:            j.Status,
+		CurrentStep:       j.CurrentStep,
+		Attempts:          j.Attempts,
+		ChunkCount:        j.ChunkCount,
+		ReusedChunks:      j.ReusedChunks,
+		EmbeddedChunks:    j.EmbeddedChunks,
+		StepDurations:     j.StepDurations,
+		ErrorMessage:      j.ErrorReason,
+		CreatedAt:         toTimestamp(j.CreatedAt),
+		UpdatedAt:         toTimestamp(j.UpdatedAt),
+	}
+	if j.StartedAt != nil {
+		out.StartedAt = toTimestamp(*j.StartedAt)
+	}
+	if j.FinishedAt != nil {
+		out.FinishedAt = toTimestamp(*j.FinishedAt)
+	}
+	return out
+}
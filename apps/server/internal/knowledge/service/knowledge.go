@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	v1 "github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1"
-	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	auditbiz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
+	authbiz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
 	iambiz "github.com/ZTH7/RagoDesk/apps/server/internal/iam/biz"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
 	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/middleware"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -19,20 +22,21 @@ import (
 // KnowledgeService handles knowledge service layer.
 type KnowledgeService struct {
 	v1.UnimplementedConsoleKnowledgeServer
+	v1.UnimplementedPlatformKnowledgeServer
 
-	uc    *biz.KnowledgeUsecase
-	iamUC *iambiz.IAMUsecase
-	log   *log.Helper
-	auth  *conf.Server_Auth
+	uc     *biz.KnowledgeUsecase
+	iamUC  *iambiz.IAMUsecase
+	authUC *authbiz.AuthUsecase
+	audit  *auditbiz.AuditUsecase
+	log    *log.Helper
+	// impersonationLog marks impersonated requests on the raw HTTP routes,
+	// which bypass ImpersonationLogMiddleware.
+	impersonationLog *log.Helper
 }
 
 // NewKnowledgeService creates a new KnowledgeService
-func NewKnowledgeService(uc *biz.KnowledgeUsecase, iamUC *iambiz.IAMUsecase, cfg *conf.Server, logger log.Logger) *KnowledgeService {
-	var auth *conf.Server_Auth
-	if cfg != nil {
-		auth = cfg.Auth
-	}
-	return &KnowledgeService{uc: uc, iamUC: iamUC, log: log.NewHelper(logger), auth: auth}
+func NewKnowledgeService(uc *biz.KnowledgeUsecase, iamUC *iambiz.IAMUsecase, authUC *authbiz.AuthUsecase, audit *auditbiz.AuditUsecase, logger log.Logger) *KnowledgeService {
+	return &KnowledgeService{uc: uc, iamUC: iamUC, authUC: authUC, audit: audit, log: log.NewHelper(logger), impersonationLog: middleware.NewImpersonationLogHelper(logger)}
 }
 
 func (s *KnowledgeService) CreateKnowledgeBase(ctx context.Context, req *v1.CreateKnowledgeBaseRequest) (*v1.KnowledgeBaseResponse, error) {
@@ -45,6 +49,9 @@ func (s *KnowledgeService) CreateKnowledgeBase(ctx context.Context, req *v1.Crea
 	created, err := s.uc.CreateKnowledgeBase(ctx, biz.KnowledgeBase{
 		Name:        req.GetName(),
 		Description: req.GetDescription(),
+		Chunking:    fromChunkingConfig(req.GetChunking()),
+		Embedding:   fromEmbeddingConfig(req.GetEmbedding()),
+		Dedup:       fromDedupConfig(req.GetDedup()),
 	})
 	if err != nil {
 		return nil, err
@@ -56,7 +63,7 @@ func (s *KnowledgeService) GetKnowledgeBase(ctx context.Context, req *v1.GetKnow
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionKnowledgeBaseRead); err != nil {
+	if err := s.requireKBPermission(ctx, biz.PermissionKnowledgeBaseRead, req.GetId()); err != nil {
 		return nil, err
 	}
 	kb, err := s.uc.GetKnowledgeBase(ctx, req.GetId())
@@ -70,13 +77,16 @@ func (s *KnowledgeService) UpdateKnowledgeBase(ctx context.Context, req *v1.Upda
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionKnowledgeBaseWrite); err != nil {
+	if err := s.requireKBPermission(ctx, biz.PermissionKnowledgeBaseWrite, req.GetId()); err != nil {
 		return nil, err
 	}
 	updated, err := s.uc.UpdateKnowledgeBase(ctx, biz.KnowledgeBase{
 		ID:          req.GetId(),
 		Name:        req.GetName(),
 		Description: req.GetDescription(),
+		Chunking:    fromChunkingConfig(req.GetChunking()),
+		Embedding:   fromEmbeddingConfig(req.GetEmbedding()),
+		Dedup:       fromDedupConfig(req.GetDedup()),
 	})
 	if err != nil {
 		return nil, err
@@ -88,7 +98,7 @@ func (s *KnowledgeService) DeleteKnowledgeBase(ctx context.Context, req *v1.Dele
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionKnowledgeBaseWrite); err != nil {
+	if err := s.requireKBPermission(ctx, biz.PermissionKnowledgeBaseWrite, req.GetId()); err != nil {
 		return nil, err
 	}
 	if err := s.uc.DeleteKnowledgeBase(ctx, req.GetId()); err != nil {
@@ -101,7 +111,8 @@ func (s *KnowledgeService) ListKnowledgeBases(ctx context.Context, req *v1.ListK
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionKnowledgeBaseRead); err != nil {
+	visible, err := s.iamUC.VisibleResources(ctx, biz.PermissionKnowledgeBaseRead, iambiz.ResourceTypeKnowledgeBase)
+	if err != nil {
 		return nil, err
 	}
 	items, err := s.uc.ListKnowledgeBases(ctx)
@@ -110,7 +121,9 @@ func (s *KnowledgeService) ListKnowledgeBases(ctx context.Context, req *v1.ListK
 	}
 	resp := &v1.ListKnowledgeBasesResponse{Items: make([]*v1.KnowledgeBase, 0, len(items))}
 	for _, item := range items {
-		resp.Items = append(resp.Items, toKnowledgeBase(item))
+		if visible.Allows(item.ID) {
+			resp.Items = append(resp.Items, toKnowledgeBase(item))
+		}
 	}
 	return resp, nil
 }
@@ -119,10 +132,22 @@ func (s *KnowledgeService) ListDocuments(ctx context.Context, req *v1.ListDocume
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionDocumentRead); err != nil {
-		return nil, err
-	}
-	items, err := s.uc.ListDocuments(ctx, req.GetKbId(), req.GetLimit(), req.GetOffset())
+	var kbIDs []string
+	if kbID := strings.TrimSpace(req.GetKbId()); kbID != "" {
+		if err := s.requireKBPermission(ctx, biz.PermissionDocumentRead, kbID); err != nil {
+			return nil, err
+		}
+		kbIDs = []string{kbID}
+	} else {
+		visible, err := s.iamUC.VisibleResources(ctx, biz.PermissionDocumentRead, iambiz.ResourceTypeKnowledgeBase)
+		if err != nil {
+			return nil, err
+		}
+		if !visible.All {
+			kbIDs = visible.IDs
+		}
+	}
+	items, err := s.uc.ListDocuments(ctx, kbIDs, req.GetLimit(), req.GetOffset())
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +162,7 @@ func (s *KnowledgeService) ListBotKnowledgeBases(ctx context.Context, req *v1.Li
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionBotRead); err != nil {
+	if err := s.iamUC.RequirePermission(ctx, biz.PermissionBotRead, iambiz.BotRef(req.GetBotId())); err != nil {
 		return nil, err
 	}
 	items, err := s.uc.ListBotKnowledgeBases(ctx, req.GetBotId())
@@ -155,7 +180,7 @@ func (s *KnowledgeService) BindBotKnowledgeBase(ctx context.Context, req *v1.Bin
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionBotKBBind); err != nil {
+	if err := s.iamUC.RequirePermission(ctx, biz.PermissionBotKBBind, iambiz.BotRef(req.GetBotId()), iambiz.KnowledgeBaseRef(req.GetKbId())); err != nil {
 		return nil, err
 	}
 	linked, err := s.uc.BindBotKnowledgeBase(ctx, req.GetBotId(), req.GetKbId(), req.GetWeight())
@@ -169,7 +194,7 @@ func (s *KnowledgeService) UnbindBotKnowledgeBase(ctx context.Context, req *v1.U
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionBotKBUnbind); err != nil {
+	if err := s.iamUC.RequirePermission(ctx, biz.PermissionBotKBUnbind, iambiz.BotRef(req.GetBotId()), iambiz.KnowledgeBaseRef(req.GetKbId())); err != nil {
 		return nil, err
 	}
 	if err := s.uc.UnbindBotKnowledgeBase(ctx, req.GetBotId(), req.GetKbId()); err != nil {
@@ -182,10 +207,10 @@ func (s *KnowledgeService) UploadDocument(ctx context.Context, req *v1.UploadDoc
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionDocumentUpload); err != nil {
+	if err := s.requireKBPermission(ctx, biz.PermissionDocumentUpload, req.GetKbId()); err != nil {
 		return nil, err
 	}
-	doc, ver, err := s.uc.UploadDocument(ctx, req.GetKbId(), req.GetTitle(), req.GetSourceType(), req.GetRawUri())
+	doc, ver, err := s.uc.UploadDocument(ctx, req.GetKbId(), req.GetTitle(), req.GetSourceType(), req.GetRawUri(), biz.DocumentLabels{AccessLabels: req.GetAccessLabels()})
 	if err != nil {
 		return nil, err
 	}
@@ -199,7 +224,7 @@ func (s *KnowledgeService) GetDocument(ctx context.Context, req *v1.GetDocumentR
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionDocumentRead); err != nil {
+	if err := s.requireDocumentPermission(ctx, biz.PermissionDocumentRead, req.GetId()); err != nil {
 		return nil, err
 	}
 	doc, versions, err := s.uc.GetDocument(ctx, req.GetId())
@@ -220,7 +245,7 @@ func (s *KnowledgeService) DeleteDocument(ctx context.Context, req *v1.DeleteDoc
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionDocumentDelete); err != nil {
+	if err := s.requireDocumentPermission(ctx, biz.PermissionDocumentDelete, req.GetId()); err != nil {
 		return nil, err
 	}
 	if err := s.uc.DeleteDocument(ctx, req.GetId()); err != nil {
@@ -233,9 +258,15 @@ func (s *KnowledgeService) UpdateDocument(ctx context.Context, req *v1.UpdateDoc
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionDocumentUpload); err != nil {
+	if err := s.requireDocumentPermission(ctx, biz.PermissionDocumentUpload, req.GetId()); err != nil {
 		return nil, err
 	}
+	if kbID := strings.TrimSpace(req.GetKbId()); kbID != "" {
+		// Moving a document needs the permission on the target as well.
+		if err := s.requireKBPermission(ctx, biz.PermissionDocumentUpload, kbID); err != nil {
+			return nil, err
+		}
+	}
 	updated, err := s.uc.UpdateDocumentKB(ctx, req.GetId(), req.GetKbId())
 	if err != nil {
 		return nil, err
@@ -243,11 +274,25 @@ func (s *KnowledgeService) UpdateDocument(ctx context.Context, req *v1.UpdateDoc
 	return &v1.DocumentResponse{Document: toDocument(updated)}, nil
 }
 
+func (s *KnowledgeService) SetDocumentAccessLabels(ctx context.Context, req *v1.SetDocumentAccessLabelsRequest) (*v1.DocumentResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireDocumentPermission(ctx, biz.PermissionDocumentUpload, req.GetId()); err != nil {
+		return nil, err
+	}
+	updated, err := s.uc.SetDocumentAccessLabels(ctx, req.GetId(), req.GetAccessLabels())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DocumentResponse{Document: toDocument(updated)}, nil
+}
+
 func (s *KnowledgeService) ReindexDocument(ctx context.Context, req *v1.ReindexDocumentRequest) (*emptypb.Empty, error) {
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionDocumentReindex); err != nil {
+	if err := s.requireDocumentPermission(ctx, biz.PermissionDocumentReindex, req.GetId()); err != nil {
 		return nil, err
 	}
 	if _, err := s.uc.ReindexDocument(ctx, req.GetId()); err != nil {
@@ -260,7 +305,7 @@ func (s *KnowledgeService) RollbackDocument(ctx context.Context, req *v1.Rollbac
 	if err := requireTenantContext(ctx); err != nil {
 		return nil, err
 	}
-	if err := s.iamUC.RequirePermission(ctx, biz.PermissionDocumentRollback); err != nil {
+	if err := s.requireDocumentPermission(ctx, biz.PermissionDocumentRollback, req.GetId()); err != nil {
 		return nil, err
 	}
 	if err := s.uc.RollbackDocument(ctx, req.GetId(), req.GetVersion()); err != nil {
@@ -291,12 +336,88 @@ func toKnowledgeBase(kb biz.KnowledgeBase) *v1.KnowledgeBase {
 		return nil
 	}
 	return &v1.KnowledgeBase{
-		Id:          kb.ID,
-		TenantId:    kb.TenantID,
-		Name:        kb.Name,
-		Description: kb.Description,
-		CreatedAt:   toTimestamp(kb.CreatedAt),
-		UpdatedAt:   toTimestamp(kb.UpdatedAt),
+		Id:              kb.ID,
+		TenantId:        kb.TenantID,
+		Name:            kb.Name,
+		Description:     kb.Description,
+		Chunking:        toChunkingConfig(kb.Chunking),
+		Embedding:       toEmbeddingConfig(kb.Embedding),
+		Dedup:           toDedupConfig(kb.Dedup),
+		IndexGeneration: kb.IndexGeneration,
+		CreatedAt:       toTimestamp(kb.CreatedAt),
+		UpdatedAt:       toTimestamp(kb.UpdatedAt),
+	}
+}
+
+func toChunkingConfig(cfg biz.ChunkingConfig) *v1.ChunkingConfig {
+	if cfg.IsZero() {
+		return nil
+	}
+	return &v1.ChunkingConfig{
+		Strategy:            cfg.Strategy,
+		MaxTokens:           cfg.MaxTokens,
+		OverlapTokens:       cfg.OverlapTokens,
+		WindowSentences:     cfg.WindowSentences,
+		OverlapSentences:    cfg.OverlapSentences,
+		SimilarityThreshold: cfg.SimilarityThreshold,
+	}
+}
+
+func fromChunkingConfig(cfg *v1.ChunkingConfig) biz.ChunkingConfig {
+	if cfg == nil {
+		return biz.ChunkingConfig{}
+	}
+	return biz.ChunkingConfig{
+		Strategy:            cfg.GetStrategy(),
+		MaxTokens:           cfg.GetMaxTokens(),
+		OverlapTokens:       cfg.GetOverlapTokens(),
+		WindowSentences:     cfg.GetWindowSentences(),
+		OverlapSentences:    cfg.GetOverlapSentences(),
+		SimilarityThreshold: cfg.GetSimilarityThreshold(),
+	}
+}
+
+func toEmbeddingConfig(cfg biz.EmbeddingConfig) *v1.EmbeddingConfig {
+	if cfg.IsZero() {
+		return nil
+	}
+	return &v1.EmbeddingConfig{
+		Provider: cfg.Provider,
+		Model:    cfg.Model,
+		Dim:      cfg.Dim,
+	}
+}
+
+func fromEmbeddingConfig(cfg *v1.EmbeddingConfig) biz.EmbeddingConfig {
+	if cfg == nil {
+		return biz.EmbeddingConfig{}
+	}
+	return biz.EmbeddingConfig{
+		Provider: cfg.GetProvider(),
+		Model:    cfg.GetModel(),
+		Dim:      cfg.GetDim(),
+	}
+}
+
+func toDedupConfig(cfg biz.DedupConfig) *v1.DedupConfig {
+	if cfg.IsZero() {
+		return nil
+	}
+	return &v1.DedupConfig{
+		Mode:                cfg.Mode,
+		MaxDistance:         cfg.MaxDistance,
+		SimilarityThreshold: cfg.SimilarityThreshold,
+	}
+}
+
+func fromDedupConfig(cfg *v1.DedupConfig) biz.DedupConfig {
+	if cfg == nil {
+		return biz.DedupConfig{}
+	}
+	return biz.DedupConfig{
+		Mode:                cfg.GetMode(),
+		MaxDistance:         cfg.GetMaxDistance(),
+		SimilarityThreshold: cfg.GetSimilarityThreshold(),
 	}
 }
 
@@ -326,6 +447,9 @@ func toDocument(doc biz.Document) *v1.Document {
 		SourceType:     doc.SourceType,
 		Status:         doc.Status,
 		CurrentVersion: doc.CurrentVersion,
+		Tags:           doc.Tags,
+		SectionPrefix:  doc.SectionPrefix,
+		AccessLabels:   doc.AccessLabels,
 		CreatedAt:      toTimestamp(doc.CreatedAt),
 		UpdatedAt:      toTimestamp(doc.UpdatedAt),
 	}
@@ -336,11 +460,14 @@ func toDocumentVersion(v biz.DocumentVersion) *v1.DocumentVersion {
 		return nil
 	}
 	return &v1.DocumentVersion{
-		Id:         v.ID,
-		TenantId:   v.TenantID,
-		DocumentId: v.DocumentID,
-		Version:    v.Version,
-		Status:     v.Status,
-		CreatedAt:  toTimestamp(v.CreatedAt),
+		Id:             v.ID,
+		TenantId:       v.TenantID,
+		DocumentId:     v.DocumentID,
+		Version:        v.Version,
+		Status:         v.Status,
+		CreatedAt:      toTimestamp(v.CreatedAt),
+		ReusedChunks:   v.ReusedChunks,
+		EmbeddedChunks: v.EmbeddedChunks,
+		SkippedChunks:  v.SkippedChunks,
 	}
 }
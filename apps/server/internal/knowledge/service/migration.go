@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+)
+
+func (s *KnowledgeService) StartKnowledgeBaseMigration(ctx context.Context, req *v1.StartKnowledgeBaseMigrationRequest) (*v1.KnowledgeBaseMigrationResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireKBPermission(ctx, biz.PermissionKnowledgeBaseWrite, req.GetKbId()); err != nil {
+		return nil, err
+	}
+	m, err := s.uc.StartKnowledgeBaseMigration(ctx, req.GetKbId(), fromChunkingConfig(req.GetChunking()), fromEmbeddingConfig(req.GetEmbedding()))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.KnowledgeBaseMigrationResponse{Migration: toKnowledgeBaseMigration(m)}, nil
+}
+
+func (s *KnowledgeService) GetKnowledgeBaseMigration(ctx context.Context, req *v1.GetKnowledgeBaseMigrationRequest) (*v1.KnowledgeBaseMigrationResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireKBPermission(ctx, biz.PermissionKnowledgeBaseRead, req.GetKbId()); err != nil {
+		return nil, err
+	}
+	m, err := s.uc.GetKnowledgeBaseMigration(ctx, req.GetKbId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.KnowledgeBaseMigrationResponse{Migration: toKnowledgeBaseMigration(m)}, nil
+}
+
+func (s *KnowledgeService) ListKnowledgeBaseMigrations(ctx context.Context, req *v1.ListKnowledgeBaseMigrationsRequest) (*v1.ListKnowledgeBaseMigrationsResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireKBPermission(ctx, biz.PermissionKnowledgeBaseRead, req.GetKbId()); err != nil {
+		return nil, err
+	}
+	items, err := s.uc.ListKnowledgeBaseMigrations(ctx, req.GetKbId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListKnowledgeBaseMigrationsResponse{Items: make([]*v1.KnowledgeBaseMigration, 0, len(items))}
+	for _, item := range items {
+		resp.Items = append(resp.Items, toKnowledgeBaseMigration(item))
+	}
+	return resp, nil
+}
+
+func (s *KnowledgeService) CancelKnowledgeBaseMigration(ctx context.Context, req *v1.CancelKnowledgeBaseMigrationRequest) (*v1.KnowledgeBaseMigrationResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireKBPermission(ctx, biz.PermissionKnowledgeBaseWrite, req.GetKbId()); err != nil {
+		return nil, err
+	}
+	m, err := s.uc.CancelKnowledgeBaseMigration(ctx, req.GetKbId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.KnowledgeBaseMigrationResponse{Migration: toKnowledgeBaseMigration(m)}, nil
+}
+
+func toKnowledgeBaseMigration(m biz.KBMigration) *v1.KnowledgeBaseMigration {
+	if m.ID == "" {
+		return nil
+	}
+	out := &v1.KnowledgeBaseMigration{
+		Id:                 m.ID,
+		TenantId:           m.TenantID,
+		KbId:               m.KBID,
+		Status:             m.Status,
+		Generation:         m.Generation,
+		Chunking:           toChunkingConfig(m.Chunking),
+		Embedding:          toEmbeddingConfig(m.Embedding),
+		TotalDocuments:     m.TotalDocuments,
+		ProcessedDocuments: m.ProcessedDocuments,
+		FailedDocuments:    m.FailedDocuments,
+		ErrorMessage:       m.ErrorReason,
+		CreatedAt:          toTimestamp(m.CreatedAt),
+		UpdatedAt:          toTimestamp(m.UpdatedAt),
+	}
+	if m.FinishedAt != nil {
+		out.FinishedAt = toTimestamp(*m.FinishedAt)
+	}
+	return out
+}
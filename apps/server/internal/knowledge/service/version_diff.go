@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	v1 "github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1"
+	biz "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/biz"
+)
+
+func (s *KnowledgeService) DiffDocumentVersions(ctx context.Context, req *v1.DiffDocumentVersionsRequest) (*v1.DocumentVersionDiffResponse, error) {
+	if err := requireTenantContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.requireDocumentPermission(ctx, biz.PermissionDocumentRead, req.GetId()); err != nil {
+		return nil, err
+	}
+	diff, err := s.uc.DiffDocumentVersions(ctx, req.GetId(), req.GetFromVersion(), req.GetToVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DocumentVersionDiffResponse{Diff: toDocumentVersionDiff(diff)}, nil
+}
+
+func toDocumentVersionDiff(d biz.DocumentVersionDiff) *v1.DocumentVersionDiff {
+	out := &v1.DocumentVersionDiff{
+		DocumentId:        d.DocumentID,
+		From:              toDocumentVersionDiffSide(d.From),
+		To:                toDocumentVersionDiffSide(d.To),
+		Added:             make([]*v1.DiffChunk, 0, len(d.Added)),
+		Removed:           make([]*v1.DiffChunk, 0, len(d.Removed)),
+		Modified:          make([]*v1.ChunkChange, 0, len(d.Modified)),
+		UnchangedChunks:   d.UnchangedChunks,
+		TextDiff:          d.TextDiff,
+		TextDiffTruncated: d.TextDiffTruncated,
+	}
+	for _, ch := range d.Added {
+		out.Added = append(out.Added, toDiffChunk(ch))
+	}
+	for _, ch := range d.Removed {
+		out.Removed = append(out.Removed, toDiffChunk(ch))
+	}
+	for _, change := range d.Modified {
+		out.Modified = append(out.Modified, &v1.ChunkChange{From: toDiffChunk(change.From), To: toDiffChunk(change.To)})
+	}
+	return out
+}
+
+func toDocumentVersionDiffSide(side biz.DocumentVersionDiffSide) *v1.DocumentVersionDiffSide {
+	out := &v1.DocumentVersionDiffSide{
+		Version:       toDocumentVersion(side.Version),
+		ChunksRebuilt: side.ChunksRebuilt,
+		Citations:     make([]*v1.ChunkCitation, 0, len(side.Citations)),
+	}
+	for _, c := range side.Citations {
+		out.Citations = append(out.Citations, &v1.ChunkCitation{
+			ChunkId:     c.ChunkID,
+			ChunkIndex:  c.ChunkIndex,
+			ContentHash: c.ContentHash,
+			Citations:   c.Citations,
+			LastCitedAt: toTimestamp(c.LastCitedAt),
+			Snippet:     c.Snippet,
+		})
+	}
+	return out
+}
+
+func toDiffChunk(ch biz.DiffChunk) *v1.DiffChunk {
+	return &v1.DiffChunk{
+		Id:          ch.ID,
+		ChunkIndex:  ch.ChunkIndex,
+		Content:     ch.Content,
+		ContentHash: ch.ContentHash,
+		Section:     ch.Section,
+		PageNo:      ch.PageNo,
+		TokenCount:  ch.TokenCount,
+		Citations:   ch.Citations,
+	}
+}
@@ -0,0 +1,241 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	auditbiz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	kmmiddleware "github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	maxAuditValueLength   = 256
+	maxAuditSummaryLength = 4096
+)
+
+// AuditRecorder stores audit entries.
+type AuditRecorder interface {
+	RecordError(ctx context.Context, entry auditbiz.AuditLog, err error)
+}
+
+// readOnlyVerbs prefix the RPCs that do not change state and are not audited.
+var readOnlyVerbs = []string{"Get", "List", "Search", "Diff", "Export"}
+
+// sensitiveKeyParts mark request fields whose string values are redacted.
+var sensitiveKeyParts = []string{"password", "secret", "token", "credential", "private_key", "api_key", "recovery_code"}
+
+// AuditMiddleware records every console and platform mutation made by an
+// authenticated caller, whether it succeeds or fails, and every request made
+// while impersonating a tenant. It must run after AuthMiddleware.
+func AuditMiddleware(recorder AuditRecorder) kmmiddleware.Middleware {
+	return func(next kmmiddleware.Handler) kmmiddleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || recorder == nil {
+				return next(ctx, req)
+			}
+			service, method := splitOperation(tr.Operation())
+			claims, _ := jwt.ClaimsFromContext(ctx)
+			if !isAuditedOperation(service, method) && !(claims.Impersonated() && method != "") {
+				return next(ctx, req)
+			}
+			reply, err := next(ctx, req)
+			entry := auditbiz.AuditLog{
+				Operation:    service + "/" + method,
+				ResourceType: resourceType(method),
+			}
+			if msg, ok := req.(proto.Message); ok {
+				entry.ResourceID = requestResourceID(msg, entry.ResourceType)
+				entry.TenantID = stringField(msg.ProtoReflect(), "tenant_id")
+				entry.RequestSummary = auditSummary(msg)
+			}
+			if entry.ResourceID == "" && err == nil {
+				if msg, ok := reply.(proto.Message); ok {
+					entry.ResourceID = replyResourceID(msg)
+				}
+			}
+			if entry.TenantID == "" && entry.ResourceType == "tenant" {
+				entry.TenantID = entry.ResourceID
+			}
+			recorder.RecordError(ctx, entry, err)
+			return reply, err
+		}
+	}
+}
+
+// auditSummary renders a request as JSON with secrets redacted and long
+// values truncated.
+func auditSummary(msg proto.Message) string {
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return ""
+	}
+	raw, err = json.Marshal(redactAuditValue("", value))
+	if err != nil {
+		return ""
+	}
+	summary := string(raw)
+	if len(summary) > maxAuditSummaryLength {
+		summary = summary[:maxAuditSummaryLength] + "...(truncated)"
+	}
+	return summary
+}
+
+func redactAuditValue(key string, value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, item := range v {
+			v[k] = redactAuditValue(k, item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactAuditValue(key, item)
+		}
+		return v
+	case string:
+		if isSensitiveKey(key) {
+			return "[REDACTED]"
+		}
+		if len(v) > maxAuditValueLength {
+			return v[:maxAuditValueLength] + "..."
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if strings.HasSuffix(key, "_id") || strings.HasSuffix(key, "_ids") {
+		return false
+	}
+	if key == "code" || key == "key" {
+		return true
+	}
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+func isAuditedOperation(service string, method string) bool {
+	if !strings.HasPrefix(service, "Console") && !strings.HasPrefix(service, "Platform") {
+		return false
+	}
+	switch service {
+	case "ConsoleAuth", "PlatformAuth", "ConsoleAudit", "PlatformAudit":
+		return false
+	}
+	for _, verb := range readOnlyVerbs {
+		if strings.HasPrefix(method, verb) {
+			return false
+		}
+	}
+	return method != ""
+}
+
+// splitOperation turns "/api.iam.v1.ConsoleIAM/CreateUser" into
+// ("ConsoleIAM", "CreateUser").
+func splitOperation(operation string) (string, string) {
+	operation = strings.TrimPrefix(operation, "/")
+	idx := strings.LastIndex(operation, "/")
+	if idx < 0 {
+		return "", ""
+	}
+	service := operation[:idx]
+	if dot := strings.LastIndex(service, "."); dot >= 0 {
+		service = service[dot+1:]
+	}
+	return service, operation[idx+1:]
+}
+
+// resourceType drops the leading verb of a method and snake-cases the rest,
+// e.g. "RotateAPIKey" becomes "api_key".
+func resourceType(method string) string {
+	runes := []rune(method)
+	start := len(runes)
+	for i := 1; i < len(runes); i++ {
+		if unicode.IsUpper(runes[i]) {
+			start = i
+			break
+		}
+	}
+	runes = runes[start:]
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// requestResourceID prefers "<resource>_id", then "id", then the first other
+// *_id field of the request.
+func requestResourceID(msg proto.Message, resource string) string {
+	m := msg.ProtoReflect()
+	if id := stringField(m, resource+"_id"); id != "" {
+		return id
+	}
+	if id := stringField(m, "id"); id != "" {
+		return id
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		name := string(fields.Get(i).Name())
+		if name == "tenant_id" || !strings.HasSuffix(name, "_id") {
+			continue
+		}
+		if id := stringField(m, name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// replyResourceID finds the id of a created resource, either on the reply
+// itself or on its first message field carrying one.
+func replyResourceID(msg proto.Message) string {
+	m := msg.ProtoReflect()
+	if id := stringField(m, "id"); id != "" {
+		return id
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !m.Has(fd) {
+			continue
+		}
+		if id := stringField(m.Get(fd).Message(), "id"); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func stringField(m protoreflect.Message, name string) string {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() || fd.IsMap() {
+		return ""
+	}
+	return strings.TrimSpace(m.Get(fd).String())
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"testing"
+
+	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestCheckImpersonation(t *testing.T) {
+	tests := []struct {
+		name       string
+		operation  string
+		readOnly   bool
+		wantReason string
+	}{
+		{name: "console write", operation: "/api.knowledge.v1.ConsoleKnowledge/CreateKnowledgeBase"},
+		{name: "platform api", operation: "/api.platform.v1.PlatformTenant/ListTenants", wantReason: "IMPERSONATION_FORBIDDEN"},
+		{name: "mfa factors", operation: "/api.auth.v1.ConsoleMFA/EnrollTOTP", wantReason: "IMPERSONATION_FORBIDDEN"},
+		{name: "read-only read", operation: "/api.knowledge.v1.ConsoleKnowledge/ListDocuments", readOnly: true},
+		{name: "read-only sign out", operation: "/api.auth.v1.ConsoleAuth/Logout", readOnly: true},
+		{name: "read-only write", operation: "/api.knowledge.v1.ConsoleKnowledge/DeleteDocument", readOnly: true, wantReason: "IMPERSONATION_READ_ONLY"},
+		// Raw HTTP routes name their operation without a package.
+		{name: "raw upload", operation: "ConsoleKnowledge/UploadDocumentFile"},
+		{name: "read-only raw upload", operation: "ConsoleKnowledge/UploadDocumentFile", readOnly: true, wantReason: "IMPERSONATION_READ_ONLY"},
+		{name: "read-only raw import", operation: "ConsoleKnowledge/ImportKnowledgeBase", readOnly: true, wantReason: "IMPERSONATION_READ_ONLY"},
+		{name: "read-only raw export", operation: "ConsoleKnowledge/ExportKnowledgeBase", readOnly: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := &jwt.Claims{TenantID: "tenant-1", ReadOnly: tt.readOnly, Actor: &jwt.Actor{Subject: "admin-1"}}
+			err := CheckImpersonation(tt.operation, claims)
+			if reason := errors.Reason(err); reason != tt.wantReason {
+				t.Fatalf("err = %v, want reason %q", err, tt.wantReason)
+			}
+		})
+	}
+}
@@ -3,18 +3,27 @@ package middleware
 import (
 	"context"
 	"strings"
-	"time"
 
 	jwt "github.com/ZTH7/RagoDesk/apps/server/internal/kit/jwt"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
-	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
 	"github.com/go-kratos/kratos/v2/errors"
 	kmmiddleware "github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
 )
 
+// TokenVerifier verifies access tokens, selecting the key by kid, and
+// rejects tokens whose session was revoked.
+type TokenVerifier interface {
+	VerifyToken(ctx context.Context, token string) (*jwt.Claims, error)
+}
+
+// TenantGate rejects tenants that are suspended or being deleted.
+type TenantGate interface {
+	RequireTenantActive(ctx context.Context, tenantID string) error
+}
+
 // AuthMiddleware enforces JWT auth for protected console/platform operations.
-func AuthMiddleware(cfg *conf.Server_Auth) kmmiddleware.Middleware {
+func AuthMiddleware(tokens TokenVerifier, tenants TenantGate) kmmiddleware.Middleware {
 	return func(next kmmiddleware.Handler) kmmiddleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			tr, ok := transport.FromServerContext(ctx)
@@ -24,7 +33,7 @@ func AuthMiddleware(cfg *conf.Server_Auth) kmmiddleware.Middleware {
 			if !isAdminOperation(tr.Operation()) {
 				return next(ctx, req)
 			}
-			if cfg == nil || cfg.JwtSecret == "" {
+			if tokens == nil {
 				return nil, errors.Unauthorized("ADMIN_UNAUTHORIZED", "jwt config missing")
 			}
 			header := strings.TrimSpace(tr.RequestHeader().Get("Authorization"))
@@ -35,12 +44,23 @@ func AuthMiddleware(cfg *conf.Server_Auth) kmmiddleware.Middleware {
 			if token == "" {
 				return nil, errors.Unauthorized("ADMIN_UNAUTHORIZED", "missing authorization")
 			}
-			claims, err := jwt.ParseHS256(token, cfg.JwtSecret, cfg.Issuer, cfg.Audience, time.Now())
+			claims, err := tokens.VerifyToken(ctx, token)
 			if err != nil {
-				return nil, errors.Unauthorized("ADMIN_UNAUTHORIZED", err.Error())
+				return nil, err
+			}
+			if claims.Impersonated() {
+				if err := CheckImpersonation(tr.Operation(), claims); err != nil {
+					return nil, err
+				}
 			}
 			ctx = jwt.WithClaims(ctx, claims)
 			if claims.TenantID != "" {
+				// Signing out stays possible while the tenant is suspended.
+				if tenants != nil && !isAuthOperation(tr.Operation()) {
+					if err := tenants.RequireTenantActive(ctx, claims.TenantID); err != nil {
+						return nil, err
+					}
+				}
 				ctx = tenant.WithTenantID(ctx, claims.TenantID)
 			}
 			return next(ctx, req)
@@ -78,15 +98,29 @@ func ErrorMiddleware() kmmiddleware.Middleware {
 	}
 }
 
+func isAuthOperation(operation string) bool {
+	return strings.Contains(operation, "ConsoleAuth") || strings.Contains(operation, "PlatformAuth")
+}
+
 func isAdminOperation(operation string) bool {
-	if strings.Contains(operation, "ConsoleAuth") || strings.Contains(operation, "PlatformAuth") {
-		return false
+	if isAuthOperation(operation) {
+		// Logging out needs the session of the caller; login and refresh do not.
+		return strings.HasSuffix(operation, "/Logout") || strings.HasSuffix(operation, "/LogoutAll")
 	}
 	return strings.Contains(operation, "PlatformIAM") ||
 		strings.Contains(operation, "ConsoleIAM") ||
+		strings.Contains(operation, "ConsoleSSO") ||
+		strings.Contains(operation, "ConsoleMFA") ||
+		strings.Contains(operation, "PlatformMFA") ||
 		strings.Contains(operation, "ConsoleKnowledge") ||
+		strings.Contains(operation, "PlatformKnowledge") ||
 		strings.Contains(operation, "ConsoleBot") ||
 		strings.Contains(operation, "ConsoleConversation") ||
 		strings.Contains(operation, "ConsoleAPIMgmt") ||
-		strings.Contains(operation, "ConsoleAnalytics")
+		strings.Contains(operation, "ConsoleAnalytics") ||
+		strings.Contains(operation, "ConsoleAudit") ||
+		strings.Contains(operation, "PlatformAudit") ||
+		strings.Contains(operation, "PlatformImpersonation") ||
+		strings.Contains(operation, "ConsoleImpersonation") ||
+		strings.Contains(operation, "ConsoleCredential")
 }
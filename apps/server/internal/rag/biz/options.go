@@ -27,6 +27,8 @@ const (
 	defaultOutboundProxy       = "http://127.0.0.1:10808"
 	defaultSystemPrompt        = "You are a helpful assistant. Answer using the provided context. If the context is insufficient, say you don't know."
 	defaultRefusalMessage      = "I don't have enough information to answer that based on the provided knowledge."
+	// replyLanguageAuto answers in the detected language of the question.
+	replyLanguageAuto = "auto"
 )
 
 type ragOptions struct {
@@ -45,6 +47,7 @@ type ragOptions struct {
 	llmMaxTokens        int
 	systemPrompt        string
 	refusalMessage      string
+	replyLanguage       string
 	embeddingConfig     provider.Config
 	proxy               string
 }
@@ -131,6 +134,7 @@ func loadRAGOptions(cfg *conf.Data) ragOptions {
 				if strings.TrimSpace(llm.RefusalMessage) != "" {
 					opts.refusalMessage = llm.RefusalMessage
 				}
+				opts.replyLanguage = llm.ReplyLanguage
 			}
 		}
 		if knowledge := cfg.Knowledge; knowledge != nil {
@@ -173,6 +177,7 @@ func loadRAGOptions(cfg *conf.Data) ragOptions {
 	opts.systemPrompt = envString("RAGODESK_RAG_SYSTEM_PROMPT", opts.systemPrompt)
 	opts.refusalMessage = envString("RAGODESK_RAG_REFUSAL_MESSAGE", opts.refusalMessage)
 	opts.rerankWeight = envFloat32("RAGODESK_RAG_RERANK_WEIGHT", opts.rerankWeight)
+	opts.replyLanguage = strings.ToLower(envString("RAGODESK_RAG_REPLY_LANGUAGE", strings.TrimSpace(opts.replyLanguage)))
 
 	opts.embeddingConfig.Provider = envString("RAGODESK_EMBEDDING_PROVIDER", opts.embeddingConfig.Provider)
 	opts.embeddingConfig.Endpoint = envString("RAGODESK_EMBEDDING_ENDPOINT", opts.embeddingConfig.Endpoint)
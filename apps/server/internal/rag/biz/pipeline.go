@@ -12,10 +12,11 @@ type ragContext struct {
 	topK         int
 	threshold    float32
 	kbs          []BotKnowledgeBase
-	queryVector  []float32
-	queryVectors [][]float32
+	queryVectors map[string][][]float32 // keyed by embeddingKey
 	queryWeights []float32
 	normalized   string
+	// language is the detected language code of the question.
+	language     string
 	queries      []string
 	ranked       []scoredChunk
 	chunks       map[string]ChunkMeta
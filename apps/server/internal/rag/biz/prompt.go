@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 	"unicode"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/lang"
 )
 
 const (
@@ -14,7 +16,9 @@ const (
 	maxBlocksPerDoc  = 3
 )
 
-func buildPrompt(question string, ranked []scoredChunk, chunks map[string]ChunkMeta) string {
+// buildPrompt assembles the LLM prompt; replyLanguage, when set, is the
+// language code the answer must be written in.
+func buildPrompt(question string, ranked []scoredChunk, chunks map[string]ChunkMeta, replyLanguage string) string {
 	var builder strings.Builder
 	builder.WriteString("Use the context to answer the question. If the context does not contain the answer, say you don't know.\n\n")
 	builder.WriteString("Context:\n")
@@ -26,6 +30,9 @@ func buildPrompt(question string, ranked []scoredChunk, chunks map[string]ChunkM
 	if len(selected) == 0 {
 		builder.WriteString("(no context available)\n")
 	}
+	if replyLanguage != "" {
+		fmt.Fprintf(&builder, "\nAnswer in %s, even if the context is in another language.\n", lang.Name(replyLanguage))
+	}
 	builder.WriteString("\nQuestion: ")
 	builder.WriteString(strings.TrimSpace(question))
 	builder.WriteString("\nAnswer:")
@@ -2,10 +2,13 @@ package biz
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
 	"github.com/cloudwego/eino/compose"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -19,6 +22,9 @@ type MessageRequest struct {
 	Message   string
 	TopK      int32
 	Threshold float32
+	// Entitlements are the verified access labels of the end user; only
+	// chunks of unlabeled documents or documents sharing a label are used.
+	Entitlements []string
 }
 
 // MessageResponse represents a RAG answer.
@@ -33,8 +39,23 @@ type MessageResponse struct {
 
 // BotKnowledgeBase describes bot knowledge base binding.
 type BotKnowledgeBase struct {
-	KBID   string
-	Weight float64
+	KBID            string
+	Weight          float64
+	Embedding       EmbeddingConfig
+	IndexGeneration int32
+}
+
+// EmbeddingConfig is the embedding model of a knowledge base; the zero value
+// means the deployment default.
+type EmbeddingConfig struct {
+	Provider string `json:"provider,omitempty"`
+	Model    string `json:"model,omitempty"`
+	Dim      int32  `json:"dim,omitempty"`
+}
+
+// IsZero reports whether the knowledge base uses the default embedding model.
+func (c EmbeddingConfig) IsZero() bool {
+	return strings.TrimSpace(c.Provider) == "" && strings.TrimSpace(c.Model) == "" && c.Dim == 0
 }
 
 // VectorSearchRequest describes a vector search input.
@@ -43,6 +64,11 @@ type VectorSearchRequest struct {
 	KBID           string
 	TopK           int
 	ScoreThreshold float32
+	// Collection is the collection key of the embedding model; empty means
+	// the default collection.
+	Collection string
+	// Entitlements limit results to chunks whose access labels allow them.
+	Entitlements []string
 }
 
 // VectorSearchResult describes a vector search output.
@@ -52,6 +78,8 @@ type VectorSearchResult struct {
 	DocumentVersionID string
 	KBID              string
 	Score             float32
+	// Confidence is the OCR confidence of the chunk (1 for native text).
+	Confidence float32
 }
 
 // ChunkMeta contains chunk content and metadata.
@@ -64,6 +92,10 @@ type ChunkMeta struct {
 	Section           string
 	PageNo            int32
 	SourceURI         string
+	// Language is the detected language code of the chunk; empty if unknown.
+	Language string
+	// Keywords are the keywords pinned to the chunk by an editor.
+	Keywords []string
 }
 
 // BotKBResolver resolves bot knowledge base bindings.
@@ -78,7 +110,14 @@ type VectorSearcher interface {
 
 // ChunkLoader loads chunk metadata.
 type ChunkLoader interface {
-	LoadChunks(ctx context.Context, chunkIDs []string) (map[string]ChunkMeta, error)
+	// LoadChunks returns the chunks whose document the entitlements may see;
+	// withheld and missing chunks are absent from the map.
+	LoadChunks(ctx context.Context, chunkIDs []string, entitlements []string) (map[string]ChunkMeta, error)
+}
+
+// CredentialRepo loads the provider credentials tenants brought.
+type CredentialRepo interface {
+	GetProviderCredential(ctx context.Context, tenantID string, providerName string) (provider.Credential, bool, error)
 }
 
 // RAGUsecase handles rag business logic.
@@ -89,31 +128,38 @@ type RAGUsecase struct {
 	log        *log.Helper
 	pipeline   compose.Runnable[MessageRequest, MessageResponse]
 
-	embedder provider.Provider
-	llm      provider.LLMProvider
-	opts     ragOptions
+	embedder    provider.Provider
+	embedders   map[string]provider.Provider
+	embeddersMu sync.Mutex
+	llm         provider.LLMProvider
+	llmConfig   provider.LLMConfig
+	// tenantClients hold the providers of tenants using their own keys.
+	tenantClients *provider.TenantClients
+	opts          ragOptions
 }
 
 // NewRAGUsecase creates a new RAGUsecase.
-func NewRAGUsecase(kbRepo BotKBResolver, vectorRepo VectorSearcher, chunkRepo ChunkLoader, cfg *conf.Data, logger log.Logger) (*RAGUsecase, error) {
+func NewRAGUsecase(kbRepo BotKBResolver, vectorRepo VectorSearcher, chunkRepo ChunkLoader, credRepo CredentialRepo, cfg *conf.Data, logger log.Logger) (*RAGUsecase, error) {
 	opts := loadRAGOptions(cfg)
 	embedder := provider.NewProvider(opts.embeddingConfig)
-	llm := provider.NewLLMProvider(provider.LLMConfig{
+	llmConfig := provider.LLMConfig{
 		Provider:  opts.llmProvider,
 		Endpoint:  opts.llmEndpoint,
 		APIKey:    opts.llmAPIKey,
 		Model:     opts.llmModel,
 		TimeoutMs: opts.llmTimeoutMs,
 		Proxy:     opts.proxy,
-	})
+	}
 	uc := &RAGUsecase{
-		kbRepo:     kbRepo,
-		vectorRepo: vectorRepo,
-		chunkRepo:  chunkRepo,
-		log:        log.NewHelper(logger),
-		embedder:   embedder,
-		llm:        llm,
-		opts:       opts,
+		kbRepo:        kbRepo,
+		vectorRepo:    vectorRepo,
+		chunkRepo:     chunkRepo,
+		log:           log.NewHelper(logger),
+		embedder:      embedder,
+		llm:           provider.NewLLMProvider(llmConfig),
+		llmConfig:     llmConfig,
+		tenantClients: provider.NewTenantClients(credRepo),
+		opts:          opts,
 	}
 	pipeline, err := uc.buildPipeline()
 	if err != nil {
@@ -123,6 +169,88 @@ func NewRAGUsecase(kbRepo BotKBResolver, vectorRepo VectorSearcher, chunkRepo Ch
 	return uc, nil
 }
 
+// embeddingKey identifies the embedder of a knowledge base; empty for the default.
+func embeddingKey(cfg EmbeddingConfig) string {
+	if cfg.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%s|%s|%d", strings.ToLower(strings.TrimSpace(cfg.Provider)), strings.TrimSpace(cfg.Model), cfg.Dim)
+}
+
+// embeddingConfigFor returns the deployment embedding config with a
+// knowledge base embedding model applied.
+func (uc *RAGUsecase) embeddingConfigFor(cfg EmbeddingConfig) provider.Config {
+	embeddingCfg := uc.opts.embeddingConfig
+	if cfg.IsZero() {
+		return embeddingCfg
+	}
+	if p := strings.TrimSpace(cfg.Provider); p != "" {
+		embeddingCfg.Provider = strings.ToLower(p)
+	}
+	embeddingCfg.Model = strings.TrimSpace(cfg.Model)
+	embeddingCfg.Dim = int(cfg.Dim)
+	return embeddingCfg
+}
+
+// embedderFor returns the query embedder matching a knowledge base embedding
+// model. Endpoint, API key and timeout come from the deployment config.
+func (uc *RAGUsecase) embedderFor(cfg EmbeddingConfig) provider.Provider {
+	key := embeddingKey(cfg)
+	if key == "" {
+		return uc.embedder
+	}
+	uc.embeddersMu.Lock()
+	defer uc.embeddersMu.Unlock()
+	if uc.embedders == nil {
+		uc.embedders = make(map[string]provider.Provider)
+	}
+	if cached, ok := uc.embedders[key]; ok {
+		return cached
+	}
+	embedder := provider.NewProvider(uc.embeddingConfigFor(cfg))
+	uc.embedders[key] = embedder
+	return embedder
+}
+
+// tenantEmbedderFor is embedderFor using the API key of the tenant in
+// context when it brought one for the provider. Collection keys keep using
+// embedderFor, which names the same model.
+func (uc *RAGUsecase) tenantEmbedderFor(ctx context.Context, cfg EmbeddingConfig) (provider.Provider, error) {
+	tenantID, _ := tenant.TenantID(ctx)
+	embedder, ok, err := uc.tenantClients.Embedder(ctx, tenantID, uc.embeddingConfigFor(cfg))
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return embedder, nil
+	}
+	return uc.embedderFor(cfg), nil
+}
+
+// llmFor returns the LLM of the tenant in context: built with its own API
+// key when it brought one for the LLM provider, the deployment one otherwise.
+func (uc *RAGUsecase) llmFor(ctx context.Context) (provider.LLMProvider, error) {
+	tenantID, _ := tenant.TenantID(ctx)
+	llm, ok, err := uc.tenantClients.LLM(ctx, tenantID, uc.llmConfig)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return llm, nil
+	}
+	return uc.llm, nil
+}
+
+// ProviderConfigs returns the deployment LLM and default embedding configs
+// without their API keys.
+func (uc *RAGUsecase) ProviderConfigs() (provider.LLMConfig, provider.Config) {
+	llm := uc.llmConfig
+	llm.APIKey = ""
+	embedding := uc.opts.embeddingConfig
+	embedding.APIKey = ""
+	return llm, embedding
+}
+
 // SendMessage handles a RAG request and returns the response.
 func (uc *RAGUsecase) SendMessage(ctx context.Context, req MessageRequest) (MessageResponse, error) {
 	if uc == nil || uc.kbRepo == nil || uc.vectorRepo == nil || uc.chunkRepo == nil {
@@ -2,8 +2,8 @@ package biz
 
 import (
 	"sort"
-	"strings"
-	"unicode"
+
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/lang"
 )
 
 type scoredChunk struct {
@@ -98,28 +98,24 @@ func overlapScore(question string, content string) float32 {
 	return float32(matched) / float32(len(qTokens))
 }
 
+// tokenSet collects the lexical tokens of text; Chinese and Japanese are
+// split into character bigrams so overlap works without word boundaries.
 func tokenSet(text string, limit int) map[string]struct{} {
 	if limit <= 0 {
 		limit = 64
 	}
-	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
-		return !(unicode.IsLetter(r) || unicode.IsNumber(r))
-	})
 	out := make(map[string]struct{})
-	count := 0
-	for _, token := range fields {
-		if len(token) < 2 {
-			continue
-		}
+	for _, token := range lang.Tokens(text, limit) {
 		out[token] = struct{}{}
-		count++
-		if count >= limit {
-			break
-		}
 	}
 	return out
 }
 
+// crossLingual reports a question and chunk in different known languages.
+func crossLingual(queryLanguage string, chunkLanguage string) bool {
+	return queryLanguage != "" && chunkLanguage != "" && queryLanguage != chunkLanguage
+}
+
 func maxFloat32(a float32, b float32) float32 {
 	if a >= b {
 		return a
@@ -16,20 +16,32 @@ func (uc *RAGUsecase) promptContext(ctx context.Context, rc *ragContext) (*ragCo
 	}
 	_, span := uc.startSpan(ctx, "rag.prompt")
 	defer span.End()
-	rc.prompt = buildPrompt(rc.req.Message, rc.ranked, rc.chunks)
+	rc.prompt = buildPrompt(rc.req.Message, rc.ranked, rc.chunks, uc.replyLanguage(rc))
 	return rc, nil
 }
 
+// replyLanguage resolves the configured reply language for a question.
+func (uc *RAGUsecase) replyLanguage(rc *ragContext) string {
+	if uc.opts.replyLanguage == replyLanguageAuto {
+		return rc.language
+	}
+	return uc.opts.replyLanguage
+}
+
 func (uc *RAGUsecase) llmContext(ctx context.Context, rc *ragContext) (*ragContext, error) {
 	if rc == nil || rc.shouldRefuse {
 		return rc, nil
 	}
-	ctx, span := uc.startSpan(ctx, "rag.llm", attribute.String("rag.llm_model", uc.llm.Model()))
+	llm, err := uc.llmFor(ctx)
+	if err != nil {
+		return rc, err
+	}
+	ctx, span := uc.startSpan(ctx, "rag.llm", attribute.String("rag.llm_model", llm.Model()))
 	defer span.End()
 	llmCtx, cancel := withTimeout(ctx, uc.opts.llmTimeoutMs)
 	defer cancel()
 	start := time.Now()
-	resp, err := uc.llm.Generate(llmCtx, provider.LLMRequest{
+	resp, err := llm.Generate(llmCtx, provider.LLMRequest{
 		System:      uc.opts.systemPrompt,
 		Prompt:      rc.prompt,
 		Temperature: uc.opts.llmTemperature,
@@ -42,7 +54,7 @@ func (uc *RAGUsecase) llmContext(ctx context.Context, rc *ragContext) (*ragConte
 	}
 	rc.reply = strings.TrimSpace(resp.Text)
 	rc.llmUsage = resp.Usage
-	rc.llmModel = uc.llm.Model()
+	rc.llmModel = llm.Model()
 	return rc, nil
 }
 
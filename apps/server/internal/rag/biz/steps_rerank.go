@@ -16,17 +16,36 @@ func (uc *RAGUsecase) rerankContext(ctx context.Context, rc *ragContext) (*ragCo
 	if rc == nil || rc.shouldRefuse || len(rc.ranked) == 0 {
 		return rc, nil
 	}
-	ctx, span := uc.startSpan(ctx, "rag.rerank", attribute.Float64("rag.rerank_weight", float64(uc.opts.rerankWeight)))
+	ctx, span := uc.startSpan(ctx, "rag.rerank",
+		attribute.Float64("rag.rerank_weight", float64(uc.opts.rerankWeight)),
+		attribute.String("rag.query_language", rc.language),
+	)
 	defer span.End()
 	start := time.Now()
 	for i := range rc.ranked {
 		chunk := rc.ranked[i]
 		meta := rc.chunks[chunk.result.ChunkID]
+		if crossLingual(rc.language, meta.Language) {
+			// Words cannot overlap across languages; rank by the vector alone
+			// so multilingual embeddings can match translated content.
+			chunk.textScore = 0
+			chunk.score = chunk.vectorScore
+			rc.ranked[i] = chunk
+			continue
+		}
 		textScore := overlapScore(rc.normalized, meta.Content)
 		sectionScore := overlapScore(rc.normalized, meta.Section)
 		if sectionScore > 0 {
 			textScore = maxFloat32(textScore, sectionScore*1.2)
 		}
+		if len(meta.Keywords) > 0 {
+			// Pinned keywords are an editor's statement of what the chunk
+			// answers and weigh like the section.
+			keywordScore := overlapScore(rc.normalized, strings.Join(meta.Keywords, " "))
+			if keywordScore > 0 {
+				textScore = maxFloat32(textScore, keywordScore*1.2)
+			}
+		}
 		chunk.textScore = textScore
 		chunk.score = combineScores(chunk.vectorScore, textScore, uc.opts.rerankWeight)
 		rc.ranked[i] = chunk
@@ -73,11 +92,15 @@ func (uc *RAGUsecase) crossEncoderRerank(ctx context.Context, rc *ragContext) er
 	if uc.llm == nil {
 		return nil
 	}
-	model := strings.ToLower(strings.TrimSpace(uc.llm.Model()))
+	llm, err := uc.llmFor(ctx)
+	if err != nil {
+		return err
+	}
+	model := strings.ToLower(strings.TrimSpace(llm.Model()))
 	if strings.Contains(model, "template") {
 		return nil
 	}
-	ctx, span := uc.startSpan(ctx, "rag.rerank_cross", attribute.String("rag.llm_model", uc.llm.Model()))
+	ctx, span := uc.startSpan(ctx, "rag.rerank_cross", attribute.String("rag.llm_model", llm.Model()))
 	defer span.End()
 
 	n := crossEncoderTopN
@@ -110,7 +133,7 @@ func (uc *RAGUsecase) crossEncoderRerank(ctx context.Context, rc *ragContext) er
 	llmCtx, cancel := withTimeout(ctx, timeout)
 	defer cancel()
 	start := time.Now()
-	resp, err := uc.llm.Generate(llmCtx, provider.LLMRequest{
+	resp, err := llm.Generate(llmCtx, provider.LLMRequest{
 		System:      "You are a ranking model that orders passages by relevance to a question.",
 		Prompt:      prompt,
 		Temperature: 0,
@@ -6,6 +6,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ZTH7/RagoDesk/apps/server/internal/ai/provider"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/lang"
 	"github.com/go-kratos/kratos/v2/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
@@ -39,6 +41,7 @@ func (uc *RAGUsecase) initContext(_ context.Context, req MessageRequest) (*ragCo
 		topK:         topK,
 		threshold:    threshold,
 		normalized:   normalized,
+		language:     lang.Detect(req.Message),
 		queries:      queries,
 		queryWeights: weights,
 	}, nil
@@ -74,25 +77,36 @@ func (uc *RAGUsecase) embedContext(ctx context.Context, rc *ragContext) (*ragCon
 	embedCtx, cancel := withTimeout(ctx, uc.opts.embeddingConfig.TimeoutMs)
 	defer cancel()
 	start := time.Now()
-	vecs, err := uc.embedder.Embed(embedCtx, rc.queries)
-	uc.logStep("embed", start, err)
-	if err != nil {
-		uc.recordSpanError(span, err)
-		return rc, err
+	// Embed the queries once per distinct embedding model of the bound knowledge bases.
+	rc.queryVectors = make(map[string][][]float32)
+	for _, kb := range rc.kbs {
+		key := embeddingKey(kb.Embedding)
+		if _, ok := rc.queryVectors[key]; ok {
+			continue
+		}
+		embedder, err := uc.tenantEmbedderFor(ctx, kb.Embedding)
+		var vecs [][]float32
+		if err == nil {
+			vecs, err = embedder.Embed(embedCtx, rc.queries)
+		}
+		if err == nil && len(vecs) == 0 {
+			err = errors.InternalServer("EMBEDDING_EMPTY", "embedding empty")
+		}
+		if err == nil && len(vecs) != len(rc.queries) {
+			err = errors.InternalServer("EMBEDDING_COUNT_MISMATCH", "embedding count mismatch")
+		}
+		if err != nil {
+			uc.logStep("embed", start, err)
+			uc.recordSpanError(span, err)
+			return rc, err
+		}
+		rc.queryVectors[key] = vecs
 	}
-	if len(vecs) == 0 {
-		err := errors.InternalServer("EMBEDDING_EMPTY", "embedding empty")
-		uc.recordSpanError(span, err)
-		return rc, err
+	uc.logStep("embed", start, nil)
+	span.SetAttributes(attribute.Int("rag.embedding_models", len(rc.queryVectors)))
+	if vecs := rc.queryVectors[""]; len(vecs) > 0 {
+		span.SetAttributes(attribute.Int("rag.embedding_dim", len(vecs[0])))
 	}
-	if len(vecs) != len(rc.queries) {
-		err := errors.InternalServer("EMBEDDING_COUNT_MISMATCH", "embedding count mismatch")
-		uc.recordSpanError(span, err)
-		return rc, err
-	}
-	rc.queryVector = vecs[0]
-	rc.queryVectors = vecs
-	span.SetAttributes(attribute.Int("rag.embedding_dim", len(rc.queryVector)))
 	return rc, nil
 }
 
@@ -117,24 +131,27 @@ func (uc *RAGUsecase) retrieveContext(ctx context.Context, rc *ragContext) (*rag
 		limit = 1
 	}
 	group.SetLimit(limit)
-	for qIdx, vec := range rc.queryVectors {
-		vec := vec
-		qWeight := float32(1)
-		if qIdx < len(rc.queryWeights) {
-			qWeight = rc.queryWeights[qIdx]
+	for _, kb := range rc.kbs {
+		kb := kb
+		kbID := strings.TrimSpace(kb.KBID)
+		if kbID == "" {
+			continue
 		}
-		for _, kb := range rc.kbs {
-			kb := kb
-			kbID := strings.TrimSpace(kb.KBID)
-			if kbID == "" {
-				continue
+		for qIdx, vec := range rc.queryVectors[embeddingKey(kb.Embedding)] {
+			vec := vec
+			qWeight := float32(1)
+			if qIdx < len(rc.queryWeights) {
+				qWeight = rc.queryWeights[qIdx]
 			}
+			collection := uc.vectorCollectionKey(kb, len(vec))
 			group.Go(func() error {
 				results, err := uc.vectorRepo.Search(groupCtx, VectorSearchRequest{
 					Vector:         vec,
 					KBID:           kbID,
 					TopK:           rc.topK,
 					ScoreThreshold: minScore,
+					Collection:     collection,
+					Entitlements:   rc.req.Entitlements,
 				})
 				if err != nil {
 					mu.Lock()
@@ -154,7 +171,7 @@ func (uc *RAGUsecase) retrieveContext(ctx context.Context, rc *ragContext) (*rag
 					if minScore > 0 && item.Score < minScore {
 						continue
 					}
-					vecScore := item.Score * float32(weight) * qWeight
+					vecScore := item.Score * float32(weight) * qWeight * ocrConfidenceWeight(item.Confidence)
 					local = append(local, scoredChunk{
 						result:      item,
 						vectorScore: vecScore,
@@ -195,6 +212,26 @@ func (uc *RAGUsecase) retrieveContext(ctx context.Context, rc *ragContext) (*rag
 	return rc, nil
 }
 
+// vectorCollectionKey mirrors the ingestion side: knowledge bases on the default
+// embedding model share the default collection, others get one per (model, dim),
+// and every index generation has its own collection.
+func (uc *RAGUsecase) vectorCollectionKey(kb BotKnowledgeBase, dim int) string {
+	key := ""
+	if !kb.Embedding.IsZero() {
+		key = provider.CollectionKey(uc.embedderFor(kb.Embedding).Model(), dim)
+	}
+	return provider.GenerationCollectionKey(key, kb.IndexGeneration)
+}
+
+// ocrConfidenceWeight down-weights OCR chunks: half of the score is kept for
+// any chunk, the other half scales with the recognition confidence.
+func ocrConfidenceWeight(confidence float32) float32 {
+	if confidence <= 0 || confidence >= 1 {
+		return 1
+	}
+	return 0.5 + 0.5*confidence
+}
+
 func (uc *RAGUsecase) loadChunksContext(ctx context.Context, rc *ragContext) (*ragContext, error) {
 	if rc == nil || rc.shouldRefuse {
 		return rc, nil
@@ -217,12 +254,24 @@ func (uc *RAGUsecase) loadChunksContext(ctx context.Context, rc *ragContext) (*r
 		chunkIDs = append(chunkIDs, item.result.ChunkID)
 	}
 	start := time.Now()
-	chunks, err := uc.chunkRepo.LoadChunks(ctx, chunkIDs)
+	chunks, err := uc.chunkRepo.LoadChunks(ctx, chunkIDs, rc.req.Entitlements)
 	uc.logStep("chunks", start, err)
 	if err != nil {
 		uc.recordSpanError(span, err)
 		return rc, err
 	}
 	rc.chunks = chunks
+	// The vector payload may lag a label change; chunks the loader withheld
+	// are never ranked, cited or shown.
+	kept := rc.ranked[:0]
+	for _, item := range rc.ranked {
+		if _, ok := chunks[item.result.ChunkID]; ok {
+			kept = append(kept, item)
+		}
+	}
+	if dropped := len(rc.ranked) - len(kept); dropped > 0 {
+		span.SetAttributes(attribute.Int("rag.withheld_chunks", dropped))
+	}
+	rc.ranked = kept
 	return rc, nil
 }
@@ -29,16 +29,27 @@ type qdrantSearchRequest struct {
 }
 
 type qdrantFilter struct {
-	Must []qdrantCondition `json:"must,omitempty"`
+	Must    []qdrantCondition `json:"must,omitempty"`
+	Should  []qdrantCondition `json:"should,omitempty"`
+	MustNot []qdrantCondition `json:"must_not,omitempty"`
 }
 
+// qdrantCondition is a field condition, or a nested filter when Should is
+// set.
 type qdrantCondition struct {
-	Key   string          `json:"key"`
-	Match *qdrantMatchAny `json:"match,omitempty"`
+	Key     string            `json:"key,omitempty"`
+	Match   *qdrantMatchAny   `json:"match,omitempty"`
+	IsEmpty *qdrantField      `json:"is_empty,omitempty"`
+	Should  []qdrantCondition `json:"should,omitempty"`
 }
 
 type qdrantMatchAny struct {
-	Value any `json:"value"`
+	Value any      `json:"value,omitempty"`
+	Any   []string `json:"any,omitempty"`
+}
+
+type qdrantField struct {
+	Key string `json:"key"`
 }
 
 type qdrantSearchResponse struct {
@@ -57,6 +68,7 @@ type qdrantSearchResult struct {
 	DocumentVersionID string
 	KBID              string
 	Score             float32
+	Confidence        float32
 }
 
 func newQdrantSearchClient(endpoint string, apiKey string, timeoutMs int) *qdrantSearchClient {
@@ -74,7 +86,7 @@ func newQdrantSearchClient(endpoint string, apiKey string, timeoutMs int) *qdran
 	}
 }
 
-func (c *qdrantSearchClient) Search(ctx context.Context, collection string, vector []float32, topK int, tenantID string, kbID string, threshold float32) ([]qdrantSearchResult, error) {
+func (c *qdrantSearchClient) Search(ctx context.Context, collection string, vector []float32, topK int, tenantID string, kbID string, threshold float32, entitlements []string) ([]qdrantSearchResult, error) {
 	if c == nil || c.endpoint == "" {
 		return nil, kerrors.InternalServer("QDRANT_ENDPOINT_MISSING", "qdrant endpoint missing")
 	}
@@ -92,6 +104,11 @@ func (c *qdrantSearchClient) Search(ctx context.Context, collection string, vect
 		Must: []qdrantCondition{
 			{Key: "tenant_id", Match: &qdrantMatchAny{Value: tenantID}},
 			{Key: "kb_id", Match: &qdrantMatchAny{Value: kbID}},
+			accessCondition(entitlements),
+		},
+		// Chunks excluded by an editor stay indexed but are never retrieved.
+		MustNot: []qdrantCondition{
+			{Key: "excluded", Match: &qdrantMatchAny{Value: true}},
 		},
 	}
 	reqBody := qdrantSearchRequest{
@@ -117,6 +134,10 @@ func (c *qdrantSearchClient) Search(ctx context.Context, collection string, vect
 		return nil, err
 	}
 	defer resp.Body.Close()
+	// Collections are created on the first ingestion of their embedding model.
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body := readBodyLimit(resp.Body, 16<<10)
 		return nil, kerrors.InternalServer("QDRANT_SEARCH_FAILED", fmt.Sprintf("qdrant search failed: %s", body))
@@ -134,11 +155,23 @@ func (c *qdrantSearchClient) Search(ctx context.Context, collection string, vect
 			DocumentVersionID: payloadString(payload, "document_version_id", ""),
 			KBID:              payloadString(payload, "kb_id", ""),
 			Score:             item.Score,
+			Confidence:        payloadConfidence(payload),
 		})
 	}
 	return out, nil
 }
 
+// accessCondition matches points of unlabeled documents (including points
+// indexed before access labels existed) and of documents sharing a label
+// with entitlements.
+func accessCondition(entitlements []string) qdrantCondition {
+	should := []qdrantCondition{{IsEmpty: &qdrantField{Key: "access_labels"}}}
+	if len(entitlements) > 0 {
+		should = append(should, qdrantCondition{Key: "access_labels", Match: &qdrantMatchAny{Any: entitlements}})
+	}
+	return qdrantCondition{Should: should}
+}
+
 func (c *qdrantSearchClient) applyAuth(req *http.Request) {
 	if c.apiKey == "" {
 		return
@@ -146,6 +179,17 @@ func (c *qdrantSearchClient) applyAuth(req *http.Request) {
 	req.Header.Set("api-key", c.apiKey)
 }
 
+// payloadConfidence reads the OCR confidence of a chunk; points indexed
+// before OCR support have none and count as native text.
+func payloadConfidence(payload map[string]any) float32 {
+	if payload != nil {
+		if v, ok := payload["confidence"].(float64); ok && v > 0 {
+			return float32(v)
+		}
+	}
+	return 1
+}
+
 func payloadString(payload map[string]any, key string, fallback any) string {
 	if payload != nil {
 		if raw, ok := payload[key]; ok {
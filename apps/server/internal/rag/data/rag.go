@@ -3,10 +3,12 @@ package data
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"strings"
 
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
 	internaldata "github.com/ZTH7/RagoDesk/apps/server/internal/data"
+	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/access"
 	biz "github.com/ZTH7/RagoDesk/apps/server/internal/rag/biz"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/kit/tenant"
 	kerrors "github.com/go-kratos/kratos/v2/errors"
@@ -74,8 +76,10 @@ func (r *kbRepo) ResolveBotKnowledgeBases(ctx context.Context, botID string) ([]
 	}
 	rows, err := r.db.QueryContext(
 		ctx,
-		`SELECT kb_id, weight
-		FROM bot_kb WHERE tenant_id = ? AND bot_id = ? ORDER BY created_at DESC`,
+		`SELECT b.kb_id, b.weight, k.embedding_config, COALESCE(k.index_generation, 0)
+		FROM bot_kb b
+		LEFT JOIN knowledge_base k ON k.tenant_id = b.tenant_id AND k.id = b.kb_id
+		WHERE b.tenant_id = ? AND b.bot_id = ? ORDER BY b.created_at DESC`,
 		tenantID,
 		botID,
 	)
@@ -87,9 +91,13 @@ func (r *kbRepo) ResolveBotKnowledgeBases(ctx context.Context, botID string) ([]
 	items := make([]biz.BotKnowledgeBase, 0)
 	for rows.Next() {
 		var item biz.BotKnowledgeBase
-		if err := rows.Scan(&item.KBID, &item.Weight); err != nil {
+		var embeddingRaw sql.NullString
+		if err := rows.Scan(&item.KBID, &item.Weight, &embeddingRaw, &item.IndexGeneration); err != nil {
 			return nil, err
 		}
+		if embeddingRaw.Valid && strings.TrimSpace(embeddingRaw.String) != "" {
+			_ = json.Unmarshal([]byte(embeddingRaw.String), &item.Embedding)
+		}
 		items = append(items, item)
 	}
 	if err := rows.Err(); err != nil {
@@ -110,7 +118,11 @@ func (r *vectorRepo) Search(ctx context.Context, req biz.VectorSearchRequest) ([
 	if kbID == "" {
 		return nil, nil
 	}
-	points, err := r.vector.Search(ctx, r.collection, req.Vector, req.TopK, tenantID, kbID, req.ScoreThreshold)
+	collection := r.collection
+	if key := strings.TrimSpace(req.Collection); key != "" {
+		collection = r.collection + "_" + key
+	}
+	points, err := r.vector.Search(ctx, collection, req.Vector, req.TopK, tenantID, kbID, req.ScoreThreshold, req.Entitlements)
 	if err != nil {
 		return nil, err
 	}
@@ -122,12 +134,13 @@ func (r *vectorRepo) Search(ctx context.Context, req biz.VectorSearchRequest) ([
 			DocumentVersionID: p.DocumentVersionID,
 			KBID:              p.KBID,
 			Score:             p.Score,
+			Confidence:        p.Confidence,
 		})
 	}
 	return out, nil
 }
 
-func (r *chunkRepo) LoadChunks(ctx context.Context, chunkIDs []string) (map[string]biz.ChunkMeta, error) {
+func (r *chunkRepo) LoadChunks(ctx context.Context, chunkIDs []string, entitlements []string) (map[string]biz.ChunkMeta, error) {
 	tenantID, err := tenant.RequireTenantID(ctx)
 	if err != nil {
 		return nil, err
@@ -149,6 +162,7 @@ func (r *chunkRepo) LoadChunks(ctx context.Context, chunkIDs []string) (map[stri
 		}
 		for rows.Next() {
 			var meta biz.ChunkMeta
+			var labelsRaw, keywordsRaw sql.NullString
 			if err := rows.Scan(
 				&meta.ChunkID,
 				&meta.KBID,
@@ -158,10 +172,21 @@ func (r *chunkRepo) LoadChunks(ctx context.Context, chunkIDs []string) (map[stri
 				&meta.Section,
 				&meta.PageNo,
 				&meta.SourceURI,
+				&meta.Language,
+				&keywordsRaw,
+				&labelsRaw,
 			); err != nil {
 				rows.Close()
 				return nil, err
 			}
+			// The document row is authoritative for access labels; the
+			// vector payload only narrows the search.
+			if !access.Allowed(decodeAccessLabels(labelsRaw), entitlements) {
+				continue
+			}
+			if keywordsRaw.Valid && strings.TrimSpace(keywordsRaw.String) != "" {
+				meta.Keywords = strings.Split(keywordsRaw.String, "\n")
+			}
 			out[meta.ChunkID] = meta
 		}
 		if err := rows.Err(); err != nil {
@@ -174,7 +199,7 @@ func (r *chunkRepo) LoadChunks(ctx context.Context, chunkIDs []string) (map[stri
 }
 
 // ProviderSet is rag data providers.
-var ProviderSet = wire.NewSet(NewKBRepo, NewVectorRepo, NewChunkRepo)
+var ProviderSet = wire.NewSet(NewKBRepo, NewVectorRepo, NewChunkRepo, NewCredentialRepo)
 
 func buildChunkQuery(tenantID string, chunkIDs []string) (string, []any) {
 	placeholders := make([]string, 0, len(chunkIDs))
@@ -184,11 +209,27 @@ func buildChunkQuery(tenantID string, chunkIDs []string) (string, []any) {
 		placeholders = append(placeholders, "?")
 		args = append(args, id)
 	}
-	query := `SELECT id, kb_id, document_id, document_version_id, content, section, page_no, source_uri
-		FROM doc_chunk WHERE tenant_id = ? AND id IN (` + strings.Join(placeholders, ",") + `)`
+	query := `SELECT c.id, c.kb_id, c.document_id, c.document_version_id, c.content, c.section, c.page_no, c.source_uri, c.language,
+			c.keywords, d.access_labels
+		FROM doc_chunk c
+		JOIN document d ON d.tenant_id = c.tenant_id AND d.id = c.document_id
+		WHERE c.tenant_id = ? AND c.excluded = 0 AND c.id IN (` + strings.Join(placeholders, ",") + `)`
 	return query, args
 }
 
+// decodeAccessLabels decodes document.access_labels. Labels that fail to
+// decode are kept as an unmatchable label so the document stays hidden.
+func decodeAccessLabels(raw sql.NullString) []string {
+	if !raw.Valid || strings.TrimSpace(raw.String) == "" {
+		return nil
+	}
+	var labels []string
+	if err := json.Unmarshal([]byte(raw.String), &labels); err != nil {
+		return []string{""}
+	}
+	return labels
+}
+
 func dedupeStrings(values []string) []string {
 	if len(values) == 0 {
 		return nil
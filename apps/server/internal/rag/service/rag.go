@@ -64,12 +64,28 @@ func (s *RAGService) SendMessage(ctx context.Context, req *ragv1.SendMessageRequ
 		s.recordUsage(ctx, key, operation, apiVersion, model, usage, callErr, start, clientIP, userAgent)
 		s.recordAnalytics(ctx, key, req, respConfidence, respRefused, respRefs, callErr, start)
 	}()
+	// Entitlements come from the verified session; without one only
+	// unlabeled documents are searched.
+	var entitlements []string
+	if s.conv != nil && strings.TrimSpace(req.SessionId) != "" {
+		session, _, err := s.conv.GetSession(ctx, req.SessionId, false, 0, 0)
+		if err != nil {
+			callErr = err
+			return nil, callErr
+		}
+		if session.BotID != "" && session.BotID != key.BotID {
+			callErr = errors.BadRequest("SESSION_BOT_MISMATCH", "session bot mismatch")
+			return nil, callErr
+		}
+		entitlements = session.Entitlements
+	}
 	resp, callErr := s.uc.SendMessage(ctx, biz.MessageRequest{
-		SessionID: req.SessionId,
-		BotID:     key.BotID,
-		Message:   req.Message,
-		TopK:      req.TopK,
-		Threshold: req.Threshold,
+		SessionID:    req.SessionId,
+		BotID:        key.BotID,
+		Message:      req.Message,
+		TopK:         req.TopK,
+		Threshold:    req.Threshold,
+		Entitlements: entitlements,
 	})
 	if callErr != nil {
 		return nil, callErr
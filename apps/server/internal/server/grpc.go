@@ -3,18 +3,24 @@ package server
 import (
 	analyticsv1 "github.com/ZTH7/RagoDesk/apps/server/api/analytics/v1"
 	apimgmtv1 "github.com/ZTH7/RagoDesk/apps/server/api/apimgmt/v1"
+	auditv1 "github.com/ZTH7/RagoDesk/apps/server/api/audit/v1"
 	authv1 "github.com/ZTH7/RagoDesk/apps/server/api/auth/v1"
 	botv1 "github.com/ZTH7/RagoDesk/apps/server/api/bot/v1"
 	conversationv1 "github.com/ZTH7/RagoDesk/apps/server/api/conversation/v1"
+	credentialv1 "github.com/ZTH7/RagoDesk/apps/server/api/credential/v1"
 	iamv1 "github.com/ZTH7/RagoDesk/apps/server/api/iam/v1"
 	knowledgev1 "github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1"
 	ragv1 "github.com/ZTH7/RagoDesk/apps/server/api/rag/v1"
 	analyticsservice "github.com/ZTH7/RagoDesk/apps/server/internal/analytics/service"
 	apimgmtservice "github.com/ZTH7/RagoDesk/apps/server/internal/apimgmt/service"
+	auditbiz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
+	auditservice "github.com/ZTH7/RagoDesk/apps/server/internal/audit/service"
+	authbiz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
 	authservice "github.com/ZTH7/RagoDesk/apps/server/internal/auth/service"
 	botservice "github.com/ZTH7/RagoDesk/apps/server/internal/bot/service"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
 	conversationservice "github.com/ZTH7/RagoDesk/apps/server/internal/conversation/service"
+	credentialservice "github.com/ZTH7/RagoDesk/apps/server/internal/credential/service"
 	iamservice "github.com/ZTH7/RagoDesk/apps/server/internal/iam/service"
 	knowledgeservice "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/service"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/middleware"
@@ -26,14 +32,16 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, logger log.Logger, iamSvc *iamservice.IAMService, knowledgeSvc *knowledgeservice.KnowledgeService, ragSvc *ragservice.RAGService, conversationSvc *conversationservice.ConversationService, apimgmtSvc *apimgmtservice.APIMgmtService, analyticsSvc *analyticsservice.AnalyticsService, botSvc *botservice.BotService, consoleAuthSvc *authservice.ConsoleAuthService, platformAuthSvc *authservice.PlatformAuthService) *grpc.Server {
+func NewGRPCServer(c *conf.Server, logger log.Logger, authUC *authbiz.AuthUsecase, auditUC *auditbiz.AuditUsecase, iamSvc *iamservice.IAMService, knowledgeSvc *knowledgeservice.KnowledgeService, ragSvc *ragservice.RAGService, conversationSvc *conversationservice.ConversationService, apimgmtSvc *apimgmtservice.APIMgmtService, analyticsSvc *analyticsservice.AnalyticsService, botSvc *botservice.BotService, consoleAuthSvc *authservice.ConsoleAuthService, platformAuthSvc *authservice.PlatformAuthService, ssoSvc *authservice.SSOService, consoleMFASvc *authservice.ConsoleMFAService, platformMFASvc *authservice.PlatformMFAService, platformImpersonationSvc *authservice.PlatformImpersonationService, consoleImpersonationSvc *authservice.ConsoleImpersonationService, consoleAuditSvc *auditservice.ConsoleAuditService, platformAuditSvc *auditservice.PlatformAuditService, consoleCredentialSvc *credentialservice.ConsoleCredentialService) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
 			middleware.ErrorMiddleware(),
 			middleware.TracingMiddleware(),
 			middleware.LoggingMiddleware(),
-			middleware.AuthMiddleware(c.Auth),
+			middleware.AuthMiddleware(authUC, authUC),
+			middleware.ImpersonationLogMiddleware(logger),
+			middleware.AuditMiddleware(auditUC),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -49,11 +57,20 @@ func NewGRPCServer(c *conf.Server, logger log.Logger, iamSvc *iamservice.IAMServ
 	iamv1.RegisterPlatformIAMServer(srv, iamSvc)
 	iamv1.RegisterConsoleIAMServer(srv, iamSvc)
 	knowledgev1.RegisterConsoleKnowledgeServer(srv, knowledgeSvc)
+	knowledgev1.RegisterPlatformKnowledgeServer(srv, knowledgeSvc)
 	botv1.RegisterConsoleBotServer(srv, botSvc)
 	authv1.RegisterConsoleAuthServer(srv, consoleAuthSvc)
 	authv1.RegisterPlatformAuthServer(srv, platformAuthSvc)
+	authv1.RegisterConsoleSSOServer(srv, ssoSvc)
+	authv1.RegisterConsoleMFAServer(srv, consoleMFASvc)
+	authv1.RegisterPlatformMFAServer(srv, platformMFASvc)
+	authv1.RegisterPlatformImpersonationServer(srv, platformImpersonationSvc)
+	authv1.RegisterConsoleImpersonationServer(srv, consoleImpersonationSvc)
 	apimgmtv1.RegisterConsoleAPIMgmtServer(srv, apimgmtSvc)
 	analyticsv1.RegisterConsoleAnalyticsServer(srv, analyticsSvc)
+	auditv1.RegisterConsoleAuditServer(srv, consoleAuditSvc)
+	auditv1.RegisterPlatformAuditServer(srv, platformAuditSvc)
+	credentialv1.RegisterConsoleCredentialServer(srv, consoleCredentialSvc)
 	ragv1.RegisterRAGServer(srv, ragSvc)
 	conversationv1.RegisterConversationServer(srv, conversationSvc)
 	conversationv1.RegisterConsoleConversationServer(srv, conversationSvc)
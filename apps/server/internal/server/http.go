@@ -3,18 +3,24 @@ package server
 import (
 	analyticsv1 "github.com/ZTH7/RagoDesk/apps/server/api/analytics/v1"
 	apimgmtv1 "github.com/ZTH7/RagoDesk/apps/server/api/apimgmt/v1"
+	auditv1 "github.com/ZTH7/RagoDesk/apps/server/api/audit/v1"
 	authv1 "github.com/ZTH7/RagoDesk/apps/server/api/auth/v1"
 	botv1 "github.com/ZTH7/RagoDesk/apps/server/api/bot/v1"
 	conversationv1 "github.com/ZTH7/RagoDesk/apps/server/api/conversation/v1"
+	credentialv1 "github.com/ZTH7/RagoDesk/apps/server/api/credential/v1"
 	iamv1 "github.com/ZTH7/RagoDesk/apps/server/api/iam/v1"
 	knowledgev1 "github.com/ZTH7/RagoDesk/apps/server/api/knowledge/v1"
 	ragv1 "github.com/ZTH7/RagoDesk/apps/server/api/rag/v1"
 	analyticsservice "github.com/ZTH7/RagoDesk/apps/server/internal/analytics/service"
 	apimgmtservice "github.com/ZTH7/RagoDesk/apps/server/internal/apimgmt/service"
+	auditbiz "github.com/ZTH7/RagoDesk/apps/server/internal/audit/biz"
+	auditservice "github.com/ZTH7/RagoDesk/apps/server/internal/audit/service"
+	authbiz "github.com/ZTH7/RagoDesk/apps/server/internal/auth/biz"
 	authservice "github.com/ZTH7/RagoDesk/apps/server/internal/auth/service"
 	botservice "github.com/ZTH7/RagoDesk/apps/server/internal/bot/service"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/conf"
 	conversationservice "github.com/ZTH7/RagoDesk/apps/server/internal/conversation/service"
+	credentialservice "github.com/ZTH7/RagoDesk/apps/server/internal/credential/service"
 	iamservice "github.com/ZTH7/RagoDesk/apps/server/internal/iam/service"
 	knowledgeservice "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/service"
 	"github.com/ZTH7/RagoDesk/apps/server/internal/middleware"
@@ -26,7 +32,7 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, logger log.Logger, iamSvc *iamservice.IAMService, knowledgeSvc *knowledgeservice.KnowledgeService, ragSvc *ragservice.RAGService, conversationSvc *conversationservice.ConversationService, apimgmtSvc *apimgmtservice.APIMgmtService, analyticsSvc *analyticsservice.AnalyticsService, botSvc *botservice.BotService, consoleAuthSvc *authservice.ConsoleAuthService, platformAuthSvc *authservice.PlatformAuthService) *http.Server {
+func NewHTTPServer(c *conf.Server, logger log.Logger, authUC *authbiz.AuthUsecase, auditUC *auditbiz.AuditUsecase, iamSvc *iamservice.IAMService, knowledgeSvc *knowledgeservice.KnowledgeService, ragSvc *ragservice.RAGService, conversationSvc *conversationservice.ConversationService, apimgmtSvc *apimgmtservice.APIMgmtService, analyticsSvc *analyticsservice.AnalyticsService, botSvc *botservice.BotService, consoleAuthSvc *authservice.ConsoleAuthService, platformAuthSvc *authservice.PlatformAuthService, ssoSvc *authservice.SSOService, consoleMFASvc *authservice.ConsoleMFAService, platformMFASvc *authservice.PlatformMFAService, platformImpersonationSvc *authservice.PlatformImpersonationService, consoleImpersonationSvc *authservice.ConsoleImpersonationService, consoleAuditSvc *auditservice.ConsoleAuditService, platformAuditSvc *auditservice.PlatformAuditService, consoleCredentialSvc *credentialservice.ConsoleCredentialService, jwksSvc *authservice.JWKSService) *http.Server {
 	var opts = []http.ServerOption{
 		http.Filter(middleware.CORSFilter()),
 		http.Middleware(
@@ -34,7 +40,9 @@ func NewHTTPServer(c *conf.Server, logger log.Logger, iamSvc *iamservice.IAMServ
 			middleware.ErrorMiddleware(),
 			middleware.TracingMiddleware(),
 			middleware.LoggingMiddleware(),
-			middleware.AuthMiddleware(c.Auth),
+			middleware.AuthMiddleware(authUC, authUC),
+			middleware.ImpersonationLogMiddleware(logger),
+			middleware.AuditMiddleware(auditUC),
 		),
 	}
 	if c.Http.Network != "" {
@@ -50,14 +58,30 @@ func NewHTTPServer(c *conf.Server, logger log.Logger, iamSvc *iamservice.IAMServ
 	iamv1.RegisterPlatformIAMHTTPServer(srv, iamSvc)
 	iamv1.RegisterConsoleIAMHTTPServer(srv, iamSvc)
 	knowledgev1.RegisterConsoleKnowledgeHTTPServer(srv, knowledgeSvc)
+	knowledgev1.RegisterPlatformKnowledgeHTTPServer(srv, knowledgeSvc)
 	botv1.RegisterConsoleBotHTTPServer(srv, botSvc)
 	authv1.RegisterConsoleAuthHTTPServer(srv, consoleAuthSvc)
 	authv1.RegisterPlatformAuthHTTPServer(srv, platformAuthSvc)
+	authv1.RegisterConsoleSSOHTTPServer(srv, ssoSvc)
+	authv1.RegisterConsoleMFAHTTPServer(srv, consoleMFASvc)
+	authv1.RegisterPlatformMFAHTTPServer(srv, platformMFASvc)
+	authv1.RegisterPlatformImpersonationHTTPServer(srv, platformImpersonationSvc)
+	authv1.RegisterConsoleImpersonationHTTPServer(srv, consoleImpersonationSvc)
 	apimgmtv1.RegisterConsoleAPIMgmtHTTPServer(srv, apimgmtSvc)
 	analyticsv1.RegisterConsoleAnalyticsHTTPServer(srv, analyticsSvc)
+	auditv1.RegisterConsoleAuditHTTPServer(srv, consoleAuditSvc)
+	auditv1.RegisterPlatformAuditHTTPServer(srv, platformAuditSvc)
+	credentialv1.RegisterConsoleCredentialHTTPServer(srv, consoleCredentialSvc)
 	ragv1.RegisterRAGHTTPServer(srv, ragSvc)
 	conversationv1.RegisterConversationHTTPServer(srv, conversationSvc)
 	conversationv1.RegisterConsoleConversationHTTPServer(srv, conversationSvc)
 	srv.Route("/console/v1").POST("/documents/upload_file", knowledgeSvc.UploadDocumentFile)
+	srv.Route("/console/v1").POST("/documents/import", knowledgeSvc.ImportDocuments)
+	srv.Route("/console/v1").GET("/knowledge_bases/{kb_id}/export", knowledgeSvc.ExportKnowledgeBase)
+	srv.Route("/console/v1").POST("/knowledge_bases/import", knowledgeSvc.ImportKnowledgeBase)
+	srv.Route("/console/v1").GET("/sso/oidc/callback", ssoSvc.OIDCCallback)
+	srv.Route("/console/v1").POST("/sso/saml/acs", ssoSvc.SAMLACS)
+	srv.Route("/console/v1").GET("/sso/saml/{tenant_id}/metadata", ssoSvc.SAMLMetadata)
+	srv.Route("/.well-known").GET("/jwks.json", jwksSvc.JWKS)
 	return srv
 }
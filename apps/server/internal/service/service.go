@@ -3,9 +3,11 @@ package service
 import (
 	analyticsservice "github.com/ZTH7/RagoDesk/apps/server/internal/analytics/service"
 	apimgmtservice "github.com/ZTH7/RagoDesk/apps/server/internal/apimgmt/service"
+	auditservice "github.com/ZTH7/RagoDesk/apps/server/internal/audit/service"
 	authservice "github.com/ZTH7/RagoDesk/apps/server/internal/auth/service"
 	botservice "github.com/ZTH7/RagoDesk/apps/server/internal/bot/service"
 	conversationservice "github.com/ZTH7/RagoDesk/apps/server/internal/conversation/service"
+	credentialservice "github.com/ZTH7/RagoDesk/apps/server/internal/credential/service"
 	iamservice "github.com/ZTH7/RagoDesk/apps/server/internal/iam/service"
 	knowledgeservice "github.com/ZTH7/RagoDesk/apps/server/internal/knowledge/service"
 	ragservice "github.com/ZTH7/RagoDesk/apps/server/internal/rag/service"
@@ -17,9 +19,11 @@ import (
 var ProviderSet = wire.NewSet(
 	analyticsservice.ProviderSet,
 	apimgmtservice.ProviderSet,
+	auditservice.ProviderSet,
 	authservice.ProviderSet,
 	botservice.ProviderSet,
 	conversationservice.ProviderSet,
+	credentialservice.ProviderSet,
 	iamservice.ProviderSet,
 	knowledgeservice.ProviderSet,
 	ragservice.ProviderSet,